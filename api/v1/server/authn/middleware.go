@@ -289,7 +289,7 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	}
 
 	// Validate the token.
-	tenantId, tokenUUID, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
+	tenantId, tokenUUID, scopes, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
 
 	if err != nil {
 		a.l.Debug().Ctx(ctx).Err(err).Msg("error validating tenant token")
@@ -307,6 +307,11 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 
 	c.Set(string(analytics.APITokenIDKey), tokenUUID)
 
+	// important: scopes are enforced later in the authz step
+	if scopes != nil {
+		c.Set(middleware.APITokenScopesContextKey, scopes)
+	}
+
 	ctx = context.WithValue(ctx, analytics.APITokenIDKey, tokenUUID)
 	ctx = context.WithValue(ctx, analytics.TenantIDKey, tenantId)
 	ctx = context.WithValue(ctx, analytics.SourceKey, analytics.SourceAPI)
@@ -207,6 +207,46 @@ func (s *SessionHelpers) ValidateOAuthState(
 	return true, isOAuthTriggered, nil
 }
 
+// SaveOAuthNonce generates the nonce of an OpenID Connect login and stores it in the session next to the OAuth
+// state, so the ID token returned to the callback can be bound to the browser which started the login.
+func (s *SessionHelpers) SaveOAuthNonce(
+	c echo.Context,
+	integration string,
+) (string, error) {
+	nonce, err := random.Generate(32)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.SaveKV(c, fmt.Sprintf("oauth_nonce_%s", integration), nonce); err != nil {
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+// PopOAuthNonce returns the nonce stored by SaveOAuthNonce and removes it from the session, so each nonce is
+// only accepted once.
+func (s *SessionHelpers) PopOAuthNonce(
+	c echo.Context,
+	integration string,
+) (string, error) {
+	nonceKey := fmt.Sprintf("oauth_nonce_%s", integration)
+
+	nonce, err := s.GetKey(c, nonceKey)
+
+	if err != nil || nonce == "" {
+		return "", fmt.Errorf("nonce not found in session")
+	}
+
+	if err := s.RemoveKey(c, nonceKey); err != nil {
+		return "", fmt.Errorf("could not clear session")
+	}
+
+	return nonce, nil
+}
+
 func (s *SessionHelpers) SaveNewSession(c echo.Context, session *sessions.Session) error {
 	session.Values["authenticated"] = false
 
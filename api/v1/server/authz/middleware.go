@@ -1,15 +1,20 @@
 package authz
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
 	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
 
@@ -20,9 +25,15 @@ type AuthZ struct {
 }
 
 func NewAuthZ(config *server.ServerConfig) (*AuthZ, error) {
-	rbacAuthorizer, err := newHatchetAuthorizer()
-	if err != nil {
-		return nil, err
+	rbacAuthorizer := config.Auth.RoleAuthorizer
+
+	if rbacAuthorizer == nil {
+		var err error
+
+		rbacAuthorizer, err = NewHatchetAuthorizer()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &AuthZ{
@@ -88,8 +99,8 @@ var restrictedWithBearerToken = []string{
 	"ApiTokenUpdateRevoke",
 }
 
-// At the moment, there's no further bearer auth because bearer tokens are admin-scoped
-// and we check that the bearer token has access to the tenant in the authn step.
+// Bearer tokens are admin-scoped unless they were created with scopes, and we check that the bearer
+// token has access to the tenant in the authn step.
 func (a *AuthZ) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
 	// check for is_exchange_token set in the context, in which case we need to validate the user set in the context
 	// exchange tokens are subject to the same RBAC restrictions as cookie auth, since they represent a user. only
@@ -113,11 +124,140 @@ func (a *AuthZ) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error
 		if rbac.OperationIn(r.OperationID, restrictedWithBearerToken) {
 			return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
 		}
+
+		if scopes, ok := c.Get(middleware.APITokenScopesContextKey).(*rbac.Scopes); ok {
+			return a.authorizeTokenScopes(c, r, scopes)
+		}
+	}
+
+	return nil
+}
+
+func (a *AuthZ) authorizeTokenScopes(c echo.Context, r *middleware.RouteInfo, scopes *rbac.Scopes) error {
+	unauthorized := echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+	ctx := c.Request().Context()
+
+	if !a.config.Auth.ScopeAuthorizer.IsAuthorized(scopes, r.OperationID) {
+		a.l.Debug().Ctx(ctx).Msgf("api token scopes do not include operation %s", r.OperationID)
+
+		return unauthorized
+	}
+
+	if !scopes.RestrictsWorkflows() {
+		return nil
+	}
+
+	workflowName, err := a.getRequestWorkflowName(c, r)
+
+	if err != nil {
+		a.l.Debug().Ctx(ctx).Err(err).Msgf("error getting workflow name for request")
+
+		return unauthorized
+	}
+
+	// operations which can't be attributed to a single workflow are not permitted for workflow-scoped tokens
+	if workflowName == "" || !scopes.AllowsWorkflow(workflowName) {
+		a.l.Debug().Ctx(ctx).Msgf("api token scopes do not include workflow %s for operation %s", workflowName, r.OperationID)
+
+		return unauthorized
 	}
 
 	return nil
 }
 
+// getRequestWorkflowName returns the name of the workflow which the request operates on, based on the resources
+// populated for the route. It returns an empty string if the request doesn't operate on a single workflow.
+func (a *AuthZ) getRequestWorkflowName(c echo.Context, r *middleware.RouteInfo) (string, error) {
+	var workflowId uuid.UUID
+
+	// triggering a run references the workflow by name in the request body
+	if r.OperationID == "V1WorkflowRunCreate" {
+		return getRequestBodyWorkflowName(c)
+	}
+
+	switch {
+	case c.Get("workflow") != nil:
+		workflow, ok := c.Get("workflow").(*sqlcv1.GetWorkflowByIdRow)
+
+		if !ok {
+			return "", fmt.Errorf("unexpected workflow type in context")
+		}
+
+		return workflow.Workflow.Name, nil
+	case c.Get("cron-workflow") != nil:
+		cron, ok := c.Get("cron-workflow").(*sqlcv1.ListCronWorkflowsRow)
+
+		if !ok {
+			return "", fmt.Errorf("unexpected cron workflow type in context")
+		}
+
+		return cron.WorkflowName, nil
+	case c.Get("scheduled-workflow-run") != nil:
+		scheduled, ok := c.Get("scheduled-workflow-run").(*sqlcv1.ListScheduledWorkflowsRow)
+
+		if !ok {
+			return "", fmt.Errorf("unexpected scheduled workflow run type in context")
+		}
+
+		return scheduled.Name, nil
+	case c.Get("v1-workflow-run") != nil:
+		workflowRun, ok := c.Get("v1-workflow-run").(*v1.V1WorkflowRunPopulator)
+
+		if !ok {
+			return "", fmt.Errorf("unexpected workflow run type in context")
+		}
+
+		workflowId = workflowRun.WorkflowRun.WorkflowID
+	case c.Get("task") != nil:
+		task, ok := c.Get("task").(*sqlcv1.V1TasksOlap)
+
+		if !ok {
+			return "", fmt.Errorf("unexpected task type in context")
+		}
+
+		workflowId = task.WorkflowID
+	default:
+		// routes like /workflows/{workflow}/scheduled reference the workflow by name
+		return c.Param("workflow"), nil
+	}
+
+	workflow, err := a.config.V1.Workflows().GetWorkflowById(c.Request().Context(), workflowId)
+
+	if err != nil {
+		return "", fmt.Errorf("could not get workflow %s: %w", workflowId, err)
+	}
+
+	return workflow.Workflow.Name, nil
+}
+
+// getRequestBodyWorkflowName reads the workflow name from the request body, and restores the body so that it can
+// be bound by the handler.
+func getRequestBodyWorkflowName(c echo.Context) (string, error) {
+	req := c.Request()
+
+	if req.Body == nil {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("could not read request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var parsed struct {
+		WorkflowName string `json:"workflowName"`
+	}
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("could not parse request body: %w", err)
+	}
+
+	return parsed.WorkflowName, nil
+}
+
 func (a *AuthZ) handleCustomAuth(c echo.Context, r *middleware.RouteInfo) error {
 	if a.config.Auth.CustomAuthenticator == nil {
 		return fmt.Errorf("custom auth handler is not set")
@@ -182,7 +322,7 @@ func (a *AuthZ) validateUserTenantPermissions(c echo.Context, r *middleware.Rout
 		c.Set("tenant-member", tenantMember)
 
 		// authorize tenant operations
-		if err := a.authorizeTenantOperations(tenantMember.Role, r); err != nil {
+		if err := a.authorizeTenantOperations(tenantMember, r); err != nil {
 			a.l.Debug().Ctx(ctx).Err(err).Msgf("error authorizing tenant operations")
 
 			return unauthorized
@@ -192,14 +332,25 @@ func (a *AuthZ) validateUserTenantPermissions(c echo.Context, r *middleware.Rout
 	return nil
 }
 
-func (a *AuthZ) authorizeTenantOperations(tenantMemberRole sqlcv1.TenantMemberRole, r *middleware.RouteInfo) error {
+func (a *AuthZ) authorizeTenantOperations(tenantMember *sqlcv1.PopulateTenantMembersRow, r *middleware.RouteInfo) error {
 	// if the operation is in the allowed operations, skip the RBAC check this is needed for extensions
 	if rbac.OperationIn(r.OperationID, a.config.Auth.AllowedOperations) {
 		return nil
 	}
 
+	// members with a custom role are only permitted to perform the operations granted by that role
+	if tenantMember.CustomRoleId != nil {
+		role := rbac.NewRole(tenantMember.CustomRoleInherits, tenantMember.CustomRolePermissions)
+
+		if !a.rbac.IsAuthorizedRole(role, r.OperationID) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+		}
+
+		return nil
+	}
+
 	// at the moment, tenant members are only restricted from creating other tenant users.
-	if !a.rbac.IsAuthorized(string(tenantMemberRole), r.OperationID) {
+	if !a.rbac.IsAuthorized(string(tenantMember.Role), r.OperationID) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
 	}
 
@@ -0,0 +1,59 @@
+package authz
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+func TestWorkflowScopedTokenTriggersV1WorkflowRun(t *testing.T) {
+	l := zerolog.Nop()
+
+	a := &AuthZ{
+		config: &server.ServerConfig{
+			Auth: server.AuthConfig{
+				ScopeAuthorizer: newScopeAuthorizer(t),
+			},
+		},
+		l: &l,
+	}
+
+	route := &middleware.RouteInfo{OperationID: "V1WorkflowRunCreate"}
+	scopes := rbac.NewScopes([]string{rbac.ScopeTriggerOnly}, []string{"my-workflow"})
+
+	trigger := func(body string) (echo.Context, error) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/stable/tenants/tenant/workflow-runs/trigger", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		c.Set(middleware.APITokenScopesContextKey, scopes)
+
+		return c, a.handleBearerAuth(c, route)
+	}
+
+	body := `{"workflowName":"my-workflow","input":{}}`
+	c, err := trigger(body)
+	require.NoError(t, err)
+
+	// the handler must still be able to bind the body
+	remaining, err := io.ReadAll(c.Request().Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(remaining))
+
+	_, err = trigger(`{"workflowName":"other-workflow","input":{}}`)
+	assert.Error(t, err)
+
+	_, err = trigger(`{"input":{}}`)
+	assert.Error(t, err)
+}
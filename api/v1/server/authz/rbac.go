@@ -11,7 +11,9 @@ import (
 //go:embed rbac.yaml
 var yamlFile []byte
 
-func newHatchetAuthorizer() (*rbac.Authorizer, error) {
+// NewHatchetAuthorizer returns an authorizer for the built-in tenant member roles, which is also used to
+// validate and authorize custom tenant roles.
+func NewHatchetAuthorizer() (*rbac.Authorizer, error) {
 	permMap, err := rbac.LoadPermissionMap(yamlFile)
 	if err != nil {
 		return nil, err
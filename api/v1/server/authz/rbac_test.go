@@ -17,6 +17,10 @@ var adminAndOwnerOnly = []string{
 	"TenantInviteDelete",
 	"TenantMemberList",
 	"TenantMemberUpdate",
+	"TenantRoleList",
+	"TenantRoleCreate",
+	"TenantRoleUpdate",
+	"TenantRoleDelete",
 	// members cannot create API tokens for a tenant, because they have admin permissions
 	"ApiTokenList",
 	"ApiTokenCreate",
@@ -35,7 +39,7 @@ func operationIdsFromSpec() []string {
 }
 
 func TestAuthorizeTenantOperations(t *testing.T) {
-	r, err := newHatchetAuthorizer()
+	r, err := NewHatchetAuthorizer()
 	assert.Nil(t, err)
 	allOperations := operationIdsFromSpec()
 	for _, operationId := range allOperations {
@@ -50,6 +54,115 @@ func TestAuthorizeTenantOperations(t *testing.T) {
 }
 
 func TestValidateSpec(t *testing.T) {
-	_, err := newHatchetAuthorizer()
+	_, err := NewHatchetAuthorizer()
 	assert.Nil(t, err)
 }
+
+func newScopeAuthorizer(t *testing.T) *rbac.ScopeAuthorizer {
+	t.Helper()
+
+	spec, err := gen.GetSwagger()
+	assert.Nil(t, err)
+
+	a, err := rbac.NewScopeAuthorizer(spec)
+	assert.Nil(t, err)
+
+	return a
+}
+
+func TestScopeAuthorizer(t *testing.T) {
+	a := newScopeAuthorizer(t)
+
+	// unscoped tokens can perform every operation
+	for _, operationId := range operationIdsFromSpec() {
+		assert.True(t, a.IsAuthorized(nil, operationId))
+	}
+
+	readOnly := rbac.NewScopes([]string{rbac.ScopeReadOnly}, nil)
+	assert.True(t, a.IsAuthorized(readOnly, "WorkflowList"))
+	assert.True(t, a.IsAuthorized(readOnly, "V1WorkflowRunGet"))
+	assert.False(t, a.IsAuthorized(readOnly, "V1WorkflowRunCreate"))
+	assert.False(t, a.IsAuthorized(readOnly, "WorkflowDelete"))
+
+	triggerOnly := rbac.NewScopes([]string{rbac.ScopeTriggerOnly}, nil)
+	assert.True(t, a.IsAuthorized(triggerOnly, "V1WorkflowRunCreate"))
+	assert.True(t, a.IsAuthorized(triggerOnly, "EventCreate"))
+	assert.False(t, a.IsAuthorized(triggerOnly, "WorkflowList"))
+
+	// presets and operation ids can be combined
+	combined := rbac.NewScopes([]string{rbac.ScopeTriggerOnly, "v1workflowrunget"}, nil)
+	assert.True(t, a.IsAuthorized(combined, "V1WorkflowRunCreate"))
+	assert.True(t, a.IsAuthorized(combined, "V1WorkflowRunGet"))
+	assert.False(t, a.IsAuthorized(combined, "V1WorkflowRunList"))
+}
+
+func TestScopeAuthorizerValidate(t *testing.T) {
+	a := newScopeAuthorizer(t)
+
+	assert.Nil(t, a.Validate(nil))
+	assert.Nil(t, a.Validate(rbac.NewScopes([]string{rbac.ScopeReadOnly, "WorkflowRunCreate"}, []string{"my-workflow"})))
+	assert.NotNil(t, a.Validate(rbac.NewScopes([]string{"NotAnOperation"}, nil)))
+	assert.NotNil(t, a.Validate(rbac.NewScopes(nil, []string{" "})))
+}
+
+func TestScopeAuthorizerGRPC(t *testing.T) {
+	a := newScopeAuthorizer(t)
+
+	assert.True(t, a.IsAuthorizedGRPC(nil, "/Dispatcher/Listen"))
+
+	triggerOnly := rbac.NewScopes([]string{rbac.ScopeTriggerOnly}, nil)
+	assert.True(t, a.IsAuthorizedGRPC(triggerOnly, "/v1.AdminService/TriggerWorkflowRun"))
+	assert.True(t, a.IsAuthorizedGRPC(triggerOnly, "/EventsService/Push"))
+	assert.True(t, a.IsAuthorizedGRPC(triggerOnly, "/Dispatcher/GetVersion"))
+	assert.False(t, a.IsAuthorizedGRPC(triggerOnly, "/v1.AdminService/CancelTasks"))
+	assert.False(t, a.IsAuthorizedGRPC(triggerOnly, "/Dispatcher/Listen"))
+
+	workflowScoped := rbac.NewScopes(nil, []string{"my-workflow"})
+	assert.True(t, a.IsAuthorizedGRPC(workflowScoped, "/WorkflowService/TriggerWorkflow"))
+	assert.False(t, a.IsAuthorizedGRPC(workflowScoped, "/Dispatcher/SubscribeToWorkflowRuns"))
+	assert.False(t, a.IsAuthorizedGRPC(workflowScoped, "/Dispatcher/SubscribeToWorkflowEvents"))
+	assert.False(t, a.IsAuthorizedGRPC(workflowScoped, "/EventsService/Push"))
+	assert.False(t, a.IsAuthorizedGRPC(workflowScoped, "/Dispatcher/Register"))
+
+	assert.True(t, workflowScoped.AllowsWorkflow("my-workflow"))
+	assert.False(t, workflowScoped.AllowsWorkflow("other-workflow"))
+}
+
+func TestValidateCustomRole(t *testing.T) {
+	r, err := NewHatchetAuthorizer()
+	assert.Nil(t, err)
+
+	operator := rbac.NewRole(nil, []string{"V1TaskCancel", "V1TaskReplay"})
+	assert.Nil(t, r.ValidateRole("operator", operator))
+	assert.Nil(t, r.ValidateRole("viewer", rbac.NewRole([]string{"MEMBER"}, nil)))
+
+	assert.NotNil(t, r.ValidateRole("", operator))
+	assert.NotNil(t, r.ValidateRole("admin", operator))
+	assert.NotNil(t, r.ValidateRole("escalated", rbac.NewRole([]string{"OWNER"}, nil)))
+	assert.NotNil(t, r.ValidateRole("escalated-admin", rbac.NewRole([]string{"ADMIN"}, nil)))
+	assert.NotNil(t, r.ValidateRole("member-manager", rbac.NewRole([]string{"MEMBER"}, []string{"TenantMemberUpdate"})))
+	assert.NotNil(t, r.ValidateRole("role-manager", rbac.NewRole(nil, []string{"TenantRoleUpdate"})))
+	assert.NotNil(t, r.ValidateRole("unknown-role", rbac.NewRole([]string{"OPERATOR"}, nil)))
+	assert.NotNil(t, r.ValidateRole("unknown-operation", rbac.NewRole(nil, []string{"NotAnOperation"})))
+}
+
+func TestAuthorizeCustomRole(t *testing.T) {
+	r, err := NewHatchetAuthorizer()
+	assert.Nil(t, err)
+
+	operator := rbac.NewRole(nil, []string{"V1TaskCancel", "V1TaskReplay"})
+	assert.True(t, r.IsAuthorizedRole(operator, "V1TaskCancel"))
+	assert.True(t, r.IsAuthorizedRole(operator, "V1TaskReplay"))
+	assert.False(t, r.IsAuthorizedRole(operator, "WorkflowUpdate"))
+	assert.False(t, r.IsAuthorizedRole(operator, "TenantMemberUpdate"))
+
+	memberOperator := rbac.NewRole([]string{"MEMBER"}, []string{"TenantMemberList"})
+	assert.True(t, r.IsAuthorizedRole(memberOperator, "WorkflowUpdate"))
+	assert.True(t, r.IsAuthorizedRole(memberOperator, "TenantMemberList"))
+	assert.False(t, r.IsAuthorizedRole(memberOperator, "TenantMemberUpdate"))
+
+	// roles stored before management operations were restricted don't grant them
+	legacy := rbac.NewRole([]string{"ADMIN"}, []string{"TenantRoleUpdate"})
+	assert.False(t, r.IsAuthorizedRole(legacy, "TenantRoleUpdate"))
+	assert.False(t, r.IsAuthorizedRole(legacy, "TenantMemberUpdate"))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	authtoken "github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/constants"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
@@ -38,7 +40,20 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		expiresAt = &e
 	}
 
-	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenantId, request.Body.Name, false, expiresAt)
+	var token *authtoken.Token
+	var err error
+
+	scopes := rbac.NewScopes(derefStrings(request.Body.Scopes), derefStrings(request.Body.Workflows))
+
+	if scopes != nil {
+		if err := a.config.Auth.ScopeAuthorizer.Validate(scopes); err != nil {
+			return gen.ApiTokenCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		token, err = a.config.Auth.JWTManager.GenerateScopedTenantToken(ctx.Request().Context(), tenantId, request.Body.Name, expiresAt, scopes)
+	} else {
+		token, err = a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenantId, request.Body.Name, false, expiresAt)
+	}
 
 	if err != nil {
 		return nil, err
@@ -54,6 +69,7 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		map[string]interface{}{
 			"name":       request.Body.Name,
 			"expires_at": expiresAt,
+			"scoped":     scopes != nil,
 		},
 	)
 
@@ -62,3 +78,11 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		Token: token.Token,
 	}, nil
 }
+
+func derefStrings(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+
+	return *s
+}
@@ -21,6 +21,13 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 		authTypes = append(authTypes, "github")
 	}
 
+	var oidcName *string
+
+	if u.config.Auth.OIDCProvider != nil {
+		authTypes = append(authTypes, "oidc")
+		oidcName = &u.config.Auth.ConfigFile.OIDC.Name
+	}
+
 	pylonAppID := u.config.Pylon.AppID
 
 	var posthogConfig *gen.APIMetaPosthog
@@ -38,7 +45,8 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 
 	meta := gen.APIMeta{
 		Auth: &gen.APIMetaAuth{
-			Schemes: &authTypes,
+			Schemes:  &authTypes,
+			OidcName: oidcName,
 		},
 		PylonAppId:              &pylonAppID,
 		Posthog:                 posthogConfig,
@@ -0,0 +1,66 @@
+package tenants
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// alert rules are evaluated once a minute, so shorter windows can't be evaluated reliably
+const minAlertRuleWindow = time.Minute
+
+func (t *TenantService) AlertRuleCreate(ctx echo.Context, request gen.AlertRuleCreateRequestObject) (gen.AlertRuleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.AlertRuleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	window := "1h"
+
+	if request.Body.Window != nil {
+		window = *request.Body.Window
+	}
+
+	if d, err := time.ParseDuration(window); err != nil || d < minAlertRuleWindow {
+		return gen.AlertRuleCreate400JSONResponse(apierrors.NewAPIErrors("window must be a duration of at least 1m")), nil
+	}
+
+	workflow, err := t.config.V1.Workflows().GetWorkflowById(ctx.Request().Context(), request.Body.WorkflowId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if err != nil || workflow.Workflow.TenantId != tenantId {
+		return gen.AlertRuleCreate400JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+	}
+
+	rule, err := t.config.V1.TenantAlertingSettings().CreateTenantAlertRule(ctx.Request().Context(), tenantId, &v1.CreateTenantAlertRuleOpts{
+		Name:       request.Body.Name,
+		Kind:       string(request.Body.Kind),
+		WorkflowId: request.Body.WorkflowId,
+		Threshold:  request.Body.Threshold,
+		Window:     window,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertRuleCreate201JSONResponse(
+		*transformers.ToTenantAlertRule(rule),
+	), nil
+}
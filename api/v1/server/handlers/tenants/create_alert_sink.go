@@ -0,0 +1,65 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) AlertSinkCreate(ctx echo.Context, request gen.AlertSinkCreateRequestObject) (gen.AlertSinkCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.AlertSinkCreate400JSONResponse(*apiErrors), nil
+	}
+
+	cfg := &alerting.AlertSinkConfig{}
+
+	if request.Body.Url != nil {
+		cfg.URL = *request.Body.Url
+	}
+
+	if request.Body.Secret != nil {
+		cfg.Secret = *request.Body.Secret
+	}
+
+	if request.Body.RoutingKey != nil {
+		cfg.RoutingKey = *request.Body.RoutingKey
+	}
+
+	kind := sqlcv1.TenantAlertSinkKind(request.Body.Kind)
+
+	if err := cfg.Validate(kind); err != nil {
+		return gen.AlertSinkCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	// the config is encrypted, since it contains secrets and routing keys
+	encryptedCfg, err := alerting.EncryptAlertSinkConfig(t.config.Encryption, kind, cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := t.config.V1.TenantAlertingSettings().CreateTenantAlertSink(ctx.Request().Context(), tenantId, &v1.CreateTenantAlertSinkOpts{
+		Name:   request.Body.Name,
+		Kind:   string(request.Body.Kind),
+		Config: encryptedCfg,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertSinkCreate201JSONResponse(
+		*transformers.ToTenantAlertSink(sink),
+	), nil
+}
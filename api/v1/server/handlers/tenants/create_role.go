@@ -0,0 +1,66 @@
+package tenants
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/constants"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) TenantRoleCreate(ctx echo.Context, request gen.TenantRoleCreateRequestObject) (gen.TenantRoleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	inherits := make([]string, 0)
+
+	if request.Body.Inherits != nil {
+		inherits = *request.Body.Inherits
+	}
+
+	permissions := make([]string, 0)
+
+	if request.Body.Permissions != nil {
+		permissions = *request.Body.Permissions
+	}
+
+	// custom roles must only reference built-in roles and operations which exist in the spec
+	if err := t.config.Auth.RoleAuthorizer.ValidateRole(request.Body.Name, rbac.NewRole(inherits, permissions)); err != nil {
+		return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	role, err := t.config.V1.TenantRole().CreateTenantRole(ctx.Request().Context(), tenantId, &v1.CreateTenantRoleOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Inherits:    inherits,
+		Permissions: permissions,
+	})
+
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors("a role with that name already exists")), nil
+		}
+
+		return nil, err
+	}
+
+	ctx.Set(constants.ResourceIdKey.String(), role.ID.String())
+	ctx.Set(constants.ResourceTypeKey.String(), constants.ResourceTypeTenantRole.String())
+
+	return gen.TenantRoleCreate201JSONResponse(
+		*transformers.ToTenantRole(role),
+	), nil
+}
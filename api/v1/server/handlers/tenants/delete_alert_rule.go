@@ -0,0 +1,22 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) AlertRuleDelete(ctx echo.Context, request gen.AlertRuleDeleteRequestObject) (gen.AlertRuleDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+	rule := ctx.Get("alert-rule").(*sqlcv1.TenantAlertRule)
+
+	err := t.config.V1.TenantAlertingSettings().DeleteTenantAlertRule(ctx.Request().Context(), tenantId, rule.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertRuleDelete204Response{}, nil
+}
@@ -0,0 +1,22 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) AlertSinkDelete(ctx echo.Context, request gen.AlertSinkDeleteRequestObject) (gen.AlertSinkDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+	sink := ctx.Get("alert-sink").(*sqlcv1.TenantAlertSink)
+
+	err := t.config.V1.TenantAlertingSettings().DeleteTenantAlertSink(ctx.Request().Context(), tenantId, sink.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertSinkDelete204Response{}, nil
+}
@@ -0,0 +1,34 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/constants"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) TenantRoleDelete(ctx echo.Context, request gen.TenantRoleDeleteRequestObject) (gen.TenantRoleDeleteResponseObject, error) {
+	role := ctx.Get("tenant-role").(*sqlcv1.TenantRole)
+
+	err := t.config.V1.TenantRole().DeleteTenantRole(ctx.Request().Context(), role.ID)
+
+	if errors.Is(err, v1.ErrTenantRoleInUse) {
+		return gen.TenantRoleDelete400JSONResponse(
+			apierrors.NewAPIErrors("the role is assigned to one or more members, reassign them before deleting the role"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Set(constants.ResourceIdKey.String(), role.ID.String())
+	ctx.Set(constants.ResourceTypeKey.String(), constants.ResourceTypeTenantRole.String())
+
+	return gen.TenantRoleDelete204Response{}, nil
+}
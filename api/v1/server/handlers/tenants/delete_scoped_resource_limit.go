@@ -0,0 +1,22 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) ScopedResourceLimitDelete(ctx echo.Context, request gen.ScopedResourceLimitDeleteRequestObject) (gen.ScopedResourceLimitDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+	limit := ctx.Get("scoped-resource-limit").(*sqlcv1.V1ScopedResourceLimit)
+
+	err := t.config.V1.TenantLimit().DeleteScopedLimit(ctx.Request().Context(), tenantId, limit.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ScopedResourceLimitDelete204Response{}, nil
+}
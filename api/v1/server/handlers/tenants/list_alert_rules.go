@@ -0,0 +1,30 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) AlertRuleList(ctx echo.Context, request gen.AlertRuleListRequestObject) (gen.AlertRuleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	rules, err := t.config.V1.TenantAlertingSettings().ListTenantAlertRules(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantAlertRule, len(rules))
+
+	for i := range rules {
+		rows[i] = *transformers.ToTenantAlertRule(rules[i])
+	}
+
+	return gen.AlertRuleList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) AlertSinkList(ctx echo.Context, request gen.AlertSinkListRequestObject) (gen.AlertSinkListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	sinks, err := t.config.V1.TenantAlertingSettings().ListTenantAlertSinks(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantAlertSink, len(sinks))
+
+	for i := range sinks {
+		rows[i] = *transformers.ToTenantAlertSink(sinks[i])
+	}
+
+	return gen.AlertSinkList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
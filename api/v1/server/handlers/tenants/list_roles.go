@@ -0,0 +1,30 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) TenantRoleList(ctx echo.Context, request gen.TenantRoleListRequestObject) (gen.TenantRoleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	roles, err := t.config.V1.TenantRole().ListTenantRoles(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantRole, len(roles))
+
+	for i := range roles {
+		rows[i] = *transformers.ToTenantRole(roles[i])
+	}
+
+	return gen.TenantRoleList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
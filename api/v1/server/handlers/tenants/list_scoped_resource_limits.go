@@ -0,0 +1,30 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) ScopedResourceLimitList(ctx echo.Context, request gen.ScopedResourceLimitListRequestObject) (gen.ScopedResourceLimitListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	limits, err := t.config.V1.TenantLimit().ListScopedLimits(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ScopedResourceLimit, len(limits))
+
+	for i := range limits {
+		rows[i] = *transformers.ToScopedResourceLimit(limits[i])
+	}
+
+	return gen.ScopedResourceLimitList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
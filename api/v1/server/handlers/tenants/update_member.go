@@ -1,6 +1,9 @@
 package tenants
 
 import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
@@ -20,6 +23,13 @@ func (t *TenantService) TenantMemberUpdate(ctx echo.Context, request gen.TenantM
 		return gen.TenantMemberUpdate400JSONResponse(*apiErrors), nil
 	}
 
+	// members with a custom role can't change roles, since they could assign permissions they don't have
+	if tenantMember.Role == sqlcv1.TenantMemberRoleMEMBER || tenantMember.CustomRoleId != nil {
+		return gen.TenantMemberUpdate400JSONResponse(
+			apierrors.NewAPIErrors("only owners and admins can change the role of a member"),
+		), nil
+	}
+
 	// if user is not an owner, they cannot change a role to owner or change owner roles
 	if tenantMember.Role != sqlcv1.TenantMemberRoleOWNER {
 		if request.Body.Role == gen.OWNER {
@@ -47,6 +57,29 @@ func (t *TenantService) TenantMemberUpdate(ctx echo.Context, request gen.TenantM
 		Role: v1.StringPtr(string(request.Body.Role)),
 	}
 
+	// custom roles replace the permissions of the MEMBER role, so they can't be combined with other roles
+	if request.Body.CustomRoleId != nil {
+		if request.Body.Role != gen.MEMBER {
+			return gen.TenantMemberUpdate400JSONResponse(
+				apierrors.NewAPIErrors("custom roles can only be assigned to members with the MEMBER role"),
+			), nil
+		}
+
+		customRole, err := t.config.V1.TenantRole().GetTenantRoleById(ctx.Request().Context(), *request.Body.CustomRoleId)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if customRole == nil || customRole.TenantId != memberToUpdate.TenantId {
+			return gen.TenantMemberUpdate400JSONResponse(
+				apierrors.NewAPIErrors("custom role not found"),
+			), nil
+		}
+
+		updateOpts.CustomRoleId = &customRole.ID
+	}
+
 	updatedMember, err := t.config.V1.Tenant().UpdateTenantMember(ctx.Request().Context(), memberToUpdate.ID, updateOpts)
 
 	if err != nil {
@@ -0,0 +1,60 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/constants"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) TenantRoleUpdate(ctx echo.Context, request gen.TenantRoleUpdateRequestObject) (gen.TenantRoleUpdateResponseObject, error) {
+	role := ctx.Get("tenant-role").(*sqlcv1.TenantRole)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	updateOpts := &v1.UpdateTenantRoleOpts{
+		Description: request.Body.Description,
+	}
+
+	inherits := role.Inherits
+
+	if request.Body.Inherits != nil {
+		inherits = *request.Body.Inherits
+		updateOpts.Inherits = inherits
+	}
+
+	permissions := role.Permissions
+
+	if request.Body.Permissions != nil {
+		permissions = *request.Body.Permissions
+		updateOpts.Permissions = permissions
+	}
+
+	// custom roles must only reference built-in roles and operations which exist in the spec
+	if err := t.config.Auth.RoleAuthorizer.ValidateRole(role.Name, rbac.NewRole(inherits, permissions)); err != nil {
+		return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	updatedRole, err := t.config.V1.TenantRole().UpdateTenantRole(ctx.Request().Context(), role.ID, updateOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Set(constants.ResourceIdKey.String(), updatedRole.ID.String())
+	ctx.Set(constants.ResourceTypeKey.String(), constants.ResourceTypeTenantRole.String())
+
+	return gen.TenantRoleUpdate200JSONResponse(
+		*transformers.ToTenantRole(updatedRole),
+	), nil
+}
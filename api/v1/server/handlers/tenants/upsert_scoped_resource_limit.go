@@ -0,0 +1,80 @@
+package tenants
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TenantService) ScopedResourceLimitUpsert(ctx echo.Context, request gen.ScopedResourceLimitUpsertRequestObject) (gen.ScopedResourceLimitUpsertResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ScopedResourceLimitUpsert400JSONResponse(*apiErrors), nil
+	}
+
+	window := 24 * time.Hour
+
+	if request.Body.Window != nil {
+		d, err := time.ParseDuration(*request.Body.Window)
+
+		if err != nil {
+			return gen.ScopedResourceLimitUpsert400JSONResponse(apierrors.NewAPIErrors("window must be a duration")), nil
+		}
+
+		window = d
+	}
+
+	opts := &v1.UpsertScopedResourceLimitOpts{
+		Resource:      sqlcv1.LimitResource(request.Body.Resource),
+		Scope:         sqlcv1.V1ResourceLimitScope(request.Body.Scope),
+		WorkflowId:    request.Body.WorkflowId,
+		MetadataKey:   request.Body.MetadataKey,
+		MetadataValue: request.Body.MetadataValue,
+		Limit:         int32(request.Body.LimitValue), // nolint: gosec
+		Window:        window,
+	}
+
+	if request.Body.SoftLimitValue != nil {
+		softLimit := int32(*request.Body.SoftLimitValue) // nolint: gosec
+		opts.SoftLimit = &softLimit
+	}
+
+	if err := v1.ValidateScopedResourceLimitOpts(opts); err != nil {
+		return gen.ScopedResourceLimitUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if opts.WorkflowId != nil {
+		workflow, err := t.config.V1.Workflows().GetWorkflowById(ctx.Request().Context(), *opts.WorkflowId)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if err != nil || workflow.Workflow.TenantId != tenantId {
+			return gen.ScopedResourceLimitUpsert400JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+		}
+	}
+
+	limit, err := t.config.V1.TenantLimit().UpsertScopedLimit(ctx.Request().Context(), tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ScopedResourceLimitUpsert200JSONResponse(
+		*transformers.ToScopedResourceLimit(limit),
+	), nil
+}
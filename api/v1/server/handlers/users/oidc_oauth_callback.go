@@ -0,0 +1,293 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcOauthCallback(ctx echo.Context, _ gen.UserUpdateOidcOauthCallbackRequestObject) (gen.UserUpdateOidcOauthCallbackResponseObject, error) {
+	if u.config.Auth.OIDCProvider == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "OIDC login is not enabled.")
+	}
+
+	sessionHelpers := authn.NewSessionHelpers(u.config.SessionStore)
+
+	isValid, _, err := sessionHelpers.ValidateOAuthState(ctx, "oidc")
+
+	if err != nil || !isValid {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	nonce, err := sessionHelpers.PopOAuthNonce(ctx, "oidc")
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	oauthConfig, err := u.config.Auth.OIDCProvider.OAuth2Config(ctx.Request().Context())
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not reach the identity provider. Please try again later.")
+	}
+
+	token, err := oauthConfig.Exchange(context.Background(), ctx.Request().URL.Query().Get("code"))
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Forbidden")
+	}
+
+	if !token.Valid() {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, fmt.Errorf("invalid token"), "Forbidden")
+	}
+
+	user, claims, err := u.upsertOIDCUserFromToken(ctx.Request().Context(), u.config, token, nonce)
+
+	if err != nil {
+		if errors.Is(err, ErrNotInRestrictedDomain) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Email is not in the restricted domain group.")
+		}
+
+		if errors.Is(err, ErrOIDCNotVerified) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Please verify your email with your identity provider.")
+		}
+
+		if errors.Is(err, ErrOIDCNoEmail) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Your identity provider did not return an email.")
+		}
+
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	// failing to join the tenant shouldn't prevent the user from logging in, since they can still be invited
+	if err := u.joinOIDCTenant(ctx.Request().Context(), user, claims); err != nil {
+		u.config.Logger.Error().Err(err).Msgf("could not add user %s to the oidc auto join tenant", user.ID)
+	}
+
+	err = authn.NewSessionHelpers(u.config.SessionStore).SaveAuthenticated(ctx, user)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	analyticsCtx := context.WithValue(ctx.Request().Context(), analytics.UserIDKey, user.ID)
+	analyticsCtx = context.WithValue(analyticsCtx, analytics.SourceKey, analytics.SourceUI)
+	u.config.Analytics.Enqueue(
+		analyticsCtx,
+		analytics.User, analytics.Login,
+		user.ID.String(),
+		map[string]interface{}{"provider": "oidc"},
+	)
+
+	return gen.UserUpdateOidcOauthCallback302Response{
+		Headers: gen.UserUpdateOidcOauthCallback302ResponseHeaders{
+			Location: u.config.Runtime.ServerURL,
+		},
+	}, nil
+}
+
+var ErrOIDCNotVerified = fmt.Errorf("Please verify your email with your identity provider")
+var ErrOIDCNoEmail = fmt.Errorf("OIDC user must have an email")
+
+func (u *UserService) upsertOIDCUserFromToken(ctx context.Context, config *server.ServerConfig, tok *oauth2.Token, nonce string) (*sqlcv1.User, *oauth.OIDCClaims, error) {
+	claims, err := config.Auth.OIDCProvider.Claims(ctx, tok, nonce)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if claims.Email == "" {
+		return nil, nil, ErrOIDCNoEmail
+	}
+
+	if err := u.checkUserRestrictionsForEmail(config, claims.Email); err != nil {
+		return nil, nil, err
+	}
+
+	if !config.Auth.OIDCProvider.IsAllowedDomain(claims.Email) {
+		return nil, nil, ErrNotInRestrictedDomain
+	}
+
+	// if the provider isn't required to verify emails, the email is trusted as verified
+	emailVerified := claims.EmailVerified || !config.Auth.ConfigFile.OIDC.RequireEmailVerified
+
+	if !emailVerified {
+		return nil, nil, ErrOIDCNotVerified
+	}
+
+	expiresAt := tok.Expiry
+
+	// use the encryption service to encrypt the access and refresh token
+	accessTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.AccessToken), "oidc_access_token")
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt access token: %s", err.Error())
+	}
+
+	refreshTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.RefreshToken), "oidc_refresh_token")
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt refresh token: %s", err.Error())
+	}
+
+	oauthOpts := &v1.OAuthOpts{
+		Provider:       "oidc",
+		ProviderUserId: claims.Subject,
+		AccessToken:    accessTokenEncrypted,
+		RefreshToken:   refreshTokenEncrypted,
+		ExpiresAt:      &expiresAt,
+	}
+
+	user, err := u.getOIDCUser(ctx, claims)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user != nil {
+		user, err = u.config.V1.User().UpdateUser(ctx, user.ID, &v1.UpdateUserOpts{
+			EmailVerified: v1.BoolPtr(emailVerified),
+			Name:          v1.StringPtr(claims.Name),
+			OAuth:         oauthOpts,
+		})
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to update user: %s", err.Error())
+		}
+	} else {
+		user, err = u.config.V1.User().CreateUser(ctx, &v1.CreateUserOpts{
+			Email:         claims.Email,
+			EmailVerified: v1.BoolPtr(emailVerified),
+			Name:          v1.StringPtr(claims.Name),
+			OAuth:         oauthOpts,
+		})
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create user: %s", err.Error())
+		}
+	}
+
+	return user, claims, nil
+}
+
+// getOIDCUser returns the user who signs in with the OIDC account, or nil if there is none. Users are found by the
+// subject of the account they signed in with before. An existing user with the same email is only linked to the
+// account if the provider verified the email, since providers which don't require verification let anyone claim any
+// email.
+func (u *UserService) getOIDCUser(ctx context.Context, claims *oauth.OIDCClaims) (*sqlcv1.User, error) {
+	user, err := u.config.V1.User().GetUserByOAuthProviderUserId(ctx, "oidc", claims.Subject)
+
+	if err == nil {
+		return user, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+
+	user, err = u.config.V1.User().GetUserByEmail(ctx, claims.Email)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+
+	if !claims.EmailVerified {
+		return nil, ErrOIDCNotVerified
+	}
+
+	return user, nil
+}
+
+// joinOIDCTenant adds the user to the auto join tenant with the role mapped from their groups. Users who are
+// already members of the tenant keep their current role.
+func (u *UserService) joinOIDCTenant(ctx context.Context, user *sqlcv1.User, claims *oauth.OIDCClaims) error {
+	provider := u.config.Auth.OIDCProvider
+
+	if provider.AutoJoinTenantId() == nil {
+		return nil
+	}
+
+	tenantId := *provider.AutoJoinTenantId()
+
+	_, err := u.config.V1.Tenant().GetTenantMemberByUserID(ctx, tenantId, user.ID)
+
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get tenant member: %w", err)
+	}
+
+	roleName, ok := provider.TenantRole(claims.Groups)
+
+	if !ok {
+		return nil
+	}
+
+	role := roleName
+
+	var customRole *sqlcv1.TenantRole
+
+	// roles which aren't built-in refer to a custom role in the tenant, which is assigned on top of the MEMBER role
+	if role != string(sqlcv1.TenantMemberRoleOWNER) && role != string(sqlcv1.TenantMemberRoleADMIN) && role != string(sqlcv1.TenantMemberRoleMEMBER) {
+		roles, err := u.config.V1.TenantRole().ListTenantRoles(ctx, tenantId)
+
+		if err != nil {
+			return fmt.Errorf("failed to list tenant roles: %w", err)
+		}
+
+		for _, r := range roles {
+			if r.Name == roleName {
+				customRole = r
+				break
+			}
+		}
+
+		if customRole == nil {
+			return fmt.Errorf("role %s is not a built-in or custom role of the tenant", roleName)
+		}
+
+		role = string(sqlcv1.TenantMemberRoleMEMBER)
+	}
+
+	member, err := u.config.V1.Tenant().CreateTenantMember(ctx, tenantId, &v1.CreateTenantMemberOpts{
+		Role:   role,
+		UserId: user.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create tenant member: %w", err)
+	}
+
+	if customRole != nil {
+		_, err = u.config.V1.Tenant().UpdateTenantMember(ctx, member.ID, &v1.UpdateTenantMemberOpts{
+			Role:         v1.StringPtr(role),
+			CustomRoleId: &customRole.ID,
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to assign custom role: %w", err)
+		}
+	}
+
+	return nil
+}
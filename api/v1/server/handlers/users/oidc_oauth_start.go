@@ -0,0 +1,50 @@
+package users
+
+import (
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcOauthStart(ctx echo.Context, _ gen.UserUpdateOidcOauthStartRequestObject) (gen.UserUpdateOidcOauthStartResponseObject, error) {
+	if u.config.Auth.OIDCProvider == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "OIDC login is not enabled.")
+	}
+
+	if !u.config.Runtime.AllowSignup {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "User signup is disabled.")
+	}
+
+	oauthConfig, err := u.config.Auth.OIDCProvider.OAuth2Config(ctx.Request().Context())
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not reach the identity provider. Please try again later.")
+	}
+
+	sessionHelpers := authn.NewSessionHelpers(u.config.SessionStore)
+
+	state, err := sessionHelpers.SaveOAuthState(ctx, "oidc")
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	// the nonce is stored in the same session cookie as the state, and binds the ID token to this login
+	nonce, err := sessionHelpers.SaveOAuthNonce(ctx, "oidc")
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	url := oauthConfig.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+
+	return gen.UserUpdateOidcOauthStart302Response{
+		Headers: gen.UserUpdateOidcOauthStart302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
@@ -0,0 +1,31 @@
+package bulkoperationsv1
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkOperationsService) V1BulkOperationCancel(ctx echo.Context, request gen.V1BulkOperationCancelRequestObject) (gen.V1BulkOperationCancelResponseObject, error) {
+	op := ctx.Get("v1-bulk-operation").(*sqlcv1.V1BulkOperation)
+
+	cancelled, err := t.config.V1.BulkOperations().CancelBulkOperation(ctx.Request().Context(), op.ID)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return gen.V1BulkOperationCancel400JSONResponse(apierrors.NewAPIErrors("only pending or running bulk operations can be cancelled")), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1BulkOperationCancel200JSONResponse(
+		transformers.ToV1BulkOperation(cancelled),
+	), nil
+}
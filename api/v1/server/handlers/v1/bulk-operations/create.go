@@ -0,0 +1,100 @@
+package bulkoperationsv1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkOperationsService) V1BulkOperationCreate(ctx echo.Context, request gen.V1BulkOperationCreateRequestObject) (gen.V1BulkOperationCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.V1BulkOperationCreate400JSONResponse(*apiErrors), nil
+	}
+
+	if request.Body.Kind == gen.REPLAYEVENTS {
+		return gen.V1BulkOperationCreate400JSONResponse(apierrors.NewAPIErrors("events are replayed with the event replay endpoint")), nil
+	}
+
+	filter, err := toBulkOperationFilter(request.Body.Filter)
+
+	if err != nil {
+		return gen.V1BulkOperationCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// the filter is resolved by the tasks controller, which only applies the operation to a bounded number of runs,
+	// so filters which match more runs are rejected up front. listing one run past the maximum is enough to tell.
+	opts := filter.ToListWorkflowRunOpts()
+	opts.Limit = v1.MaxBulkOperationRuns + 1
+
+	externalIds, err := t.config.V1.OLAP().ListWorkflowRunExternalIds(reqCtx, tenant.ID, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	if len(externalIds) > v1.MaxBulkOperationRuns {
+		return gen.V1BulkOperationCreate400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf(
+			"more than %d runs match the filter, which is the maximum which a single operation can apply to. narrow the time range or filter and try again.",
+			v1.MaxBulkOperationRuns,
+		))), nil
+	}
+
+	op, err := t.config.V1.BulkOperations().CreateBulkOperation(reqCtx, tenant.ID, &v1.CreateBulkOperationOpts{
+		Kind:   sqlcv1.V1BulkOperationKind(request.Body.Kind),
+		Filter: filter,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1BulkOperationCreate200JSONResponse(
+		transformers.ToV1BulkOperation(op),
+	), nil
+}
+
+func toBulkOperationFilter(filter gen.V1TaskFilter) (*v1.BulkOperationFilter, error) {
+	res := &v1.BulkOperationFilter{
+		Since: filter.Since,
+		Until: filter.Until,
+	}
+
+	if filter.Statuses != nil {
+		for _, status := range *filter.Statuses {
+			res.Statuses = append(res.Statuses, sqlcv1.V1ReadableStatusOlap(status))
+		}
+	}
+
+	if filter.WorkflowIds != nil {
+		res.WorkflowIds = append(res.WorkflowIds, *filter.WorkflowIds...)
+	}
+
+	if filter.AdditionalMetadata != nil && len(*filter.AdditionalMetadata) > 0 {
+		res.AdditionalMetadata = make(map[string]string, len(*filter.AdditionalMetadata))
+
+		for _, v := range *filter.AdditionalMetadata {
+			kv := strings.SplitN(v, ":", 2)
+
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid additional metadata filter: %s", v)
+			}
+
+			res.AdditionalMetadata[kv[0]] = kv[1]
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,17 @@
+package bulkoperationsv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkOperationsService) V1BulkOperationGet(ctx echo.Context, request gen.V1BulkOperationGetRequestObject) (gen.V1BulkOperationGetResponseObject, error) {
+	op := ctx.Get("v1-bulk-operation").(*sqlcv1.V1BulkOperation)
+
+	return gen.V1BulkOperationGet200JSONResponse(
+		transformers.ToV1BulkOperation(op),
+	), nil
+}
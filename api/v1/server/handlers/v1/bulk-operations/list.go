@@ -0,0 +1,47 @@
+package bulkoperationsv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkOperationsService) V1BulkOperationList(ctx echo.Context, request gen.V1BulkOperationListRequestObject) (gen.V1BulkOperationListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	limit := 50
+	offset := 0
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+	}
+
+	if limit < 1 || limit > 100 {
+		return gen.V1BulkOperationList400JSONResponse(apierrors.NewAPIErrors("Limit must be between 1 and 100.")), nil
+	}
+
+	if offset < 0 {
+		return gen.V1BulkOperationList400JSONResponse(apierrors.NewAPIErrors("Offset must not be negative.")), nil
+	}
+
+	ops, count, err := t.config.V1.BulkOperations().ListBulkOperations(ctx.Request().Context(), tenant.ID, &v1.ListBulkOperationsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1BulkOperationList200JSONResponse(
+		transformers.ToV1BulkOperationList(ops, count, int64(limit), int64(offset)),
+	), nil
+}
@@ -0,0 +1,15 @@
+package bulkoperationsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1BulkOperationsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1BulkOperationsService(config *server.ServerConfig) *V1BulkOperationsService {
+	return &V1BulkOperationsService{
+		config: config,
+	}
+}
@@ -0,0 +1,23 @@
+package deadlettersv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1DeadLettersService) V1DeadLetterPolicyDelete(ctx echo.Context, request gen.V1DeadLetterPolicyDeleteRequestObject) (gen.V1DeadLetterPolicyDeleteResponseObject, error) {
+	policy := ctx.Get("v1-dead-letter-policy").(*sqlcv1.V1DeadLetterPolicy)
+
+	err := t.config.V1.DeadLetter().DeleteDeadLetterPolicy(ctx.Request().Context(), policy.TenantID, policy.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1DeadLetterPolicyDelete200JSONResponse(
+		transformers.ToV1DeadLetterPolicy(policy),
+	), nil
+}
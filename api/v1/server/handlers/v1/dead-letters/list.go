@@ -0,0 +1,23 @@
+package deadlettersv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1DeadLettersService) V1DeadLetterPolicyList(ctx echo.Context, request gen.V1DeadLetterPolicyListRequestObject) (gen.V1DeadLetterPolicyListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	policies, err := t.config.V1.DeadLetter().ListDeadLetterPolicies(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1DeadLetterPolicyList200JSONResponse(
+		transformers.ToV1DeadLetterPolicyList(policies),
+	), nil
+}
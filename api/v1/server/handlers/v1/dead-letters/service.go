@@ -0,0 +1,15 @@
+package deadlettersv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1DeadLettersService struct {
+	config *server.ServerConfig
+}
+
+func NewV1DeadLettersService(config *server.ServerConfig) *V1DeadLettersService {
+	return &V1DeadLettersService{
+		config: config,
+	}
+}
@@ -0,0 +1,57 @@
+package deadlettersv1
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1DeadLettersService) V1DeadLetterPolicyUpsert(ctx echo.Context, request gen.V1DeadLetterPolicyUpsertRequestObject) (gen.V1DeadLetterPolicyUpsertResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.V1DeadLetterPolicyUpsert400JSONResponse(*apiErrors), nil
+	}
+
+	if request.Body.SourceWorkflowId != nil {
+		workflow, err := t.config.V1.Workflows().GetWorkflowById(ctx.Request().Context(), *request.Body.SourceWorkflowId)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if err != nil || workflow.Workflow.TenantId != tenant.ID {
+			return gen.V1DeadLetterPolicyUpsert400JSONResponse(apierrors.NewAPIErrors("source workflow not found")), nil
+		}
+
+		if workflow.Workflow.Name == request.Body.DeadLetterWorkflowName {
+			return gen.V1DeadLetterPolicyUpsert400JSONResponse(apierrors.NewAPIErrors("a workflow can't be its own dead-letter workflow")), nil
+		}
+	}
+
+	policy, err := t.config.V1.DeadLetter().UpsertDeadLetterPolicy(ctx.Request().Context(), tenant.ID, &v1.UpsertDeadLetterPolicyOpts{
+		SourceWorkflowId:       request.Body.SourceWorkflowId,
+		DeadLetterWorkflowName: request.Body.DeadLetterWorkflowName,
+	})
+
+	if errors.Is(err, v1.ErrDeadLetterCycle) {
+		return gen.V1DeadLetterPolicyUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1DeadLetterPolicyUpsert200JSONResponse(
+		transformers.ToV1DeadLetterPolicy(policy),
+	), nil
+}
@@ -92,6 +92,7 @@ func toGenWaitData(raw []byte) *gen.V1WaitData {
 			SleepDurationMs: c.SleepDurationMs,
 			EventKey:        c.EventKey,
 			WorkflowName:    c.WorkflowName,
+			SignalName:      c.SignalName,
 		})
 	}
 
@@ -104,6 +105,7 @@ func toGenWaitData(raw []byte) *gen.V1WaitData {
 				SleepDurationMs: g.Conditions[0].SleepDurationMs,
 				EventKey:        g.Conditions[0].EventKey,
 				WorkflowName:    g.Conditions[0].WorkflowName,
+				SignalName:      g.Conditions[0].SignalName,
 			})
 			continue
 		}
@@ -115,6 +117,7 @@ func toGenWaitData(raw []byte) *gen.V1WaitData {
 				SleepDurationMs: c.SleepDurationMs,
 				EventKey:        c.EventKey,
 				WorkflowName:    c.WorkflowName,
+				SignalName:      c.SignalName,
 			})
 		}
 		items = append(items, gen.V1WaitItem{Or: &genConds})
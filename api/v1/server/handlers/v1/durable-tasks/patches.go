@@ -0,0 +1,64 @@
+package durabletasks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *DurableTasksService) V1DurableTaskPatchList(ctx echo.Context, request gen.V1DurableTaskPatchListRequestObject) (gen.V1DurableTaskPatchListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	limit := int64(1000)
+	offset := int64(0)
+
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	if request.Params.PatchId == "" {
+		return gen.V1DurableTaskPatchList400JSONResponse(apierrors.NewAPIErrors("patchId is required")), nil
+	}
+
+	if limit < 1 || offset < 0 {
+		return gen.V1DurableTaskPatchList400JSONResponse(apierrors.NewAPIErrors("limit must be positive and offset must not be negative")), nil
+	}
+
+	rows, err := t.config.V1.DurableEvents().ListInFlightDurableTasksWithPatch(ctx.Request().Context(), repository.ListInFlightDurableTasksWithPatchOpts{
+		TenantId: tenant.ID,
+		PatchId:  request.Params.PatchId,
+		Limit:    limit,
+		Offset:   offset,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1DurableTaskPatchList200JSONResponse(toDurableTaskPatchMarkers(rows)), nil
+}
+
+func toDurableTaskPatchMarkers(rows []*sqlcv1.ListInFlightDurableTasksWithPatchRow) []gen.V1DurableTaskPatchMarker {
+	result := make([]gen.V1DurableTaskPatchMarker, 0, len(rows))
+
+	for _, row := range rows {
+		result = append(result, gen.V1DurableTaskPatchMarker{
+			TaskExternalId:        row.DurableTaskExternalID,
+			TaskDisplayName:       row.DurableTaskDisplayName,
+			WorkflowId:            row.WorkflowID,
+			WorkflowRunExternalId: row.WorkflowRunID,
+			NodeId:                row.NodeID,
+			BranchId:              row.BranchID,
+			RecordedAt:            row.InsertedAt.Time,
+		})
+	}
+
+	return result
+}
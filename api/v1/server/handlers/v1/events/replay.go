@@ -0,0 +1,147 @@
+package eventsv1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const (
+	// maxReplayedEvents is the maximum number of events which can be replayed by a single request
+	maxReplayedEvents = 10000
+
+	// defaultReplayEventsPerSecond is the rate at which events are replayed if the request doesn't set one
+	defaultReplayEventsPerSecond = 100
+)
+
+func (t *V1EventsService) V1EventReplay(ctx echo.Context, request gen.V1EventReplayRequestObject) (gen.V1EventReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	reqCtx := ctx.Request().Context()
+
+	filter, err := toEventReplayFilter(request.Body)
+
+	if err != nil {
+		return gen.V1EventReplay400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if filter.EventsPerSecond < 1 || filter.EventsPerSecond > 1000 {
+		return gen.V1EventReplay400JSONResponse(apierrors.NewAPIErrors("eventsPerSecond must be between 1 and 1000")), nil
+	}
+
+	opts, err := filter.ToListEventsParams(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the count is returned alongside the first page, so there's no need to read more than a single event here
+	opts.Limit = pgtype.Int8{Int64: 1, Valid: true}
+
+	_, maybeTotal, err := t.config.V1.OLAP().ListEvents(reqCtx, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	var matched int64
+
+	if maybeTotal != nil {
+		matched = *maybeTotal
+	}
+
+	dryRun := request.Body.DryRun != nil && *request.Body.DryRun
+
+	if dryRun || matched == 0 {
+		return gen.V1EventReplay200JSONResponse(gen.V1ReplayEventsResponse{
+			MatchedCount: matched,
+			DryRun:       dryRun,
+		}), nil
+	}
+
+	if matched > maxReplayedEvents {
+		return gen.V1EventReplay400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf(
+			"%d events match the filter, which is more than the maximum of %d which can be replayed at once. narrow the time range or filter and try again.",
+			matched,
+			maxReplayedEvents,
+		))), nil
+	}
+
+	// the events are replayed by the tasks controller, so the replay doesn't depend on the request staying open
+	op, err := t.config.V1.BulkOperations().CreateBulkOperation(reqCtx, tenant.ID, &v1.CreateBulkOperationOpts{
+		Kind:        sqlcv1.V1BulkOperationKindREPLAYEVENTS,
+		EventFilter: filter,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event replay operation: %w", err)
+	}
+
+	operation := transformers.ToV1BulkOperation(op)
+
+	return gen.V1EventReplay200JSONResponse(gen.V1ReplayEventsResponse{
+		MatchedCount: matched,
+		DryRun:       false,
+		Operation:    &operation,
+	}), nil
+}
+
+func toEventReplayFilter(body *gen.V1ReplayEventsRequest) (*v1.EventReplayFilter, error) {
+	until := time.Now().UTC()
+
+	// replayed events are seen after the time of the request, so bounding the range by the time of the request
+	// ensures that they're never replayed again by the same operation
+	if body.Until != nil && body.Until.Before(until) {
+		until = *body.Until
+	}
+
+	if !body.Since.Before(until) {
+		return nil, fmt.Errorf("since must be before until")
+	}
+
+	filter := &v1.EventReplayFilter{
+		Since:           body.Since,
+		Until:           until,
+		EventsPerSecond: defaultReplayEventsPerSecond,
+	}
+
+	if body.EventsPerSecond != nil {
+		filter.EventsPerSecond = *body.EventsPerSecond
+	}
+
+	if body.KeyPattern != nil {
+		if *body.KeyPattern == "" {
+			return nil, fmt.Errorf("keyPattern must not be empty")
+		}
+
+		filter.KeyPattern = body.KeyPattern
+	}
+
+	if body.Scopes != nil {
+		filter.Scopes = *body.Scopes
+	}
+
+	if body.AdditionalMetadata != nil && len(*body.AdditionalMetadata) > 0 {
+		filter.AdditionalMetadata = make(map[string]string, len(*body.AdditionalMetadata))
+
+		for _, m := range *body.AdditionalMetadata {
+			split := strings.SplitN(m, ":", 2)
+
+			if len(split) != 2 || split[0] == "" || split[1] == "" {
+				return nil, fmt.Errorf("invalid additional metadata format: %s, expected key:value", m)
+			}
+
+			filter.AdditionalMetadata[split[0]] = split[1]
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TasksService) V1TaskQuery(ctx echo.Context, request gen.V1TaskQueryRequestObject) (gen.V1TaskQueryResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	grpcReq := &contracts.QueryDurableTaskRequest{
+		DurableTaskExternalId: request.Task.String(),
+		QueryName:             request.Body.Name,
+	}
+
+	if request.Body.Input != nil {
+		input, err := json.Marshal(request.Body.Input)
+		if err != nil {
+			return gen.V1TaskQuery400JSONResponse(apierrors.NewAPIErrors("invalid input")), nil
+		}
+
+		grpcReq.Input = input
+	}
+
+	resp, err := t.proxyQuery.Do(ctx.Request().Context(), tenant, grpcReq)
+
+	if err != nil {
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.NotFound:
+				return gen.V1TaskQuery404JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			case codes.InvalidArgument, codes.FailedPrecondition, codes.DeadlineExceeded:
+				return gen.V1TaskQuery400JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			}
+		}
+
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+
+	if len(resp.Payload) > 0 {
+		if err := json.Unmarshal(resp.Payload, &result); err != nil {
+			return nil, fmt.Errorf("query result is not a JSON object: %w", err)
+		}
+	}
+
+	return gen.V1TaskQuery200JSONResponse{
+		Result: result,
+	}, nil
+}
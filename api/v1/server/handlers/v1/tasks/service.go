@@ -14,6 +14,8 @@ type TasksService struct {
 	config      *server.ServerConfig
 	proxyCancel *proxy.Proxy[admincontracts.CancelTasksRequest, admincontracts.CancelTasksResponse]
 	proxyReplay *proxy.Proxy[admincontracts.ReplayTasksRequest, admincontracts.ReplayTasksResponse]
+	proxySignal *proxy.Proxy[admincontracts.SendDurableTaskSignalRequest, admincontracts.SendDurableTaskSignalResponse]
+	proxyQuery  *proxy.Proxy[admincontracts.QueryDurableTaskRequest, admincontracts.QueryDurableTaskResponse]
 }
 
 func NewTasksService(config *server.ServerConfig) *TasksService {
@@ -25,9 +27,19 @@ func NewTasksService(config *server.ServerConfig) *TasksService {
 		return cli.Admin().ReplayTasks(ctx, in)
 	})
 
+	proxySignal := proxy.NewProxy(config, func(ctx context.Context, cli *client.GRPCClient, in *admincontracts.SendDurableTaskSignalRequest) (*admincontracts.SendDurableTaskSignalResponse, error) {
+		return cli.Dispatcher().SendDurableTaskSignal(ctx, in)
+	})
+
+	proxyQuery := proxy.NewProxy(config, func(ctx context.Context, cli *client.GRPCClient, in *admincontracts.QueryDurableTaskRequest) (*admincontracts.QueryDurableTaskResponse, error) {
+		return cli.Dispatcher().QueryDurableTask(ctx, in)
+	})
+
 	return &TasksService{
 		config:      config,
 		proxyCancel: proxyCancel,
 		proxyReplay: proxyReplay,
+		proxySignal: proxySignal,
+		proxyQuery:  proxyQuery,
 	}
 }
@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *TasksService) V1TaskSignal(ctx echo.Context, request gen.V1TaskSignalRequestObject) (gen.V1TaskSignalResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	grpcReq := &contracts.SendDurableTaskSignalRequest{
+		DurableTaskExternalId: request.Task.String(),
+		SignalName:            request.Body.Name,
+	}
+
+	if request.Body.Payload != nil {
+		payload, err := json.Marshal(request.Body.Payload)
+		if err != nil {
+			return gen.V1TaskSignal400JSONResponse(apierrors.NewAPIErrors("invalid payload")), nil
+		}
+
+		grpcReq.Payload = payload
+	}
+
+	resp, err := t.proxySignal.Do(ctx.Request().Context(), tenant, grpcReq)
+
+	if err != nil {
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.NotFound:
+				return gen.V1TaskSignal404JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				return gen.V1TaskSignal400JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			}
+		}
+
+		return nil, err
+	}
+
+	return gen.V1TaskSignal200JSONResponse{
+		Delivered: resp.Delivered,
+	}, nil
+}
@@ -185,6 +185,13 @@ func (w *V1WebhooksService) constructCreateOpts(tenantId uuid.UUID, request gen.
 			Type: sqlcv1.V1IncomingWebhookAuthTypeHMAC,
 		}
 
+		// for ED25519, the signing secret is the public key which signatures are verified against
+		if hmacAuth.Auth.Algorithm == gen.ED25519 {
+			if _, err := parseEd25519PublicKey(hmacAuth.Auth.SigningSecret); err != nil {
+				return params, err
+			}
+		}
+
 		signingSecretEncrypted, err := w.config.Encryption.Encrypt([]byte(hmacAuth.Auth.SigningSecret), "v1_webhook_hmac_signing_secret")
 
 		if err != nil {
@@ -215,9 +222,36 @@ func (w *V1WebhooksService) constructCreateOpts(tenantId uuid.UUID, request gen.
 		return params, fmt.Errorf("unsupported auth type: %s", discriminator)
 	}
 
+	if err := validateSourceAuth(params.Sourcename, params.AuthConfig); err != nil {
+		return params, err
+	}
+
 	return params, nil
 }
 
+// validateSourceAuth checks that the auth config can be used to verify requests from the source, for sources
+// which don't support every auth type.
+func validateSourceAuth(sourceName sqlcv1.V1IncomingWebhookSourceName, authConfig v1.AuthConfig) error {
+	isEd25519 := authConfig.HMACAuth != nil && authConfig.HMACAuth.Algorithm == sqlcv1.V1IncomingWebhookHmacAlgorithmED25519
+
+	switch sourceName {
+	case sqlcv1.V1IncomingWebhookSourceNameDISCORD:
+		if !isEd25519 {
+			return fmt.Errorf("discord webhooks must use HMAC auth with the ED25519 algorithm")
+		}
+	case sqlcv1.V1IncomingWebhookSourceNameTWILIO:
+		if authConfig.Type != sqlcv1.V1IncomingWebhookAuthTypeHMAC {
+			return fmt.Errorf("twilio webhooks must use HMAC auth")
+		}
+	default:
+		if isEd25519 {
+			return fmt.Errorf("the ED25519 algorithm is only supported for discord webhooks")
+		}
+	}
+
+	return nil
+}
+
 func returnEventResponsePayloadOrDefault(requestReturnEventAsResponsePayload *bool) bool {
 	if requestReturnEventAsResponsePayload == nil {
 		return true
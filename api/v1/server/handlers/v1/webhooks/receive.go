@@ -1,6 +1,7 @@
 package webhooksv1
 
 import (
+	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/md5"
 	"crypto/sha1"
@@ -15,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -81,12 +83,7 @@ func (w *V1WebhooksService) V1WebhookReceive(ctx echo.Context, request gen.V1Web
 	}
 
 	if isChallenge {
-		res, err := transformers.ToV1WebhookResponse(nil, challengeResponse, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transform response: %w", err)
-		}
-
-		return gen.V1WebhookReceive200JSONResponse(*res), nil
+		return gen.V1WebhookReceive200JSONResponse(*challengeResponse), nil
 	}
 
 	ok, validationError := w.validateWebhook(rawBody, *webhook, *ctx.Request())
@@ -161,8 +158,8 @@ func (w *V1WebhooksService) V1WebhookReceive(ctx echo.Context, request gen.V1Web
 						}
 					}
 				}
-			case sqlcv1.V1IncomingWebhookSourceNameGENERIC:
-				/* For GENERIC webhooks, convert all form fields to the payload map */
+			case sqlcv1.V1IncomingWebhookSourceNameGENERIC, sqlcv1.V1IncomingWebhookSourceNameTWILIO:
+				/* For GENERIC and TWILIO webhooks, convert all form fields to the payload map */
 				for key, values := range formData {
 					if len(values) > 0 {
 						payloadMap[key] = values[0]
@@ -327,6 +324,17 @@ func (w *V1WebhooksService) V1WebhookReceive(ctx echo.Context, request gen.V1Web
 		return nil, fmt.Errorf("failed to ingest event")
 	}
 
+	if webhook.SourceName == sqlcv1.V1IncomingWebhookSourceNameDISCORD {
+		/* Discord interactions fail unless they're responded to within 3 seconds, so they're deferred and the
+		 * triggered workflow responds using the interaction token from the payload.
+		 */
+		if responseType, ok := discordDeferredResponseType(payloadMap); ok {
+			return gen.V1WebhookReceive200JSONResponse(gen.V1WebhookResponse{
+				Type: &responseType,
+			}), nil
+		}
+	}
+
 	if !webhook.ReturnEventAsResponsePayload {
 		return gen.V1WebhookReceive204Response{}, nil
 	}
@@ -386,6 +394,14 @@ func (vr ValidationError) ToResponse() (gen.V1WebhookReceiveResponseObject, erro
 				},
 			},
 		}, nil
+	case http.StatusUnauthorized:
+		return gen.V1WebhookReceive401JSONResponse{
+			Errors: []gen.APIError{
+				{
+					Description: vr.ErrorText,
+				},
+			},
+		}, nil
 	case http.StatusForbidden:
 		return gen.V1WebhookReceive403JSONResponse{
 			Errors: []gen.APIError{
@@ -404,7 +420,7 @@ func (vr ValidationError) ToResponse() (gen.V1WebhookReceiveResponseObject, erro
 type IsValid bool
 type IsChallenge bool
 
-func (w *V1WebhooksService) performChallenge(webhookPayload []byte, webhook sqlcv1.V1IncomingWebhook, request http.Request) (IsChallenge, *string, error) {
+func (w *V1WebhooksService) performChallenge(webhookPayload []byte, webhook sqlcv1.V1IncomingWebhook, request http.Request) (IsChallenge, *gen.V1WebhookResponse, error) {
 	switch webhook.SourceName {
 	case sqlcv1.V1IncomingWebhookSourceNameSLACK:
 		/* Slack Events API URL verification challenges come as application/json with direct JSON payload
@@ -419,7 +435,41 @@ func (w *V1WebhooksService) performChallenge(webhookPayload []byte, webhook sqlc
 		}
 
 		if challenge, ok := payload["challenge"].(string); ok && challenge != "" {
-			return true, repository.StringPtr(challenge), nil
+			res, err := transformers.ToV1WebhookResponse(nil, repository.StringPtr(challenge), nil)
+
+			if err != nil {
+				return false, nil, fmt.Errorf("failed to transform response: %w", err)
+			}
+
+			return true, res, nil
+		}
+
+		return false, nil, nil
+	case sqlcv1.V1IncomingWebhookSourceNameDISCORD:
+		/* Discord sends a PING interaction when the interactions endpoint URL is saved, and expects a PONG in response.
+		 * Unlike Slack challenges, Discord also sends PINGs with invalid signatures and expects them to be rejected,
+		 * so PINGs are only answered once the signature is verified.
+		 * See: https://discord.com/developers/docs/interactions/overview#setting-up-an-endpoint
+		 */
+		if ok, _ := w.validateDiscordWebhook(webhookPayload, webhook, request); !ok {
+			/* The request is rejected by the regular validation */
+			return false, nil, nil
+		}
+
+		interaction := struct {
+			Type int `json:"type"`
+		}{}
+
+		if err := json.Unmarshal(webhookPayload, &interaction); err != nil {
+			return false, nil, nil
+		}
+
+		if interaction.Type == discordInteractionTypePing {
+			pong := discordResponseTypePong
+
+			return true, &gen.V1WebhookResponse{
+				Type: &pong,
+			}, nil
 		}
 
 		return false, nil, nil
@@ -439,10 +489,20 @@ func (w *V1WebhooksService) validateWebhook(webhookPayload []byte, webhook sqlcv
 		return w.validateStripeWebhook(webhookPayload, webhook, request)
 	case sqlcv1.V1IncomingWebhookSourceNameSVIX:
 		return w.validateSvixWebhook(webhookPayload, webhook, request)
+	case sqlcv1.V1IncomingWebhookSourceNameTWILIO:
+		return w.validateTwilioWebhook(webhookPayload, webhook, request)
+	case sqlcv1.V1IncomingWebhookSourceNameDISCORD:
+		return w.validateDiscordWebhook(webhookPayload, webhook, request)
 	case sqlcv1.V1IncomingWebhookSourceNameGITHUB:
 		fallthrough
 	case sqlcv1.V1IncomingWebhookSourceNameLINEAR:
 		fallthrough
+	case sqlcv1.V1IncomingWebhookSourceNameGITLAB:
+		fallthrough
+	case sqlcv1.V1IncomingWebhookSourceNameBITBUCKET:
+		fallthrough
+	case sqlcv1.V1IncomingWebhookSourceNameSHOPIFY:
+		fallthrough
 	case sqlcv1.V1IncomingWebhookSourceNameGENERIC:
 		switch webhook.AuthMethod {
 		case sqlcv1.V1IncomingWebhookAuthTypeBASIC:
@@ -805,6 +865,235 @@ func (w *V1WebhooksService) validateSvixWebhook(webhookPayload []byte, webhook s
 	}
 }
 
+func (w *V1WebhooksService) validateTwilioWebhook(webhookPayload []byte, webhook sqlcv1.V1IncomingWebhook, request http.Request) (
+	IsValid,
+	*ValidationError,
+) {
+	signatureHeader := request.Header.Get(webhook.AuthHmacSignatureHeaderName.String)
+
+	if signatureHeader == "" {
+		return false, &ValidationError{
+			Code:      http.StatusForbidden,
+			ErrorText: fmt.Sprintf("missing or invalid signature header: %s", webhook.AuthHmacSignatureHeaderName.String),
+		}
+	}
+
+	decryptedSigningSecret, err := w.config.Encryption.Decrypt(webhook.AuthHmacWebhookSigningSecret, "v1_webhook_hmac_signing_secret")
+
+	if err != nil {
+		return false, &ValidationError{
+			Code:      http.StatusInternalServerError,
+			ErrorText: fmt.Sprintf("failed to decrypt Twilio auth token: %s", err),
+		}
+	}
+
+	/* Twilio signs the public url which it sent the request to, so it's reconstructed from the server url
+	 * rather than the host of the request, which may have been rewritten by a proxy.
+	 */
+	requestURL := strings.TrimSuffix(w.config.Runtime.ServerURL, "/") + request.URL.RequestURI()
+
+	signedPayload, err := twilioSignedPayload(requestURL, webhookPayload, request.Header.Get("Content-Type"))
+
+	if err != nil {
+		return false, &ValidationError{
+			Code:      http.StatusForbidden,
+			ErrorText: err.Error(),
+		}
+	}
+
+	algorithm := webhook.AuthHmacAlgorithm.V1IncomingWebhookHmacAlgorithm
+	encoding := webhook.AuthHmacEncoding.V1IncomingWebhookHmacEncoding
+
+	expectedSignature, err := computeHMACSignature([]byte(signedPayload), decryptedSigningSecret, algorithm, encoding)
+
+	if err != nil {
+		return false, &ValidationError{
+			Code:      http.StatusInternalServerError,
+			ErrorText: fmt.Sprintf("failed to compute HMAC signature: %s", err),
+		}
+	}
+
+	if !signaturesMatch(signatureHeader, expectedSignature) {
+		return false, &ValidationError{
+			Code:      http.StatusForbidden,
+			ErrorText: "invalid Twilio signature",
+		}
+	}
+
+	return true, nil
+}
+
+/* twilioSignedPayload returns the string which Twilio signs. For form-encoded requests, this is the request url
+ * followed by every POST parameter name and value, sorted by name. JSON requests are signed without the body,
+ * and instead contain a hex-encoded SHA256 of the body in the bodySHA256 query parameter, which is checked here.
+ * See: https://www.twilio.com/docs/usage/webhooks/webhooks-security#validating-signatures-from-twilio
+ */
+func twilioSignedPayload(requestURL string, webhookPayload []byte, contentType string) (string, error) {
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		formData, err := url.ParseQuery(string(webhookPayload))
+
+		if err != nil {
+			return "", fmt.Errorf("failed to parse form data")
+		}
+
+		keys := make([]string, 0, len(formData))
+
+		for key := range formData {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		var sb strings.Builder
+
+		sb.WriteString(requestURL)
+
+		for _, key := range keys {
+			values := formData[key]
+			sort.Strings(values)
+
+			for _, value := range values {
+				sb.WriteString(key)
+				sb.WriteString(value)
+			}
+		}
+
+		return sb.String(), nil
+	}
+
+	parsedURL, err := url.Parse(requestURL)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid request url")
+	}
+
+	bodyHash := parsedURL.Query().Get("bodySHA256")
+
+	if bodyHash == "" {
+		return "", fmt.Errorf("missing bodySHA256 query parameter")
+	}
+
+	expectedBodyHash := sha256.Sum256(webhookPayload)
+
+	if !hmac.Equal([]byte(strings.ToLower(bodyHash)), []byte(hex.EncodeToString(expectedBodyHash[:]))) {
+		return "", fmt.Errorf("bodySHA256 query parameter does not match the request body")
+	}
+
+	return requestURL, nil
+}
+
+const (
+	discordTimestampHeader = "X-Signature-Timestamp"
+
+	// See: https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object-interaction-type
+	discordInteractionTypePing               = 1
+	discordInteractionTypeApplicationCommand = 2
+	discordInteractionTypeMessageComponent   = 3
+	discordInteractionTypeModalSubmit        = 5
+
+	// See: https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-response-object-interaction-callback-type
+	discordResponseTypePong                   = 1
+	discordResponseTypeDeferredChannelMessage = 5
+	discordResponseTypeDeferredUpdateMessage  = 6
+)
+
+func (w *V1WebhooksService) validateDiscordWebhook(webhookPayload []byte, webhook sqlcv1.V1IncomingWebhook, request http.Request) (
+	IsValid,
+	*ValidationError,
+) {
+	/* Discord expects interactions with invalid signatures to be rejected with a 401 */
+	signatureHeader := request.Header.Get(webhook.AuthHmacSignatureHeaderName.String)
+	timestampHeader := request.Header.Get(discordTimestampHeader)
+
+	if signatureHeader == "" || timestampHeader == "" {
+		return false, &ValidationError{
+			Code:      http.StatusUnauthorized,
+			ErrorText: fmt.Sprintf("missing or invalid signature headers: %s, %s", webhook.AuthHmacSignatureHeaderName.String, discordTimestampHeader),
+		}
+	}
+
+	timestamp, err := strconv.ParseInt(strings.TrimSpace(timestampHeader), 10, 64)
+
+	if err != nil || time.Unix(timestamp, 0).UTC().Before(time.Now().Add(-5*time.Minute)) {
+		return false, &ValidationError{
+			Code:      http.StatusUnauthorized,
+			ErrorText: "timestamp in header is invalid or out of range",
+		}
+	}
+
+	decryptedPublicKey, err := w.config.Encryption.Decrypt(webhook.AuthHmacWebhookSigningSecret, "v1_webhook_hmac_signing_secret")
+
+	if err != nil {
+		return false, &ValidationError{
+			Code:      http.StatusInternalServerError,
+			ErrorText: fmt.Sprintf("failed to decrypt Discord public key: %s", err),
+		}
+	}
+
+	publicKey, err := parseEd25519PublicKey(string(decryptedPublicKey))
+
+	if err != nil {
+		return false, &ValidationError{
+			Code:      http.StatusInternalServerError,
+			ErrorText: err.Error(),
+		}
+	}
+
+	if !verifyEd25519Signature(publicKey, timestampHeader, webhookPayload, signatureHeader) {
+		return false, &ValidationError{
+			Code:      http.StatusUnauthorized,
+			ErrorText: "invalid Ed25519 signature",
+		}
+	}
+
+	return true, nil
+}
+
+// parseEd25519PublicKey parses a hex-encoded Ed25519 public key, as shown in the Discord developer portal.
+func parseEd25519PublicKey(key string) (ed25519.PublicKey, error) {
+	publicKey, err := hex.DecodeString(strings.TrimSpace(key))
+
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key must be a hex-encoded Ed25519 public key")
+	}
+
+	return ed25519.PublicKey(publicKey), nil
+}
+
+// verifyEd25519Signature verifies a hex-encoded Ed25519 signature of the timestamp followed by the body.
+func verifyEd25519Signature(publicKey ed25519.PublicKey, timestamp string, webhookPayload []byte, signature string) bool {
+	sig, err := hex.DecodeString(strings.TrimSpace(signature))
+
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return false
+	}
+
+	message := make([]byte, 0, len(timestamp)+len(webhookPayload))
+	message = append(message, timestamp...)
+	message = append(message, webhookPayload...)
+
+	return ed25519.Verify(publicKey, message, sig)
+}
+
+// discordDeferredResponseType returns the deferred response type for interactions which need a response
+// from the workflow they trigger.
+func discordDeferredResponseType(payload map[string]interface{}) (int, bool) {
+	interactionType, ok := payload["type"].(float64)
+
+	if !ok {
+		return 0, false
+	}
+
+	switch int(interactionType) {
+	case discordInteractionTypeApplicationCommand, discordInteractionTypeModalSubmit:
+		return discordResponseTypeDeferredChannelMessage, true
+	case discordInteractionTypeMessageComponent:
+		return discordResponseTypeDeferredUpdateMessage, true
+	default:
+		return 0, false
+	}
+}
+
 func signaturesMatch(providedSignature, expectedSignature string) bool {
 	providedSignature = strings.TrimSpace(providedSignature)
 	expectedSignature = strings.TrimSpace(expectedSignature)
@@ -0,0 +1,81 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package webhooksv1
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestTwilioSignedPayload(t *testing.T) {
+	// example from https://www.twilio.com/docs/usage/webhooks/webhooks-security
+	requestURL := "https://mycompany.com/myapp.php?foo=1&bar=2"
+	body := []byte("CallSid=CA1234567890ABCDE&Caller=%2B12349013030&Digits=1234&From=%2B12349013030&To=%2B18005551212")
+
+	signed, err := twilioSignedPayload(requestURL, body, "application/x-www-form-urlencoded")
+	require.NoError(t, err)
+	assert.Equal(t, requestURL+"CallSidCA1234567890ABCDECaller+12349013030Digits1234From+12349013030To+18005551212", signed)
+
+	sig, err := computeHMACSignature([]byte(signed), []byte("12345"), sqlcv1.V1IncomingWebhookHmacAlgorithmSHA1, sqlcv1.V1IncomingWebhookHmacEncodingBASE64)
+	require.NoError(t, err)
+	assert.Equal(t, "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", sig)
+}
+
+func TestTwilioSignedPayloadJSON(t *testing.T) {
+	body := []byte(`{"CallSid":"CA1234567890ABCDE"}`)
+	bodyHash := sha256.Sum256(body)
+
+	requestURL := "https://mycompany.com/webhooks/twilio?bodySHA256=" + hex.EncodeToString(bodyHash[:])
+
+	signed, err := twilioSignedPayload(requestURL, body, "application/json")
+	require.NoError(t, err)
+	assert.Equal(t, requestURL, signed)
+
+	_, err = twilioSignedPayload(requestURL, []byte(`{"CallSid":"tampered"}`), "application/json")
+	assert.Error(t, err)
+
+	_, err = twilioSignedPayload("https://mycompany.com/webhooks/twilio", body, "application/json")
+	assert.Error(t, err)
+}
+
+func TestVerifyEd25519Signature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	parsed, err := parseEd25519PublicKey(hex.EncodeToString(publicKey))
+	require.NoError(t, err)
+
+	body := []byte(`{"type":1}`)
+	timestamp := "1700000000"
+	signature := hex.EncodeToString(ed25519.Sign(privateKey, append([]byte(timestamp), body...)))
+
+	assert.True(t, verifyEd25519Signature(parsed, timestamp, body, signature))
+	assert.False(t, verifyEd25519Signature(parsed, "1700000001", body, signature))
+	assert.False(t, verifyEd25519Signature(parsed, timestamp, []byte(`{"type":2}`), signature))
+	assert.False(t, verifyEd25519Signature(parsed, timestamp, body, "not-hex"))
+
+	_, err = parseEd25519PublicKey("abcd")
+	assert.Error(t, err)
+}
+
+func TestDiscordDeferredResponseType(t *testing.T) {
+	responseType, ok := discordDeferredResponseType(map[string]interface{}{"type": float64(2)})
+	assert.True(t, ok)
+	assert.Equal(t, discordResponseTypeDeferredChannelMessage, responseType)
+
+	responseType, ok = discordDeferredResponseType(map[string]interface{}{"type": float64(3)})
+	assert.True(t, ok)
+	assert.Equal(t, discordResponseTypeDeferredUpdateMessage, responseType)
+
+	// autocomplete interactions can't be deferred
+	_, ok = discordDeferredResponseType(map[string]interface{}{"type": float64(4)})
+	assert.False(t, ok)
+}
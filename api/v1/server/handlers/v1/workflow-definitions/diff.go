@@ -0,0 +1,64 @@
+package workflowdefinitionsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowDefinitionsService) V1WorkflowDefinitionDiff(ctx echo.Context, request gen.V1WorkflowDefinitionDiffRequestObject) (gen.V1WorkflowDefinitionDiffResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	workflow, err := t.config.V1.Workflows().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Params.Name)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.V1WorkflowDefinitionDiff404JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+		}
+
+		return nil, err
+	}
+
+	// an empty target version compares against the latest version
+	toVersion := ""
+
+	if request.Params.To != nil {
+		toVersion = *request.Params.To
+	}
+
+	versions := []string{request.Params.From, toVersion}
+	defs := make([]*v1.WorkflowDefinition, len(versions))
+
+	for i, version := range versions {
+		defs[i], err = t.config.V1.Workflows().GetWorkflowDefinition(ctx.Request().Context(), tenant.ID, workflow.ID, version)
+
+		if err != nil {
+			switch {
+			case errors.Is(err, pgx.ErrNoRows):
+				return gen.V1WorkflowDefinitionDiff404JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("workflow version %q not found", version))), nil
+			case errors.Is(err, v1.ErrWorkflowDefinitionNotStored), errors.Is(err, v1.ErrWorkflowDefinitionNotRepresentable):
+				return gen.V1WorkflowDefinitionDiff400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+			}
+
+			return nil, err
+		}
+	}
+
+	changes, err := v1.DiffWorkflowDefinitions(defs[0], defs[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowDefinitionDiff200JSONResponse(
+		transformers.ToV1WorkflowDefinitionDiff(defs[0], defs[1], changes),
+	), nil
+}
@@ -0,0 +1,51 @@
+package workflowdefinitionsv1
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowDefinitionsService) V1WorkflowDefinitionExport(ctx echo.Context, request gen.V1WorkflowDefinitionExportRequestObject) (gen.V1WorkflowDefinitionExportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	workflow, err := t.config.V1.Workflows().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Params.Name)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.V1WorkflowDefinitionExport404JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+		}
+
+		return nil, err
+	}
+
+	version := ""
+
+	if request.Params.Version != nil {
+		version = *request.Params.Version
+	}
+
+	def, err := t.config.V1.Workflows().GetWorkflowDefinition(ctx.Request().Context(), tenant.ID, workflow.ID, version)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return gen.V1WorkflowDefinitionExport404JSONResponse(apierrors.NewAPIErrors("workflow version not found")), nil
+		case errors.Is(err, v1.ErrWorkflowDefinitionNotStored), errors.Is(err, v1.ErrWorkflowDefinitionNotRepresentable):
+			return gen.V1WorkflowDefinitionExport400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.V1WorkflowDefinitionExport200JSONResponse(
+		transformers.ToV1WorkflowDefinition(def),
+	), nil
+}
@@ -0,0 +1,89 @@
+package workflowdefinitionsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowDefinitionsService) V1WorkflowDefinitionImport(ctx echo.Context, request gen.V1WorkflowDefinitionImportRequestObject) (gen.V1WorkflowDefinitionImportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	opts, err := transformers.ToWorkflowDefinition(request.Body).ToCreateWorkflowVersionOpts()
+
+	if err != nil {
+		return gen.V1WorkflowDefinitionImport400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.V1WorkflowDefinitionImport400JSONResponse(*apiErrors), nil
+	}
+
+	if err := validateParents(opts); err != nil {
+		return gen.V1WorkflowDefinitionImport400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	workflowVersion, err := t.config.V1.Workflows().PutWorkflowVersion(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		var cycleErr *v1.JobRunHasCycleError
+
+		if errors.As(err, &cycleErr) {
+			return gen.V1WorkflowDefinitionImport400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		return nil, err
+	}
+
+	if len(opts.CronTriggers) > 0 {
+		msg := tasktypes.NewCronUpdateMessage(tenant.ID, msgqueue.MsgIDCronUpdate)
+
+		if err := t.config.MessageQueueV1.SendMessage(ctx.Request().Context(), msgqueue.TICKER_UPDATE_QUEUE, msg); err != nil {
+			t.config.Logger.Err(err).Msg("could not send cron trigger update message")
+		}
+	}
+
+	def, err := t.config.V1.Workflows().GetWorkflowDefinition(
+		ctx.Request().Context(),
+		tenant.ID,
+		workflowVersion.WorkflowVersion.WorkflowId,
+		workflowVersion.WorkflowVersion.ID.String(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowDefinitionImport200JSONResponse(
+		transformers.ToV1WorkflowDefinition(def),
+	), nil
+}
+
+func validateParents(opts *v1.CreateWorkflowVersionOpts) error {
+	names := make(map[string]bool, len(opts.Tasks))
+
+	for _, task := range opts.Tasks {
+		names[task.ReadableId] = true
+	}
+
+	for _, task := range opts.Tasks {
+		for _, parent := range task.Parents {
+			if !names[parent] {
+				return fmt.Errorf("%w: parent task '%s' not found for task '%s'", v1.ErrDagParentNotFound, parent, task.ReadableId)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package workflowdefinitionsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1WorkflowDefinitionsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1WorkflowDefinitionsService(config *server.ServerConfig) *V1WorkflowDefinitionsService {
+	return &V1WorkflowDefinitionsService{
+		config: config,
+	}
+}
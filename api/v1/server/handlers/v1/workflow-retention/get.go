@@ -0,0 +1,31 @@
+package workflowretentionv1
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowRetentionService) V1WorkflowRetentionPolicyGet(ctx echo.Context, request gen.V1WorkflowRetentionPolicyGetRequestObject) (gen.V1WorkflowRetentionPolicyGetResponseObject, error) {
+	workflow := ctx.Get("workflow").(*sqlcv1.GetWorkflowByIdRow)
+
+	policy, err := t.config.V1.WorkflowRetention().GetWorkflowRetentionPolicy(ctx.Request().Context(), workflow.Workflow.TenantId, workflow.Workflow.ID)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return gen.V1WorkflowRetentionPolicyGet404JSONResponse(apierrors.NewAPIErrors("workflow does not have a retention policy")), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowRetentionPolicyGet200JSONResponse(
+		transformers.ToV1WorkflowRetentionPolicy(policy),
+	), nil
+}
@@ -0,0 +1,23 @@
+package workflowretentionv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowRetentionService) V1WorkflowRetentionPolicyList(ctx echo.Context, request gen.V1WorkflowRetentionPolicyListRequestObject) (gen.V1WorkflowRetentionPolicyListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	policies, err := t.config.V1.WorkflowRetention().ListWorkflowRetentionPolicies(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowRetentionPolicyList200JSONResponse(
+		transformers.ToV1WorkflowRetentionPolicyList(policies),
+	), nil
+}
@@ -0,0 +1,15 @@
+package workflowretentionv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1WorkflowRetentionService struct {
+	config *server.ServerConfig
+}
+
+func NewV1WorkflowRetentionService(config *server.ServerConfig) *V1WorkflowRetentionService {
+	return &V1WorkflowRetentionService{
+		config: config,
+	}
+}
@@ -0,0 +1,91 @@
+package workflowretentionv1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowRetentionService) V1WorkflowRetentionPolicyUpsert(ctx echo.Context, request gen.V1WorkflowRetentionPolicyUpsertRequestObject) (gen.V1WorkflowRetentionPolicyUpsertResponseObject, error) {
+	workflow := ctx.Get("workflow").(*sqlcv1.GetWorkflowByIdRow)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.V1WorkflowRetentionPolicyUpsert400JSONResponse(*apiErrors), nil
+	}
+
+	maxPeriod, err := t.config.Runtime.Limits.GetMaxWorkflowRetentionPeriod()
+
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &v1.UpsertWorkflowRetentionPolicyOpts{}
+
+	// durations are validated above, so the only parse errors left are periods which are too short or too long
+	if opts.RetentionPeriod, err = parseRetentionPeriod("retentionPeriod", request.Body.RetentionPeriod, maxPeriod); err != nil {
+		return gen.V1WorkflowRetentionPolicyUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if opts.CompletedRetentionPeriod, err = parseOptionalRetentionPeriod("completedRetentionPeriod", request.Body.CompletedRetentionPeriod, maxPeriod); err != nil {
+		return gen.V1WorkflowRetentionPolicyUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if opts.FailedRetentionPeriod, err = parseOptionalRetentionPeriod("failedRetentionPeriod", request.Body.FailedRetentionPeriod, maxPeriod); err != nil {
+		return gen.V1WorkflowRetentionPolicyUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if opts.CancelledRetentionPeriod, err = parseOptionalRetentionPeriod("cancelledRetentionPeriod", request.Body.CancelledRetentionPeriod, maxPeriod); err != nil {
+		return gen.V1WorkflowRetentionPolicyUpsert400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	policy, err := t.config.V1.WorkflowRetention().UpsertWorkflowRetentionPolicy(ctx.Request().Context(), workflow.Workflow.TenantId, workflow.Workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowRetentionPolicyUpsert200JSONResponse(
+		transformers.ToV1WorkflowRetentionPolicy(policy),
+	), nil
+}
+
+func parseRetentionPeriod(field, s string, maxPeriod time.Duration) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a valid duration", field)
+	}
+
+	if d < v1.MinWorkflowRetentionPeriod {
+		return 0, fmt.Errorf("%s must be at least %s", field, v1.MinWorkflowRetentionPeriod)
+	}
+
+	if d > maxPeriod {
+		return 0, fmt.Errorf("%s must be at most %s", field, maxPeriod)
+	}
+
+	return d, nil
+}
+
+func parseOptionalRetentionPeriod(field string, s *string, maxPeriod time.Duration) (*time.Duration, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	d, err := parseRetentionPeriod(field, *s, maxPeriod)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	"github.com/hatchet-dev/hatchet/pkg/constants"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
@@ -75,6 +77,24 @@ func (t *V1WorkflowRunsService) V1WorkflowRunCreate(ctx echo.Context, request ge
 		if e, ok := status.FromError(err); ok {
 			switch e.Code() { // nolint: gocritic
 			case codes.InvalidArgument:
+				// input which doesn't match the workflow's input schema has a field violation for each JSON pointer
+				for _, detail := range e.Details() {
+					if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.FieldViolations) > 0 {
+						violations := make([]jsonschema.Violation, 0, len(badRequest.FieldViolations))
+
+						for _, v := range badRequest.FieldViolations {
+							violations = append(violations, jsonschema.Violation{
+								Pointer: v.Field,
+								Message: v.Description,
+							})
+						}
+
+						return gen.V1WorkflowRunCreate400JSONResponse(
+							apierrors.NewInvalidInputAPIErrors(violations),
+						), nil
+					}
+				}
+
 				return gen.V1WorkflowRunCreate400JSONResponse(
 					apierrors.NewAPIErrors(e.Message()),
 				), nil
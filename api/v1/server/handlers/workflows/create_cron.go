@@ -2,7 +2,10 @@ package workflows
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -35,6 +38,34 @@ func (t *WorkflowService) CronWorkflowTriggerCreate(ctx echo.Context, request ge
 		priority = *request.Body.Priority
 	}
 
+	var timezone *string
+
+	if request.Body.Timezone != nil && *request.Body.Timezone != "" {
+		if _, err := time.LoadLocation(*request.Body.Timezone); err != nil || strings.EqualFold(*request.Body.Timezone, "local") {
+			return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("invalid timezone %q", *request.Body.Timezone))), nil
+		}
+
+		timezone = request.Body.Timezone
+	}
+
+	var misfirePolicy *sqlcv1.WorkflowTriggerCronRefMisfirePolicy
+
+	if request.Body.MisfirePolicy != nil {
+		policy := sqlcv1.WorkflowTriggerCronRefMisfirePolicy(*request.Body.MisfirePolicy)
+		misfirePolicy = &policy
+	}
+
+	if request.Body.MisfireLimit != nil && (misfirePolicy == nil || *misfirePolicy != sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL) {
+		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("misfireLimit can only be set with the FIRE_ALL misfire policy")), nil
+	}
+
+	var overlapPolicy *sqlcv1.WorkflowTriggerCronRefOverlapPolicy
+
+	if request.Body.OverlapPolicy != nil {
+		policy := sqlcv1.WorkflowTriggerCronRefOverlapPolicy(*request.Body.OverlapPolicy)
+		overlapPolicy = &policy
+	}
+
 	inputBytes, err := json.Marshal(request.Body.Input)
 
 	if err != nil {
@@ -63,9 +94,19 @@ func (t *WorkflowService) CronWorkflowTriggerCreate(ctx echo.Context, request ge
 			AdditionalMetadata: request.Body.AdditionalMetadata,
 			WorkflowId:         workflow.ID,
 			Priority:           &priority,
+			Timezone:           timezone,
+			MisfirePolicy:      misfirePolicy,
+			MisfireLimit:       request.Body.MisfireLimit,
+			OverlapPolicy:      overlapPolicy,
 		},
 	)
 	if err != nil {
+		invalidInput := &v1.ErrInvalidInput{}
+
+		if errors.As(err, &invalidInput) {
+			return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewInvalidInputAPIErrors(invalidInput.Violations)), nil
+		}
+
 		if strings.Contains(err.Error(), "unique constraint") {
 			return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("cron trigger with that name-expression pair already exists")), nil
 		}
@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/labstack/echo/v4"
 
@@ -60,6 +61,14 @@ func (t *WorkflowService) ScheduledWorkflowRunCreate(ctx echo.Context, request g
 	})
 
 	if err != nil {
+		invalidInput := &v1.ErrInvalidInput{}
+
+		if errors.As(err, &invalidInput) {
+			return gen.ScheduledWorkflowRunCreate400JSONResponse(
+				apierrors.NewInvalidInputAPIErrors(invalidInput.Violations),
+			), nil
+		}
+
 		return gen.ScheduledWorkflowRunCreate400JSONResponse(
 			apierrors.NewAPIErrors(err.Error()),
 		), nil
@@ -0,0 +1,106 @@
+package workflows
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *WorkflowService) WorkflowCronHistoryList(ctx echo.Context, request gen.WorkflowCronHistoryListRequestObject) (gen.WorkflowCronHistoryListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	limit := 50
+	offset := 0
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+	}
+
+	if limit < 1 || limit > 100 {
+		return gen.WorkflowCronHistoryList400JSONResponse(apierrors.NewAPIErrors("Limit must be between 1 and 100.")), nil
+	}
+
+	if offset < 0 {
+		return gen.WorkflowCronHistoryList400JSONResponse(apierrors.NewAPIErrors("Offset must not be negative.")), nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	cron, err := t.config.V1.WorkflowSchedules().GetCronWorkflow(dbCtx, tenantId, request.CronWorkflow)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cron == nil {
+		return gen.WorkflowCronHistoryList404JSONResponse(apierrors.NewAPIErrors("Cron workflow not found.")), nil
+	}
+
+	fires, count, err := t.config.V1.WorkflowSchedules().ListCronFires(dbCtx, tenantId, request.CronWorkflow, &v1.ListCronFiresOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := make([]uuid.UUID, 0, len(fires))
+
+	for _, fire := range fires {
+		if fire.WorkflowRunExternalID != nil {
+			externalIds = append(externalIds, *fire.WorkflowRunExternalID)
+		}
+	}
+
+	statuses := make(map[uuid.UUID]sqlcv1.V1ReadableStatusOlap)
+
+	if len(externalIds) > 0 {
+		statuses, err = t.config.V1.OLAP().ListWorkflowRunStatuses(dbCtx, tenantId, externalIds)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows := make([]gen.CronWorkflowFire, len(fires))
+
+	for i, fire := range fires {
+		rows[i] = transformers.ToCronWorkflowFire(fire, statuses)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.WorkflowCronHistoryList200JSONResponse(
+		gen.CronWorkflowFireList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				CurrentPage: &currPage,
+				NextPage:    &nextPage,
+			},
+		},
+	), nil
+}
@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -10,6 +11,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
 
@@ -31,6 +33,18 @@ func (t *WorkflowService) WorkflowCronTrigger(ctx echo.Context, request gen.Work
 		cronName = &cron.Name.String
 	}
 
+	err := ticker.ValidateInput(ctx.Request().Context(), t.config.MessageQueueV1, t.config.V1, tenant.ID, cron.WorkflowName, cron.Input)
+
+	if err != nil {
+		invalidInput := &repository.ErrInvalidInput{}
+
+		if errors.As(err, &invalidInput) {
+			return gen.WorkflowCronTrigger400JSONResponse(apierrors.NewInvalidInputAPIErrors(invalidInput.Violations)), nil
+		}
+
+		return nil, err
+	}
+
 	externalId, err := ticker.RunCronWorkflow(
 		ctx.Request().Context(),
 		t.config.MessageQueueV1,
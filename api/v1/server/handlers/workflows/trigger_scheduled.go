@@ -1,6 +1,7 @@
 package workflows
 
 import (
+	"errors"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -42,6 +43,12 @@ func (w *WorkflowService) WorkflowScheduledTrigger(ctx echo.Context, request gen
 		},
 	)
 
+	invalidInput := &repository.ErrInvalidInput{}
+
+	if errors.As(err, &invalidInput) {
+		return gen.WorkflowScheduledTrigger400JSONResponse(apierrors.NewInvalidInputAPIErrors(invalidInput.Violations)), nil
+	}
+
 	// external id can be nil if idempotency collision happens
 	// note: this will be fixed soon with the new idempotency improvements
 	if err != nil || externalId == nil {
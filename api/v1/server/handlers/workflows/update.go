@@ -3,9 +3,36 @@ package workflows
 import (
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
 
 func (t *WorkflowService) WorkflowUpdate(ctx echo.Context, request gen.WorkflowUpdateRequestObject) (gen.WorkflowUpdateResponseObject, error) {
-	panic("deprecated")
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+	workflow := ctx.Get("workflow").(*sqlcv1.GetWorkflowByIdRow)
+
+	if request.Body.IsPaused != nil {
+		return gen.WorkflowUpdate400JSONResponse(
+			apierrors.NewAPIErrors("pausing workflows is not supported", "isPaused"),
+		), nil
+	}
+
+	opts := &v1.UpdateWorkflowOpts{}
+
+	if request.Body.InputValidationMode != nil {
+		mode := sqlcv1.WorkflowInputValidationMode(*request.Body.InputValidationMode)
+		opts.InputValidationMode = &mode
+	}
+
+	updated, err := t.config.V1.Workflows().UpdateWorkflow(ctx.Request().Context(), tenantId, workflow.Workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdate200JSONResponse(*transformers.ToWorkflow(updated, nil)), nil
 }
@@ -4,4 +4,8 @@ const (
 	// IsExchangeTokenContextKey is the context key used to indicate that the request
 	// is authenticated with an exchange token.
 	IsExchangeTokenContextKey = "is_exchange_token"
+
+	// APITokenScopesContextKey is the context key used to store the scopes of the API token
+	// the request is authenticated with. It is not set for unrestricted tokens.
+	APITokenScopesContextKey = "api_token_scopes"
 )
@@ -1,6 +1,9 @@
 package apierrors
 
-import "github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
+)
 
 func NewAPIErrors(description string, field ...string) gen.APIErrors {
 	apiError := gen.APIError{
@@ -13,3 +16,22 @@ func NewAPIErrors(description string, field ...string) gen.APIErrors {
 		Errors: []gen.APIError{apiError},
 	}
 }
+
+// NewInvalidInputAPIErrors returns an error for each value of a workflow run's input which doesn't match the
+// workflow's input schema, with the JSON pointer of the value as the field.
+func NewInvalidInputAPIErrors(violations []jsonschema.Violation) gen.APIErrors {
+	res := gen.APIErrors{
+		Errors: make([]gen.APIError, 0, len(violations)),
+	}
+
+	for _, v := range violations {
+		field := v.Pointer
+
+		res.Errors = append(res.Errors, gen.APIError{
+			Description: v.Message,
+			Field:       &field,
+		})
+	}
+
+	return res
+}
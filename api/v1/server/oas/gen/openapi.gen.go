@@ -41,18 +41,39 @@ const (
 	ConcurrencyScopeWORKFLOW ConcurrencyScope = "WORKFLOW"
 )
 
+// Defines values for CronWorkflowFireOutcome.
+const (
+	SKIPPEDINVALIDINPUT CronWorkflowFireOutcome = "SKIPPED_INVALID_INPUT"
+	SKIPPEDOVERLAP      CronWorkflowFireOutcome = "SKIPPED_OVERLAP"
+	TRIGGERED           CronWorkflowFireOutcome = "TRIGGERED"
+)
+
 // Defines values for CronWorkflowsMethod.
 const (
 	CronWorkflowsMethodAPI     CronWorkflowsMethod = "API"
 	CronWorkflowsMethodDEFAULT CronWorkflowsMethod = "DEFAULT"
 )
 
+// Defines values for CronWorkflowsMisfirePolicy.
+const (
+	FIREALL  CronWorkflowsMisfirePolicy = "FIRE_ALL"
+	FIREONCE CronWorkflowsMisfirePolicy = "FIRE_ONCE"
+	SKIP     CronWorkflowsMisfirePolicy = "SKIP"
+)
+
 // Defines values for CronWorkflowsOrderByField.
 const (
 	CronWorkflowsOrderByFieldCreatedAt CronWorkflowsOrderByField = "createdAt"
 	CronWorkflowsOrderByFieldName      CronWorkflowsOrderByField = "name"
 )
 
+// Defines values for CronWorkflowsOverlapPolicy.
+const (
+	ALLOW          CronWorkflowsOverlapPolicy = "ALLOW"
+	CANCELPREVIOUS CronWorkflowsOverlapPolicy = "CANCEL_PREVIOUS"
+	SKIPIFRUNNING  CronWorkflowsOverlapPolicy = "SKIP_IF_RUNNING"
+)
+
 // Defines values for EventOrderByDirection.
 const (
 	EventOrderByDirectionAsc  EventOrderByDirection = "asc"
@@ -98,6 +119,13 @@ const (
 	OtelStatusCodeUNSET OtelStatusCode = "UNSET"
 )
 
+// Defines values for RateLimitMode.
+const (
+	FIXEDWINDOW   RateLimitMode = "FIXED_WINDOW"
+	SLIDINGWINDOW RateLimitMode = "SLIDING_WINDOW"
+	TOKENBUCKET   RateLimitMode = "TOKEN_BUCKET"
+)
+
 // Defines values for RateLimitOrderByDirection.
 const (
 	RateLimitOrderByDirectionAsc  RateLimitOrderByDirection = "asc"
@@ -134,6 +162,12 @@ const (
 	ScheduledWorkflowsOrderByFieldTriggerAt ScheduledWorkflowsOrderByField = "triggerAt"
 )
 
+// Defines values for ScopedResourceLimitScope.
+const (
+	ScopedResourceLimitScopeMETADATA ScopedResourceLimitScope = "METADATA"
+	ScopedResourceLimitScopeWORKFLOW ScopedResourceLimitScope = "WORKFLOW"
+)
+
 // Defines values for StepRunEventReason.
 const (
 	StepRunEventReasonACKNOWLEDGED                 StepRunEventReason = "ACKNOWLEDGED"
@@ -175,6 +209,21 @@ const (
 	StepRunStatusSUCCEEDED         StepRunStatus = "SUCCEEDED"
 )
 
+// Defines values for TenantAlertRuleKind.
+const (
+	CRONMISSED  TenantAlertRuleKind = "CRON_MISSED"
+	FAILURERATE TenantAlertRuleKind = "FAILURE_RATE"
+	P95DURATION TenantAlertRuleKind = "P95_DURATION"
+	QUEUEDEPTH  TenantAlertRuleKind = "QUEUE_DEPTH"
+)
+
+// Defines values for TenantAlertSinkKind.
+const (
+	TenantAlertSinkKindPAGERDUTY TenantAlertSinkKind = "PAGERDUTY"
+	TenantAlertSinkKindTEAMS     TenantAlertSinkKind = "TEAMS"
+	TenantAlertSinkKindWEBHOOK   TenantAlertSinkKind = "WEBHOOK"
+)
+
 // Defines values for TenantEnvironment.
 const (
 	Development TenantEnvironment = "development"
@@ -206,6 +255,21 @@ const (
 	V1 TenantVersion = "V1"
 )
 
+// Defines values for V1BulkOperationKind.
+const (
+	CANCEL       V1BulkOperationKind = "CANCEL"
+	REPLAY       V1BulkOperationKind = "REPLAY"
+	REPLAYEVENTS V1BulkOperationKind = "REPLAY_EVENTS"
+)
+
+// Defines values for V1BulkOperationStatus.
+const (
+	V1BulkOperationStatusCANCELLED V1BulkOperationStatus = "CANCELLED"
+	V1BulkOperationStatusCOMPLETED V1BulkOperationStatus = "COMPLETED"
+	V1BulkOperationStatusPENDING   V1BulkOperationStatus = "PENDING"
+	V1BulkOperationStatusRUNNING   V1BulkOperationStatus = "RUNNING"
+)
+
 // Defines values for V1CELDebugResponseStatus.
 const (
 	V1CELDebugResponseStatusERROR   V1CELDebugResponseStatus = "ERROR"
@@ -229,16 +293,19 @@ const (
 
 // Defines values for V1DurableEventLogKind.
 const (
-	MEMO    V1DurableEventLogKind = "MEMO"
-	RUN     V1DurableEventLogKind = "RUN"
-	WAITFOR V1DurableEventLogKind = "WAIT_FOR"
+	V1DurableEventLogKindMEMO    V1DurableEventLogKind = "MEMO"
+	V1DurableEventLogKindPATCH   V1DurableEventLogKind = "PATCH"
+	V1DurableEventLogKindRUN     V1DurableEventLogKind = "RUN"
+	V1DurableEventLogKindSIGNAL  V1DurableEventLogKind = "SIGNAL"
+	V1DurableEventLogKindWAITFOR V1DurableEventLogKind = "WAIT_FOR"
 )
 
 // Defines values for V1DurableWaitConditionKind.
 const (
-	CHILDWORKFLOW V1DurableWaitConditionKind = "CHILD_WORKFLOW"
-	SLEEP         V1DurableWaitConditionKind = "SLEEP"
-	USEREVENT     V1DurableWaitConditionKind = "USER_EVENT"
+	V1DurableWaitConditionKindCHILDWORKFLOW V1DurableWaitConditionKind = "CHILD_WORKFLOW"
+	V1DurableWaitConditionKindSIGNAL        V1DurableWaitConditionKind = "SIGNAL"
+	V1DurableWaitConditionKindSLEEP         V1DurableWaitConditionKind = "SLEEP"
+	V1DurableWaitConditionKindUSEREVENT     V1DurableWaitConditionKind = "USER_EVENT"
 )
 
 // Defines values for V1LogLineLevel.
@@ -307,10 +374,11 @@ const (
 
 // Defines values for V1WebhookHMACAlgorithm.
 const (
-	MD5    V1WebhookHMACAlgorithm = "MD5"
-	SHA1   V1WebhookHMACAlgorithm = "SHA1"
-	SHA256 V1WebhookHMACAlgorithm = "SHA256"
-	SHA512 V1WebhookHMACAlgorithm = "SHA512"
+	ED25519 V1WebhookHMACAlgorithm = "ED25519"
+	MD5     V1WebhookHMACAlgorithm = "MD5"
+	SHA1    V1WebhookHMACAlgorithm = "SHA1"
+	SHA256  V1WebhookHMACAlgorithm = "SHA256"
+	SHA512  V1WebhookHMACAlgorithm = "SHA512"
 )
 
 // Defines values for V1WebhookHMACEncoding.
@@ -322,12 +390,30 @@ const (
 
 // Defines values for V1WebhookSourceName.
 const (
-	GENERIC V1WebhookSourceName = "GENERIC"
-	GITHUB  V1WebhookSourceName = "GITHUB"
-	LINEAR  V1WebhookSourceName = "LINEAR"
-	SLACK   V1WebhookSourceName = "SLACK"
-	STRIPE  V1WebhookSourceName = "STRIPE"
-	SVIX    V1WebhookSourceName = "SVIX"
+	BITBUCKET V1WebhookSourceName = "BITBUCKET"
+	DISCORD   V1WebhookSourceName = "DISCORD"
+	GENERIC   V1WebhookSourceName = "GENERIC"
+	GITHUB    V1WebhookSourceName = "GITHUB"
+	GITLAB    V1WebhookSourceName = "GITLAB"
+	LINEAR    V1WebhookSourceName = "LINEAR"
+	SHOPIFY   V1WebhookSourceName = "SHOPIFY"
+	SLACK     V1WebhookSourceName = "SLACK"
+	STRIPE    V1WebhookSourceName = "STRIPE"
+	SVIX      V1WebhookSourceName = "SVIX"
+	TWILIO    V1WebhookSourceName = "TWILIO"
+)
+
+// Defines values for V1WorkflowDefinitionSticky.
+const (
+	HARD V1WorkflowDefinitionSticky = "HARD"
+	SOFT V1WorkflowDefinitionSticky = "SOFT"
+)
+
+// Defines values for V1WorkflowDefinitionChangeKind.
+const (
+	ADDED   V1WorkflowDefinitionChangeKind = "ADDED"
+	CHANGED V1WorkflowDefinitionChangeKind = "CHANGED"
+	REMOVED V1WorkflowDefinitionChangeKind = "REMOVED"
 )
 
 // Defines values for V1WorkflowType.
@@ -353,9 +439,15 @@ const (
 
 // Defines values for WorkerType.
 const (
-	MANAGED    WorkerType = "MANAGED"
-	SELFHOSTED WorkerType = "SELFHOSTED"
-	WEBHOOK    WorkerType = "WEBHOOK"
+	WorkerTypeMANAGED    WorkerType = "MANAGED"
+	WorkerTypeSELFHOSTED WorkerType = "SELFHOSTED"
+	WorkerTypeWEBHOOK    WorkerType = "WEBHOOK"
+)
+
+// Defines values for WorkflowInputValidationMode.
+const (
+	REJECT WorkflowInputValidationMode = "REJECT"
+	WARN   WorkflowInputValidationMode = "WARN"
 )
 
 // Defines values for WorkflowKind.
@@ -381,13 +473,13 @@ const (
 
 // Defines values for WorkflowRunStatus.
 const (
-	BACKOFF   WorkflowRunStatus = "BACKOFF"
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	QUEUED    WorkflowRunStatus = "QUEUED"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusBACKOFF   WorkflowRunStatus = "BACKOFF"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusQUEUED    WorkflowRunStatus = "QUEUED"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
 // APIError defines model for APIError.
@@ -438,6 +530,9 @@ type APIMeta struct {
 
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
+	// OidcName the name of the OIDC provider, if the oidc scheme is supported
+	OidcName *string `json:"oidcName,omitempty"`
+
 	// Schemes the supported types of authentication
 	Schemes *[]string `json:"schemes,omitempty"`
 }
@@ -480,6 +575,12 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Scopes The operations the API token is restricted to. If empty, the token can perform any operation.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// Workflows The names of the workflows the API token is restricted to. If empty, the token can access every workflow.
+	Workflows *[]string `json:"workflows,omitempty"`
 }
 
 // AcceptInviteRequest defines model for AcceptInviteRequest.
@@ -531,6 +632,12 @@ type CreateAPITokenRequest struct {
 
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Scopes OpenAPI operation IDs or scope presets (read-only, trigger-only) which the token is restricted to. If empty, the token can perform any operation.
+	Scopes *[]string `json:"scopes,omitempty" validate:"omitnil,dive,required,max=255"`
+
+	// Workflows The names of the workflows the token is restricted to. If empty, the token can access every workflow.
+	Workflows *[]string `json:"workflows,omitempty" validate:"omitnil,dive,required,max=255"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -545,7 +652,19 @@ type CreateCronWorkflowTriggerRequest struct {
 	CronExpression     string                 `json:"cronExpression"`
 	CronName           string                 `json:"cronName"`
 	Input              map[string]interface{} `json:"input"`
-	Priority           *int32                 `json:"priority,omitempty"`
+
+	// MisfireLimit The maximum number of missed runs to fire with the FIRE_ALL misfire policy. Defaults to 100.
+	MisfireLimit *int32 `json:"misfireLimit,omitempty"`
+
+	// MisfirePolicy What to do with runs of a cron which were missed while no ticker was running.
+	MisfirePolicy *CronWorkflowsMisfirePolicy `json:"misfirePolicy,omitempty"`
+
+	// OverlapPolicy What to do when a cron fires while the run it previously triggered is still running.
+	OverlapPolicy *CronWorkflowsOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Priority      *int32                      `json:"priority,omitempty"`
+
+	// Timezone The IANA timezone to evaluate the cron expression in, for example America/New_York. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CreateEventRequest defines model for CreateEventRequest.
@@ -578,6 +697,40 @@ type CreateTenantAlertEmailGroupRequest struct {
 	Emails []string `json:"emails" validate:"required,dive,email"`
 }
 
+// CreateTenantAlertRuleRequest defines model for CreateTenantAlertRuleRequest.
+type CreateTenantAlertRuleRequest struct {
+	Kind TenantAlertRuleKind `json:"kind"`
+
+	// Name The name of the alert rule
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Threshold The threshold of the rule. This is a number of runs for QUEUE_DEPTH rules, a number of seconds for P95_DURATION rules and a percentage for FAILURE_RATE rules. It's ignored for CRON_MISSED rules.
+	Threshold float64 `json:"threshold" validate:"gte=0"`
+
+	// Window The window which the rule is evaluated over, as a duration string (e.g. 1h). Must be at least 1m. Queue depth is measured over runs created within the window.
+	Window *string `json:"window,omitempty" validate:"omitnil,duration"`
+
+	// WorkflowId The id of the workflow which the rule is evaluated against
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// CreateTenantAlertSinkRequest defines model for CreateTenantAlertSinkRequest.
+type CreateTenantAlertSinkRequest struct {
+	Kind TenantAlertSinkKind `json:"kind"`
+
+	// Name The name of the alert sink
+	Name string `json:"name" validate:"required,max=255"`
+
+	// RoutingKey The PagerDuty integration key. Required for PAGERDUTY sinks.
+	RoutingKey *string `json:"routingKey,omitempty"`
+
+	// Secret The secret used to sign the payloads of WEBHOOK sinks.
+	Secret *string `json:"secret,omitempty" validate:"omitempty,min=16"`
+
+	// Url The url which alerts are posted to. Required for WEBHOOK and TEAMS sinks.
+	Url *string `json:"url,omitempty" validate:"omitempty,url"`
+}
+
 // CreateTenantInviteRequest defines model for CreateTenantInviteRequest.
 type CreateTenantInviteRequest struct {
 	// Email The email of the user to invite.
@@ -600,20 +753,71 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTenantRoleRequest defines model for CreateTenantRoleRequest.
+type CreateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1024"`
+
+	// Inherits The built-in roles (ADMIN or MEMBER) which this role inherits permissions from.
+	Inherits *[]string `json:"inherits,omitempty"`
+
+	// Name The name of the role.
+	Name string `json:"name" validate:"required,hatchetName,max=255"`
+
+	// Permissions The API operations which this role is permitted to perform.
+	Permissions *[]string `json:"permissions,omitempty"`
+}
+
+// CronWorkflowFire defines model for CronWorkflowFire.
+type CronWorkflowFire struct {
+	// FiredAt The time the cron actually fired at.
+	FiredAt time.Time               `json:"firedAt"`
+	Outcome CronWorkflowFireOutcome `json:"outcome"`
+
+	// ScheduledAt The time the cron was scheduled to fire at.
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// WorkflowRunId The external id of the workflow run which was triggered, if any.
+	WorkflowRunId     *openapi_types.UUID `json:"workflowRunId,omitempty"`
+	WorkflowRunStatus *V1TaskStatus       `json:"workflowRunStatus,omitempty"`
+}
+
+// CronWorkflowFireList defines model for CronWorkflowFireList.
+type CronWorkflowFireList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]CronWorkflowFire `json:"rows,omitempty"`
+}
+
+// CronWorkflowFireOutcome defines model for CronWorkflowFireOutcome.
+type CronWorkflowFireOutcome string
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Cron               string                  `json:"cron"`
 	Enabled            bool                    `json:"enabled"`
 	Input              *map[string]interface{} `json:"input,omitempty"`
+	LastFiredAt        *time.Time              `json:"lastFiredAt,omitempty"`
 	Metadata           APIResourceMeta         `json:"metadata"`
 	Method             CronWorkflowsMethod     `json:"method"`
-	Name               *string                 `json:"name,omitempty"`
-	Priority           *int32                  `json:"priority,omitempty"`
-	TenantId           string                  `json:"tenantId"`
-	WorkflowId         string                  `json:"workflowId"`
-	WorkflowName       string                  `json:"workflowName"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// MisfireLimit The maximum number of missed runs which are fired with the FIRE_ALL misfire policy.
+	MisfireLimit *int32 `json:"misfireLimit,omitempty"`
+
+	// MisfirePolicy What to do with runs of a cron which were missed while no ticker was running.
+	MisfirePolicy CronWorkflowsMisfirePolicy `json:"misfirePolicy"`
+	Name          *string                    `json:"name,omitempty"`
+
+	// OverlapPolicy What to do when a cron fires while the run it previously triggered is still running.
+	OverlapPolicy CronWorkflowsOverlapPolicy `json:"overlapPolicy"`
+	Priority      *int32                     `json:"priority,omitempty"`
+	TenantId      string                     `json:"tenantId"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in. Crons without a timezone are evaluated in UTC.
+	Timezone          *string `json:"timezone,omitempty"`
+	WorkflowId        string  `json:"workflowId"`
+	WorkflowName      string  `json:"workflowName"`
+	WorkflowVersionId string  `json:"workflowVersionId"`
 }
 
 // CronWorkflowsList defines model for CronWorkflowsList.
@@ -625,9 +829,15 @@ type CronWorkflowsList struct {
 // CronWorkflowsMethod defines model for CronWorkflowsMethod.
 type CronWorkflowsMethod string
 
+// CronWorkflowsMisfirePolicy What to do with runs of a cron which were missed while no ticker was running.
+type CronWorkflowsMisfirePolicy string
+
 // CronWorkflowsOrderByField defines model for CronWorkflowsOrderByField.
 type CronWorkflowsOrderByField string
 
+// CronWorkflowsOverlapPolicy What to do when a cron fires while the run it previously triggered is still running.
+type CronWorkflowsOverlapPolicy string
+
 // Event defines model for Event.
 type Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -826,6 +1036,9 @@ type QueueMetrics struct {
 
 // RateLimit defines model for RateLimit.
 type RateLimit struct {
+	// Burst The maximum number of units which can be used in a burst. Only set for TOKEN_BUCKET rate limits.
+	Burst *int `json:"burst,omitempty"`
+
 	// Key The key for the rate limit.
 	Key string `json:"key"`
 
@@ -833,7 +1046,8 @@ type RateLimit struct {
 	LastRefill time.Time `json:"lastRefill"`
 
 	// LimitValue The maximum number of requests allowed within the window.
-	LimitValue int `json:"limitValue"`
+	LimitValue int           `json:"limitValue"`
+	Mode       RateLimitMode `json:"mode"`
 
 	// TenantId The ID of the tenant associated with this rate limit.
 	TenantId string `json:"tenantId"`
@@ -851,6 +1065,9 @@ type RateLimitList struct {
 	Rows       *[]RateLimit        `json:"rows,omitempty"`
 }
 
+// RateLimitMode defines model for RateLimitMode.
+type RateLimitMode string
+
 // RateLimitOrderByDirection defines model for RateLimitOrderByDirection.
 type RateLimitOrderByDirection string
 
@@ -1001,6 +1218,45 @@ type ScheduledWorkflowsMethod string
 // ScheduledWorkflowsOrderByField defines model for ScheduledWorkflowsOrderByField.
 type ScheduledWorkflowsOrderByField string
 
+// ScopedResourceLimit defines model for ScopedResourceLimit.
+type ScopedResourceLimit struct {
+	// LastRefill The last time the usage of the limit was reset.
+	LastRefill time.Time `json:"lastRefill"`
+
+	// LimitValue The hard limit. Triggers which would exceed it are rejected.
+	LimitValue int             `json:"limitValue"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// MetadataKey The additional metadata key of a METADATA limit.
+	MetadataKey *string `json:"metadataKey,omitempty"`
+
+	// MetadataValue The additional metadata value of a METADATA limit.
+	MetadataValue *string                  `json:"metadataValue,omitempty"`
+	Resource      TenantResource           `json:"resource"`
+	Scope         ScopedResourceLimitScope `json:"scope"`
+
+	// SoftLimitValue The soft limit, which alerts the tenant once it's crossed.
+	SoftLimitValue *int `json:"softLimitValue,omitempty"`
+
+	// Value The usage of the limit in the current window.
+	Value int `json:"value"`
+
+	// Window The window which usage is counted over, as a duration string (e.g. 24h).
+	Window string `json:"window"`
+
+	// WorkflowId The id of the workflow of a WORKFLOW limit.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
+// ScopedResourceLimitList defines model for ScopedResourceLimitList.
+type ScopedResourceLimitList struct {
+	Pagination *PaginationResponse    `json:"pagination,omitempty"`
+	Rows       *[]ScopedResourceLimit `json:"rows,omitempty"`
+}
+
+// ScopedResourceLimitScope defines model for ScopedResourceLimitScope.
+type ScopedResourceLimitScope string
+
 // SemaphoreSlots defines model for SemaphoreSlots.
 type SemaphoreSlots struct {
 	// ActionId The action id.
@@ -1201,6 +1457,56 @@ type TenantAlertEmailGroupList struct {
 	Rows       *[]TenantAlertEmailGroup `json:"rows,omitempty"`
 }
 
+// TenantAlertRule defines model for TenantAlertRule.
+type TenantAlertRule struct {
+	// IsFiring Whether the rule is currently firing
+	IsFiring bool                `json:"isFiring"`
+	Kind     TenantAlertRuleKind `json:"kind"`
+
+	// LastFiredAt The last time the rule started firing
+	LastFiredAt *time.Time      `json:"lastFiredAt,omitempty"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the alert rule
+	Name string `json:"name"`
+
+	// Threshold The threshold of the rule. This is a number of runs for QUEUE_DEPTH rules, a number of seconds for P95_DURATION rules and a percentage for FAILURE_RATE rules. It's ignored for CRON_MISSED rules.
+	Threshold float64 `json:"threshold"`
+
+	// Window The window which the rule is evaluated over, as a duration string (e.g. 1h)
+	Window string `json:"window"`
+
+	// WorkflowId The id of the workflow which the rule is evaluated against
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// TenantAlertRuleKind defines model for TenantAlertRuleKind.
+type TenantAlertRuleKind string
+
+// TenantAlertRuleList defines model for TenantAlertRuleList.
+type TenantAlertRuleList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]TenantAlertRule  `json:"rows,omitempty"`
+}
+
+// TenantAlertSink defines model for TenantAlertSink.
+type TenantAlertSink struct {
+	Kind     TenantAlertSinkKind `json:"kind"`
+	Metadata APIResourceMeta     `json:"metadata"`
+
+	// Name The name of the alert sink
+	Name string `json:"name"`
+}
+
+// TenantAlertSinkKind defines model for TenantAlertSinkKind.
+type TenantAlertSinkKind string
+
+// TenantAlertSinkList defines model for TenantAlertSinkList.
+type TenantAlertSinkList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]TenantAlertSink  `json:"rows,omitempty"`
+}
+
 // TenantAlertingSettings defines model for TenantAlertingSettings.
 type TenantAlertingSettings struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -1251,6 +1557,12 @@ type TenantInviteList struct {
 
 // TenantMember defines model for TenantMember.
 type TenantMember struct {
+	// CustomRoleId The id of the custom role assigned to the member. If set, the member's permissions are determined by the custom role.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+
+	// CustomRoleName The name of the custom role assigned to the member.
+	CustomRoleName *string `json:"customRoleName,omitempty"`
+
 	// ManuallyAdded Whether this membership was explicitly granted (as opposed to synced via user-group tags). Only explicit members can have their role edited or be removed.
 	ManuallyAdded *bool            `json:"manually_added,omitempty"`
 	Metadata      APIResourceMeta  `json:"metadata"`
@@ -1304,6 +1616,28 @@ type TenantResourcePolicy struct {
 	Limits []TenantResourceLimit `json:"limits"`
 }
 
+// TenantRole defines model for TenantRole.
+type TenantRole struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty"`
+
+	// Inherits The built-in roles (ADMIN or MEMBER) which this role inherits permissions from.
+	Inherits []string        `json:"inherits"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Name The name of the role.
+	Name string `json:"name"`
+
+	// Permissions The API operations which this role is permitted to perform.
+	Permissions []string `json:"permissions"`
+}
+
+// TenantRoleList defines model for TenantRoleList.
+type TenantRoleList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]TenantRole       `json:"rows,omitempty"`
+}
+
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]interface{} `json:"queues,omitempty"`
@@ -1347,7 +1681,9 @@ type UpdateTenantInviteRequest struct {
 
 // UpdateTenantMemberRequest defines model for UpdateTenantMemberRequest.
 type UpdateTenantMemberRequest struct {
-	Role TenantMemberRole `json:"role"`
+	// CustomRoleId The id of a custom role to assign to the member. Custom roles can only be assigned to members with the MEMBER role. If omitted, any custom role is removed from the member.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+	Role         TenantMemberRole    `json:"role"`
 }
 
 // UpdateTenantRequest defines model for UpdateTenantRequest.
@@ -1375,12 +1711,47 @@ type UpdateTenantRequest struct {
 	Version *TenantVersion `json:"version,omitempty"`
 }
 
+// UpdateTenantRoleRequest defines model for UpdateTenantRoleRequest.
+type UpdateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1024"`
+
+	// Inherits The built-in roles (ADMIN or MEMBER) which this role inherits permissions from.
+	Inherits *[]string `json:"inherits,omitempty"`
+
+	// Permissions The API operations which this role is permitted to perform.
+	Permissions *[]string `json:"permissions,omitempty"`
+}
+
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
 type UpdateWorkerRequest struct {
 	// IsPaused Whether the worker is paused and cannot accept new runs.
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
 
+// UpsertScopedResourceLimitRequest defines model for UpsertScopedResourceLimitRequest.
+type UpsertScopedResourceLimitRequest struct {
+	// LimitValue The hard limit. Triggers which would exceed it are rejected.
+	LimitValue int `json:"limitValue" validate:"gt=0"`
+
+	// MetadataKey The additional metadata key of a METADATA limit.
+	MetadataKey *string `json:"metadataKey,omitempty" validate:"omitnil,min=1,max=255"`
+
+	// MetadataValue The additional metadata value of a METADATA limit. Values which aren't strings are matched against their JSON encoding, so a value of 42 matches "42".
+	MetadataValue *string                  `json:"metadataValue,omitempty" validate:"omitnil,max=255"`
+	Resource      TenantResource           `json:"resource"`
+	Scope         ScopedResourceLimitScope `json:"scope"`
+
+	// SoftLimitValue The soft limit, which alerts the tenant once it's crossed. Must be lower than the hard limit.
+	SoftLimitValue *int `json:"softLimitValue,omitempty" validate:"omitnil,gt=0"`
+
+	// Window The window which usage is counted over, as a duration string (e.g. 24h). Must be at least 1m.
+	Window *string `json:"window,omitempty" validate:"omitnil,duration"`
+
+	// WorkflowId The id of the workflow of a WORKFLOW limit.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -1469,6 +1840,40 @@ type V1BranchDurableTaskResponse struct {
 	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
 }
 
+// V1BulkOperation defines model for V1BulkOperation.
+type V1BulkOperation struct {
+	// EventFilter The filter of a REPLAY_EVENTS operation. The filter of the operation only holds its time range and additional metadata.
+	EventFilter *V1EventReplayFilter `json:"eventFilter,omitempty"`
+	Filter      V1TaskFilter         `json:"filter"`
+
+	// FinishedAt The time at which the operation completed or was cancelled.
+	FinishedAt *time.Time          `json:"finishedAt,omitempty"`
+	Kind       V1BulkOperationKind `json:"kind"`
+	Metadata   APIResourceMeta     `json:"metadata"`
+
+	// ProcessedCount The number of runs or events which have been cancelled or replayed so far.
+	ProcessedCount int                   `json:"processedCount"`
+	Status         V1BulkOperationStatus `json:"status"`
+
+	// TenantId The ID of the tenant associated with this bulk operation.
+	TenantId string `json:"tenantId"`
+
+	// TotalCount The number of runs or events which matched the filter when the operation started. Unset while the operation is pending.
+	TotalCount *int `json:"totalCount,omitempty"`
+}
+
+// V1BulkOperationKind defines model for V1BulkOperationKind.
+type V1BulkOperationKind string
+
+// V1BulkOperationList defines model for V1BulkOperationList.
+type V1BulkOperationList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]V1BulkOperation  `json:"rows,omitempty"`
+}
+
+// V1BulkOperationStatus defines model for V1BulkOperationStatus.
+type V1BulkOperationStatus string
+
 // V1CELDebugRequest defines model for V1CELDebugRequest.
 type V1CELDebugRequest struct {
 	// AdditionalMetadata Additional metadata, which simulates metadata that could be sent with an event or a workflow run
@@ -1512,6 +1917,12 @@ type V1CancelledTasks struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// V1CreateBulkOperationRequest defines model for V1CreateBulkOperationRequest.
+type V1CreateBulkOperationRequest struct {
+	Filter V1TaskFilter        `json:"filter"`
+	Kind   V1BulkOperationKind `json:"kind"`
+}
+
 // V1CreateFilterRequest defines model for V1CreateFilterRequest.
 type V1CreateFilterRequest struct {
 	// Expression The expression for the filter
@@ -1638,6 +2049,24 @@ type V1DagChildren struct {
 	DagId    *openapi_types.UUID `json:"dagId,omitempty"`
 }
 
+// V1DeadLetterPolicy defines model for V1DeadLetterPolicy.
+type V1DeadLetterPolicy struct {
+	// DeadLetterWorkflowName The name of the workflow which is triggered for permanently failed runs.
+	DeadLetterWorkflowName string          `json:"deadLetterWorkflowName"`
+	Metadata               APIResourceMeta `json:"metadata"`
+
+	// SourceWorkflowId The workflow whose failed runs are sent to the dead-letter workflow. If unset, the policy is the tenant default, which applies to every workflow without its own policy.
+	SourceWorkflowId *openapi_types.UUID `json:"sourceWorkflowId,omitempty"`
+
+	// TenantId The ID of the tenant associated with this dead-letter policy.
+	TenantId string `json:"tenantId"`
+}
+
+// V1DeadLetterPolicyList defines model for V1DeadLetterPolicyList.
+type V1DeadLetterPolicyList struct {
+	Rows *[]V1DeadLetterPolicy `json:"rows,omitempty"`
+}
+
 // V1DurableEventLogEntry defines model for V1DurableEventLogEntry.
 type V1DurableEventLogEntry struct {
 	// BranchId The branch id when this entry was first seen.
@@ -1673,10 +2102,38 @@ type V1DurableEventLogKind string
 // V1DurableEventLogList defines model for V1DurableEventLogList.
 type V1DurableEventLogList = []V1DurableEventLogEntry
 
+// V1DurableTaskPatchMarker defines model for V1DurableTaskPatchMarker.
+type V1DurableTaskPatchMarker struct {
+	// BranchId The branch id of the patch marker in the event log.
+	BranchId int64 `json:"branchId"`
+
+	// NodeId The node id of the patch marker in the event log.
+	NodeId int64 `json:"nodeId"`
+
+	// RecordedAt When the patch marker was recorded.
+	RecordedAt time.Time `json:"recordedAt"`
+
+	// TaskDisplayName The display name of the durable task which recorded the patch marker.
+	TaskDisplayName string `json:"taskDisplayName"`
+
+	// TaskExternalId The external id of the durable task which recorded the patch marker.
+	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
+
+	// WorkflowId The id of the workflow of the durable task.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowRunExternalId The external id of the workflow run of the durable task.
+	WorkflowRunExternalId openapi_types.UUID `json:"workflowRunExternalId"`
+}
+
+// V1DurableTaskPatchMarkerList defines model for V1DurableTaskPatchMarkerList.
+type V1DurableTaskPatchMarkerList = []V1DurableTaskPatchMarker
+
 // V1DurableWaitCondition defines model for V1DurableWaitCondition.
 type V1DurableWaitCondition struct {
 	EventKey        *string                    `json:"eventKey,omitempty"`
 	Kind            V1DurableWaitConditionKind `json:"kind"`
+	SignalName      *string                    `json:"signalName,omitempty"`
 	SleepDurationMs *int64                     `json:"sleepDurationMs,omitempty"`
 	WorkflowName    *string                    `json:"workflowName,omitempty"`
 }
@@ -1747,6 +2204,20 @@ type V1EventWorkflowRunSummary struct {
 	Succeeded int64 `json:"succeeded"`
 }
 
+// V1EventReplayFilter The filter of a REPLAY_EVENTS operation. The filter of the operation only holds its time range and additional metadata.
+type V1EventReplayFilter struct {
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// EventsPerSecond The maximum number of events which are replayed per second.
+	EventsPerSecond int `json:"eventsPerSecond"`
+
+	// KeyPattern A glob pattern which event keys must match.
+	KeyPattern *string   `json:"keyPattern,omitempty"`
+	Scopes     *[]string `json:"scopes,omitempty"`
+	Since      time.Time `json:"since"`
+	Until      time.Time `json:"until"`
+}
+
 // V1Filter defines model for V1Filter.
 type V1Filter struct {
 	// Expression The expression associated with this filter.
@@ -1826,6 +2297,55 @@ type V1LogsPointMetrics struct {
 	Results *[]V1LogsPointMetric `json:"results,omitempty"`
 }
 
+// V1QueryTaskRequest defines model for V1QueryTaskRequest.
+type V1QueryTaskRequest struct {
+	// Input The input of the query.
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// Name The name of the query handler registered by the task.
+	Name string `json:"name"`
+}
+
+// V1QueryTaskResponse defines model for V1QueryTaskResponse.
+type V1QueryTaskResponse struct {
+	// Result The result returned by the query handler.
+	Result map[string]interface{} `json:"result"`
+}
+
+// V1ReplayEventsRequest defines model for V1ReplayEventsRequest.
+type V1ReplayEventsRequest struct {
+	// AdditionalMetadata The additional metadata key-value pairs (delimited by a `:`) which events must have.
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// DryRun If true, the matching events are counted but not replayed.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// EventsPerSecond The maximum number of events which are replayed per second. Defaults to 100.
+	EventsPerSecond *int `json:"eventsPerSecond,omitempty"`
+
+	// KeyPattern A glob pattern which event keys must match, where `*` matches any characters and `?` matches a single character.
+	KeyPattern *string `json:"keyPattern,omitempty"`
+
+	// Scopes The scopes which events must have.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// Since Replay events that occurred after this time.
+	Since time.Time `json:"since"`
+
+	// Until Replay events that occurred before this time. Defaults to the time of the request.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// V1ReplayEventsResponse defines model for V1ReplayEventsResponse.
+type V1ReplayEventsResponse struct {
+	// DryRun Whether this was a dry run.
+	DryRun bool `json:"dryRun"`
+
+	// MatchedCount The number of events which matched the filter.
+	MatchedCount int64            `json:"matchedCount"`
+	Operation    *V1BulkOperation `json:"operation,omitempty"`
+}
+
 // V1ReplayTaskRequest defines model for V1ReplayTaskRequest.
 type V1ReplayTaskRequest struct {
 	// ExternalIds A list of external IDs, which can refer to either task or workflow run external IDs
@@ -1856,6 +2376,21 @@ type V1RunningDetailCount struct {
 // V1RunningFilter defines model for V1RunningFilter.
 type V1RunningFilter string
 
+// V1SignalTaskRequest defines model for V1SignalTaskRequest.
+type V1SignalTaskRequest struct {
+	// Name The name of the signal, which the task waits for by name.
+	Name string `json:"name"`
+
+	// Payload The payload of the signal, which is returned to the task when it waits for the signal.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// V1SignalTaskResponse defines model for V1SignalTaskResponse.
+type V1SignalTaskResponse struct {
+	// Delivered Whether the task was waiting for the signal. Otherwise the signal is buffered until the task waits for it.
+	Delivered bool `json:"delivered"`
+}
+
 // V1TaskEvent defines model for V1TaskEvent.
 type V1TaskEvent struct {
 	// Attempt The attempt number of the task.
@@ -2103,6 +2638,15 @@ type V1UpdateWebhookRequest struct {
 	StaticPayload *map[string]interface{} `json:"staticPayload,omitempty"`
 }
 
+// V1UpsertDeadLetterPolicyRequest defines model for V1UpsertDeadLetterPolicyRequest.
+type V1UpsertDeadLetterPolicyRequest struct {
+	// DeadLetterWorkflowName The name of the workflow which is triggered for permanently failed runs.
+	DeadLetterWorkflowName string `json:"deadLetterWorkflowName"`
+
+	// SourceWorkflowId The workflow whose failed runs are sent to the dead-letter workflow. If unset, the tenant default policy is set.
+	SourceWorkflowId *openapi_types.UUID `json:"sourceWorkflowId,omitempty"`
+}
+
 // V1WaitData defines model for V1WaitData.
 type V1WaitData = []V1WaitItem
 
@@ -2111,10 +2655,26 @@ type V1WaitItem struct {
 	EventKey        *string                     `json:"eventKey,omitempty"`
 	Kind            *V1DurableWaitConditionKind `json:"kind,omitempty"`
 	Or              *[]V1DurableWaitCondition   `json:"or,omitempty"`
+	SignalName      *string                     `json:"signalName,omitempty"`
 	SleepDurationMs *int64                      `json:"sleepDurationMs,omitempty"`
 	WorkflowName    *string                     `json:"workflowName,omitempty"`
 }
 
+// V1UpsertWorkflowRetentionPolicyRequest defines model for V1UpsertWorkflowRetentionPolicyRequest.
+type V1UpsertWorkflowRetentionPolicyRequest struct {
+	// CancelledRetentionPeriod How long cancelled runs are kept for, which overrides the retention period. Must be at least 1h.
+	CancelledRetentionPeriod *string `json:"cancelledRetentionPeriod,omitempty" validate:"omitnil,duration"`
+
+	// CompletedRetentionPeriod How long completed runs are kept for, which overrides the retention period. Must be at least 1h.
+	CompletedRetentionPeriod *string `json:"completedRetentionPeriod,omitempty" validate:"omitnil,duration"`
+
+	// FailedRetentionPeriod How long failed runs are kept for, which overrides the retention period. Must be at least 1h.
+	FailedRetentionPeriod *string `json:"failedRetentionPeriod,omitempty" validate:"omitnil,duration"`
+
+	// RetentionPeriod How long runs of the workflow are kept for, as a duration string (e.g. 24h). Must be at least 1h.
+	RetentionPeriod string `json:"retentionPeriod" validate:"required,duration"`
+}
+
 // V1Webhook defines model for V1Webhook.
 type V1Webhook struct {
 	AuthType V1WebhookAuthType `json:"authType"`
@@ -2192,49 +2752,311 @@ type V1WebhookResponse struct {
 
 	// Message The message for the webhook response
 	Message *string `json:"message,omitempty"`
+
+	// Type The interaction response type, returned in response to Discord PING interactions
+	Type *int `json:"type,omitempty"`
 }
 
 // V1WebhookSourceName defines model for V1WebhookSourceName.
 type V1WebhookSourceName string
 
-// V1WorkflowRun defines model for V1WorkflowRun.
-type V1WorkflowRun struct {
-	// AdditionalMetadata Additional metadata for the task run.
-	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+// V1WorkflowDefinition A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically.
+type V1WorkflowDefinition struct {
+	// CanaryPercentage The percentage of runs which are routed to this version while it is a canary.
+	CanaryPercentage *int32 `json:"canaryPercentage,omitempty"`
 
-	// CreatedAt The timestamp the task run was created.
-	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// Concurrency The concurrency strategies of the workflow.
+	Concurrency *[]V1WorkflowDefinitionConcurrency `json:"concurrency,omitempty"`
 
-	// DisplayName The display name of the task run.
-	DisplayName string `json:"displayName"`
+	// CronInput The input of runs triggered by the cron expressions.
+	CronInput *map[string]interface{} `json:"cronInput,omitempty"`
 
-	// Duration The duration of the task run, in milliseconds.
-	Duration *int `json:"duration,omitempty"`
+	// CronTriggers The cron expressions which trigger the workflow.
+	CronTriggers *[]string `json:"cronTriggers,omitempty"`
 
-	// ErrorMessage The error message of the task run (for the latest run)
-	ErrorMessage *string `json:"errorMessage,omitempty"`
+	// DefaultFilters The filters which are created for the workflow by default.
+	DefaultFilters *[]V1WorkflowDefinitionDefaultFilter `json:"defaultFilters,omitempty"`
 
-	// FinishedAt The timestamp the task run finished.
-	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// DefaultPriority The default priority of runs of the workflow.
+	DefaultPriority *int32 `json:"defaultPriority,omitempty"`
 
-	// Input The input of the task run.
-	Input    openapi.NonNullableJSON `json:"input"`
-	Metadata APIResourceMeta         `json:"metadata"`
+	// Description The description of the workflow.
+	Description *string `json:"description,omitempty"`
 
-	// Output The output of the task run (for the latest run)
-	Output               openapi.NonNullableJSON `json:"output"`
-	ParentTaskExternalId *openapi_types.UUID     `json:"parentTaskExternalId,omitempty"`
+	// EventTriggers The event keys which trigger the workflow.
+	EventTriggers *[]string `json:"eventTriggers,omitempty"`
 
-	// StartedAt The timestamp the task run started.
-	StartedAt *time.Time   `json:"startedAt,omitempty"`
-	Status    V1TaskStatus `json:"status"`
+	// InputJsonSchema The JSON schema of the input of the workflow.
+	InputJsonSchema *map[string]interface{} `json:"inputJsonSchema,omitempty"`
 
-	// TenantId The ID of the tenant.
-	TenantId   openapi_types.UUID `json:"tenantId"`
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+	// Name The name of the workflow.
+	Name          string                    `json:"name"`
+	OnFailureTask *V1WorkflowDefinitionTask `json:"onFailureTask,omitempty"`
 
-	// WorkflowVersionId The ID of the workflow version.
-	WorkflowVersionId *openapi_types.UUID `json:"workflowVersionId,omitempty"`
+	// Sticky The sticky strategy of the workflow.
+	Sticky *V1WorkflowDefinitionSticky `json:"sticky,omitempty"`
+	Tasks  []V1WorkflowDefinitionTask  `json:"tasks"`
+
+	// Version The version of the workflow.
+	Version *string `json:"version,omitempty"`
+}
+
+// V1WorkflowDefinitionSticky The sticky strategy of the workflow.
+type V1WorkflowDefinitionSticky string
+
+// V1WorkflowDefinitionChange defines model for V1WorkflowDefinitionChange.
+type V1WorkflowDefinitionChange struct {
+	// From The JSON encoded value before the change. Unset if the value was added.
+	From *string                        `json:"from,omitempty"`
+	Kind V1WorkflowDefinitionChangeKind `json:"kind"`
+
+	// Path The path of the changed value, for example `tasks[charge].retries`.
+	Path string `json:"path"`
+
+	// To The JSON encoded value after the change. Unset if the value was removed.
+	To *string `json:"to,omitempty"`
+}
+
+// V1WorkflowDefinitionChangeKind defines model for V1WorkflowDefinitionChangeKind.
+type V1WorkflowDefinitionChangeKind string
+
+// V1WorkflowDefinitionConcurrency defines model for V1WorkflowDefinitionConcurrency.
+type V1WorkflowDefinitionConcurrency struct {
+	// Expression The CEL expression which evaluates the concurrency key.
+	Expression string `json:"expression"`
+
+	// LimitStrategy The strategy used when the concurrency limit is reached.
+	LimitStrategy *string `json:"limitStrategy,omitempty"`
+
+	// MaxRuns The maximum number of concurrent runs for each key.
+	MaxRuns *int32 `json:"maxRuns,omitempty"`
+
+	// WeightExpression The CEL expression which evaluates the weight of a concurrency key.
+	WeightExpression *string `json:"weightExpression,omitempty"`
+}
+
+// V1WorkflowDefinitionDefaultFilter defines model for V1WorkflowDefinitionDefaultFilter.
+type V1WorkflowDefinitionDefaultFilter struct {
+	// Expression The CEL expression of the filter.
+	Expression string `json:"expression"`
+
+	// Payload The payload of the filter.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// Scope The scope of the filter.
+	Scope string `json:"scope"`
+}
+
+// V1WorkflowDefinitionDiff defines model for V1WorkflowDefinitionDiff.
+type V1WorkflowDefinitionDiff struct {
+	Changes []V1WorkflowDefinitionChange `json:"changes"`
+
+	// From A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically.
+	From V1WorkflowDefinition `json:"from"`
+
+	// To A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically.
+	To V1WorkflowDefinition `json:"to"`
+}
+
+// V1WorkflowDefinitionRateLimit defines model for V1WorkflowDefinitionRateLimit.
+type V1WorkflowDefinitionRateLimit struct {
+	// Duration The duration of the rate limit window.
+	Duration *string `json:"duration,omitempty"`
+
+	// Key The key of the rate limit.
+	Key *string `json:"key,omitempty"`
+
+	// KeyExpr The CEL expression which evaluates the key of the rate limit.
+	KeyExpr *string `json:"keyExpr,omitempty"`
+
+	// LimitExpr The CEL expression which evaluates the limit of the rate limit.
+	LimitExpr *string `json:"limitExpr,omitempty"`
+
+	// Units The number of units consumed.
+	Units *int `json:"units,omitempty"`
+
+	// UnitsExpr The CEL expression which evaluates the number of units consumed.
+	UnitsExpr *string `json:"unitsExpr,omitempty"`
+}
+
+// V1WorkflowDefinitionRetryRule defines model for V1WorkflowDefinitionRetryRule.
+type V1WorkflowDefinitionRetryRule struct {
+	// BackoffBaseSeconds The base delay between retries, in seconds.
+	BackoffBaseSeconds *float64 `json:"backoffBaseSeconds,omitempty"`
+
+	// BackoffStrategy The strategy used to compute the delay between retries.
+	BackoffStrategy *string `json:"backoffStrategy,omitempty"`
+
+	// ErrorType The error type the rule applies to.
+	ErrorType string `json:"errorType"`
+
+	// MaxRetries The number of times the task is retried for the error type.
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// V1WorkflowDefinitionTask defines model for V1WorkflowDefinitionTask.
+type V1WorkflowDefinitionTask struct {
+	// Action The action id of the task.
+	Action      string                             `json:"action"`
+	Concurrency *[]V1WorkflowDefinitionConcurrency `json:"concurrency,omitempty"`
+
+	// DesiredWorkerLabels The labels a worker should have to run the task, keyed by the label key.
+	DesiredWorkerLabels *map[string]V1WorkflowDefinitionWorkerLabel `json:"desiredWorkerLabels,omitempty"`
+
+	// IsDurable Whether the task is durable.
+	IsDurable *bool `json:"isDurable,omitempty"`
+
+	// Name The name of the task.
+	Name string `json:"name"`
+
+	// OutputJsonSchema The JSON schema of the output of the task.
+	OutputJsonSchema *map[string]interface{} `json:"outputJsonSchema,omitempty"`
+
+	// Parents The names of the tasks which this task depends on.
+	Parents    *[]string                        `json:"parents,omitempty"`
+	RateLimits *[]V1WorkflowDefinitionRateLimit `json:"rateLimits,omitempty"`
+
+	// Retries The number of times the task is retried.
+	Retries *int `json:"retries,omitempty"`
+
+	// RetryBackoffBaseSeconds The base delay between retries, in seconds.
+	RetryBackoffBaseSeconds *float64 `json:"retryBackoffBaseSeconds,omitempty"`
+
+	// RetryBackoffFactor The factor the delay between retries is multiplied by.
+	RetryBackoffFactor *float64 `json:"retryBackoffFactor,omitempty"`
+
+	// RetryBackoffMaxSeconds The maximum delay between retries, in seconds.
+	RetryBackoffMaxSeconds *int `json:"retryBackoffMaxSeconds,omitempty"`
+
+	// RetryBackoffStrategy The strategy used to compute the delay between retries.
+	RetryBackoffStrategy *string `json:"retryBackoffStrategy,omitempty"`
+
+	// RetryRules The retry rules of the task for specific error types.
+	RetryRules *[]V1WorkflowDefinitionRetryRule `json:"retryRules,omitempty"`
+
+	// ScheduleTimeout The scheduling timeout of the task.
+	ScheduleTimeout *string `json:"scheduleTimeout,omitempty"`
+
+	// SlotRequests The number of slots of each type the task uses.
+	SlotRequests *map[string]int32 `json:"slotRequests,omitempty"`
+
+	// Timeout The execution timeout of the task.
+	Timeout *string `json:"timeout,omitempty"`
+
+	// TriggerConditions The conditions the task waits for before it runs.
+	TriggerConditions *[]V1WorkflowDefinitionTriggerCondition `json:"triggerConditions,omitempty"`
+}
+
+// V1WorkflowDefinitionTriggerCondition defines model for V1WorkflowDefinitionTriggerCondition.
+type V1WorkflowDefinitionTriggerCondition struct {
+	// Action The action taken when the condition is met.
+	Action string `json:"action"`
+
+	// EventKey The event key of a user event condition.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Expression The CEL expression of the condition.
+	Expression *string `json:"expression,omitempty"`
+
+	// Kind The kind of the condition.
+	Kind string `json:"kind"`
+
+	// OrGroup The group of the condition. A group is satisfied when any of its conditions is met.
+	OrGroup int32 `json:"orGroup"`
+
+	// ParentName The name of the parent task of a parent override condition.
+	ParentName *string `json:"parentName,omitempty"`
+
+	// ReadableDataKey The key the data of the condition is stored under.
+	ReadableDataKey string `json:"readableDataKey"`
+
+	// SleepDuration The duration of a sleep condition.
+	SleepDuration *string `json:"sleepDuration,omitempty"`
+}
+
+// V1WorkflowDefinitionWorkerLabel defines model for V1WorkflowDefinitionWorkerLabel.
+type V1WorkflowDefinitionWorkerLabel struct {
+	// Comparator The comparator used to match the value of the label.
+	Comparator *string `json:"comparator,omitempty"`
+
+	// IntValue The integer value of the label.
+	IntValue *int32 `json:"intValue,omitempty"`
+
+	// Required Whether a worker must have the label to run the task.
+	Required *bool `json:"required,omitempty"`
+
+	// StrValue The string value of the label.
+	StrValue *string `json:"strValue,omitempty"`
+
+	// Weight The weight of the label when ranking workers.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// V1WorkflowRetentionPolicy defines model for V1WorkflowRetentionPolicy.
+type V1WorkflowRetentionPolicy struct {
+	// CancelledRetentionPeriod How long cancelled runs are kept for, which overrides the retention period.
+	CancelledRetentionPeriod *string `json:"cancelledRetentionPeriod,omitempty"`
+
+	// CompletedRetentionPeriod How long completed runs are kept for, which overrides the retention period.
+	CompletedRetentionPeriod *string `json:"completedRetentionPeriod,omitempty"`
+
+	// FailedRetentionPeriod How long failed runs are kept for, which overrides the retention period.
+	FailedRetentionPeriod *string         `json:"failedRetentionPeriod,omitempty"`
+	Metadata              APIResourceMeta `json:"metadata"`
+
+	// RetentionPeriod How long runs of the workflow are kept for, as a duration string (e.g. 24h).
+	RetentionPeriod string `json:"retentionPeriod"`
+
+	// TenantId The ID of the tenant associated with this retention policy.
+	TenantId string `json:"tenantId"`
+
+	// WorkflowId The workflow whose runs are kept for the retention period.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// V1WorkflowRetentionPolicyList defines model for V1WorkflowRetentionPolicyList.
+type V1WorkflowRetentionPolicyList struct {
+	Rows *[]V1WorkflowRetentionPolicy `json:"rows,omitempty"`
+}
+
+// V1WorkflowRun defines model for V1WorkflowRun.
+type V1WorkflowRun struct {
+	// AdditionalMetadata Additional metadata for the task run.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// CreatedAt The timestamp the task run was created.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DisplayName The display name of the task run.
+	DisplayName string `json:"displayName"`
+
+	// Duration The duration of the task run, in milliseconds.
+	Duration *int `json:"duration,omitempty"`
+
+	// ErrorMessage The error message of the task run (for the latest run)
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// FinishedAt The timestamp the task run finished.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Input The input of the task run.
+	Input    openapi.NonNullableJSON `json:"input"`
+	Metadata APIResourceMeta         `json:"metadata"`
+
+	// Output The output of the task run (for the latest run)
+	Output               openapi.NonNullableJSON `json:"output"`
+	ParentTaskExternalId *openapi_types.UUID     `json:"parentTaskExternalId,omitempty"`
+
+	// StartedAt The timestamp the task run started.
+	StartedAt *time.Time   `json:"startedAt,omitempty"`
+	Status    V1TaskStatus `json:"status"`
+
+	// TenantId The ID of the tenant.
+	TenantId   openapi_types.UUID `json:"tenantId"`
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowVersionId The ID of the workflow version.
+	WorkflowVersionId *openapi_types.UUID `json:"workflowVersionId,omitempty"`
 }
 
 // V1WorkflowRunDetails defines model for V1WorkflowRunDetails.
@@ -2421,6 +3243,9 @@ type Workflow struct {
 	// Description The description of the workflow.
 	Description *string `json:"description,omitempty"`
 
+	// InputValidationMode What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure.
+	InputValidationMode *WorkflowInputValidationMode `json:"inputValidationMode,omitempty"`
+
 	// IsPaused Whether the workflow is paused.
 	IsPaused *bool `json:"isPaused,omitempty"`
 
@@ -2452,6 +3277,9 @@ type WorkflowConcurrency struct {
 // WorkflowID A workflow ID.
 type WorkflowID = string
 
+// WorkflowInputValidationMode What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure.
+type WorkflowInputValidationMode string
+
 // WorkflowKind defines model for WorkflowKind.
 type WorkflowKind string
 
@@ -2605,6 +3433,9 @@ type WorkflowTriggers struct {
 
 // WorkflowUpdateRequest defines model for WorkflowUpdateRequest.
 type WorkflowUpdateRequest struct {
+	// InputValidationMode What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure.
+	InputValidationMode *WorkflowInputValidationMode `json:"inputValidationMode,omitempty"`
+
 	// IsPaused Whether the workflow is paused.
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
@@ -2701,6 +3532,27 @@ type V1TaskEventListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V1BulkOperationListParams defines parameters for V1BulkOperationList.
+type V1BulkOperationListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// V1DurableTaskPatchListParams defines parameters for V1DurableTaskPatchList.
+type V1DurableTaskPatchListParams struct {
+	// PatchId The id of the patch
+	PatchId string `form:"patchId" json:"patchId"`
+
+	// Offset The number of tasks to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number of tasks to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // V1DurableTaskEventLogListParams defines parameters for V1DurableTaskEventLogList.
 type V1DurableTaskEventLogListParams struct {
 	// Offset The number of event log entries to skip
@@ -2872,6 +3724,27 @@ type V1WebhookListParams struct {
 	WebhookNames *[]string `form:"webhookNames,omitempty" json:"webhookNames,omitempty"`
 }
 
+// V1WorkflowDefinitionDiffParams defines parameters for V1WorkflowDefinitionDiff.
+type V1WorkflowDefinitionDiffParams struct {
+	// Name The name of the workflow
+	Name string `form:"name" json:"name"`
+
+	// From The version to compare from, either a version string or a workflow version id
+	From string `form:"from" json:"from"`
+
+	// To The version to compare to, either a version string or a workflow version id. Defaults to the latest version.
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// V1WorkflowDefinitionExportParams defines parameters for V1WorkflowDefinitionExport.
+type V1WorkflowDefinitionExportParams struct {
+	// Name The name of the workflow
+	Name string `form:"name" json:"name"`
+
+	// Version The version of the workflow, either a version string or a workflow version id. Defaults to the latest version.
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+}
+
 // V1WorkflowRunListParams defines parameters for V1WorkflowRunList.
 type V1WorkflowRunListParams struct {
 	// Offset The number to skip
@@ -3112,6 +3985,15 @@ type CronWorkflowListParams struct {
 	OrderByDirection *WorkflowRunOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// WorkflowCronHistoryListParams defines parameters for WorkflowCronHistoryList.
+type WorkflowCronHistoryListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // WorkflowRunListParams defines parameters for WorkflowRunList.
 type WorkflowRunListParams struct {
 	// Offset The number to skip
@@ -3238,12 +4120,27 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// V1TaskQueryJSONRequestBody defines body for V1TaskQuery for application/json ContentType.
+type V1TaskQueryJSONRequestBody = V1QueryTaskRequest
+
+// V1TaskSignalJSONRequestBody defines body for V1TaskSignal for application/json ContentType.
+type V1TaskSignalJSONRequestBody = V1SignalTaskRequest
+
+// V1BulkOperationCreateJSONRequestBody defines body for V1BulkOperationCreate for application/json ContentType.
+type V1BulkOperationCreateJSONRequestBody = V1CreateBulkOperationRequest
+
 // V1CelDebugJSONRequestBody defines body for V1CelDebug for application/json ContentType.
 type V1CelDebugJSONRequestBody = V1CELDebugRequest
 
+// V1DeadLetterPolicyUpsertJSONRequestBody defines body for V1DeadLetterPolicyUpsert for application/json ContentType.
+type V1DeadLetterPolicyUpsertJSONRequestBody = V1UpsertDeadLetterPolicyRequest
+
 // V1DurableTaskBranchJSONRequestBody defines body for V1DurableTaskBranch for application/json ContentType.
 type V1DurableTaskBranchJSONRequestBody = V1BranchDurableTaskRequest
 
+// V1EventReplayJSONRequestBody defines body for V1EventReplay for application/json ContentType.
+type V1EventReplayJSONRequestBody = V1ReplayEventsRequest
+
 // V1FilterCreateJSONRequestBody defines body for V1FilterCreate for application/json ContentType.
 type V1FilterCreateJSONRequestBody = V1CreateFilterRequest
 
@@ -3262,9 +4159,15 @@ type V1WebhookCreateJSONRequestBody = V1CreateWebhookRequest
 // V1WebhookUpdateJSONRequestBody defines body for V1WebhookUpdate for application/json ContentType.
 type V1WebhookUpdateJSONRequestBody = V1UpdateWebhookRequest
 
+// V1WorkflowDefinitionImportJSONRequestBody defines body for V1WorkflowDefinitionImport for application/json ContentType.
+type V1WorkflowDefinitionImportJSONRequestBody = V1WorkflowDefinition
+
 // V1WorkflowRunCreateJSONRequestBody defines body for V1WorkflowRunCreate for application/json ContentType.
 type V1WorkflowRunCreateJSONRequestBody = V1TriggerWorkflowRunRequest
 
+// V1WorkflowRetentionPolicyUpsertJSONRequestBody defines body for V1WorkflowRetentionPolicyUpsert for application/json ContentType.
+type V1WorkflowRetentionPolicyUpsertJSONRequestBody = V1UpsertWorkflowRetentionPolicyRequest
+
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
@@ -3274,6 +4177,12 @@ type TenantUpdateJSONRequestBody = UpdateTenantRequest
 // AlertEmailGroupCreateJSONRequestBody defines body for AlertEmailGroupCreate for application/json ContentType.
 type AlertEmailGroupCreateJSONRequestBody = CreateTenantAlertEmailGroupRequest
 
+// AlertRuleCreateJSONRequestBody defines body for AlertRuleCreate for application/json ContentType.
+type AlertRuleCreateJSONRequestBody = CreateTenantAlertRuleRequest
+
+// AlertSinkCreateJSONRequestBody defines body for AlertSinkCreate for application/json ContentType.
+type AlertSinkCreateJSONRequestBody = CreateTenantAlertSinkRequest
+
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
@@ -3298,6 +4207,15 @@ type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 // TenantMemberUpdateJSONRequestBody defines body for TenantMemberUpdate for application/json ContentType.
 type TenantMemberUpdateJSONRequestBody = UpdateTenantMemberRequest
 
+// TenantRoleCreateJSONRequestBody defines body for TenantRoleCreate for application/json ContentType.
+type TenantRoleCreateJSONRequestBody = CreateTenantRoleRequest
+
+// TenantRoleUpdateJSONRequestBody defines body for TenantRoleUpdate for application/json ContentType.
+type TenantRoleUpdateJSONRequestBody = UpdateTenantRoleRequest
+
+// ScopedResourceLimitUpsertJSONRequestBody defines body for ScopedResourceLimitUpsert for application/json ContentType.
+type ScopedResourceLimitUpsertJSONRequestBody = UpsertScopedResourceLimitRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
@@ -3457,6 +4375,12 @@ type ServerInterface interface {
 	// Update tenant alert email group
 	// (PATCH /api/v1/alerting-email-groups/{alert-email-group})
 	AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error
+	// Delete tenant alert rule
+	// (DELETE /api/v1/alerting-rules/{alert-rule})
+	AlertRuleDelete(ctx echo.Context, alertRule openapi_types.UUID) error
+	// Delete tenant alert sink
+	// (DELETE /api/v1/alerting-sinks/{alert-sink})
+	AlertSinkDelete(ctx echo.Context, alertSink openapi_types.UUID) error
 	// Revoke API Token
 	// (POST /api/v1/api-tokens/{api-token})
 	ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error
@@ -3478,6 +4402,9 @@ type ServerInterface interface {
 	// Detailed Health Probe For the Instance
 	// (POST /api/v1/monitoring/{tenant}/probe)
 	MonitoringPostRunProbe(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete scoped resource limit
+	// (DELETE /api/v1/scoped-resource-limits/{scoped-resource-limit})
+	ScopedResourceLimitDelete(ctx echo.Context, scopedResourceLimit openapi_types.UUID) error
 	// Delete Slack webhook
 	// (DELETE /api/v1/slack/{slack})
 	SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error
@@ -3496,18 +4423,48 @@ type ServerInterface interface {
 	// List log lines
 	// (GET /api/v1/stable/tasks/{task}/logs)
 	V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error
+	// Query a task
+	// (POST /api/v1/stable/tasks/{task}/query)
+	V1TaskQuery(ctx echo.Context, task openapi_types.UUID) error
 	// Restore a task
 	// (POST /api/v1/stable/tasks/{task}/restore)
 	V1TaskRestore(ctx echo.Context, task openapi_types.UUID) error
+	// Signal a task
+	// (POST /api/v1/stable/tasks/{task}/signal)
+	V1TaskSignal(ctx echo.Context, task openapi_types.UUID) error
 	// List events for a task
 	// (GET /api/v1/stable/tasks/{task}/task-events)
 	V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error
+	// List bulk operations
+	// (GET /api/v1/stable/tenants/{tenant}/bulk-operations)
+	V1BulkOperationList(ctx echo.Context, tenant openapi_types.UUID, params V1BulkOperationListParams) error
+	// Create a bulk operation
+	// (POST /api/v1/stable/tenants/{tenant}/bulk-operations)
+	V1BulkOperationCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Get a bulk operation
+	// (GET /api/v1/stable/tenants/{tenant}/bulk-operations/{v1-bulk-operation})
+	V1BulkOperationGet(ctx echo.Context, tenant openapi_types.UUID, v1BulkOperation openapi_types.UUID) error
+	// Cancel a bulk operation
+	// (POST /api/v1/stable/tenants/{tenant}/bulk-operations/{v1-bulk-operation}/cancel)
+	V1BulkOperationCancel(ctx echo.Context, tenant openapi_types.UUID, v1BulkOperation openapi_types.UUID) error
 	// Debug a CEL expression
 	// (POST /api/v1/stable/tenants/{tenant}/cel/debug)
 	V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error
+	// List dead-letter policies
+	// (GET /api/v1/stable/tenants/{tenant}/dead-letter-policies)
+	V1DeadLetterPolicyList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Set a dead-letter policy
+	// (PUT /api/v1/stable/tenants/{tenant}/dead-letter-policies)
+	V1DeadLetterPolicyUpsert(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete a dead-letter policy
+	// (DELETE /api/v1/stable/tenants/{tenant}/dead-letter-policies/{v1-dead-letter-policy})
+	V1DeadLetterPolicyDelete(ctx echo.Context, tenant openapi_types.UUID, v1DeadLetterPolicy openapi_types.UUID) error
 	// Branch durable task
 	// (POST /api/v1/stable/tenants/{tenant}/durable-tasks/branch)
 	V1DurableTaskBranch(ctx echo.Context, tenant openapi_types.UUID) error
+	// List durable tasks on a patch marker
+	// (GET /api/v1/stable/tenants/{tenant}/durable-tasks/patches)
+	V1DurableTaskPatchList(ctx echo.Context, tenant openapi_types.UUID, params V1DurableTaskPatchListParams) error
 	// List durable event log
 	// (GET /api/v1/stable/tenants/{tenant}/durable-tasks/{durable-task})
 	V1DurableTaskEventLogList(ctx echo.Context, tenant openapi_types.UUID, durableTask openapi_types.UUID, params V1DurableTaskEventLogListParams) error
@@ -3517,6 +4474,9 @@ type ServerInterface interface {
 	// List event keys
 	// (GET /api/v1/stable/tenants/{tenant}/events/keys)
 	V1EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Replay events
+	// (POST /api/v1/stable/tenants/{tenant}/events/replay)
+	V1EventReplay(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get events
 	// (GET /api/v1/stable/tenants/{tenant}/events/{v1-event})
 	V1EventGet(ctx echo.Context, tenant openapi_types.UUID, v1Event openapi_types.UUID) error
@@ -3574,6 +4534,18 @@ type ServerInterface interface {
 	// Post a webhook message
 	// (POST /api/v1/stable/tenants/{tenant}/webhooks/{v1-webhook})
 	V1WebhookReceive(ctx echo.Context, tenant openapi_types.UUID, v1Webhook string) error
+	// Diff workflow definitions
+	// (GET /api/v1/stable/tenants/{tenant}/workflow-definitions/diff)
+	V1WorkflowDefinitionDiff(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowDefinitionDiffParams) error
+	// Export a workflow definition
+	// (GET /api/v1/stable/tenants/{tenant}/workflow-definitions/export)
+	V1WorkflowDefinitionExport(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowDefinitionExportParams) error
+	// Import a workflow definition
+	// (POST /api/v1/stable/tenants/{tenant}/workflow-definitions/import)
+	V1WorkflowDefinitionImport(ctx echo.Context, tenant openapi_types.UUID) error
+	// List workflow retention policies
+	// (GET /api/v1/stable/tenants/{tenant}/workflow-retention-policies)
+	V1WorkflowRetentionPolicyList(ctx echo.Context, tenant openapi_types.UUID) error
 	// List workflow runs
 	// (GET /api/v1/stable/tenants/{tenant}/workflow-runs)
 	V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error
@@ -3586,6 +4558,15 @@ type ServerInterface interface {
 	// Create workflow run
 	// (POST /api/v1/stable/tenants/{tenant}/workflow-runs/trigger)
 	V1WorkflowRunCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete a workflow retention policy
+	// (DELETE /api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy)
+	V1WorkflowRetentionPolicyDelete(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error
+	// Get a workflow retention policy
+	// (GET /api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy)
+	V1WorkflowRetentionPolicyGet(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error
+	// Set a workflow retention policy
+	// (PUT /api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy)
+	V1WorkflowRetentionPolicyUpsert(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error
 	// List tasks
 	// (GET /api/v1/stable/workflow-runs/{v1-workflow-run})
 	V1WorkflowRunGet(ctx echo.Context, v1WorkflowRun openapi_types.UUID) error
@@ -3619,6 +4600,18 @@ type ServerInterface interface {
 	// Create tenant alert email group
 	// (POST /api/v1/tenants/{tenant}/alerting-email-groups)
 	AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant alert rules
+	// (GET /api/v1/tenants/{tenant}/alerting-rules)
+	AlertRuleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant alert rule
+	// (POST /api/v1/tenants/{tenant}/alerting-rules)
+	AlertRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant alert sinks
+	// (GET /api/v1/tenants/{tenant}/alerting-sinks)
+	AlertSinkList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant alert sink
+	// (POST /api/v1/tenants/{tenant}/alerting-sinks)
+	AlertSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get tenant alerting settings
 	// (GET /api/v1/tenants/{tenant}/alerting/settings)
 	TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error
@@ -3688,6 +4681,24 @@ type ServerInterface interface {
 	// Create tenant alert email group
 	// (GET /api/v1/tenants/{tenant}/resource-policy)
 	TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant roles
+	// (GET /api/v1/tenants/{tenant}/roles)
+	TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant role
+	// (POST /api/v1/tenants/{tenant}/roles)
+	TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete tenant role
+	// (DELETE /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
+	// Update tenant role
+	// (PATCH /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
+	// List scoped resource limits
+	// (GET /api/v1/tenants/{tenant}/scoped-resource-limits)
+	ScopedResourceLimitList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Upsert scoped resource limit
+	// (POST /api/v1/tenants/{tenant}/scoped-resource-limits)
+	ScopedResourceLimitUpsert(ctx echo.Context, tenant openapi_types.UUID) error
 	// List Slack integrations
 	// (GET /api/v1/tenants/{tenant}/slack)
 	SlackWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -3763,6 +4774,9 @@ type ServerInterface interface {
 	// Trigger cron job workflow run immediately
 	// (POST /api/v1/tenants/{tenant}/workflows/crons/{cron-workflow})
 	WorkflowCronTrigger(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) error
+	// List cron job fires
+	// (GET /api/v1/tenants/{tenant}/workflows/crons/{cron-workflow}/history)
+	WorkflowCronHistoryList(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, params WorkflowCronHistoryListParams) error
 	// Get workflow runs
 	// (GET /api/v1/tenants/{tenant}/workflows/runs)
 	WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error
@@ -3832,6 +4846,12 @@ type ServerInterface interface {
 	// List tenant memberships
 	// (GET /api/v1/users/memberships)
 	TenantMembershipsList(ctx echo.Context) error
+	// Complete OAuth flow
+	// (GET /api/v1/users/oidc/callback)
+	UserUpdateOidcOauthCallback(ctx echo.Context) error
+	// Start OAuth flow
+	// (GET /api/v1/users/oidc/start)
+	UserUpdateOidcOauthStart(ctx echo.Context) error
 	// Change user password
 	// (POST /api/v1/users/password)
 	UserUpdatePassword(ctx echo.Context) error
@@ -3939,6 +4959,46 @@ func (w *ServerInterfaceWrapper) AlertEmailGroupUpdate(ctx echo.Context) error {
 	return err
 }
 
+// AlertRuleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertRuleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "alert-rule" -------------
+	var alertRule openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "alert-rule", runtime.ParamLocationPath, ctx.Param("alert-rule"), &alertRule)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alert-rule: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertRuleDelete(ctx, alertRule)
+	return err
+}
+
+// AlertSinkDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertSinkDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "alert-sink" -------------
+	var alertSink openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "alert-sink", runtime.ParamLocationPath, ctx.Param("alert-sink"), &alertSink)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alert-sink: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertSinkDelete(ctx, alertSink)
+	return err
+}
+
 // ApiTokenUpdateRevoke converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
 	var err error
@@ -4050,6 +5110,26 @@ func (w *ServerInterfaceWrapper) MonitoringPostRunProbe(ctx echo.Context) error
 	return err
 }
 
+// ScopedResourceLimitDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ScopedResourceLimitDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scoped-resource-limit" -------------
+	var scopedResourceLimit openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scoped-resource-limit", ctx.Param("scoped-resource-limit"), &scopedResourceLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scoped-resource-limit: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScopedResourceLimitDelete(ctx, scopedResourceLimit)
+	return err
+}
+
 // SlackWebhookDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) SlackWebhookDelete(ctx echo.Context) error {
 	var err error
@@ -4243,6 +5323,26 @@ func (w *ServerInterfaceWrapper) V1LogLineList(ctx echo.Context) error {
 	return err
 }
 
+// V1TaskQuery converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskQuery(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task" -------------
+	var task openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task", ctx.Param("task"), &task, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskQuery(ctx, task)
+	return err
+}
+
 // V1TaskRestore converts echo context to params.
 func (w *ServerInterfaceWrapper) V1TaskRestore(ctx echo.Context) error {
 	var err error
@@ -4263,6 +5363,26 @@ func (w *ServerInterfaceWrapper) V1TaskRestore(ctx echo.Context) error {
 	return err
 }
 
+// V1TaskSignal converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskSignal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task" -------------
+	var task openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task", ctx.Param("task"), &task, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskSignal(ctx, task)
+	return err
+}
+
 // V1TaskEventList converts echo context to params.
 func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	var err error
@@ -4299,8 +5419,8 @@ func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	return err
 }
 
-// V1CelDebug converts echo context to params.
-func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
+// V1BulkOperationList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkOperationList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4314,20 +5434,200 @@ func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1CelDebug(ctx, tenant)
-	return err
-}
-
-// V1DurableTaskBranch converts echo context to params.
-func (w *ServerInterfaceWrapper) V1DurableTaskBranch(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1BulkOperationListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkOperationList(ctx, tenant, params)
+	return err
+}
+
+// V1BulkOperationCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkOperationCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkOperationCreate(ctx, tenant)
+	return err
+}
+
+// V1BulkOperationGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkOperationGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-bulk-operation" -------------
+	var v1BulkOperation openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-bulk-operation", runtime.ParamLocationPath, ctx.Param("v1-bulk-operation"), &v1BulkOperation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-bulk-operation: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkOperationGet(ctx, tenant, v1BulkOperation)
+	return err
+}
+
+// V1BulkOperationCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkOperationCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-bulk-operation" -------------
+	var v1BulkOperation openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-bulk-operation", runtime.ParamLocationPath, ctx.Param("v1-bulk-operation"), &v1BulkOperation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-bulk-operation: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkOperationCancel(ctx, tenant, v1BulkOperation)
+	return err
+}
+
+// V1CelDebug converts echo context to params.
+func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1CelDebug(ctx, tenant)
+	return err
+}
+
+// V1DeadLetterPolicyList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DeadLetterPolicyList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1DeadLetterPolicyList(ctx, tenant)
+	return err
+}
+
+// V1DeadLetterPolicyUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DeadLetterPolicyUpsert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1DeadLetterPolicyUpsert(ctx, tenant)
+	return err
+}
+
+// V1DeadLetterPolicyDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DeadLetterPolicyDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-dead-letter-policy" -------------
+	var v1DeadLetterPolicy openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-dead-letter-policy", runtime.ParamLocationPath, ctx.Param("v1-dead-letter-policy"), &v1DeadLetterPolicy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-dead-letter-policy: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1DeadLetterPolicyDelete(ctx, tenant, v1DeadLetterPolicy)
+	return err
+}
+
+// V1DurableTaskBranch converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DurableTaskBranch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4339,6 +5639,49 @@ func (w *ServerInterfaceWrapper) V1DurableTaskBranch(ctx echo.Context) error {
 	return err
 }
 
+// V1DurableTaskPatchList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DurableTaskPatchList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1DurableTaskPatchListParams
+	// ------------- Required query parameter "patchId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "patchId", ctx.QueryParams(), &params.PatchId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter patchId: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1DurableTaskPatchList(ctx, tenant, params)
+	return err
+}
+
 // V1DurableTaskEventLogList converts echo context to params.
 func (w *ServerInterfaceWrapper) V1DurableTaskEventLogList(ctx echo.Context) error {
 	var err error
@@ -4495,6 +5838,26 @@ func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
 	return err
 }
 
+// V1EventReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1EventReplay(ctx, tenant)
+	return err
+}
+
 // V1EventGet converts echo context to params.
 func (w *ServerInterfaceWrapper) V1EventGet(ctx echo.Context) error {
 	var err error
@@ -5201,13 +6564,13 @@ func (w *ServerInterfaceWrapper) V1WebhookReceive(ctx echo.Context) error {
 	return err
 }
 
-// V1WorkflowRunList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
+// V1WorkflowDefinitionDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowDefinitionDiff(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
@@ -5217,64 +6580,183 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1WorkflowRunListParams
-	// ------------- Optional query parameter "offset" -------------
+	var params V1WorkflowDefinitionDiffParams
+	// ------------- Required query parameter "name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	// ------------- Optional query parameter "statuses" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	// ------------- Required query parameter "since" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowDefinitionDiff(ctx, tenant, params)
+	return err
+}
 
-	// ------------- Optional query parameter "until" -------------
+// V1WorkflowDefinitionExport converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowDefinitionExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "additional_metadata" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "additional_metadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additional_metadata: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "workflow_ids" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowDefinitionExportParams
+	// ------------- Required query parameter "name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "worker_id" -------------
+	// ------------- Optional query parameter "version" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "worker_id", ctx.QueryParams(), &params.WorkerId)
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
-	// ------------- Required query parameter "only_tasks" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowDefinitionExport(ctx, tenant, params)
+	return err
+}
+
+// V1WorkflowDefinitionImport converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowDefinitionImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowDefinitionImport(ctx, tenant)
+	return err
+}
+
+// V1WorkflowRetentionPolicyList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRetentionPolicyList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRetentionPolicyList(ctx, tenant)
+	return err
+}
+
+// V1WorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Required query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "additional_metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additional_metadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additional_metadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflow_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "worker_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "worker_id", ctx.QueryParams(), &params.WorkerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	// ------------- Required query parameter "only_tasks" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "only_tasks", ctx.QueryParams(), &params.OnlyTasks)
 	if err != nil {
@@ -5427,6 +6909,90 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunCreate(ctx echo.Context) error {
 	return err
 }
 
+// V1WorkflowRetentionPolicyDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRetentionPolicyDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow", ctx.Param("workflow"), &workflow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRetentionPolicyDelete(ctx, tenant, workflow)
+	return err
+}
+
+// V1WorkflowRetentionPolicyGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRetentionPolicyGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow", ctx.Param("workflow"), &workflow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRetentionPolicyGet(ctx, tenant, workflow)
+	return err
+}
+
+// V1WorkflowRetentionPolicyUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRetentionPolicyUpsert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow", ctx.Param("workflow"), &workflow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRetentionPolicyUpsert(ctx, tenant, workflow)
+	return err
+}
+
 // V1WorkflowRunGet converts echo context to params.
 func (w *ServerInterfaceWrapper) V1WorkflowRunGet(ctx echo.Context) error {
 	var err error
@@ -5697,6 +7263,86 @@ func (w *ServerInterfaceWrapper) AlertEmailGroupCreate(ctx echo.Context) error {
 	return err
 }
 
+// AlertRuleList converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertRuleList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertRuleList(ctx, tenant)
+	return err
+}
+
+// AlertRuleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertRuleCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertRuleCreate(ctx, tenant)
+	return err
+}
+
+// AlertSinkList converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertSinkList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertSinkList(ctx, tenant)
+	return err
+}
+
+// AlertSinkCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertSinkCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertSinkCreate(ctx, tenant)
+	return err
+}
+
 // TenantAlertingSettingsGet converts echo context to params.
 func (w *ServerInterfaceWrapper) TenantAlertingSettingsGet(ctx echo.Context) error {
 	var err error
@@ -6347,8 +7993,8 @@ func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error
 	return err
 }
 
-// SlackWebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+// TenantRoleList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -6363,12 +8009,12 @@ func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SlackWebhookList(ctx, tenant)
+	err = w.Handler.TenantRoleList(ctx, tenant)
 	return err
 }
 
-// UserUpdateSlackOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+// TenantRoleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -6378,14 +8024,150 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	err = w.Handler.TenantRoleCreate(ctx, tenant)
 	return err
 }
 
-// SnsList converts echo context to params.
+// TenantRoleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantRoleDelete(ctx, tenant, tenantRole)
+	return err
+}
+
+// TenantRoleUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantRoleUpdate(ctx, tenant, tenantRole)
+	return err
+}
+
+// ScopedResourceLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) ScopedResourceLimitList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScopedResourceLimitList(ctx, tenant)
+	return err
+}
+
+// ScopedResourceLimitUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) ScopedResourceLimitUpsert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScopedResourceLimitUpsert(ctx, tenant)
+	return err
+}
+
+// SlackWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SlackWebhookList(ctx, tenant)
+	return err
+}
+
+// UserUpdateSlackOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	return err
+}
+
+// SnsList converts echo context to params.
 func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
@@ -7063,6 +8845,50 @@ func (w *ServerInterfaceWrapper) WorkflowCronTrigger(ctx echo.Context) error {
 	return err
 }
 
+// WorkflowCronHistoryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronHistoryList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowCronHistoryListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowCronHistoryList(ctx, tenant, cronWorkflow, params)
+	return err
+}
+
 // WorkflowRunList converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	var err error
@@ -7697,6 +9523,24 @@ func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
 	return err
 }
 
+// UserUpdateOidcOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateOidcOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcOauthStart(ctx)
+	return err
+}
+
 // UserUpdatePassword converts echo context to params.
 func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
 	var err error
@@ -8003,6 +9847,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
 	router.DELETE(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupDelete)
 	router.PATCH(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupUpdate)
+	router.DELETE(baseURL+"/api/v1/alerting-rules/:alert-rule", wrapper.AlertRuleDelete)
+	router.DELETE(baseURL+"/api/v1/alerting-sinks/:alert-sink", wrapper.AlertSinkDelete)
 	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
 	router.GET(baseURL+"/api/v1/cloud/metadata", wrapper.CloudMetadataGet)
 	router.GET(baseURL+"/api/v1/events/:event", wrapper.EventGet)
@@ -8010,19 +9856,31 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
 	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
 	router.POST(baseURL+"/api/v1/monitoring/:tenant/probe", wrapper.MonitoringPostRunProbe)
+	router.DELETE(baseURL+"/api/v1/scoped-resource-limits/:scoped-resource-limit", wrapper.ScopedResourceLimitDelete)
 	router.DELETE(baseURL+"/api/v1/slack/:slack", wrapper.SlackWebhookDelete)
 	router.DELETE(baseURL+"/api/v1/sns/:sns", wrapper.SnsDelete)
 	router.POST(baseURL+"/api/v1/sns/:tenant/:event", wrapper.SnsUpdate)
 	router.GET(baseURL+"/api/v1/stable/dags/tasks", wrapper.V1DagListTasks)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task", wrapper.V1TaskGet)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/logs", wrapper.V1LogLineList)
+	router.POST(baseURL+"/api/v1/stable/tasks/:task/query", wrapper.V1TaskQuery)
 	router.POST(baseURL+"/api/v1/stable/tasks/:task/restore", wrapper.V1TaskRestore)
+	router.POST(baseURL+"/api/v1/stable/tasks/:task/signal", wrapper.V1TaskSignal)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/task-events", wrapper.V1TaskEventList)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/bulk-operations", wrapper.V1BulkOperationList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bulk-operations", wrapper.V1BulkOperationCreate)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/bulk-operations/:v1-bulk-operation", wrapper.V1BulkOperationGet)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bulk-operations/:v1-bulk-operation/cancel", wrapper.V1BulkOperationCancel)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/cel/debug", wrapper.V1CelDebug)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/dead-letter-policies", wrapper.V1DeadLetterPolicyList)
+	router.PUT(baseURL+"/api/v1/stable/tenants/:tenant/dead-letter-policies", wrapper.V1DeadLetterPolicyUpsert)
+	router.DELETE(baseURL+"/api/v1/stable/tenants/:tenant/dead-letter-policies/:v1-dead-letter-policy", wrapper.V1DeadLetterPolicyDelete)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/durable-tasks/branch", wrapper.V1DurableTaskBranch)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/durable-tasks/patches", wrapper.V1DurableTaskPatchList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/durable-tasks/:durable-task", wrapper.V1DurableTaskEventLogList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events", wrapper.V1EventList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events/keys", wrapper.V1EventKeyList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/events/replay", wrapper.V1EventReplay)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events/:v1-event", wrapper.V1EventGet)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/filters", wrapper.V1FilterList)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/filters", wrapper.V1FilterCreate)
@@ -8042,10 +9900,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookGet)
 	router.PATCH(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookUpdate)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookReceive)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-definitions/diff", wrapper.V1WorkflowDefinitionDiff)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-definitions/export", wrapper.V1WorkflowDefinitionExport)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-definitions/import", wrapper.V1WorkflowDefinitionImport)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-retention-policies", wrapper.V1WorkflowRetentionPolicyList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs", wrapper.V1WorkflowRunList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/display-names", wrapper.V1WorkflowRunDisplayNamesList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/external-ids", wrapper.V1WorkflowRunExternalIdsList)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/trigger", wrapper.V1WorkflowRunCreate)
+	router.DELETE(baseURL+"/api/v1/stable/tenants/:tenant/workflows/:workflow/retention-policy", wrapper.V1WorkflowRetentionPolicyDelete)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflows/:workflow/retention-policy", wrapper.V1WorkflowRetentionPolicyGet)
+	router.PUT(baseURL+"/api/v1/stable/tenants/:tenant/workflows/:workflow/retention-policy", wrapper.V1WorkflowRetentionPolicyUpsert)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run", wrapper.V1WorkflowRunGet)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run/status", wrapper.V1WorkflowRunGetStatus)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run/task-events", wrapper.V1WorkflowRunTaskEventsList)
@@ -8057,6 +9922,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-rules", wrapper.AlertRuleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-rules", wrapper.AlertRuleCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-sinks", wrapper.AlertSinkList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-sinks", wrapper.AlertSinkCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting/settings", wrapper.TenantAlertingSettingsGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
@@ -8080,6 +9949,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitDelete)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyGet)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/scoped-resource-limits", wrapper.ScopedResourceLimitList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/scoped-resource-limits", wrapper.ScopedResourceLimitUpsert)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack", wrapper.SlackWebhookList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack/start", wrapper.UserUpdateSlackOauthStart)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
@@ -8105,6 +9980,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronGet)
 	router.PATCH(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronUpdate)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronTrigger)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow/history", wrapper.WorkflowCronHistoryList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs", wrapper.WorkflowRunList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs/metrics", wrapper.WorkflowRunGetMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled", wrapper.WorkflowScheduledList)
@@ -8128,6 +10004,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
 	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
 	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
+	router.GET(baseURL+"/api/v1/users/oidc/callback", wrapper.UserUpdateOidcOauthCallback)
+	router.GET(baseURL+"/api/v1/users/oidc/start", wrapper.UserUpdateOidcOauthStart)
 	router.POST(baseURL+"/api/v1/users/password", wrapper.UserUpdatePassword)
 	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
 	router.GET(baseURL+"/api/v1/users/slack/callback", wrapper.UserUpdateSlackOauthCallback)
@@ -8263,6 +10141,74 @@ func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type AlertRuleDeleteRequestObject struct {
+	AlertRule openapi_types.UUID `json:"alert-rule"`
+}
+
+type AlertRuleDeleteResponseObject interface {
+	VisitAlertRuleDeleteResponse(w http.ResponseWriter) error
+}
+
+type AlertRuleDelete204Response struct {
+}
+
+func (response AlertRuleDelete204Response) VisitAlertRuleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AlertRuleDelete400JSONResponse APIErrors
+
+func (response AlertRuleDelete400JSONResponse) VisitAlertRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleDelete403JSONResponse APIError
+
+func (response AlertRuleDelete403JSONResponse) VisitAlertRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkDeleteRequestObject struct {
+	AlertSink openapi_types.UUID `json:"alert-sink"`
+}
+
+type AlertSinkDeleteResponseObject interface {
+	VisitAlertSinkDeleteResponse(w http.ResponseWriter) error
+}
+
+type AlertSinkDelete204Response struct {
+}
+
+func (response AlertSinkDelete204Response) VisitAlertSinkDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AlertSinkDelete400JSONResponse APIErrors
+
+func (response AlertSinkDelete400JSONResponse) VisitAlertSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkDelete403JSONResponse APIError
+
+func (response AlertSinkDelete403JSONResponse) VisitAlertSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type ApiTokenUpdateRevokeRequestObject struct {
 	ApiToken openapi_types.UUID `json:"api-token"`
 }
@@ -8467,6 +10413,40 @@ func (response MonitoringPostRunProbe403JSONResponse) VisitMonitoringPostRunProb
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ScopedResourceLimitDeleteRequestObject struct {
+	ScopedResourceLimit openapi_types.UUID `json:"scoped-resource-limit"`
+}
+
+type ScopedResourceLimitDeleteResponseObject interface {
+	VisitScopedResourceLimitDeleteResponse(w http.ResponseWriter) error
+}
+
+type ScopedResourceLimitDelete204Response struct {
+}
+
+func (response ScopedResourceLimitDelete204Response) VisitScopedResourceLimitDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ScopedResourceLimitDelete400JSONResponse APIErrors
+
+func (response ScopedResourceLimitDelete400JSONResponse) VisitScopedResourceLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitDelete403JSONResponse APIError
+
+func (response ScopedResourceLimitDelete403JSONResponse) VisitScopedResourceLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SlackWebhookDeleteRequestObject struct {
 	Slack openapi_types.UUID `json:"slack"`
 }
@@ -8731,58 +10711,148 @@ func (response V1LogLineList403JSONResponse) VisitV1LogLineListResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskRestoreRequestObject struct {
+type V1TaskQueryRequestObject struct {
 	Task openapi_types.UUID `json:"task"`
+	Body *V1TaskQueryJSONRequestBody
 }
 
-type V1TaskRestoreResponseObject interface {
-	VisitV1TaskRestoreResponse(w http.ResponseWriter) error
+type V1TaskQueryResponseObject interface {
+	VisitV1TaskQueryResponse(w http.ResponseWriter) error
 }
 
-type V1TaskRestore200JSONResponse V1RestoreTaskResponse
+type V1TaskQuery200JSONResponse V1QueryTaskResponse
 
-func (response V1TaskRestore200JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+func (response V1TaskQuery200JSONResponse) VisitV1TaskQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskRestore400JSONResponse APIErrors
+type V1TaskQuery400JSONResponse APIErrors
 
-func (response V1TaskRestore400JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+func (response V1TaskQuery400JSONResponse) VisitV1TaskQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskRestore403JSONResponse APIErrors
+type V1TaskQuery403JSONResponse APIErrors
 
-func (response V1TaskRestore403JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+func (response V1TaskQuery403JSONResponse) VisitV1TaskQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskRestore404JSONResponse APIErrors
+type V1TaskQuery404JSONResponse APIErrors
 
-func (response V1TaskRestore404JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+func (response V1TaskQuery404JSONResponse) VisitV1TaskQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventListRequestObject struct {
-	Task   openapi_types.UUID `json:"task"`
-	Params V1TaskEventListParams
+type V1TaskRestoreRequestObject struct {
+	Task openapi_types.UUID `json:"task"`
 }
 
-type V1TaskEventListResponseObject interface {
-	VisitV1TaskEventListResponse(w http.ResponseWriter) error
-}
+type V1TaskRestoreResponseObject interface {
+	VisitV1TaskRestoreResponse(w http.ResponseWriter) error
+}
+
+type V1TaskRestore200JSONResponse V1RestoreTaskResponse
+
+func (response V1TaskRestore200JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskRestore400JSONResponse APIErrors
+
+func (response V1TaskRestore400JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskRestore403JSONResponse APIErrors
+
+func (response V1TaskRestore403JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskRestore404JSONResponse APIErrors
+
+func (response V1TaskRestore404JSONResponse) VisitV1TaskRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskSignalRequestObject struct {
+	Task openapi_types.UUID `json:"task"`
+	Body *V1TaskSignalJSONRequestBody
+}
+
+type V1TaskSignalResponseObject interface {
+	VisitV1TaskSignalResponse(w http.ResponseWriter) error
+}
+
+type V1TaskSignal200JSONResponse V1SignalTaskResponse
+
+func (response V1TaskSignal200JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskSignal400JSONResponse APIErrors
+
+func (response V1TaskSignal400JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskSignal403JSONResponse APIErrors
+
+func (response V1TaskSignal403JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskSignal404JSONResponse APIErrors
+
+func (response V1TaskSignal404JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskEventListRequestObject struct {
+	Task   openapi_types.UUID `json:"task"`
+	Params V1TaskEventListParams
+}
+
+type V1TaskEventListResponseObject interface {
+	VisitV1TaskEventListResponse(w http.ResponseWriter) error
+}
 
 type V1TaskEventList200JSONResponse V1TaskEventList
 
@@ -8829,6 +10899,168 @@ func (response V1TaskEventList501JSONResponse) VisitV1TaskEventListResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1BulkOperationListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1BulkOperationListParams
+}
+
+type V1BulkOperationListResponseObject interface {
+	VisitV1BulkOperationListResponse(w http.ResponseWriter) error
+}
+
+type V1BulkOperationList200JSONResponse V1BulkOperationList
+
+func (response V1BulkOperationList200JSONResponse) VisitV1BulkOperationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationList400JSONResponse APIErrors
+
+func (response V1BulkOperationList400JSONResponse) VisitV1BulkOperationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationList403JSONResponse APIErrors
+
+func (response V1BulkOperationList403JSONResponse) VisitV1BulkOperationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1BulkOperationCreateJSONRequestBody
+}
+
+type V1BulkOperationCreateResponseObject interface {
+	VisitV1BulkOperationCreateResponse(w http.ResponseWriter) error
+}
+
+type V1BulkOperationCreate200JSONResponse V1BulkOperation
+
+func (response V1BulkOperationCreate200JSONResponse) VisitV1BulkOperationCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCreate400JSONResponse APIErrors
+
+func (response V1BulkOperationCreate400JSONResponse) VisitV1BulkOperationCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCreate403JSONResponse APIErrors
+
+func (response V1BulkOperationCreate403JSONResponse) VisitV1BulkOperationCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationGetRequestObject struct {
+	Tenant          openapi_types.UUID `json:"tenant"`
+	V1BulkOperation openapi_types.UUID `json:"v1-bulk-operation"`
+}
+
+type V1BulkOperationGetResponseObject interface {
+	VisitV1BulkOperationGetResponse(w http.ResponseWriter) error
+}
+
+type V1BulkOperationGet200JSONResponse V1BulkOperation
+
+func (response V1BulkOperationGet200JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationGet400JSONResponse APIErrors
+
+func (response V1BulkOperationGet400JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationGet403JSONResponse APIErrors
+
+func (response V1BulkOperationGet403JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationGet404JSONResponse APIErrors
+
+func (response V1BulkOperationGet404JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCancelRequestObject struct {
+	Tenant          openapi_types.UUID `json:"tenant"`
+	V1BulkOperation openapi_types.UUID `json:"v1-bulk-operation"`
+}
+
+type V1BulkOperationCancelResponseObject interface {
+	VisitV1BulkOperationCancelResponse(w http.ResponseWriter) error
+}
+
+type V1BulkOperationCancel200JSONResponse V1BulkOperation
+
+func (response V1BulkOperationCancel200JSONResponse) VisitV1BulkOperationCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCancel400JSONResponse APIErrors
+
+func (response V1BulkOperationCancel400JSONResponse) VisitV1BulkOperationCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCancel403JSONResponse APIErrors
+
+func (response V1BulkOperationCancel403JSONResponse) VisitV1BulkOperationCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1BulkOperationCancel404JSONResponse APIErrors
+
+func (response V1BulkOperationCancel404JSONResponse) VisitV1BulkOperationCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1CelDebugRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Body   *V1CelDebugJSONRequestBody
@@ -8865,6 +11097,122 @@ func (response V1CelDebug403JSONResponse) VisitV1CelDebugResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1DeadLetterPolicyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type V1DeadLetterPolicyListResponseObject interface {
+	VisitV1DeadLetterPolicyListResponse(w http.ResponseWriter) error
+}
+
+type V1DeadLetterPolicyList200JSONResponse V1DeadLetterPolicyList
+
+func (response V1DeadLetterPolicyList200JSONResponse) VisitV1DeadLetterPolicyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyList400JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyList400JSONResponse) VisitV1DeadLetterPolicyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyList403JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyList403JSONResponse) VisitV1DeadLetterPolicyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyUpsertRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1DeadLetterPolicyUpsertJSONRequestBody
+}
+
+type V1DeadLetterPolicyUpsertResponseObject interface {
+	VisitV1DeadLetterPolicyUpsertResponse(w http.ResponseWriter) error
+}
+
+type V1DeadLetterPolicyUpsert200JSONResponse V1DeadLetterPolicy
+
+func (response V1DeadLetterPolicyUpsert200JSONResponse) VisitV1DeadLetterPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyUpsert400JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyUpsert400JSONResponse) VisitV1DeadLetterPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyUpsert403JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyUpsert403JSONResponse) VisitV1DeadLetterPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyDeleteRequestObject struct {
+	Tenant             openapi_types.UUID `json:"tenant"`
+	V1DeadLetterPolicy openapi_types.UUID `json:"v1-dead-letter-policy"`
+}
+
+type V1DeadLetterPolicyDeleteResponseObject interface {
+	VisitV1DeadLetterPolicyDeleteResponse(w http.ResponseWriter) error
+}
+
+type V1DeadLetterPolicyDelete200JSONResponse V1DeadLetterPolicy
+
+func (response V1DeadLetterPolicyDelete200JSONResponse) VisitV1DeadLetterPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyDelete400JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyDelete400JSONResponse) VisitV1DeadLetterPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyDelete403JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyDelete403JSONResponse) VisitV1DeadLetterPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DeadLetterPolicyDelete404JSONResponse APIErrors
+
+func (response V1DeadLetterPolicyDelete404JSONResponse) VisitV1DeadLetterPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1DurableTaskBranchRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Body   *V1DurableTaskBranchJSONRequestBody
@@ -8901,6 +11249,42 @@ func (response V1DurableTaskBranch403JSONResponse) VisitV1DurableTaskBranchRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1DurableTaskPatchListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1DurableTaskPatchListParams
+}
+
+type V1DurableTaskPatchListResponseObject interface {
+	VisitV1DurableTaskPatchListResponse(w http.ResponseWriter) error
+}
+
+type V1DurableTaskPatchList200JSONResponse V1DurableTaskPatchMarkerList
+
+func (response V1DurableTaskPatchList200JSONResponse) VisitV1DurableTaskPatchListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DurableTaskPatchList400JSONResponse APIErrors
+
+func (response V1DurableTaskPatchList400JSONResponse) VisitV1DurableTaskPatchListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DurableTaskPatchList403JSONResponse APIErrors
+
+func (response V1DurableTaskPatchList403JSONResponse) VisitV1DurableTaskPatchListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1DurableTaskEventLogListRequestObject struct {
 	Tenant      openapi_types.UUID `json:"tenant"`
 	DurableTask openapi_types.UUID `json:"durable-task"`
@@ -9018,6 +11402,42 @@ func (response V1EventKeyList403JSONResponse) VisitV1EventKeyListResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1EventReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1EventReplayJSONRequestBody
+}
+
+type V1EventReplayResponseObject interface {
+	VisitV1EventReplayResponse(w http.ResponseWriter) error
+}
+
+type V1EventReplay200JSONResponse V1ReplayEventsResponse
+
+func (response V1EventReplay200JSONResponse) VisitV1EventReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1EventReplay400JSONResponse APIErrors
+
+func (response V1EventReplay400JSONResponse) VisitV1EventReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1EventReplay403JSONResponse APIErrors
+
+func (response V1EventReplay403JSONResponse) VisitV1EventReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1EventGetRequestObject struct {
 	Tenant  openapi_types.UUID `json:"tenant"`
 	V1Event openapi_types.UUID `json:"v1-event"`
@@ -9758,80 +12178,250 @@ type V1WebhookUpdateResponseObject interface {
 	VisitV1WebhookUpdateResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookUpdate200JSONResponse V1Webhook
+type V1WebhookUpdate200JSONResponse V1Webhook
+
+func (response V1WebhookUpdate200JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookUpdate400JSONResponse APIErrors
+
+func (response V1WebhookUpdate400JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookUpdate403JSONResponse APIErrors
+
+func (response V1WebhookUpdate403JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookUpdate404JSONResponse APIErrors
+
+func (response V1WebhookUpdate404JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookReceiveRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1Webhook string             `json:"v1-webhook"`
+}
+
+type V1WebhookReceiveResponseObject interface {
+	VisitV1WebhookReceiveResponse(w http.ResponseWriter) error
+}
+
+type V1WebhookReceive200JSONResponse V1WebhookResponse
+
+func (response V1WebhookReceive200JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookReceive204Response struct {
+}
+
+func (response V1WebhookReceive204Response) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type V1WebhookReceive400JSONResponse APIErrors
+
+func (response V1WebhookReceive400JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookReceive401JSONResponse APIErrors
+
+func (response V1WebhookReceive401JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WebhookReceive403JSONResponse APIErrors
+
+func (response V1WebhookReceive403JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionDiffRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1WorkflowDefinitionDiffParams
+}
+
+type V1WorkflowDefinitionDiffResponseObject interface {
+	VisitV1WorkflowDefinitionDiffResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowDefinitionDiff200JSONResponse V1WorkflowDefinitionDiff
+
+func (response V1WorkflowDefinitionDiff200JSONResponse) VisitV1WorkflowDefinitionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionDiff400JSONResponse APIErrors
+
+func (response V1WorkflowDefinitionDiff400JSONResponse) VisitV1WorkflowDefinitionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionDiff403JSONResponse APIErrors
+
+func (response V1WorkflowDefinitionDiff403JSONResponse) VisitV1WorkflowDefinitionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionDiff404JSONResponse APIErrors
+
+func (response V1WorkflowDefinitionDiff404JSONResponse) VisitV1WorkflowDefinitionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionExportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1WorkflowDefinitionExportParams
+}
+
+type V1WorkflowDefinitionExportResponseObject interface {
+	VisitV1WorkflowDefinitionExportResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowDefinitionExport200JSONResponse V1WorkflowDefinition
+
+func (response V1WorkflowDefinitionExport200JSONResponse) VisitV1WorkflowDefinitionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionExport400JSONResponse APIErrors
+
+func (response V1WorkflowDefinitionExport400JSONResponse) VisitV1WorkflowDefinitionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionExport403JSONResponse APIErrors
+
+func (response V1WorkflowDefinitionExport403JSONResponse) VisitV1WorkflowDefinitionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowDefinitionExport404JSONResponse APIErrors
 
-func (response V1WebhookUpdate200JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowDefinitionExport404JSONResponse) VisitV1WorkflowDefinitionExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate400JSONResponse APIErrors
+type V1WorkflowDefinitionImportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1WorkflowDefinitionImportJSONRequestBody
+}
 
-func (response V1WebhookUpdate400JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+type V1WorkflowDefinitionImportResponseObject interface {
+	VisitV1WorkflowDefinitionImportResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowDefinitionImport200JSONResponse V1WorkflowDefinition
+
+func (response V1WorkflowDefinitionImport200JSONResponse) VisitV1WorkflowDefinitionImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate403JSONResponse APIErrors
+type V1WorkflowDefinitionImport400JSONResponse APIErrors
 
-func (response V1WebhookUpdate403JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowDefinitionImport400JSONResponse) VisitV1WorkflowDefinitionImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate404JSONResponse APIErrors
+type V1WorkflowDefinitionImport403JSONResponse APIErrors
 
-func (response V1WebhookUpdate404JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowDefinitionImport403JSONResponse) VisitV1WorkflowDefinitionImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceiveRequestObject struct {
-	Tenant    openapi_types.UUID `json:"tenant"`
-	V1Webhook string             `json:"v1-webhook"`
+type V1WorkflowRetentionPolicyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type V1WebhookReceiveResponseObject interface {
-	VisitV1WebhookReceiveResponse(w http.ResponseWriter) error
+type V1WorkflowRetentionPolicyListResponseObject interface {
+	VisitV1WorkflowRetentionPolicyListResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookReceive200JSONResponse V1WebhookResponse
+type V1WorkflowRetentionPolicyList200JSONResponse V1WorkflowRetentionPolicyList
 
-func (response V1WebhookReceive200JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRetentionPolicyList200JSONResponse) VisitV1WorkflowRetentionPolicyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceive204Response struct {
-}
-
-func (response V1WebhookReceive204Response) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+type V1WorkflowRetentionPolicyList400JSONResponse APIErrors
 
-type V1WebhookReceive400JSONResponse APIErrors
-
-func (response V1WebhookReceive400JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRetentionPolicyList400JSONResponse) VisitV1WorkflowRetentionPolicyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceive403JSONResponse APIErrors
+type V1WorkflowRetentionPolicyList403JSONResponse APIErrors
 
-func (response V1WebhookReceive403JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRetentionPolicyList403JSONResponse) VisitV1WorkflowRetentionPolicyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
@@ -10009,6 +12599,142 @@ func (response V1WorkflowRunCreate403JSONResponse) VisitV1WorkflowRunCreateRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1WorkflowRetentionPolicyDeleteRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type V1WorkflowRetentionPolicyDeleteResponseObject interface {
+	VisitV1WorkflowRetentionPolicyDeleteResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowRetentionPolicyDelete200JSONResponse V1WorkflowRetentionPolicy
+
+func (response V1WorkflowRetentionPolicyDelete200JSONResponse) VisitV1WorkflowRetentionPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyDelete400JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyDelete400JSONResponse) VisitV1WorkflowRetentionPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyDelete403JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyDelete403JSONResponse) VisitV1WorkflowRetentionPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyDelete404JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyDelete404JSONResponse) VisitV1WorkflowRetentionPolicyDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyGetRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type V1WorkflowRetentionPolicyGetResponseObject interface {
+	VisitV1WorkflowRetentionPolicyGetResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowRetentionPolicyGet200JSONResponse V1WorkflowRetentionPolicy
+
+func (response V1WorkflowRetentionPolicyGet200JSONResponse) VisitV1WorkflowRetentionPolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyGet400JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyGet400JSONResponse) VisitV1WorkflowRetentionPolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyGet403JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyGet403JSONResponse) VisitV1WorkflowRetentionPolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyGet404JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyGet404JSONResponse) VisitV1WorkflowRetentionPolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyUpsertRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *V1WorkflowRetentionPolicyUpsertJSONRequestBody
+}
+
+type V1WorkflowRetentionPolicyUpsertResponseObject interface {
+	VisitV1WorkflowRetentionPolicyUpsertResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowRetentionPolicyUpsert200JSONResponse V1WorkflowRetentionPolicy
+
+func (response V1WorkflowRetentionPolicyUpsert200JSONResponse) VisitV1WorkflowRetentionPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyUpsert400JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyUpsert400JSONResponse) VisitV1WorkflowRetentionPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyUpsert403JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyUpsert403JSONResponse) VisitV1WorkflowRetentionPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkflowRetentionPolicyUpsert404JSONResponse APIErrors
+
+func (response V1WorkflowRetentionPolicyUpsert404JSONResponse) VisitV1WorkflowRetentionPolicyUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1WorkflowRunGetRequestObject struct {
 	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
 }
@@ -10438,34 +13164,176 @@ func (response AlertEmailGroupList403JSONResponse) VisitAlertEmailGroupListRespo
 
 type AlertEmailGroupCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *AlertEmailGroupCreateJSONRequestBody
+	Body   *AlertEmailGroupCreateJSONRequestBody
+}
+
+type AlertEmailGroupCreateResponseObject interface {
+	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
+
+func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupCreate400JSONResponse APIErrors
+
+func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupCreate403JSONResponse APIError
+
+func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type AlertRuleListResponseObject interface {
+	VisitAlertRuleListResponse(w http.ResponseWriter) error
+}
+
+type AlertRuleList200JSONResponse TenantAlertRuleList
+
+func (response AlertRuleList200JSONResponse) VisitAlertRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleList400JSONResponse APIErrors
+
+func (response AlertRuleList400JSONResponse) VisitAlertRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleList403JSONResponse APIError
+
+func (response AlertRuleList403JSONResponse) VisitAlertRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AlertRuleCreateJSONRequestBody
+}
+
+type AlertRuleCreateResponseObject interface {
+	VisitAlertRuleCreateResponse(w http.ResponseWriter) error
+}
+
+type AlertRuleCreate201JSONResponse TenantAlertRule
+
+func (response AlertRuleCreate201JSONResponse) VisitAlertRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleCreate400JSONResponse APIErrors
+
+func (response AlertRuleCreate400JSONResponse) VisitAlertRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertRuleCreate403JSONResponse APIError
+
+func (response AlertRuleCreate403JSONResponse) VisitAlertRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type AlertSinkListResponseObject interface {
+	VisitAlertSinkListResponse(w http.ResponseWriter) error
+}
+
+type AlertSinkList200JSONResponse TenantAlertSinkList
+
+func (response AlertSinkList200JSONResponse) VisitAlertSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkList400JSONResponse APIErrors
+
+func (response AlertSinkList400JSONResponse) VisitAlertSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkList403JSONResponse APIError
+
+func (response AlertSinkList403JSONResponse) VisitAlertSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertSinkCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AlertSinkCreateJSONRequestBody
 }
 
-type AlertEmailGroupCreateResponseObject interface {
-	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
+type AlertSinkCreateResponseObject interface {
+	VisitAlertSinkCreateResponse(w http.ResponseWriter) error
 }
 
-type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
+type AlertSinkCreate201JSONResponse TenantAlertSink
 
-func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response AlertSinkCreate201JSONResponse) VisitAlertSinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate400JSONResponse APIErrors
+type AlertSinkCreate400JSONResponse APIErrors
 
-func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response AlertSinkCreate400JSONResponse) VisitAlertSinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate403JSONResponse APIError
+type AlertSinkCreate403JSONResponse APIError
 
-func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response AlertSinkCreate403JSONResponse) VisitAlertSinkCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
@@ -11369,6 +14237,238 @@ func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicy
 	return json.NewEncoder(w).Encode(response)
 }
 
+type TenantRoleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantRoleListResponseObject interface {
+	VisitTenantRoleListResponse(w http.ResponseWriter) error
+}
+
+type TenantRoleList200JSONResponse TenantRoleList
+
+func (response TenantRoleList200JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleList400JSONResponse APIErrors
+
+func (response TenantRoleList400JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleList403JSONResponse APIErrors
+
+func (response TenantRoleList403JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantRoleCreateJSONRequestBody
+}
+
+type TenantRoleCreateResponseObject interface {
+	VisitTenantRoleCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantRoleCreate201JSONResponse TenantRole
+
+func (response TenantRoleCreate201JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleCreate400JSONResponse APIErrors
+
+func (response TenantRoleCreate400JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleCreate403JSONResponse APIErrors
+
+func (response TenantRoleCreate403JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleDeleteRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
+}
+
+type TenantRoleDeleteResponseObject interface {
+	VisitTenantRoleDeleteResponse(w http.ResponseWriter) error
+}
+
+type TenantRoleDelete204Response struct {
+}
+
+func (response TenantRoleDelete204Response) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type TenantRoleDelete400JSONResponse APIErrors
+
+func (response TenantRoleDelete400JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleDelete403JSONResponse APIErrors
+
+func (response TenantRoleDelete403JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleDelete404JSONResponse APIErrors
+
+func (response TenantRoleDelete404JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleUpdateRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
+	Body       *TenantRoleUpdateJSONRequestBody
+}
+
+type TenantRoleUpdateResponseObject interface {
+	VisitTenantRoleUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantRoleUpdate200JSONResponse TenantRole
+
+func (response TenantRoleUpdate200JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleUpdate400JSONResponse APIErrors
+
+func (response TenantRoleUpdate400JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleUpdate403JSONResponse APIErrors
+
+func (response TenantRoleUpdate403JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleUpdate404JSONResponse APIErrors
+
+func (response TenantRoleUpdate404JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type ScopedResourceLimitListResponseObject interface {
+	VisitScopedResourceLimitListResponse(w http.ResponseWriter) error
+}
+
+type ScopedResourceLimitList200JSONResponse ScopedResourceLimitList
+
+func (response ScopedResourceLimitList200JSONResponse) VisitScopedResourceLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitList400JSONResponse APIErrors
+
+func (response ScopedResourceLimitList400JSONResponse) VisitScopedResourceLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitList403JSONResponse APIError
+
+func (response ScopedResourceLimitList403JSONResponse) VisitScopedResourceLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitUpsertRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ScopedResourceLimitUpsertJSONRequestBody
+}
+
+type ScopedResourceLimitUpsertResponseObject interface {
+	VisitScopedResourceLimitUpsertResponse(w http.ResponseWriter) error
+}
+
+type ScopedResourceLimitUpsert200JSONResponse ScopedResourceLimit
+
+func (response ScopedResourceLimitUpsert200JSONResponse) VisitScopedResourceLimitUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitUpsert400JSONResponse APIErrors
+
+func (response ScopedResourceLimitUpsert400JSONResponse) VisitScopedResourceLimitUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScopedResourceLimitUpsert403JSONResponse APIError
+
+func (response ScopedResourceLimitUpsert403JSONResponse) VisitScopedResourceLimitUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SlackWebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -12389,6 +15489,52 @@ func (response WorkflowCronTrigger500JSONResponse) VisitWorkflowCronTriggerRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowCronHistoryListRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+	Params       WorkflowCronHistoryListParams
+}
+
+type WorkflowCronHistoryListResponseObject interface {
+	VisitWorkflowCronHistoryListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowCronHistoryList200JSONResponse CronWorkflowFireList
+
+func (response WorkflowCronHistoryList200JSONResponse) VisitWorkflowCronHistoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronHistoryList400JSONResponse APIErrors
+
+func (response WorkflowCronHistoryList400JSONResponse) VisitWorkflowCronHistoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronHistoryList403JSONResponse APIErrors
+
+func (response WorkflowCronHistoryList403JSONResponse) VisitWorkflowCronHistoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronHistoryList404JSONResponse APIErrors
+
+func (response WorkflowCronHistoryList404JSONResponse) VisitWorkflowCronHistoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params WorkflowRunListParams
@@ -13254,6 +16400,48 @@ func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type UserUpdateOidcOauthCallbackRequestObject struct {
+}
+
+type UserUpdateOidcOauthCallbackResponseObject interface {
+	VisitUserUpdateOidcOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOidcOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOidcOauthCallback302Response struct {
+	Headers UserUpdateOidcOauthCallback302ResponseHeaders
+}
+
+func (response UserUpdateOidcOauthCallback302Response) VisitUserUpdateOidcOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateOidcOauthStartRequestObject struct {
+}
+
+type UserUpdateOidcOauthStartResponseObject interface {
+	VisitUserUpdateOidcOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOidcOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOidcOauthStart302Response struct {
+	Headers UserUpdateOidcOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateOidcOauthStart302Response) VisitUserUpdateOidcOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
 type UserUpdatePasswordRequestObject struct {
 	Body *UserUpdatePasswordJSONRequestBody
 }
@@ -13834,6 +17022,10 @@ type StrictServerInterface interface {
 
 	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
 
+	AlertRuleDelete(ctx echo.Context, request AlertRuleDeleteRequestObject) (AlertRuleDeleteResponseObject, error)
+
+	AlertSinkDelete(ctx echo.Context, request AlertSinkDeleteRequestObject) (AlertSinkDeleteResponseObject, error)
+
 	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
 
 	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
@@ -13848,6 +17040,8 @@ type StrictServerInterface interface {
 
 	MonitoringPostRunProbe(ctx echo.Context, request MonitoringPostRunProbeRequestObject) (MonitoringPostRunProbeResponseObject, error)
 
+	ScopedResourceLimitDelete(ctx echo.Context, request ScopedResourceLimitDeleteRequestObject) (ScopedResourceLimitDeleteResponseObject, error)
+
 	SlackWebhookDelete(ctx echo.Context, request SlackWebhookDeleteRequestObject) (SlackWebhookDeleteResponseObject, error)
 
 	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
@@ -13860,20 +17054,42 @@ type StrictServerInterface interface {
 
 	V1LogLineList(ctx echo.Context, request V1LogLineListRequestObject) (V1LogLineListResponseObject, error)
 
+	V1TaskQuery(ctx echo.Context, request V1TaskQueryRequestObject) (V1TaskQueryResponseObject, error)
+
 	V1TaskRestore(ctx echo.Context, request V1TaskRestoreRequestObject) (V1TaskRestoreResponseObject, error)
 
+	V1TaskSignal(ctx echo.Context, request V1TaskSignalRequestObject) (V1TaskSignalResponseObject, error)
+
 	V1TaskEventList(ctx echo.Context, request V1TaskEventListRequestObject) (V1TaskEventListResponseObject, error)
 
+	V1BulkOperationList(ctx echo.Context, request V1BulkOperationListRequestObject) (V1BulkOperationListResponseObject, error)
+
+	V1BulkOperationCreate(ctx echo.Context, request V1BulkOperationCreateRequestObject) (V1BulkOperationCreateResponseObject, error)
+
+	V1BulkOperationGet(ctx echo.Context, request V1BulkOperationGetRequestObject) (V1BulkOperationGetResponseObject, error)
+
+	V1BulkOperationCancel(ctx echo.Context, request V1BulkOperationCancelRequestObject) (V1BulkOperationCancelResponseObject, error)
+
 	V1CelDebug(ctx echo.Context, request V1CelDebugRequestObject) (V1CelDebugResponseObject, error)
 
+	V1DeadLetterPolicyList(ctx echo.Context, request V1DeadLetterPolicyListRequestObject) (V1DeadLetterPolicyListResponseObject, error)
+
+	V1DeadLetterPolicyUpsert(ctx echo.Context, request V1DeadLetterPolicyUpsertRequestObject) (V1DeadLetterPolicyUpsertResponseObject, error)
+
+	V1DeadLetterPolicyDelete(ctx echo.Context, request V1DeadLetterPolicyDeleteRequestObject) (V1DeadLetterPolicyDeleteResponseObject, error)
+
 	V1DurableTaskBranch(ctx echo.Context, request V1DurableTaskBranchRequestObject) (V1DurableTaskBranchResponseObject, error)
 
+	V1DurableTaskPatchList(ctx echo.Context, request V1DurableTaskPatchListRequestObject) (V1DurableTaskPatchListResponseObject, error)
+
 	V1DurableTaskEventLogList(ctx echo.Context, request V1DurableTaskEventLogListRequestObject) (V1DurableTaskEventLogListResponseObject, error)
 
 	V1EventList(ctx echo.Context, request V1EventListRequestObject) (V1EventListResponseObject, error)
 
 	V1EventKeyList(ctx echo.Context, request V1EventKeyListRequestObject) (V1EventKeyListResponseObject, error)
 
+	V1EventReplay(ctx echo.Context, request V1EventReplayRequestObject) (V1EventReplayResponseObject, error)
+
 	V1EventGet(ctx echo.Context, request V1EventGetRequestObject) (V1EventGetResponseObject, error)
 
 	V1FilterList(ctx echo.Context, request V1FilterListRequestObject) (V1FilterListResponseObject, error)
@@ -13912,6 +17128,14 @@ type StrictServerInterface interface {
 
 	V1WebhookReceive(ctx echo.Context, request V1WebhookReceiveRequestObject) (V1WebhookReceiveResponseObject, error)
 
+	V1WorkflowDefinitionDiff(ctx echo.Context, request V1WorkflowDefinitionDiffRequestObject) (V1WorkflowDefinitionDiffResponseObject, error)
+
+	V1WorkflowDefinitionExport(ctx echo.Context, request V1WorkflowDefinitionExportRequestObject) (V1WorkflowDefinitionExportResponseObject, error)
+
+	V1WorkflowDefinitionImport(ctx echo.Context, request V1WorkflowDefinitionImportRequestObject) (V1WorkflowDefinitionImportResponseObject, error)
+
+	V1WorkflowRetentionPolicyList(ctx echo.Context, request V1WorkflowRetentionPolicyListRequestObject) (V1WorkflowRetentionPolicyListResponseObject, error)
+
 	V1WorkflowRunList(ctx echo.Context, request V1WorkflowRunListRequestObject) (V1WorkflowRunListResponseObject, error)
 
 	V1WorkflowRunDisplayNamesList(ctx echo.Context, request V1WorkflowRunDisplayNamesListRequestObject) (V1WorkflowRunDisplayNamesListResponseObject, error)
@@ -13920,6 +17144,12 @@ type StrictServerInterface interface {
 
 	V1WorkflowRunCreate(ctx echo.Context, request V1WorkflowRunCreateRequestObject) (V1WorkflowRunCreateResponseObject, error)
 
+	V1WorkflowRetentionPolicyDelete(ctx echo.Context, request V1WorkflowRetentionPolicyDeleteRequestObject) (V1WorkflowRetentionPolicyDeleteResponseObject, error)
+
+	V1WorkflowRetentionPolicyGet(ctx echo.Context, request V1WorkflowRetentionPolicyGetRequestObject) (V1WorkflowRetentionPolicyGetResponseObject, error)
+
+	V1WorkflowRetentionPolicyUpsert(ctx echo.Context, request V1WorkflowRetentionPolicyUpsertRequestObject) (V1WorkflowRetentionPolicyUpsertResponseObject, error)
+
 	V1WorkflowRunGet(ctx echo.Context, request V1WorkflowRunGetRequestObject) (V1WorkflowRunGetResponseObject, error)
 
 	V1WorkflowRunGetStatus(ctx echo.Context, request V1WorkflowRunGetStatusRequestObject) (V1WorkflowRunGetStatusResponseObject, error)
@@ -13942,6 +17172,14 @@ type StrictServerInterface interface {
 
 	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
 
+	AlertRuleList(ctx echo.Context, request AlertRuleListRequestObject) (AlertRuleListResponseObject, error)
+
+	AlertRuleCreate(ctx echo.Context, request AlertRuleCreateRequestObject) (AlertRuleCreateResponseObject, error)
+
+	AlertSinkList(ctx echo.Context, request AlertSinkListRequestObject) (AlertSinkListResponseObject, error)
+
+	AlertSinkCreate(ctx echo.Context, request AlertSinkCreateRequestObject) (AlertSinkCreateResponseObject, error)
+
 	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
 
 	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
@@ -13988,6 +17226,18 @@ type StrictServerInterface interface {
 
 	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
 
+	TenantRoleList(ctx echo.Context, request TenantRoleListRequestObject) (TenantRoleListResponseObject, error)
+
+	TenantRoleCreate(ctx echo.Context, request TenantRoleCreateRequestObject) (TenantRoleCreateResponseObject, error)
+
+	TenantRoleDelete(ctx echo.Context, request TenantRoleDeleteRequestObject) (TenantRoleDeleteResponseObject, error)
+
+	TenantRoleUpdate(ctx echo.Context, request TenantRoleUpdateRequestObject) (TenantRoleUpdateResponseObject, error)
+
+	ScopedResourceLimitList(ctx echo.Context, request ScopedResourceLimitListRequestObject) (ScopedResourceLimitListResponseObject, error)
+
+	ScopedResourceLimitUpsert(ctx echo.Context, request ScopedResourceLimitUpsertRequestObject) (ScopedResourceLimitUpsertResponseObject, error)
+
 	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
 
 	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
@@ -14038,6 +17288,8 @@ type StrictServerInterface interface {
 
 	WorkflowCronTrigger(ctx echo.Context, request WorkflowCronTriggerRequestObject) (WorkflowCronTriggerResponseObject, error)
 
+	WorkflowCronHistoryList(ctx echo.Context, request WorkflowCronHistoryListRequestObject) (WorkflowCronHistoryListResponseObject, error)
+
 	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
 
 	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
@@ -14084,6 +17336,10 @@ type StrictServerInterface interface {
 
 	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
 
+	UserUpdateOidcOauthCallback(ctx echo.Context, request UserUpdateOidcOauthCallbackRequestObject) (UserUpdateOidcOauthCallbackResponseObject, error)
+
+	UserUpdateOidcOauthStart(ctx echo.Context, request UserUpdateOidcOauthStartRequestObject) (UserUpdateOidcOauthStartResponseObject, error)
+
 	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
 
 	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
@@ -14212,6 +17468,50 @@ func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup
 	return nil
 }
 
+// AlertRuleDelete operation
+func (sh *strictHandler) AlertRuleDelete(ctx echo.Context, alertRule openapi_types.UUID) error {
+	var request AlertRuleDeleteRequestObject
+
+	request.AlertRule = alertRule
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertRuleDelete(ctx, request.(AlertRuleDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertRuleDeleteResponseObject); ok {
+		return validResponse.VisitAlertRuleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertSinkDelete operation
+func (sh *strictHandler) AlertSinkDelete(ctx echo.Context, alertSink openapi_types.UUID) error {
+	var request AlertSinkDeleteRequestObject
+
+	request.AlertSink = alertSink
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertSinkDelete(ctx, request.(AlertSinkDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertSinkDeleteResponseObject); ok {
+		return validResponse.VisitAlertSinkDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ApiTokenUpdateRevoke operation
 func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
 	var request ApiTokenUpdateRevokeRequestObject
@@ -14360,6 +17660,28 @@ func (sh *strictHandler) MonitoringPostRunProbe(ctx echo.Context, tenant openapi
 	return nil
 }
 
+// ScopedResourceLimitDelete operation
+func (sh *strictHandler) ScopedResourceLimitDelete(ctx echo.Context, scopedResourceLimit openapi_types.UUID) error {
+	var request ScopedResourceLimitDeleteRequestObject
+
+	request.ScopedResourceLimit = scopedResourceLimit
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScopedResourceLimitDelete(ctx, request.(ScopedResourceLimitDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScopedResourceLimitDeleteResponseObject); ok {
+		return validResponse.VisitScopedResourceLimitDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SlackWebhookDelete operation
 func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
 	var request SlackWebhookDeleteRequestObject
@@ -14419,149 +17741,375 @@ func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID,
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
-		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
+		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1DagListTasks operation
+func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
+	var request V1DagListTasksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
+		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskGet operation
+func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
+	var request V1TaskGetRequestObject
+
+	request.Task = task
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
+		return validResponse.VisitV1TaskGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1LogLineList operation
+func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error {
+	var request V1LogLineListRequestObject
+
+	request.Task = task
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
+		return validResponse.VisitV1LogLineListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskQuery operation
+func (sh *strictHandler) V1TaskQuery(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1TaskQueryRequestObject
+
+	request.Task = task
+
+	var body V1TaskQueryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskQuery(ctx, request.(V1TaskQueryRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskQueryResponseObject); ok {
+		return validResponse.VisitV1TaskQueryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskRestore operation
+func (sh *strictHandler) V1TaskRestore(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1TaskRestoreRequestObject
+
+	request.Task = task
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskRestore(ctx, request.(V1TaskRestoreRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskRestoreResponseObject); ok {
+		return validResponse.VisitV1TaskRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskSignal operation
+func (sh *strictHandler) V1TaskSignal(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1TaskSignalRequestObject
+
+	request.Task = task
+
+	var body V1TaskSignalJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskSignal(ctx, request.(V1TaskSignalRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskSignalResponseObject); ok {
+		return validResponse.VisitV1TaskSignalResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskEventList operation
+func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
+	var request V1TaskEventListRequestObject
+
+	request.Task = task
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
+		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1BulkOperationList operation
+func (sh *strictHandler) V1BulkOperationList(ctx echo.Context, tenant openapi_types.UUID, params V1BulkOperationListParams) error {
+	var request V1BulkOperationListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1BulkOperationList(ctx, request.(V1BulkOperationListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1BulkOperationListResponseObject); ok {
+		return validResponse.VisitV1BulkOperationListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1BulkOperationCreate operation
+func (sh *strictHandler) V1BulkOperationCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1BulkOperationCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body V1BulkOperationCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1BulkOperationCreate(ctx, request.(V1BulkOperationCreateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1BulkOperationCreateResponseObject); ok {
+		return validResponse.VisitV1BulkOperationCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1DagListTasks operation
-func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
-	var request V1DagListTasksRequestObject
+// V1BulkOperationGet operation
+func (sh *strictHandler) V1BulkOperationGet(ctx echo.Context, tenant openapi_types.UUID, v1BulkOperation openapi_types.UUID) error {
+	var request V1BulkOperationGetRequestObject
 
-	request.Params = params
+	request.Tenant = tenant
+	request.V1BulkOperation = v1BulkOperation
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
+		return sh.ssi.V1BulkOperationGet(ctx, request.(V1BulkOperationGetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
-		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkOperationGetResponseObject); ok {
+		return validResponse.VisitV1BulkOperationGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskGet operation
-func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
-	var request V1TaskGetRequestObject
+// V1BulkOperationCancel operation
+func (sh *strictHandler) V1BulkOperationCancel(ctx echo.Context, tenant openapi_types.UUID, v1BulkOperation openapi_types.UUID) error {
+	var request V1BulkOperationCancelRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
+	request.V1BulkOperation = v1BulkOperation
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
+		return sh.ssi.V1BulkOperationCancel(ctx, request.(V1BulkOperationCancelRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
-		return validResponse.VisitV1TaskGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkOperationCancelResponseObject); ok {
+		return validResponse.VisitV1BulkOperationCancelResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1LogLineList operation
-func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error {
-	var request V1LogLineListRequestObject
+// V1CelDebug operation
+func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1CelDebugRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
+
+	var body V1CelDebugJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
+		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
-		return validResponse.VisitV1LogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
+		return validResponse.VisitV1CelDebugResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskRestore operation
-func (sh *strictHandler) V1TaskRestore(ctx echo.Context, task openapi_types.UUID) error {
-	var request V1TaskRestoreRequestObject
+// V1DeadLetterPolicyList operation
+func (sh *strictHandler) V1DeadLetterPolicyList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1DeadLetterPolicyListRequestObject
 
-	request.Task = task
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskRestore(ctx, request.(V1TaskRestoreRequestObject))
+		return sh.ssi.V1DeadLetterPolicyList(ctx, request.(V1DeadLetterPolicyListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskRestoreResponseObject); ok {
-		return validResponse.VisitV1TaskRestoreResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DeadLetterPolicyListResponseObject); ok {
+		return validResponse.VisitV1DeadLetterPolicyListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskEventList operation
-func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
-	var request V1TaskEventListRequestObject
+// V1DeadLetterPolicyUpsert operation
+func (sh *strictHandler) V1DeadLetterPolicyUpsert(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1DeadLetterPolicyUpsertRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
+
+	var body V1DeadLetterPolicyUpsertJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
+		return sh.ssi.V1DeadLetterPolicyUpsert(ctx, request.(V1DeadLetterPolicyUpsertRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
-		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DeadLetterPolicyUpsertResponseObject); ok {
+		return validResponse.VisitV1DeadLetterPolicyUpsertResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1CelDebug operation
-func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request V1CelDebugRequestObject
+// V1DeadLetterPolicyDelete operation
+func (sh *strictHandler) V1DeadLetterPolicyDelete(ctx echo.Context, tenant openapi_types.UUID, v1DeadLetterPolicy openapi_types.UUID) error {
+	var request V1DeadLetterPolicyDeleteRequestObject
 
 	request.Tenant = tenant
-
-	var body V1CelDebugJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.V1DeadLetterPolicy = v1DeadLetterPolicy
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
+		return sh.ssi.V1DeadLetterPolicyDelete(ctx, request.(V1DeadLetterPolicyDeleteRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
-		return validResponse.VisitV1CelDebugResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DeadLetterPolicyDeleteResponseObject); ok {
+		return validResponse.VisitV1DeadLetterPolicyDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -14596,6 +18144,29 @@ func (sh *strictHandler) V1DurableTaskBranch(ctx echo.Context, tenant openapi_ty
 	return nil
 }
 
+// V1DurableTaskPatchList operation
+func (sh *strictHandler) V1DurableTaskPatchList(ctx echo.Context, tenant openapi_types.UUID, params V1DurableTaskPatchListParams) error {
+	var request V1DurableTaskPatchListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1DurableTaskPatchList(ctx, request.(V1DurableTaskPatchListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1DurableTaskPatchListResponseObject); ok {
+		return validResponse.VisitV1DurableTaskPatchListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1DurableTaskEventLogList operation
 func (sh *strictHandler) V1DurableTaskEventLogList(ctx echo.Context, tenant openapi_types.UUID, durableTask openapi_types.UUID, params V1DurableTaskEventLogListParams) error {
 	var request V1DurableTaskEventLogListRequestObject
@@ -14665,6 +18236,34 @@ func (sh *strictHandler) V1EventKeyList(ctx echo.Context, tenant openapi_types.U
 	return nil
 }
 
+// V1EventReplay operation
+func (sh *strictHandler) V1EventReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1EventReplayRequestObject
+
+	request.Tenant = tenant
+
+	var body V1EventReplayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1EventReplay(ctx, request.(V1EventReplayRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1EventReplayResponseObject); ok {
+		return validResponse.VisitV1EventReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1EventGet operation
 func (sh *strictHandler) V1EventGet(ctx echo.Context, tenant openapi_types.UUID, v1Event openapi_types.UUID) error {
 	var request V1EventGetRequestObject
@@ -15134,6 +18733,102 @@ func (sh *strictHandler) V1WebhookReceive(ctx echo.Context, tenant openapi_types
 	return nil
 }
 
+// V1WorkflowDefinitionDiff operation
+func (sh *strictHandler) V1WorkflowDefinitionDiff(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowDefinitionDiffParams) error {
+	var request V1WorkflowDefinitionDiffRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowDefinitionDiff(ctx, request.(V1WorkflowDefinitionDiffRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowDefinitionDiffResponseObject); ok {
+		return validResponse.VisitV1WorkflowDefinitionDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowDefinitionExport operation
+func (sh *strictHandler) V1WorkflowDefinitionExport(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowDefinitionExportParams) error {
+	var request V1WorkflowDefinitionExportRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowDefinitionExport(ctx, request.(V1WorkflowDefinitionExportRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowDefinitionExportResponseObject); ok {
+		return validResponse.VisitV1WorkflowDefinitionExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowDefinitionImport operation
+func (sh *strictHandler) V1WorkflowDefinitionImport(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkflowDefinitionImportRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkflowDefinitionImportJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowDefinitionImport(ctx, request.(V1WorkflowDefinitionImportRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowDefinitionImportResponseObject); ok {
+		return validResponse.VisitV1WorkflowDefinitionImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowRetentionPolicyList operation
+func (sh *strictHandler) V1WorkflowRetentionPolicyList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkflowRetentionPolicyListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRetentionPolicyList(ctx, request.(V1WorkflowRetentionPolicyListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRetentionPolicyListResponseObject); ok {
+		return validResponse.VisitV1WorkflowRetentionPolicyListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1WorkflowRunList operation
 func (sh *strictHandler) V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error {
 	var request V1WorkflowRunListRequestObject
@@ -15157,74 +18852,149 @@ func (sh *strictHandler) V1WorkflowRunList(ctx echo.Context, tenant openapi_type
 	return nil
 }
 
-// V1WorkflowRunDisplayNamesList operation
-func (sh *strictHandler) V1WorkflowRunDisplayNamesList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunDisplayNamesListParams) error {
-	var request V1WorkflowRunDisplayNamesListRequestObject
+// V1WorkflowRunDisplayNamesList operation
+func (sh *strictHandler) V1WorkflowRunDisplayNamesList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunDisplayNamesListParams) error {
+	var request V1WorkflowRunDisplayNamesListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRunDisplayNamesList(ctx, request.(V1WorkflowRunDisplayNamesListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRunDisplayNamesListResponseObject); ok {
+		return validResponse.VisitV1WorkflowRunDisplayNamesListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowRunExternalIdsList operation
+func (sh *strictHandler) V1WorkflowRunExternalIdsList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunExternalIdsListParams) error {
+	var request V1WorkflowRunExternalIdsListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRunExternalIdsList(ctx, request.(V1WorkflowRunExternalIdsListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRunExternalIdsListResponseObject); ok {
+		return validResponse.VisitV1WorkflowRunExternalIdsListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowRunCreate operation
+func (sh *strictHandler) V1WorkflowRunCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkflowRunCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkflowRunCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRunCreate(ctx, request.(V1WorkflowRunCreateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRunCreateResponseObject); ok {
+		return validResponse.VisitV1WorkflowRunCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowRetentionPolicyDelete operation
+func (sh *strictHandler) V1WorkflowRetentionPolicyDelete(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error {
+	var request V1WorkflowRetentionPolicyDeleteRequestObject
 
 	request.Tenant = tenant
-	request.Params = params
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1WorkflowRunDisplayNamesList(ctx, request.(V1WorkflowRunDisplayNamesListRequestObject))
+		return sh.ssi.V1WorkflowRetentionPolicyDelete(ctx, request.(V1WorkflowRetentionPolicyDeleteRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1WorkflowRunDisplayNamesListResponseObject); ok {
-		return validResponse.VisitV1WorkflowRunDisplayNamesListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1WorkflowRetentionPolicyDeleteResponseObject); ok {
+		return validResponse.VisitV1WorkflowRetentionPolicyDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1WorkflowRunExternalIdsList operation
-func (sh *strictHandler) V1WorkflowRunExternalIdsList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunExternalIdsListParams) error {
-	var request V1WorkflowRunExternalIdsListRequestObject
+// V1WorkflowRetentionPolicyGet operation
+func (sh *strictHandler) V1WorkflowRetentionPolicyGet(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error {
+	var request V1WorkflowRetentionPolicyGetRequestObject
 
 	request.Tenant = tenant
-	request.Params = params
+	request.Workflow = workflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1WorkflowRunExternalIdsList(ctx, request.(V1WorkflowRunExternalIdsListRequestObject))
+		return sh.ssi.V1WorkflowRetentionPolicyGet(ctx, request.(V1WorkflowRetentionPolicyGetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1WorkflowRunExternalIdsListResponseObject); ok {
-		return validResponse.VisitV1WorkflowRunExternalIdsListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1WorkflowRetentionPolicyGetResponseObject); ok {
+		return validResponse.VisitV1WorkflowRetentionPolicyGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1WorkflowRunCreate operation
-func (sh *strictHandler) V1WorkflowRunCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request V1WorkflowRunCreateRequestObject
+// V1WorkflowRetentionPolicyUpsert operation
+func (sh *strictHandler) V1WorkflowRetentionPolicyUpsert(ctx echo.Context, tenant openapi_types.UUID, workflow openapi_types.UUID) error {
+	var request V1WorkflowRetentionPolicyUpsertRequestObject
 
 	request.Tenant = tenant
+	request.Workflow = workflow
 
-	var body V1WorkflowRunCreateJSONRequestBody
+	var body V1WorkflowRetentionPolicyUpsertJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1WorkflowRunCreate(ctx, request.(V1WorkflowRunCreateRequestObject))
+		return sh.ssi.V1WorkflowRetentionPolicyUpsert(ctx, request.(V1WorkflowRetentionPolicyUpsertRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1WorkflowRunCreateResponseObject); ok {
-		return validResponse.VisitV1WorkflowRunCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1WorkflowRetentionPolicyUpsertResponseObject); ok {
+		return validResponse.VisitV1WorkflowRetentionPolicyUpsertResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -15493,6 +19263,106 @@ func (sh *strictHandler) AlertEmailGroupCreate(ctx echo.Context, tenant openapi_
 	return nil
 }
 
+// AlertRuleList operation
+func (sh *strictHandler) AlertRuleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertRuleListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertRuleList(ctx, request.(AlertRuleListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertRuleListResponseObject); ok {
+		return validResponse.VisitAlertRuleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertRuleCreate operation
+func (sh *strictHandler) AlertRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertRuleCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body AlertRuleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertRuleCreate(ctx, request.(AlertRuleCreateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertRuleCreateResponseObject); ok {
+		return validResponse.VisitAlertRuleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertSinkList operation
+func (sh *strictHandler) AlertSinkList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertSinkListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertSinkList(ctx, request.(AlertSinkListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertSinkListResponseObject); ok {
+		return validResponse.VisitAlertSinkListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertSinkCreate operation
+func (sh *strictHandler) AlertSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertSinkCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body AlertSinkCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertSinkCreate(ctx, request.(AlertSinkCreateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertSinkCreateResponseObject); ok {
+		return validResponse.VisitAlertSinkCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // TenantAlertingSettingsGet operation
 func (sh *strictHandler) TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request TenantAlertingSettingsGetRequestObject
@@ -16057,6 +19927,158 @@ func (sh *strictHandler) TenantResourcePolicyGet(ctx echo.Context, tenant openap
 	return nil
 }
 
+// TenantRoleList operation
+func (sh *strictHandler) TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleList(ctx, request.(TenantRoleListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleListResponseObject); ok {
+		return validResponse.VisitTenantRoleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleCreate operation
+func (sh *strictHandler) TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantRoleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleCreate(ctx, request.(TenantRoleCreateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleCreateResponseObject); ok {
+		return validResponse.VisitTenantRoleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleDelete operation
+func (sh *strictHandler) TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleDeleteRequestObject
+
+	request.Tenant = tenant
+	request.TenantRole = tenantRole
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleDelete(ctx, request.(TenantRoleDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleDeleteResponseObject); ok {
+		return validResponse.VisitTenantRoleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleUpdate operation
+func (sh *strictHandler) TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleUpdateRequestObject
+
+	request.Tenant = tenant
+	request.TenantRole = tenantRole
+
+	var body TenantRoleUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleUpdate(ctx, request.(TenantRoleUpdateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleUpdateResponseObject); ok {
+		return validResponse.VisitTenantRoleUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScopedResourceLimitList operation
+func (sh *strictHandler) ScopedResourceLimitList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ScopedResourceLimitListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScopedResourceLimitList(ctx, request.(ScopedResourceLimitListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScopedResourceLimitListResponseObject); ok {
+		return validResponse.VisitScopedResourceLimitListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScopedResourceLimitUpsert operation
+func (sh *strictHandler) ScopedResourceLimitUpsert(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ScopedResourceLimitUpsertRequestObject
+
+	request.Tenant = tenant
+
+	var body ScopedResourceLimitUpsertJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScopedResourceLimitUpsert(ctx, request.(ScopedResourceLimitUpsertRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScopedResourceLimitUpsertResponseObject); ok {
+		return validResponse.VisitScopedResourceLimitUpsertResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SlackWebhookList operation
 func (sh *strictHandler) SlackWebhookList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request SlackWebhookListRequestObject
@@ -16660,6 +20682,30 @@ func (sh *strictHandler) WorkflowCronTrigger(ctx echo.Context, tenant openapi_ty
 	return nil
 }
 
+// WorkflowCronHistoryList operation
+func (sh *strictHandler) WorkflowCronHistoryList(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, params WorkflowCronHistoryListParams) error {
+	var request WorkflowCronHistoryListRequestObject
+
+	request.Tenant = tenant
+	request.CronWorkflow = cronWorkflow
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowCronHistoryList(ctx, request.(WorkflowCronHistoryListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowCronHistoryListResponseObject); ok {
+		return validResponse.VisitWorkflowCronHistoryListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunList operation
 func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
 	var request WorkflowRunListRequestObject
@@ -17202,6 +21248,46 @@ func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
 	return nil
 }
 
+// UserUpdateOidcOauthCallback operation
+func (sh *strictHandler) UserUpdateOidcOauthCallback(ctx echo.Context) error {
+	var request UserUpdateOidcOauthCallbackRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcOauthCallback(ctx, request.(UserUpdateOidcOauthCallbackRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcOauthCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcOauthCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserUpdateOidcOauthStart operation
+func (sh *strictHandler) UserUpdateOidcOauthStart(ctx echo.Context) error {
+	var request UserUpdateOidcOauthStartRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcOauthStart(ctx, request.(UserUpdateOidcOauthStartRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcOauthStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UserUpdatePassword operation
 func (sh *strictHandler) UserUpdatePassword(ctx echo.Context) error {
 	var request UserUpdatePasswordRequestObject
@@ -17538,368 +21624,491 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9e3PbOpIwDn8VlN63as+pknzLydmzqXr+cGwl0cSxvZKdPPPMpryQCEkYUySHAO1o",
-	"Uvnuv8KNBEmABHWzlLBqao4j4tJodDcajb5870zCRRQGKKCk8+Z7h0zmaAH5n+e3g34chzH7O4rDCMUU",
-	"I/5lEnqI/ddDZBLjiOIw6LzpQDBJCA0X4AOkkzmiALHegDfudtA3uIh81Hlz+sfJSbczDeMFpJ03nQQH",
-	"9M8/Ot0OXUao86aDA4pmKO786OaHL8+m/RtMwxjQOSZiTn26znnW8AlJmBaIEDhD2ayExjiY8UnDCXnw",
-	"cfBompL9DmgI6BwBL5wkCxRQaACgC/AUYArQN0woyYEzw3SejI8m4eJ4LvDU89CT+tsE0RQj3ytDw2Dg",
-	"nwCdQ6pNDjABkJBwgiFFHnjGdM7hgVHk4wkc+7nt6ARwYUDEj24nRv9KcIy8zpt/5Kb+mjYOx/9EE8pg",
-	"VLRCysSC0t8xRQv+x/8/RtPOm87/7zijvWNJeMcp1f1Ip4FxDJclkOS4Fmg+IQrLsEDfD58v5jCYoVtI",
-	"yHMYGxD7PEd0jmIQxiAIKUgIigmYwABMeEe2+TgGkeqv4ZLGCUrBGYehj2DA4BHTxghSdIcCGNAmk/Ju",
-	"IEDPgPK+xHnGQfCEqVi442SY9wAh/yp+5tSOCcABoTCYIOfZR3gWJFGDyQmeBSCJMlZqNGVC5w6kxcji",
-	"nDX90e2EY4LiJzjGPqbLfsAYo54acp3AbzSGEwQmoe+jCevwO2M+JMYCYWBfxxT6xLiQKCR0Hs4c13Ir",
-	"W7OOcbhgoCZkhOInFLuuCILbtCeYIg/FQqIRPgpbzyQMpniWxMgDv436w8/94cPt8OZT/+5D/370IH+5",
-	"H179vuKKl34YnEfRwCLkbtl3Jr3A4JITR0IQ78OEKGNKCkgSRWFMc3Lt9OzVH6///M+/euyPwv+x3//r",
-	"5PTMKPds4uRcklhepPANMTEZA13ChTzABiUgnAJGqCigeMKxrEP8j84YEjzpdDuzMJz5iIm2VGSWToWS",
-	"bLSBPWAHqthTg3CuIxK5nekQJfpG+maXN5efLkbcsC8MIWKIDMbyYVl7OskjTC2m4ki4zbircDJE+ENI",
-	"qIUCQ0I/hDNwfjsAc9ZKh3FOaUTeHB9Lxj2SXxhxmk5zGOGPaFk/zyNa5qaJ5o8PGenC8cRDU2fyHSIS",
-	"JvEEmU9FccR455bVU7xAmo4Ry7HAMyTydModgp2zk7Oz3ulZ7/TV3enrNyd/vvnjr6O//vrr1eu/eiev",
-	"35ycdDTtz4MU9dgEJlRhi0DAnqAbDZguwAG4vxcCgg2tAzQen53+8dfJf/bO/vgT9f54BV/34Nlrr/fH",
-	"6X/+eeqdTqbT/2LzL+C3KxTMGJO/+tMAThJ5q6LJh4QC2X8buCrwA2aTZLuqg27hjbvwEZnEw7cIx4iY",
-	"lvxljgT7M2KlrDuQrY+cN3iBKPSgIMmawy5HwVa5cleQKylsR/n9PXv9ug6HKWzdVLykyDAicTJBERUq",
-	"1xD9K0FCmOTxKfQrgdn1qHOBAzuxdjvfeiGMcI/dvWYo6KFvNIY9CmcciifoY7YvnTfpirtJgr3OjxIh",
-	"CXhN632b+I9Cpe0/oYBal4ye1NXSSf03DFl7ERAzfP3R7Vywc8h3AGjg5UFqvB3Z/TXh3NZke5wWxCDk",
-	"SwqDSRLHKJgsr/AC0xGNIUWzpTi9kwXrcHF+fdG/ehhcM73s/bA/GnW6ncvhze3Ddf9Lf3TX6Xb++75/",
-	"38/++X54c3/7MLy5v758GN68HVxre5xBqc09moQR0uf8cjP8+O7q5kun27k7H32s7Y8oZb+aREyMCDHe",
-	"8Rk7T7IxQNa2y5RAj11OZihgGisCkB2ZYBqHC0AheQQ4iBJKukAxchcgOjkyiSG/iNdKArXtxw9OBMMk",
-	"IOaFLOA3vEgWIEgWY6Z9T7OlUfAcxo9TP3wGcRLkBSgO6Kszo3mEqC1xBFdsIetIUTRE0GPakknpZtDG",
-	"8nt62CLAujGMP8/xZC4OOX1ziNhhYWYQp0CNhJXYKm5AV6cJtUyTCNLXRiGto63Svj+ipdD9PA+zpUP/",
-	"Ntdd3wOLiaoEk/jhu4teJkSdOnzt8kocOwMLf3hJnFmg1NYgeSJjAriwL2+G+xERLjANsN9VE/HFmI/f",
-	"c3H4igv8WqcvH/+rA9JIFAYElbFGlUJTxlgOrGowxCh2OC7iMPgiWfcuxrMZiq37mFHZJ03tKQ08icOg",
-	"X023rMm13ICy0szEnnHkKMZhjOmySNpcvEjp1Hnzih9e4u/TMsmXFAQ2W9e0OA3O0qq+phisPqvNOCsQ",
-	"XdomFfUpBfKTVNvmDBnmsThDuQ3waLrEsf78FLJ0z7ZJ34zyGOqrEr3pOE2OhfKw/BMHjg8IptiniEFU",
-	"zwniOsqxlm3e6HqkWResu0jDCE/OYxs7LuC/wwAoBR8wigG/nQ+vf1erH12PAB9jHTGWaroLHPyf0+4C",
-	"fvs/Z6//LKu8KbB2rhc23HMfxbS/gNh/H4dJZJffrAkxCUsfE8rWKFoo01bMTkRHu88Ky/fwE+ryGctr",
-	"l6DWrbzmkiMGN+41/6S2la2V6RPikrGRvVXr6nbi0K/VjcRqPiGmjw1ZeyM+OnKwOqzY8RHMcIA+o1gJ",
-	"9HqYVOMf3Q4KnnAcBgskXg3q+/a1Ds4XZfG0sIk94EgMg3EIYw8Hs0spZ806lrDmW+V5NoyQyjQEhIYx",
-	"4m9aZrizvSF+MrOIQT+ZbX7hXfmEx0+8HxYTJQfKTEmZJkFcD8IqpBoVC6NE0WzAZfttqk40mmsNw84C",
-	"0Xno1ZsJNHR9El00Yq88blfWfbodQS0DzziHusPVfLZqbqqBZH7jMHYjVQqaaaDC7DlYJWVkdJDuQS2d",
-	"XmGTvIvgDAfpe0PVLt6mLVNFnovu5yb2Ip1vnN5FTLSjGTYu++/O76/uOtwuajZr6APcxB6K3y7fqUd6",
-	"NUygFF9UsrxmI3Htd5dq75pa6xp8TdOH7/ojrMhqZXAHl3kBXnR4kO4Q1oUo+h8mwShZLGBca/fhW/Wl",
-	"3K2CJYXOnC7kq9pwdSbmN73JjQT89rfRzTUYLykiv9cr76nazqf/uB4NqDH2gPnT5ZT5XgG6L1BWgCgl",
-	"yCWOhQ+BLkUgmXSEgmSXHzYJ5CB6RgjGk7nxNLLRe/n1kNvcjY/IXMvMzJ2qodHIaTGwTSF2GFq0ajJu",
-	"hAJP2qOrBpbNmoz8rwQl9RCLVk3GjZMgcIBYNmsyMkkmE4S8eqDThu6jp1ROqp6GDDdF/u1IvwqvwGNr",
-	"nFh2sa69N71DkCYxeufDWf8J+okSFIlvevwjVpecL6m3BQJTMSaY+nCme1soySwP0rKzRdE0l05n0uM0",
-	"yAc5qSGG7/nhrKcOyZ6wE/UyBZG7PfW4rgwj7fcwnsEA/5ujoUdI2Cu7ZGQS5m/h2HAKVvl88sNQ8/qU",
-	"KsA/w/HRlp6XS2MSiiJ30T+iKDJRZeU9guIFChNqXr78WLf0p3XvEE/a3UHdXfnSTcT0t3A8TIKKo0E4",
-	"ELg5BaSdUudje5MhgsRyq53iAJN5s6n/KSiyakcZ0YqWlt1bg+jiVHCUjRkUxrTZYgiFNCEO62GHu2ir",
-	"3gblG6YzibPNb07lk0cUV7NAk+VqGn0dyJpWU+i5/p1bDKIIJN0FO9eM0m1SEvi2f305uH7f6XaG99fX",
-	"4q/R/cVFv3/Zv+x0O+/OB1f8D/HsL/5+e37x8ebdO6OgZTqw2RnQ1SO72NWw2XIS/ixH7O9yO9W8U8cm",
-	"o/LNIM6/YJAXhjcPTa2fiAabnMhEZnyZPpw8fkHjeRg+vvgiNVg2tMQbivxRBIMa10Y3QaLeua9dfQAi",
-	"GLOrVAQDizRTroDnlMZ4nFBU6XVge/HJlhsjGi8vwiSgRnOm5UnQanfkX7XniXIDFD/hScUAEQw2tTZi",
-	"RyP79BEHtbZhRQ28rexnh52L3wsZ0FQ7bNY67ftJxhIZl8f0ZJdDRTVMEaCBra08vxc56HOEm/f+1Oil",
-	"insUbtU5dH89uu1fDN4N+AEzuL7rD6/Pr9hhxD3+2QF0Nehf33W6ndvhzeX9hfjt5np0/6k/NJ5Eaqot",
-	"WWXSdealkQOHFE+zRhItFT9O5ucCHeUR3mfYvPnY6Xb6w+GNGYmGxesejN870qnsIeJkedbtBOib+ter",
-	"bidIFvwfpPPm9EQEjugSM9fZ5OisfNYiEUGXTnzmZGzQYDFGBaBv5ZFfuY2crcvonx1S6OumHdaU36p9",
-	"TKh488uCFE9cbBuG3f3vBCVMoY/xxHDQBsni1s3wxAlPmZ+ObOv9bydbkxgLC3dtbniyDjh0MzKJEaWp",
-	"6ahT6yaUgZqbpasjxCSahpAi7mVZRqXTSwZ3DOXehWbHT0joEE2xb3FX4G770q9fH4z79Me8I+K+dVsI",
-	"fuATfYZ+glzdSWPhgEAAD7+TDyFy159x4IXP5m3fxEtLDaKf7OtQ0sSwjgX0kOsixDfzFOIbXwbbSxxo",
-	"fpIZmoWtbRrGE+S5+kNpF0Btv9R6U6hylPZVp+s9eJ7IeMx4S0o/r/FMURyj9FQhsKmwpqHSOBqaMH1b",
-	"M1QUXk85eDZ6Fl+BySdWtyw1uTGsYmpaw0y0NVuQRGlmDCpZRopRD9U8km5EVzeaSFiKoxvFP5phQlGM",
-	"PGWyMVjVLfucetRjD8TpOGmEKvuM4iNDJEcJeW4eVJkHf9VkDnFbVtfnIWJ//ToRRkMU+XD5UwXziCVp",
-	"9kdiXVmOO152fVrz1ycnNestwG1btc0+qHV3P8IKBl1X+BR0MZN5XPRVsJXZq97oDs9GLZjyDAPOEKH3",
-	"sUXzvB9ecW9HFHjc/VkmLCGAhttxzLEdl0mA/8V0Iw8FFE8xigvvkCriVXhp64HiY+SHwUxBXCtlt+gk",
-	"7mbBr3T8Hk3myEt8pFHauoEeWw7U6HaoCEhx1xOaxHZkg3/V0ONt7kGDByyyP0YXH/qX9+xHkzKYzrxd",
-	"59k9dYMtrz7zhd2Fy2tjEtucl+wwCS6aG/dLGu2uz1INAJcljpwU9y+lDi/pTpwRRaUncZl23yb+4yXy",
-	"EUXvuMPJio6xaVxN6hf7iJaAXy5BBLHIaSRcWsB4mc/A8oiWp29401PhwHkm/nXWJBlL+iQklArz1akh",
-	"3YgRv9RdyFakxg0M9qPhFluPz2m6980kX4l6+NNWoZVBm15fOR6IobhuvMCB/Oepy2NANYZsSrLHv3sb",
-	"XkmRiBsmbjMvxS2Xm7agblVit6o5zInnzDGyGyH3UjK6BiDf84QsjFJsFo11N3Nd5S8vyZuuzMrdIhPN",
-	"ulSloa8pC+qLVMA0X52NM7fJM2kan63yvRFFq3DmHpi2DZeDH6tJ5VXChMqj2MzfusZU7bU/QgsYzcMY",
-	"jfyQbtj2nbMrmz1PhTmT+KF4ApM93BM+rWiHJroiZYjnpCgCcaIWVm9q0L0L6xeKfV+53bqvtHTRqLBQ",
-	"O4Ne4M0MLV3d1l6wqzOq0V2uyj5ScxgEyLeBKT8D7Jmf/ggbHDyL0c2PKmKEa6sdXU3B7ekrTrKWBQwu",
-	"bKtn39ZYOutuXzcffJ1F74Xtzs26phCRojtPF12NDI3nC0WRTdyZfcTn2PdilHdzrdV5MblMYp4iuTJG",
-	"g0scTHjynbGvu1NoodNb8REX90DSbFXxasmezKeEH6rnGBdXP1s67w47wLKnfkWYHLG/sTke2ACg9z/J",
-	"yckrTso0F+moZVzaVCyFZcl28tbQmqN15fstqVP4PFXQ9RZiJ85pPwpzkX3aRmwowoJz2Bfbe00tUea6",
-	"k9SdtQyu/R63ysN71qcCQ0XbfC5ExCHCQAbEpO03LwfChNpAXFFEcMew86m0vbghc+MRK6JLxc6soUG6",
-	"BmuxtjZx4iBrmqw47VKxYuE8sLr5NqXAdGWVUSkSdefxZI6f0EHKpebPAnslYkJ2SzR3quD6fFCAkXG2",
-	"w4/a1Ww3LFFxC9KQoPBovlHb6H0fjBZ5BjQ65ck2lvQhEzsV2F+jPXOHRUV0Q5zyoMN6pB8P78FjSp6Q",
-	"ep107T1SfZzo7h2OCR0hcQNwp70r2LRXw/hBcYXKAViYOcWshqZsJ7pyfyuIeV8yX+TItJaQM5Gu7GLD",
-	"vvACeLi+efhyM/zIA0zSH4fnd/2Hq8GnwV3mJTC4fv9wN/jUv3y4uee2udFo8P5a+BHcnQ/v+F/nFx+v",
-	"b75c9S/fC/eDwfVg9CHviTDs3w3/LjwVdKcENvTN/d3DsP9u2Jd9hn1tEn3u0dUNa3nVPx+lYw76lw9v",
-	"//5wP+JLUTmUH4b31w8iJfPH/t8fdN8ISxMJqNFEaOIYDamD63c3bODzoXTFuBgO7gYX51dVo1U5dci/",
-	"HgQaPomIIA0nDZw+5N+idVVI6x0kj+acv1n6jco8Q7J/Qvgo+fQaTTqazMeqTeX92GWSTtXoEgKD9E+z",
-	"Irtn0SpkUjZcEELfkw86blKR78Pm0yuHFPpOnY2oS3NQFctCoVjmgexbsnWm1p8Q8NbKhLbgvYjZAgQD",
-	"6C8pnpCbiN4ktNqmJAecQwLCiCIPSNNEOoh5jnXzQ269BoMtwyI/FmfGPB8XYUDj0O9FPgwQIHMYCx/u",
-	"tM5aiq3f0NHsCMBn8iYhvWdEaO/sd7PZilczsvpbymJH98Or0gw4mPiJhwgQlX5+NxvF1sk1meXraJgd",
-	"tLZkBYcrG/2rlScKyWx3m8V2S2l67MlsjWveA33JvBempL+zsCe4rzPkr5o/8qvCwUwWXCC7k3Yi10//",
-	"W4TZLvPECxyY6vFFLzENAc+8qgzPIQFgjACMojiEkzkOZqK8DEdw1fwqGa8gEh51tCIUYskqeL8MDw9T",
-	"qsSFZhx9B7GfxMgBFO7zrQOSqw3BU52Z5/QhEUu1v+FmAY0wkDvL33GL2cWrQ5fgN0Vk77jZUGoaxhhF",
-	"MFVNAKQq7k5S1Wbf8eySwAiwXS708yeqUnj9cAJ9HuX2hPww4p958LSXTAp1MTU9VUuYvb1M2T/S4kSV",
-	"L9qqNJWs8rjLck2rpeOue+CULGp7nlWf7VgTLaoeaPkIuaoW1lO85ihSecSzvdJzg1qpUdDO3hxOkpSb",
-	"nUliT8vwL2CQQN9fPkDPq06Lh4k6e+Y44jILfYt8PMHUX4JZDAO2879x1TkKZWUgsgwmyANPGHIG6s3Y",
-	"aQoonJHfj8BN4C/TMdTYvAzpHD6pGq9svwDyMNfGYzBGIEaL8CkXH6wJ4BfjD/esugwRda3vCYpFj9tk",
-	"7ONJFWXz8SoS5Osw7w0NS3JchYaHcp/UoXDz5Zpbcs4vPw2uO93Op/6nt5bcJ2KY6vwQ9ZfmJnfkKkzk",
-	"4NCMmKuaLIrjFePkUgQoyi/WEksNYv3hw+jq5q7T7fQ/C1vS3fno48Pw/prbqm6utZggnpfm4ubT4Pr9",
-	"w5f+2w83Nx8rcJ9TCk16MYwXFRkX+HcZR2A8b0RuCBqCZxjz5JQlbVH0NmcwaJaMwpyHYjOpJcTY9iWa",
-	"4V8v8WFKE/Xsm1KQW2KJug1rnk9igSiKVVYJpRaIscBv+AgdgVPgwWUXnIJnhB7ZfxdhQOe/r+g7laLH",
-	"mGXCLnYVom5DH08MmZvFBabqTp8WMRVNDTpQA7GbZ786x2MJnH110jLtKlCtAknLfKbk0eeTTrfz+dQs",
-	"SmSZr8Sa5xd9oygOoNWhUn3XU6uIQRlxape9daPNijEKGVxGrAoYdhDhag2aFt71TYqqVdQu+ZEOODJE",
-	"AdmLVa0ZOFEdMyEA+hULSOkrr8lhsZHaTVaVVAdE9n95QOysdtBPE61B8CUNgls01G2lfGiDl6OV30ss",
-	"XPiFO9nZ03+QW5iQusz9wlMPYAIi3hrAwAMTGAQhBZCXBwcBykr8GvL3l6EjJrtJrd0Qel6MCNHth7nr",
-	"gTJIlc2I7MMHSOam42YOyVwf8j9IYTp5AAkN+3bphwEYJVEUxhRczCG1TvgZxXiK69DLraBMBj3J5tJK",
-	"k4PBzAlzSG4hIc9h7DoHBJHsAAiiG7f32BnAwyTy4TLHCGr/Ghsc89j9aiGwizkMZkghyMoEAXq2I5Hz",
-	"LnrOsKauCmbYV9A71Mh83VElICkQlfhbD4ZSomr5pZvDkw3lV+EMB6tX0FyNv9cqqLl3GFdrjOpwrbLI",
-	"HRS63U5Ii2DYw92SDgnOm6ar1WSOI3Ko1uOSNX2Hp/k2ThkxmWnbPp++jWEwmcsIujtIHq0sN+YtbRYS",
-	"8RVg/owT8+xxYBqHC8d6VkHoWUPd2LeVB6aQPPZdrTtZLJ2MEgSsO5tYLO9ow+adAnApGroZsr/adsmW",
-	"zsB1m+RCmQKAAhovN7hRKw69ga16sQ266F9donEy23RZ/K681hG8SHxIEckyJHHngEmY+B4YI+4PIpR4",
-	"GMiCk2EMYNE4WZIAKKvwb0T4Rf8KZG34NVtUTbOE0fgUxbdw6YfQsoMyi1Mk2pTXB9UnpsSDMGA/xOgJ",
-	"hwnpybAQOUanKulbeWL+qTwfLYX1yxx61RZQDW9qVrOAzSijMgGJhdzZJ5VJEuCprPOpytbJIoqmncjC",
-	"jkyxwyTx0xjawg5no3fZhLx+ICHTxDfep9xi+8pYUGF+pcAga5CbdQxLfgn2LbfEdF28brJ4NeDe7aNR",
-	"Ze2Gz6cXPIKt8nDMDPXVVt/sHYEoUpzAAMRoKm1YWNxr2bETxnnC1Dvr5uIN55ByS+b1+ZThQ6Xt+mHe",
-	"MBX5x5oSU9YkYnvNFOjiaNBEPhFC7xnFKCtNujVU/BCL4DJHLLRi+6ulqMZf6pJdlGH6RaBCfCrhaBtG",
-	"i+adhJFFleSfzO+rYrwjcE9k/UqSjInwS2Uo9/j9QbYiAFJdGrnlj6zM+73h8zuX5FAgJHfkWQQN33KZ",
-	"fEXb8zBAN9POm3/UCjtD/7eQ4Ml5QuedH91V+p/fDkTV5FU6f/h0ftH58dW6ODk4f7vw11ki4gAWNB+2",
-	"6FppIocSkEg88a53SxsV82wT4RSwViigeCKpMOQGTcUgMheLJvTPbwcPH/t/Nwj7YjJ8Nb2AxEAtdpRy",
-	"ZJhzn39Ey35jrUtfklDvHtHyCNxx51ACEulAJzSkfCt+XdJxoYTI0Rqp81OsmqKak1jEsp2nWcOtKqH2",
-	"aCI6Fh7XxSqg0NNiOVoqBcUjD8/XL5eGSdbZrLEwKbAe/vkQZdxLzZbXWwrjGE1oJtloqMAyh7pwS7Ny",
-	"anXilFHWRepheFKpeIsmKerMbFJelXjKKvaWBwYypDqyKMwqvCUDu2tiB3eJnEnUPZBbunjfkth6ez4a",
-	"XGxXaPFzYg+wyeDYLjL5SjeGy0s4u9ByVxVztRmyWtWr1qp4v0FD91QB8PosXwZeklYkEXAezvoBjZer",
-	"m5EyCcxNPdy9cYpjQgFBKHC0/OCAWGNgvhgmUO3d/ScxGUGKSd2LZTrLHBIwRigARHUzvyc+OhS3LKFc",
-	"VbmssqUtwiCkYYAn0PeXAAfsYCdMCVdGNllgS5yPfjhzRHW6Hldcpx24OQBTC2ocszVA8ngp7NjXjQzd",
-	"eXssh1AtXMKKSfFCc2SDYG17sPv8a15IE4JirVhp0azAYySiOHzCHvJSM1EYAx+Okd+VRkG2qYhQOPYx",
-	"4U4vMF3OM7RUf2MfLh3eyT+fflEtSwd+yVQq2SXPjTnu75YtrkWS+eoi1Ip1UYUn+pfzwd3Du5uhcPq/",
-	"sVh7CkOpRyxH0W2UrgYZnrZk+LsIA2H5td8YjH6BjaRPbqK00K6PUHQp/Xw+ucb411R8KNbdY3NVblsZ",
-	"NG3vRlf9/m2n27kf9YcPKr7g4sPg6vJBZdqw7KQl186Kpvj89cuY19CpwGWx+4ZyTDrYjMJpBoBufRwj",
-	"AIMl+Nvo5rpHUIyhj//NxYNY2dFK1qWKyQoXpjAGE0jRLIzxv2UHYkkIgIKqDMCEwkWkNBJ1NIpg2YIq",
-	"Un1INQzQ2kQxTjtRpLdZla6x0js9NXlzP8PMWppdqMfLwoyOgo0z050GjEmqyWlwMJOa/HUTa4KMd01B",
-	"zeDkugeMIh9PCslbzSVYpO7stqgv5Y4VflqlKqKGeb9m4mcPfCCUILQYuMsba6nvUU/hqQWksI2cHA17",
-	"WJv22yEld2VoRpwER1syKdtLYNrJyp6Zsa56c9owdUV1OKbl62DN0KJVk3H/5VTCWrRqMm7sVslaNmsy",
-	"Mn/FRF490GlD99GLsQNpoex/qfLZ2ezpnmhpMaW0sNVPavC0VPWgY74UX6KJD5ny91STPVtyNibAy7qA",
-	"32icoN/ZAR7F4SyGiwU3Ev42hT7Rs+5sxgHXquNoyppSdbjCVsbHYbyXbUKhqNj2Bs9xdWNvUrBWVzKz",
-	"veBlZKGz0V6cullNq/ryJZ9P+VUzMLwZQUrRIrKovfKjJsF4YGs4Az4OLFHLuWS7hiBc9pmRJ6dcw4iO",
-	"EcvoCfn1SJLLvuKt88lLDX4B4UxZOWovULbeooXxTpPPx2vym6HxEvBUnm6YXtncxc1MJvFlmG9F25Ym",
-	"Rlwm2ySnZwSop0hNN++rzg9XioyyIj5v79/zJAJpTswa9x010j7IBMXlFl1cfq6q238+uuh0O5f90YV9",
-	"ueQ2xAEVEdblJQsMGhNTCDQaP3F8G7/wLTDnucCC6FeJyRWN3Ha7IEf15RvcjoT7W8Ndy6HUUaSLItqt",
-	"31hTvzGBt+24jcVy7C17jQ0RoWFc46otCziYQ+ELtwrV1Ez0Q3HpuEQUYj89wYqWZDyh9Tcg2YwjkfCz",
-	"QL40yZzAyrVynEwekSUTh0gGgOK6ucQcMuuHvxTevjIkc5WZS3X8xYo1gCrRl13BUmF7dcWT2gwuRBrq",
-	"m2uV1Nose9l+26zOzdU45dFuqa/yqSLPO7eaqWfzen7sp81XzCvvXOWg8mLPpCsi9cs36FVGZcjplVwc",
-	"U9x23CyBvLKINau1hdWj1kAllRdT63uW4dpMr+mW7YVOkxG9RRjmKWz7yeIbZodXY+WywhczwZvTyBez",
-	"w4/613cPd/pi0jU8CKWllMr+Ytg/vyuUz/84uL0VH2/urxh27h5G/evL3MiX98Pzt1f9h0w4qV+G/dHd",
-	"zZCt1S6kmlXrdk8KTHAwQY3KldCEoKbUlhUJKpVbDSj2V6lsX12etVkBVoEEO+tWaueSYo0SOEvGvyV1",
-	"25Dt32kZG9Gyi6hx1LK5jpUENnxWVFaJjVpTNZAGTatBGIxOvObQl6pSIYW1NsVthiSjP4IGmyaiU6GU",
-	"lYS4uPl0e9W/K1WCqChwkXdpW60Ebt5gYTSArPuyzy8O8s2ohP2NKnC6U6D9IqNaCUXZ/a22xn+wxgCY",
-	"PaWnOHmGRLqWN3D28la2fOW3QBtRJaQxDye/FofqAhyABfZ9TNAkDDziplPXReMVZgG/pWkFIUWEst9+",
-	"ry415ox+Nrzq1sDnsSYWsgLlkuplgLz6MUIBjPDRdRhcJ74Pxz7624jn9kxb9fAiCmM+qYyqLzeOILtJ",
-	"d2aYzpPx0SRcHM8hncwR7XnoSf19DCN8/HR6LIo8HIeQn/bfeoEcq/OGvzG5ln/FpETHgGeMKQYPlx+s",
-	"MOnbLs65fFts+NQ1Ul2ieeRFeqX9jVDs+8KATNj8Uqb+vvlcNcliFMHnAHkXlYJGe/gUzcsip6qiXXlA",
-	"8a0hbxwQtYlCnXerGdpFZ2ug+i5uzg4F4w2yZ0tF44vKfFbF01IwvqwCrGu6W/3FZEOzO7zyVppBBhWu",
-	"8xWHeXMP+maP0kf8lRYuIp8LtfHZ6R9/nfxn7+yPP1Hvj1fwdQ+evfZ6f5z+55+n3ulkOv0vtAF0Opm7",
-	"0jSl0tqlroAXYTDFM2NS1PyDubPTktU0pbkQrUB8NelmrbPJlHq2mWR6PsNE65ST15/6dH2wK+xgKteC",
-	"4bxKz5ludjUyOodnf+T8yLU69sKklvMkMG/B1+JVZbv2teoXjE3p+6WsZynwEhL7VfMOL6Q/1hZt2R6K",
-	"6Nyi0bNPOWVCvgg8Q4riKfR985C7U7EPUTncpg7TUGSLR62G28TOL9HRfaN+NVVqPc8T2/27VZd+InVp",
-	"Nd9qXfs4WkczEGK/cLhf5lSEVY77r4XD6yVPcEZNvJ5fo4NcHrobO8d3ViOg24liHKraxYYwJPnVRkoG",
-	"Az3PCPEQBv7yAZscf4E8EcHUhzOAA48HgAcz8JyllWC99dwSWVCnsgTl59cO2aImXxPFIlvXJ2TMjdvV",
-	"qil8PhW5rdt0Qyu7T5sfiWTK8FJKn4NLz9JmV1khu8peJkcxUOkXLera8cRgXQYULcxveunXXccVi4SG",
-	"zWKm85HQJueCLQUrm3ZCZgx5Y8pj4uZXpTJaqQ4/uocgX7ZeW7zNHNVmjqoRjpuJgCqP3yjwyDFflZag",
-	"6KsuObREdmUZEuGPtkwB57cDzrQakvO5jUxEMEfQQ7GbliraFjdRTluLK22mrlrH1yoJeq7Jy3wmq26a",
-	"iK9rS8ekjZPL9lW8zDklzmdLHbNROEI7lmwrdiGmvtYOVEBZOmpNCv189it/xu5K80UuE8aH89NOl/3n",
-	"7PWf4o/Xp2edbufT5etq7KUJtQzFqrSJ3JNzpb14naRJ6Ek7sfMIfdWJOw3OAkiTGH1Ym47Z0CAdzygw",
-	"8SzgtfYnMbIYgQj/xtkwlWV4FjhNUMwgliJKw5N5xUXQammkr+E9zWvW/7+dLmOwPteGxB/3w6tq8tgL",
-	"N2Klcjm6/aV3OVt0xWQOfR8FVQ7yDTInVMYlKpegwpGYah2ut9TyAa1t7fv+dX/I5eb7wd2H+7fc5Xk4",
-	"uO1zb+Xzi4+dbudqcN0/547Inwf/17bnmSVo87lxKj3omvudKXN/63vW+p7toTfQGpel1omq/AC55oPG",
-	"fj/IHcx7UEMvlxq3EsPLkfQ0Wev1iLfOno6ya1veySTn85H6k+j2du00FD79plCGJHD3K5KZscgc1huJ",
-	"9BRBrP27MDbAox5eeWI0l8hX3jBTRvL+QusHdQlwyOby2da6YJVT7HRyOFHoVpCVtzavDuS316uJJFxZ",
-	"ylc8fuoJLSuAfanXS107avB8acH4pp4yv5icthSK7IvZUfx7wbVQT1lx/r7T7dydjz4aVXKp9Wch0/nd",
-	"3pVFVjoiGZXfJLZUu1N9k9hvZGiTBhE2rmmvcygRWcbtNU43tUjiZhJgclUWUmSnGBhMQRBSoNLwdgEE",
-	"MQy8cKE6PWPfB2MEZihAsbrG6NR1tjWMN0ezt58EuNre7JqUUzhrkc2klt10sVPLS178OFlfcl2sjCkv",
-	"7Q/Qsm/8aR8GHtfM5SsNH2q1K/8C0XnoNVqtBP2T6Jnq9hehZ6HaD3d3tyoNxST0UgpWhh6HpBQaVlKY",
-	"cxN/dUR4NQlJVNac85mhSrR2zgdrpICVaedTunWZseuu0+3c3oz4f+7vuJZkOyFFgCipih4l8k1I+NpO",
-	"YAAiFDO6yq249oxnehG/7RqToea9iyAheBYgD2SduDXo/n5wCSRJ7/6WxzPDW1AlE7GI7PGczHO+TUI0",
-	"u5GHEHJsHBMafUjoBwRjOkaQVt3Xc7vGeomU9hDMVe/8Tfns5Oysd3rWO311d/r6zcmfb/746+ivv/56",
-	"9fqv3snrNycn7tnroGAwdmT3Ve58W/KcF4V0+6ez/VSO0QQFdERRZE9OLdqI+C2elFq/lzYgqWF+LgNV",
-	"xbJoOPKUIk5qE1wSkPUCYaDvYgPIivMaoUsCtoWDYBq6cc9Q68CdX0KaXZAzO/1tThTWDzvKxuHBFzpy",
-	"2DcAnyD24Rj7mC758ezjBU7tCRmR/8YgeuAFeHr/k5ycvELgu+rso67oBn78bk4f74e2s4mgBYzmYYwA",
-	"ayTF0IpEM1Jjjfh8Jp8iJ2ueRF0h7YhLnzTMTBx71pyr8iAWR0NhqfW2ONH7vk61vR9eGYZvquny9kYt",
-	"RZP6pUO6sjaCyqbJum7aLekJ+olFzPFPdZNXp4KvwMPLP6padfoUyGFeKuVh9WEwS+SLlLO8Gl1+JOIE",
-	"FZ2lfdicNcysdUlR2f9GY2hsQLxH+7ClxXGIdN3y5uqcJzK5/fvdB/6+cff32/7oYji45Wmg7t/+3Wyn",
-	"KcrPEk3Vyk8oZBobmlFaQY1VsrMuRCltCJIgJ5lzg5dfGTkglhds+A0vkoU2SZOhCywi5rFzRjnLzPnF",
-	"3eBzn+f5TP+8Pb8fWdLJaKJVd4/pX737cDMSiWk+nV+fiwRbX/pvP9zcfLQOxA/sslFYR5E5NjH9xSEA",
-	"oNvB5BYmpC5SMFNNCIh4e3Nkwj/DseX4ZF9MADkJjL+FY9MhuRMd04o5CmeWtbIvK681tdZC41Wv+sFO",
-	"Oh5mt73KFcgXr2aCW3tcU8istKIbDuo0KMrCivLRRdz7JobETDNEte/v4zCJDP4ggUrRJBLTzhAl0pc1",
-	"7QpmrG+qfGiWfCPCuAQZ0RhSNKst46JBeJXr94Pfju1Xk7LQSyGmuWezUiGIV2f1UlBNXVxN14jVqi0a",
-	"XJpCn1IAB5dGHKrexXpa7+6vL+4G/NyTGQPZX+fvKwUkG6RRJbTc7Ab2Ut/NWtJa0c07VrDMF74fFftp",
-	"zZnHmeQjqgpUpiGFvoliUx57REuLl5EanpGlWyy0uq9DQCI0wVM8ySYBv0WQEOSBJwylB/vvZq6wIqKB",
-	"C5r5wkvjBBnGr3vR1X25UpPM6cnJidU3yzhM3puqoWNUowX9MxwrMeZ6jlsqdK2dN0CciLs2W4q5pfXn",
-	"ZUDIuRdt0lVI9wIx+gvZa8K9XTYY/E7rVXbgaaiSWF2A1inykg2kO/doYH+tFiZ7cuXW3IDcD4VhEqxR",
-	"9qE8yjuM/Ny5r6fiyWg5J8U0yVgzyUi5N7Wyu5Xdrex+KdltmeMnFO0V/pEriGY+2oCihd3j0nJfqe9s",
-	"CEQRKc1GPL1hdaLxNX3QsgyKG0+MuIEB3cpFl7K+yEV1S4jURq2jnpLF8bZ/fSnSWWeJrQ3Zz/MZrtNk",
-	"2G/PLz7evHtXe0ryaVe6N+cFip0Y7/LipOiBEwa3muQvwcoajCZz5CV+RZiUpfPax9GXYgYiRwFTs9nk",
-	"gpfWtPol5RIfbZEdqwrHktpFWI0EIkNcAzpSQ12IjnVaaKF5af6MIYxJ9avqFyimM36UzGX8pni0eVWE",
-	"qsXewZkJvX4Yb8bkH2w4bZA06woIq+hHCoWLmF1kpma5YGRpwZcP2HNMlFGYkLvjG2fkcuTh0ZJvZM1p",
-	"iXmFzTWDAt4MkhelQRirDJziZ7PKvVC3zOjLNLAH+QrRHM0ieZJVnm7yZasKDE2bLbJs7gnDZUP0Vw/u",
-	"BjOFiU9vK/OXyUbWPGZOjwTyFvk3Ig7ehaVW6d9GN9dAAF0O5OEjGN1q1LPgCz32hbEn/DMd0ECk2nGH",
-	"Fyi0lA4jFE8el7akJOwbIPJZxe0lUZMXDdiW62BPp4WXMicca31GIq2ZCeVPGWXbEzO7LPBZe892fbdo",
-	"nADb+RqolqUIIzfQ13pO52S1ybehJvS5F3uyK4QLhwpiqRw5jRESbi/WUkoL+K2mxXMzZd9WBUnEgiRM",
-	"/nL5KSAcIxijWGU44Rjlxwr/OduUOaURv/aE4SNGqjlmuyp+Um/nbzoyqDnrK5PdsN4JoeHCcbIfXOIL",
-	"7ypDPIGYBZzfDngxQMptYvlfU0LsnB6dHJ1wOhZh3Z03nVdHp0cnMkKbY4JHYfuynv/MFDLzXj3Ps1YB",
-	"IgSk9hi26VCVYupcye/vORpUiAOf5ezkpDzwBwR9Oucoei2+T8KAyjQbMIp8mcHo+J9E8BVJD8AaPu7H",
-	"ccik8I+Sx+p1SNN15Iij8+YfX7sdoipOsVVnDZVPyT8kzJM5mjx2vrL+HH8xgt6yHoGsGa7C4FA12HcU",
-	"8gUDGgI4maCIAhrD6RRPajGaYqAWpU+nx9BnIiWY9dACYr/HH5LJ8Xf+s/7bD4EXH1HD7emS/04ATHN/",
-	"se6Adxdv06VdOGct+qwBd7UQI3CeieECUa4P/KPCyac0A5C1CzpvRGaEVGiUltLRhZp4H8h2bL2S6l9L",
-	"9PSHwSExmUwQIdPE95dAoNTLJU4rIe9Ht/PHrijvHCygz7CAPMBzankqEEmA8WrjYJigeBfGY+x5SNw+",
-	"MvoWdFJFZori73gTdlh968VS5eAfRN9O10AYX/m1l04MlQ/EdWsdEhcj/BwkzunhbSjk8UaIQWBHbFoB",
-	"cWkkW5lMKrFFQ5AonOex8cMs9jeyEOMSTLDnxIAAtBUDjmJAUMv2xIB+QEa4R8NHFLBTUf3NT8MoNCU5",
-	"GKKn8BEBGPD0jby19NZKZyyIiQjfsVbKoMO6u0iJdHiLTFCw7tVxF/PlSTrn0P3cRE2aULUkHbaxd3Ln",
-	"FBlnv1VRcrrlOQqe+GHiHes3dLsGXcodp649fBCAA0JhMEElIr5gn5V7iV2x3j5uOSAgCbK4jX0hsBqt",
-	"XSBYf6+XW/9Je2H71lND9MJIOLvIE03bb2EOP/7O//ujar+ZlOKtjkobyq3iYiNrJZFI6mxTTkRSx10K",
-	"oc1ttky2VHN4i+pET1KsCWzwHWtlW47ENcxk5C1QXCHVBP18tVP4cZ1Y49uSSrUamr9MBdivTve86EFL",
-	"+3tG+wu08hluPb13d3DLHGxNaCo9Eg/kIN/EEc7GOOZ2erFLxLrjV5iwC5APcq1tG8xaD/INt7bbbC65",
-	"49qUDTdf5cTJrW6fCCHder4RhU0o739uk8MA05BJ8+PvguN/HEdxOEb2y6V6+5QVKlUtCm7XFbUsRBok",
-	"+QRmZ/h06tuQ0GES3PJ53W1TtkMvlVw7PvUqCAp9Q5NE2VY4fo92eipch5TXJAhj/G+Rt15mORIx3CJK",
-	"s2TmpBD7yAPCbg/49oB3Up4Psm01Hxw5MiM+nDwef+f/cbDigxFrqFWEyVMO/yrTRbkb7XNjWomHg7iX",
-	"1vk8TvZJtTndDRj3QUbCYuLXu5lYZCHjyRyh74fPbHrTi0CRapXo5b9XqViC6PIcE5Dj7yQgTtxyPdKl",
-	"fplfAtKATfKD2RlFntx7xyYFZLSMsoeMUiLYlFWuR5WMEhADmyjFRbM2mVUXNq+6EpdYpPHb2IvpH127",
-	"IUCUalrJEqDBcPb6dQ6I003oQFEcsn8grz3D9og1bZdIXtEBwChS1F4+1kSbAj9SOPbRsQdn5DhNBm+9",
-	"NBJ+a+TtAJ1DCsbID4OZnlUgTTwOZ+Ur5efTS8gLOd/xqVzMZSrld5agRSTh5izzrwTFy4xnPDh7wF71",
-	"MbetCBEnuVOA96UuPs7UW12foEE++Us4u5AxX+YkZhVyiE2pXv/4rL+2lbDbeb0r4cduoXgR+WiBAlrS",
-	"DbjxQtFB+nQOyaNRwvCGx9/Zf2qel0Tti/FS8E1RgLAJHE3tfBzroc8A3fGRDylFi4jKvCwWoSAbdXRY",
-	"SrFQ27TjF6p8NDK9caz+6vz5h7j7bH/WlMifIeGawjRMRJKmPRERGT+XRIT9zkBdRMixH87qdBU/nAEf",
-	"B0hlPpJwFCXKVTi7woGo0HKIUkVmeaKhTNQ7Xloki8jmaITGVnTbPCNPwiCTZYdghihDNceyZWaCheXR",
-	"MHNF6gbLvSmtM+A0dRJQ7G9g6nPA5F2Pom8UEATjyRzwmbSqzxXr5x1MIr16rZyC0RPyfyO/s4lwMPET",
-	"D9n2l7UkHaO2Wy3wFQuwAVyVW08lt2GA8SgVO+Xxzw/j5UPaKQelE3ClnDpOh6zT9uzBkasLoQYKsYxi",
-	"bd/N81ppKvm1Y+cqnK1/6sSI0DBGVZ6cvIFwGMETtk9eEvMUv5bjhx2Hste+Hz/bZQGJBIkPmbSrVvvk",
-	"fVrlc3+Uz4J3qmSH7SiB7P97WSS/3dlBq6QIqxiRO9f8BJogecSR7SyeTgnaiBq4VcVz+zfcbK9X8Fdr",
-	"rVDtLTencpgkzPrCjrfQXswmyD/20DiZ2TWQ/hP0E16QDVz0rwD6FsWI8Bh3OIM4IFmBQ1nA24MUHhnk",
-	"4QXyL/lUh+Lhs/kAs8+nF/0rjoSaeDKOScJEIS/ozcSEGfk7DSvTwXdUqJCkHs+whvaaoT+Mj5NZicU0",
-	"nr/oX9lZ3onX5b2hJzSecQwDEWNqZvu3/DuAuesGmMbhQn+dC0IPdUVFRszf7QL0DMaya8BQ3JPPvuwz",
-	"pgQsuB8wMQmISzETk25i9l9aUggUaDipERkS64qodysXDMA6CggJNo+O0C+2rWxIZYNkxcK9XwkGlakF",
-	"8FzCmxQR3/V//nB4yBcuKH44AyhgGq5SX3TIaxhfKNHhzPnOtI+uODmRqXkcmGHUsfxCN7xwati7HV/7",
-	"bDAc3F1QUnOOkh1NsCUEtLfCXd0Kry0WJ34ZUwyd7o8mf+V2A+GCVHEry/G5kzh2sERpgpfkQvxNcraZ",
-	"NWofJevPZJEyPAkqb6hHtCTaS5N1Wtau+fscJwOZsqzuZe4iDAj2UKxIjHvphROeutADcEqRLAMo3z23",
-	"+VpbDcsYTcMY1QKzqffbd2JraJiDBsa8vHc4wfzO+4zpXL8u6aXDLPBlefksO7tlP0P3deXqoIl7nbgD",
-	"TlBMIQ6y3GdV60zTmaOVXpq5a5ElHXrV4tItkascL9kVBMdA+HuaIJYZz190W8ZLkJUYyYJseX3q1JJs",
-	"eZQuV2AxLsRQDU9N84iWPVEZN4I4JuA3D3HBx7hvCSD43zf/+3tRbFV6kbt5BpBJGCEneShauq6Lt14P",
-	"3u1qku4vCu0Tft0TfsobjnHvDRS0Y34Mu16P+dnupKl9RMtDUda2ngdC4aIpI3B0t8xgYgYgtcctMMT3",
-	"p9Neg8w/3DmbErODdpMkQHscmGWDSWHqYN1s3NITtQeUS24W0iQvS0o5TpwpdByXY0q2rD2jhEramhP2",
-	"1Zxwl6sM4Tkp0LW3z8opSldEfhkXcx6tX6uv2V2BJGOCKJjAwMM8Uaei643eHqpWDO4J8jgbCVj4E2kZ",
-	"HkjVKzl3frZUHdzpxUNj7QaCXYmYVrLntS2Fl0y2C/xW6Vpdy7P8Ba/VKh/axcBW0Sza/truNhwFAh0u",
-	"Ljfc4yYlZenYEAY7fk2X5FHHerJurwZw+1z00s9FKX+mvOnO8+5aHL9gib9d8sPAOknRuPLBfqlxklsx",
-	"T2zlqbWYL1spJg7ztuUoGlQynFYsvKRYcGX9rkaY7OiviGVPFXi7wUTMdsgWk5Sff3EunoW0PdytFpMV",
-	"ztgio1XWWak/Ng88Y1Tu2EyrlLwkw23jCiA2aeUrwAtUb3GWD6pgSysfDu+Ud1D2/XDWi0Ic0N4iK/ld",
-	"oRsscJBQxHQD9VeM4KMXPvNylH44A3Kc6lown09FvRoZQv4e0VsGhCo7fqjSrk0y0SaZKHhkDy4liHVm",
-	"cdatL3u9lAdQwdaeh9y+i2mRb/yCcBOKogYws+a7gnfraThITno2zDwfzgA/AZTkbnPV7U8iqvLmOCYH",
-	"cT38G+ejcjrPf5IH2zY3Vas2tLmptpSbqtWdWt1pH3SnVVKY8YOzNZWumcDMSUfh6ZLcbBMSHpXqGcRJ",
-	"4GyNgOSRLUKEefx8ZggNDTUqhQOga6sY9dBsSssoOo9x6RZ4Ipxoz4VyBGMUiGzV/0FMId4FoEX7B9b+",
-	"QbV+4K23SmxZknvhhMxjuaio36UnubOc3KIhDmYPvPuuID83RDg99p5k0JGDypGFOj0sKmOdXvaA43ky",
-	"kmA100BRiraWgf2xDPC9KRsFqtN2uZ+4m3sT0AF1OYZ/lrcAfuCp4GUtG5z0qOt0O+gbZFvcedM5Ozk7",
-	"7Z2w/92dnLzh//t/Frkju59PxVPpJg5IDmka2qyDGjL41gB2igNM5sh7ywdvDu72ZeMahlOOptZyus/y",
-	"0WY63ZCUJMcTGEyQb89wdsG/pxVHTPJONPm1Pag5Chzyj8kqTiGYKKTtNDEhn9RHnkjDUus6rZq3ZYDa",
-	"BKwlGVWQDBuXTDGKfLisSvrOvldKJtHkl5ZMAgVNJFOskLZLySTAdBVMsWzdyqVWLqFy9vucXNikXIrh",
-	"BFXfJW/umEhk7eRNsZDMqCilbsYExU9wjH1Ml+8RvWNdD/bGqC/Wwd4XJ0HBWvZCaR1JBIOXSOWYzntg",
-	"6RtvKPJHEQxWyOOfMUgrsncmsrk8slULyYstTWLmZNOaolPWxXXJrCCb1mZW+CLatakV9jm1giAXwIZ1",
-	"y03G21+z5qu4rUiaGKWjOPs9SKJzBlR2qIC0epIXz16gs08Dx4GUkVvngbzzQIoYLem4LAa+bgaDrKi4",
-	"RQa2OQxkDgOJjyYRTIopXyiLgaKRJmkMFD20CtS+5DEol/134P0GahNPZSD/4ZbLoFZmHHg2Aza5cttQ",
-	"LFyf1yDDih3Y3T7hufK/ylXQ8v5ehDHWsndXJ7eadAWKfmW+AqkeWvj2kFMWFBTgn41HVSaClkctqQhq",
-	"jkkU8NIPMaSox2+gbHPl3jtyWV2ugtpj8cCzFWyXw7aXeeDnVdxV+oFWMOyR4m6QB6uf7OYb/G1IeO5m",
-	"HEzCBQ5mKb0uECFwVnHCD9EE4adWBjWRQUHi+yXKD5Yggks/hB7AAYDBEsjVdjsUfaPHkQ9xgdKKU+5E",
-	"hjiUARR5UxUoalmMl84EL1X0CkJjx19cNUGTJMZ02Xnzj6+6ZBBsa2DWVeWDyy1fPpv24iSoeyHJ15Op",
-	"fSPJ6se07yT7X9GKyBo/Ti8lO6sHxAOgYOxjRHjdauQE3hajsXxIm4CysYDvvYm4ccxifiBhYgyINMOa",
-	"S+51FG85turLHNG5EAAywB5cnr8n7PQKA3+p/67cjIwCKfCXD6pBrZozDkMfwcAhmE53rXHB2QvF1elQ",
-	"1gXYOdR8e7FAOzD14Ywftc+SLsKYe1PoZJDeTmHggTCh7E+pbBGmfbIGSgs7ApdoChNf1K7/X0YP/wvw",
-	"FCQBQfwYNy1fzvSgBu00IyFZGo0phBKa4f319eD6vTx0wDiZPCJ6BM6vrkCMaBIHBIxDOgdh0JMcypaG",
-	"nvCEX2cZWXfBzfXDl5vhx/4w7SMYhDuTxkkQsJtPGEhHOBR3Qf/z4OKuf5lvnxs1j57zq6sju/8YG/8h",
-	"Tbvo7G0qOqYJBLcfpTMSCmbT1/bWt3WPHEvFM7+uf2+pwHjuPnDsYRL5cNnjrik1twPZlpfaFa4s4bTi",
-	"ylB9Y7gUg3EXl4O+PWgHEUnfDHNIkWH9En0SdXa1STt5qo/2g0wpYiaBVnS1oqup6FJ80mN8Ui25cjzK",
-	"dS1z4dysTEyF5NIyKR2s4GqtAq1V4Fe1CrSXlRe7rBilaHv2/0xnf+6s3YkeIE039ljeO9FA+TtXx8xp",
-	"JNo6Pp9K1GlIqXGiyJECDaVn8a69J7Q7BqIQ+6SZB7ROIe1bZtEhucBAG2DwPD9zb2Ttl5oC1nmSYwcz",
-	"piTTlmiYXrxDcdr/T8fjRPE/HRBZXCUy+nF0iMzBIOzmM97T4p+gLe9gKyitwGXtKb7Hp3gxpt2Robsl",
-	"gl6BxY+F6l3J6VSk7mQaejgt8P1RLRfLu+fKvKxPr11ufk7W1i/rLUvvqfvhRZj4ngi8Ztduk+ayRwnH",
-	"clxFFDO+iKzhGRxlsf8quyEPGBf3d6d0G5rAYQzU5zO4mgh/nTr4mVg1mot+XonKCaK1drR60rqyi+IF",
-	"Dmb12pJs11h6vUf0Tk5xsHcfcxERFNG5SEMm0saAyRz7XoxsbkG8wx7lxhGCRGxOK0kOXpJU8eemxQuK",
-	"pExRf/44hvFkjp9QnRYkW0kweTEUkwgZURRJV/BzNbCD+FDjWa2nCt7WLXw/83XJfZd7vkLWLqmKtxfH",
-	"HSZZTLmukGixLKRy7K8xv5JPbPuZbKoSTSkL18skl3uZaNNAHomrWCuNfh1p5H7XamXR4cgijfE3Konk",
-	"C3NFonr+9EXkE7LFv1XU+LzQXzw3/SIrBhcT1aVcFm/SL/MGKyBs9Ooqkfpzc94Kz60psaW5huVDapHI",
-	"TRSd+kzU2grEm6h8Wqkk8KY5YlL/ZzmD1da3GzeJl6V4lcWlpfbdHjOCGL0QiRMGfROqQaksiiuz5ZIs",
-	"VqeFCcRsOJhV89XhJIfZkruRQECTwy2KGSIpFu7LiUJge84d0jkn+WQF1qs4746hzwgjmPXQAmK/N4vD",
-	"JKq0mDPlTjnFS/LiYwA+AJADFFn3nDXpsxbvWYNDCQjY/kloQkzDAmLWTWh5J29GrqDWRueY89WnPFcd",
-	"Y/zyvrT6za2AG7ezroTyRle70+2y9wonoIGGWr423v2M3LbZU/KYIErr3pQJ3z3VBagu1UG/GrngYDaS",
-	"fQ4kz+iOjkkNMWuckfqetKxkuNYZ0LQxPopwj4aPqCbDFji/HQDRrpprziN8x5q1+iQ55g/KtwOOD+KQ",
-	"zc7EJ+phvK0sUVQeGUUK1GrMkP64TnWJIKN2N2JvdUSOAEXrmlq4TRNGcdKWvzYcL5UxU0MGqzpwHJ7J",
-	"RcGr3Fu5LZdj9lra5nDc6xyOj2jpFPbP2jXP0sDJ4CNaukTRZzClfmuDS+KaZE/IisYAKl+4weWKIGbB",
-	"B2tkvHCBcJgEIoBGGr7M9csQjCdzwOfUoLHnThAdnIHh+zkSfYyJCCF/Hw5jrwoH/PPb5TuMfK/Z1Dd6",
-	"TwsOxOQejtFE5lmvgOFSa9Ycjqx3JbFkiTbQEjxBP0HmdBvoG1xEPmIi+xEtT9/wpqedLvvXmfjXGRPv",
-	"1Wk5Pm02K0e2DJENMU3MUU3nvPFgNwk5tnlXWCnEovX5CezONprSwpG7vgmZj2vRQdorAEcAx0WNWVhm",
-	"O30R9x5BCU1svkj0+NXd6s7+azezDiV/SvUUfZsg5CFLhTmxNw34vP5icjxO/Ee7O93bxJelVRDJZAKp",
-	"FAqszy8sGNjyGwoH8pLSgTQXD63b7Z7JB86mupAgG5YSExhMkF/hdsu/C0OGll82p+LapIZwKxEj/MoK",
-	"BUeAu0IhLwwxiny43LjYyBy22L+es8vyQOTw3FYOQPVDOP4nmjhoLhxpKAtOb4XU3gqpIafU7cgnbkZz",
-	"tLEK25yDnfUjWrbPepmxcaXbOkd2e2M33diBtP1ukg/kaWA9pwUPkmZH81AdMb/q0SwQsC9H82bMagK4",
-	"Vqv/RQ/M7/y/vWdM5z31iVu3a8OPIIXi8AwqDYSXkML3iH7BdH6n2L5Wfij2MYuPEsi7frv86U95tmmr",
-	"xOFyqmhP+bwvm4YZZ97tGoi8mp+nCNIkRr2pDyucQvtP0E9ErVvZIStnVeMR+k60f+fDmRqlgSowuNwn",
-	"54Pc2kXAI8rWZHpvm2arH3iV4FbR0LvcKPbqYjjwOJEGM/DM33znCIzRHD7hMFYla3JrIHOeW3CMAJ6C",
-	"25DQD+EMYF4BCI59wRtJAJ8g9tm/bUXFSJ/XXPcG0+uQjTIPZ81q1W1TMpUJEIfBEJHEr9dy1O56ZdQp",
-	"Y8EvEeZ1AMW4HUWUEqSSKsA7LvdWVIZw8IQpahptpnqZ5eWAf20NB8pxXsPHSi7zCtuto7wpliyjxS0F",
-	"kIkJKmm99QXQQsYEStwixQRuXzQ8TIC7SlSYJIxfPTHC2dmOTAbsaHRxEijyrUkuIK7u9WJIUY+PydhD",
-	"8toa56j6oSf+/UOIGB9RVBY2l/x3kh7tLoJG9DlY1+c811fD1kvRcegnf61sERSyz7Ilx2aCCDNytV3k",
-	"8/tYm36kGSccTgqSQ+GE7WZJWU0reLE8KY6cK+A7GM6V+Usac27VybdAizFnvkY3SNXLzOKf+Nf2Bqmo",
-	"UcPHSjdIhe32Bmm6QWa0uJkIazne8Xfxh4MSCKAEAkzjcFFnjxbU8HOognLZNtjE553y7h9b4d1VdMBf",
-	"g2sPwDCbMmluYxrIi64iZIccfKVJ7CLg59CB90IEbFf5FdvlpvxKdOxJvkBH6WXQg+W+tcLrhYWXVa6s",
-	"ILyqtJ4oDheIzlFCegumg07qi/5kXYDsUnyTtKb1vU27fpKT/RQXBYq+0ePIh7hAFcWRmtwBylhumfKl",
-	"mZJxgGFfNnUD+VeCEuTMhrx1Yw78b9brgJjvsNNCHFKk//btITnaWy39D3hCMcFh0MrEfZKJ6e6UJaLi",
-	"nFVlYvbUR5wMMnH23FgdKTOEFF2xdgdukRFrfUQViXpcXOLqTCuONpAM/a1XbckSoSEnYxD+Pn4lCLzS",
-	"96UmRCwbnLhSfpuPa5/LGW8id1MtJreZoSmlsz3I0lSERc/UtE3FJ89rDYIQNXZuJWnhAUjHTWNBWqls",
-	"yB69KPTxZFmfqlp1AKKDS1iCCqG65T3aNNXHJrSs9l5a2I323XTn2d6JDyeP1QmqR6wJeEbjeRg+lj0J",
-	"+Ocv4mvrSSByU+s4aXJxLqB6n9hhRyWy7wOY0HkY438jT0z8ejcTf0J0Hnq8Ehj0/fDZXJ5bbBDXAwUL",
-	"6OcZ/7gWIx4TCmNqZccR+yrOsZvzhM4Bv6cXGfKeqBdLDtANQyjveYic+erkrOYyy1Emj5UcVuYIetJh",
-	"yg8FwdQY+/mGo0kSY7rk+JmE4SNGbFBeTPGrTg8cpfkZFSGwHViZDurqBYyuR0UCLAjkgLRyWMrh69FA",
-	"R1UDSVzEciuL904WlxkhlcTXozXKFBQGNjFYG4jEEZDnr8rqBJuj2fykzgFFxV1tGXqPGNrKeY4cXXmi",
-	"yvrevV281spa84f2aLt9c4EJMc1sBmlN+NzOtO+J+/CemO7Npj0sFPOS4+/qz+rC5TCDZbwUDFU4vQUh",
-	"Hogdz/zQoFZoA0uh6kAlhtyiFeVDKxF2VkJdp8VnKOqo14kI/VBnP7GNrvCJTEm5uZyozSV8TilaRDIp",
-	"Nm+riQ+b4Di0JMKtBKnyhMCEhwlIESKIwN+/C8ILP+LVMcquGDpGrGNFzlGenNmVh3nzloX3MQtqnARy",
-	"q2qCOXAQJdwfQjzumpb7Yy80lTYHaoV84Rv+EgIlW1OlLUA0k84CdcLlPaIjMWwrWl5OO2iW3d9iaZDD",
-	"tReKfb5QqF3aitSgkDz2CIW0xmAIySOvIikthTVWwjtIHkd80IPMb8oWy2YngM4hBYuEUACjCMEY4EC5",
-	"WXHWPQKfMCE4mHEMEQBjBP6N4rA3xb6PPEBC8LF/ef4faWhOD0YY/G10c30L6RxA/xkuCXcT8p8Qzx1v",
-	"cjJkY18zePYwjiLd6QYiyEhMrRDaAzunjc93kfpMugX1nsP4sSoRTOZhbvXZat21skAxgYovHKkMIVXl",
-	"zkX4hgxmEx2B2o72PXHfHAQ08l89QakcxMZCv7wjQI5/BDYq/QBOtjmz1yi9qNralnP3zxNAZ7yVDktO",
-	"FdUvheyEFMK7OgwgOxva2Kt9jL16p+Ky5XbKcv5N6vc7x5SjWNTw3/E1QiPB1cKwW3OjIQI6n4xO4HhV",
-	"RwWFaGFibF4dLY3IZv2PjDJI1sVsS6VppdI0vJCapwIdwy9YOM0Et/3GYX9FyBFMax3Yy4Jq+T0q51io",
-	"NlI2ETjf9X/WeUjlOKFW9ZFkesgOUwXWN4OmY/BQjRnZdq2arqV1oLInS8m/TdYnSunmaWp1fj7mz9y1",
-	"z5TiMVwwtA70UQ1fD/joLXO/PHNnqaFutbLoAsZ1XjTzOOLb3b4n7Og94YuO+8AlKVO2SU1Vhs1JHDKH",
-	"EdqSHjHiY7fy5mCUCbFhrUbxE2kUaVSU9EarjDkWbQSL+37qeUEMukYV6/OQXOEk1VelplsZsHEAryCh",
-	"YHCpyrj6UO2gzQgLCR14VivsqzOTFXYH3ttNSsyXCkW3JpH989paQZa4u3S5yULi9CTEW7ppNL/ks5CH",
-	"pjDxaefNSTcnKnbxQJTO/XqVyUciR994yR3YLJPKT01Sbm5e7Wofezavb20yz206Zm2Y2YWKmBlDOpmX",
-	"HnuqNKbDCTPblnuJ9k4ikOEaECLjlMpPJZt+7Ik0S833VOkbJsHAI7m35bUQXE5i3tAgJGPb2tejmsR7",
-	"gmx28XJDjidxGNRrJKwV+Gc4zoCiMZ7Nav1WLuIw+KXVlIPJHJxuLPbYtDNEU5X4qKY2gu3itoW7Lpu5",
-	"KXjXdaqUcUpO8U2mYx2aT3WYZR8qsjGPl2AqMz5vLCm0LkWIe2Lo8XJ7uaE1pWDH2aFzyFhDQ2+PXYOW",
-	"XjrntqSus0P3+Dv7T0/96lY7tHwQOz98MMI58LoV6eptYOUwuvtaoo4FL4yb2GaeLpa+MKOp2VtFniCs",
-	"JTHEY+KazHXI7kl7zFlbOjrbY/MQDPuNDuuNyIe6mr181nRGZ+Fw4AV890s+bKuEry4g7oSBw8nWx6hA",
-	"1MV1se3VqQp6hd1WVaiWA5IttyQKjLZ0SRglUYAXC+RhSJG/dBcLcrBWLux1+lgpCpJgiEji19+4pXH0",
-	"13ND2ssUCt3O611hfBBQFAfQBwTFTygGSCJFF1lKfphvG5oUWVN+uZkiuDrr6pCge0nUu1m2Bv99Nvhz",
-	"J5gG1n7efoem/n18h4hgzJBmcb0rgCUaf9EfY3cEnyF5mhE26eS2XbjOjfGlQMVAuxThNsZLu/oN877S",
-	"Tu4C3CMOPCeoeMPGIH3EgVcPzcE/BlG8QABOGaCl4I9nSFQSDH0JnbOTs9PeCfvf3cnJG/6//2d9bOPd",
-	"z9kEZuJl14Ieg6LjyDsc4jGahjHaJshv+QybhLkCy1McYDJfHWbVf6d43hTQG8X09h43yy+Jv+zTZlF3",
-	"bC20Wwn32M6bJo/wcKlsA4EEjR10efbXS904BnIdUIWbVg1v1fA9UMNb3bLVLV8khJOsVnQrb3xqa27V",
-	"n++GElibO+cZqF7is+OxxmqYtlzFfjhSnVsr4j5bEbd3L0oJ4KA8P1tlqlWmDkaZypaRieqN2Gadclmm",
-	"DJ5aaXec0bIsYVqrw2a1EosGsF295Hic+I+9zJPa7MXxNvEfpVPuhhQVNuLh+FdvyY+qzFMZWlzDJsf1",
-	"W7PbCluVa7InztRJLE7btRJCSYi3Tvu8dUkh3O1qJIVoBH6Lker9+wbFxuE4h+5UbKg0ww3Ehtyn/RUb",
-	"ak01YkOuoxUbFrFRu8/bFBvf0z97pZy3tRFcZpAbCo0Dj+My4MBa58+I6r0N7TLvbuuwXYztsuCpmcej",
-	"hTZqorw2woAHXbv/oLhvmwdye9c/9BiwbcuR6miw3HVgQ5LlwAPF9l64bCt2rCRdGlQOz8ioHDDysleW",
-	"WgmpB6v9ksrPAZQNva+6LG1QVtaEy1nEY+O4uZRKDz147ldVxNaMp2vFTBtaVx1at11J52YuSpOd/8hy",
-	"7FVVegUQBOjZnmnPPdGexMLh1IWtz/lWnd28ErQdKYEC26smEKChJdqfpkfc7rTAZmlS9HK2dvhb4fwS",
-	"wnnPStJJQVdF5dtJcqrJ4pz7olkeK/1SSmT3u7zpCthK4V1KYbUDK9zBKzTLPb+C6xK41Y1b8WsTv0o7",
-	"rtGJNy5yRZ3j3iRMAloTGcbbqKoxqjI6fILYh2MfcemriRuzeeA9oqKOMrngMx686K0r7nPgxb1ym7Xi",
-	"g4ysui5IrPWVMIeG5JC0WsmvPPsnBMXkeJLEMarmbCJuB6IhYN1K3HtPUPwe0Qs52Bbpjs3UkM44xPtE",
-	"Vqe7AeM+gAmdhzH+NxIH2snr3Uz8CdF56PEqTtD3w2d1lqFJEmO65GJ8EoaPGJ0nTHb94ysTVYX0kHly",
-	"U+TOt99AxjNM58n4eAJ9fwwnj1ZyvggXkY8oEjR9w+YHxvOITSQs7+/50DcMlxdq+AKBvzo5q/Eymch5",
-	"vfK8cwQ9frh97/ih2Iz8PhTF+o8CMnO4UwvMz+GIPkJhbBcFI/Z1NcTxrs2xxuHZPs44dA0RFoYzH22H",
-	"3vjQPzm9CfRtmN4yxP109IaDJ0xRdZVNwuM2lTYsOnCl2+n4ZiPc8b4DOdc235C0iZzCdnxM1MbkF9jq",
-	"i87HKq+eWMBeRnl3hhtijvaO4WSCImq3vJ3z7yS1sMlJStSmb77o09mOPUkMLibSDEkWA1AF9YmVm+iv",
-	"9Q1NyUtgu7T37vQVI16HzEpfQ/69GX2JPluiLzH4BuhLrLylr0r6Ethegb78cIYDO1ldhTMCcAAgPxuP",
-	"KhSMKz7QltzQ2BHMxq8npN3do/1wNkMewEF7fX7h63O388fZ2a7WHcUhowFutO0HFNMl6IEn6GOPT8Y2",
-	"RTbBwUy4cpBOlcLLCdt8le92vvVQwKbqxZCiHreBMx1avNWYmDlMaA03hwl1Y2c21J4wGQOl5bLDMVIJ",
-	"6nG1Ty3QYoxiMsdRgzuc1sntHifOwE9ZN5l+Z6sEbp60+YVOR1F7qVvlUqdjsJ4kI0jIcxhXuFKkVXNY",
-	"B6DaV4nUWzXm9pSkizkMZulE+6QtTThkXoqoVpy3SlMzpama1QXl55lxbX0qRjMmieOqa7doQSpVqtRT",
-	"alt8r8DYJ45XyGsfGlum38xNSVH5Zi5LxIeTx608Uo3YyHv8RlUjSRs+Wj2hmEgQrO5PbA2ynXKBErEO",
-	"JSwOgmn4HtHPctA1hVgUs9EpFr01SLNctKdHJ0cnpmy3mufRP9KuX9OG4ZgbTy2+l7bFFrwtK4j9CwIx",
-	"okkc5JBXuOkwMZsEAeOfdIpvPTVkL4xEcr0yCzyj8TwMH3vSEe34u/zBIdEHO+pk67KjmvjdPYeHHMju",
-	"CJZOtGM/MMekGAq+9mB7eeNEMRGHTqZW7y/Z4qsTcxxLPLuYKVRT6VdfwzFScSOuKYH3lm824z8poBfu",
-	"kxI1DDNVuaUYVtKKRxI76Xa17LlH7MmtMqUtasqjKW/yP37UeF+LVkbHau6c6cRzwsm0ymfZcMYfjsdy",
-	"Y99RueLWHllySi4FfKkLit0HmavV9TW6KwnZPcHKXtDytvKV5M4N21khMZAolO0uDsqR1/T0Iy2nWapj",
-	"r8NshdOkGNzjlPKwWbX8BveivYyQaZIuMAWwDdB74Rw5klg1ilkxPqZbp2G5c0IDletXCBRbMTis5a2X",
-	"5i09Cm0dxnJR+9y5q5keuBcMtnldMI8M11h5mX05x2W7Vg6dJEJRPWzlgVVBXI85a9REp8KgbJPyFUBT",
-	"xntKXzqsJ2WDQqD7wM+GYjyilM4GKqWvXifdDNgsDpOIVzjKQFAbZQWFd/qIlp3aNCBbFhJrVh1Uj0pt",
-	"4cE91CZWqnTYSHCp1ERW55Ysv2WzZEEr5QjaS8l1Z2CXIzCYcus2SRh1IK/LucqHFBGa8hQmYIroZI48",
-	"Wx28TPDvuSIlyWDFxEMvlm5Ig7dRnqE2u1CbXWgL2YUaiWYpG4jDq1buJHcSy9K35oBMMD+DXN6ylFMO",
-	"U+upgq282ysVMCPFVVXAouPfGMEYxanjX9foCsg9yYQ8SGK/86bT+fH1x/8XAAD//+jRITtphwMA",
+	"H4sIAAAAAAAC/+z9C3PbuvE4DH8VjN53puc8I19Pcn5tZjrPKLaSqHFsV7KTf//9ZVxYhCTUFKESoB31",
+	"TL77M7iRIAmQoG6WE850ehwRl8Vid7FY7OWPzpjMFyRCEaOdN3906HiG5lD82bse9OOYxPzvRUwWKGYY",
+	"iS9jEiD+3wDRcYwXDJOo86YDwTihjMzBB8jGM8QA4r2BaNztoG9wvghR583Jq+PjbmdC4jlknTedBEfs",
+	"91edboctF6jzpoMjhqYo7nzv5ocvz2b8G0xIDNgMUzmnOV2nlzV8RAqmOaIUTlE2K2UxjqZiUjKmdyGO",
+	"HmxT8t8BI4DNEAjIOJmjiEELAF2AJwAzgL5hymgOnClms+T+cEzmRzOJp4MAPeq/bRBNMAqDMjQcBvEJ",
+	"sBlkxuQAUwApJWMMGQrAE2YzAQ9cLEI8hvdhbjs6EZxbEPG924nRfxIco6Dz5p+5qb+mjcn9v9GYcRg1",
+	"rdAysaD0d8zQXPzx/4/RpPOm8/87ymjvSBHekR6p8z2dBsYxXJZAUuM6oPmEGCzDAsOQPJ3NYDRF15DS",
+	"JxJbEPs0Q2yGYkBiEBEGEopiCsYwAmPRkW8+jsFC9zdwyeIEpeDcExIiGHF45LQxggzdoAhGrMmkohuI",
+	"0BNgoi/1nnEQPWKGaIPJsOgBiPgqfxbUjinAEWUwGiPv2Ud4GiWLBpNTPI1AsshYqdGUCZt5kBYnix5v",
+	"+r3bIfcUxY/wHoeYLfsRZ4x6ash1Ar+wGI4RGJMwRGPe4VfOfEiOBUjkXscEhtS6kAWhbEamnmu5Vq15",
+	"x5jMOagJHaH4EcW+K4LgOu0JJihAsZRoVIzC1zMm0QRPkxgF4JdRf/i5P7y7Hl596t986N+O7tQvt8OL",
+	"X1dc8TIkUW+xGDiE3DX/zqUXGJwL4kgoEn24EOVMyQBNFgsSM3O6zsnpb69e//4/fz7gfxT+j//+l+OT",
+	"U6vcc4mTniKxvEghOBhfchFqhZ0LV0Am4sC4GpyfgUVMHnGA5AnBf+X9gdhVxHGtVoJycqVz9cCg7WiQ",
+	"/ah97nQowPtRDgbnEhQxPBZbbE7xz849pHjc6XamhExDxOVqKq9L85YEswtnA36aS4Iqow7VUaiipXSI",
+	"EnMhk9LKlBV57YsxgeWkrj0a1fmpF1NxHl1nrF04lhb4A6HMQf6Esg9kCnrXAzDjrUwYZ4wt6JujIyU1",
+	"DtUXzhk2eoEL/BEt6+d5QMvcNIvZw13GN/B+HKCJN+8MESVJPEb2I1meb0HPsXqG58hQcGI1FniCVB2N",
+	"eU45PT49PTg5PTj5DZy8fnP8+5tXfz7885///NvrPx8cv35zfNwxVM8AMnTAJ7ChCjukEQ4k3RjAdAGO",
+	"wO2tlE58aBOg+/vTk1d/Pv6fg9NXv6ODV7/B1wfw9HVw8Orkf34/CU7Gk8lf+Pxz+O0CRVMuYX773QJO",
+	"sghWRVMIKQOq/zZwVeAHzCfJdtUE3cEbN+QB2cTDtwWOEbUt+csMSfbnxMp4d6BaH3pv8BwxGEAGPU7a",
+	"HAU75cpNQa6ksB3m9/f09WurKCcLRO2jcqwI+UQLi5ZbzWI8FmKeHILBBKD5gi27oqVsxZWrBYo5WgCM",
+	"ltlwhx1/Id/tPJH4YRKSJ+peOtVrT9uuDDAcjxGlAD2ieJkO1wTgAlmm291NJXZKX1a6HI/RgkkVeoj+",
+	"kyDKyiQq9WVJrOsx/BxHbv7vdr4dELjAB/wuPUXRAfrGYnjA4FRA8QhDzEm98yZdcTdJcND5XuJNCa9t",
+	"vW+T8EFeUfqPKGLOJaNHbSrwus5ZhqzbKTXDVxtQdEEiiqqgKhOm/JYjnSqIxUw2+l9ZYLgp0VjqGddi",
+	"Qg/cD4I89htTXmZ6SXDQkBK99m4QqCWRaJzEMYrGyxGDzCrhY0SpUg5LMz2gpWgGgwDz/YThda57uhC3",
+	"CalE5vKHP3xUF0l4+nxyb4oUI4PITn1BEmcWoqcZHs8MQYcpEMx72Fmd5ckcswiHXT2RWIz9hOrJ80le",
+	"sDd1QF0tUMRHSk8VMDin/HIpOgC+w4hR8EuMYHBAopBL+hhPpygW//rVhpQdHWorIBk/om4qZOfw219P",
+	"X78WGF/9dNz1ybi5VdtuQl89OMklxZlWBMv4y9FqtQIqR3HDcRaT6ItC242kRCdzZ6LnkyH9SwOPYxL1",
+	"q4UZb6LtBKWPOFokzDryHNMJjtEFnmNmx8wcfsPzZA6iZH6PYk5ic0wpCkCcRMJ8x/sLK7Cgo3eDYf+u",
+	"d3EB1MhgQUI8Xh6CczSBSchEl5Pj45wijSP226kUEnyuzpuTY27En+NI/dMme9UE12J8mw7PbysEBEQC",
+	"J8DlNgrAUaWkwhOKkV7P0wyHCEQEMDx+QLG428RJFOFoeigu4BySf3ZGHwfXnW5HrPPq8qyv/+5dXBhE",
+	"keGePKI4hAsfMPmtQ4HHV0YVSBytcRIBzLi0e8QkoeFSCzkUCLMOw2Fog7Z3cXH1pdMVUN8N3t0Nby8v",
+	"B5fvO93OWe/yrH9xdz3sfx5c3Y6ssC9iTGLMlsXTML9Zv9XtFMNz9F8SOe40g95lD+gmHBXoEYYJZHLh",
+	"AhvZQQ5w1BUHjFJLQG+OYjyGR5fo6e4fJH7IE9rtzVk9Q0vu6NqY0eCrEhd+TTm+Wquy83jh5EzbAK2+",
+	"pceo0HmMVWTMax9LaAV+Azygpb3/A1o6u9vJozyG/qpPpXScEiWVKUac7fZhxSdJAnxAMMEhQ7Ei++qN",
+	"lmYngbVs80aXI8OK6NxFRhZ43Itdx8cc/pdEQOvlgFMM+KU3vPxVr350OQJijHV0seyMxNFfT9RJ+Xv5",
+	"pEyBdZ9S8qGoF6KY9ecQh+9jkiycq0e8CbVpfCGmjK9RttD285huQ19Ily8UBjFjee0KVK+VD5PQffl+",
+	"wFFQdxcrjPWRd/E24EDeDcRJiDZCEoayyGYxojMSBnYg0s8aEg7DIbgR5nAKoHHWi1OTb+rfb/u3/bvz",
+	"/vXNB9GcdnPtKBqTKJBNr//y+u78dti7GVxdyrYARgGAYIHiMYoYnEr+fdcbXNwO+3fD3k1ftjsEA/Yn",
+	"CvA0Ivxg443OhleXd58Go1H/XLXJWeBIcm+iTwLUAH1Thv56LBVsHAXkSSJMHCD8jWfW6VrwJ5saFwsO",
+	"GMecPrkCwE/9LoAcmekNTe4t+AUdTg/ByezXQ/ApoQzcIwAZCBE3o57MD8HfE5QgEKAFm/Ex5wjSJFZD",
+	"yv1Q1k+h12BpqJQgbfyep9X/gYOScFC8c1RiBU4hjigzd3AVI4H9hUTwaw5kkxG85MEIRw+bkgd8rFXk",
+	"AeW+IRuWBzFJGI6mH13H/TWcovg8Ycvca9gDWh6CoRpPcnbvfX94fnvzDwEltSoFFI1j5LhLyG/8gOBX",
+	"UPkiz1e+gMuQwEDo51/6bz9cXX10zdCMoOUFV5yWvwtMJHFoBy2JQ0W6YhsogOLuQvVtOYcHDSMXazf9",
+	"3qfRJqFNYsupZtJ4HSnXGJXluWlFgvikyZEf43yXpFF3I2qLPrI5RYbIj5k+IS7Sh7y99ajvqMHqsOLG",
+	"RzTFEfqMYn231nenz/yF6vOJ9WaEokcck2iOIua3jL7RwVsiSI+cTaBe4I5E9wTGAY6m5+rmYDd9SicY",
+	"5w0lG0beMzgnM2IaAUpwZ1tCw2TqEA5hMt38wrvK803c4VxsJYCqJSBSoSxW+hL2cr6EaomcaDck3uC3",
+	"v54cn74Se4yjGYqx663iPsEhO8CRmJ2CX3rnnwaX3Jj6qf/pbX+YGUsxFU2AHg4sUMztJOKRcBKTebO3",
+	"PT9yXxcltj3PnYPGItxmQOM1tIQNhQcmTwVtIF7j2bDCpplZEd/h2GLNnPAxeo6TVj3YK/sJHLMEhuFS",
+	"mJUCAJn/MzZJ2JjMkSkXb4aD9+/7w/65sitd98/vrj73hxe9a+OXweXn3sWA//f69sYqQ7mADJLQdxHc",
+	"Hpd2SQ2PTdaiVcNhErkUWvSNoZgLOYtmy21wymwIaWZ/k86v0fLQotZWgcDfrJLa58LPJzeQPqi2Reox",
+	"MdhNCSLbNB/CusA2ebaAUxylflVVAF6nLVPDuzjfn5o84hZo3csFzOxFfc1uVQee1exuZWnDs6zsFZYa",
+	"2xvNxb1o3mUcvXUfkzliMxKYbH3ef9e7vbjpCJ8ZK8Ou+1ig9OsYKTlU+2jgZyx8IQ8Bket1pn0h6Eh9",
+	"bxDYD9AGzweZ9aH0cGAaInB0CLj8oIIkSMIAzMbgBGo2dbwiFK0jzs/OZzndQN09rMO4nTtSnNkGKsxe",
+	"MIsIwZaJsVQaFHmpSJt15wnds8OErnCSXMUBit8u3+moGM0nkX4EQiVvw2xHpXPPDp+A1nzBWeMAYWmk",
+	"Sf3lt8jiFi4+z1/9ihFGKv7IuRBTr0rmcxgvvfywvpS7VXCcfD9KF/JVb7i+TReuhA1e58AvfxtdXYL7",
+	"JUP01/qHrPQJS0z/cT0a0GPsAe+myymzrQZ0X6CsAFFJkHMcy6AdU4pAOlZPCm754ZJAHqJnhGA8nlkP",
+	"Gxe9lz3mhaegNXBCXNhT1S5tKFSqorLm8JibQOwxtGzVZNwFirhFqm5g1azJyP9JUFIPsWzVZFyli9UN",
+	"rJo1GZkm4zFCQT3QaUP/0TmVv0OQJTF6F8JpX+pJkp3Eo1mRnDB1Rop9SeNwEJjIMcEkhFMzDkfLL3Xc",
+	"lMNwis4c6XQ2ZcWAfJDjLTn8QUimB/ooOZCeBQeZliSi8Q6EJgsXxu8knsII/1eg4YBSclAO1sn48G/k",
+	"vqH5UBwZZQPiv8n94ZYCD0pjUoYW/gJyxNDCZgWs1fJJUmEJ4lp6zdIf11WkHw0FWtuGxdJtxPQ3cj9M",
+	"ogoB2uQqn3ZKY+LdTYYIUodlYoIjTGfNpv43ua/bUU60sqVj99YgujgVHOXHAgZj1mwx1MuqJrdOW9Xk",
+	"Jg+TqBmJ881vTuXcpFDNAk2Wa+i9dSAbZ7/VGLrOxVMOogkk3QU312TGTy2Br/uX59LukFkgRrdnZ/3+",
+	"ubA0c2+R/nlqlpB/v+2dfbx6984qaLmmaA8T9U0UUOxq2Ww1iXA8pm7P453qpxoeu4rKIc77vNFnhjcP",
+	"Te2TiQGbmshGZmKZIRw/fEH3M0Ienn2RBiwbWuIVQ+FoAaOaoFc/QaLdfi59Q18WMOYXjgWMHNJMB4n2",
+	"GIvxfcJQZbCN69ksW26MWLw8I0nErMZGhxOp0/gmvhqv/uUGKH7E44oBFjDa1NqoG43800cP1yNNDdrn",
+	"iPdzwy7E75nKs1M7bNY67ftJpbixLo/ryT6Him6YIsAA21h5fi9y0OcINx8XbNBLFfdo3Opz6PZydN0/",
+	"G7wbiANmcHnTH172LvhhJBJR8APoYtC/5O8k18Or89sz+dvV5ej2U39oPYn0VFuyXaTrzEsjDw4pnmaN",
+	"JJpelZ+NtUBHeYT3OTavPna6nf5weGVHomXxZnTiHx0ZC8juFoIsT7udCH3T//qty11DxT8ojy753i1s",
+	"Qr6zLQRetQCihRHmfup1JTdgsQ3OP5dG/s1v5GxdtpEZYTA0DSC8qbhVh5gy6V+R5c469pjStrvCZ/UT",
+	"YjEeWw7aKJlf+5lnBOFpI82ha71/97LIyLGUf6wwzzgHHPqZYuSIxhuaBTU5544U1NwsXRMhNtE0hCx7",
+	"ZM2j8j6JqffbaxJhpl9deWTfPZKul5g/IoqRDsFVFC4BRUyQxM3Vx/7l3dvbs4/9GxBDhkDIwaB2xHm9",
+	"PWSjWG/s/Nl7iCY4dHgk8u+ZH0g2mHyUFR1RcGjS8OZSdIiJPsMwQb4Ij6V7GAUiQ1WNh7b5gu1xEKdE",
+	"8Umdw5t4UKnZnUf34rU4tCx+DgPku3LT697hZk8mkgBwZLzuZnsjjYUTEo9R4BsCZNxgs4E6er0pVDny",
+	"VLv01eTPPXiMSGGx3/byVGOcuu8G/6d/fvdlcHkuX/8vBvz2nf1gigLreZyOvMZzR3GM0pOH3C69LcZe",
+	"WUdDY34jMUw5hVdYAZ6LYeRXYAuWN21vTe5Uqxjj1jCkbc1aplCamctKtqMav7cCE6Yb0TXNSgqW4ujW",
+	"AxLxv36e5ClDtAjh8odK3iGXZNgkqXNlOXp43vUZzV8fH6cN7OstwO1atctmaHT3Pw4KRl5f+DR0cRIp",
+	"Zq9gK3suAWtQNR+1YN6zDDhFlN26AnNuhxciwgBFgQiiVbl1KY/K2YpLi+uASCL8H65uBChieIJRXHib",
+	"1PnRZKyvGUh1j0ISTTXEtT7CWww19rPqV4YPj5TPsUFp66a3cKen2JR7ofSE9D8Zm2QIyAb/aqAn2Nwj",
+	"hwi95X+Mzj70z2/5jzb1J515u07Rq7k379hTeSduqU2JanMOo8MkOmtu4i9pbbs+PQ0AfJboFyDxpdTh",
+	"OT1rM6JICbdKiGa8ylPRnaMQMfROuJ2s6ESa5mPQyxHGGHGBAguIY5Wxh88A7pf5DL0PaHnyRjQ9kc6O",
+	"p/Jfp02S9aYPQ1KNsF8PGtKNHPFL3aVjRWrcwGDfG26x88CcpHtfeR+rpx4zvOqLW39eXx0eyKFeq3xN",
+	"6p8nPk8C1RhyqcWB+B5seCVFIrZUFbCUHbDyA94IPZVKEVjlSKV+b2CqW1XOwL4PtyKtrpNSZdbdKgzh",
+	"TTDp2ppbXijblt+IiM2xNQqa49RF2y+S6tIMzFtlSSvuV6HtPbDZloHye0Mt93PZTU01pNptfDQmCxRo",
+	"ndvx6tTslSbh93N9GTVfayhqEKxb9/oyg3Ggni6ASrOo37qeSBIGAH0bIxQAzERAVyxMhrknAvMBZq3r",
+	"iejqjMKAlsAerpSJEMRP/Zveee+mV/EIoztV4MI2hdT5fCfRzjp+8TwaB7lUbdUUX6Iy8ZMYgEzYRc1m",
+	"8zYS+m4+S4thfiHRGAHM80eNY0Kpa6sr3rQshKuesPRr15pPWRJ0OY0ofJJEXsmiTl/NfvWIQPTKzyQo",
+	"4svV8OO7i6svGUU0s+Abl6WUcjQpNHhTs0vsEqnshcguQWVNZDclB9KJvjMUYv27fUUjzTRaYOsN6XQ7",
+	"mlvt4hrN4WJGYjQKCdvwG1fu/ciZjQFTQEMiRbrq4S/UV3xvouZl0iIdGFrI+OfAz8Bq+lnXL5QHTqsu",
+	"G801kUss4QV6gQsztHTNN7XC+xmnGtP5tOwtOoNRhEIXmOozlyVWHwLKBwdPcnT746kc4dKZBEZPwVln",
+	"1UnWsvvDuWv1/NsaS+fd3esWg6+z6L14sfB7U9CISNGdp4uuQYbWY4GhhUvc2aNlZjgMYpR3+K+992N6",
+	"nsSihmFltJqQOJiK4/o+l7TISASylWgZaQujzVYVIxhwOF2Eor8b+gJfof2UCIl+hPZxenbV2+zwAyzz",
+	"GdKEKRD7C5/jjg8ADv43OT7+DUlXtl+tIfAbiypzLLlK9UnRmqN1HQWjqFN6f1bQ9RaiyHqsvyDjmX0j",
+	"NhRrJjjsi+uVupYoc91p6thfBtdt9FjFwSbrU4Gh4otkLljOI9ZKhQam7TcvB0jCXCCuKCKEi2xvouzP",
+	"fsjceOxezGp2Zg0N0jdslbd1iRMPWdNkxWmXihVzdc4RMuh18qYUmK6sMj5Poa4Xj2f4Eb1IudT8aXSv",
+	"RAyJAxTbO1VwfT48yso42+FH42q2G5aouAUZSNB4tBtAXfS+D6aGPANa3XpVG0e6obGbCtw+OIG9w7wi",
+	"zitOedBjPcp7UfTgdIMekfbQ8O090n286O4djikbIRQ1o70L2LRXw0hqeYXKAViYOcWsgaZsJ7pqfyuI",
+	"eV8y5eTItJaQM5GurWLDvvR9uru8uuMWMhFql/7I6wbcXQw+DW4y3yjuw34z+MQzkt7yn3uj0eD9pfSe",
+	"uukNb8RfvbOPl1dfLvrn78U/3w0uB6MPef+rYf9m+A8zDZ78mQ99dXtzN+y/G/ZVn2HfmMSce3RxxVte",
+	"9HujdMxB//zu7T/ubkdiKdrqx5Pu3b0fXt1e333s/+PO9AhzNFGAWk2ENo4xkDq4fHfFB+4NdZ6/4eBm",
+	"cNa7qBqtypVN/XUn0fBJxkYaOGng6qb+lq2rgvt1UtQygWfpeirfMdKkqvz/C+l4mnS0vdPpNpX3Y59J",
+	"OlWjJ9SOgHFWFtE/aV6hlKLlgkDCQDkC+ElFsQ+br6/Iwxm9OltRl+asK5iRQhSrRPN9R6Wb1PpDVJ0G",
+	"ZUKbi17UbgGCEQyXDI/p1YJdJazapqQGnEEKyEI8C0nTRDqIfY51M9FvvU6xK5e7OBan1oxHZyRiMQkP",
+	"FiGMEKDiqVe2LVow5fsYfKJvEnrwhCg7OLW/lPEIchQ7vczlZ+FsXpwBR+MwCRBVVfR/tY6+Vlb7LHOR",
+	"X/mB2tLDApxs0K9OVijUf9pt4act1bx113+yrnkP1CT7Xvg+LxZqUNkysL3DsTW82RQ/ulqPeuqWyeKx",
+	"CHAqC501amMVEl3XhvxysNRFNINoT0qwV1TwaqtwNfKJWL2K1obcIlYrW9VUMFfXqDKcJFKerZFjKWcZ",
+	"B4dBJFw7N+hAqd16u4Xun26sXc/OT7VfwpJDtIqY5KW5Nlrfa0eCxloazJPoaugoXY3pkyKrXPFbLa9x",
+	"xWlJ1/+qoxU+3H7RitjzFWgFR9MRYvw/dHf3Bpk/tM8rv+NoKpK5CWCqx5e9tGecqBUgalXLWmZwsYgJ",
+	"HM+48BQ15dNq5q75875+wm9pRSjkkrWnWBmeUpaREizGM+M7iMMkRh6giJhRExDT44aKJMP2ObkKIsb3",
+	"0VJgpHZWeEQVa9xWKyjwmyayd5yH9Z3dmmsETHQTAJk+rxRVbdYjxi1RrAC7RUs/fzfVgiUkYxh2up0A",
+	"PaKQLMRnkZApSOSLuVu6DNKg/u0UteMEx5kD0UrfMOE2g6kaBqguh7vQTFernFfnKiS/Oh2d9Gc31mSL",
+	"KlcnMYI41PI37EYuF7mSf9lemVn5ndQoaWdvjiVFys3OJLmnZfjHCWVkzve6XueVbWVFM0gpnkaynhb/",
+	"Jo+lQzDglwnWNX77U74GHBfhAWL8F979flkc2ssTMwP70ksP8gDdLmcjUf/sDgZBdSJyTNU4dIYXQqKj",
+	"b4sQjzG/EE9jKPy1fxEmugXRZUyX0RgF4BFDIV4Opvz6Dhic0l9Vfi09hh5bZOOawUdxxcWxXA8KMB+c",
+	"xDxPV4zm5BEF9uPp2aSHf7UPjoi61rcUxbLHdXIf4nEV34vxKip9mjDvDYcrZl2Fw4dqn/SRefXlUrwY",
+	"iYKNwkmc12usOCirM/LVG+eb2OKrMJGDw7iar/o0UhyvAFWGx6ER0WJ62acPb/3hHX+h63Q7/c/yzeqm",
+	"N/rI39nUvdjIuCAygZ5dfRIpstS1yI37mmgqGMJ4XhXNw7+r+B3raSxDUxgBTzAW5QBKurTsbY9TaRbM",
+	"Zc+2t5kQLjm2e4kbj9VaPcrJIxNe3YY1jxqaI4ZibSfTSpMcC/yCD9EhOAEBXHbBCXhC6IH/d04iZrOJ",
+	"eXmKGaE8lhAet9jViMoq2BXCB/lglY8IemZ1E7RoiA3EbnWUThEVCriK1ZEQba/g796W7N26TcuJj/2r",
+	"0lu2qaV7lge3mor2RjFJtTp/tUT5gfiqFbZjWQbpinxR9lJBuvhubXFeI7lpWuPStO2sm6qmsP0GXNYN",
+	"ljDsICGWM8eaTCxg1jHU2HZXvXeWsP2eDjiypBBxjrhuqobqLA0SIOtLqXuNW3y8XiHtY4AfUVfMWE7+",
+	"WPFqba68JuXlare7AijOO5YJiOrvAsTXHAFzN3pG1KW+aI04yxrJuzPht+r7vA1A363TqsLygJTnDDdo",
+	"EHkkdHmx7ty8mOr7tjgnC9aEWiPG7rDulisv2oGpfex4zseOLT5C+AtJzpsRDrv6mV/wx9r+ZU29qqqZ",
+	"j4Ruybup60AzjKH5gi27c/jtryfHp6/EEvbzMrEfKr1je7+IACvn1mJ6DRNaV79SRmkJQEVr4WwzhlFE",
+	"GIDjMVowEKGntM6mpYqlBTqKYpkqIp89wgnq7nLm+NPplP31WKxvu4lymouaOY7+eiKY5/T16xyEG8u0",
+	"A8RIGsUwRtGfmHJgkufCHLLxLPMwUs8BohY0isYkwNG0CygBxiSvTlUvCv638+r0fzsbwYWBhR8yFRD4",
+	"lFDGNUZel4NzLJSJfQyOWIfINR5TYjfNehMobrud01ezTndLyYHS9UEGQsQtuCfzjZ/H28s2tM7tvDoB",
+	"kVWlprYn1FoXAhgEMaLUdCXIrUW/TZcOIvHhA6Qzm2owg3RmDvknWphOXU7lveZ6GZIIjJLFgsQMnM0g",
+	"c074GcV4guvOLT6lUNkfVXMlg3Iw2BXHGaTXkNInEvvOAcFCdQAqF9xGHzfd+mKAKU+8n9Mb9f419j3I",
+	"Y9dFYGczGE2RRpDzyI7QkxuJQtVFTxnWtF3cDvsKNgk9slj3ohKQFAgy2RoMpTqY6ks3hycXyi/IFEfV",
+	"1qDN8/cKC9Y2oD3EuF7jog7XQzTFlFUZGPcQ3X4XSodg2MPdUo8R3ptmWqG4gwt9qa4SJdeRHZ7m2zhl",
+	"5GS2bft88jaG0Xim0lLx4E8ny92Lli7tTH7lShojIBaFaNKbvE+dSRI4jbn828oDM0gf+r4vP5mKqVJv",
+	"Ad6dTyyXt2nlsgBcioZuhuyvrl1yZXn23Sa1UK4AoIjFyw1u1IpDb2Crnm+DkvDhSluYHOWz3nml3/98",
+	"otIVcErP8u1PPDtz4jB7mfllHA7Hmal1hjIzGeDDixTv3ILHXXTS7Dj+Pjo+US8F5G0g6mURkzHiF/M0",
+	"YU1V3VVhwCYxEHukrSnCffIeoShbNW8j5Q8KuNVkAmO7041fEqnCsrNkUpuovHmfhA/ZVtpdqrl/3eoI",
+	"0rYlDomkTfVAkaMhncIV3EYUCTILUaEJrqzI6+VZpOLe0hJ/ildKhGBh1WJUkkwRIdJpXF/0/pH+cSe8",
+	"+EZWt7zCkHug9RQg8jNX2wmyprzU2dWn64v+TSHVhh1LZ/2Lc3SfTBv6LxRMGmUTqbbUUTxPQsgQTb/I",
+	"WIqxMD7fIxE+I5kERpKaOVnDonNHCTPo2yJGlFpfXzirnPUvQNZGvNyp6E57/i5OnddwGRLoYHLFUAvZ",
+	"prw+qD+J2sok4j/E6BGThB6ofFQgZQK3/0d5YvGpPB8r5RMWQ3RrPEgMvOlZ7UpoRhmVtSvsMItPunAf",
+	"wFI4qg3g28HfJpH1aT3Ld1YeVeYe1LK2sMPZ6F0+IU3GXMxMktBqc/I9D4pY0EdCKSOZM7uecwxHYmv+",
+	"LbfEdF2dbsr2Iq3OaFRZPv/zyZk4JisvEJmjU7XXTOaHRbtGffEYTdSzOJa2P66akzhPmGZn8/luwwV8",
+	"VtHG7DL3TKsXvKm1zg11uTdLdAk0GGoxlULvCcUo0122horvchFC5uSOjzWLUBUV2ZUVyWKFbqkqKCAc",
+	"PCRWI+euIObqMyH7nppVixLZNP1UHAbqo3OYDPT0hczC6/yTXVeU4x2CW6rSMdDknsqgZE5AgbAYqVaU",
+	"XxYM2epXirAiOb2gwE3e2IyZsxcbY7OqtlzlsDf2nEToatJ580/uAxWqvyw3u49o2W+sI/BXF72nYhT+",
+	"HJ1l6EhU/Jc8z/OtMkcylb5dE8lhM5OSaUpSI9kGiBFLYpnyrZeWFHYqMIbXkOxYcKWVq4BSq4jVaCmV",
+	"q0sEpunSMM06289Xvsvr4V8MUca90sN4izGJYzRmGeUyosGyIl3eSHXcYbXYUnQ3yroorQGPK9VE2SRF",
+	"XWFNOrN/aVXSl6vYO3VsLFcEcKh3Oh1UBnbXxg4Wjisp/gmb2a5MXgZxvt57SPEYiGEse5FQfjjOnXV5",
+	"5NfagQrLT0etMY3zwW6WLsHMm4t37ITNUMTwWAlW614aWtnb3mhwVp+9K51cwmEB8Ov3bivdWunWSrcd",
+	"Sze4wE5fNe6vyBnBWHNePtj2ZIZggGK/SHfZtohTNW2t5DNm6up17E7y9a4HPEltK/ta2dfKvhcq+8Ip",
+	"iTGbzU3z8uhD76TT5f85ff27/OP1yWmn2/l0/pobn85PX78++YvV/KS9Wc3hPvT/j8jyPOr//ir943Zo",
+	"z0PN43wgS2L0YW0h+uFT7wyk43Uck4nEX+MYOayPVHwTAiHdWxG55DFBUQqmuDbwZF9xEbTdyXS+ps0I",
+	"dPnGcw6nZ0aFrmJFOkvtrnpj1CiZz2G8tJkDAzi113n3CAL5fHKOYHCBGH8UcIS4B2kLHbzjR6GF/JOm",
+	"vBWbsUDxHEYqH6swkxdDCDaSVEr+64uvTehpRigy4RHO7OLxRkXucXwchAIhaTcRhJdEaV6hhcClWHP2",
+	"WKrcpVPf7sUixIjKFxsULw0YMJvxADPMKCBPkRptbfeCzbzumsvPAFslJ4ODsL5a6dL+sNnwYbI4qO/b",
+	"pPJ9kbUnyLQfsXi5uvNLposIBxXh3jDBMWWAIhR5+qvgiDqT+H2xTKDb+7tPYDqCDNM6P+t0Fu4FLZwW",
+	"qO52uHKC5RLKtWNGlQfQnESEkQiPeT4sgCOu4lJuSNauQarisdQUQzL1RHW6Hl9cpx3EAx1mDtT4bQN/",
+	"YjmX3neXjdzz8l5kAkK9cAUrpkUWP3RBsLYXm//8a8q4hKL4U1bZp/jQxz8fLGLyiAMUpA+3JAYhvEdh",
+	"Vz3T801FlMH7EFMR2QrT5TxBR51x/uFcnVGpPLLf6qwxfQ8Fb5DRRb9/3el2eEGVO53H6ezD4OL8ziip",
+	"zEuROOqbkHhvQBEKX1olt/w5RGhxruJxPvlW0HgqqCPVZ5AzVXBRn3omWGvgKl6SSq6AqSeSKbdz50S3",
+	"7FFYFC5ffY6/otuSTCv2pTe4uXt3NZQZ3K4yeuC5lW/OPjie7gtj62Pe90C3HcyWPU1bcl36mnuPfYLx",
+	"gy1UqqEH64KPBeZQRsiudr408GrdxHQxGpM4qDzNChPJFGmy164PLqkr69lLsG3twPKYd82DarWQw407",
+	"HeeKvK2AtZwHzHN4RRfprFCDwL66rlWCGqxRKQkLUmQ1oVUYxC62HGUQV3RWzBuGrSWnH1zPAsI+7Oi+",
+	"ofLfHn4oZJIBYPpn8fDgaCnCyw8oijEM8X8FCcqVHa7ksVIxWcGUS2IwhgxxY9d/VQfqqNWEoip/eMrg",
+	"fJE5M0vZLu4UxathtextmNN2E4YBN1Gkdh9dSbsy/xnNisZEpj9ZOorMyGzO6NBx5ebUryq1x0NmzKJA",
+	"EHc4YasZ63r4tWmTSmUzGyZ8i5PocEuuSRIkHz1YgYOjqXpIuGzy4FPGpt4wC0KrEKgtn+5qzXUhBGnD",
+	"1L7ooSUpx92aoQt2S49xsxqOVePKVk3GNWo81oRT8GZNRhYOxiioBzpt6D96gUj1IlI0mbOne2KUyu58",
+	"rTA1qtfkzOBooaqv2Sm7F6ETFfVkbbFZVQ78Ij9FLoDECMoB+Yb5qBiRgo6XbaLCBC3itGIYTZEss1XW",
+	"Kw473QLa7AqKf1YleYReo3gk6n45k3bheTI3SDAXJSTz/KiYqQWKVQ0xe9zUA1peQ8ZQbE1yNQ3JPVjI",
+	"72r09NWcgnlCmYxJsp/5XJmgzdZPcSTT1Pid90nEcLhicko5lR6jjHq7JpwR4MquyVUOwXaD9Dkah5AT",
+	"6SOqTsuhKFs8V6RdwC8sTtCvXFlbxGQaw/lcPFX/MoEhRb9ajdXb0GcNxVy1AXwKCz5ehr/1JpTHim1v",
+	"4M5dN/YmFSr7m1adB3hGFl8NNtqLoyeLd/B5EhO2usgSLAWZyN1n3yv10ZDYIu874akjI0dSfxVT5ro1",
+	"ic+cPAXlWkb0TOiPHlFYjyS17AvR+ntWud4KGodCNai9LLt6ZwdsaRNixOJlRRCt+C6TbflhemWLHe9o",
+	"FV+W+VY00w3OG022SU7PCDDbb2Pzvpr8cKHJSBvHz/tvb993umZp+ppgNj3SPsgEzeUOhVR9vooDFL9d",
+	"nuMYjVkhKWlvdNbpds77ozP3cuk1wRGTqdfLS5YYtNZtkWi0fhL4tn4RW2D9ImTDihm+ZSO/3S7IUXP5",
+	"FiuGDAZtuGs5lHqK9L8nKF5WBlHWBe9qFv0PH8kqs/ycRkV/MINREKIYxCofU1aLS9uVDa4+ff06x9Yn",
+	"dTsWud+7DES4QoLjNM+/M3pXeqlmQOcWVe8GqWawQygvgeI+SNeKaK9ITnog83IuII4p+CVAIvOgXA4E",
+	"/3rzr1/Ni5C6BPHMFTmDXOWOWHzZ4uUwsdwcBhPA4gSpom38psUVVjUzjFGaUvI+YSAiLL33OXJRb/F2",
+	"Cc6lm5dw7Do5PlZ0yofqvDk5Pj4WZKr+ub2bKLdXoxiBf/0//0qTqork8DMYw7HU7qMA/Ov/NT4D7i0T",
+	"oqyNgL16B7PLreMeQrdAJuntOD+n5Ao9kzBAkrGoYRQAOGHaXYnLan/FML1a+891jyYkRsZkOZpgOvWN",
+	"NnNL/vWFyHp795ESLlHm4rmci9eTzNIaL7Vt2pZsnZORX9qbmmwunpZJYuY8apSSpHiXM0FPpVAVUttc",
+	"A01zDQyVrNxGqgEth7ecaWCIKCMxqtMMMhO/JRV6/ohXTR2UJq3h54hBnCVLKrpx4TGrN82rZgKJsoyI",
+	"ch5RyXR0Oo77ZPyAHOXcZAEeFNfNJedQpePCpcwQo3LIrzJzAWl6xQZAlejLDJXpleTiQlRGHJzJ1EFX",
+	"l3eqeqL9hjISDmGVHO+n1krPsq6R8Ey6mkBdF+5eXqsbq7bNHs/zYGCaaaqMGFDxN2jMDOiyvp5BPPZ9",
+	"MdHpPJNQiB9RXJfhWaGPCiC5RlgAE1zxpk+YIuNXIFKUTSZ8eCBOd9tWYOaoZWCuMgPTvlS+yF2+La1t",
+	"idMXqzL3ozgmpl9tiQLFka6Dc1LXwP7fb/u3/fO7y6u7tEJp+uOwd9O/uxh8Gtxk9Uh5GdKbwaf++d3V",
+	"Lf+5N+JuhIJPRze9oeTYd4PLweiD/LM3uBB/DPs3w3+ovGBpLrBuxxxr2DdHu7i6uRv2L/q9Udrw6pb/",
+	"9G7YH31Ixxz0z+/e/uOOu77yXv3Lm7sbczHpGu7kNb/b6Z19vLz6ctE/fy8zkw37PQm2XDYf5ePg+lp+",
+	"vLq94Ni5uRv1L89zI5/fDntvL/p3maDSvwz7o5urIV+rTWDhwG7dmFdsXpaMyhalWWllzAiIt8SI1tOS",
+	"xc5oNQ56hTV1O6nfjP97mTyOfMOmTJbH2uVM+p6lU5sMkOG63s/ieyooXC9q6z6lNnxKlOcxok0D1LIU",
+	"koX5G71Nmi8oeRDqyaDKX7rqmiQc8aoskErGWLlKJCjrn7s+r29SzCZIhZ3XMjZiSSyixtOSKA73JDIA",
+	"cRxRYy1XnA4tBe23GlyLxtwgBZ5Jxva0d10FsI/7VG4840hMD4G6PJaW7Ta1UjMqtCwzhB3e9agivxae",
+	"VKxPNOv6mfKBCxaDDGMb1U/MuFr3JVK3EgNR39rLtSG4NU+UmWNnTmlVvfxNUcHKb3P5LTBGTDIDimW4",
+	"RDsE5YfqAhyBOQ5DLG2e1E9lrMueWZgF/JLWBYcMUcZ/+9We07Q+z3QB/Xx43c0f/77PH26qV0n/9Y8L",
+	"FMEFPrwk0WUShtwbkjswm60O8HxBYpbdMTvlxgvIb4WdKWaz5P5wTOZHM2HPYgcBetR/H8EFPno8OaIo",
+	"fkTxEYHirP52EKmxOm+EF4x0s5EO6jUmwSIdZ4WcTNf/sp0Q077LaFG626WBk9qAITKUpOaEXyjDYSiN",
+	"/zyWUBsVft18/Z1kPlrApwgFZ5WCxvCGlM3LIsdiWalIBSu/NeSNF0RtCxhztXk1VwDZ2Rlmsou7jEoy",
+	"3lD2qF7+omcVHYb3QouBM8sLWlhUgHXNpqv7dGxodg8/tMqL6aAisL7iMG8eX9/Mbe5Q+JHB+SIUQu3+",
+	"9OTVn4//5+D01e/o4NVv8PUBPH0dHLw6+Z/fT4KT8WTyF7QBdBasOec9rq3e9EYfrTqpvrmdkWiCp9bq",
+	"73lfPu84CqeVYJXQtQyzNXX1nbN9lrV3XTOp0ryWieoncTsZml5IQS7cDQdphnPrQZUeMEaVAmsUXfZH",
+	"Lmg4825k0rrhEV/3tXhH2a4FtPrZaFOKfqmEWwq8gsR9Mb/BcxWdsUUbbYAWbGYfSnwyR9DRw0+QoXgC",
+	"w9A+5O5065eoFW5TeWkoq+VLYsNt4geX7Oi/UT+bDrWeU6zr4t3qST+QnrRauKepfRyuoxlIsV843PMR",
+	"8asc918Lh9dznuCcmnA0bXiQS7g3d47LYNYv2W43dL10F8MpfVnEmMSYOWLx9VcXKdmSfvAn/jse2XeH",
+	"bTFJQJ2IYBLCKcBRINInRlPwpE9fIuMCjeSrWRIIbQJyOoc9rZQ6sD6zXG7ctM6P2K7bRdBW0lgnssv+",
+	"tiPRWq5W8eLyF7fph1dIP7yX2YOtVEpRzIr5Hp3kug85Tp8lUWk+NamRt1QVsd9kHJUr32fFBqZnLWIo",
+	"4lio2cc0MUDWAcWYWHD5gTyBkEjZaGSHEEh8QAvGt0+7yJFHFMc4QJq51dB8dzEJDsEn7tN+L2qZhghS",
+	"Bk5m61THJnPMIhx208c5jsi0IGqDleku+70yScL+yyqS/D6uKfZejVhGUZrkl5W+q6mqphxA8As6nB6C",
+	"01ezXze+Is2z5pJKnsv59dl5WCkJFvXYyOPtlcO+pzt8774E1WKdh8a2qkJbVWETetFm8jKUx2+UDsGz",
+	"loOR2f+rKTl6hpzI157qppVYuq78/cY4exFLrWDx9aFLb1guH/nxDIYhiqrcsRvkG6pMZKA+Fqk1FQid",
+	"isdDm68MQ7HyQUtFCm/ezSIRsPmJgHNMxyQOwDWPGDH6U3u8iBudoxyna5p637/sDwVVvR/cfLh9K5zN",
+	"h4PrPv/jonf2sdPtXAwu+z3hAv558H9ky4seb/l2cPP29uxjXzixf7i6HrzjRHnzZXAx4DHg54PR2dXQ",
+	"5cWnFdxzxJ9M7K5YPa6jyrzmIEacaVDEUscso4K0eng8BCLGqqteGGhXyz7aBTE/2UQkL+1mxoAoUMki",
+	"4iRE6iJBYoaCLqAEsCcCghS+VFuh/DxK59ZJFxHAgSy+EYbLcl6oMYxgvLxG8ZivwUVvi/R7WgLeCLol",
+	"CdOxKpjqRavy7piJrOJAzlOMI/zt1O47SCIZrjR22NuMBlz/ggxNjYcN01rlKwtK235mwGD1MCTRQFsL",
+	"M+vitYFcESBd5Zom8FjIoogAH9k4bKn1KOGNlPnTYa0tDqM2TE3nxlN9SLi8mko7Hq3KeGYSSVqInOTn",
+	"5qtWI661XecmVBVgX1facdNrt2HPtV0IPOk4N4F9vvQXD1OrDmmo3Hcj+HxTOy4I9m+URCOxAc0pnnuq",
+	"Abl7epk5/0zLmptmqKhEG4neQRwmMlJ0FeoS/YRaiccPS5c+yb9pcbS0AZam7796xw+nDz3HMcR0SO7K",
+	"7KABLm6lEs7VfjKNjf5R9qjlequxCNgZVLpSIUlsTOZ2+AQZiapVKAAyGUYa2S+SJERTdAhuI4qYrvwv",
+	"W4lg+SBA9suhX/0T1wJ0IRTphml/aMg8PSSQCvqukIbqcRT8S6DvnzzXwxR9PVSP9f+ygsyIN4Z0noVa",
+	"BMVoTh5RUL/bYqVdd/2GGlyZgb/n5yqs79PVZ/HX2Yfe5fu+v3J2ltcUmr0lFW61Oi2GeIVR1ipT0eC2",
+	"CdtmCO1tpNjeJRvkV3mLTLNJm6OLUWTwLxzPHKQ6h9/cKZvL+VHS8Zk8xQS5wfFML8XjCHtCeDpj/fUx",
+	"KQeSCnI9VgskV1uyvU4hWJc2compD2veGpsdjoVg8NIUzfOjO6F0Y1UP7o1dPJlYb8DRFK13cqljwZb6",
+	"Qh0MTUfMBGbzngWMCRDEaN10sb4oG0KGLjiTl/HWLOonuzKCJxwFDp2nsm5AaSTXEJzxV2Z5/5nEl7Xm",
+	"kvjwmy2JMKN1znKiEZdUNJkjR45i0WYtsD3mq379t9AZYjxdTmhRre7h+IFMJm8hRTLRlgML95AiECDu",
+	"QHeP2BNCkfYdFJFmRpBZ5jFGkvvQsDvJhXEY1aRNDkhu8yfzRaKM/lZIrDsr4tfcBUnFZ1mWVBYTCJFR",
+	"9dF54soJ6whG+NcZ7rxUQZrdebPpvQ7forRO1+YrcfRtxxaKWhmImvkROQNRCyaabZlZAkT5+r8IR+IL",
+	"XhGOuk/Y5rMb45bu69LnTUyZuTLTGUnCQORLE080SZSiqcvlXWbGET0L2k22XV4xfRklqSA+uyuX3wXZ",
+	"uZMyFmHD1/tylJwVDcog6oY+56ycWjTSaMcALVAUUECiZhaNWJ/F6+kq2ZFum2NNseH0G4yXb59Djpsz",
+	"v4NjRlzVDsQ3t+DmS5wnIcNc7nJ2WWH+T/Bb5cL1Vchr7dVI3sm5Feszm1ZljpaPAaY3u3ArXKAxnuCx",
+	"cbzQtUypmQJhoWreMUhCdIPniLhiZFUj7hbBZLtaKURDwpQXUaWEb2p1LQYBh4QJFIprcKoJCGQmFNmN",
+	"7axqregbGiep06bPUpVB9oxEconU+cahvlszk0nDF2ap/9rqtsICPPW1LqW5TykS3tpIcZpVNBMGH1CU",
+	"M6DIwYRQQcxtNP/oug5lHiXCNJFQFKvf0sHto65kQKgeU1siLZc2HAV+Y5D4fUyShX2YKf9UHgf01Bds",
+	"1EqWWOYpaskEqLuJJsgM2x78KA95P/9NIwZL7of6QfuQ1Sw+RjDgihIv+/ux6gIsxDNksIQLgQJGZCq6",
+	"wOWeYpacrb+0QyA6VMJuK46bslh5Ydk++7Kfqepa0v7MFzCGzjM9+54edCJFq2FCJpNM7T20J+tgn3lL",
+	"tw/CFMWOwTyoLMOeS6FOlfg05XE2SVGZt2valMUVS5BL9UWHtIfaR8pspRmAghtjGD3wOeRCqO81soJA",
+	"Co68e+LBa79xPoPDrQ2QHfvHbtifcaeesNuqTmQgSZDtenWKpOd+aX+c27HjqkUl394qBrZ71jX1jLOP",
+	"7e0plwXkbb5SbmUGs+Z5v3TUdZv7q839tYfZmNYQ9G0Sq3IeiDXjyvc7L8KLCctvmGyoJruPJYBf+92u",
+	"E8QvWmcR/NnZHLhL26dpfcywZ+M0lHlQbYlgk6jBqZzIy8gMLlDuUC8+xst0QyORc6w6d++aRSmytGYb",
+	"z1a2gQEdyaoKW1+YOl1Ut4RIY1Svst0657xXPQ3RsOwlKxNitXnl27zybV75l5lXvrlXb02O49rsfuVa",
+	"7p2cNNJHSOq4Wzqusq17rlwz5iWKuiVgUENkK6vRFUl+jCm/biqjzRdb7j6NezeWdlR7SoVsZVWH8puw",
+	"qyBbZf20u3bFocukKvsmcdgodlK9dfFxbVuWQ8mZsCGsWZPIY5EUjWPkenoV32SENImBiiIXjzyDiajE",
+	"uIjJIw5Q0AWQ25MDMtednniWvnsEpihCsbaGmERyujWMN0dzsJ8EuNre7JqUUzhrkc2FjzvkdacRuzm4",
+	"/GyRuS7uJCWSoO6gY99EoiYekymqRBolIlezHM4Rm5Gg0WoV6J9kz9REcEYCB9V+uLm51pXcxiQwaltK",
+	"5HvUdTOwksKcm/irJ8KrSSg2nD7cJ1wW4Cxbezs8WClgZdr5lG5dFqnMLxzXVyPxn9sboQu4TkgdI13h",
+	"4UBVmL8YQVSfXKCY01XDIE1MF8JoFtvMJvlccZBSPI1QALJOwqh8ezs4B4qkd28sClNnT8tztApqEW0E",
+	"mefiAFGcQ1YleeQdQItoDCFlHxCM2T2CrMrsl9s13ksmfoJgpnvnDW6nx6enByenBye/gZPXb45/f/Pq",
+	"z4d//vOff3v954Pj12+Oj72FSQglg6EIxX3K4H0o7Oh7COn2T2f3qRyjMYoYN+C4w6dkG5mGP42WWoGk",
+	"hvm5rJ6hun661vdpzQslDqhZdZ1E5i4euu9FOKgduHrc2jt5s+jc6sk8LuSRr9UtTiJOiINoQvxkwNDo",
+	"oPwRs5u1yxsxv+pRSBiAjxCH8B6HPHycKw0yuYOgJij8D9MIgMLhIDuGqM78kjZUMSOSTjHND162xoQ6",
+	"+MgndLDJ0IWNkvPYNqkhukxJ9QuH4I6PCA7+Nzk+/g2BPzJMdGU38P1Xe+BcSFwKBkVzuJiRGMklyrNk",
+	"Rc4f6bFGYj6rodznZUcSZLGAX6ZzjPoX7z5cjaSt8FPvsiftj1/6bz9cXTlKQUhlxukKIT+Dwbll7fUP",
+	"NbL3bd2F5XZ4YRm+6f2FuUJgKj3cfMLh3M5aa5xdj26HMS9PMTc++JIq8PD8KZacN7UUyGFeSudhDWE0",
+	"TZQh21t+j84/UqkXyc6fs4QHpV0ldl1aHR19no7P2oAGD+5hS4sTEJk3hquLnni9uP7HzQfx+H3zj+v+",
+	"6Gw4uBZvF7dv/2Fl4ZxUMIPoz24Gn/udbmdwmf553bsd9c+dw/Cj2JZzdcPZSsQr6OfUyvGJBF57KZ5T",
+	"LV35iPQacgfU6qipTLuhYCHa2905/03uHacC/7JyNqG/kXub7N+J/uvcC51WsjwE/7LyWvV+3UDrNbTa",
+	"J0F+NW6ilStQj/rNxI/hP6CRWWmotxw3afp9h6hVbzDuNBRTxIzvDu/8XqRDHKRT6BSxcgYK6aSvj1Dj",
+	"scAvL4WWGPLZ9G5weXc9vHo/7I9G/M1xeHV9d9n/0h/d6BfM7J/vh1e313fDq9vL87vh1dvBZeerI1Z2",
+	"tewU5js3XSU4Vk9dXHXXiv2qrRycWzYnA3BwbsV1ldyyyCrI/c8XCxTRLJYl9VqDOXSAgCAa/YkZDvey",
+	"pSXqMuUfMOz/rX92A2LEl0fNHKJdlZjtS294qX+jKhw6UpnnxiQOKICRmZJ+IjMomWmM5CRc8+wNLysP",
+	"m2Lil3e3l2c3g6vL7K2b/9V7XzuI1moaCQCdoqf0nqW+21WltcoQ7VjL4qvwtGqq1s6a1ELGfERVngCM",
+	"MBjaGDkVUTz9mP0Sqofn3OrnbKBNMTCLdEwnAb8sIKU81xGGKu3Jr54hECs4KVdFRZfvujVP0qa3b2pt",
+	"Ozk+PnY65ViHyfvbNnSdbbSgf5N7Ld191SDlqbZBTUg6dg6CHNZ2ZJGWcyvD3vOAkHNA3aQzqcENdo9S",
+	"VxQrCt4uGwx+Y/Qqu3g21OicTqLrhFxkA5nunwbYX6uFyZ7cuw1HUf9DYZhEV3GA4rfLcxyjNBo3vWmO",
+	"eEbe8/7orPKczkZ5h1GYO/fNmpkZLeekmCEZayYZaQfYVna3sruV3c8lux1z/ICivWx7u+5fnktXZFXS",
+	"tNPtjG7Pzvr987zTsumpnHoHv+2dfbx6965WzolpV7r55EnCcf0pbK3FPYZE1wbvlmAdxzJ3UKCSjtld",
+	"xh2d1xYoX4rFHj1JpGaz6ZmIYnY6DeVqTG4xusJRX09NW7cI5zVPFuNtQEd6qDPZsU6PKDQvzZ8xhNWH",
+	"XTGO9ZtmOutHxVzWb5pHrR8ztrV8rlosN31a0Bu6cik0taJHG67QqOyaEsIq+tEJW2Kuik7scsHK0pIv",
+	"77CDG+smFO7p1hmFHLlTT3mbnpbaV9hc7S7gzSJ5URoOtMrAKX42q57JA9OOvuwMvVNm+OZolnUqnfJ0",
+	"vx+LqhZmaDhFIZB7FfBZSiEd4spFC1YoVmDJ8l+d6q8UpCZGsDpg6Je2Z3o/I/w66pk9zJLgzKKXr1UG",
+	"wKWPNxUEQqt7PDlzpOJslvDaNOJmbbtpvh8dFACgUX5NZCzku067aaaGLkBs/PM9SBnpufWyeITfu4ur",
+	"L/yJvzeyO+ZQfiVVyZ1c76Q6+ZPxUsq78V0xEmKaG6jrDKvkth5uJc73M88k4VsqNJEdPE2eQWpD42pu",
+	"r+5bpV6Wlim5gb7WHxJCIm3yqamJaNuLPdkVwr/IFFnpG1OxyAgSXnrp5zK25vBbTYunZjdPceWywCyj",
+	"hhJ+dIujV+XqRjBGMS+4x/8lMCo0EvFztikzxhYyOxZ5wEg3x3xX5U/ak+FNR2XRyPrCBea55HjvhDIy",
+	"95zsu1AWJo4SJB/kLKB3PeAdMRMmtvyvKSF2Tg6PD48FHcs8Ip03nd8OTw6PVUoQgQmR9iPEj0g5U5Tn",
+	"fa+dJXirCFEKUvMO33ShFfId6lyo7+8FGnQwjJjl9PjYkgsLwZDNBIpey+9jEjFVyE8I17EY/OjflEQp",
+	"6nz4uB/HJKYSmfk5LwlL15Ejjs6bf37tdqiKCBarzhpqD59/KpjHMzR+6Hzl/QX++BmyrEcgb4arMDjU",
+	"DfYdhWLB/IiE4zFaMMBiOJngcS1GUwzUovTx5AiGXKRE0wM0hzg8EO/S9OgP8bP523eJlxAxy1X+XPxO",
+	"AUxTnvHuQHSXT92lXejxFn3eQDi0yBEEz8RwjmRdtn9WuFyVZgDCUCXEhirvo4RGaSkdU6jJ54Zsx9bL",
+	"jPa1RE+vLE72yXiMKJ0kYcgrx8n0fqxiaZzyXu2K8npgDkOOBRQAnqUXBjpkTYLx28bBsEHxjsT3OAiQ",
+	"vLhm9C3ppIrMNMXfiCb8sPp2ECuVQ3yQfTtdC2F8lVWwxpYyWPLuvw6JyxF+DBIX9PCWBMuNEYPEjty0",
+	"AuLSmMfv37tNsMUISDTO89j4bhf7G1mIdQk22HNiQALaigFPMSCpZXtiwHZAitz1+mTk/1jlSOT97IKC",
+	"p6tf8RTkg9bIBjXvCzj3YpW2v6V054GnNrMpiYtudtqmOHpIaZv/YxXa5v3stD3C0cOKtM0HraFtNe8L",
+	"oG0BaUvbVbStNrMpbYtuedpe4ANGHlDE6Vr/Lch6QWzZiIbokTwgACN+wweitXLaTacqUPYC3/BW+lWI",
+	"d/ch73R4B01rWPeKpGOxPEXSArofm4xpEzpWpMM39kbtnKbf7LcqEk63PEfB45AkwZFpWXVbPkpJprW5",
+	"SgwCcEQZjMZl1eOMf9Zehm6DyPZxKwABSZTFkO8LgdVYWySCTbcttfWfDDedbwd6iAOykD6P6iZi7Ld8",
+	"Uz/6Q/z3e9V+y8AWJHPg5jdUPK3LjayVRGII5+Eqvu5UCG1uswUWai9dMgvkoxJrEhtix1rZliNxAzMZ",
+	"eUsUV0g1JBu4KfyoTqxlNW7EQVxN8+epAPvZ6f5ckHBL+/tF+3O08hnuPL13d3CrXKJNaEov56Uc5Js4",
+	"wvkYR+J9Ve4Sde44d4YGMAxBrrVrg3nrQb7h1nabz6V23Jiy4ebrrHe51e0TIaRbLzaisAnl/c9tMokw",
+	"I1yaH/0hOf770SIm98h9udTuTmZkNCNAvMcpbxiR6FC5LrgZPp36mlA2TKJrMa+/UcV16KWSa8enXgVB",
+	"ybKUip4Efg93eirwJ1iYsBmJ8X+lA5nKYyidlVRhu6JFg8lSWfK9FYjtAe+UPB9k22o/OHJkJjyVgrTd",
+	"gUwrdfSH9XdPe53sC3RfmXOqRGUj0Ur76YjywP7WO+sUTrqzLmZvjXl29LUGvYJBz0Vl/kY9O1nk2SOE",
+	"44ejP8R/PKgfjHhDncirTPL8q8qX6k/ruTHdNM5b7SVN53GyT3R8shswbqNMwsuJX+9mYpmGV2Qzh2FI",
+	"nlBgZ6Ui1WoWEr9XcpBskOMYbgqnEfXilsuRqRSV+SWiDdgkP5ibUSK6n2xSQEbLKHvIKCWCTVnlclTJ",
+	"KBG1sInW6w1jrF2z5/Nqi1GJRRq7/Dybet6tLCy+qqHMgOH09escECebuCIsYsL/gYJUQras+fys6bKx",
+	"iMqIPKBDU3v5WJNtCvzIU4KjowBO6VFagMhpU6EcFhHWQwGbQQbukSgFbOReSivz8EmLXPv55BxO+UA3",
+	"Yiofa7IuXZPFtvA8YIpl/pOgeJnxTACndzioPua2FYXtJXcK8D6XXcCbequr0VHfLJBi289UmTx7vtcK",
+	"OcSn1I/jYtaf24jO/eRPdmekwfNFiOYoYiXdQNj2NB2kt09IH6wSRjQ8+oP/p+b1VYwJ7peSb4oChE/g",
+	"+RIlxnEe+hzQHR/5+VqLDqGgGnVMWEr5Brb5zFWoLNfIMi2w+rPz56vjV7uZNSVyXmcoIgxMSBIFeyQi",
+	"Mn4uiQj3nYH5iJCjkEzrdJWQTEGII6TzQyo4ihLlgkwvcCRLGL5EqaIClBlR1uD7pUOyaEuhBRocsd9f",
+	"WTNj2mPYYcxUtRge+c04qgWWHTNTLA3zlpkrElzZJ0dR0GTqJGI43MDUPcDl3QFD3xigCMbjGRAzcTBk",
+	"ZtGq9YsONpFevVZBwegRhb/QX/lEOBqHSYBc+8tb0o5V260W+JoF+AC+ym2gUwBywETwrZvyxOe7++Vd",
+	"2ikHpRdwpcyDXoes1/bswZFrCqEGCrHKFNO6leS10lTyG8fOBZmuf+pIwnHaq/4uJYJRgiwtPgZBnEQR",
+	"T7rAs0PybA18xC5IKBYXaClMZjAKQhSbdZDul1kVZcAnwIgCGCNx4Mtc3Ij7oIpWemxpXArJ9NChQv9d",
+	"ccBeH3dbiob7fCJWz9FQE/2WSnhZHHl3cW45EHWa1xqx8B9BG60evj96eE4wSdmwJW04RpSRGFUFYIgG",
+	"0s8Tj/n5Ycohh5RQvV6EnNgaJyokNOJFtR8tM+4pM6bssB12pHgqPBid71r8EgNFgsYAyMYiFYVVRzgE",
+	"fEmqFab1Z742lEsFgxIQI56umpq/81EQfpTliimcI7CAy5DAwKUwjOSSflaNQS7fQ2XIdpOiKNix0mBC",
+	"6SmpRHVXlgLeyqm9klNyQ7clpvj/H2TJRt2u1LJNtRmNgyRc938AQxp9wAuXKWMyoWgjVrSt2u22/0CQ",
+	"7fUK0TDtI177SJCz2NgkzPrCTrQwHI7uk/DhIBVcde8HnFp5D5D1kJYcOVwXzAllui74BMeU2ZSnt0n4",
+	"cKV/85aN++ix1MpHX/lY3vMGNt0CybXG3YKoKOLHV1CI/GPW69hZjFT6sfzYKn3uWFR6oBwh+iaFHrkx",
+	"J04iWXaRX9n41smXBnlhy0YRJerUZe0ejh94HqQo6IpiiphRsIjJNEaU8onAPQILEoYoqJUlEuoXE560",
+	"jVuZREEOKzXXs8IGMwLGGo27vKjlQK4VDhJEm3RohUMmHCQxlJi4gXxorkEc/fF4cpD/7Xt1HHIRvK56",
+	"1OUixBQGtezv6wS1j5pEgQtdwJVw+2JtyM34PX9hajn+eS5Olw6bjHRqWlHIdC1EvSnBcyQVFbfZ+Uwp",
+	"MhAsUCQkDolTk3N+QYeA5/tXCtAMPiIAQ5ky+R6hSKlEIQoypQgF6as0nEwQf2KqV2EkwK0Y+yHFWEYk",
+	"rRjbPzEmee8ZJNkYhUcBuk+mbkHVl3XluTJ31r8w6s0AOIU4ooyrSY9YPoItEplCxiZtzlB4Lqb6qW9J",
+	"/QuBhJqrkcAk5Vcihqh8E7Ijf8d3pQx8zyctpKgnsKyhvTCZwZ33ybTEYoYAOOtfrHldChAMDkLEGIoP",
+	"FiTEY1VfpcbqanQDulve9PqE2cxMAauLnDnNsOcIBhdixGs+4PKlWGK3e6BbsdLAVmnbqJbFCgZLK5Iy",
+	"LuN7AOQm1BgvE6sriYVn0jRIgmf0v7pApcop8AyegIgAOWeu3uGUV485BL0IoG+YiophAv5lmkRNeI5Y",
+	"eoorwdh+AyjS3O2Copj91Ce0REERMTXndYmsltLrZNfncxFsD5cTZhcey1Z0GK4fwsxgwVFjybHiOS1M",
+	"DKUPS89kWGXAcyYFbU2YyIxeMBaVMcNQ+SMRp0jzkSiNs+Hvl1nBwtgVpoXyDv0w2kitJDHTh7XSZP9s",
+	"DJIRNyLGug5a9xNv0kX2QHq/3ccwGs/c1oe34juH2vDClRVtjUQXEQlQVz7NyQieCD2Be9U14rt/oDKo",
+	"6Kcd8VSMrC8753ImbnGRs//U6pBEgYGTukddiXXNbzt+yi0D62mnUGCbPtxtqEBegChWLISqaMGha7kK",
+	"xWJdDSgnIhaSVT1MFTg6mIR4OmM5ILWak/rq86biOgXE0GAO4wfuKXIVjRGISISEChSiCeuKtmMSIN50",
+	"BqYJFCPcL4UcXcRoLGxbchzlMhKjOXl0XLcyyrzmXV60C1qWBUgs3+EQJr4NgkbZs5p4wOn8N7t2hTPn",
+	"fXE+cUU6/CQ4oLHBKcdkJMpoQbFUKz2L1qcixmAeX4YeplrK5FybFKd/mP/87pFiLItlQhETEc/SMzgX",
+	"F1Ut7KR/Opm+aHmX00CNXGh2GE0sP5NzMJlY9m73YtIKw0sVmTlK9pSVJQS0l+HnvgznpHG6P83lbzfP",
+	"517i2CPIyxC8NFebzyZnmwV6tcEMO07S1EvzND6gJTVy4Din5e2aZw4SZPARLX1yBp2RiOIAxZrERP5Q",
+	"MhaJUgIAJxw8UftBZWTaZh6palju0YTEqBaYTWWWeie3hpEcNDBGAFJKxljc9sR7t2F9Sh/bZGYSG3y6",
+	"ySBw7OyWM6D6r8tcDM0iKiAYo5hBHKkCLjXrHCbRSLRDK+XAEkHucp5Gi0u3RK1S5uvBMcCBC2LR8pm3",
+	"hZsTggAzUWspq46lblRyLQ7ws36fsqpOloWUpWA6zQNaHnAvHX53wzEFvwRICD5t5/jXm3/9WhRblfmt",
+	"/XKWicoXXvJQtvRdl2i9Hrzb1ST9g3Xb5GJ1F+qUNzwL1jVQ0I7EMex7PeaN/TS1j6j1dzLUlZUYQaC7",
+	"ZQYbMwClPW6BIWRsQWXGvcQduZkP15SwWgI2RbLpaIqkixNm0tGVG+Jjwlgo65NBMIff8DyZgxgyVIzx",
+	"FD5PLIkjxOssh4jK1wG1X/wzBIEKGSUxiFKlSIDjjgWdEJn8P3N4LCwURcGC4IjRQzDUcRhq6DGMVTbC",
+	"zGxOYjzF/MSXyFBRqf+ayWKYd3c6luNOfL/Dwb+sasIDWjqFjQTjp37AlCgQ2KA1b5eKAiW9UhSisXpY",
+	"0kqzTt+042fN/BL8UxAmSmZKoDXZtapEuay9wM82lQnuv9Wg3LmQgoza0+43qXy+x+V2KvyoXnJlarU/",
+	"rXK/kYLUtEkx6pRyvDhTSnsfFV+1rNXv5XW+NcXuqymWz5h5xgdexoday13lFCXzmjBkqtQkne4uCh9l",
+	"dhaa3FPEuDYb4AAylNL1Ri0vVSsGtxQFgo0kLELHL8MDmY4bE3lBrLbbHRttDNZuINjVglrJXriparxk",
+	"sl3id/WEQcrnUw7sFM1tmh6VpkeiwycIVfv/KzkpfWzJrjOwK/JokpxHkUL71P7cse2aP1Pe9Od5fy1O",
+	"XLDk3z5Vf2GdpHjhYSuKW7GwlgV6LfbLVoqJl3nb8hQNOjylFQvPKRZ8Wb9rECY/+isqFBpmY5fBRM72",
+	"ki0mKT//5Fw8Jaw93J0WkxXO2CKjSVf+EqvJ+t71x+YLrwOeOzYTvZbnZLjtRLgH61wBUrzs4QVAwtae",
+	"8i/xlPdQ9kMyPRAvrAdzxGI8pjXVi+c4ShjiuoH+K0bwISBPEX8M4x7ZapycaddWUEF8UIUB3yN2zYH4",
+	"pGB4qdKuLR3alg4tRLMMzhWIdWZx3q2vej2X92TB1p6H3L2Lussdfka4KUOLBjDz5ruCd+vFVWlOejZL",
+	"wMtZSZwAWnL/9Gf8HpUXL2+OZ8lX38O/cZVxr/P8B3mwbSuOt2pDW3F8SxXHW92p1Z32QXdapTC9ODhb",
+	"U+maZem9dBRRxdHPNqHg0YlEZAEjT2sEpA98ETJE7sczQxhoqFEpPABdW8Woh2ZTWkbReUxItyiQoZh7",
+	"LpQXMBbZIiF9+BO1pcco5QXi7e94+zvd+g4HOfi3mrRIOiGLOFgW4+kU5UqEO05u2RBHUxWTsSPIe7aw",
+	"j4NHFbDpoXJkcSN388o40ec94ETKtiRazTRQlKKtZWB/LANib8pGgQ0UARMn7ubeBExAfY7hH+UtQBx4",
+	"OvGDUaRWedR1uh30DfIt7rzpnB6fnhwc8//dHB+/Ef/7vw65o7r3JvKpdBMHpIA0TQthgko4fGsAO8ER",
+	"pjMUvBWDNwd3+7JxDcOpQFNrOd1n+egynW5ISlLPmmQCGOqQdy+nStj2PKgFCjxS4ab5IccaaTst1aMr",
+	"ft2I7WxWIEySQOs+0daFzxUo05Jh45KpLrWAitCtkkxtqLuKE28imZ4xnN1XMKUVLVu51MqlwB65vw25",
+	"FMMxqr5LXt1wkcjbqZtiIRFcUUpd3VMUP8J7HGK2fI/YDe/6Ym+M5mI97H1xEhWsZc+UEpcuYPQcaXDT",
+	"eV9Y6tsrhsLRAkZer075O2fGIK3I3pnIFvIoqqiXbexKJjFzsmlN0fmE7meEPPhkVlBNazMrfJHt2tQK",
+	"+5xaQZIL4MP65XUU7S9581XcVhRNjNJRvP0eFNF5A6o6VEBaPcmzZy8w2aeB40DKyK3zQN55IEWMUf9G",
+	"/rR2BgM1tFsGtjkMVA4DhY8mEUyaKZ8pi4GmkSZpDDQ9tArUvuQxyDi0Ae83UJtEKgP1D79cBrUy44Vn",
+	"M+CTa7cNzcL1eQ0yrLiB3e0Tni//61wFLe/vRRhjLXt3TXKrSVeg6VflK1DqoYNvX3LKgoIC/KPxqM5E",
+	"0PKoIxVBzTGJIlE2J+b+FOIGyjdX7b0nl9XlKqg9Fl94toLtctj2Mg/8uIq7Tj/QCoY9Utwt8mD1k91+",
+	"g78mVORuxtGYzHlOS02vc0QpnFac8EM0RvixlUFNZFCUhGGJ8qMlWMBlSGAAcARgtARqtd0Oj9o7WoQQ",
+	"FyitOOVOZIhH/naZN1WDopfFeelU8lJFr4hYO+6NBNrRq/FtBBM2IzH+LwqeUydC4yTmDypv/vnVFElS",
+	"XlikxKqCyce8oN5rDwLEHV05vPQowJOJ85nmjMwXMEayBILRS1zFnwh4RDHV/84ev63PN+rbeTrIOZ/4",
+	"h7BHqKU5ng7Ef5rIPeuECtPCl1BuCpjEZN4FCLMZ4k9nuoUcB+TdEfRH5+M8H2wrUDLSHMZDcI4mMAll",
+	"wQ0RwAgZokw3OXQsgpHOM14b7RRea+mVeApypGTyWqs+Prf6yPfRvjWGuFaf17UA20Q0+rYgMXMK6b74",
+	"LGX0GEYkwmMYGmDmhbPmoa4qcqMqG8XoEaMnuet4zgdEXI1iBMCICO6teJovUb4EqZXuTeVmYc7dSU71",
+	"ea/EZ63olHzhFp2t5HxuySnlAIDW3dmN8JTCrMqvfIqprDlhg7IrHJNUMBkgEcqojqtMRekIBpOCrgww",
+	"BThAERNS2cqUZbaPiPAESPk6jcLzE7+D+UsRv1uzM/qJkxu74ODbhDUOd2plbC4E08O6FYK1zxKSL3Yu",
+	"jmLE14tJdLAgIR5jVOcXKSv2qU5Ad5J6nBY0w0Reu/Us0vpDEr68Qt+lKPP9gBZMCDMln7iWYLZEMSaV",
+	"8mWo216LQdsStvSoGjkN/OzKu93ybdHjTvOsBVdb5N0kquPWfL38Wj/mrD5+68u8r77MPcGbImZDJFjy",
+	"9GZWbVdxZebxSjKZk68PM4JxiBFlQLxt+YC3xYxJSqH1BWVjSRn3JiuOZ6XBF5LKiQORVkHwqY+I4i3n",
+	"P/oyQ/KelSbBBOe995SfViQKl+bvOhTQKpCicHmnG9Qabe4JCRGMPBJemeFvPjh7ptxXJpR1SbAKoYx7",
+	"lQwLTEI4FUftk6ILUcWd5cgg9SCBUQBIwvif6kGU6lLrWjPM283+xenhXwBPQBJRxFxGMzXTnR6004yE",
+	"3qla45jNFDTD28vLweV7deiA+2T8gNgh6F1cqHL2FNwTNgMkOlAcypeGHvFY6JGcrLvg6vLuy9XwY3+Y",
+	"9pEMwr/yvYyE/TBStyAUd0H/8+Dspn+eb58bNY+e3sXFoTvGk49/l5ZG8Y4Ilx3TIh/bz6QzkuplU029",
+	"jT/fo+DvwsUgsT7JiKvyJu8DRwGmPOj8IBLhYNW3A9WWD6vCzcgkD3JdkjHjxnAuBxNhaC/69mAcRLRo",
+	"/pRIUak3FfoU6txqk3HyVB/tLzLtr50EWtHViq6mokvzyQEO6iRXjkeFrpVj0Dn3vea6RFbKuUJyGdnO",
+	"X6zgaq0CrVXgZ7UKtJeVZ7usWKVoe/b/SGd/7qzdiR6gTDduv4gb2UDnJKjOa2WQaJuc4EShzkBKTaBT",
+	"jhQYUc4ez+R7wO8YiEEc0mZZCkwKad8ui0kDCgy0TQanR3/oP78fFXwPlvXZBKzuB8u8E2kXUCKCl4Xa",
+	"4u1dAOAU4qiBj8ELz1pgaHp2sAzn0h/K/cE7v4GN1Fq3zWd3eE/Tijh8LpaNPC66GZ1XpUbwETwNZMeL",
+	"zpzQCo6apAut0Ng3oaFyPmxDYiwSi8QYcYkxI08gJNFUKiL5QBdTLekCspAWnXDJlRFudIGhsl0cgh6/",
+	"gWHKuLWhJIC0VpMxJZUpqceoib/k7YKiuBVJe3ljk3vj2Liay1uJXhgB0untWW5vDeUpRa083U95OtqW",
+	"PC1fJfOmIZF8zvjle005rZz1gtt4+cUwNbwzkr7hEmk4/t9OIOwL/9sBC0dmjMwU4anF5WCQLlhTxOyC",
+	"qrC8l69C+RtsWoPwHhuEiyUMPG1D3RJBr8DiR1ITquR0NkP6sSevaMVJdFjLxeoZc2VeNqc33sl+TNY2",
+	"331blt7Tg/uMJGEg8+zjSO5A0Qi+R/XlclxFNTM+i6wRBTuFw3C1C4qoDyCfgr2qqxgChzNQX8zg623y",
+	"w+fmt4hVq+fBjytRBUG0D+etnrSu7GKYp/ir15ZUu8bSi1eGUlO82LuPVQYFaMFmsuqcrBIExjMcBjFy",
+	"RZiIDntUCkkKErk5rSR58ZKkij83LV7QQskU/ef3IxiPZ/gR1WlBqpUCk3e3ipARQwsVVdzTA3uIDz2e",
+	"07Cr4W0jjPezPJvad7XnKxRpU6p4e3HcYU3NlOsKdTXLQirH/gbza/nEt5/LpirRlLJwvUzyuZfJNg3k",
+	"kbyKtdLo55FG/netVha9HFlkMP5GJZH8TN3eyNKLkipvZEeo5I34+cx0nt30U7EcXE5UV2FbNHomd14J",
+	"YSMHXoXUH5vzVvDcTYktLS0tfygRuY2iU+/cWluBfBPNJflyEHhTx7Y0lFbN4LT1vehUXJ4Ur/3HWmrf",
+	"7TEjiTEgSJ4wwtvK8krhzWy5mprVVYAM365Kvno5tYC25AclEdDkcFvEHJEMy0jYRCOwPede0jmn+GQF",
+	"1qs4745gyAkjmh6gOcThwTQmyaLSYs6VOx1frchLjAHEAEANUGTdHm/S5y3e8wZtUkrNEzbENLuKuTeh",
+	"5Z28GbmCWhudY95Xn/JcdYzx04dlmje3Am78zroSyhtd7U62y94rnIDlBbV8bb/7Wbltw6dknIRoteNR",
+	"9rSy/zAJUXsi5lgmRckaZ6HEeMss7kNQ0+RWTz8+ia5iI36hPBtkBCCY870bF7xUxzGhVIzEZjGiMxIG",
+	"bq5pj8viccmx0uSg5Lvz/Cckh3r1szEWvVs+rzgUBYo2fhpSHD2sdhrKnla+HuHooT0Nc+yRomSN01Bi",
+	"vOUS92moaXKrpyGfRJ+GFEUB1WciI1md0S74hPk5SCYM3CA4F/mzr+EUxecJW7rZpj0Oi8chx0qT45Bv",
+	"z/Mfhxzq1Y9DKnq3jF5xHAoUbfo4PKKIsTqHY5k9Q3cBukt1cmGDNHA0Hak+LyRzxo7OSAMxaxyT5p60",
+	"PGR587OgaWN8tMAHjDygmko+oHc9ALJdNdf0FviGN2uVSXokvI2vBwIf1KOyvY1P0sRlbd64ghrJKVKi",
+	"1mCG9MfVNUgAo4za/Yi9VQEFAjStG7rfNt+3i5O2/LXhvIwZMzVksKoDx8OHmorg1pwjtatmXOZK29aK",
+	"2+tacQ9o6ZVenLdrng1ekMFHtPTJ1p3BlJq/B+fUt5iXlBWNAdSBUoPzFUHMItPXyKzvA+EwiWR2BWX7",
+	"slIRRTAez4CY04DGnaNddvAGRuznSPaxFjyDwnmYxEEVDsTnt8t3GIVBs6mvzJ4OHMjJAxyjsfi1EoZz",
+	"o1lzOLLelcSSJfRHS/AIwwTZ0/qrit1cZD+g5ckb0fSk0+X/OpX/Ou18ta8nS///abPZ/7NlyKprOCjB",
+	"bYNHNB7sJvH/Nu8KK8XftwEhkTsSw1BaBHLXtymLcR06SHsFEAgQuKix/Ur+fp7YD0kJTay8SPb42WOu",
+	"Tv+ym1mHij+Veoq+jREKShHq6oIi96YBn9dfTI7uk/DBHWv1NgkfFHnQTCbQSqHA+/zEgoEvv6FwoM8k",
+	"HUqgepoUSvKiDdLcM4Eh+NaUGnTDYmMMozEKK4I0xXdp2TAKW+Z0XpcYkUEIcoSfWcMQCPDXMNQNQqSj",
+	"Xm5cjmThPfxfT9nteRDQLd5B0h/I/b/R2EOVEUhDWSqzVkjtrZAaCkrdjnwSdjVPo6s01nkYXj+iZfvO",
+	"R49yuGh6fRfIbq/wtis8UMbgTfKBOg2c57TkQdrsaB7qI+ZnPZolAvblaN6MnU0C12r1P+mB+Yf47wEv",
+	"6nqgPwlzd22yCsigPDyjSovhOWTwPWJfMJvdaLavlR+afeziowTyrh8zf/hTnm/aKlmbBFW0p3zeuc3A",
+	"jDfvdi1EXs3PEwRZEqODSQgrvET7/NlLeP8A1QHwDj4uou9k+3chnOpRGqgCg/N98kbIrV2mx0HZmmwP",
+	"cJNs9YOgEtwqGnqXG8X6PChBigJBpNEUPIlH4BkC92gGHzGJRbxLcQ10JjLR3yOAJ+CaUPaBTAGmIMCU",
+	"5zQWvJFE8BHikP/bsUhM+5FoPphcEj7KjEwr16qQfU9IiGC0ZclUJkBMuD9UEtZrOXp3gzLqtLHgp0gK",
+	"8gKKHnmKKC1IFVWAd0LuragM4egRs8bB17qXXV4OxNfWcECPSvhYyYdeY7v1nLeFmWW0uKUQMzlBJa23",
+	"zgFGiJhEiV98mMTts8aGSXBXCQxThPGzp9E7Pd2RyQCyGnNBPigt5VubXEBC3TuIIUMHYkzOHorX1jhH",
+	"9Q8H8t/ffSrOwwaC5oXXhM9zfTVsByk6XvrJ36gg/H7KFluF9HR/XBf5/D7WJqtsxgkvJ2HlS+GE7ebU",
+	"XE0reLasmp6cK+F7MZwrN6Q551adfHPEA06a3iB1LzuLfxJf2xskPSrhY6UbpMZ2e4O03SAzWtxMyLUa",
+	"7+gP+YeHEijSdvG2YBKTeZ09WlLDj6EKqmW7YJOfd8q7r7bCu6vogD8H174Aw2zKpLmNaSAvupqQPTK2",
+	"lyZxi4AfQwfeCxGwXeVXbpef8qvQsSfZ5T2ll0UPVvvWCq9nFl5OubKC8KrSehYxmSM2Qwk9kClI60vE",
+	"Zl1U1lJafJN0FoG5Trt+UpP9EBcFhr6xo0UIcYEqiiM1uQOUsdwy5XMzJecAy75s6gbynwQlyJsNRevG",
+	"HPh33usFMd/LzhPxkkL/t28PydHeavmAwCOKKSZRKxP3SSamu1OWiJpzVpWJ2VMf9TLIxNlzY3WkDH+X",
+	"vODtXrhFRq71AVVk7vFxiaszrXjaQDL0t161JUuEgZyMQcT7+IUk8Erfl5oQsWxw6kv5bYKufU3Qtalk",
+	"TrWY3GbKppTO9iBtUxEWM3XTNhWfPK81CEI02LmVpIUHIBM3jQVppbKhehwsSIjHy/rc1boDkB18whJ0",
+	"CNW16NHmrT6yoWW199LCbrTvpjuvDRaTBiXBxgllZA5EHz/7xZC0xcEMliHr1AWTW9UeLVbfAomczfqm",
+	"G+TuT+2ti7rhos4R4vcaJ5D8nO7pHNRVnNNjEqKWKV0Hl8DORs8q/c8D/i9Pv2+TkVVso7pqg6E4y1R9",
+	"vxgBSCmeRkgEbCq/EDCGUUQYD32UUwWHFfz/Y7gLCVTVeMuqvd2xx1Az156WO/fIr2c1mdDN0ZuXd7uT",
+	"3yv49sfw8dkXvt2um09DtWJPXHy8NAyLg08rw/bIvWczMqxKy6FjskBBOozxlFbxnsDJRXbMbCqyoyxQ",
+	"LAfvAhgSnvEBsxnvgmOQUDhFAEdihHESxyhi4AlHAXkqCcuRmEDbfV7Ug8Q27/YutDS75Nt3r7WI5W/7",
+	"Dixt9t6fH13PKUuc6ndzIl6WYAQyZ4687wiMAuk/0uVtE6WbsPT9V/MgoHBumKV5NzGhD/fdLiiK2U9s",
+	"a5AIsGCmRjuwklFmfsh2bKdqgmUd3haJFOIKadIKE+M0p6KSqhVNm7JX0BCOH6qrQY54E104uRylJz5/",
+	"kV/bo1YWgjRx0sQprYDqfeKFk92AcRvBhM1IjP+LAjnx691M/AmxGQlARPhLVkieSgklDF4QPhaSBcxz",
+	"XXxcixGPKIMxc7LjiH+VB/RVL2EzwI/5EkPeUh0NJAC64ggVPV8iZ/52fFpjURMoQ0EZKzMEAxWMHBJJ",
+	"MDWO9GLD0TiJMVsK/IwJecCID9p588+v37+a9CBQmp9REwLfgZXpoK447+hyVCTAgkCOaCuHlRy+HA1M",
+	"VDWQxEUst7J472RxmRFSSXw5WqMmcGFgG4O1L6gCAXn+qiwFvDmazU/qffso7mrL0HvE0E7O8+ToyhOV",
+	"ocVBnEQHu4iEGjG0GCbRSwuI2r5fkQ0xDa2PDC1EadzczrSvDvsQq5PuzaajFzXz0qM/9J/fK1kXZrDc",
+	"LyVDFU5vSYgvxEfW7sSvV+gCS6Pqpb5WyC1aUT60EmFXEiFHi0+QgshDRJiHOv+Jb3TFo2RKys3lRG2d",
+	"vh5jaL5QFShFW0N8uATHSyvQ10qQKncsTIWnjhIhkgjC/bsgPLPDZB2j7IqhY8Q7VtTz4h28eVg0b1l4",
+	"HyuMxUmktqrmlRRHi0S8isrAKdtyv++FptLWF6uQL2LDn0OgZGuqtAXIZioQr064cCuAHLYVLc+nHTSr",
+	"nOuwNKjh2gvFPl8o9C5tRWowSB8OKIOsxmAI6QMQzaSlsMZKeAPpw0gM+iJrh/HF8tn5UzRkYJ5QBuBi",
+	"gWCsvTQ16x6CT5hSXsGLY4iK8JX/opgcTHDIC3JRAj72z3t/StNeHcAFBn8bXV1eQzYDMHzi9Vn5DoaP",
+	"iB5qDBQC+PnYlxyePcxRlO50AxFkJaZWCO2BndPF57soK6Lcgg64f2dVkvUse4vTZ6t118qSsElUfBFI",
+	"5QgZqplc51OaKE52BHo72vfEfXMQMMh/dUdrNYiLhX56R4Ac/0hsVPoBHG9z5qBRdLTe2pZz988TwGS8",
+	"lQ5LQRXVL4X8hBTNaHWKnexsaPOa7WNes3c656naTqGgJdQxpfyIVszXiuKRHHy31wiDBFdLcdqaGy3Z",
+	"RfOFXiSOV3VU0IiWJkZp7616qeDfzbxFuj+3aNBDqwzin43HCzHDz6t4SAQYeKE1TwUmhgEOxGNWrLG4",
+	"uycDG9zuG4f7FSFHMK114PQvO6o8mo/Hc1QflZtc2KNy/uJqI2UTgfOH+c86D6kcJ9SqPopMX7LDVIH1",
+	"7aCZGHypxoxsu1ZNhd46ULkTkeffJuuTkHfzNLU6Px+JZ+7aZ0rRSjG0CfRhDV8PxOgtcz8/c2eR+tdp",
+	"QhgN4zovmnkcie1u3xN29J7wxcR95FPwINukpirD5iQOncEF2pIeMRJjt/LmxSgTcsNajeIH0ijSqCjl",
+	"jVYZcyzbSBYPw9Tzglp0jSrWFyG50kmqL2dtZcAWALyAlLuL6LSCIdQ76DLCQsoGgdMK+9upzQq7A+9t",
+	"QSMr2Dxb/8o99dpaQZb4u3T5yULq9SQkWvppND/ls1CAJjAJWefNcTcnKnbxQJTO/XqVyUey/s39Ujiw",
+	"OSZVn5qUs9q82tU+9mxe39pkDbl0zNowszMdMXPPQ41Kjz1VGtPLCTPblntJhgsqkeEbECJ3xfJUsunH",
+	"noVhqfkjVfqGSTQIaO5teS0ElwuENjQIqdi29vWopjaAJJtdvNzQo3FMonqNhLcC/yb3GVAsxtNprd/K",
+	"WUyin1pNeTFV+dKNxSKH6xSxVCU+rKk77Lq4beGuy2duCt5lnSplnVJQfJPpeIfmU73MksoVlQ7vl2Ci",
+	"qilurOCiKUWof9HF++X26i4aSsGOKy/mkLGGht4euxYtvXTObUldjwk3h/L/HOhfv3uVgS4fxN4PH5xw",
+	"XnjZnXT1LrByGN3bqjvWTWzTThcL4djR1OytIk8QznLT8jFxTeZ6ye5Je8xZWzo622PzJRj2Gx3WG5EP",
+	"lWWztJBIZ/QWDi+8cNZ+yYdt1c0yBcSNNHB42fomWe0SD9tenapgVqhoVYW6ChWCLbckCqy2dEUYJVGA",
+	"53MUYMhQuPQXC2qwVi7sdfpYJQp4KijKH/7qVAdlHP353JD2MoVCt/N6VxgfRAzFEQwBRfEjigFSSDFF",
+	"lpYf9tuGIUXWlF9rmCKOZpgyEi+rXbI4bc8JZSBGYxQxMMExUoX0nO8FXYCjcZgEPB+LbC+LCj+hGAnT",
+	"+gIFlQLzg4TsRT8m7I3Q/OGfOnZ1m3yHY+Rli+XWflO1EzzQXiefO7GOkGcpW8pN2YX0FcYEX3cw00et",
+	"3sm9fW7d5+dW4YLY4K1VtN/hQ+s+vgIvoCy7a3d8LoAlG38xXWF2BJ8ldaUVNuVivF24etbofqAzUBQf",
+	"l72zVfhGbYi+6mT0Ae4BR4EXVKJhY5A+4iioh+bFP8UzPEcATjigpdA77h2tUhCZS+icHp+eHBzz/90c",
+	"H78R//u/TlcH0b3HJ7ATLzfKHHAoOp68IyC+RxMSo22C/FbMsEmYK7A8wRGms9Vh1v13iudNAb1RTG/P",
+	"taTsx/HTOpYUdcf2fWwrwXbb8SjhAx/51BWDQIHGD7o8+5uFxjzDaF9QfbFWDW/V8D1Qw1vdstUtnyWA",
+	"nq5W8jBvfGorHtaf75YChJs75zmoQRKioPqQ51GtuuUq9sOR7txaEffZiri9e1FKAC/K775Vplpl6sUo",
+	"U9kyMlG9EdusVybhlMFTK+2O8wmXJUxrddisVuLQALarlxzdJ+HDQRbHYvehe5uEDyokYkOKCh/x5US3",
+	"bMmLtcxTGVp8g9bv67dmt/UNK9fkTltsklictmslhJYQb732eeuSQjo710gK2Qj8EiPd+9cNio2X45q/",
+	"U7Ghk7w3EBtqn/ZXbOg11YgNtY5WbDjERu0+b1Ns/JH+eVDKOF4bP2sHuaHQeOFRtBYcuAC0o3pvA2vt",
+	"u9uGyxQjax14aubx6KCNmhjbjTDgS460fVnct80Dub3rv/QI3G3LkepY3Nx1YEOS5YWH6e69cNlW5G5J",
+	"uohgPb+rS0ZGJTnzzFeWWglphgr/lMrPC4gtua26LG1QVtYEKzvEY+Oo5ZRKX3ro8s+qiK0ZzdyKmTaw",
+	"uTqwebuSzs9c9EcWy5xmOK2qsw0giNCTO27ZP82pwsLLqcpdn3GzurZEJWg7UgIltldN38KII9cKS4+4",
+	"3WmBzZJUmcXE3fC3wvk5hPOeFQRVgq6KyreTYtqQxTn3Rbs81vqlksj+d3nbFbCVwruUwnoHVriDV2iW",
+	"e34FNyVwqxu34tclfrV2XKMTb1zkPoma8gdjkkSsJjJMtNE1u2Q/CuAjxCG8D5GQvoa4sZsH3iPhoIpi",
+	"eiZmfPGit6602gsvrZjbrBUfZCSpSPJpfSUcoSE5JK1WcDHP/glFMT0aJ3GMqjmbytuBbAh4txL33lIU",
+	"v0fsTA22RbrjMzWkMwHxPpHVyW7AuI1gwmYkxv9F8kA7fr2biT8hNiOBqKEHw5A86bMMjZMYs6UQ42NC",
+	"HjDqJVx2/fPr969Fui+QmyZ3sf0WMp5iNkvuj8YwDO/h+MFJzmeEO/IzJGn6is8PrOcRn0ha3t+Loa84",
+	"Ls/08AUC/+34tMbLZKzmDcrzzhAMxOH2RyckcjPy+1AU698LyMzhTi8wP4cn+iiDsVsUjPjX1RAnujbH",
+	"moBn+zgT0DVEGCHTEG2H3sTQPzi9SfRtmN4yxP1w9IajR8xQdY1jKuI2tTYsOwil2+v45iPciL4DNdc2",
+	"35CMiZrmPcwvsNUXvY9VmXQ1j72M8m4sN8Qc7R3B8RgtmNvy1hPfKYD5SUrUZm6+7NPZjj1JDi4nMgxJ",
+	"DgNQBfXJldvor/UNTclLYru09/70FSNRBdJJX0PxvRl9yT5boi85+AboS668pa9K+pLYXoG+QjLFkZus",
+	"LsiUAhwBKM7GwwoF40IMtCU3NH4E8/HrCWl39+iQTKcoADhqr8/PfH3m5ujTXa17ERNOA8Jo248YZktw",
+	"wMPjcSAm45uimvA07EiP5FZ4BWHbr/LcaoUiPtVBzJPcCBs416HlW42NmUnCariZJMyPnflQe8JkHJSW",
+	"y16OkUpSj699ao54bhc6w4sGdzijk989Tp6Bn7JuKv3OVgncPmnzC52JovZSt8qlzsRgPUkSHIy3YsC6",
+	"wsH4xzZfCdRt1niVIu2HM10tIKVPJK5w2Ulr4/EOQLevOrqv9ZjbU8bPZjCaphPtk1Y+FpAFKaJataFV",
+	"zpsp59VHiqT8PDOurbfHaMpP/LjKvCNb0ErVPfXI2xbfazD2ieM18toH7ZbpN3Mj11S+mUs5DeH4YSu6",
+	"5IiPvMfKZI0kbahdPqKYKhCcbnZ8DaqddrWTMTUlLA6iCXmP2Gc16JpCbBHz0RmWvQ1Is5zHJ4fHh8e2",
+	"rMqGh9s/065f04bkXhjpHT6+rsUWvHoriP0LAjFiSRzlkFe4UXMxm0QR5590im8HesgDspBJHMss8ITu",
+	"Z4Q8HCiHx6M/1A8eCWX4Uadalx0i5e/+uWLUQG6Hw3SiHfsbeiZf0fC1B9vzG8GKCV9MMnV6GaoWX72Y",
+	"40jh2cccppuq+I0ajlGKG/VNPb23fLMZP10JvXTTVajhmKnKYcaxklbWUthJt6tlzz1iT2H9K21RUx5N",
+	"eVP88b3Gy1+2sjrwCydgL54TjSt941H8UjlOAt/cF/6nD7S0Or+XAgv1BcXt645imdGiMvtPDSH7J/LZ",
+	"C1reVl6c3LnhOisUBhKNst3F23nympnmpuW0yJ5gZh1mK5wmxSAyr9SaurVfGpkG96K9jMRqkpYyBbAN",
+	"BH3mXEyKWA2KWTEOq1unYflzQgOV62cISFwxCLHlrefmLTPacR3G8lH7/LmrmR64Fwy2eV0wjwzfnAwq",
+	"y3eOy3atHHpJhKJ62MoDp4K4HnPWqIleBWj5JuUrzaaM95i+dDhPygYFZ/eBny1Fn2TJpg1U5F+9Hr8d",
+	"sGlMkoWopJWBoDfKCYro9BEtO7XpZrYsJNasbqkfldoCl3uoTaxUUbOR4NIpsJzOLVke1WZJqVbKRbWX",
+	"kuvGwi6HYDAR1m2acOpAQVdwVQgZoizlKUzBBDGeGslVbzET/HuuSCkyWDHB1bOltTLgbZTPqs1i1Wax",
+	"2kIWq0aiWckG6vGqlTvJvcSy8q15QSaYH0Eub1nKqU1dUxVs5d1eqYAZKa6qAhYd/+4RjFGcOv51ra6A",
+	"wpNMyoMkDjtvOp3vX7//fwMAnJHygjCJBAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -18,5 +18,13 @@ func ToAPIToken(token *sqlcv1.APIToken) *gen.APIToken {
 		res.Name = token.Name.String
 	}
 
+	if len(token.Scopes) > 0 {
+		res.Scopes = &token.Scopes
+	}
+
+	if len(token.Workflows) > 0 {
+		res.Workflows = &token.Workflows
+	}
+
 	return res
 }
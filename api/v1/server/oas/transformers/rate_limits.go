@@ -13,6 +13,12 @@ func ToRateLimitFromSQLC(rl *sqlcv1.ListRateLimitsForTenantNoMutateRow) (*gen.Ra
 		LimitValue: int(rl.LimitValue),
 		Value:      int(rl.Value),
 		Window:     rl.Window,
+		Mode:       gen.RateLimitMode(rl.Mode),
+	}
+
+	if rl.Burst.Valid {
+		burst := int(rl.Burst.Int32)
+		res.Burst = &burst
 	}
 
 	return res, nil
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
 
@@ -53,6 +54,47 @@ func ToTenantAlertEmailGroup(group *sqlcv1.TenantAlertEmailGroup) *gen.TenantAle
 	}
 }
 
+func ToTenantAlertSink(sink *sqlcv1.TenantAlertSink) *gen.TenantAlertSink {
+	return &gen.TenantAlertSink{
+		Metadata: *toAPIMetadata(sink.ID, sink.CreatedAt.Time, sink.UpdatedAt.Time),
+		Name:     sink.Name,
+		Kind:     gen.TenantAlertSinkKind(sink.Kind),
+	}
+}
+
+func ToTenantAlertRule(rule *sqlcv1.TenantAlertRule) *gen.TenantAlertRule {
+	res := &gen.TenantAlertRule{
+		Metadata:   *toAPIMetadata(rule.ID, rule.CreatedAt.Time, rule.UpdatedAt.Time),
+		Name:       rule.Name,
+		Kind:       gen.TenantAlertRuleKind(rule.Kind),
+		WorkflowId: rule.WorkflowId,
+		Threshold:  rule.Threshold,
+		Window:     rule.Window,
+		IsFiring:   rule.IsFiring,
+	}
+
+	if rule.LastFiredAt.Valid {
+		res.LastFiredAt = &rule.LastFiredAt.Time
+	}
+
+	return res
+}
+
+func ToTenantRole(role *sqlcv1.TenantRole) *gen.TenantRole {
+	res := &gen.TenantRole{
+		Metadata:    *toAPIMetadata(role.ID, role.CreatedAt.Time, role.UpdatedAt.Time),
+		Name:        role.Name,
+		Inherits:    role.Inherits,
+		Permissions: role.Permissions,
+	}
+
+	if role.Description.Valid {
+		res.Description = &role.Description.String
+	}
+
+	return res
+}
+
 func ToTenantResourcePolicy(_limits []*sqlcv1.TenantResourceLimit) *gen.TenantResourcePolicy {
 
 	limits := make([]gen.TenantResourceLimit, 0, len(_limits))
@@ -88,6 +130,34 @@ func ToTenantResourcePolicy(_limits []*sqlcv1.TenantResourceLimit) *gen.TenantRe
 	}
 }
 
+func ToScopedResourceLimit(limit *sqlcv1.V1ScopedResourceLimit) *gen.ScopedResourceLimit {
+	res := &gen.ScopedResourceLimit{
+		Metadata:   *toAPIMetadata(limit.ID, limit.CreatedAt.Time, limit.UpdatedAt.Time),
+		Resource:   gen.TenantResource(limit.Resource),
+		Scope:      gen.ScopedResourceLimitScope(limit.Scope),
+		WorkflowId: limit.WorkflowID,
+		LimitValue: int(limit.LimitValue),
+		Value:      int(limit.Value),
+		Window:     sqlchelpers.PgIntervalToDuration(limit.LimitWindow).String(),
+		LastRefill: limit.LastRefill.Time,
+	}
+
+	if limit.MetadataKey.Valid {
+		res.MetadataKey = &limit.MetadataKey.String
+	}
+
+	if limit.MetadataValue.Valid {
+		res.MetadataValue = &limit.MetadataValue.String
+	}
+
+	if limit.SoftLimitValue.Valid {
+		softLimit := int(limit.SoftLimitValue.Int32)
+		res.SoftLimitValue = &softLimit
+	}
+
+	return res
+}
+
 func ToTaskStats(stats map[string]v1.TaskStat, requiredNames []string) gen.TaskStats {
 	result := make(gen.TaskStats)
 
@@ -50,5 +50,10 @@ func ToTenantMember(tenantMember *sqlcv1.PopulateTenantMembersRow) *gen.TenantMe
 		Role: gen.TenantMemberRole(tenantMember.Role),
 	}
 
+	if tenantMember.CustomRoleId != nil {
+		res.CustomRoleId = tenantMember.CustomRoleId
+		res.CustomRoleName = &tenantMember.CustomRoleName.String
+	}
+
 	return res
 }
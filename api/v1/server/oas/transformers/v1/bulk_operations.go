@@ -0,0 +1,143 @@
+package transformers
+
+import (
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1BulkOperation(op *sqlcv1.V1BulkOperation) gen.V1BulkOperation {
+	res := gen.V1BulkOperation{
+		Metadata: gen.APIResourceMeta{
+			CreatedAt: op.CreatedAt.Time,
+			UpdatedAt: op.UpdatedAt.Time,
+			Id:        op.ID.String(),
+		},
+		TenantId:       op.TenantID.String(),
+		Kind:           gen.V1BulkOperationKind(op.Kind),
+		Status:         gen.V1BulkOperationStatus(op.Status),
+		ProcessedCount: int(op.ProcessedCount),
+	}
+
+	if op.Kind == sqlcv1.V1BulkOperationKindREPLAYEVENTS {
+		if filter, err := v1.ParseEventReplayFilter(op); err == nil {
+			eventFilter := ToV1EventReplayFilter(filter)
+
+			res.Filter = gen.V1TaskFilter{
+				Since:              filter.Since,
+				Until:              &filter.Until,
+				AdditionalMetadata: eventFilter.AdditionalMetadata,
+			}
+			res.EventFilter = &eventFilter
+		}
+	} else if filter, err := v1.ParseBulkOperationFilter(op); err == nil {
+		res.Filter = ToV1TaskFilter(filter)
+	}
+
+	if op.TotalCount.Valid {
+		totalCount := int(op.TotalCount.Int32)
+		res.TotalCount = &totalCount
+	}
+
+	if op.FinishedAt.Valid {
+		res.FinishedAt = &op.FinishedAt.Time
+	}
+
+	return res
+}
+
+// ToV1TaskFilter converts the stored filter of a bulk operation back into the filter of the request
+func ToV1TaskFilter(filter *v1.BulkOperationFilter) gen.V1TaskFilter {
+	res := gen.V1TaskFilter{
+		Since: filter.Since,
+		Until: filter.Until,
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]gen.V1TaskStatus, len(filter.Statuses))
+
+		for i, status := range filter.Statuses {
+			statuses[i] = gen.V1TaskStatus(status)
+		}
+
+		res.Statuses = &statuses
+	}
+
+	if len(filter.WorkflowIds) > 0 {
+		workflowIds := make([]uuid.UUID, len(filter.WorkflowIds))
+		copy(workflowIds, filter.WorkflowIds)
+
+		res.WorkflowIds = &workflowIds
+	}
+
+	if len(filter.AdditionalMetadata) > 0 {
+		additionalMetadata := make([]string, 0, len(filter.AdditionalMetadata))
+
+		for k, v := range filter.AdditionalMetadata {
+			additionalMetadata = append(additionalMetadata, k+":"+v)
+		}
+
+		sort.Strings(additionalMetadata)
+
+		res.AdditionalMetadata = &additionalMetadata
+	}
+
+	return res
+}
+
+// ToV1EventReplayFilter converts the stored filter of an event replay operation back into the filter of the request
+func ToV1EventReplayFilter(filter *v1.EventReplayFilter) gen.V1EventReplayFilter {
+	res := gen.V1EventReplayFilter{
+		Since:           filter.Since,
+		Until:           filter.Until,
+		KeyPattern:      filter.KeyPattern,
+		EventsPerSecond: filter.EventsPerSecond,
+	}
+
+	if len(filter.Scopes) > 0 {
+		scopes := make([]string, len(filter.Scopes))
+		copy(scopes, filter.Scopes)
+
+		res.Scopes = &scopes
+	}
+
+	if len(filter.AdditionalMetadata) > 0 {
+		additionalMetadata := make([]string, 0, len(filter.AdditionalMetadata))
+
+		for k, v := range filter.AdditionalMetadata {
+			additionalMetadata = append(additionalMetadata, k+":"+v)
+		}
+
+		sort.Strings(additionalMetadata)
+
+		res.AdditionalMetadata = &additionalMetadata
+	}
+
+	return res
+}
+
+func ToV1BulkOperationList(ops []*sqlcv1.V1BulkOperation, total, limit, offset int64) gen.V1BulkOperationList {
+	rows := make([]gen.V1BulkOperation, len(ops))
+
+	for i, op := range ops {
+		rows[i] = ToV1BulkOperation(op)
+	}
+
+	currentPage := offset / limit
+	nextPage := currentPage + 1
+	totalPages := int64(math.Ceil(float64(total) / float64(limit)))
+
+	return gen.V1BulkOperationList{
+		Rows: &rows,
+		Pagination: &gen.PaginationResponse{
+			CurrentPage: &currentPage,
+			NextPage:    &nextPage,
+			NumPages:    &totalPages,
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1DeadLetterPolicy(policy *sqlcv1.V1DeadLetterPolicy) gen.V1DeadLetterPolicy {
+	return gen.V1DeadLetterPolicy{
+		Metadata: gen.APIResourceMeta{
+			CreatedAt: policy.CreatedAt.Time,
+			UpdatedAt: policy.UpdatedAt.Time,
+			Id:        policy.ID.String(),
+		},
+		TenantId:               policy.TenantID.String(),
+		SourceWorkflowId:       policy.SourceWorkflowID,
+		DeadLetterWorkflowName: policy.DeadLetterWorkflowName,
+	}
+}
+
+func ToV1DeadLetterPolicyList(policies []*sqlcv1.V1DeadLetterPolicy) gen.V1DeadLetterPolicyList {
+	rows := make([]gen.V1DeadLetterPolicy, len(policies))
+
+	for i, policy := range policies {
+		rows[i] = ToV1DeadLetterPolicy(policy)
+	}
+
+	return gen.V1DeadLetterPolicyList{
+		Rows: &rows,
+	}
+}
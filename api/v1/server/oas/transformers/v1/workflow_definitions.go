@@ -0,0 +1,406 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func ToV1WorkflowDefinition(def *v1.WorkflowDefinition) gen.V1WorkflowDefinition {
+	res := gen.V1WorkflowDefinition{
+		Name:             def.Name,
+		DefaultPriority:  def.DefaultPriority,
+		CanaryPercentage: def.CanaryPercentage,
+		Tasks:            make([]gen.V1WorkflowDefinitionTask, len(def.Tasks)),
+	}
+
+	if def.Version != "" {
+		res.Version = &def.Version
+	}
+
+	if def.Description != "" {
+		res.Description = &def.Description
+	}
+
+	if def.Sticky != nil {
+		sticky := gen.V1WorkflowDefinitionSticky(*def.Sticky)
+		res.Sticky = &sticky
+	}
+
+	if len(def.EventTriggers) > 0 {
+		res.EventTriggers = &def.EventTriggers
+	}
+
+	if len(def.CronTriggers) > 0 {
+		res.CronTriggers = &def.CronTriggers
+	}
+
+	if def.CronInput != nil {
+		res.CronInput = &def.CronInput
+	}
+
+	if len(def.DefaultFilters) > 0 {
+		filters := make([]gen.V1WorkflowDefinitionDefaultFilter, len(def.DefaultFilters))
+
+		for i, f := range def.DefaultFilters {
+			filters[i] = gen.V1WorkflowDefinitionDefaultFilter{
+				Expression: f.Expression,
+				Scope:      f.Scope,
+			}
+
+			if f.Payload != nil {
+				payload := f.Payload
+				filters[i].Payload = &payload
+			}
+		}
+
+		res.DefaultFilters = &filters
+	}
+
+	if def.InputJsonSchema != nil {
+		res.InputJsonSchema = &def.InputJsonSchema
+	}
+
+	if len(def.Concurrency) > 0 {
+		concurrency := toV1WorkflowDefinitionConcurrency(def.Concurrency)
+		res.Concurrency = &concurrency
+	}
+
+	for i := range def.Tasks {
+		res.Tasks[i] = toV1WorkflowDefinitionTask(&def.Tasks[i])
+	}
+
+	if def.OnFailureTask != nil {
+		onFailure := toV1WorkflowDefinitionTask(def.OnFailureTask)
+		res.OnFailureTask = &onFailure
+	}
+
+	return res
+}
+
+func toV1WorkflowDefinitionTask(task *v1.WorkflowDefinitionTask) gen.V1WorkflowDefinitionTask {
+	res := gen.V1WorkflowDefinitionTask{
+		Name:                    task.Name,
+		Action:                  task.Action,
+		Timeout:                 task.Timeout,
+		ScheduleTimeout:         task.ScheduleTimeout,
+		Retries:                 task.Retries,
+		RetryBackoffFactor:      task.RetryBackoffFactor,
+		RetryBackoffMaxSeconds:  task.RetryBackoffMaxSeconds,
+		RetryBackoffStrategy:    task.RetryBackoffStrategy,
+		RetryBackoffBaseSeconds: task.RetryBackoffBaseSeconds,
+	}
+
+	if len(task.Parents) > 0 {
+		res.Parents = &task.Parents
+	}
+
+	if task.IsDurable {
+		res.IsDurable = &task.IsDurable
+	}
+
+	if len(task.RateLimits) > 0 {
+		rateLimits := make([]gen.V1WorkflowDefinitionRateLimit, len(task.RateLimits))
+
+		for i, rl := range task.RateLimits {
+			rateLimits[i] = gen.V1WorkflowDefinitionRateLimit{
+				KeyExpr:   rl.KeyExpr,
+				Units:     rl.Units,
+				UnitsExpr: rl.UnitsExpr,
+				LimitExpr: rl.LimitExpr,
+				Duration:  rl.Duration,
+			}
+
+			if rl.Key != "" {
+				key := rl.Key
+				rateLimits[i].Key = &key
+			}
+		}
+
+		res.RateLimits = &rateLimits
+	}
+
+	if len(task.Concurrency) > 0 {
+		concurrency := toV1WorkflowDefinitionConcurrency(task.Concurrency)
+		res.Concurrency = &concurrency
+	}
+
+	if len(task.RetryRules) > 0 {
+		retryRules := make([]gen.V1WorkflowDefinitionRetryRule, len(task.RetryRules))
+
+		for i, rule := range task.RetryRules {
+			retryRules[i] = gen.V1WorkflowDefinitionRetryRule{
+				ErrorType:          rule.ErrorType,
+				MaxRetries:         rule.MaxRetries,
+				BackoffStrategy:    rule.BackoffStrategy,
+				BackoffBaseSeconds: rule.BackoffBaseSeconds,
+			}
+		}
+
+		res.RetryRules = &retryRules
+	}
+
+	if len(task.DesiredWorkerLabels) > 0 {
+		labels := make(map[string]gen.V1WorkflowDefinitionWorkerLabel, len(task.DesiredWorkerLabels))
+
+		for key, label := range task.DesiredWorkerLabels {
+			labels[key] = gen.V1WorkflowDefinitionWorkerLabel{
+				IntValue:   label.IntValue,
+				StrValue:   label.StrValue,
+				Required:   label.Required,
+				Weight:     label.Weight,
+				Comparator: label.Comparator,
+			}
+		}
+
+		res.DesiredWorkerLabels = &labels
+	}
+
+	if len(task.SlotRequests) > 0 {
+		res.SlotRequests = &task.SlotRequests
+	}
+
+	if len(task.TriggerConditions) > 0 {
+		conditions := make([]gen.V1WorkflowDefinitionTriggerCondition, len(task.TriggerConditions))
+
+		for i, c := range task.TriggerConditions {
+			conditions[i] = gen.V1WorkflowDefinitionTriggerCondition{
+				Kind:            c.Kind,
+				Action:          c.Action,
+				ReadableDataKey: c.ReadableDataKey,
+				OrGroup:         c.OrGroup,
+				SleepDuration:   c.SleepDuration,
+				EventKey:        c.EventKey,
+				ParentName:      c.ParentName,
+			}
+
+			if c.Expression != "" {
+				expression := c.Expression
+				conditions[i].Expression = &expression
+			}
+		}
+
+		res.TriggerConditions = &conditions
+	}
+
+	if task.OutputJsonSchema != nil {
+		res.OutputJsonSchema = &task.OutputJsonSchema
+	}
+
+	return res
+}
+
+func toV1WorkflowDefinitionConcurrency(concurrency []v1.WorkflowDefinitionConcurrency) []gen.V1WorkflowDefinitionConcurrency {
+	res := make([]gen.V1WorkflowDefinitionConcurrency, len(concurrency))
+
+	for i, c := range concurrency {
+		res[i] = gen.V1WorkflowDefinitionConcurrency{
+			Expression:       c.Expression,
+			MaxRuns:          c.MaxRuns,
+			LimitStrategy:    c.LimitStrategy,
+			WeightExpression: c.WeightExpression,
+		}
+	}
+
+	return res
+}
+
+func ToWorkflowDefinition(def *gen.V1WorkflowDefinition) *v1.WorkflowDefinition {
+	res := &v1.WorkflowDefinition{
+		Name:             def.Name,
+		DefaultPriority:  def.DefaultPriority,
+		CanaryPercentage: def.CanaryPercentage,
+		Tasks:            make([]v1.WorkflowDefinitionTask, len(def.Tasks)),
+	}
+
+	if def.Version != nil {
+		res.Version = *def.Version
+	}
+
+	if def.Description != nil {
+		res.Description = *def.Description
+	}
+
+	if def.Sticky != nil {
+		sticky := string(*def.Sticky)
+		res.Sticky = &sticky
+	}
+
+	if def.EventTriggers != nil {
+		res.EventTriggers = *def.EventTriggers
+	}
+
+	if def.CronTriggers != nil {
+		res.CronTriggers = *def.CronTriggers
+	}
+
+	if def.CronInput != nil {
+		res.CronInput = *def.CronInput
+	}
+
+	if def.DefaultFilters != nil {
+		for _, f := range *def.DefaultFilters {
+			filter := v1.WorkflowDefinitionDefaultFilter{
+				Expression: f.Expression,
+				Scope:      f.Scope,
+			}
+
+			if f.Payload != nil {
+				filter.Payload = *f.Payload
+			}
+
+			res.DefaultFilters = append(res.DefaultFilters, filter)
+		}
+	}
+
+	if def.InputJsonSchema != nil {
+		res.InputJsonSchema = *def.InputJsonSchema
+	}
+
+	if def.Concurrency != nil {
+		res.Concurrency = toWorkflowDefinitionConcurrency(*def.Concurrency)
+	}
+
+	for i := range def.Tasks {
+		res.Tasks[i] = toWorkflowDefinitionTask(&def.Tasks[i])
+	}
+
+	if def.OnFailureTask != nil {
+		onFailure := toWorkflowDefinitionTask(def.OnFailureTask)
+		res.OnFailureTask = &onFailure
+	}
+
+	return res
+}
+
+func toWorkflowDefinitionTask(task *gen.V1WorkflowDefinitionTask) v1.WorkflowDefinitionTask {
+	res := v1.WorkflowDefinitionTask{
+		Name:                    task.Name,
+		Action:                  task.Action,
+		Timeout:                 task.Timeout,
+		ScheduleTimeout:         task.ScheduleTimeout,
+		Retries:                 task.Retries,
+		RetryBackoffFactor:      task.RetryBackoffFactor,
+		RetryBackoffMaxSeconds:  task.RetryBackoffMaxSeconds,
+		RetryBackoffStrategy:    task.RetryBackoffStrategy,
+		RetryBackoffBaseSeconds: task.RetryBackoffBaseSeconds,
+	}
+
+	if task.Parents != nil {
+		res.Parents = *task.Parents
+	}
+
+	if task.IsDurable != nil {
+		res.IsDurable = *task.IsDurable
+	}
+
+	if task.RateLimits != nil {
+		for _, rl := range *task.RateLimits {
+			rateLimit := v1.WorkflowDefinitionRateLimit{
+				KeyExpr:   rl.KeyExpr,
+				Units:     rl.Units,
+				UnitsExpr: rl.UnitsExpr,
+				LimitExpr: rl.LimitExpr,
+				Duration:  rl.Duration,
+			}
+
+			if rl.Key != nil {
+				rateLimit.Key = *rl.Key
+			}
+
+			res.RateLimits = append(res.RateLimits, rateLimit)
+		}
+	}
+
+	if task.Concurrency != nil {
+		res.Concurrency = toWorkflowDefinitionConcurrency(*task.Concurrency)
+	}
+
+	if task.RetryRules != nil {
+		for _, rule := range *task.RetryRules {
+			res.RetryRules = append(res.RetryRules, v1.WorkflowDefinitionRetryRule{
+				ErrorType:          rule.ErrorType,
+				MaxRetries:         rule.MaxRetries,
+				BackoffStrategy:    rule.BackoffStrategy,
+				BackoffBaseSeconds: rule.BackoffBaseSeconds,
+			})
+		}
+	}
+
+	if task.DesiredWorkerLabels != nil {
+		res.DesiredWorkerLabels = make(map[string]v1.WorkflowDefinitionWorkerLabel, len(*task.DesiredWorkerLabels))
+
+		for key, label := range *task.DesiredWorkerLabels {
+			res.DesiredWorkerLabels[key] = v1.WorkflowDefinitionWorkerLabel{
+				IntValue:   label.IntValue,
+				StrValue:   label.StrValue,
+				Required:   label.Required,
+				Weight:     label.Weight,
+				Comparator: label.Comparator,
+			}
+		}
+	}
+
+	if task.SlotRequests != nil {
+		res.SlotRequests = *task.SlotRequests
+	}
+
+	if task.TriggerConditions != nil {
+		for _, c := range *task.TriggerConditions {
+			condition := v1.WorkflowDefinitionTriggerCondition{
+				Kind:            c.Kind,
+				Action:          c.Action,
+				ReadableDataKey: c.ReadableDataKey,
+				OrGroup:         c.OrGroup,
+				SleepDuration:   c.SleepDuration,
+				EventKey:        c.EventKey,
+				ParentName:      c.ParentName,
+			}
+
+			if c.Expression != nil {
+				condition.Expression = *c.Expression
+			}
+
+			res.TriggerConditions = append(res.TriggerConditions, condition)
+		}
+	}
+
+	if task.OutputJsonSchema != nil {
+		res.OutputJsonSchema = *task.OutputJsonSchema
+	}
+
+	return res
+}
+
+func toWorkflowDefinitionConcurrency(concurrency []gen.V1WorkflowDefinitionConcurrency) []v1.WorkflowDefinitionConcurrency {
+	res := make([]v1.WorkflowDefinitionConcurrency, len(concurrency))
+
+	for i, c := range concurrency {
+		res[i] = v1.WorkflowDefinitionConcurrency{
+			Expression:       c.Expression,
+			MaxRuns:          c.MaxRuns,
+			LimitStrategy:    c.LimitStrategy,
+			WeightExpression: c.WeightExpression,
+		}
+	}
+
+	return res
+}
+
+func ToV1WorkflowDefinitionDiff(from, to *v1.WorkflowDefinition, changes []v1.WorkflowDefinitionChange) gen.V1WorkflowDefinitionDiff {
+	res := gen.V1WorkflowDefinitionDiff{
+		From:    ToV1WorkflowDefinition(from),
+		To:      ToV1WorkflowDefinition(to),
+		Changes: make([]gen.V1WorkflowDefinitionChange, len(changes)),
+	}
+
+	for i, c := range changes {
+		res.Changes[i] = gen.V1WorkflowDefinitionChange{
+			Path: c.Path,
+			Kind: gen.V1WorkflowDefinitionChangeKind(c.Kind),
+			From: c.From,
+			To:   c.To,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,47 @@
+package transformers
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1WorkflowRetentionPolicy(policy *sqlcv1.V1WorkflowRetentionPolicy) gen.V1WorkflowRetentionPolicy {
+	return gen.V1WorkflowRetentionPolicy{
+		Metadata: gen.APIResourceMeta{
+			CreatedAt: policy.CreatedAt.Time,
+			UpdatedAt: policy.UpdatedAt.Time,
+			Id:        policy.ID.String(),
+		},
+		TenantId:                 policy.TenantID.String(),
+		WorkflowId:               policy.WorkflowID,
+		RetentionPeriod:          sqlchelpers.PgIntervalToDuration(policy.RetentionPeriod).String(),
+		CompletedRetentionPeriod: optionalRetentionPeriod(policy.CompletedRetentionPeriod),
+		FailedRetentionPeriod:    optionalRetentionPeriod(policy.FailedRetentionPeriod),
+		CancelledRetentionPeriod: optionalRetentionPeriod(policy.CancelledRetentionPeriod),
+	}
+}
+
+func ToV1WorkflowRetentionPolicyList(policies []*sqlcv1.V1WorkflowRetentionPolicy) gen.V1WorkflowRetentionPolicyList {
+	rows := make([]gen.V1WorkflowRetentionPolicy, len(policies))
+
+	for i, policy := range policies {
+		rows[i] = ToV1WorkflowRetentionPolicy(policy)
+	}
+
+	return gen.V1WorkflowRetentionPolicyList{
+		Rows: &rows,
+	}
+}
+
+func optionalRetentionPeriod(i pgtype.Interval) *string {
+	if !i.Valid {
+		return nil
+	}
+
+	s := sqlchelpers.PgIntervalToDuration(i).String()
+
+	return &s
+}
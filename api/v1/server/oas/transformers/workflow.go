@@ -26,6 +26,9 @@ func ToWorkflow(
 
 	res.IsPaused = &workflow.IsPaused.Bool
 
+	inputValidationMode := gen.WorkflowInputValidationMode(workflow.InputValidationMode)
+	res.InputValidationMode = &inputValidationMode
+
 	res.Description = &workflow.Description.String
 
 	if version != nil {
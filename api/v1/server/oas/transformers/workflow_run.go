@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
@@ -84,6 +86,44 @@ func ToCronWorkflowsFromSQLC(cron *sqlcv1.ListCronWorkflowsRow) *gen.CronWorkflo
 		Method:             gen.CronWorkflowsMethod(cron.Method),
 		Priority:           &cron.Priority,
 		Input:              &input,
+		MisfirePolicy:      gen.CronWorkflowsMisfirePolicy(cron.MisfirePolicy),
+		OverlapPolicy:      gen.CronWorkflowsOverlapPolicy(cron.OverlapPolicy),
+	}
+
+	if cron.Timezone.Valid {
+		res.Timezone = &cron.Timezone.String
+	}
+
+	if cron.MisfireLimit.Valid {
+		res.MisfireLimit = &cron.MisfireLimit.Int32
+	}
+
+	if cron.LastFiredAt.Valid {
+		res.LastFiredAt = &cron.LastFiredAt.Time
+	}
+
+	return res
+}
+
+func ToCronWorkflowFire(fire *sqlcv1.V1CronFire, statuses map[uuid.UUID]sqlcv1.V1ReadableStatusOlap) gen.CronWorkflowFire {
+	res := gen.CronWorkflowFire{
+		ScheduledAt:   fire.ScheduledAt.Time,
+		FiredAt:       fire.FiredAt.Time,
+		Outcome:       gen.CronWorkflowFireOutcome(fire.Outcome),
+		WorkflowRunId: fire.WorkflowRunExternalID,
+	}
+
+	if fire.WorkflowRunExternalID != nil {
+		if status, ok := statuses[*fire.WorkflowRunExternalID]; ok {
+			runStatus := gen.V1TaskStatus(status)
+
+			// evicted runs are still running from the user's perspective
+			if status == sqlcv1.V1ReadableStatusOlapEVICTED {
+				runStatus = gen.V1TaskStatusRUNNING
+			}
+
+			res.WorkflowRunStatus = &runStatus
+		}
 	}
 
 	return res
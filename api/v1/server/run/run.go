@@ -28,7 +28,9 @@ import (
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	bulkoperationsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/bulk-operations"
 	celv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/cel"
+	deadlettersv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/dead-letters"
 	durabletasksv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/durable-tasks"
 	eventsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/events"
 	featureflagsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/feature-flags"
@@ -37,6 +39,8 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/observability"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/tasks"
 	webhooksv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/webhooks"
+	workflowdefinitionsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-definitions"
+	workflowretentionv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-retention"
 	workflowrunsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-runs"
 	webhookworker "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-worker"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workers"
@@ -75,6 +79,10 @@ type apiService struct {
 	*workflowrunsv1.V1WorkflowRunsService
 	*eventsv1.V1EventsService
 	*filtersv1.V1FiltersService
+	*deadlettersv1.V1DeadLettersService
+	*workflowretentionv1.V1WorkflowRetentionService
+	*bulkoperationsv1.V1BulkOperationsService
+	*workflowdefinitionsv1.V1WorkflowDefinitionsService
 	*webhooksv1.V1WebhooksService
 	*celv1.V1CELService
 	*observability.V1ObservabilityService
@@ -84,31 +92,35 @@ type apiService struct {
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:            users.NewUserService(config),
-		TenantService:          tenants.NewTenantService(config),
-		EventService:           events.NewEventService(config),
-		RateLimitService:       rate_limits.NewRateLimitService(config),
-		LogsService:            logs.NewLogsService(config),
-		WorkflowService:        workflows.NewWorkflowService(config),
-		WorkflowRunsService:    workflowruns.NewWorkflowRunsService(config),
-		WorkerService:          workers.NewWorkerService(config),
-		MetadataService:        metadata.NewMetadataService(config),
-		APITokenService:        apitokens.NewAPITokenService(config),
-		StepRunService:         stepruns.NewStepRunService(config),
-		IngestorsService:       ingestors.NewIngestorsService(config),
-		SlackAppService:        slackapp.NewSlackAppService(config),
-		WebhookWorkersService:  webhookworker.NewWebhookWorkersService(config),
-		MonitoringService:      monitoring.NewMonitoringService(config),
-		InfoService:            info.NewInfoService(config),
-		TasksService:           tasks.NewTasksService(config),
-		V1WorkflowRunsService:  workflowrunsv1.NewV1WorkflowRunsService(config),
-		V1EventsService:        eventsv1.NewV1EventsService(config),
-		V1FiltersService:       filtersv1.NewV1FiltersService(config),
-		V1WebhooksService:      webhooksv1.NewV1WebhooksService(config),
-		V1CELService:           celv1.NewV1CELService(config),
-		V1ObservabilityService: observability.NewV1ObservabilityService(config),
-		V1FeatureFlagsService:  featureflagsv1.NewV1FeatureFlagsService(config),
-		DurableTasksService:    durabletasksv1.NewDurableTasksService(config),
+		UserService:                  users.NewUserService(config),
+		TenantService:                tenants.NewTenantService(config),
+		EventService:                 events.NewEventService(config),
+		RateLimitService:             rate_limits.NewRateLimitService(config),
+		LogsService:                  logs.NewLogsService(config),
+		WorkflowService:              workflows.NewWorkflowService(config),
+		WorkflowRunsService:          workflowruns.NewWorkflowRunsService(config),
+		WorkerService:                workers.NewWorkerService(config),
+		MetadataService:              metadata.NewMetadataService(config),
+		APITokenService:              apitokens.NewAPITokenService(config),
+		StepRunService:               stepruns.NewStepRunService(config),
+		IngestorsService:             ingestors.NewIngestorsService(config),
+		SlackAppService:              slackapp.NewSlackAppService(config),
+		WebhookWorkersService:        webhookworker.NewWebhookWorkersService(config),
+		MonitoringService:            monitoring.NewMonitoringService(config),
+		InfoService:                  info.NewInfoService(config),
+		TasksService:                 tasks.NewTasksService(config),
+		V1WorkflowRunsService:        workflowrunsv1.NewV1WorkflowRunsService(config),
+		V1EventsService:              eventsv1.NewV1EventsService(config),
+		V1FiltersService:             filtersv1.NewV1FiltersService(config),
+		V1DeadLettersService:         deadlettersv1.NewV1DeadLettersService(config),
+		V1WorkflowRetentionService:   workflowretentionv1.NewV1WorkflowRetentionService(config),
+		V1BulkOperationsService:      bulkoperationsv1.NewV1BulkOperationsService(config),
+		V1WorkflowDefinitionsService: workflowdefinitionsv1.NewV1WorkflowDefinitionsService(config),
+		V1WebhooksService:            webhooksv1.NewV1WebhooksService(config),
+		V1CELService:                 celv1.NewV1CELService(config),
+		V1ObservabilityService:       observability.NewV1ObservabilityService(config),
+		V1FeatureFlagsService:        featureflagsv1.NewV1FeatureFlagsService(config),
+		DurableTasksService:          durabletasksv1.NewDurableTasksService(config),
 	}
 }
 
@@ -278,6 +290,25 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return member, member.TenantId.String(), nil
 	})
 
+	populatorMW.RegisterGetter("tenant-role", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid tenant role id")
+		}
+
+		role, err := config.V1.TenantRole().GetTenantRoleById(ctxTimeout, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return role, role.TenantId.String(), nil
+	})
+
 	populatorMW.RegisterGetter("api-token", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		ctxTimeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -361,6 +392,63 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return emailGroup, emailGroup.TenantId.String(), nil
 	})
 
+	populatorMW.RegisterGetter("alert-sink", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid alert sink id")
+		}
+
+		sink, err := config.V1.TenantAlertingSettings().GetTenantAlertSinkById(timeoutCtx, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return sink, sink.TenantId.String(), nil
+	})
+
+	populatorMW.RegisterGetter("alert-rule", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid alert rule id")
+		}
+
+		rule, err := config.V1.TenantAlertingSettings().GetTenantAlertRuleById(timeoutCtx, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return rule, rule.TenantId.String(), nil
+	})
+
+	populatorMW.RegisterGetter("scoped-resource-limit", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid scoped resource limit id")
+		}
+
+		limit, err := config.V1.TenantLimit().GetScopedLimitById(timeoutCtx, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return limit, limit.TenantID.String(), nil
+	})
+
 	populatorMW.RegisterGetter("sns", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -636,6 +724,44 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return filter, filter.TenantID.String(), nil
 	})
 
+	populatorMW.RegisterGetter("v1-dead-letter-policy", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid dead-letter policy id")
+		}
+
+		policy, err := config.V1.DeadLetter().GetDeadLetterPolicyById(timeoutCtx, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return policy, policy.TenantID.String(), nil
+	})
+
+	populatorMW.RegisterGetter("v1-bulk-operation", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid bulk operation id")
+		}
+
+		op, err := config.V1.BulkOperations().GetBulkOperationById(timeoutCtx, idUuid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return op, op.TenantID.String(), nil
+	})
+
 	populatorMW.RegisterGetter("v1-event", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		idUuid, err := uuid.Parse(id)
 
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/archive"
+)
+
+var (
+	archiveDate   string
+	archiveSchema string
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "command for working with archived run history.",
+}
+
+var archiveRehydrateCmd = &cobra.Command{
+	Use:   "rehydrate",
+	Short: "load an archived day of run history into a read-only schema.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+		err := runArchiveRehydrate(cmd.Context(), configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [archive rehydrate] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archiveCmd)
+	archiveCmd.AddCommand(archiveRehydrateCmd)
+
+	archiveRehydrateCmd.PersistentFlags().StringVar(
+		&archiveDate,
+		"date",
+		"",
+		"the day to rehydrate, as YYYY-MM-DD",
+	)
+
+	archiveRehydrateCmd.PersistentFlags().StringVar(
+		&archiveSchema,
+		"schema",
+		"",
+		"the schema to load the archived tables into, which must not exist (default: archive_YYYYMMDD)",
+	)
+
+	_ = archiveRehydrateCmd.MarkPersistentFlagRequired("date")
+}
+
+func runArchiveRehydrate(ctx context.Context, cf *loader.ConfigLoader) error {
+	date, err := time.Parse("2006-01-02", archiveDate)
+
+	if err != nil {
+		return fmt.Errorf("invalid date %s, expected YYYY-MM-DD: %w", archiveDate, err)
+	}
+
+	schema := archiveSchema
+
+	if schema == "" {
+		schema = fmt.Sprintf("archive_%s", date.Format("20060102"))
+	}
+
+	dc, err := cf.InitDataLayer()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	if dc.Archiver == nil {
+		return fmt.Errorf("archiving is not configured, set SERVER_ARCHIVE_KIND to the archive which should be read")
+	}
+
+	tables, err := dc.Archiver.Rehydrate(ctx, dc.Pool, archive.RehydrateOpts{
+		Date:   date,
+		Schema: schema,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tables {
+		fmt.Printf("loaded %d rows from %d partitions into %s.%s\n", t.Rows, t.Partitions, schema, t.Table)
+	}
+
+	fmt.Printf("run history from %s is available in the read-only schema %s, drop it with DROP SCHEMA %s CASCADE\n", archiveDate, schema, schema)
+
+	return nil
+}
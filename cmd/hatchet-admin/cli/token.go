@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
@@ -19,6 +21,8 @@ var (
 	tokenTenantIdStr string
 	tokenName        string
 	expiresIn        time.Duration
+	tokenScopes      []string
+	tokenWorkflows   []string
 )
 
 var tokenCmd = &cobra.Command{
@@ -65,6 +69,19 @@ func init() {
 		"Expiration duration for the API token",
 	)
 
+	tokenCreateAPICmd.PersistentFlags().StringSliceVar(
+		&tokenScopes,
+		"scope",
+		nil,
+		"restrict the token to OpenAPI operation IDs or scope presets (read-only, trigger-only), can be repeated",
+	)
+
+	tokenCreateAPICmd.PersistentFlags().StringSliceVar(
+		&tokenWorkflows,
+		"workflow",
+		nil,
+		"restrict the token to the named workflows, can be repeated",
+	)
 }
 
 func runCreateAPIToken(expiresIn time.Duration) error {
@@ -94,7 +111,17 @@ func runCreateAPIToken(expiresIn time.Duration) error {
 		return err
 	}
 
-	defaultTok, err := server.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt)
+	var defaultTok *token.Token
+
+	if scopes := rbac.NewScopes(tokenScopes, tokenWorkflows); scopes != nil {
+		if err := server.Auth.ScopeAuthorizer.Validate(scopes); err != nil {
+			return err
+		}
+
+		defaultTok, err = server.Auth.JWTManager.GenerateScopedTenantToken(context.Background(), tenantId, tokenName, &expiresAt, scopes)
+	} else {
+		defaultTok, err = server.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt)
+	}
 
 	if err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
@@ -71,10 +72,16 @@ var cronListCmd = &cobra.Command{
 var cronGetCmd = &cobra.Command{
 	Use:   "get <cron-id>",
 	Short: "Get cron job details",
-	Long:  `Get details about a cron job. Outputs raw JSON.`,
-	Args:  cobra.ExactArgs(1),
+	Long:  `Get details about a cron job, including its most recent fires. Outputs raw JSON.`,
+	Example: `  # Show the cron job with its last 10 fires
+  hatchet cron get <cron-id>
+
+  # Show the cron job with its last 50 fires
+  hatchet cron get <cron-id> --fires 50`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		cronID := args[0]
+		fires, _ := cmd.Flags().GetInt64("fires")
 		_, hatchetClient := clientFromCmd(cmd)
 
 		cronUUID, err := uuid.Parse(cronID)
@@ -92,10 +99,35 @@ var cronGetCmd = &cobra.Command{
 			cli.Logger.Fatalf("cron job not found (status %d)", resp.StatusCode())
 		}
 
-		printJSON(resp.JSON200)
+		out := cronWithFires{CronWorkflows: resp.JSON200}
+
+		if fires > 0 {
+			historyResp, err := hatchetClient.API().WorkflowCronHistoryListWithResponse(ctx, tenantUUID, cronUUID, &rest.WorkflowCronHistoryListParams{
+				Limit: &fires,
+			})
+			if err != nil {
+				cli.Logger.Fatalf("failed to list cron job fires: %v", err)
+			}
+			if historyResp.JSON200 == nil {
+				cli.Logger.Fatalf("unexpected response from API (status %d)", historyResp.StatusCode())
+			}
+
+			if historyResp.JSON200.Rows != nil {
+				out.Fires = *historyResp.JSON200.Rows
+			}
+		}
+
+		printJSON(out)
 	},
 }
 
+// cronWithFires is the output of cron get, which adds the most recent fires of the cron job to its details.
+type cronWithFires struct {
+	*rest.CronWorkflows
+
+	Fires []rest.CronWorkflowFire `json:"fires,omitempty"`
+}
+
 var cronCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a cron job",
@@ -104,7 +136,13 @@ var cronCreateCmd = &cobra.Command{
   hatchet cron create --profile local
 
   # JSON mode (required flags)
-  hatchet cron create --workflow my-workflow --cron "0 * * * *" --name my-cron -o json`,
+  hatchet cron create --workflow my-workflow --cron "0 * * * *" --name my-cron -o json
+
+  # Run at 9am New York time, and catch up on the latest missed run after an outage
+  hatchet cron create --workflow my-workflow --cron "0 9 * * *" --timezone America/New_York --misfire-policy fire-once
+
+  # Don't start a new run while the previous run is still running
+  hatchet cron create --workflow my-workflow --cron "*/5 * * * *" --overlap-policy skip-if-running`,
 	Run: func(cmd *cobra.Command, args []string) {
 		isJSON := isJSONOutput(cmd)
 		_, hatchetClient := clientFromCmd(cmd)
@@ -115,6 +153,10 @@ var cronCreateCmd = &cobra.Command{
 		cronName, _ := cmd.Flags().GetString("name")
 		inputStr, _ := cmd.Flags().GetString("input")
 		inputFile, _ := cmd.Flags().GetString("input-file")
+		timezone, _ := cmd.Flags().GetString("timezone")
+		misfirePolicyStr, _ := cmd.Flags().GetString("misfire-policy")
+		misfireLimit, _ := cmd.Flags().GetInt32("misfire-limit")
+		overlapPolicyStr, _ := cmd.Flags().GetString("overlap-policy")
 
 		if !isJSON {
 			// Interactive mode: show workflow selector first, then remaining fields
@@ -144,6 +186,34 @@ var cronCreateCmd = &cobra.Command{
 						Value(&inputStr).
 						Placeholder("{}"),
 				),
+				huh.NewGroup(
+					huh.NewInput().
+						Title("Timezone (optional, defaults to UTC)").
+						Value(&timezone).
+						Placeholder("America/New_York"),
+				),
+				huh.NewGroup(
+					huh.NewSelect[string]().
+						Title("Missed runs").
+						Description("What to do with runs which were missed while Hatchet was unavailable").
+						Options(
+							huh.NewOption("Skip missed runs", "skip"),
+							huh.NewOption("Fire the latest missed run", "fire-once"),
+							huh.NewOption("Fire all missed runs", "fire-all"),
+						).
+						Value(&misfirePolicyStr),
+				),
+				huh.NewGroup(
+					huh.NewSelect[string]().
+						Title("Overlapping runs").
+						Description("What to do when the cron fires while its previous run is still running").
+						Options(
+							huh.NewOption("Allow overlapping runs", "allow"),
+							huh.NewOption("Skip if the previous run is still running", "skip-if-running"),
+							huh.NewOption("Cancel the previous run", "cancel-previous"),
+						).
+						Value(&overlapPolicyStr),
+				),
 			).WithTheme(styles.HatchetTheme())
 			if err := form.Run(); err != nil {
 				cli.Logger.Fatalf("form cancelled: %v", err)
@@ -157,6 +227,37 @@ var cronCreateCmd = &cobra.Command{
 			cli.Logger.Fatal("--cron is required")
 		}
 
+		var misfirePolicy *rest.CronWorkflowsMisfirePolicy
+		if misfirePolicyStr != "" {
+			policy, err := parseMisfirePolicy(misfirePolicyStr)
+			if err != nil {
+				cli.Logger.Fatal(err.Error())
+			}
+			misfirePolicy = &policy
+		}
+
+		var misfireLimitPtr *int32
+		if cmd.Flags().Changed("misfire-limit") {
+			if misfirePolicy == nil || *misfirePolicy != rest.FIREALL {
+				cli.Logger.Fatal("--misfire-limit can only be used with --misfire-policy fire-all")
+			}
+			misfireLimitPtr = &misfireLimit
+		}
+
+		var overlapPolicy *rest.CronWorkflowsOverlapPolicy
+		if overlapPolicyStr != "" {
+			policy, err := parseOverlapPolicy(overlapPolicyStr)
+			if err != nil {
+				cli.Logger.Fatal(err.Error())
+			}
+			overlapPolicy = &policy
+		}
+
+		var timezonePtr *string
+		if timezone != "" {
+			timezonePtr = &timezone
+		}
+
 		// Build input map
 		inputData := map[string]interface{}{}
 		if inputFile != "" {
@@ -190,6 +291,10 @@ var cronCreateCmd = &cobra.Command{
 			CronName:           cronName,
 			Input:              inputData,
 			AdditionalMetadata: map[string]interface{}{},
+			Timezone:           timezonePtr,
+			MisfirePolicy:      misfirePolicy,
+			MisfireLimit:       misfireLimitPtr,
+			OverlapPolicy:      overlapPolicy,
 		})
 		if err != nil {
 			cli.Logger.Fatalf("failed to create cron job: %v", err)
@@ -380,12 +485,44 @@ func init() {
 	cronListCmd.Flags().Int64("limit", 50, "Number of results to return")
 	cronListCmd.Flags().Int64("offset", 0, "Offset for pagination")
 
+	cronGetCmd.Flags().Int64("fires", 10, "Number of recent fires to show (0 to hide, max 100)")
+
 	cronCreateCmd.Flags().StringP("workflow", "w", "", "Workflow name or ID")
 	cronCreateCmd.Flags().StringP("cron", "c", "", "Cron expression (e.g. '0 * * * *')")
 	cronCreateCmd.Flags().StringP("name", "n", "", "Cron job name")
 	cronCreateCmd.Flags().StringP("input", "i", "", "Input JSON string")
 	cronCreateCmd.Flags().String("input-file", "", "Path to a JSON file for input")
+	cronCreateCmd.Flags().String("timezone", "", "IANA timezone to evaluate the cron expression in (e.g. 'America/New_York', default: UTC)")
+	cronCreateCmd.Flags().String("misfire-policy", "", "What to do with runs missed while Hatchet was unavailable: skip, fire-once or fire-all (default: skip)")
+	cronCreateCmd.Flags().Int32("misfire-limit", 0, "Maximum number of missed runs to fire with --misfire-policy fire-all (default: 100)")
+	cronCreateCmd.Flags().String("overlap-policy", "", "What to do when the cron fires while its previous run is still running: allow, skip-if-running or cancel-previous (default: allow)")
 
 	cronDeleteCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
 	cronDisableCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
 }
+
+// parseMisfirePolicy parses a misfire policy flag, accepting both the API values (FIRE_ONCE) and their kebab-case
+// form (fire-once).
+func parseMisfirePolicy(s string) (rest.CronWorkflowsMisfirePolicy, error) {
+	policy := rest.CronWorkflowsMisfirePolicy(strings.ReplaceAll(strings.ToUpper(s), "-", "_"))
+
+	switch policy {
+	case rest.SKIP, rest.FIREONCE, rest.FIREALL:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid --misfire-policy %q, must be one of skip, fire-once or fire-all", s)
+	}
+}
+
+// parseOverlapPolicy parses an overlap policy flag, accepting both the API values (SKIP_IF_RUNNING) and their
+// kebab-case form (skip-if-running).
+func parseOverlapPolicy(s string) (rest.CronWorkflowsOverlapPolicy, error) {
+	policy := rest.CronWorkflowsOverlapPolicy(strings.ReplaceAll(strings.ToUpper(s), "-", "_"))
+
+	switch policy {
+	case rest.ALLOW, rest.SKIPIFRUNNING, rest.CANCELPREVIOUS:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid --overlap-policy %q, must be one of allow, skip-if-running or cancel-previous", s)
+	}
+}
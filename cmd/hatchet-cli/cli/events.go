@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	Aliases: []string{"event"},
+	Short:   "Manage events",
+	Long:    `Commands for working with the events which were pushed to Hatchet.`,
+	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
+}
+
+var eventsReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Replay events matching a filter",
+	Long: `Replay every event matching a key pattern, time range and additional metadata by pushing it again, for
+example after fixing a broken consumer. Replays are throttled with --rate, and carry the id of the original event in
+the hatchet__replayed_event_id additional metadata key. Use --dry-run to count the matching events without replaying
+them.
+
+The events are replayed by a background operation, and the command waits for it to finish while showing its progress
+unless --no-wait is set. Use 'hatchet runs operations' to follow or cancel the operation later.`,
+	Example: `  # Count the events which would be replayed
+  hatchet events replay --key "order:*" --since 6h --dry-run
+
+  # Replay events with matching metadata, 50 events per second
+  hatchet events replay --key "order:created" --since 24h --until 1h --metadata customer:acme --rate 50
+
+  # Queue the replay without waiting for it to finish
+  hatchet events replay --key "order:*" --since 6h --yes --no-wait
+
+  # JSON output (no confirmation)
+  hatchet events replay --key "order:*" --since 6h -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		noWait, _ := cmd.Flags().GetBool("no-wait")
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		body := buildReplayEventsRequest(cmd)
+
+		if !isJSON && !dryRun && !yes {
+			count := replayEvents(ctx, hatchetClient, withDryRun(body)).MatchedCount
+			if count == 0 {
+				fmt.Println(styles.Muted.Render("No events match your filters."))
+				return
+			}
+			if !confirmAction(fmt.Sprintf("This will replay %d events matching your filters. Continue?", count)) {
+				fmt.Println("Aborted.")
+				return
+			}
+		}
+
+		if dryRun {
+			body = withDryRun(body)
+		}
+
+		res := replayEvents(ctx, hatchetClient, body)
+
+		if res.Operation != nil && !noWait {
+			res.Operation = waitForBulkOperation(ctx, hatchetClient, clientTenantUUID(hatchetClient), res.Operation, !isJSON)
+		}
+
+		if isJSON {
+			printJSON(res)
+			return
+		}
+
+		switch {
+		case res.DryRun:
+			fmt.Println(styles.InfoMessage(fmt.Sprintf("%d event(s) match your filters", res.MatchedCount)))
+		case res.Operation == nil:
+			fmt.Println(styles.Muted.Render("No events match your filters."))
+		case noWait:
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Submitted replay of %d event(s) as bulk operation %s", res.MatchedCount, res.Operation.Metadata.Id)))
+			fmt.Println(styles.Muted.Render(fmt.Sprintf("Follow its progress with: hatchet runs operations get %s --wait", res.Operation.Metadata.Id)))
+		case res.Operation.Status == rest.V1BulkOperationStatusCANCELLED:
+			fmt.Println(styles.InfoMessage(fmt.Sprintf("Replay was cancelled after replaying %d event(s)", res.Operation.ProcessedCount)))
+		default:
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Replayed %d event(s)", res.Operation.ProcessedCount)))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventsReplayCmd)
+
+	eventsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
+	eventsCmd.PersistentFlags().StringP("output", "o", "", "Output format: json")
+
+	eventsReplayCmd.Flags().StringP("key", "k", "", "Glob pattern which event keys must match, e.g. order:* (default: all keys)")
+	eventsReplayCmd.Flags().StringP("since", "s", "", "Replay events since this duration ago (e.g. 1h, 24h, 7d) [required]")
+	eventsReplayCmd.Flags().String("until", "", "Replay events until this duration ago (e.g. 30m)")
+	eventsReplayCmd.Flags().StringSliceP("metadata", "m", nil, "Additional metadata which events must have, as key:value")
+	eventsReplayCmd.Flags().StringSlice("scope", nil, "Scopes which events must have")
+	eventsReplayCmd.Flags().Int("rate", 100, "Maximum number of events to replay per second")
+	eventsReplayCmd.Flags().Bool("dry-run", false, "Count the matching events without replaying them")
+	eventsReplayCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	eventsReplayCmd.Flags().Bool("no-wait", false, "Return as soon as the replay is submitted, without waiting for it to finish")
+
+	_ = eventsReplayCmd.MarkFlagRequired("since")
+}
+
+func buildReplayEventsRequest(cmd *cobra.Command) rest.V1ReplayEventsRequest {
+	sinceStr, _ := cmd.Flags().GetString("since")
+	since, err := parseSinceDuration(sinceStr)
+	if err != nil {
+		cli.Logger.Fatalf("invalid --since value: %v", err)
+	}
+
+	body := rest.V1ReplayEventsRequest{
+		Since: since,
+	}
+
+	untilStr, _ := cmd.Flags().GetString("until")
+	if untilStr != "" {
+		var until time.Time
+		until, err = parseSinceDuration(untilStr)
+		if err != nil {
+			cli.Logger.Fatalf("invalid --until value: %v", err)
+		}
+		body.Until = &until
+	}
+
+	if key, _ := cmd.Flags().GetString("key"); key != "" {
+		body.KeyPattern = &key
+	}
+
+	if metadata, _ := cmd.Flags().GetStringSlice("metadata"); len(metadata) > 0 {
+		body.AdditionalMetadata = &metadata
+	}
+
+	if scopes, _ := cmd.Flags().GetStringSlice("scope"); len(scopes) > 0 {
+		body.Scopes = &scopes
+	}
+
+	rate, _ := cmd.Flags().GetInt("rate")
+	body.EventsPerSecond = &rate
+
+	return body
+}
+
+func withDryRun(body rest.V1ReplayEventsRequest) rest.V1ReplayEventsRequest {
+	dryRun := true
+	body.DryRun = &dryRun
+	return body
+}
+
+func replayEvents(ctx context.Context, hatchetClient client.Client, body rest.V1ReplayEventsRequest) *rest.V1ReplayEventsResponse { //nolint:staticcheck
+	tenantUUID := clientTenantUUID(hatchetClient)
+
+	resp, err := hatchetClient.API().V1EventReplayWithResponse(ctx, tenantUUID, body)
+	if err != nil {
+		cli.Logger.Fatalf("failed to replay events: %v", err)
+	}
+	if resp.JSON400 != nil {
+		cli.Logger.Fatalf("could not replay events: %s", resp.JSON400.Errors[0].Description)
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	return resp.JSON200
+}
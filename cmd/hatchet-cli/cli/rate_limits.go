@@ -1,19 +1,24 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
 	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
 	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/tui"
 	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 var rateLimitsCmd = &cobra.Command{
 	Use:     "rate-limits",
 	Aliases: []string{"rate-limit", "rl", "rls"},
 	Short:   "Manage rate limits",
-	Long:    `Commands for listing and viewing rate limit usage.`,
+	Long:    `Commands for listing, viewing and configuring rate limits.`,
 	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
 }
 
@@ -67,9 +72,60 @@ var rateLimitsListCmd = &cobra.Command{
 	},
 }
 
+var rateLimitsPutCmd = &cobra.Command{
+	Use:   "put <key>",
+	Short: "Create or update a rate limit",
+	Long: `Create or update a rate limit. The mode controls how the limit refills:
+
+  fixed_window    the limit resets at the start of every window (default)
+  sliding_window  the limit applies to any window-length interval
+  token_bucket    the limit refills continuously over the window, up to --burst`,
+	Example: `  # Allow 100 units per minute, refilling continuously with bursts of up to 20
+  hatchet rate-limits put openai --limit 100 --duration minute --mode token_bucket --burst 20
+
+  # Allow 1000 units in any rolling hour
+  hatchet rate-limits put stripe --limit 1000 --duration hour --mode sliding_window -o json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key := args[0]
+		isJSON := isJSONOutput(cmd)
+		_, hatchetClient := clientFromCmd(cmd)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		duration, _ := cmd.Flags().GetString("duration")
+		mode, _ := cmd.Flags().GetString("mode")
+
+		if limit <= 0 {
+			cli.Logger.Fatal("--limit is required and must be greater than 0")
+		}
+
+		opts := &types.RateLimitOpts{
+			Max:      limit,
+			Duration: types.RateLimitDuration(strings.ToLower(duration)),
+			Mode:     types.RateLimitMode(strings.ToUpper(mode)),
+		}
+
+		if cmd.Flags().Changed("burst") {
+			burst, _ := cmd.Flags().GetInt("burst")
+			opts.Burst = &burst
+		}
+
+		if err := hatchetClient.Admin().PutRateLimit(key, opts); err != nil {
+			cli.Logger.Fatalf("failed to put rate limit: %v", err)
+		}
+
+		if isJSON {
+			printJSON(map[string]interface{}{"key": key, "limit": limit, "duration": opts.Duration, "mode": opts.Mode})
+		} else {
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Updated rate limit: %s", key)))
+		}
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(rateLimitsCmd)
 	rateLimitsCmd.AddCommand(rateLimitsListCmd)
+	rateLimitsCmd.AddCommand(rateLimitsPutCmd)
 
 	rateLimitsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
 	rateLimitsCmd.PersistentFlags().StringP("output", "o", "", "Output format: json (skips interactive TUI)")
@@ -77,4 +133,9 @@ func init() {
 	rateLimitsListCmd.Flags().StringP("search", "s", "", "Search rate limits by key")
 	rateLimitsListCmd.Flags().Int64("limit", 50, "Number of results to return")
 	rateLimitsListCmd.Flags().Int64("offset", 0, "Offset for pagination")
+
+	rateLimitsPutCmd.Flags().Int("limit", 0, "Maximum number of units per window")
+	rateLimitsPutCmd.Flags().String("duration", "minute", "Window of the rate limit: second, minute, hour, day, week, month or year")
+	rateLimitsPutCmd.Flags().String("mode", "fixed_window", "Refill mode: fixed_window, sliding_window or token_bucket")
+	rateLimitsPutCmd.Flags().Int("burst", 0, "Maximum number of units which can be used at once (token_bucket only, defaults to --limit)")
 }
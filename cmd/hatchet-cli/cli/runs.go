@@ -180,7 +180,9 @@ var runsCancelCmd = &cobra.Command{
 	Long: `Cancel a specific run by ID, or cancel multiple runs matching filter criteria.
 
 If a run ID is provided, cancels that specific run (task or DAG).
-If no run ID is provided, requires --since flag and cancels runs matching the filter.`,
+If no run ID is provided, requires --since flag and cancels runs matching the filter. Bulk cancels run
+in the background on the server: the command waits for the operation to finish and shows its progress,
+unless --no-wait is set. Use 'hatchet runs operations' to follow or cancel the operation later.`,
 	Args: cobra.MaximumNArgs(1),
 	Example: `  # Cancel a specific run
   hatchet runs cancel 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --profile local
@@ -189,7 +191,10 @@ If no run ID is provided, requires --since flag and cancels runs matching the fi
   hatchet runs cancel --since 1h --status FAILED --profile local
 
   # Bulk cancel JSON mode (no confirmation)
-  hatchet runs cancel --since 24h --workflow my-workflow -o json`,
+  hatchet runs cancel --since 24h --workflow my-workflow -o json
+
+  # Submit a bulk cancel without waiting for it to finish
+  hatchet runs cancel --since 24h --status QUEUED --no-wait`,
 	Run: func(cmd *cobra.Command, args []string) {
 		isJSON := isJSONOutput(cmd)
 		yes, _ := cmd.Flags().GetBool("yes")
@@ -265,25 +270,7 @@ If no run ID is provided, requires --since flag and cancels runs matching the fi
 			}
 		}
 
-		resp, err := hatchetClient.API().V1TaskCancelWithResponse(ctx, tenantUUID, rest.V1CancelTaskRequest{
-			Filter: filter,
-		})
-		if err != nil {
-			cli.Logger.Fatalf("failed to cancel runs: %v", err)
-		}
-		if resp.JSON200 == nil {
-			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
-		}
-
-		if isJSON {
-			printJSON(resp.JSON200)
-		} else {
-			count := 0
-			if resp.JSON200.Ids != nil {
-				count = len(*resp.JSON200.Ids)
-			}
-			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Cancelled %d run(s)", count)))
-		}
+		submitBulkOperation(cmd, hatchetClient, tenantUUID, rest.CANCEL, filter)
 	},
 }
 
@@ -293,7 +280,9 @@ var runsReplayCmd = &cobra.Command{
 	Long: `Replay a specific run by ID, or replay multiple runs matching filter criteria.
 
 If a run ID is provided, replays that specific run (task or DAG).
-If no run ID is provided, requires --since flag and replays runs matching the filter.`,
+If no run ID is provided, requires --since flag and replays runs matching the filter. Bulk replays run
+in the background on the server: the command waits for the operation to finish and shows its progress,
+unless --no-wait is set. Use 'hatchet runs operations' to follow or cancel the operation later.`,
 	Args: cobra.MaximumNArgs(1),
 	Example: `  # Replay a specific run
   hatchet runs replay 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --profile local
@@ -302,7 +291,10 @@ If no run ID is provided, requires --since flag and replays runs matching the fi
   hatchet runs replay --since 1h --status FAILED --profile local
 
   # Bulk replay JSON mode (no confirmation)
-  hatchet runs replay --since 24h -o json`,
+  hatchet runs replay --since 24h -o json
+
+  # Submit a bulk replay without waiting for it to finish
+  hatchet runs replay --since 24h --status FAILED --no-wait`,
 	Run: func(cmd *cobra.Command, args []string) {
 		isJSON := isJSONOutput(cmd)
 		yes, _ := cmd.Flags().GetBool("yes")
@@ -377,25 +369,7 @@ If no run ID is provided, requires --since flag and replays runs matching the fi
 			}
 		}
 
-		resp, err := hatchetClient.API().V1TaskReplayWithResponse(ctx, tenantUUID, rest.V1ReplayTaskRequest{
-			Filter: filter,
-		})
-		if err != nil {
-			cli.Logger.Fatalf("failed to replay runs: %v", err)
-		}
-		if resp.JSON200 == nil {
-			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
-		}
-
-		if isJSON {
-			printJSON(resp.JSON200)
-		} else {
-			count := 0
-			if resp.JSON200.Ids != nil {
-				count = len(*resp.JSON200.Ids)
-			}
-			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Replayed %d run(s)", count)))
-		}
+		submitBulkOperation(cmd, hatchetClient, tenantUUID, rest.REPLAY, filter)
 	},
 }
 
@@ -819,7 +793,7 @@ var runsEventsCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(runsCmd)
-	runsCmd.AddCommand(runsListCmd, runsGetCmd, runsCancelCmd, runsReplayCmd, runsLogsCmd, runsEventsCmd, runsListChildrenCmd)
+	runsCmd.AddCommand(runsListCmd, runsGetCmd, runsCancelCmd, runsReplayCmd, runsLogsCmd, runsEventsCmd, runsListChildrenCmd, runsOperationsCmd)
 
 	// Persistent flags on parent (inherited by all subcommands)
 	runsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
@@ -840,6 +814,7 @@ func init() {
 	runsCancelCmd.Flags().StringP("workflow", "w", "", "Filter by workflow name or ID")
 	runsCancelCmd.Flags().StringSlice("status", nil, "Filter by status (QUEUED,RUNNING,COMPLETED,FAILED,CANCELLED)")
 	runsCancelCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	runsCancelCmd.Flags().Bool("no-wait", false, "Return as soon as the bulk cancel is submitted, without waiting for it to finish")
 
 	// runs replay flags (same as cancel)
 	runsReplayCmd.Flags().StringP("since", "s", "", "Replay runs since this duration ago (e.g. 1h, 24h) [required for bulk replay]")
@@ -847,6 +822,7 @@ func init() {
 	runsReplayCmd.Flags().StringP("workflow", "w", "", "Filter by workflow name or ID")
 	runsReplayCmd.Flags().StringSlice("status", nil, "Filter by status (QUEUED,RUNNING,COMPLETED,FAILED,CANCELLED)")
 	runsReplayCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	runsReplayCmd.Flags().Bool("no-wait", false, "Return as soon as the bulk replay is submitted, without waiting for it to finish")
 
 	// runs list-children flags
 	runsListChildrenCmd.Flags().Int64("limit", 50, "Number of results to return (for task children)")
@@ -0,0 +1,286 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var runsOperationsCmd = &cobra.Command{
+	Use:     "operations",
+	Aliases: []string{"ops"},
+	Short:   "Manage bulk cancel and replay operations",
+	Long: `Bulk cancels and replays by filter, and event replays, run in the background on the server as operations. Use
+these commands to list operations, follow their progress, or cancel them.`,
+}
+
+var runsOperationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List bulk operations, most recent first",
+	Example: `  # List the most recent bulk operations
+  hatchet runs operations list
+
+  # JSON output
+  hatchet runs operations list -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		limit, _ := cmd.Flags().GetInt64("limit")
+		offset, _ := cmd.Flags().GetInt64("offset")
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		resp, err := hatchetClient.API().V1BulkOperationListWithResponse(ctx, tenantUUID, &rest.V1BulkOperationListParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to list bulk operations: %v", err)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSON {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if resp.JSON200.Rows == nil || len(*resp.JSON200.Rows) == 0 {
+			fmt.Println(styles.Muted.Render("No bulk operations found."))
+			return
+		}
+
+		for _, op := range *resp.JSON200.Rows {
+			fmt.Println(formatBulkOperation(&op))
+		}
+	},
+}
+
+var runsOperationsGetCmd = &cobra.Command{
+	Use:   "get <operation-id>",
+	Short: "Get a bulk operation",
+	Long:  `Get a bulk operation, including its progress. With --wait, polls the operation until it finishes.`,
+	Example: `  # Show a bulk operation
+  hatchet runs operations get 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # Wait for a bulk operation to finish
+  hatchet runs operations get 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --wait`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		wait, _ := cmd.Flags().GetBool("wait")
+		_, hatchetClient := clientFromCmd(cmd)
+
+		opUUID := parseBulkOperationID(args[0])
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		op := getBulkOperation(ctx, hatchetClient, tenantUUID, opUUID)
+
+		if wait {
+			op = waitForBulkOperation(ctx, hatchetClient, tenantUUID, op, !isJSON)
+		}
+
+		if isJSON {
+			printJSON(op)
+		} else {
+			fmt.Println(formatBulkOperation(op))
+		}
+	},
+}
+
+var runsOperationsCancelCmd = &cobra.Command{
+	Use:   "cancel <operation-id>",
+	Short: "Cancel a bulk operation",
+	Long: `Cancel a pending or running bulk operation. Runs which the operation already cancelled or replayed are not
+affected.`,
+	Example: `  # Cancel a bulk operation
+  hatchet runs operations cancel 8ff4f149-099e-4c16-a8d1-0535f8c79b83`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		_, hatchetClient := clientFromCmd(cmd)
+
+		opUUID := parseBulkOperationID(args[0])
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		resp, err := hatchetClient.API().V1BulkOperationCancelWithResponse(ctx, tenantUUID, opUUID)
+		if err != nil {
+			cli.Logger.Fatalf("failed to cancel bulk operation: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not cancel bulk operation: %s", resp.JSON400.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSON {
+			printJSON(resp.JSON200)
+		} else {
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Cancelled bulk operation %s", shortID(resp.JSON200.Metadata.Id))))
+		}
+	},
+}
+
+func init() {
+	runsOperationsCmd.AddCommand(runsOperationsListCmd, runsOperationsGetCmd, runsOperationsCancelCmd)
+
+	runsOperationsListCmd.Flags().Int64("limit", 20, "Number of results to return")
+	runsOperationsListCmd.Flags().Int64("offset", 0, "Offset for pagination")
+
+	runsOperationsGetCmd.Flags().Bool("wait", false, "Poll the operation until it finishes")
+}
+
+// submitBulkOperation creates a bulk cancel or replay operation for the filter and, unless --no-wait is set, waits
+// for it to finish while showing its progress
+func submitBulkOperation(cmd *cobra.Command, hatchetClient client.Client, tenantUUID openapi_types.UUID, kind rest.V1BulkOperationKind, filter *rest.V1TaskFilter) { //nolint:staticcheck
+	isJSON := isJSONOutput(cmd)
+	noWait, _ := cmd.Flags().GetBool("no-wait")
+	ctx := cmd.Context()
+
+	resp, err := hatchetClient.API().V1BulkOperationCreateWithResponse(ctx, tenantUUID, rest.V1CreateBulkOperationRequest{
+		Kind:   kind,
+		Filter: *filter,
+	})
+	if err != nil {
+		cli.Logger.Fatalf("failed to submit bulk operation: %v", err)
+	}
+	if resp.JSON400 != nil {
+		cli.Logger.Fatalf("could not submit bulk operation: %s", resp.JSON400.Errors[0].Description)
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	op := resp.JSON200
+
+	if noWait {
+		if isJSON {
+			printJSON(op)
+		} else {
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Submitted bulk operation %s", op.Metadata.Id)))
+			fmt.Println(styles.Muted.Render(fmt.Sprintf("Follow its progress with: hatchet runs operations get %s --wait", op.Metadata.Id)))
+		}
+		return
+	}
+
+	op = waitForBulkOperation(ctx, hatchetClient, tenantUUID, op, !isJSON)
+
+	if isJSON {
+		printJSON(op)
+		return
+	}
+
+	verb := "Cancelled"
+	if kind == rest.REPLAY {
+		verb = "Replayed"
+	}
+
+	if op.Status == rest.V1BulkOperationStatusCANCELLED {
+		fmt.Println(styles.InfoMessage(fmt.Sprintf("Bulk operation was cancelled after processing %d run(s)", op.ProcessedCount)))
+		return
+	}
+
+	fmt.Println(styles.SuccessMessage(fmt.Sprintf("%s %d run(s)", verb, op.ProcessedCount)))
+}
+
+// waitForBulkOperation polls the operation until it's completed or cancelled. If interrupted, the operation keeps
+// running on the server, and the last known state is returned.
+func waitForBulkOperation(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, op *rest.V1BulkOperation, showProgress bool) *rest.V1BulkOperation { //nolint:staticcheck
+	opUUID := parseBulkOperationID(op.Metadata.Id)
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	for !isBulkOperationFinished(op) {
+		if showProgress {
+			fmt.Printf("\r%s", formatBulkOperationProgress(op))
+		}
+
+		select {
+		case <-ctx.Done():
+			return op
+		case <-sigCh:
+			if showProgress {
+				fmt.Println()
+				fmt.Println(styles.Muted.Render(fmt.Sprintf("Stopped waiting. The operation keeps running: hatchet runs operations get %s --wait", op.Metadata.Id)))
+			}
+			return op
+		case <-ticker.C:
+			op = getBulkOperation(ctx, hatchetClient, tenantUUID, opUUID)
+		}
+	}
+
+	if showProgress {
+		fmt.Printf("\r%s\n", formatBulkOperationProgress(op))
+	}
+
+	return op
+}
+
+func getBulkOperation(ctx context.Context, hatchetClient client.Client, tenantUUID, opUUID openapi_types.UUID) *rest.V1BulkOperation { //nolint:staticcheck
+	resp, err := hatchetClient.API().V1BulkOperationGetWithResponse(ctx, tenantUUID, opUUID)
+	if err != nil {
+		cli.Logger.Fatalf("failed to get bulk operation: %v", err)
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("bulk operation not found (status %d)", resp.StatusCode())
+	}
+
+	return resp.JSON200
+}
+
+func isBulkOperationFinished(op *rest.V1BulkOperation) bool {
+	return op.Status == rest.V1BulkOperationStatusCOMPLETED || op.Status == rest.V1BulkOperationStatusCANCELLED
+}
+
+func formatBulkOperationProgress(op *rest.V1BulkOperation) string {
+	unit := "run"
+	if op.Kind == rest.REPLAYEVENTS {
+		unit = "event"
+	}
+
+	if op.TotalCount == nil {
+		return fmt.Sprintf("%s: resolving matching %ss...", op.Status, unit)
+	}
+
+	return fmt.Sprintf("%s: %d/%d %s(s) processed", op.Status, op.ProcessedCount, *op.TotalCount, unit)
+}
+
+func formatBulkOperation(op *rest.V1BulkOperation) string {
+	return fmt.Sprintf("%s  %-13s %s  created %s",
+		op.Metadata.Id,
+		op.Kind,
+		formatBulkOperationProgress(op),
+		op.Metadata.CreatedAt.Local().Format(time.RFC3339),
+	)
+}
+
+func parseBulkOperationID(id string) openapi_types.UUID {
+	opUUID, err := uuid.Parse(id)
+	if err != nil {
+		cli.Logger.Fatalf("invalid bulk operation ID %q: %v", id, err)
+	}
+
+	return opUUID
+}
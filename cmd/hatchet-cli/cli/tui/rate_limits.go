@@ -51,6 +51,7 @@ func NewRateLimitsView(ctx ViewContext) *RateLimitsView {
 		{Title: "Limit", Width: 10},
 		{Title: "Usage %", Width: 10},
 		{Title: "Window", Width: 12},
+		{Title: "Mode", Width: 15},
 		{Title: "Last Refill", Width: 18},
 	}
 
@@ -304,6 +305,7 @@ func (v *RateLimitsView) updateTableRows() {
 			fmt.Sprintf("%d", rl.LimitValue),
 			usagePct,
 			rl.Window,
+			string(rl.Mode),
 			lastRefill,
 		}
 	}
@@ -14,7 +14,7 @@ var workflowsCmd = &cobra.Command{
 	Use:     "workflows",
 	Aliases: []string{"workflow"},
 	Short:   "Manage workflows",
-	Long:    `Commands for listing and inspecting workflows.`,
+	Long:    `Commands for listing and inspecting workflows, exporting, importing and diffing their definitions, and managing their retention policies.`,
 	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
 }
 
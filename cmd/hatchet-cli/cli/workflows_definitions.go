@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var workflowsExportCmd = &cobra.Command{
+	Use:   "export <workflow-name>",
+	Short: "Export a workflow definition",
+	Long: `Export the canonical definition of a workflow version: its tasks, parents, concurrency, rate limits, crons and
+event triggers. The definition is written as YAML unless --output json is set, and can be registered in another
+environment with "hatchet workflows import".`,
+	Example: `  # Export the latest version of a workflow
+  hatchet workflows export process-order > process-order.yaml
+
+  # Export a specific version as JSON
+  hatchet workflows export process-order --version v2 -o json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		version, _ := cmd.Flags().GetString("version")
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		params := &rest.V1WorkflowDefinitionExportParams{
+			Name: args[0],
+		}
+		if version != "" {
+			params.Version = &version
+		}
+
+		resp, err := hatchetClient.API().V1WorkflowDefinitionExportWithResponse(ctx, tenantUUID, params)
+		if err != nil {
+			cli.Logger.Fatalf("failed to export workflow definition: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not export workflow definition: %s", resp.JSON400.Errors[0].Description)
+		}
+		if resp.JSON404 != nil {
+			cli.Logger.Fatalf("could not export workflow definition: %s", resp.JSON404.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		printWorkflowDefinition(cmd, resp.JSON200)
+	},
+}
+
+var workflowsImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a workflow definition",
+	Long: `Register a workflow definition from a YAML or JSON file, typically one written by "hatchet workflows export".
+Registering a definition which matches the current version of the workflow does not create a new version.`,
+	Example: `  # Promote a workflow from staging to production
+  hatchet workflows export process-order -p staging > process-order.yaml
+  hatchet workflows import process-order.yaml -p production`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		contents, err := os.ReadFile(args[0])
+		if err != nil {
+			cli.Logger.Fatalf("could not read %s: %v", args[0], err)
+		}
+
+		// YAML is a superset of JSON, so both formats are accepted here
+		var def rest.V1WorkflowDefinition
+		if err := yaml.Unmarshal(contents, &def); err != nil {
+			cli.Logger.Fatalf("could not parse workflow definition: %v", err)
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		resp, err := hatchetClient.API().V1WorkflowDefinitionImportWithResponse(ctx, tenantUUID, def)
+		if err != nil {
+			cli.Logger.Fatalf("failed to import workflow definition: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not import workflow definition: %s", resp.JSON400.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		imported := resp.JSON200.Name
+		if resp.JSON200.Version != nil {
+			imported = fmt.Sprintf("%s (version %s)", imported, *resp.JSON200.Version)
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Imported workflow %s", imported)))
+	},
+}
+
+var workflowsDiffCmd = &cobra.Command{
+	Use:   "diff <workflow-name> <from-version> [to-version]",
+	Short: "Show what changed between two versions of a workflow",
+	Long: `Compare the definitions of two versions of a workflow. Versions can be given by their version string or their
+version ID. When the second version is omitted, the first version is compared against the latest version.`,
+	Example: `  # Compare two versions
+  hatchet workflows diff process-order v1 v2
+
+  # Compare a version against the latest version
+  hatchet workflows diff process-order v1 -o json`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		params := &rest.V1WorkflowDefinitionDiffParams{
+			Name: args[0],
+			From: args[1],
+		}
+		if len(args) == 3 {
+			params.To = &args[2]
+		}
+
+		resp, err := hatchetClient.API().V1WorkflowDefinitionDiffWithResponse(ctx, tenantUUID, params)
+		if err != nil {
+			cli.Logger.Fatalf("failed to diff workflow definitions: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not diff workflow definitions: %s", resp.JSON400.Errors[0].Description)
+		}
+		if resp.JSON404 != nil {
+			cli.Logger.Fatalf("could not diff workflow definitions: %s", resp.JSON404.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if len(resp.JSON200.Changes) == 0 {
+			fmt.Println(styles.Muted.Render("No changes."))
+			return
+		}
+
+		for _, change := range resp.JSON200.Changes {
+			fmt.Println(formatWorkflowDefinitionChange(&change))
+		}
+	},
+}
+
+func init() {
+	workflowsCmd.AddCommand(workflowsExportCmd, workflowsImportCmd, workflowsDiffCmd)
+
+	workflowsExportCmd.Flags().String("version", "", "Version string or version ID to export (default: latest)")
+}
+
+// printWorkflowDefinition writes a workflow definition to stdout as YAML, or as JSON with --output json
+func printWorkflowDefinition(cmd *cobra.Command, def *rest.V1WorkflowDefinition) {
+	if isJSONOutput(cmd) {
+		printJSON(def)
+		return
+	}
+
+	out, err := yaml.Marshal(def)
+	if err != nil {
+		cli.Logger.Fatalf("could not encode workflow definition: %v", err)
+	}
+
+	fmt.Print(string(out))
+}
+
+func formatWorkflowDefinitionChange(change *rest.V1WorkflowDefinitionChange) string {
+	added := lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	removed := lipgloss.NewStyle().Foreground(styles.StatusFailedColor)
+
+	switch change.Kind {
+	case rest.ADDED:
+		return added.Render(fmt.Sprintf("+ %s: %s", change.Path, formatWorkflowDefinitionValue(change.To)))
+	case rest.REMOVED:
+		return removed.Render(fmt.Sprintf("- %s: %s", change.Path, formatWorkflowDefinitionValue(change.From)))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s",
+			change.Path,
+			removed.Render(formatWorkflowDefinitionValue(change.From)),
+			added.Render(formatWorkflowDefinitionValue(change.To)),
+		)
+	}
+}
+
+// formatWorkflowDefinitionValue renders a JSON-encoded value from a diff, compacting it onto a single line
+func formatWorkflowDefinitionValue(value *string) string {
+	if value == nil {
+		return "null"
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(*value), &decoded); err != nil {
+		return *value
+	}
+
+	out, err := json.Marshal(decoded)
+	if err != nil {
+		return *value
+	}
+
+	return string(out)
+}
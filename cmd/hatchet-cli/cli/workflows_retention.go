@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var workflowsRetentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "Manage how long runs of a workflow are kept for",
+	Long: `Commands for managing workflow retention policies. Runs of a workflow with a retention policy are kept for its
+retention period instead of the default retention period, and failed, completed or cancelled runs can be kept for
+a different period than other runs.`,
+	Run: func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
+}
+
+var workflowsRetentionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List workflow retention policies",
+	Example: `  # List every workflow with a retention policy
+  hatchet workflows retention list
+
+  # JSON output
+  hatchet workflows retention list -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		resp, err := hatchetClient.API().V1WorkflowRetentionPolicyListWithResponse(ctx, tenantUUID)
+		if err != nil {
+			cli.Logger.Fatalf("failed to list retention policies: %v", err)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if resp.JSON200.Rows == nil || len(*resp.JSON200.Rows) == 0 {
+			fmt.Println(styles.Muted.Render("No retention policies, runs are kept for the default retention period."))
+			return
+		}
+
+		for i, policy := range *resp.JSON200.Rows {
+			if i > 0 {
+				fmt.Println()
+			}
+
+			printWorkflowRetentionPolicy(&policy)
+		}
+	},
+}
+
+var workflowsRetentionGetCmd = &cobra.Command{
+	Use:   "get <workflow>",
+	Short: "Show the retention policy of a workflow",
+	Example: `  # Show the retention policy of a workflow
+  hatchet workflows retention get process-order`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		workflowUUID, err := resolveWorkflowID(ctx, hatchetClient, args[0])
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		resp, err := hatchetClient.API().V1WorkflowRetentionPolicyGetWithResponse(ctx, tenantUUID, workflowUUID)
+		if err != nil {
+			cli.Logger.Fatalf("failed to get retention policy: %v", err)
+		}
+		if resp.JSON404 != nil {
+			fmt.Println(styles.Muted.Render(fmt.Sprintf("%s has no retention policy, its runs are kept for the default retention period.", args[0])))
+			return
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		printWorkflowRetentionPolicy(resp.JSON200)
+	},
+}
+
+var workflowsRetentionSetCmd = &cobra.Command{
+	Use:   "set <workflow>",
+	Short: "Set the retention policy of a workflow",
+	Long: `Set how long runs of a workflow are kept for, replacing its existing retention policy. Periods are durations such
+as 12h or 90d, and must be at least 1h and at most the server's max workflow retention period. The --completed,
+--failed and --cancelled periods override --period for runs with that final status.`,
+	Example: `  # Keep runs of a noisy workflow for a day
+  hatchet workflows retention set sync-inventory --period 1d
+
+  # Keep succeeded runs for a week, but failed runs for 90 days
+  hatchet workflows retention set charge-invoice --period 7d --failed 90d`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		period, _ := cmd.Flags().GetString("period")
+
+		body := rest.V1UpsertWorkflowRetentionPolicyRequest{}
+
+		var err error
+
+		if body.RetentionPeriod, err = parseRetentionPeriod(period); err != nil {
+			cli.Logger.Fatalf("invalid --period: %v", err)
+		}
+
+		for flag, field := range map[string]**string{
+			"completed": &body.CompletedRetentionPeriod,
+			"failed":    &body.FailedRetentionPeriod,
+			"cancelled": &body.CancelledRetentionPeriod,
+		} {
+			if !cmd.Flags().Changed(flag) {
+				continue
+			}
+
+			value, _ := cmd.Flags().GetString(flag)
+
+			parsed, err := parseRetentionPeriod(value)
+			if err != nil {
+				cli.Logger.Fatalf("invalid --%s: %v", flag, err)
+			}
+
+			*field = &parsed
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		workflowUUID, err := resolveWorkflowID(ctx, hatchetClient, args[0])
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		resp, err := hatchetClient.API().V1WorkflowRetentionPolicyUpsertWithResponse(ctx, tenantUUID, workflowUUID, body)
+		if err != nil {
+			cli.Logger.Fatalf("failed to set retention policy: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not set retention policy: %s", resp.JSON400.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Set the retention policy of %s", args[0])))
+		printWorkflowRetentionPolicy(resp.JSON200)
+	},
+}
+
+var workflowsRetentionClearCmd = &cobra.Command{
+	Use:   "clear <workflow>",
+	Short: "Remove the retention policy of a workflow",
+	Long:  `Remove the retention policy of a workflow, so its runs are kept for the default retention period again.`,
+	Example: `  # Remove a retention policy without a confirmation prompt
+  hatchet workflows retention clear sync-inventory -y`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		workflowUUID, err := resolveWorkflowID(ctx, hatchetClient, args[0])
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		if !yes && !isJSONOutput(cmd) {
+			if !confirmAction(fmt.Sprintf("Remove the retention policy of %s?", args[0])) {
+				fmt.Println("Cancelled.")
+				return
+			}
+		}
+
+		resp, err := hatchetClient.API().V1WorkflowRetentionPolicyDeleteWithResponse(ctx, tenantUUID, workflowUUID)
+		if err != nil {
+			cli.Logger.Fatalf("failed to remove retention policy: %v", err)
+		}
+		if resp.JSON404 != nil {
+			cli.Logger.Fatalf("could not remove retention policy: %s", resp.JSON404.Errors[0].Description)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Removed the retention policy of %s", args[0])))
+	},
+}
+
+func init() {
+	workflowsCmd.AddCommand(workflowsRetentionCmd)
+	workflowsRetentionCmd.AddCommand(workflowsRetentionListCmd, workflowsRetentionGetCmd, workflowsRetentionSetCmd, workflowsRetentionClearCmd)
+
+	workflowsRetentionSetCmd.Flags().String("period", "", "How long runs are kept for (e.g. 12h, 30d)")
+	workflowsRetentionSetCmd.Flags().String("completed", "", "How long completed runs are kept for (default: --period)")
+	workflowsRetentionSetCmd.Flags().String("failed", "", "How long failed runs are kept for (default: --period)")
+	workflowsRetentionSetCmd.Flags().String("cancelled", "", "How long cancelled runs are kept for (default: --period)")
+	_ = workflowsRetentionSetCmd.MarkFlagRequired("period")
+
+	workflowsRetentionClearCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+}
+
+// parseRetentionPeriod accepts a Go duration or a number of days (e.g. "90d"), and returns it as a Go duration
+// string, which is the format the API expects
+func parseRetentionPeriod(s string) (string, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return "", fmt.Errorf("invalid duration %q (use e.g. 12h, 30d)", s)
+		}
+
+		return (time.Duration(n) * 24 * time.Hour).String(), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return "", fmt.Errorf("invalid duration %q (use e.g. 12h, 30d)", s)
+	}
+
+	return d.String(), nil
+}
+
+func printWorkflowRetentionPolicy(policy *rest.V1WorkflowRetentionPolicy) {
+	fmt.Println(styles.KeyValue("Workflow", policy.WorkflowId.String()))
+	fmt.Println(styles.KeyValue("Retention period", policy.RetentionPeriod))
+
+	if policy.CompletedRetentionPeriod != nil {
+		fmt.Println(styles.KeyValue("Completed runs", *policy.CompletedRetentionPeriod))
+	}
+
+	if policy.FailedRetentionPeriod != nil {
+		fmt.Println(styles.KeyValue("Failed runs", *policy.FailedRetentionPeriod))
+	}
+
+	if policy.CancelledRetentionPeriod != nil {
+		fmt.Println(styles.KeyValue("Cancelled runs", *policy.CancelledRetentionPeriod))
+	}
+}
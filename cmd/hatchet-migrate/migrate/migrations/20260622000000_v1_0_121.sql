@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE v1_concurrency_strategy ADD VALUE IF NOT EXISTS 'WEIGHTED_FAIR';
+
+-- a CEL expression evaluated against each concurrency key to determine its weight, only used by
+-- the WEIGHTED_FAIR strategy
+ALTER TABLE v1_step_concurrency ADD COLUMN weight_expression TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- note: we don't remove the WEIGHTED_FAIR enum value, as postgres doesn't support dropping enum values
+ALTER TABLE v1_step_concurrency DROP COLUMN weight_expression;
+-- +goose StatementEnd
@@ -0,0 +1,160 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "RateLimitMode" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET');
+
+ALTER TABLE "RateLimit"
+    ADD COLUMN "mode" "RateLimitMode" NOT NULL DEFAULT 'FIXED_WINDOW',
+    -- the capacity of a TOKEN_BUCKET rate limit, defaults to "limitValue" when null
+    ADD COLUMN "burst" INTEGER,
+    -- the units used in the previous window of a SLIDING_WINDOW rate limit
+    ADD COLUMN "previousWindowUsed" INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- get_refill_value returns the stored value of the rate limit after a refill. Token buckets accrue
+-- "limitValue" tokens per window continuously, up to the burst size, while fixed and sliding windows
+-- reset to "limitValue" at the start of every window.
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+    tokens BIGINT;
+BEGIN
+    IF rate_limit."mode" = 'TOKEN_BUCKET' THEN
+        tokens := FLOOR(
+            EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) / EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL) * rate_limit."limitValue"
+        );
+        refill_amount := LEAST(COALESCE(rate_limit."burst", rate_limit."limitValue"), rate_limit."value" + GREATEST(tokens, 0));
+    ELSIF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- get_refill_timestamp returns the "lastRefill" of the rate limit after a refill.
+CREATE OR REPLACE FUNCTION get_refill_timestamp(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+DECLARE
+    window_interval INTERVAL := rate_limit."window"::INTERVAL;
+    elapsed INTERVAL := NOW() - rate_limit."lastRefill";
+    tokens BIGINT;
+BEGIN
+    IF rate_limit."mode" = 'TOKEN_BUCKET' THEN
+        -- a full bucket doesn't accrue tokens, so the refill clock restarts
+        IF get_refill_value(rate_limit) >= COALESCE(rate_limit."burst", rate_limit."limitValue") THEN
+            RETURN CURRENT_TIMESTAMP;
+        END IF;
+
+        -- only advance by the time it took to accrue whole tokens, so that partial tokens carry over
+        tokens := FLOOR(EXTRACT(EPOCH FROM elapsed) / EXTRACT(EPOCH FROM window_interval) * rate_limit."limitValue");
+        RETURN rate_limit."lastRefill" + GREATEST(tokens, 0) * (window_interval / GREATEST(rate_limit."limitValue", 1));
+    ELSIF elapsed >= (window_interval - INTERVAL '10 milliseconds') THEN
+        -- consecutive sliding windows stay aligned so that the previous window's usage decays correctly
+        IF rate_limit."mode" = 'SLIDING_WINDOW' AND elapsed < (2 * window_interval - INTERVAL '10 milliseconds') THEN
+            RETURN rate_limit."lastRefill" + window_interval;
+        END IF;
+        RETURN CURRENT_TIMESTAMP;
+    END IF;
+    RETURN rate_limit."lastRefill";
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- get_previous_window_used returns the "previousWindowUsed" of a sliding window rate limit after a refill.
+CREATE OR REPLACE FUNCTION get_previous_window_used(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    window_interval INTERVAL := rate_limit."window"::INTERVAL;
+    elapsed INTERVAL := NOW() - rate_limit."lastRefill";
+BEGIN
+    IF rate_limit."mode" <> 'SLIDING_WINDOW' OR elapsed >= (2 * window_interval - INTERVAL '10 milliseconds') THEN
+        RETURN 0;
+    ELSIF elapsed >= (window_interval - INTERVAL '10 milliseconds') THEN
+        RETURN GREATEST(rate_limit."limitValue" - rate_limit."value", 0);
+    END IF;
+    RETURN rate_limit."previousWindowUsed";
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- get_available_value returns the number of units which can currently be used. For sliding windows,
+-- the previous window's usage is weighted by how much of it still overlaps the sliding window.
+CREATE OR REPLACE FUNCTION get_available_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    overlap DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."mode" <> 'SLIDING_WINDOW' THEN
+        RETURN get_refill_value(rate_limit);
+    END IF;
+
+    overlap := LEAST(1, GREATEST(0,
+        1 - EXTRACT(EPOCH FROM (NOW() - get_refill_timestamp(rate_limit))) / EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL)
+    ));
+
+    RETURN get_refill_value(rate_limit) - CEIL(get_previous_window_used(rate_limit) * overlap)::INTEGER;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- get_next_refill_at returns the next time at which the available value of the rate limit can increase.
+CREATE OR REPLACE FUNCTION get_next_refill_at(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+DECLARE
+    window_interval INTERVAL := rate_limit."window"::INTERVAL;
+BEGIN
+    IF rate_limit."mode" = 'TOKEN_BUCKET' THEN
+        IF rate_limit."value" >= COALESCE(rate_limit."burst", rate_limit."limitValue") THEN
+            RETURN NOW() + window_interval / GREATEST(rate_limit."limitValue", 1);
+        END IF;
+        RETURN rate_limit."lastRefill" + window_interval / GREATEST(rate_limit."limitValue", 1);
+    ELSIF rate_limit."mode" = 'SLIDING_WINDOW' AND rate_limit."previousWindowUsed" > 0 THEN
+        -- capacity frees up continuously while the previous window's usage decays
+        RETURN LEAST(
+            rate_limit."lastRefill" + window_interval - INTERVAL '10 milliseconds',
+            NOW() + window_interval / rate_limit."previousWindowUsed"
+        );
+    END IF;
+    RETURN rate_limit."lastRefill" + window_interval - INTERVAL '10 milliseconds';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+BEGIN
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION get_next_refill_at("RateLimit");
+DROP FUNCTION get_available_value("RateLimit");
+DROP FUNCTION get_previous_window_used("RateLimit");
+DROP FUNCTION get_refill_timestamp("RateLimit");
+
+ALTER TABLE "RateLimit"
+    DROP COLUMN "mode",
+    DROP COLUMN "burst",
+    DROP COLUMN "previousWindowUsed";
+
+DROP TYPE "RateLimitMode";
+-- +goose StatementEnd
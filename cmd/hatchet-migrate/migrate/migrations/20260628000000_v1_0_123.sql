@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "APIToken"
+    ADD COLUMN "scopes" TEXT[],
+    ADD COLUMN "workflows" TEXT[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "APIToken"
+    DROP COLUMN "scopes",
+    DROP COLUMN "workflows";
+-- +goose StatementEnd
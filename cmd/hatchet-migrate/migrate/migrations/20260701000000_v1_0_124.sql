@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "TenantRole" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT,
+    "inherits" TEXT[] NOT NULL DEFAULT '{}',
+    "permissions" TEXT[] NOT NULL DEFAULT '{}',
+
+    CONSTRAINT "TenantRole_pkey" PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX "TenantRole_tenantId_name_key" ON "TenantRole" ("tenantId" ASC, "name" ASC);
+
+ALTER TABLE "TenantRole" ADD CONSTRAINT "TenantRole_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "TenantMember" ADD COLUMN "customRoleId" UUID;
+
+ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_customRoleId_fkey" FOREIGN KEY ("customRoleId") REFERENCES "TenantRole" ("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "TenantMember" DROP COLUMN "customRoleId";
+
+DROP TABLE "TenantRole";
+-- +goose StatementEnd
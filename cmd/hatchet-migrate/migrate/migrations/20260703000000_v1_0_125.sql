@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "TenantAlertSinkKind" AS ENUM ('WEBHOOK', 'TEAMS', 'PAGERDUTY');
+
+CREATE TABLE "TenantAlertSink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "kind" "TenantAlertSinkKind" NOT NULL,
+    "name" TEXT NOT NULL,
+    -- the encrypted sink config, which contains urls, secrets and routing keys
+    "config" BYTEA NOT NULL,
+
+    CONSTRAINT "TenantAlertSink_pkey" PRIMARY KEY ("id")
+);
+
+CREATE INDEX "TenantAlertSink_tenantId_idx" ON "TenantAlertSink" ("tenantId" ASC);
+
+ALTER TABLE "TenantAlertSink" ADD CONSTRAINT "TenantAlertSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "TenantAlertSink";
+
+DROP TYPE "TenantAlertSinkKind";
+-- +goose StatementEnd
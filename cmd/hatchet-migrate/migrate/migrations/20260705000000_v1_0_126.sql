@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "TenantAlertRuleKind" AS ENUM ('QUEUE_DEPTH', 'P95_DURATION', 'FAILURE_RATE', 'CRON_MISSED');
+
+CREATE TABLE "TenantAlertRule" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "TenantAlertRuleKind" NOT NULL,
+    -- the threshold is a number of runs, a duration in seconds or a percentage, depending on the kind
+    "threshold" DOUBLE PRECISION NOT NULL,
+    -- the window which the rule is evaluated over, as a duration string
+    "window" TEXT NOT NULL DEFAULT '1h',
+    "isFiring" BOOLEAN NOT NULL DEFAULT false,
+    "lastEvaluatedAt" TIMESTAMP(3),
+    "lastFiredAt" TIMESTAMP(3),
+
+    CONSTRAINT "TenantAlertRule_pkey" PRIMARY KEY ("id")
+);
+
+CREATE INDEX "TenantAlertRule_tenantId_idx" ON "TenantAlertRule" ("tenantId" ASC);
+
+ALTER TABLE "TenantAlertRule" ADD CONSTRAINT "TenantAlertRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "TenantAlertRule" ADD CONSTRAINT "TenantAlertRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "TenantAlertRule";
+
+DROP TYPE "TenantAlertRuleKind";
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE v1_incoming_webhook_source_name ADD VALUE IF NOT EXISTS 'GITLAB';
+ALTER TYPE v1_incoming_webhook_source_name ADD VALUE IF NOT EXISTS 'BITBUCKET';
+ALTER TYPE v1_incoming_webhook_source_name ADD VALUE IF NOT EXISTS 'SHOPIFY';
+ALTER TYPE v1_incoming_webhook_source_name ADD VALUE IF NOT EXISTS 'TWILIO';
+ALTER TYPE v1_incoming_webhook_source_name ADD VALUE IF NOT EXISTS 'DISCORD';
+
+-- ED25519 isn't an HMAC algorithm, but it's used in the same way: the signing secret stores the public key
+-- which request signatures are verified against.
+ALTER TYPE v1_incoming_webhook_hmac_algorithm ADD VALUE IF NOT EXISTS 'ED25519';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- intentionally blank
+-- +goose StatementEnd
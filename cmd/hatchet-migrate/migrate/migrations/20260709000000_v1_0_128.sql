@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "WorkflowInputValidationMode" AS ENUM ('REJECT', 'WARN');
+
+ALTER TABLE "Workflow" ADD COLUMN "inputValidationMode" "WorkflowInputValidationMode" NOT NULL DEFAULT 'REJECT';
+
+ALTER TYPE v1_cel_evaluation_failure_source ADD VALUE IF NOT EXISTS 'INPUT_SCHEMA';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "Workflow" DROP COLUMN "inputValidationMode";
+
+DROP TYPE "WorkflowInputValidationMode";
+-- +goose StatementEnd
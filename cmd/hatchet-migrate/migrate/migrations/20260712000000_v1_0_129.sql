@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "WorkflowTriggerCronRefMisfirePolicy" AS ENUM ('SKIP', 'FIRE_ONCE', 'FIRE_ALL');
+
+ALTER TABLE "WorkflowTriggerCronRef"
+    ADD COLUMN "timezone" TEXT,
+    ADD COLUMN "misfirePolicy" "WorkflowTriggerCronRefMisfirePolicy" NOT NULL DEFAULT 'SKIP',
+    ADD COLUMN "misfireLimit" INTEGER,
+    ADD COLUMN "lastFiredAt" TIMESTAMP(3);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "WorkflowTriggerCronRef"
+    DROP COLUMN "timezone",
+    DROP COLUMN "misfirePolicy",
+    DROP COLUMN "misfireLimit",
+    DROP COLUMN "lastFiredAt";
+
+DROP TYPE "WorkflowTriggerCronRefMisfirePolicy";
+-- +goose StatementEnd
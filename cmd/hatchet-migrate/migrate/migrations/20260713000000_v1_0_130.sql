@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "WorkflowTriggerCronRefOverlapPolicy" AS ENUM ('ALLOW', 'SKIP_IF_RUNNING', 'CANCEL_PREVIOUS');
+
+ALTER TABLE "WorkflowTriggerCronRef"
+    ADD COLUMN "overlapPolicy" "WorkflowTriggerCronRefOverlapPolicy" NOT NULL DEFAULT 'ALLOW';
+
+CREATE TYPE v1_cron_fire_outcome AS ENUM ('TRIGGERED', 'SKIPPED_OVERLAP', 'SKIPPED_INVALID_INPUT');
+
+CREATE TABLE v1_cron_fire (
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    cron_id UUID NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    fired_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    outcome v1_cron_fire_outcome NOT NULL,
+    -- the external id of the workflow run which was triggered, NULL if the fire was skipped
+    workflow_run_external_id UUID,
+
+    CONSTRAINT v1_cron_fire_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_cron_fire_cron_id_idx ON v1_cron_fire (cron_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_cron_fire;
+
+DROP TYPE v1_cron_fire_outcome;
+
+ALTER TABLE "WorkflowTriggerCronRef" DROP COLUMN "overlapPolicy";
+
+DROP TYPE "WorkflowTriggerCronRefOverlapPolicy";
+-- +goose StatementEnd
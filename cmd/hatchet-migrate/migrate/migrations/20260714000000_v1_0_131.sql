@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_step_output_schema stores the JSON schema which the output of a step is validated against when it completes.
+CREATE TABLE v1_step_output_schema (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    output_schema JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (step_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_step_output_schema;
+-- +goose StatementEnd
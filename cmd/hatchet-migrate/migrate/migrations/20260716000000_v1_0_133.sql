@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_dead_letter_policy configures the workflow which is triggered when a workflow run fails permanently. The policy
+-- without a source workflow is the tenant default, and applies to every workflow which doesn't have its own policy.
+CREATE TABLE v1_dead_letter_policy (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    source_workflow_id UUID,
+    dead_letter_workflow_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_dead_letter_policy_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX v1_dead_letter_policy_source_idx ON v1_dead_letter_policy (
+    tenant_id,
+    COALESCE(source_workflow_id, '00000000-0000-0000-0000-000000000000'::UUID)
+);
+
+-- v1_dead_letter_run stores the failed workflow runs which haven't been sent to their dead-letter workflow yet
+CREATE TABLE v1_dead_letter_run (
+    tenant_id UUID NOT NULL,
+    workflow_run_external_id UUID NOT NULL,
+    dead_letter_workflow_name TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_dead_letter_run_pkey PRIMARY KEY (tenant_id, workflow_run_external_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_dead_letter_run;
+DROP TABLE v1_dead_letter_policy;
+-- +goose StatementEnd
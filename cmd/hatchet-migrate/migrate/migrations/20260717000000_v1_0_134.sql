@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_bulk_operation_kind AS ENUM ('CANCEL', 'REPLAY');
+
+CREATE TYPE v1_bulk_operation_status AS ENUM ('PENDING', 'RUNNING', 'COMPLETED', 'CANCELLED');
+
+-- v1_bulk_operation stores bulk cancellations and replays of the runs matching a filter, which are processed in
+-- batches by the tasks controller
+CREATE TABLE v1_bulk_operation (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_operation_kind NOT NULL,
+    status v1_bulk_operation_status NOT NULL DEFAULT 'PENDING',
+    filter JSONB NOT NULL,
+    -- the number of runs which matched the filter, NULL until the operation has started
+    total_count INTEGER,
+    processed_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+
+    CONSTRAINT v1_bulk_operation_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_bulk_operation_tenant_id_idx ON v1_bulk_operation (tenant_id, created_at DESC);
+
+CREATE INDEX v1_bulk_operation_active_idx ON v1_bulk_operation (tenant_id, created_at) WHERE status IN ('PENDING', 'RUNNING');
+
+-- v1_bulk_operation_run stores the runs matched by a bulk operation which haven't been processed yet
+CREATE TABLE v1_bulk_operation_run (
+    operation_id UUID NOT NULL,
+    workflow_run_external_id UUID NOT NULL,
+
+    CONSTRAINT v1_bulk_operation_run_pkey PRIMARY KEY (operation_id, workflow_run_external_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_bulk_operation_run;
+DROP TABLE v1_bulk_operation;
+DROP TYPE v1_bulk_operation_status;
+DROP TYPE v1_bulk_operation_kind;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "WorkflowVersion" ADD COLUMN "canaryPercentage" INTEGER;
+
+-- v1_worker_workflow_version stores the version of each workflow which a worker registered, used to route runs
+-- which are pinned to a workflow version to matching workers
+CREATE TABLE v1_worker_workflow_version (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    version TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, worker_id, workflow_id)
+);
+
+CREATE INDEX v1_worker_workflow_version_worker_id_idx ON v1_worker_workflow_version (worker_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_worker_workflow_version;
+
+ALTER TABLE "WorkflowVersion" DROP COLUMN "canaryPercentage";
+-- +goose StatementEnd
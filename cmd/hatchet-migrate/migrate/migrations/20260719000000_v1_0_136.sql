@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_workflow_retention_policy overrides how long the runs of a workflow are kept. The retention period can be set
+-- per final status of a run, for example to keep failed runs for longer than completed runs. Partitions are kept
+-- until the longest retention period has passed, and runs which expire before their partition is dropped are
+-- deleted row by row.
+CREATE TABLE v1_workflow_retention_policy (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    retention_period INTERVAL NOT NULL,
+    completed_retention_period INTERVAL,
+    failed_retention_period INTERVAL,
+    cancelled_retention_period INTERVAL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_workflow_retention_policy_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX v1_workflow_retention_policy_workflow_idx ON v1_workflow_retention_policy (tenant_id, workflow_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_workflow_retention_policy;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE v1_durable_event_log_kind ADD VALUE IF NOT EXISTS 'SIGNAL';
+
+-- v1_durable_signal stores signals which were sent to a durable task before it waited for them. A signal is consumed
+-- by the first SIGNAL entry with the same name in the durable event log of the task, which records the signal payload
+-- as its result.
+CREATE TABLE v1_durable_signal (
+    tenant_id UUID NOT NULL,
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+    durable_task_id BIGINT NOT NULL,
+    durable_task_inserted_at TIMESTAMPTZ NOT NULL,
+    name TEXT NOT NULL,
+    payload BYTEA,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT v1_durable_signal_pkey PRIMARY KEY (durable_task_id, durable_task_inserted_at, id)
+) PARTITION BY RANGE(durable_task_inserted_at);
+
+SELECT create_v1_range_partition('v1_durable_signal', NOW()::DATE);
+SELECT create_v1_range_partition('v1_durable_signal', (NOW() + INTERVAL '1 day')::DATE);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_durable_signal;
+-- +goose StatementEnd
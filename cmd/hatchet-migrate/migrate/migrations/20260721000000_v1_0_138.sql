@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- PATCH entries are version markers recorded when a durable task calls patched(), so runs which started before a code
+-- change replay the old code path while new runs take the new one.
+ALTER TYPE v1_durable_event_log_kind ADD VALUE IF NOT EXISTS 'PATCH';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- NOTE: Postgres does not support removing enum values.
+-- +goose StatementEnd
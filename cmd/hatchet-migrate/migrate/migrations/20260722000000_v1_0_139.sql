@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_resource_limit_scope AS ENUM ('WORKFLOW', 'METADATA');
+
+-- v1_scoped_resource_limit limits the usage of a resource by the runs of a workflow, or by the runs with an additional
+-- metadata key and value, on top of the limits of the tenant. Usage is counted over the window of the limit. Crossing
+-- the soft limit alerts the tenant, and triggers which would cross the hard limit are rejected.
+CREATE TABLE v1_scoped_resource_limit (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    resource "LimitResource" NOT NULL,
+    scope v1_resource_limit_scope NOT NULL,
+    workflow_id UUID,
+    metadata_key TEXT,
+    metadata_value TEXT,
+    limit_value INTEGER NOT NULL,
+    soft_limit_value INTEGER,
+    limit_window INTERVAL NOT NULL,
+    value INTEGER NOT NULL DEFAULT 0,
+    last_refill TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    soft_limit_alerted_at TIMESTAMPTZ,
+    hard_limit_alerted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_scoped_resource_limit_pkey PRIMARY KEY (id),
+    CONSTRAINT v1_scoped_resource_limit_scope_check CHECK (
+        (scope = 'WORKFLOW' AND workflow_id IS NOT NULL AND metadata_key IS NULL AND metadata_value IS NULL)
+        OR (scope = 'METADATA' AND workflow_id IS NULL AND metadata_key IS NOT NULL AND metadata_value IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX v1_scoped_resource_limit_scope_idx ON v1_scoped_resource_limit (
+    tenant_id,
+    resource,
+    scope,
+    COALESCE(workflow_id, '00000000-0000-0000-0000-000000000000'::uuid),
+    COALESCE(metadata_key, ''),
+    COALESCE(metadata_value, '')
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_scoped_resource_limit;
+DROP TYPE v1_resource_limit_scope;
+-- +goose StatementEnd
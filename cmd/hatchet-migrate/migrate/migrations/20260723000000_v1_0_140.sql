@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- REPLAY_EVENTS operations replay the events matching a filter in the background, at a limited rate
+ALTER TYPE v1_bulk_operation_kind ADD VALUE IF NOT EXISTS 'REPLAY_EVENTS';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- NOTE: Postgres does not support removing enum values.
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- SCOPED_LIMIT failures are recorded for runs which weren't triggered because they would exceed a scoped resource limit
+ALTER TYPE v1_cel_evaluation_failure_source ADD VALUE IF NOT EXISTS 'SCOPED_LIMIT';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- NOTE: Postgres does not support removing enum values.
+-- +goose StatementEnd
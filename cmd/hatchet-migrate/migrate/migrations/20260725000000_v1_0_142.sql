@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Supports listing the in-flight durable tasks which recorded a patch marker, without scanning the whole event log
+CREATE INDEX v1_durable_event_log_entry_patch_idx ON v1_durable_event_log_entry (tenant_id, idempotency_key) WHERE kind = 'PATCH';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS v1_durable_event_log_entry_patch_idx;
+-- +goose StatementEnd
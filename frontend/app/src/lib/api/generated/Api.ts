@@ -23,8 +23,12 @@ import {
   CreateEventRequest,
   CreateSNSIntegrationRequest,
   CreateTenantAlertEmailGroupRequest,
+  CreateTenantAlertRuleRequest,
+  CreateTenantAlertSinkRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CreateTenantRoleRequest,
+  CronWorkflowFireList,
   CronWorkflows,
   CronWorkflowsList,
   CronWorkflowsOrderByField,
@@ -62,6 +66,8 @@ import {
   ScheduledWorkflowsBulkUpdateResponse,
   ScheduledWorkflowsList,
   ScheduledWorkflowsOrderByField,
+  ScopedResourceLimit,
+  ScopedResourceLimitList,
   StepRun,
   StepRunArchiveList,
   StepRunEventList,
@@ -70,12 +76,18 @@ import {
   TenantAlertEmailGroup,
   TenantAlertEmailGroupList,
   TenantAlertingSettings,
+  TenantAlertRule,
+  TenantAlertRuleList,
+  TenantAlertSink,
+  TenantAlertSinkList,
   TenantInvite,
   TenantInviteList,
   TenantMember,
   TenantMemberList,
   TenantQueueMetrics,
   TenantResourcePolicy,
+  TenantRole,
+  TenantRoleList,
   TenantStepRunQueueMetrics,
   TriggerRunResult,
   TriggerWorkflowRunRequest,
@@ -85,7 +97,9 @@ import {
   UpdateTenantInviteRequest,
   UpdateTenantMemberRequest,
   UpdateTenantRequest,
+  UpdateTenantRoleRequest,
   UpdateWorkerRequest,
+  UpsertScopedResourceLimitRequest,
   User,
   UserChangePasswordRequest,
   UserLoginRequest,
@@ -93,14 +107,20 @@ import {
   UserTenantMembershipsList,
   V1BranchDurableTaskRequest,
   V1BranchDurableTaskResponse,
+  V1BulkOperation,
+  V1BulkOperationList,
   V1CELDebugRequest,
   V1CELDebugResponse,
   V1CancelTaskRequest,
   V1CancelledTasks,
+  V1CreateBulkOperationRequest,
   V1CreateFilterRequest,
   V1CreateWebhookRequest,
   V1DagChildren,
+  V1DeadLetterPolicy,
+  V1DeadLetterPolicyList,
   V1DurableEventLogList,
+  V1DurableTaskPatchMarkerList,
   V1Event,
   V1EventList,
   V1Filter,
@@ -109,10 +129,16 @@ import {
   V1LogLineList,
   V1LogLineOrderByDirection,
   V1LogsPointMetrics,
+  V1QueryTaskRequest,
+  V1QueryTaskResponse,
+  V1ReplayEventsRequest,
+  V1ReplayEventsResponse,
   V1ReplayTaskRequest,
   V1ReplayedTasks,
   V1RestoreTaskResponse,
   V1RunningFilter,
+  V1SignalTaskRequest,
+  V1SignalTaskResponse,
   V1TaskEventList,
   V1TaskPointMetrics,
   V1TaskRunMetrics,
@@ -123,10 +149,16 @@ import {
   V1TriggerWorkflowRunRequest,
   V1UpdateFilterRequest,
   V1UpdateWebhookRequest,
+  V1UpsertDeadLetterPolicyRequest,
+  V1UpsertWorkflowRetentionPolicyRequest,
   V1Webhook,
   V1WebhookList,
   V1WebhookResponse,
   V1WebhookSourceName,
+  V1WorkflowDefinition,
+  V1WorkflowDefinitionDiff,
+  V1WorkflowRetentionPolicy,
+  V1WorkflowRetentionPolicyList,
   V1WorkflowRunDetails,
   V1WorkflowRunDisplayNameList,
   V1WorkflowRunExternalIdList,
@@ -430,6 +462,54 @@ export class Api<
       ...params,
       xResources: ["tenant", "task"],
     }), { resources: new Set<string>(["tenant", "task"]) });
+  /**
+   * @description Send a named signal to a running durable task. The signal is recorded in the durable event log of the task, so replays of the task receive the same payload.
+   *
+   * @tags Task
+   * @name V1TaskSignal
+   * @summary Signal a task
+   * @request POST:/api/v1/stable/tasks/{task}/signal
+   * @secure
+   */
+  v1TaskSignal = Object.assign((
+    task: string,
+    data: V1SignalTaskRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1SignalTaskResponse, APIErrors>({
+      path: `/api/v1/stable/tasks/${task}/signal`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "task"],
+    }), { resources: new Set<string>(["tenant", "task"]) });
+  /**
+   * @description Query the current state of a running durable task, using a query handler registered by the task. Queries are not recorded in the durable event log.
+   *
+   * @tags Task
+   * @name V1TaskQuery
+   * @summary Query a task
+   * @request POST:/api/v1/stable/tasks/{task}/query
+   * @secure
+   */
+  v1TaskQuery = Object.assign((
+    task: string,
+    data: V1QueryTaskRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1QueryTaskResponse, APIErrors>({
+      path: `/api/v1/stable/tasks/${task}/query`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "task"],
+    }), { resources: new Set<string>(["tenant", "task"]) });
   /**
    * @description Lists all tasks that belong a specific list of dags
    *
@@ -655,6 +735,45 @@ export class Api<
       ...params,
       xResources: ["tenant"],
     }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Lists the in-flight durable tasks which recorded the given patch marker. Once none are left, the code path guarded by a deprecated patch can be removed.
+   *
+   * @tags Durable Tasks
+   * @name V1DurableTaskPatchList
+   * @summary List durable tasks on a patch marker
+   * @request GET:/api/v1/stable/tenants/{tenant}/durable-tasks/patches
+   * @secure
+   */
+  v1DurableTaskPatchList = Object.assign((
+    tenant: string,
+    query: {
+      /**
+       * The id of the patch
+       * @maxLength 255
+       */
+      patchId: string;
+      /**
+       * The number of tasks to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number of tasks to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DurableTaskPatchMarkerList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/durable-tasks/patches`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
   /**
    * @description Lists all event log entries for a durable task.
    *
@@ -1013,6 +1132,30 @@ export class Api<
       ...params,
       xResources: ["tenant"],
     }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Queues a bulk operation which replays every event matching the filter by ingesting it again, throttled to a maximum rate. The operation is returned unless the request is a dry run or no events match, and its progress can be followed with the bulk operation endpoints. Replayed events carry the id of the original event in the `hatchet__replayed_event_id` additional metadata key.
+   *
+   * @tags Event
+   * @name V1EventReplay
+   * @summary Replay events
+   * @request POST:/api/v1/stable/tenants/{tenant}/events/replay
+   * @secure
+   */
+  v1EventReplay = Object.assign((
+    tenant: string,
+    data: V1ReplayEventsRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1ReplayEventsResponse, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/events/replay`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
   /**
    * @description Lists all filters for a tenant.
    *
@@ -1142,6 +1285,347 @@ export class Api<
       ...params,
       xResources: ["tenant", "v1-filter"],
     }), { resources: new Set<string>(["tenant", "v1-filter"]) });
+  /**
+   * @description Lists the dead-letter policies of a tenant, with the tenant default first.
+   *
+   * @tags Dead Letter
+   * @name V1DeadLetterPolicyList
+   * @summary List dead-letter policies
+   * @request GET:/api/v1/stable/tenants/{tenant}/dead-letter-policies
+   * @secure
+   */
+  v1DeadLetterPolicyList = Object.assign((
+    tenant: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeadLetterPolicyList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/dead-letter-policies`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Sets the dead-letter workflow of a workflow, or the tenant default if no source workflow is given. An existing policy for the same source workflow is replaced.
+   *
+   * @tags Dead Letter
+   * @name V1DeadLetterPolicyUpsert
+   * @summary Set a dead-letter policy
+   * @request PUT:/api/v1/stable/tenants/{tenant}/dead-letter-policies
+   * @secure
+   */
+  v1DeadLetterPolicyUpsert = Object.assign((
+    tenant: string,
+    data: V1UpsertDeadLetterPolicyRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeadLetterPolicy, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/dead-letter-policies`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Deletes a dead-letter policy. Runs which already failed are still sent to the dead-letter workflow.
+   *
+   * @tags Dead Letter
+   * @name V1DeadLetterPolicyDelete
+   * @summary Delete a dead-letter policy
+   * @request DELETE:/api/v1/stable/tenants/{tenant}/dead-letter-policies/{v1-dead-letter-policy}
+   * @secure
+   */
+  v1DeadLetterPolicyDelete = Object.assign((
+    tenant: string,
+    v1DeadLetterPolicy: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeadLetterPolicy, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/dead-letter-policies/${v1DeadLetterPolicy}`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "v1-dead-letter-policy"],
+    }), { resources: new Set<string>(["tenant", "v1-dead-letter-policy"]) });
+  /**
+   * @description Lists the bulk operations of a tenant, most recent first.
+   *
+   * @tags Task
+   * @name V1BulkOperationList
+   * @summary List bulk operations
+   * @request GET:/api/v1/stable/tenants/{tenant}/bulk-operations
+   * @secure
+   */
+  v1BulkOperationList = Object.assign((
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1BulkOperationList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/bulk-operations`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Creates a bulk operation which cancels or replays every run matching the filter. The operation runs in the background, and its progress can be polled.
+   *
+   * @tags Task
+   * @name V1BulkOperationCreate
+   * @summary Create a bulk operation
+   * @request POST:/api/v1/stable/tenants/{tenant}/bulk-operations
+   * @secure
+   */
+  v1BulkOperationCreate = Object.assign((
+    tenant: string,
+    data: V1CreateBulkOperationRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1BulkOperation, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/bulk-operations`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Gets a bulk operation, including its progress.
+   *
+   * @tags Task
+   * @name V1BulkOperationGet
+   * @summary Get a bulk operation
+   * @request GET:/api/v1/stable/tenants/{tenant}/bulk-operations/{v1-bulk-operation}
+   * @secure
+   */
+  v1BulkOperationGet = Object.assign((
+    tenant: string,
+    v1BulkOperation: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1BulkOperation, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/bulk-operations/${v1BulkOperation}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "v1-bulk-operation"],
+    }), { resources: new Set<string>(["tenant", "v1-bulk-operation"]) });
+  /**
+   * @description Cancels a pending or running bulk operation. Runs which have already been cancelled or replayed are not affected.
+   *
+   * @tags Task
+   * @name V1BulkOperationCancel
+   * @summary Cancel a bulk operation
+   * @request POST:/api/v1/stable/tenants/{tenant}/bulk-operations/{v1-bulk-operation}/cancel
+   * @secure
+   */
+  v1BulkOperationCancel = Object.assign((
+    tenant: string,
+    v1BulkOperation: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1BulkOperation, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/bulk-operations/${v1BulkOperation}/cancel`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "v1-bulk-operation"],
+    }), { resources: new Set<string>(["tenant", "v1-bulk-operation"]) });
+  /**
+   * @description Exports the canonical definition of a workflow version, which can be reviewed or imported into another tenant.
+   *
+   * @tags Workflow
+   * @name V1WorkflowDefinitionExport
+   * @summary Export a workflow definition
+   * @request GET:/api/v1/stable/tenants/{tenant}/workflow-definitions/export
+   * @secure
+   */
+  v1WorkflowDefinitionExport = Object.assign((
+    tenant: string,
+    query: {
+      /** The name of the workflow */
+      name: string;
+      /** The version of the workflow, either a version string or a workflow version id. Defaults to the latest version. */
+      version?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowDefinition, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflow-definitions/export`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Compares the definitions of two versions of a workflow.
+   *
+   * @tags Workflow
+   * @name V1WorkflowDefinitionDiff
+   * @summary Diff workflow definitions
+   * @request GET:/api/v1/stable/tenants/{tenant}/workflow-definitions/diff
+   * @secure
+   */
+  v1WorkflowDefinitionDiff = Object.assign((
+    tenant: string,
+    query: {
+      /** The name of the workflow */
+      name: string;
+      /** The version to compare from, either a version string or a workflow version id */
+      from: string;
+      /** The version to compare to, either a version string or a workflow version id. Defaults to the latest version. */
+      to?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowDefinitionDiff, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflow-definitions/diff`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Registers a workflow definition, for example one exported from another tenant. If the definition is identical to the latest version of the workflow, no new version is created.
+   *
+   * @tags Workflow
+   * @name V1WorkflowDefinitionImport
+   * @summary Import a workflow definition
+   * @request POST:/api/v1/stable/tenants/{tenant}/workflow-definitions/import
+   * @secure
+   */
+  v1WorkflowDefinitionImport = Object.assign((
+    tenant: string,
+    data: V1WorkflowDefinition,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowDefinition, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflow-definitions/import`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Lists the retention policies of a tenant. Runs of workflows without a retention policy are kept for the default retention period.
+   *
+   * @tags Workflow
+   * @name V1WorkflowRetentionPolicyList
+   * @summary List workflow retention policies
+   * @request GET:/api/v1/stable/tenants/{tenant}/workflow-retention-policies
+   * @secure
+   */
+  v1WorkflowRetentionPolicyList = Object.assign((
+    tenant: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowRetentionPolicyList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflow-retention-policies`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Deletes the retention policy of a workflow, so its runs are kept for the default retention period again.
+   *
+   * @tags Workflow
+   * @name V1WorkflowRetentionPolicyDelete
+   * @summary Delete a workflow retention policy
+   * @request DELETE:/api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy
+   * @secure
+   */
+  v1WorkflowRetentionPolicyDelete = Object.assign((
+    tenant: string,
+    workflow: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowRetentionPolicy, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflows/${workflow}/retention-policy`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "workflow"],
+    }), { resources: new Set<string>(["tenant", "workflow"]) });
+  /**
+   * @description Gets the retention policy of a workflow.
+   *
+   * @tags Workflow
+   * @name V1WorkflowRetentionPolicyGet
+   * @summary Get a workflow retention policy
+   * @request GET:/api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy
+   * @secure
+   */
+  v1WorkflowRetentionPolicyGet = Object.assign((
+    tenant: string,
+    workflow: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowRetentionPolicy, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflows/${workflow}/retention-policy`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "workflow"],
+    }), { resources: new Set<string>(["tenant", "workflow"]) });
+  /**
+   * @description Sets how long runs of a workflow are kept for, optionally per final status. An existing retention policy for the workflow is replaced.
+   *
+   * @tags Workflow
+   * @name V1WorkflowRetentionPolicyUpsert
+   * @summary Set a workflow retention policy
+   * @request PUT:/api/v1/stable/tenants/{tenant}/workflows/{workflow}/retention-policy
+   * @secure
+   */
+  v1WorkflowRetentionPolicyUpsert = Object.assign((
+    tenant: string,
+    workflow: string,
+    data: V1UpsertWorkflowRetentionPolicyRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1WorkflowRetentionPolicy, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/workflows/${workflow}/retention-policy`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "workflow"],
+    }), { resources: new Set<string>(["tenant", "workflow"]) });
   /**
    * @description Lists all webhook for a tenant.
    *
@@ -1476,6 +1960,36 @@ export class Api<
       ...params,
       xResources: [],
     }), { resources: new Set<string>([]) });
+  /**
+   * @description Starts the OAuth flow
+   *
+   * @tags User
+   * @name UserUpdateOidcOauthStart
+   * @summary Start OAuth flow
+   * @request GET:/api/v1/users/oidc/start
+   */
+  userUpdateOidcOauthStart = Object.assign((params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/start`,
+      method: "GET",
+      ...params,
+      xResources: [],
+    }), { resources: new Set<string>([]) });
+  /**
+   * @description Completes the OAuth flow
+   *
+   * @tags User
+   * @name UserUpdateOidcOauthCallback
+   * @summary Complete OAuth flow
+   * @request GET:/api/v1/users/oidc/callback
+   */
+  userUpdateOidcOauthCallback = Object.assign((params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/callback`,
+      method: "GET",
+      ...params,
+      xResources: [],
+    }), { resources: new Set<string>([]) });
   /**
    * @description Starts the OAuth flow
    *
@@ -1671,6 +2185,183 @@ export class Api<
       ...params,
       xResources: ["tenant", "alert-email-group"],
     }), { resources: new Set<string>(["tenant", "alert-email-group"]) });
+  /**
+   * @description Creates a new tenant alert sink, which sends alerts to a webhook, Microsoft Teams or PagerDuty
+   *
+   * @tags Tenant
+   * @name AlertSinkCreate
+   * @summary Create tenant alert sink
+   * @request POST:/api/v1/tenants/{tenant}/alerting-sinks
+   * @secure
+   */
+  alertSinkCreate = Object.assign((
+    tenant: string,
+    data: CreateTenantAlertSinkRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantAlertSink, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-sinks`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Gets a list of tenant alert sinks
+   *
+   * @tags Tenant
+   * @name AlertSinkList
+   * @summary List tenant alert sinks
+   * @request GET:/api/v1/tenants/{tenant}/alerting-sinks
+   * @secure
+   */
+  alertSinkList = Object.assign((tenant: string, params: RequestParams = {}) =>
+    this.request<TenantAlertSinkList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-sinks`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Deletes a tenant alert sink
+   *
+   * @tags Tenant
+   * @name AlertSinkDelete
+   * @summary Delete tenant alert sink
+   * @request DELETE:/api/v1/alerting-sinks/{alert-sink}
+   * @secure
+   */
+  alertSinkDelete = Object.assign((alertSink: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/alerting-sinks/${alertSink}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+      xResources: ["tenant", "alert-sink"],
+    }), { resources: new Set<string>(["tenant", "alert-sink"]) });
+  /**
+   * @description Creates a new tenant alert rule, which alerts when a metric of a workflow crosses a threshold
+   *
+   * @tags Tenant
+   * @name AlertRuleCreate
+   * @summary Create tenant alert rule
+   * @request POST:/api/v1/tenants/{tenant}/alerting-rules
+   * @secure
+   */
+  alertRuleCreate = Object.assign((
+    tenant: string,
+    data: CreateTenantAlertRuleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantAlertRule, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-rules`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Gets a list of tenant alert rules
+   *
+   * @tags Tenant
+   * @name AlertRuleList
+   * @summary List tenant alert rules
+   * @request GET:/api/v1/tenants/{tenant}/alerting-rules
+   * @secure
+   */
+  alertRuleList = Object.assign((tenant: string, params: RequestParams = {}) =>
+    this.request<TenantAlertRuleList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-rules`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Deletes a tenant alert rule
+   *
+   * @tags Tenant
+   * @name AlertRuleDelete
+   * @summary Delete tenant alert rule
+   * @request DELETE:/api/v1/alerting-rules/{alert-rule}
+   * @secure
+   */
+  alertRuleDelete = Object.assign((alertRule: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/alerting-rules/${alertRule}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+      xResources: ["tenant", "alert-rule"],
+    }), { resources: new Set<string>(["tenant", "alert-rule"]) });
+  /**
+   * @description Creates a resource limit scoped to a workflow or to an additional metadata key and value, or updates the limit with the same resource and scope
+   *
+   * @tags Tenant
+   * @name ScopedResourceLimitUpsert
+   * @summary Upsert scoped resource limit
+   * @request POST:/api/v1/tenants/{tenant}/scoped-resource-limits
+   * @secure
+   */
+  scopedResourceLimitUpsert = Object.assign((
+    tenant: string,
+    data: UpsertScopedResourceLimitRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<ScopedResourceLimit, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/scoped-resource-limits`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Lists the scoped resource limits of a tenant, along with their usage in the current window
+   *
+   * @tags Tenant
+   * @name ScopedResourceLimitList
+   * @summary List scoped resource limits
+   * @request GET:/api/v1/tenants/{tenant}/scoped-resource-limits
+   * @secure
+   */
+  scopedResourceLimitList = Object.assign((tenant: string, params: RequestParams = {}) =>
+    this.request<ScopedResourceLimitList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/scoped-resource-limits`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Deletes a scoped resource limit
+   *
+   * @tags Tenant
+   * @name ScopedResourceLimitDelete
+   * @summary Delete scoped resource limit
+   * @request DELETE:/api/v1/scoped-resource-limits/{scoped-resource-limit}
+   * @secure
+   */
+  scopedResourceLimitDelete = Object.assign((scopedResourceLimit: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/scoped-resource-limits/${scopedResourceLimit}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+      xResources: ["tenant", "scoped-resource-limit"],
+    }), { resources: new Set<string>(["tenant", "scoped-resource-limit"]) });
   /**
    * @description Delete SNS integration
    *
@@ -2439,6 +3130,94 @@ export class Api<
       ...params,
       xResources: ["tenant", "member"],
     }), { resources: new Set<string>(["tenant", "member"]) });
+  /**
+   * @description Gets a list of custom roles for the tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleList
+   * @summary List tenant roles
+   * @request GET:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleList = Object.assign((tenant: string, params: RequestParams = {}) =>
+    this.request<TenantRoleList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Creates a custom role for the tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleCreate
+   * @summary Create tenant role
+   * @request POST:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleCreate = Object.assign((
+    tenant: string,
+    data: CreateTenantRoleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant"],
+    }), { resources: new Set<string>(["tenant"]) });
+  /**
+   * @description Updates a custom role of the tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleUpdate
+   * @summary Update tenant role
+   * @request PATCH:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleUpdate = Object.assign((
+    tenant: string,
+    tenantRole: string,
+    data: UpdateTenantRoleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: "PATCH",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "tenant-role"],
+    }), { resources: new Set<string>(["tenant", "tenant-role"]) });
+  /**
+   * @description Deletes a custom role of the tenant. Roles which are assigned to members cannot be deleted.
+   *
+   * @tags Tenant
+   * @name TenantRoleDelete
+   * @summary Delete tenant role
+   * @request DELETE:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleDelete = Object.assign((
+    tenant: string,
+    tenantRole: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+      xResources: ["tenant", "tenant-role"],
+    }), { resources: new Set<string>(["tenant", "tenant-role"]) });
   /**
    * @description Get an event.
    *
@@ -2950,6 +3729,41 @@ export class Api<
       ...params,
       xResources: ["tenant", "cron-workflow"],
     }), { resources: new Set<string>(["tenant", "cron-workflow"]) });
+  /**
+   * @description List the most recent fires of a cron job workflow trigger, including fires which were skipped
+   *
+   * @tags Workflow
+   * @name WorkflowCronHistoryList
+   * @summary List cron job fires
+   * @request GET:/api/v1/tenants/{tenant}/workflows/crons/{cron-workflow}/history
+   * @secure
+   */
+  workflowCronHistoryList = Object.assign((
+    tenant: string,
+    cronWorkflow: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<CronWorkflowFireList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/crons/${cronWorkflow}/history`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+      xResources: ["tenant", "cron-workflow"],
+    }), { resources: new Set<string>(["tenant", "cron-workflow"]) });
   /**
    * @description Cancel a batch of workflow runs
    *
@@ -83,6 +83,12 @@ export enum WorkflowKind {
   DAG = "DAG",
 }
 
+/** What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure. */
+export enum WorkflowInputValidationMode {
+  REJECT = "REJECT",
+  WARN = "WARN",
+}
+
 export enum StepRunEventSeverity {
   INFO = "INFO",
   WARNING = "WARNING",
@@ -152,6 +158,26 @@ export enum CronWorkflowsMethod {
   API = "API",
 }
 
+/** What to do with runs of a cron which were missed while no ticker was running. */
+export enum CronWorkflowsMisfirePolicy {
+  SKIP = "SKIP",
+  FIRE_ONCE = "FIRE_ONCE",
+  FIRE_ALL = "FIRE_ALL",
+}
+
+/** What to do when a cron fires while the run it previously triggered is still running. */
+export enum CronWorkflowsOverlapPolicy {
+  ALLOW = "ALLOW",
+  SKIP_IF_RUNNING = "SKIP_IF_RUNNING",
+  CANCEL_PREVIOUS = "CANCEL_PREVIOUS",
+}
+
+export enum CronWorkflowFireOutcome {
+  TRIGGERED = "TRIGGERED",
+  SKIPPED_OVERLAP = "SKIPPED_OVERLAP",
+  SKIPPED_INVALID_INPUT = "SKIPPED_INVALID_INPUT",
+}
+
 export enum ScheduledRunStatus {
   PENDING = "PENDING",
   RUNNING = "RUNNING",
@@ -177,6 +203,12 @@ export enum ScheduledWorkflowsMethod {
   API = "API",
 }
 
+export enum RateLimitMode {
+  FIXED_WINDOW = "FIXED_WINDOW",
+  SLIDING_WINDOW = "SLIDING_WINDOW",
+  TOKEN_BUCKET = "TOKEN_BUCKET",
+}
+
 export enum RateLimitOrderByDirection {
   Asc = "asc",
   Desc = "desc",
@@ -207,6 +239,24 @@ export enum WorkflowRunStatus {
   BACKOFF = "BACKOFF",
 }
 
+export enum TenantAlertSinkKind {
+  WEBHOOK = "WEBHOOK",
+  TEAMS = "TEAMS",
+  PAGERDUTY = "PAGERDUTY",
+}
+
+export enum TenantAlertRuleKind {
+  QUEUE_DEPTH = "QUEUE_DEPTH",
+  P95_DURATION = "P95_DURATION",
+  FAILURE_RATE = "FAILURE_RATE",
+  CRON_MISSED = "CRON_MISSED",
+}
+
+export enum ScopedResourceLimitScope {
+  WORKFLOW = "WORKFLOW",
+  METADATA = "METADATA",
+}
+
 export enum TenantMemberRole {
   OWNER = "OWNER",
   ADMIN = "ADMIN",
@@ -240,6 +290,7 @@ export enum V1WebhookHMACAlgorithm {
   SHA256 = "SHA256",
   SHA512 = "SHA512",
   MD5 = "MD5",
+  ED25519 = "ED25519",
 }
 
 export enum V1WebhookAuthType {
@@ -255,6 +306,11 @@ export enum V1WebhookSourceName {
   SLACK = "SLACK",
   LINEAR = "LINEAR",
   SVIX = "SVIX",
+  GITLAB = "GITLAB",
+  BITBUCKET = "BITBUCKET",
+  SHOPIFY = "SHOPIFY",
+  TWILIO = "TWILIO",
+  DISCORD = "DISCORD",
 }
 
 export enum TenantEnvironment {
@@ -287,12 +343,15 @@ export enum V1DurableWaitConditionKind {
   SLEEP = "SLEEP",
   USER_EVENT = "USER_EVENT",
   CHILD_WORKFLOW = "CHILD_WORKFLOW",
+  SIGNAL = "SIGNAL",
 }
 
 export enum V1DurableEventLogKind {
   RUN = "RUN",
   WAIT_FOR = "WAIT_FOR",
   MEMO = "MEMO",
+  SIGNAL = "SIGNAL",
+  PATCH = "PATCH",
 }
 
 export enum V1RunningFilter {
@@ -352,6 +411,25 @@ export enum V1TaskStatus {
   FAILED = "FAILED",
 }
 
+export enum V1BulkOperationKind {
+  CANCEL = "CANCEL",
+  REPLAY = "REPLAY",
+  REPLAY_EVENTS = "REPLAY_EVENTS",
+}
+
+export enum V1BulkOperationStatus {
+  PENDING = "PENDING",
+  RUNNING = "RUNNING",
+  COMPLETED = "COMPLETED",
+  CANCELLED = "CANCELLED",
+}
+
+export enum V1WorkflowDefinitionChangeKind {
+  ADDED = "ADDED",
+  REMOVED = "REMOVED",
+  CHANGED = "CHANGED",
+}
+
 export interface APIResourceMeta {
   /**
    * the id of this resource, in UUID format
@@ -625,6 +703,38 @@ export interface V1RestoreTaskResponse {
   requeued: boolean;
 }
 
+export interface V1SignalTaskRequest {
+  /**
+   * The name of the signal, which the task waits for by name.
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  /** The payload of the signal, which is returned to the task when it waits for the signal. */
+  payload?: object;
+}
+
+export interface V1SignalTaskResponse {
+  /** Whether the task was waiting for the signal. Otherwise the signal is buffered until the task waits for it. */
+  delivered: boolean;
+}
+
+export interface V1QueryTaskRequest {
+  /**
+   * The name of the query handler registered by the task.
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  /** The input of the query. */
+  input?: object;
+}
+
+export interface V1QueryTaskResponse {
+  /** The result returned by the query handler. */
+  result: object;
+}
+
 export interface V1DagChildren {
   /** @format uuid */
   dagId?: string;
@@ -790,6 +900,7 @@ export interface V1DurableWaitCondition {
   sleepDurationMs?: number;
   eventKey?: string;
   workflowName?: string;
+  signalName?: string;
 }
 
 export interface V1WaitItem {
@@ -798,6 +909,7 @@ export interface V1WaitItem {
   sleepDurationMs?: number;
   eventKey?: string;
   workflowName?: string;
+  signalName?: string;
   or?: V1DurableWaitCondition[];
 }
 
@@ -843,6 +955,49 @@ export interface V1DurableEventLogEntry {
 
 export type V1DurableEventLogList = V1DurableEventLogEntry[];
 
+export interface V1DurableTaskPatchMarker {
+  /**
+   * The external id of the durable task which recorded the patch marker.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  taskExternalId: string;
+  /** The display name of the durable task which recorded the patch marker. */
+  taskDisplayName: string;
+  /**
+   * The id of the workflow of the durable task.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /**
+   * The external id of the workflow run of the durable task.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowRunExternalId: string;
+  /**
+   * The node id of the patch marker in the event log.
+   * @format int64
+   */
+  nodeId: number;
+  /**
+   * The branch id of the patch marker in the event log.
+   * @format int64
+   */
+  branchId: number;
+  /**
+   * When the patch marker was recorded.
+   * @format date-time
+   */
+  recordedAt: string;
+}
+
+export type V1DurableTaskPatchMarkerList = V1DurableTaskPatchMarker[];
+
 export interface OtelSpan {
   traceId: string;
   spanId: string;
@@ -1064,6 +1219,47 @@ export interface V1EventList {
   rows?: V1Event[];
 }
 
+export interface V1ReplayEventsRequest {
+  /**
+   * A glob pattern which event keys must match, where `*` matches any characters and `?` matches a single character.
+   * @minLength 1
+   */
+  keyPattern?: string;
+  /**
+   * Replay events that occurred after this time.
+   * @format date-time
+   */
+  since: string;
+  /**
+   * Replay events that occurred before this time. Defaults to the time of the request.
+   * @format date-time
+   */
+  until?: string;
+  /** The additional metadata key-value pairs (delimited by a `:`) which events must have. */
+  additionalMetadata?: string[];
+  /** The scopes which events must have. */
+  scopes?: string[];
+  /** If true, the matching events are counted but not replayed. */
+  dryRun?: boolean;
+  /**
+   * The maximum number of events which are replayed per second. Defaults to 100.
+   * @min 1
+   * @max 1000
+   */
+  eventsPerSecond?: number;
+}
+
+export interface V1ReplayEventsResponse {
+  /**
+   * The number of events which matched the filter.
+   * @format int64
+   */
+  matchedCount: number;
+  /** Whether this was a dry run. */
+  dryRun: boolean;
+  operation?: V1BulkOperation;
+}
+
 export interface EventKeyList {
   pagination?: PaginationResponse;
   rows?: EventKey[];
@@ -1212,6 +1408,8 @@ export interface V1WebhookResponse {
   /** The message for the webhook response */
   message?: string;
   event?: V1Event;
+  /** The interaction response type, returned in response to Discord PING interactions */
+  type?: number;
   challenge?: string;
 }
 
@@ -1252,6 +1450,11 @@ export interface APIMetaAuth {
    * @example ["basic","google"]
    */
   schemes?: string[];
+  /**
+   * the name of the OIDC provider, if the oidc scheme is supported
+   * @example "Okta"
+   */
+  oidcName?: string;
 }
 
 export interface APIMetaPosthog {
@@ -1385,6 +1588,88 @@ export interface CreateTenantAlertEmailGroupRequest {
   emails: string[];
 }
 
+export interface TenantAlertSink {
+  metadata: APIResourceMeta;
+  /** The name of the alert sink */
+  name: string;
+  /** The kind of the alert sink */
+  kind: TenantAlertSinkKind;
+}
+
+export interface TenantAlertSinkList {
+  pagination?: PaginationResponse;
+  rows?: TenantAlertSink[];
+}
+
+export interface CreateTenantAlertSinkRequest {
+  /** The name of the alert sink */
+  name: string;
+  /** The kind of the alert sink */
+  kind: TenantAlertSinkKind;
+  /** The url which alerts are posted to. Required for WEBHOOK and TEAMS sinks. */
+  url?: string;
+  /** The secret used to sign the payloads of WEBHOOK sinks. */
+  secret?: string;
+  /** The PagerDuty integration key. Required for PAGERDUTY sinks. */
+  routingKey?: string;
+}
+
+export interface TenantAlertRule {
+  metadata: APIResourceMeta;
+  /** The name of the alert rule */
+  name: string;
+  /** The kind of the alert rule */
+  kind: TenantAlertRuleKind;
+  /**
+   * The id of the workflow which the rule is evaluated against
+   * @format uuid
+   */
+  workflowId: string;
+  /**
+   * The threshold of the rule. This is a number of runs for QUEUE_DEPTH rules, a number of seconds for P95_DURATION rules and a percentage for FAILURE_RATE rules. It's ignored for CRON_MISSED rules.
+   * @format double
+   */
+  threshold: number;
+  /** The window which the rule is evaluated over, as a duration string (e.g. 1h) */
+  window: string;
+  /** Whether the rule is currently firing */
+  isFiring: boolean;
+  /**
+   * The last time the rule started firing
+   * @format date-time
+   */
+  lastFiredAt?: string;
+}
+
+export interface TenantAlertRuleList {
+  pagination?: PaginationResponse;
+  rows?: TenantAlertRule[];
+}
+
+export interface CreateTenantAlertRuleRequest {
+  /** The name of the alert rule */
+  name: string;
+  /** The kind of the alert rule */
+  kind: TenantAlertRuleKind;
+  /**
+   * The id of the workflow which the rule is evaluated against
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /**
+   * The threshold of the rule. This is a number of runs for QUEUE_DEPTH rules, a number of seconds for P95_DURATION rules and a percentage for FAILURE_RATE rules. It's ignored for CRON_MISSED rules.
+   * @format double
+   */
+  threshold: number;
+  /**
+   * The window which the rule is evaluated over, as a duration string (e.g. 1h). Must be at least 1m. Queue depth is measured over runs created within the window.
+   * @default "1h"
+   */
+  window?: string;
+}
+
 export interface TenantResourceLimit {
   metadata: APIResourceMeta;
   /** The resource associated with this limit. */
@@ -1409,6 +1694,68 @@ export interface TenantResourcePolicy {
   limits: TenantResourceLimit[];
 }
 
+export interface ScopedResourceLimit {
+  metadata: APIResourceMeta;
+  /** The resource associated with this limit. */
+  resource: TenantResource;
+  /** Whether the limit applies to the runs of a workflow, or to the runs with an additional metadata key and value. */
+  scope: ScopedResourceLimitScope;
+  /**
+   * The id of the workflow of a WORKFLOW limit.
+   * @format uuid
+   */
+  workflowId?: string;
+  /** The additional metadata key of a METADATA limit. */
+  metadataKey?: string;
+  /** The additional metadata value of a METADATA limit. */
+  metadataValue?: string;
+  /** The hard limit. Triggers which would exceed it are rejected. */
+  limitValue: number;
+  /** The soft limit, which alerts the tenant once it's crossed. */
+  softLimitValue?: number;
+  /** The usage of the limit in the current window. */
+  value: number;
+  /** The window which usage is counted over, as a duration string (e.g. 24h). */
+  window: string;
+  /**
+   * The last time the usage of the limit was reset.
+   * @format date-time
+   */
+  lastRefill: string;
+}
+
+export interface ScopedResourceLimitList {
+  pagination?: PaginationResponse;
+  rows?: ScopedResourceLimit[];
+}
+
+export interface UpsertScopedResourceLimitRequest {
+  /** The resource to limit. Only TASK_RUN limits can be scoped. */
+  resource: TenantResource;
+  /** Whether the limit applies to the runs of a workflow, or to the runs with an additional metadata key and value. */
+  scope: ScopedResourceLimitScope;
+  /**
+   * The id of the workflow of a WORKFLOW limit.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId?: string;
+  /** The additional metadata key of a METADATA limit. */
+  metadataKey?: string;
+  /** The additional metadata value of a METADATA limit. Values which aren't strings are matched against their JSON encoding, so a value of 42 matches "42". */
+  metadataValue?: string;
+  /** The hard limit. Triggers which would exceed it are rejected. */
+  limitValue: number;
+  /** The soft limit, which alerts the tenant once it's crossed. Must be lower than the hard limit. */
+  softLimitValue?: number;
+  /**
+   * The window which usage is counted over, as a duration string (e.g. 24h). Must be at least 1m.
+   * @default "24h"
+   */
+  window?: string;
+}
+
 export interface UpdateTenantAlertEmailGroupRequest {
   /** A list of emails for users */
   emails: string[];
@@ -1475,6 +1822,13 @@ export interface TenantMember {
   tenant?: Tenant;
   /** Whether this membership was explicitly granted (as opposed to synced via user-group tags). Only explicit members can have their role edited or be removed. */
   manually_added?: boolean;
+  /**
+   * The id of the custom role assigned to the member. If set, the member's permissions are determined by the custom role.
+   * @format uuid
+   */
+  customRoleId?: string;
+  /** The name of the custom role assigned to the member. */
+  customRoleName?: string;
 }
 
 export interface UserTenantMembershipsList {
@@ -1597,6 +1951,10 @@ export interface APIToken {
    * @format date-time
    */
   expiresAt: string;
+  /** The operations the API token is restricted to. If empty, the token can perform any operation. */
+  scopes?: string[];
+  /** The names of the workflows the API token is restricted to. If empty, the token can access every workflow. */
+  workflows?: string[];
 }
 
 export interface ListAPITokensResponse {
@@ -1612,6 +1970,10 @@ export interface CreateAPITokenRequest {
   name: string;
   /** The duration for which the token is valid. */
   expiresIn?: string;
+  /** OpenAPI operation IDs or scope presets (read-only, trigger-only) which the token is restricted to. If empty, the token can perform any operation. */
+  scopes?: string[];
+  /** The names of the workflows the token is restricted to. If empty, the token can access every workflow. */
+  workflows?: string[];
 }
 
 export interface CreateAPITokenResponse {
@@ -1749,6 +2111,9 @@ export interface RateLimit {
    * @example "2022-12-13T15:06:48.888358-05:00"
    */
   lastRefill: string;
+  mode: RateLimitMode;
+  /** The maximum number of units which can be used in a burst. Only set for TOKEN_BUCKET rate limits. */
+  burst?: number;
 }
 
 export interface RateLimitList {
@@ -1764,6 +2129,48 @@ export interface TenantMemberList {
 export interface UpdateTenantMemberRequest {
   /** The role of the user in the tenant. */
   role: TenantMemberRole;
+  /**
+   * The id of a custom role to assign to the member. Custom roles can only be assigned to members with the MEMBER role. If omitted, any custom role is removed from the member.
+   * @format uuid
+   */
+  customRoleId?: string;
+}
+
+export interface TenantRole {
+  metadata: APIResourceMeta;
+  /** The name of the role. */
+  name: string;
+  /** A description of the role. */
+  description?: string;
+  /** The built-in roles (ADMIN or MEMBER) which this role inherits permissions from. */
+  inherits: string[];
+  /** The API operations which this role is permitted to perform. */
+  permissions: string[];
+}
+
+export interface TenantRoleList {
+  pagination?: PaginationResponse;
+  rows?: TenantRole[];
+}
+
+export interface CreateTenantRoleRequest {
+  /** The name of the role. */
+  name: string;
+  /** A description of the role. */
+  description?: string;
+  /** The built-in roles (ADMIN or MEMBER) which this role inherits permissions from. */
+  inherits?: string[];
+  /** The API operations which this role is permitted to perform. */
+  permissions?: string[];
+}
+
+export interface UpdateTenantRoleRequest {
+  /** A description of the role. */
+  description?: string;
+  /** The built-in roles (ADMIN or MEMBER) which this role inherits permissions from. */
+  inherits?: string[];
+  /** The API operations which this role is permitted to perform. */
+  permissions?: string[];
 }
 
 export interface EventData {
@@ -1779,6 +2186,8 @@ export interface Workflow {
   description?: string;
   /** Whether the workflow is paused. */
   isPaused?: boolean;
+  /** What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure. */
+  inputValidationMode?: WorkflowInputValidationMode;
   versions?: WorkflowVersionMeta[];
   /** The tags of the workflow. */
   tags?: WorkflowTag[];
@@ -1988,6 +2397,19 @@ export interface CreateCronWorkflowTriggerRequest {
    * @max 3
    */
   priority?: number;
+  /** The IANA timezone to evaluate the cron expression in, for example America/New_York. Defaults to UTC. */
+  timezone?: string;
+  /** What to do with runs of a cron which were missed while no ticker was running. */
+  misfirePolicy?: CronWorkflowsMisfirePolicy;
+  /**
+   * The maximum number of missed runs to fire with the FIRE_ALL misfire policy. Defaults to 100.
+   * @format int32
+   * @min 1
+   * @max 1000
+   */
+  misfireLimit?: number;
+  /** What to do when a cron fires while the run it previously triggered is still running. */
+  overlapPolicy?: CronWorkflowsOverlapPolicy;
 }
 
 export interface CronWorkflows {
@@ -2008,6 +2430,19 @@ export interface CronWorkflows {
    * @max 3
    */
   priority?: number;
+  /** The IANA timezone which the cron expression is evaluated in. Crons without a timezone are evaluated in UTC. */
+  timezone?: string;
+  /** What to do with runs of a cron which were missed while no ticker was running. */
+  misfirePolicy: CronWorkflowsMisfirePolicy;
+  /**
+   * The maximum number of missed runs which are fired with the FIRE_ALL misfire policy.
+   * @format int32
+   */
+  misfireLimit?: number;
+  /** @format date-time */
+  lastFiredAt?: string;
+  /** What to do when a cron fires while the run it previously triggered is still running. */
+  overlapPolicy: CronWorkflowsOverlapPolicy;
 }
 
 export interface CronWorkflowsList {
@@ -2015,6 +2450,31 @@ export interface CronWorkflowsList {
   pagination?: PaginationResponse;
 }
 
+export interface CronWorkflowFire {
+  /**
+   * The time the cron was scheduled to fire at.
+   * @format date-time
+   */
+  scheduledAt: string;
+  /**
+   * The time the cron actually fired at.
+   * @format date-time
+   */
+  firedAt: string;
+  outcome: CronWorkflowFireOutcome;
+  /**
+   * The external id of the workflow run which was triggered, if any.
+   * @format uuid
+   */
+  workflowRunId?: string;
+  workflowRunStatus?: V1TaskStatus;
+}
+
+export interface CronWorkflowFireList {
+  rows?: CronWorkflowFire[];
+  pagination?: PaginationResponse;
+}
+
 export interface UpdateCronWorkflowTriggerRequest {
   enabled?: boolean;
 }
@@ -2026,6 +2486,8 @@ export interface WorkflowRunsCancelRequest {
 export interface WorkflowUpdateRequest {
   /** Whether the workflow is paused. */
   isPaused?: boolean;
+  /** What happens when the input of a workflow run doesn't match the input JSON schema of the workflow. REJECT rejects the trigger, while WARN triggers the run and records an evaluation failure. */
+  inputValidationMode?: WorkflowInputValidationMode;
 }
 
 export interface WorkflowConcurrency {
@@ -2612,3 +3074,307 @@ export interface WebhookWorkerCreateResponse {
 }
 
 export type BulkCreateEventResponse = Events;
+
+export interface V1DeadLetterPolicy {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this dead-letter policy. */
+  tenantId: string;
+  /**
+   * The workflow whose failed runs are sent to the dead-letter workflow. If unset, the policy is the tenant default, which applies to every workflow without its own policy.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  sourceWorkflowId?: string;
+  /** The name of the workflow which is triggered for permanently failed runs. */
+  deadLetterWorkflowName: string;
+}
+
+export interface V1DeadLetterPolicyList {
+  rows?: V1DeadLetterPolicy[];
+}
+
+export interface V1UpsertDeadLetterPolicyRequest {
+  /**
+   * The workflow whose failed runs are sent to the dead-letter workflow. If unset, the tenant default policy is set.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  sourceWorkflowId?: string;
+  /** The name of the workflow which is triggered for permanently failed runs. */
+  deadLetterWorkflowName: string;
+}
+
+export interface V1BulkOperation {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this bulk operation. */
+  tenantId: string;
+  kind: V1BulkOperationKind;
+  status: V1BulkOperationStatus;
+  filter: V1TaskFilter;
+  /** The filter of a REPLAY_EVENTS operation. The filter of the operation only holds its time range and additional metadata. */
+  eventFilter?: V1EventReplayFilter;
+  /** The number of runs or events which matched the filter when the operation started. Unset while the operation is pending. */
+  totalCount?: number;
+  /** The number of runs or events which have been cancelled or replayed so far. */
+  processedCount: number;
+  /**
+   * The time at which the operation completed or was cancelled.
+   * @format date-time
+   */
+  finishedAt?: string;
+}
+
+/** The filter of a REPLAY_EVENTS operation. The filter of the operation only holds its time range and additional metadata. */
+export interface V1EventReplayFilter {
+  /** @format date-time */
+  since: string;
+  /** @format date-time */
+  until: string;
+  /** A glob pattern which event keys must match. */
+  keyPattern?: string;
+  scopes?: string[];
+  additionalMetadata?: string[];
+  /** The maximum number of events which are replayed per second. */
+  eventsPerSecond: number;
+}
+
+export interface V1BulkOperationList {
+  rows?: V1BulkOperation[];
+  pagination?: PaginationResponse;
+}
+
+export interface V1CreateBulkOperationRequest {
+  kind: V1BulkOperationKind;
+  filter: V1TaskFilter;
+}
+
+/** A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically. */
+export interface V1WorkflowDefinition {
+  /** The name of the workflow. */
+  name: string;
+  /** The version of the workflow. */
+  version?: string;
+  /** The description of the workflow. */
+  description?: string;
+  /** The sticky strategy of the workflow. */
+  sticky?: "SOFT" | "HARD";
+  /**
+   * The default priority of runs of the workflow.
+   * @format int32
+   */
+  defaultPriority?: number;
+  /**
+   * The percentage of runs which are routed to this version while it is a canary.
+   * @format int32
+   */
+  canaryPercentage?: number;
+  /** The event keys which trigger the workflow. */
+  eventTriggers?: string[];
+  /** The cron expressions which trigger the workflow. */
+  cronTriggers?: string[];
+  /** The input of runs triggered by the cron expressions. */
+  cronInput?: Record<string, any>;
+  /** The filters which are created for the workflow by default. */
+  defaultFilters?: V1WorkflowDefinitionDefaultFilter[];
+  /** The JSON schema of the input of the workflow. */
+  inputJsonSchema?: Record<string, any>;
+  /** The concurrency strategies of the workflow. */
+  concurrency?: V1WorkflowDefinitionConcurrency[];
+  tasks: V1WorkflowDefinitionTask[];
+  onFailureTask?: V1WorkflowDefinitionTask;
+}
+
+export interface V1WorkflowDefinitionTask {
+  /** The name of the task. */
+  name: string;
+  /** The action id of the task. */
+  action: string;
+  /** The names of the tasks which this task depends on. */
+  parents?: string[];
+  /** The execution timeout of the task. */
+  timeout?: string;
+  /** The scheduling timeout of the task. */
+  scheduleTimeout?: string;
+  /** The number of times the task is retried. */
+  retries?: number;
+  /**
+   * The factor the delay between retries is multiplied by.
+   * @format double
+   */
+  retryBackoffFactor?: number;
+  /** The maximum delay between retries, in seconds. */
+  retryBackoffMaxSeconds?: number;
+  /** The strategy used to compute the delay between retries. */
+  retryBackoffStrategy?: string;
+  /**
+   * The base delay between retries, in seconds.
+   * @format double
+   */
+  retryBackoffBaseSeconds?: number;
+  /** The retry rules of the task for specific error types. */
+  retryRules?: V1WorkflowDefinitionRetryRule[];
+  /** Whether the task is durable. */
+  isDurable?: boolean;
+  rateLimits?: V1WorkflowDefinitionRateLimit[];
+  concurrency?: V1WorkflowDefinitionConcurrency[];
+  /** The labels a worker should have to run the task, keyed by the label key. */
+  desiredWorkerLabels?: Record<string, V1WorkflowDefinitionWorkerLabel>;
+  /** The number of slots of each type the task uses. */
+  slotRequests?: Record<string, number>;
+  /** The conditions the task waits for before it runs. */
+  triggerConditions?: V1WorkflowDefinitionTriggerCondition[];
+  /** The JSON schema of the output of the task. */
+  outputJsonSchema?: Record<string, any>;
+}
+
+export interface V1WorkflowDefinitionConcurrency {
+  /** The CEL expression which evaluates the concurrency key. */
+  expression: string;
+  /**
+   * The maximum number of concurrent runs for each key.
+   * @format int32
+   */
+  maxRuns?: number;
+  /** The strategy used when the concurrency limit is reached. */
+  limitStrategy?: string;
+  /** The CEL expression which evaluates the weight of a concurrency key. */
+  weightExpression?: string;
+}
+
+export interface V1WorkflowDefinitionRateLimit {
+  /** The key of the rate limit. */
+  key?: string;
+  /** The CEL expression which evaluates the key of the rate limit. */
+  keyExpr?: string;
+  /** The number of units consumed. */
+  units?: number;
+  /** The CEL expression which evaluates the number of units consumed. */
+  unitsExpr?: string;
+  /** The CEL expression which evaluates the limit of the rate limit. */
+  limitExpr?: string;
+  /** The duration of the rate limit window. */
+  duration?: string;
+}
+
+export interface V1WorkflowDefinitionDefaultFilter {
+  /** The CEL expression of the filter. */
+  expression: string;
+  /** The scope of the filter. */
+  scope: string;
+  /** The payload of the filter. */
+  payload?: Record<string, any>;
+}
+
+export interface V1WorkflowDefinitionRetryRule {
+  /** The error type the rule applies to. */
+  errorType: string;
+  /**
+   * The number of times the task is retried for the error type.
+   * @format int32
+   */
+  maxRetries?: number;
+  /** The strategy used to compute the delay between retries. */
+  backoffStrategy?: string;
+  /**
+   * The base delay between retries, in seconds.
+   * @format double
+   */
+  backoffBaseSeconds?: number;
+}
+
+export interface V1WorkflowDefinitionWorkerLabel {
+  /**
+   * The integer value of the label.
+   * @format int32
+   */
+  intValue?: number;
+  /** The string value of the label. */
+  strValue?: string;
+  /** Whether a worker must have the label to run the task. */
+  required?: boolean;
+  /**
+   * The weight of the label when ranking workers.
+   * @format int32
+   */
+  weight?: number;
+  /** The comparator used to match the value of the label. */
+  comparator?: string;
+}
+
+export interface V1WorkflowDefinitionTriggerCondition {
+  /** The kind of the condition. */
+  kind: string;
+  /** The action taken when the condition is met. */
+  action: string;
+  /** The key the data of the condition is stored under. */
+  readableDataKey: string;
+  /**
+   * The group of the condition. A group is satisfied when any of its conditions is met.
+   * @format int32
+   */
+  orGroup: number;
+  /** The CEL expression of the condition. */
+  expression?: string;
+  /** The duration of a sleep condition. */
+  sleepDuration?: string;
+  /** The event key of a user event condition. */
+  eventKey?: string;
+  /** The name of the parent task of a parent override condition. */
+  parentName?: string;
+}
+
+export interface V1WorkflowDefinitionChange {
+  /** The path of the changed value, for example `tasks[charge].retries`. */
+  path: string;
+  kind: V1WorkflowDefinitionChangeKind;
+  /** The JSON encoded value before the change. Unset if the value was added. */
+  from?: string;
+  /** The JSON encoded value after the change. Unset if the value was removed. */
+  to?: string;
+}
+
+export interface V1WorkflowDefinitionDiff {
+  /** A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically. */
+  from: V1WorkflowDefinition;
+  /** A canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters and retry rules are sorted, so two definitions of the same workflow serialize identically. */
+  to: V1WorkflowDefinition;
+  changes: V1WorkflowDefinitionChange[];
+}
+
+export interface V1WorkflowRetentionPolicy {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this retention policy. */
+  tenantId: string;
+  /**
+   * The workflow whose runs are kept for the retention period.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /** How long runs of the workflow are kept for, as a duration string (e.g. 24h). */
+  retentionPeriod: string;
+  /** How long completed runs are kept for, which overrides the retention period. */
+  completedRetentionPeriod?: string;
+  /** How long failed runs are kept for, which overrides the retention period. */
+  failedRetentionPeriod?: string;
+  /** How long cancelled runs are kept for, which overrides the retention period. */
+  cancelledRetentionPeriod?: string;
+}
+
+export interface V1WorkflowRetentionPolicyList {
+  rows?: V1WorkflowRetentionPolicy[];
+}
+
+export interface V1UpsertWorkflowRetentionPolicyRequest {
+  /** How long runs of the workflow are kept for, as a duration string (e.g. 24h). Must be at least 1h. */
+  retentionPeriod: string;
+  /** How long completed runs are kept for, which overrides the retention period. Must be at least 1h. */
+  completedRetentionPeriod?: string;
+  /** How long failed runs are kept for, which overrides the retention period. Must be at least 1h. */
+  failedRetentionPeriod?: string;
+  /** How long cancelled runs are kept for, which overrides the retention period. Must be at least 1h. */
+  cancelledRetentionPeriod?: string;
+}
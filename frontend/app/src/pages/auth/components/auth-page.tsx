@@ -28,18 +28,21 @@ export function AuthPage({
   const basicEnabled = schemes.includes('basic');
   const googleEnabled = schemes.includes('google');
   const githubEnabled = schemes.includes('github');
+  const oidcEnabled = schemes.includes('oidc');
   const ssoEnabled = schemes.includes('sso');
 
   const providers = [
     googleEnabled && 'google',
     githubEnabled && 'github',
+    oidcEnabled && 'oidc',
     ssoEnabled && 'sso',
-  ].filter(Boolean) as Array<'google' | 'github' | 'sso'>;
+  ].filter(Boolean) as Array<'google' | 'github' | 'oidc' | 'sso'>;
 
   const sections = [
     providers.length > 0 && (
       <SocialAuthButtons
         providers={providers}
+        oidcName={meta?.auth?.oidcName}
         ssoExpanded={ssoExpanded}
         setSsoExpanded={setSsoExpanded}
       />
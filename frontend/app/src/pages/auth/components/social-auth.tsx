@@ -4,7 +4,7 @@ import useControlPlane from '@/hooks/use-control-plane.ts';
 import { ArrowLeft, LockOpen } from 'lucide-react';
 import React, { useState } from 'react';
 
-export type SocialAuthProvider = 'google' | 'github' | 'sso';
+export type SocialAuthProvider = 'google' | 'github' | 'oidc' | 'sso';
 
 const PROVIDER_CONFIG: Record<
   SocialAuthProvider,
@@ -20,6 +20,11 @@ const PROVIDER_CONFIG: Record<
     label: 'GitHub',
     icon: <Icons.gitHub className="size-4" />,
   },
+  oidc: {
+    href: 'users/oidc/start',
+    label: 'OIDC',
+    icon: <LockOpen className="size-4" />,
+  },
   sso: {
     href: 'users/sso/start',
     label: 'SSO',
@@ -44,10 +49,12 @@ export function OrContinueWith() {
 
 export function SocialAuthButton({
   provider,
+  label,
   ssoExpanded,
   setSsoExpanded,
 }: {
   provider: SocialAuthProvider;
+  label?: string;
   ssoExpanded: boolean;
   setSsoExpanded: any;
 }) {
@@ -135,7 +142,7 @@ export function SocialAuthButton({
           className="h-11 justify-center gap-2 border-muted-foreground/20 bg-background shadow-sm hover:bg-muted/40"
         >
           {cfg.icon}
-          {cfg.label}
+          {label || cfg.label}
         </Button>
       </a>
     )
@@ -144,10 +151,12 @@ export function SocialAuthButton({
 
 export function SocialAuthButtons({
   providers,
+  oidcName,
   ssoExpanded,
   setSsoExpanded,
 }: {
   providers: SocialAuthProvider[];
+  oidcName?: string;
   ssoExpanded: boolean;
   setSsoExpanded: any;
 }) {
@@ -160,6 +169,7 @@ export function SocialAuthButtons({
         <SocialAuthButton
           key={p}
           provider={p}
+          label={p === 'oidc' ? oidcName : undefined}
           ssoExpanded={ssoExpanded}
           setSsoExpanded={setSsoExpanded}
         />
@@ -280,6 +280,50 @@ export const AuthSetup = ({
           helpText="You can find your signing secret in the Svix dashboard under the endpoint's settings."
         />
       );
+    case V1WebhookSourceName.GITLAB:
+      return (
+        <PreconfiguredHMACAuth
+          register={register}
+          secretLabel="Secret Token"
+          helpText="Use the same value as the secret token in your GitLab webhook settings."
+          helpLink="https://docs.gitlab.com/user/project/integrations/webhooks/#validate-payloads-by-using-a-secret-token"
+        />
+      );
+    case V1WebhookSourceName.BITBUCKET:
+      return (
+        <PreconfiguredHMACAuth
+          register={register}
+          helpText="Use the same value as the secret in your Bitbucket webhook settings."
+          helpLink="https://support.atlassian.com/bitbucket-cloud/docs/manage-webhooks/#Secure-webhooks"
+        />
+      );
+    case V1WebhookSourceName.SHOPIFY:
+      return (
+        <PreconfiguredHMACAuth
+          register={register}
+          secretLabel="Client Secret"
+          helpText="You can find your client secret in the settings of your app in the Shopify Dev Dashboard."
+          helpLink="https://shopify.dev/docs/apps/build/webhooks/subscribe/https#step-2-validate-the-origin-of-your-webhook-to-ensure-its-coming-from-shopify"
+        />
+      );
+    case V1WebhookSourceName.TWILIO:
+      return (
+        <PreconfiguredHMACAuth
+          register={register}
+          secretLabel="Auth Token"
+          helpText="You can find your auth token in the Account Info panel of the Twilio Console."
+          helpLink="https://www.twilio.com/docs/usage/webhooks/webhooks-security"
+        />
+      );
+    case V1WebhookSourceName.DISCORD:
+      return (
+        <PreconfiguredHMACAuth
+          register={register}
+          secretLabel="Public Key"
+          helpText="You can find your public key in the General Information panel of your Discord application."
+          helpLink="https://discord.com/developers/docs/interactions/overview#setting-up-an-endpoint"
+        />
+      );
     default:
       const exhaustiveCheck: never = sourceName;
       throw new Error(`Unhandled source name: ${exhaustiveCheck}`);
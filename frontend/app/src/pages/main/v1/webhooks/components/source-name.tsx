@@ -2,7 +2,15 @@ import { V1WebhookSourceName } from '@/lib/api';
 import { GitHubLogoIcon } from '@radix-ui/react-icons';
 import { Webhook } from 'lucide-react';
 import { CgLinear } from 'react-icons/cg';
-import { FaSlack, FaStripeS } from 'react-icons/fa';
+import {
+  FaBitbucket,
+  FaDiscord,
+  FaGitlab,
+  FaShopify,
+  FaSlack,
+  FaStripeS,
+} from 'react-icons/fa';
+import { SiTwilio } from 'react-icons/si';
 
 const SvixLogo = ({ className }: { className?: string }) => (
   <svg
@@ -79,6 +87,41 @@ export const SourceName = ({
           Svix
         </span>
       );
+    case V1WebhookSourceName.GITLAB:
+      return (
+        <span className="flex flex-row items-center gap-x-2">
+          <FaGitlab className="size-4" />
+          GitLab
+        </span>
+      );
+    case V1WebhookSourceName.BITBUCKET:
+      return (
+        <span className="flex flex-row items-center gap-x-2">
+          <FaBitbucket className="size-4" />
+          Bitbucket
+        </span>
+      );
+    case V1WebhookSourceName.SHOPIFY:
+      return (
+        <span className="flex flex-row items-center gap-x-2">
+          <FaShopify className="size-4" />
+          Shopify
+        </span>
+      );
+    case V1WebhookSourceName.TWILIO:
+      return (
+        <span className="flex flex-row items-center gap-x-2">
+          <SiTwilio className="size-4" />
+          Twilio
+        </span>
+      );
+    case V1WebhookSourceName.DISCORD:
+      return (
+        <span className="flex flex-row items-center gap-x-2">
+          <FaDiscord className="size-4" />
+          Discord
+        </span>
+      );
     default:
       const exhaustiveCheck: never = sourceName;
       throw new Error(`Unhandled source: ${exhaustiveCheck}`);
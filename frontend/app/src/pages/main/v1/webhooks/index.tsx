@@ -335,6 +335,110 @@ const buildWebhookPayload = (data: WebhookFormData): V1CreateWebhookRequest => {
           signingSecret: data.signingSecret,
         },
       };
+    case V1WebhookSourceName.GITLAB:
+      if (!data.signingSecret) {
+        throw new Error('secret token is required for GitLab webhooks');
+      }
+
+      return {
+        ...basePayload,
+        sourceName: data.sourceName,
+        name: data.name,
+        eventKeyExpression: data.eventKeyExpression,
+        authType: V1WebhookAuthType.API_KEY,
+        auth: {
+          // GitLab sends the secret token as-is in the 'X-Gitlab-Token' header
+          // See: https://docs.gitlab.com/user/project/integrations/webhooks/#validate-payloads-by-using-a-secret-token
+          headerName: 'X-Gitlab-Token',
+          apiKey: data.signingSecret,
+        },
+      };
+    case V1WebhookSourceName.BITBUCKET:
+      if (!data.signingSecret) {
+        throw new Error('secret is required for Bitbucket webhooks');
+      }
+
+      return {
+        ...basePayload,
+        sourceName: data.sourceName,
+        name: data.name,
+        eventKeyExpression: data.eventKeyExpression,
+        authType: V1WebhookAuthType.HMAC,
+        auth: {
+          // Header name is 'X-Hub-Signature'
+          // Encoding algorithm is SHA256
+          // Encoding type is HEX
+          // See: https://support.atlassian.com/bitbucket-cloud/docs/manage-webhooks/#Secure-webhooks
+          algorithm: V1WebhookHMACAlgorithm.SHA256,
+          encoding: V1WebhookHMACEncoding.HEX,
+          signatureHeaderName: 'X-Hub-Signature',
+          signingSecret: data.signingSecret,
+        },
+      };
+    case V1WebhookSourceName.SHOPIFY:
+      if (!data.signingSecret) {
+        throw new Error('client secret is required for Shopify webhooks');
+      }
+
+      return {
+        ...basePayload,
+        sourceName: data.sourceName,
+        name: data.name,
+        eventKeyExpression: data.eventKeyExpression,
+        authType: V1WebhookAuthType.HMAC,
+        auth: {
+          // Header name is 'X-Shopify-Hmac-Sha256'
+          // Encoding algorithm is SHA256
+          // Encoding type is BASE64
+          // See: https://shopify.dev/docs/apps/build/webhooks/subscribe/https
+          algorithm: V1WebhookHMACAlgorithm.SHA256,
+          encoding: V1WebhookHMACEncoding.BASE64,
+          signatureHeaderName: 'X-Shopify-Hmac-Sha256',
+          signingSecret: data.signingSecret,
+        },
+      };
+    case V1WebhookSourceName.TWILIO:
+      if (!data.signingSecret) {
+        throw new Error('auth token is required for Twilio webhooks');
+      }
+
+      return {
+        ...basePayload,
+        sourceName: data.sourceName,
+        name: data.name,
+        eventKeyExpression: data.eventKeyExpression,
+        authType: V1WebhookAuthType.HMAC,
+        auth: {
+          // Twilio signs the request url and parameters rather than the raw
+          // body; the server-side validation implements Twilio's protocol.
+          // See: https://www.twilio.com/docs/usage/webhooks/webhooks-security
+          algorithm: V1WebhookHMACAlgorithm.SHA1,
+          encoding: V1WebhookHMACEncoding.BASE64,
+          signatureHeaderName: 'X-Twilio-Signature',
+          signingSecret: data.signingSecret,
+        },
+      };
+    case V1WebhookSourceName.DISCORD:
+      if (!data.signingSecret) {
+        throw new Error('public key is required for Discord webhooks');
+      }
+
+      return {
+        ...basePayload,
+        sourceName: data.sourceName,
+        name: data.name,
+        eventKeyExpression: data.eventKeyExpression,
+        authType: V1WebhookAuthType.HMAC,
+        auth: {
+          // Discord signs interactions with Ed25519, and the signing secret
+          // is the public key of the application.
+          // See: https://discord.com/developers/docs/interactions/overview#setting-up-an-endpoint
+          algorithm: V1WebhookHMACAlgorithm.ED25519,
+          encoding: V1WebhookHMACEncoding.HEX,
+          signatureHeaderName: 'X-Signature-Ed25519',
+          signingSecret: data.signingSecret,
+        },
+      };
     default:
       const exhaustiveCheck: never = data.sourceName;
       throw new Error(`Unhandled source name: ${exhaustiveCheck}`);
@@ -350,6 +454,11 @@ const createSourceInlineDescription = (sourceName: V1WebhookSourceName) => {
     case V1WebhookSourceName.STRIPE:
     case V1WebhookSourceName.SLACK:
     case V1WebhookSourceName.SVIX:
+    case V1WebhookSourceName.GITLAB:
+    case V1WebhookSourceName.BITBUCKET:
+    case V1WebhookSourceName.SHOPIFY:
+    case V1WebhookSourceName.TWILIO:
+    case V1WebhookSourceName.DISCORD:
       return '';
     default:
       const exhaustiveCheck: never = sourceName;
@@ -369,11 +478,35 @@ const SourceCaption = ({ sourceName }: { sourceName: V1WebhookSourceName }) => {
           </p>
         </div>
       );
+    case V1WebhookSourceName.SHOPIFY:
+      return (
+        <div className="ml-1 flex flex-row items-center gap-x-2">
+          <AlertTriangle className="size-4 text-yellow-500" />
+          <p className="text-xs text-muted-foreground">
+            Select <span className="font-semibold">JSON</span> as the format
+            of your Shopify webhook subscription.
+          </p>
+        </div>
+      );
+    case V1WebhookSourceName.DISCORD:
+      return (
+        <div className="ml-1 flex flex-row items-center gap-x-2">
+          <AlertTriangle className="size-4 text-yellow-500" />
+          <p className="text-xs text-muted-foreground">
+            Use the webhook URL as the{' '}
+            <span className="font-semibold">Interactions Endpoint URL</span> of
+            your Discord application.
+          </p>
+        </div>
+      );
     case V1WebhookSourceName.GENERIC:
     case V1WebhookSourceName.LINEAR:
     case V1WebhookSourceName.STRIPE:
     case V1WebhookSourceName.SLACK:
     case V1WebhookSourceName.SVIX:
+    case V1WebhookSourceName.GITLAB:
+    case V1WebhookSourceName.BITBUCKET:
+    case V1WebhookSourceName.TWILIO:
       return '';
     default:
       const exhaustiveCheck: never = sourceName;
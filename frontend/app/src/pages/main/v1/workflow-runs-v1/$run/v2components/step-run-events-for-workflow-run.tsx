@@ -227,6 +227,7 @@ function describeCondition(c: {
   sleepDurationMs?: number | null;
   eventKey?: string | null;
   workflowName?: string | null;
+  signalName?: string | null;
 }): string {
   switch (c.kind) {
     case V1DurableWaitConditionKind.SLEEP:
@@ -241,6 +242,10 @@ function describeCondition(c: {
       return c.workflowName
         ? `waiting for child ${c.workflowName} to complete`
         : 'waiting for child to complete';
+    case V1DurableWaitConditionKind.SIGNAL:
+      return c.signalName
+        ? `waiting for signal ${c.signalName}`
+        : 'waiting for signal';
     default:
       return String(c.kind ?? 'unknown').toLowerCase();
   }
@@ -250,6 +255,7 @@ function describeConditionCompletion(c: {
   kind?: V1DurableWaitConditionKind;
   eventKey?: string | null;
   workflowName?: string | null;
+  signalName?: string | null;
 }): string {
   switch (c.kind) {
     case V1DurableWaitConditionKind.SLEEP:
@@ -260,6 +266,10 @@ function describeConditionCompletion(c: {
       return c.workflowName
         ? `child ${c.workflowName} completed`
         : 'child completed';
+    case V1DurableWaitConditionKind.SIGNAL:
+      return c.signalName
+        ? `received signal ${c.signalName}`
+        : 'signal received';
     default:
       return 'completed';
   }
@@ -315,10 +325,15 @@ function withContextPrefix(
   entry: V1DurableEventLogEntry,
   message: string,
 ): string {
-  const kind =
-    entry.kind === V1DurableEventLogKind.WAIT_FOR
-      ? 'durable wait'
-      : 'durable run';
+  let kind = 'durable run';
+  if (
+    entry.kind === V1DurableEventLogKind.WAIT_FOR ||
+    entry.kind === V1DurableEventLogKind.SIGNAL
+  ) {
+    kind = 'durable wait';
+  } else if (entry.kind === V1DurableEventLogKind.PATCH) {
+    kind = 'durable patch';
+  }
   const branch = entry.branchId > 1 ? ` b${entry.branchId}` : '';
   return `[${kind}${branch}] ${message}`;
 }
@@ -339,6 +354,10 @@ function entryMessage(entry: V1DurableEventLogEntry): string {
     }
     return userMessage || 'spawned child';
   }
+  if (entry.kind === V1DurableEventLogKind.PATCH) {
+    // the user message of a patch marker is the patch id
+    return userMessage ? `recorded patch ${userMessage}` : 'recorded patch';
+  }
   if (userMessage) {
     return userMessage;
   }
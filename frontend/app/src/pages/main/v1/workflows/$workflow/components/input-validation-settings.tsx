@@ -0,0 +1,78 @@
+import { Label } from '@/components/v1/ui/label';
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/v1/ui/select';
+import api, { Workflow, WorkflowInputValidationMode } from '@/lib/api';
+import { useApiError } from '@/lib/hooks';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+
+function formatInputValidationMode(mode: WorkflowInputValidationMode): string {
+  switch (mode) {
+    case WorkflowInputValidationMode.REJECT:
+      return 'Reject invalid input';
+    case WorkflowInputValidationMode.WARN:
+      return 'Trigger and record a warning';
+    default: {
+      const exhaustiveCheck: never = mode;
+      return exhaustiveCheck;
+    }
+  }
+}
+
+export function InputValidationSettings({ workflow }: { workflow: Workflow }) {
+  const queryClient = useQueryClient();
+  const { handleApiError } = useApiError({});
+
+  const updateWorkflowMutation = useMutation({
+    mutationKey: ['workflow:update', workflow.metadata.id],
+    mutationFn: async (inputValidationMode: WorkflowInputValidationMode) => {
+      const res = await api.workflowUpdate(workflow.metadata.id, {
+        inputValidationMode,
+      });
+
+      return res.data;
+    },
+    onSuccess: async () => {
+      await queryClient.invalidateQueries({
+        queryKey: ['workflow:get', workflow.metadata.id],
+      });
+    },
+    onError: handleApiError,
+  });
+
+  return (
+    <div className="space-y-1">
+      <Label className="text-sm font-medium text-gray-700 dark:text-gray-300">
+        When the input doesn't match the input schema
+      </Label>
+      <Select
+        value={
+          workflow.inputValidationMode ?? WorkflowInputValidationMode.REJECT
+        }
+        onValueChange={(value) =>
+          updateWorkflowMutation.mutate(value as WorkflowInputValidationMode)
+        }
+        disabled={updateWorkflowMutation.isPending}
+      >
+        <SelectTrigger className="w-fit">
+          <SelectValue />
+        </SelectTrigger>
+        <SelectContent>
+          {Object.values(WorkflowInputValidationMode).map((mode) => (
+            <SelectItem key={mode} value={mode}>
+              {formatInputValidationMode(mode)}
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+      <p className="text-sm text-gray-500 dark:text-gray-400">
+        Applies to runs triggered by the API, events, crons and schedules, for
+        workflows which register an input schema.
+      </p>
+    </div>
+  );
+}
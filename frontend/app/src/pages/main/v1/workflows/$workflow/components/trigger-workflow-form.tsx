@@ -11,6 +11,13 @@ import {
   DialogTitle,
 } from '@/components/v1/ui/dialog';
 import { Input } from '@/components/v1/ui/input';
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/v1/ui/select';
 import { Skeleton } from '@/components/v1/ui/skeleton';
 import {
   Tabs,
@@ -21,6 +28,8 @@ import {
 import { useCurrentTenantId } from '@/hooks/use-tenant';
 import api, {
   CronWorkflows,
+  CronWorkflowsMisfirePolicy,
+  CronWorkflowsOverlapPolicy,
   queries,
   ScheduledWorkflows,
   V1WorkflowRunDetails,
@@ -37,6 +46,36 @@ import { BiDownArrowCircle } from 'react-icons/bi';
 
 type TimingOption = 'now' | 'schedule' | 'cron';
 
+function formatMisfirePolicy(policy: CronWorkflowsMisfirePolicy): string {
+  switch (policy) {
+    case CronWorkflowsMisfirePolicy.SKIP:
+      return 'Skip missed runs';
+    case CronWorkflowsMisfirePolicy.FIRE_ONCE:
+      return 'Fire the latest missed run';
+    case CronWorkflowsMisfirePolicy.FIRE_ALL:
+      return 'Fire all missed runs';
+    default: {
+      const exhaustiveCheck: never = policy;
+      return exhaustiveCheck;
+    }
+  }
+}
+
+function formatOverlapPolicy(policy: CronWorkflowsOverlapPolicy): string {
+  switch (policy) {
+    case CronWorkflowsOverlapPolicy.ALLOW:
+      return 'Allow overlapping runs';
+    case CronWorkflowsOverlapPolicy.SKIP_IF_RUNNING:
+      return 'Skip if the previous run is still running';
+    case CronWorkflowsOverlapPolicy.CANCEL_PREVIOUS:
+      return 'Cancel the previous run';
+    default: {
+      const exhaustiveCheck: never = policy;
+      return exhaustiveCheck;
+    }
+  }
+}
+
 export function TriggerWorkflowForm({
   defaultWorkflow,
   show,
@@ -63,6 +102,11 @@ export function TriggerWorkflowForm({
   );
   const [cronExpression, setCronExpression] = useState<string>('* * * * *');
   const [cronName, setCronName] = useState<string>('');
+  const [cronTimezone, setCronTimezone] = useState<string>('');
+  const [cronMisfirePolicy, setCronMisfirePolicy] =
+    useState<CronWorkflowsMisfirePolicy>(CronWorkflowsMisfirePolicy.SKIP);
+  const [cronOverlapPolicy, setCronOverlapPolicy] =
+    useState<CronWorkflowsOverlapPolicy>(CronWorkflowsOverlapPolicy.ALLOW);
 
   const [selectedWorkflowId, setSelectedWorkflowId] = useState(
     defaultWorkflow?.metadata.id,
@@ -107,6 +151,9 @@ export function TriggerWorkflowForm({
     setScheduleTime(new Date());
     setCronExpression('* * * * *');
     setCronName('');
+    setCronTimezone('');
+    setCronMisfirePolicy(CronWorkflowsMisfirePolicy.SKIP);
+    setCronOverlapPolicy(CronWorkflowsOverlapPolicy.ALLOW);
     setWorkflowSearch('');
     setDebouncedWorkflowSearch('');
     debouncedSetSearch.cancel();
@@ -233,6 +280,9 @@ export function TriggerWorkflowForm({
       addlMeta: object;
       cron: string;
       cronName: string;
+      timezone?: string;
+      misfirePolicy: CronWorkflowsMisfirePolicy;
+      overlapPolicy: CronWorkflowsOverlapPolicy;
     }) => {
       if (!selectedWorkflow || !selectedWorkflow.workflow) {
         return;
@@ -246,6 +296,9 @@ export function TriggerWorkflowForm({
           additionalMetadata: data.addlMeta,
           cronName: data.cronName,
           cronExpression: data.cron,
+          timezone: data.timezone,
+          misfirePolicy: data.misfirePolicy,
+          overlapPolicy: data.overlapPolicy,
         },
       );
 
@@ -304,6 +357,9 @@ export function TriggerWorkflowForm({
         addlMeta: addlMetaObj,
         cron: cronExpression,
         cronName: cronName,
+        timezone: cronTimezone.trim() || undefined,
+        misfirePolicy: cronMisfirePolicy,
+        overlapPolicy: cronOverlapPolicy,
       });
     }
   };
@@ -488,6 +544,58 @@ export function TriggerWorkflowForm({
                 <div className="text-sm text-gray-500">
                   {cronPretty?.error || `(runs ${cronPretty?.pretty})`}
                 </div>
+                <div className="mb-2 mt-4 font-bold">Timezone</div>
+                <Input
+                  type="text"
+                  value={cronTimezone}
+                  onChange={(e) => setCronTimezone(e.target.value)}
+                  placeholder="UTC (e.g., America/New_York)"
+                  className="w-full"
+                />
+                <div className="mb-2 mt-4 font-bold">Missed Runs</div>
+                <Select
+                  value={cronMisfirePolicy}
+                  onValueChange={(value) =>
+                    setCronMisfirePolicy(value as CronWorkflowsMisfirePolicy)
+                  }
+                >
+                  <SelectTrigger className="w-full">
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {Object.values(CronWorkflowsMisfirePolicy).map((policy) => (
+                      <SelectItem key={policy} value={policy}>
+                        {formatMisfirePolicy(policy)}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+                <div className="text-sm text-gray-500">
+                  What to do with runs which were missed while the engine was
+                  unavailable.
+                </div>
+                <div className="mb-2 mt-4 font-bold">Overlapping Runs</div>
+                <Select
+                  value={cronOverlapPolicy}
+                  onValueChange={(value) =>
+                    setCronOverlapPolicy(value as CronWorkflowsOverlapPolicy)
+                  }
+                >
+                  <SelectTrigger className="w-full">
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {Object.values(CronWorkflowsOverlapPolicy).map((policy) => (
+                      <SelectItem key={policy} value={policy}>
+                        {formatOverlapPolicy(policy)}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+                <div className="text-sm text-gray-500">
+                  What to do when the cron fires while its previous run is
+                  still running.
+                </div>
               </div>
             </TabsContent>
           </Tabs>
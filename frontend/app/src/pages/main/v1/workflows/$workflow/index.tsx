@@ -2,6 +2,7 @@ import { RunsTable } from '../../workflow-runs-v1/components/runs-table';
 import { workflowKey } from '../../workflow-runs-v1/components/v1/task-runs-columns';
 import { RunsProvider } from '../../workflow-runs-v1/hooks/runs-provider';
 import { WorkflowTags } from '../components/workflow-tags';
+import { InputValidationSettings } from './components/input-validation-settings';
 import { TriggerWorkflowForm } from './components/trigger-workflow-form';
 import WorkflowGeneralSettings from './components/workflow-general-settings';
 import { ConfirmDialog } from '@/components/v1/molecules/confirm-dialog';
@@ -174,6 +175,15 @@ export default function ExpandedWorkflow() {
               <WorkflowGeneralSettings workflow={workflowVersionQuery.data} />
             )}
 
+            <div className="mt-8 space-y-3">
+              <h3 className="border-b border-gray-200 pb-2 text-base font-semibold text-gray-900 dark:border-gray-700 dark:text-gray-100">
+                Input Validation
+              </h3>
+              <div className="pl-1">
+                <InputValidationSettings workflow={workflow} />
+              </div>
+            </div>
+
             <div className="mt-8">
               <div className="space-y-3">
                 <h3 className="border-b border-gray-200 pb-2 text-base font-semibold text-gray-900 dark:border-gray-700 dark:text-gray-100">
@@ -34,6 +34,11 @@ export default {
     },
   },
   "worker-configuration-options": "Worker Configuration Options",
+  "scoped-api-tokens": "Scoped API Tokens",
+  "custom-roles": "Custom Roles",
+  oidc: "OIDC Login",
+  "alert-sinks": "Alert Sinks",
+  "alert-rules": "Alert Rules",
   "upgrading-downgrading": "Upgrading and Downgrading",
   "downgrading-db-schema-manually": "Downgrading DB Schema Manually",
   benchmarking: "Benchmarking",
@@ -32,12 +32,14 @@ export default {
   timeouts: "Timeouts",
   cancellation: "Cancellation",
   "bulk-retries-and-cancellations": "Bulk Retries & Cancellations",
+  "dead-letter-workflows": "Dead-Letter Workflows",
   "--flow-control": {
     title: "Flow Control",
     type: "separator",
   },
   concurrency: "Concurrency",
   "rate-limits": "Rate Limits",
+  "scoped-resource-limits": "Scoped Resource Limits",
   priority: "Priority",
   "--durable-workflows-section": {
     title: "Durable Execution",
@@ -47,6 +49,8 @@ export default {
   "child-spawning": "Child Spawning",
   "durable-sleep": "Sleeps",
   "durable-event-waits": "Event Waits",
+  "durable-signals": "Signals and Queries",
+  "durable-versioning": "Versioning",
   "task-eviction": "Task Eviction",
   "directed-acyclic-graphs": "DAGs as Durable Workflows",
   "--workers-section": {
@@ -55,6 +59,7 @@ export default {
   },
   docker: "Running with Docker",
   "autoscaling-workers": "Autoscaling Workers",
+  "workflow-versioning": "Workflow Versioning",
   "advanced-assignment": {
     title: "Advanced Assignment",
     theme: { collapsed: true },
@@ -17,10 +17,11 @@ import (
 )
 
 type CELParser struct {
-	workflowStrEnv     *cel.Env
-	stepRunEnv         *cel.Env
-	eventEnv           *cel.Env
-	incomingWebhookEnv *cel.Env
+	workflowStrEnv       *cel.Env
+	stepRunEnv           *cel.Env
+	eventEnv             *cel.Env
+	incomingWebhookEnv   *cel.Env
+	concurrencyWeightEnv *cel.Env
 }
 
 var checksumDecl = decls.NewFunction("checksum",
@@ -92,11 +93,23 @@ func NewCELParser() *CELParser {
 		),
 	)
 
+	concurrencyWeightEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("key", decls.String),
+			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+			checksumDecl,
+		),
+		checksum,
+		ext.Strings(),
+	)
+
 	return &CELParser{
-		workflowStrEnv:     workflowStrEnv,
-		stepRunEnv:         stepRunEnv,
-		eventEnv:           eventEnv,
-		incomingWebhookEnv: incomingWebhookEnv,
+		workflowStrEnv:       workflowStrEnv,
+		stepRunEnv:           stepRunEnv,
+		eventEnv:             eventEnv,
+		incomingWebhookEnv:   incomingWebhookEnv,
+		concurrencyWeightEnv: concurrencyWeightEnv,
 	}
 }
 
@@ -134,6 +147,12 @@ func WithWorkflowRunID(workflowRunID uuid.UUID) InputOpts {
 	}
 }
 
+func WithConcurrencyKey(key string) InputOpts {
+	return func(w Input) {
+		w["key"] = key
+	}
+}
+
 func WithPayload(payload map[string]interface{}) InputOpts {
 	return func(w Input) {
 		w["payload"] = payload
@@ -397,3 +416,53 @@ func (p *CELParser) EvaluateIncomingWebhookExpression(expr string, input Input)
 
 	return out.Value().(string), nil
 }
+
+// ParseConcurrencyWeight compiles an expression which determines the weight of a concurrency key for
+// the WEIGHTED_FAIR strategy. The expression has access to the key as `key`, and to the input and additional
+// metadata of a task queued for the key as `input` and `additional_metadata`.
+func (p *CELParser) ParseConcurrencyWeight(expr string) (cel.Program, error) {
+	ast, issues := p.concurrencyWeightEnv.Compile(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", issues.Err())
+	}
+
+	if ast.OutputType() != cel.IntType && ast.OutputType() != cel.DoubleType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a number: got %s", ast.OutputType())
+	}
+
+	return p.concurrencyWeightEnv.Program(ast)
+}
+
+// EvaluateConcurrencyWeight evaluates a program returned by ParseConcurrencyWeight for a concurrency key and
+// the input and additional metadata of a task queued for it. Nil maps are passed as empty maps, so expressions
+// can check for fields with has().
+func (p *CELParser) EvaluateConcurrencyWeight(prg cel.Program, key string, input, additionalMetadata map[string]interface{}) (int, error) {
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+
+	if additionalMetadata == nil {
+		additionalMetadata = map[string]interface{}{}
+	}
+
+	var inMap map[string]interface{} = NewInput(
+		WithConcurrencyKey(key),
+		WithInput(input),
+		WithAdditionalMetadata(additionalMetadata),
+	)
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return 0, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	switch out.Type() {
+	case types.IntType:
+		return int(out.Value().(int64)), nil
+	case types.DoubleType:
+		return int(out.Value().(float64)), nil
+	default:
+		return 0, fmt.Errorf("expression did not evaluate to a number: got %s", out.Type().TypeName())
+	}
+}
@@ -177,3 +177,92 @@ func TestCELParserEventExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestCELParserConcurrencyWeight(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	tests := []struct {
+		expression         string
+		key                string
+		input              map[string]interface{}
+		additionalMetadata map[string]interface{}
+		expected           int
+		compileError       bool
+		expectError        bool
+	}{
+		{
+			expression: `key.startsWith("enterprise:") ? 5 : 1`,
+			key:        "enterprise:acme",
+			expected:   5,
+		},
+		{
+			expression: `key.startsWith("enterprise:") ? 5 : 1`,
+			key:        "free:acme",
+			expected:   1,
+		},
+		{
+			expression: `{"gold": 3, "silver": 2}[key.split(":")[0]]`,
+			key:        "silver:acme",
+			expected:   2,
+		},
+		{
+			expression: `2.5`,
+			key:        "any",
+			expected:   2,
+		},
+		{
+			expression:   `key + "-suffix"`, // string output is rejected at compile time
+			key:          "any",
+			compileError: true,
+		},
+		{
+			expression:         `additional_metadata.tier == "gold" ? 3 : 1`,
+			key:                "acme",
+			additionalMetadata: map[string]interface{}{"tier": "gold"},
+			expected:           3,
+		},
+		{
+			expression: `input.seats / 10`,
+			key:        "acme",
+			input:      map[string]interface{}{"seats": 40},
+			expected:   4,
+		},
+		{
+			expression: `has(additional_metadata.tier) ? 3 : 1`, // missing metadata is an empty map
+			key:        "acme",
+			expected:   1,
+		},
+		{
+			expression:   `parents.step.weight`, // parents aren't available in this environment
+			key:          "any",
+			compileError: true,
+		},
+		{
+			expression:  `{"gold": 3}[key]`, // missing map key fails at evaluation time
+			key:         "bronze",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			prg, err := parser.ParseConcurrencyWeight(tt.expression)
+
+			if tt.compileError {
+				assert.Error(t, err, "Expected compile error but got none")
+				return
+			}
+
+			assert.NoError(t, err, "Did not expect compile error but got one")
+
+			result, err := parser.EvaluateConcurrencyWeight(prg, tt.key, tt.input, tt.additionalMetadata)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
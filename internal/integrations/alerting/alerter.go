@@ -3,6 +3,7 @@ package alerting
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/email"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 
 	"github.com/hatchet-dev/timediff"
@@ -22,10 +24,11 @@ type TenantAlertManager struct {
 	enc         encryption.EncryptionService
 	frontendURL string
 	email       email.EmailService
+	httpClient  *http.Client
 }
 
 func New(repo v1.Repository, e encryption.EncryptionService, frontendURL string, email email.EmailService) *TenantAlertManager {
-	return &TenantAlertManager{repo, e, frontendURL, email}
+	return &TenantAlertManager{repo, e, frontendURL, email, newAlertSinkHTTPClient()}
 }
 
 func (t *TenantAlertManager) SendWorkflowRunAlertV1(tenantId uuid.UUID, failedRuns []*v1.WorkflowRunData) error {
@@ -59,15 +62,11 @@ func (t *TenantAlertManager) SendWorkflowRunAlertV1(tenantId uuid.UUID, failedRu
 		return fmt.Errorf("could not update tenant alerting settings: %w", err)
 	}
 
-	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackWorkflowRunAlert(slackWebhook, len(failedRuns), failedItems); innerErr != nil {
-			err = multierror.Append(err, innerErr)
-		}
-	}
+	sinks, err := t.sinks(tenantAlerting)
 
-	for _, emailGroup := range tenantAlerting.EmailGroups {
-		if innerErr := t.sendEmailWorkflowRunAlert(tenantAlerting.Tenant, emailGroup, len(failedRuns), failedItems); innerErr != nil {
+	// iterate through possible alerters
+	for _, sink := range sinks {
+		if innerErr := sink.SendWorkflowRunAlert(ctx, tenantAlerting.Tenant, len(failedRuns), failedItems); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
@@ -131,17 +130,11 @@ func (t *TenantAlertManager) sendExpiringTokenAlert(ctx context.Context, tenantA
 		return nil
 	}
 
-	var err error
+	sinks, err := t.sinks(tenantAlerting)
 
 	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackExpiringTokenAlert(slackWebhook, payload); innerErr != nil {
-			err = multierror.Append(err, innerErr)
-		}
-	}
-
-	for _, emailGroup := range tenantAlerting.EmailGroups {
-		if innerErr := t.sendEmailExpiringTokenAlert(tenantAlerting.Tenant, emailGroup, payload); innerErr != nil {
+	for _, sink := range sinks {
+		if innerErr := sink.SendExpiringTokenAlert(ctx, tenantAlerting.Tenant, payload); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
@@ -173,13 +166,7 @@ func (t *TenantAlertManager) SendTenantResourceLimitAlert(tenantId uuid.UUID, al
 	window := ""
 
 	if state.Window.Valid {
-		switch state.Window.String {
-		case "24h0m0s":
-			window = "daily"
-		default:
-			window = state.Window.String
-
-		}
+		window = limitWindowName(state.Window.String)
 	}
 
 	payload := &alerttypes.ResourceLimitAlert{
@@ -196,26 +183,134 @@ func (t *TenantAlertManager) SendTenantResourceLimitAlert(tenantId uuid.UUID, al
 	return t.sendTenantResourceLimitAlert(ctx, tenantAlerting, payload)
 }
 
+// SendScopedResourceLimitAlert sends an alert for a scoped resource limit whose usage crossed its soft or hard limit.
+func (t *TenantAlertManager) SendScopedResourceLimitAlert(tenantId uuid.UUID, alert *sqlcv1.PollScopedResourceLimitAlertsRow) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tenantAlerting, err := t.repo.TenantAlertingSettings().GetTenantAlertingSettings(ctx, tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	limit := alert.LimitValue
+
+	// the alarm is relative to the soft limit, which is what was crossed
+	if alert.AlertType == sqlcv1.TenantResourceLimitAlertTypeAlarm && alert.SoftLimitValue.Valid {
+		limit = alert.SoftLimitValue.Int32
+	}
+
+	scope := fmt.Sprintf("metadata %s=%s", alert.MetadataKey.String, alert.MetadataValue.String)
+
+	if alert.Scope == sqlcv1.V1ResourceLimitScopeWORKFLOW {
+		scope = fmt.Sprintf("workflow %s", alert.WorkflowName)
+	}
+
+	payload := &alerttypes.ResourceLimitAlert{
+		Link:          fmt.Sprintf("%s/tenants/%s/settings/billing-and-limits", t.frontendURL, tenantId),
+		Resource:      string(alert.Resource),
+		AlertType:     string(alert.AlertType),
+		CurrentValue:  int(alert.Value),
+		LimitValue:    int(limit),
+		Percentage:    int(float64(alert.Value) / float64(limit) * 100),
+		LimitWindow:   limitWindowName(sqlchelpers.PgIntervalToDuration(alert.LimitWindow).String()),
+		LastRefillAgo: timediff.TimeDiff(alert.LastRefill.Time),
+		Scope:         scope,
+	}
+
+	return t.sendTenantResourceLimitAlert(ctx, tenantAlerting, payload)
+}
+
+func limitWindowName(window string) string {
+	switch window {
+	case "24h0m0s":
+		return "daily"
+	default:
+		return window
+	}
+}
+
 func (t *TenantAlertManager) sendTenantResourceLimitAlert(ctx context.Context, tenantAlerting *v1.GetTenantAlertingSettingsResponse, payload *alerttypes.ResourceLimitAlert) error {
 
 	if !tenantAlerting.Settings.EnableExpiringTokenAlerts {
 		return nil
 	}
 
-	var err error
+	sinks, err := t.sinks(tenantAlerting)
 
 	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackTenantResourceLimitAlert(slackWebhook, payload); innerErr != nil {
+	for _, sink := range sinks {
+		if innerErr := sink.SendTenantResourceLimitAlert(ctx, tenantAlerting.Tenant, payload); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
 
-	for _, emailGroup := range tenantAlerting.EmailGroups {
-		if innerErr := t.sendEmailTenantResourceLimitAlert(tenantAlerting.Tenant, emailGroup, payload); innerErr != nil {
+	return nil
+}
+
+// SendAlertRuleAlert sends an alert for a tenant alert rule which has started firing. The value is the
+// metric which the rule was evaluated against.
+func (t *TenantAlertManager) SendAlertRuleAlert(tenantId uuid.UUID, rule *sqlcv1.TenantAlertRule, workflowName string, value float64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// read in the tenant alerting settings and determine if we should alert
+	tenantAlerting, err := t.repo.TenantAlertingSettings().GetTenantAlertingSettings(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant alerting settings: %w", err)
+	}
+
+	payload := &alerttypes.AlertRuleItem{
+		Link:         fmt.Sprintf("%s/tenants/%s/workflows/%s", t.frontendURL, tenantId, rule.WorkflowId),
+		RuleId:       rule.ID.String(),
+		RuleName:     rule.Name,
+		Kind:         string(rule.Kind),
+		WorkflowName: workflowName,
+		Summary:      alertRuleSummary(rule, workflowName, value),
+		Value:        value,
+		Threshold:    rule.Threshold,
+		Window:       rule.Window,
+	}
+
+	sinks, err := t.sinks(tenantAlerting)
+
+	// iterate through possible alerters
+	for _, sink := range sinks {
+		if innerErr := sink.SendAlertRuleAlert(ctx, tenantAlerting.Tenant, payload); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
 
+	if err != nil {
+		return fmt.Errorf("could not send alert rule alert: %w", err)
+	}
+
 	return nil
 }
+
+func alertRuleSummary(rule *sqlcv1.TenantAlertRule, workflowName string, value float64) string {
+	switch rule.Kind {
+	case sqlcv1.TenantAlertRuleKindQUEUEDEPTH:
+		return fmt.Sprintf("%s has %d queued runs, above the threshold of %d", workflowName, int64(value), int64(rule.Threshold))
+	case sqlcv1.TenantAlertRuleKindP95DURATION:
+		return fmt.Sprintf(
+			"The p95 duration of %s runs is %s over the last %s, above the threshold of %s",
+			workflowName,
+			secondsToDuration(value),
+			rule.Window,
+			secondsToDuration(rule.Threshold),
+		)
+	case sqlcv1.TenantAlertRuleKindFAILURERATE:
+		return fmt.Sprintf("%.1f%% of %s runs failed over the last %s, above the threshold of %.1f%%", value, workflowName, rule.Window, rule.Threshold)
+	case sqlcv1.TenantAlertRuleKindCRONMISSED:
+		return fmt.Sprintf("%s has had no successful cron runs in the last %s", workflowName, rule.Window)
+	default:
+		return fmt.Sprintf("%s is at %.2f, above the threshold of %.2f", workflowName, value, rule.Threshold)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second)
+}
@@ -0,0 +1,13 @@
+package alerttypes
+
+type AlertRuleItem struct {
+	Link         string  `json:"link"`
+	RuleId       string  `json:"rule_id"`
+	RuleName     string  `json:"rule_name"`
+	Kind         string  `json:"kind"`
+	WorkflowName string  `json:"workflow_name"`
+	Summary      string  `json:"summary"`
+	Value        float64 `json:"value"`
+	Threshold    float64 `json:"threshold"`
+	Window       string  `json:"window"`
+}
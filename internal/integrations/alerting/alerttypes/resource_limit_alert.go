@@ -9,4 +9,7 @@ type ResourceLimitAlert struct {
 	Percentage    int    `json:"percentage"`
 	LastRefillAgo string `json:"refill_date_time"`
 	LimitWindow   string `json:"limit_window"`
+
+	// Scope is the scope of a scoped resource limit, e.g. workflow process-order, and is empty for tenant limits
+	Scope string `json:"scope,omitempty"`
 }
@@ -74,6 +74,10 @@ func (t *TenantAlertManager) sendEmailTenantResourceLimitAlert(tenant *sqlcv1.Te
 	resource := strings.ReplaceAll(strings.ToLower(payload.Resource), "_", " ")
 	resource = cases.Title(language.English).String(resource)
 
+	if payload.Scope != "" {
+		resource = fmt.Sprintf("%s (%s)", resource, payload.Scope)
+	}
+
 	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeAlarm) {
 		subject = fmt.Sprintf("%s has exhausted %d%% of its %s limit (%d/%d)", resource, payload.Percentage, payload.LimitWindow, payload.CurrentValue, payload.LimitValue)
 		summary = "We're sending you this alert because a resource on your Hatchet tenant is approaching its usage limit."
@@ -81,7 +85,7 @@ func (t *TenantAlertManager) sendEmailTenantResourceLimitAlert(tenant *sqlcv1.Te
 	}
 
 	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeExhausted) {
-		subject = fmt.Sprintf("%s has exhausted 100%% of its %s limit (%d/%d)", payload.Resource, payload.LimitWindow, payload.CurrentValue, payload.LimitValue)
+		subject = fmt.Sprintf("%s has exhausted 100%% of its %s limit (%d/%d)", resource, payload.LimitWindow, payload.CurrentValue, payload.LimitValue)
 		summary = "We're sending you this alert because a resource on your Hatchet tenant has exhausted its usage limit."
 		summary2 = fmt.Sprintf("Any further resource usage will be denied until the limit is increased or its refill window is reached. Last refilled %s.", payload.LastRefillAgo)
 	}
@@ -104,3 +108,22 @@ func (t *TenantAlertManager) sendEmailTenantResourceLimitAlert(tenant *sqlcv1.Te
 		},
 	)
 }
+
+func (t *TenantAlertManager) sendEmailAlertRuleAlert(tenant *sqlcv1.Tenant, emailGroup *v1.TenantAlertEmailGroupForSend, payload *alerttypes.AlertRuleItem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return t.email.SendAlertRuleAlert(
+		ctx,
+		emailGroup.Emails,
+		email.AlertRuleEmailData{
+			TenantName:   tenant.Name,
+			Subject:      fmt.Sprintf("Alert rule %s is firing", payload.RuleName),
+			Summary:      payload.Summary,
+			RuleName:     payload.RuleName,
+			WorkflowName: payload.WorkflowName,
+			Link:         payload.Link,
+			SettingsLink: fmt.Sprintf("%s/tenants/%s/settings/alerting", t.frontendURL, tenant.ID.String()),
+		},
+	)
+}
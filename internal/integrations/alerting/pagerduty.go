@@ -0,0 +1,159 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutyEvent is an event in the PagerDuty Events API v2 format.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key,omitempty"`
+	Payload     pagerDutyPayload `json:"payload"`
+	Links       []pagerDutyLink  `json:"links,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string      `json:"summary"`
+	Source        string      `json:"source"`
+	Severity      string      `json:"severity"`
+	Group         string      `json:"group,omitempty"`
+	Class         string      `json:"class,omitempty"`
+	CustomDetails interface{} `json:"custom_details,omitempty"`
+}
+
+type pagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// pagerDutySink triggers PagerDuty incidents through the Events API v2.
+type pagerDutySink struct {
+	client     *http.Client
+	url        string
+	routingKey string
+}
+
+func newPagerDutySink(client *http.Client, url, routingKey string) *pagerDutySink {
+	return &pagerDutySink{
+		client:     client,
+		url:        url,
+		routingKey: routingKey,
+	}
+}
+
+func (p *pagerDutySink) SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	links := make([]pagerDutyLink, 0, len(failedRuns))
+
+	for _, workflowRun := range failedRuns {
+		links = append(links, pagerDutyLink{
+			Href: workflowRun.Link,
+			Text: workflowRun.WorkflowRunReadableId,
+		})
+	}
+
+	return p.send(ctx, &pagerDutyEvent{
+		Payload: pagerDutyPayload{
+			Summary:  workflowRunAlertSummary(numFailed),
+			Severity: "error",
+			Group:    tenant.Name,
+			Class:    WebhookEventWorkflowRunFailed,
+			CustomDetails: &WebhookWorkflowRunFailedData{
+				NumFailed:  numFailed,
+				FailedRuns: failedRuns,
+			},
+		},
+		Links: links,
+	})
+}
+
+func (p *pagerDutySink) SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error {
+	return p.send(ctx, &pagerDutyEvent{
+		// alert once per token, even if the token alert is sent multiple times
+		DedupKey: fmt.Sprintf("hatchet-%s-token-%s", tenant.ID, payload.TokenName),
+		Payload: pagerDutyPayload{
+			Summary:       expiringTokenAlertSummary(payload),
+			Severity:      "warning",
+			Group:         tenant.Name,
+			Class:         WebhookEventTokenExpiring,
+			CustomDetails: payload,
+		},
+		Links: []pagerDutyLink{
+			{
+				Href: payload.Link,
+				Text: "Manage Tokens",
+			},
+		},
+	})
+}
+
+func (p *pagerDutySink) SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error {
+	severity := "warning"
+
+	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeExhausted) {
+		severity = "critical"
+	}
+
+	return p.send(ctx, &pagerDutyEvent{
+		DedupKey: fmt.Sprintf("hatchet-%s-limit-%s-%s", tenant.ID, resourceLimitAlertResource(payload), payload.AlertType),
+		Payload: pagerDutyPayload{
+			Summary:       resourceLimitAlertSummary(payload),
+			Severity:      severity,
+			Group:         tenant.Name,
+			Class:         WebhookEventResourceLimitAlert,
+			CustomDetails: payload,
+		},
+		Links: []pagerDutyLink{
+			{
+				Href: payload.Link,
+				Text: "View Limits",
+			},
+		},
+	})
+}
+
+func (p *pagerDutySink) SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error {
+	return p.send(ctx, &pagerDutyEvent{
+		// group repeated firings of the same rule into a single incident
+		DedupKey: fmt.Sprintf("hatchet-%s-rule-%s", tenant.ID, payload.RuleId),
+		Payload: pagerDutyPayload{
+			Summary:       alertRuleAlertSummary(payload),
+			Severity:      "error",
+			Group:         tenant.Name,
+			Class:         WebhookEventAlertRuleFiring,
+			CustomDetails: payload,
+		},
+		Links: []pagerDutyLink{
+			{
+				Href: payload.Link,
+				Text: fmt.Sprintf("View %s", payload.WorkflowName),
+			},
+		},
+	})
+}
+
+func (p *pagerDutySink) send(ctx context.Context, event *pagerDutyEvent) error {
+	event.RoutingKey = p.routingKey
+	event.EventAction = "trigger"
+	event.Payload.Source = "hatchet"
+
+	body, err := json.Marshal(event)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal pagerduty event: %w", err)
+	}
+
+	if err := postJSON(ctx, p.client, p.url, body, nil); err != nil {
+		return fmt.Errorf("could not send pagerduty alert: %w", err)
+	}
+
+	return nil
+}
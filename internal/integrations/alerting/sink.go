@@ -0,0 +1,321 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// AlertSink sends tenant alerts to a single destination, such as a Slack channel, an email group or an
+// external webhook.
+type AlertSink interface {
+	SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error
+
+	SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error
+
+	SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error
+
+	SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error
+}
+
+const alertSinkConfigEncryptionContext = "tenant_alert_sink_config"
+
+// AlertSinkConfig is the config of a tenant alert sink. It's stored encrypted, since it contains secrets.
+type AlertSinkConfig struct {
+	// URL is the url which alerts are posted to, for WEBHOOK and TEAMS sinks.
+	URL string `json:"url,omitempty"`
+
+	// Secret is used to sign the payloads of WEBHOOK sinks.
+	Secret string `json:"secret,omitempty"`
+
+	// RoutingKey is the integration key of the PagerDuty service, for PAGERDUTY sinks.
+	RoutingKey string `json:"routingKey,omitempty"`
+}
+
+// Validate checks that the config contains the fields which are required for the kind of sink.
+func (c *AlertSinkConfig) Validate(kind sqlcv1.TenantAlertSinkKind) error {
+	switch kind {
+	case sqlcv1.TenantAlertSinkKindWEBHOOK:
+		if err := validateSinkURL(c.URL); err != nil {
+			return err
+		}
+
+		if c.Secret == "" {
+			return fmt.Errorf("secret is required for webhook sinks")
+		}
+	case sqlcv1.TenantAlertSinkKindTEAMS:
+		if err := validateSinkURL(c.URL); err != nil {
+			return err
+		}
+	case sqlcv1.TenantAlertSinkKindPAGERDUTY:
+		if c.RoutingKey == "" {
+			return fmt.Errorf("routing key is required for pagerduty sinks")
+		}
+	default:
+		return fmt.Errorf("unknown alert sink kind %s", kind)
+	}
+
+	return nil
+}
+
+func validateSinkURL(rawURL string) error {
+	if rawURL == "" {
+		return fmt.Errorf("url is required")
+	}
+
+	u, err := url.Parse(rawURL)
+
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("url must be a valid http or https url")
+	}
+
+	host := strings.ToLower(u.Hostname())
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errDisallowedSinkAddress
+	}
+
+	// hostnames are checked once they're resolved, when the alert is sent
+	if ip := net.ParseIP(host); ip != nil && isDisallowedSinkIP(ip) {
+		return errDisallowedSinkAddress
+	}
+
+	return nil
+}
+
+// errDisallowedSinkAddress is returned for sink urls which point to internal addresses, so that alert sinks can't be
+// used to send requests to the services next to Hatchet.
+var errDisallowedSinkAddress = errors.New("url must not point to a loopback, link-local or private address")
+
+func isDisallowedSinkIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified()
+}
+
+// EncryptAlertSinkConfig validates and encrypts the config of a tenant alert sink.
+func EncryptAlertSinkConfig(enc encryption.EncryptionService, kind sqlcv1.TenantAlertSinkKind, cfg *AlertSinkConfig) ([]byte, error) {
+	if err := cfg.Validate(kind); err != nil {
+		return nil, err
+	}
+
+	cfgBytes, err := json.Marshal(cfg)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal alert sink config: %w", err)
+	}
+
+	return enc.Encrypt(cfgBytes, alertSinkConfigEncryptionContext)
+}
+
+func decryptAlertSinkConfig(enc encryption.EncryptionService, sink *sqlcv1.TenantAlertSink) (*AlertSinkConfig, error) {
+	cfgBytes, err := enc.Decrypt(sink.Config, alertSinkConfigEncryptionContext)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt config of alert sink %s: %w", sink.ID, err)
+	}
+
+	cfg := &AlertSinkConfig{}
+
+	if err := json.Unmarshal(cfgBytes, cfg); err != nil {
+		return nil, fmt.Errorf("could not unmarshal config of alert sink %s: %w", sink.ID, err)
+	}
+
+	return cfg, nil
+}
+
+// sinks returns the alert sinks configured for the tenant. Sinks which can't be constructed are skipped, and
+// their errors are returned alongside the other sinks.
+func (t *TenantAlertManager) sinks(tenantAlerting *v1.GetTenantAlertingSettingsResponse) ([]AlertSink, error) {
+	res := make([]AlertSink, 0, len(tenantAlerting.SlackWebhooks)+len(tenantAlerting.EmailGroups)+len(tenantAlerting.AlertSinks))
+
+	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
+		res = append(res, &slackSink{t: t, webhook: slackWebhook})
+	}
+
+	for _, emailGroup := range tenantAlerting.EmailGroups {
+		res = append(res, &emailSink{t: t, group: emailGroup})
+	}
+
+	var err error
+
+	for _, sink := range tenantAlerting.AlertSinks {
+		cfg, innerErr := decryptAlertSinkConfig(t.enc, sink)
+
+		if innerErr != nil {
+			err = multierror.Append(err, innerErr)
+			continue
+		}
+
+		switch sink.Kind {
+		case sqlcv1.TenantAlertSinkKindWEBHOOK:
+			res = append(res, newWebhookSink(t.httpClient, cfg.URL, cfg.Secret))
+		case sqlcv1.TenantAlertSinkKindTEAMS:
+			res = append(res, newTeamsSink(t.httpClient, cfg.URL))
+		case sqlcv1.TenantAlertSinkKindPAGERDUTY:
+			res = append(res, newPagerDutySink(t.httpClient, pagerDutyEventsURL, cfg.RoutingKey))
+		default:
+			err = multierror.Append(err, fmt.Errorf("unknown kind %s for alert sink %s", sink.Kind, sink.ID))
+		}
+	}
+
+	return res, err
+}
+
+type slackSink struct {
+	t       *TenantAlertManager
+	webhook *sqlcv1.SlackAppWebhook
+}
+
+func (s *slackSink) SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	return s.t.sendSlackWorkflowRunAlert(s.webhook, numFailed, failedRuns)
+}
+
+func (s *slackSink) SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error {
+	return s.t.sendSlackExpiringTokenAlert(s.webhook, payload)
+}
+
+func (s *slackSink) SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error {
+	return s.t.sendSlackTenantResourceLimitAlert(s.webhook, payload)
+}
+
+func (s *slackSink) SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error {
+	return s.t.sendSlackAlertRuleAlert(s.webhook, payload)
+}
+
+type emailSink struct {
+	t     *TenantAlertManager
+	group *v1.TenantAlertEmailGroupForSend
+}
+
+func (s *emailSink) SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	return s.t.sendEmailWorkflowRunAlert(tenant, s.group, numFailed, failedRuns)
+}
+
+func (s *emailSink) SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error {
+	return s.t.sendEmailExpiringTokenAlert(tenant, s.group, payload)
+}
+
+func (s *emailSink) SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error {
+	return s.t.sendEmailTenantResourceLimitAlert(tenant, s.group, payload)
+}
+
+func (s *emailSink) SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error {
+	return s.t.sendEmailAlertRuleAlert(tenant, s.group, payload)
+}
+
+// newAlertSinkHTTPClient returns the client which alerts are sent with. It refuses to connect to the addresses
+// rejected by validateSinkURL, which covers hostnames resolving to them and redirects to them.
+func newAlertSinkHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || isDisallowedSinkIP(ip) {
+				return errDisallowedSinkAddress
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	// requests aren't sent through a proxy, since the proxy would connect to the sink instead of the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+	}
+}
+
+// postJSON posts a JSON body to the url and returns an error if the response isn't a 2xx.
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+
+	if err != nil {
+		return fmt.Errorf("failed creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func workflowRunAlertSummary(numFailed int) string {
+	if numFailed <= 1 {
+		return fmt.Sprintf("%d Hatchet workflow failed", numFailed)
+	}
+
+	return fmt.Sprintf("%d Hatchet workflows failed", numFailed)
+}
+
+func expiringTokenAlertSummary(payload *alerttypes.ExpiringTokenItem) string {
+	return fmt.Sprintf("Hatchet token %s will expire %s", payload.TokenName, payload.ExpiresAtRelativeDate)
+}
+
+func resourceLimitAlertSummary(payload *alerttypes.ResourceLimitAlert) string {
+	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeExhausted) {
+		return fmt.Sprintf("Hatchet %s resource is at 100%% of its limit (%d/%d)", resourceLimitAlertResource(payload), payload.CurrentValue, payload.LimitValue)
+	}
+
+	return fmt.Sprintf("Hatchet %s resource is at %d%% of its limit (%d/%d)", resourceLimitAlertResource(payload), payload.Percentage, payload.CurrentValue, payload.LimitValue)
+}
+
+// resourceLimitAlertResource returns the resource of the alert, along with the scope of the limit for scoped limits
+func resourceLimitAlertResource(payload *alerttypes.ResourceLimitAlert) string {
+	if payload.Scope == "" {
+		return payload.Resource
+	}
+
+	return fmt.Sprintf("%s (%s)", payload.Resource, payload.Scope)
+}
+
+func alertRuleAlertSummary(payload *alerttypes.AlertRuleItem) string {
+	return fmt.Sprintf("Hatchet alert rule %s is firing: %s", payload.RuleName, payload.Summary)
+}
@@ -0,0 +1,316 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/internal/signature"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+type capturedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newCaptureServer(t *testing.T, status int) (*httptest.Server, chan capturedRequest) {
+	t.Helper()
+
+	reqs := make(chan capturedRequest, 10)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		reqs <- capturedRequest{header: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, reqs
+}
+
+var testTenant = &sqlcv1.Tenant{
+	ID:   uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+	Name: "acme",
+}
+
+var testFailedRuns = []alerttypes.WorkflowRunFailedItem{
+	{
+		Link:                  "https://hatchet.example.com/tenants/1/runs/2",
+		WorkflowName:          "process-order",
+		WorkflowRunReadableId: "process-order",
+		RelativeDate:          "5 minutes ago",
+	},
+}
+
+func TestWebhookSink(t *testing.T) {
+	srv, reqs := newCaptureServer(t, http.StatusOK)
+
+	sink := newWebhookSink(srv.Client(), srv.URL, "shh")
+
+	require.NoError(t, sink.SendWorkflowRunAlert(context.Background(), testTenant, 1, testFailedRuns))
+
+	req := <-reqs
+
+	timestamp := req.header.Get(WebhookTimestampHeader)
+
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), time.Unix(sentAt, 0), time.Minute)
+
+	expectedSig, err := signature.Sign(timestamp+"."+string(req.body), "shh")
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedSig, req.header.Get(WebhookSignatureHeader))
+	assert.Equal(t, WebhookEventWorkflowRunFailed, req.header.Get(WebhookEventHeader))
+
+	payload := struct {
+		WebhookAlertPayload
+		Data WebhookWorkflowRunFailedData `json:"data"`
+	}{}
+
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+
+	assert.Equal(t, WebhookEventWorkflowRunFailed, payload.Type)
+	assert.Equal(t, testTenant.ID.String(), payload.TenantId)
+	assert.Equal(t, "acme", payload.TenantName)
+	assert.Equal(t, "1 Hatchet workflow failed", payload.Summary)
+	assert.Equal(t, 1, payload.Data.NumFailed)
+	assert.Equal(t, testFailedRuns, payload.Data.FailedRuns)
+}
+
+func TestWebhookSinkErrorStatus(t *testing.T) {
+	srv, _ := newCaptureServer(t, http.StatusInternalServerError)
+
+	sink := newWebhookSink(srv.Client(), srv.URL, "shh")
+
+	assert.Error(t, sink.SendExpiringTokenAlert(context.Background(), testTenant, &alerttypes.ExpiringTokenItem{TokenName: "ci"}))
+}
+
+func TestTeamsSink(t *testing.T) {
+	srv, reqs := newCaptureServer(t, http.StatusAccepted)
+
+	sink := newTeamsSink(srv.Client(), srv.URL)
+
+	require.NoError(t, sink.SendTenantResourceLimitAlert(context.Background(), testTenant, &alerttypes.ResourceLimitAlert{
+		Link:         "https://hatchet.example.com/tenants/1/settings/billing-and-limits",
+		Resource:     "TASK_RUN",
+		AlertType:    string(sqlcv1.TenantResourceLimitAlertTypeExhausted),
+		CurrentValue: 100,
+		LimitValue:   100,
+		Percentage:   100,
+	}))
+
+	req := <-reqs
+
+	msg := &teamsMessage{}
+	require.NoError(t, json.Unmarshal(req.body, msg))
+
+	require.Len(t, msg.Attachments, 1)
+	assert.Equal(t, "application/vnd.microsoft.card.adaptive", msg.Attachments[0].ContentType)
+
+	card := msg.Attachments[0].Content
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, "Hatchet TASK_RUN resource is at 100% of its limit (100/100)", card.Body[0].Text)
+	assert.Len(t, card.Body, 3)
+	require.Len(t, card.Actions, 1)
+	assert.Equal(t, "https://hatchet.example.com/tenants/1/settings/billing-and-limits", card.Actions[0].URL)
+}
+
+func TestPagerDutySink(t *testing.T) {
+	srv, reqs := newCaptureServer(t, http.StatusAccepted)
+
+	sink := newPagerDutySink(srv.Client(), srv.URL, "routing-key")
+
+	require.NoError(t, sink.SendWorkflowRunAlert(context.Background(), testTenant, 3, testFailedRuns))
+
+	event := &pagerDutyEvent{}
+	require.NoError(t, json.Unmarshal((<-reqs).body, event))
+
+	assert.Equal(t, "routing-key", event.RoutingKey)
+	assert.Equal(t, "trigger", event.EventAction)
+	assert.Empty(t, event.DedupKey)
+	assert.Equal(t, "3 Hatchet workflows failed", event.Payload.Summary)
+	assert.Equal(t, "hatchet", event.Payload.Source)
+	assert.Equal(t, "error", event.Payload.Severity)
+	require.Len(t, event.Links, 1)
+	assert.Equal(t, testFailedRuns[0].Link, event.Links[0].Href)
+
+	require.NoError(t, sink.SendExpiringTokenAlert(context.Background(), testTenant, &alerttypes.ExpiringTokenItem{TokenName: "ci"}))
+
+	event = &pagerDutyEvent{}
+	require.NoError(t, json.Unmarshal((<-reqs).body, event))
+
+	assert.Equal(t, "warning", event.Payload.Severity)
+	assert.Equal(t, "hatchet-11111111-1111-1111-1111-111111111111-token-ci", event.DedupKey)
+}
+
+func TestAlertSinkConfigValidate(t *testing.T) {
+	assert.NoError(t, (&AlertSinkConfig{URL: "https://example.com/hook", Secret: "shh"}).Validate(sqlcv1.TenantAlertSinkKindWEBHOOK))
+	assert.Error(t, (&AlertSinkConfig{URL: "https://example.com/hook"}).Validate(sqlcv1.TenantAlertSinkKindWEBHOOK))
+	assert.Error(t, (&AlertSinkConfig{URL: "ftp://example.com", Secret: "shh"}).Validate(sqlcv1.TenantAlertSinkKindWEBHOOK))
+
+	assert.NoError(t, (&AlertSinkConfig{URL: "https://example.webhook.office.com/x"}).Validate(sqlcv1.TenantAlertSinkKindTEAMS))
+	assert.Error(t, (&AlertSinkConfig{}).Validate(sqlcv1.TenantAlertSinkKindTEAMS))
+
+	assert.NoError(t, (&AlertSinkConfig{RoutingKey: "key"}).Validate(sqlcv1.TenantAlertSinkKindPAGERDUTY))
+	assert.Error(t, (&AlertSinkConfig{URL: "https://example.com"}).Validate(sqlcv1.TenantAlertSinkKindPAGERDUTY))
+
+	assert.Error(t, (&AlertSinkConfig{}).Validate("SMOKE_SIGNAL"))
+
+	for _, url := range []string{
+		"http://localhost:8080/hook",
+		"http://api.localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://[::1]/hook",
+		"http://0.0.0.0/hook",
+		"http://10.0.0.12/hook",
+		"http://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[fe80::1]/hook",
+	} {
+		assert.ErrorIs(t, (&AlertSinkConfig{URL: url, Secret: "shh"}).Validate(sqlcv1.TenantAlertSinkKindWEBHOOK), errDisallowedSinkAddress, url)
+	}
+}
+
+func TestAlertSinkHTTPClientRejectsInternalAddresses(t *testing.T) {
+	srv, _ := newCaptureServer(t, http.StatusOK)
+
+	// the test server listens on a loopback address, so the connection is refused when it's dialed
+	err := postJSON(context.Background(), newAlertSinkHTTPClient(), srv.URL, []byte("{}"), nil)
+
+	assert.ErrorIs(t, err, errDisallowedSinkAddress)
+}
+
+func TestSinksFromSettings(t *testing.T) {
+	masterKey, privateEc256, publicEc256, _, err := encryption.GenerateLocalKeys()
+	require.NoError(t, err)
+
+	enc, err := encryption.NewLocalEncryption(masterKey, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	webhookConfig, err := EncryptAlertSinkConfig(enc, sqlcv1.TenantAlertSinkKindWEBHOOK, &AlertSinkConfig{URL: "https://example.com/hook", Secret: "shh"})
+	require.NoError(t, err)
+
+	pagerDutyConfig, err := EncryptAlertSinkConfig(enc, sqlcv1.TenantAlertSinkKindPAGERDUTY, &AlertSinkConfig{RoutingKey: "key"})
+	require.NoError(t, err)
+
+	_, err = EncryptAlertSinkConfig(enc, sqlcv1.TenantAlertSinkKindTEAMS, &AlertSinkConfig{})
+	assert.Error(t, err)
+
+	m := New(nil, enc, "https://hatchet.example.com", nil)
+
+	sinks, err := m.sinks(&v1.GetTenantAlertingSettingsResponse{
+		EmailGroups: []*v1.TenantAlertEmailGroupForSend{{Emails: []string{"ops@example.com"}}},
+		AlertSinks: []*sqlcv1.TenantAlertSink{
+			{ID: uuid.New(), Kind: sqlcv1.TenantAlertSinkKindWEBHOOK, Config: webhookConfig},
+			{ID: uuid.New(), Kind: sqlcv1.TenantAlertSinkKindPAGERDUTY, Config: pagerDutyConfig},
+			{ID: uuid.New(), Kind: sqlcv1.TenantAlertSinkKindTEAMS, Config: []byte("not encrypted")},
+		},
+	})
+
+	// the sink which can't be decrypted is skipped
+	assert.Error(t, err)
+	require.Len(t, sinks, 3)
+
+	assert.IsType(t, &emailSink{}, sinks[0])
+
+	webhook, ok := sinks[1].(*webhookSink)
+	require.True(t, ok)
+	assert.Equal(t, "https://example.com/hook", webhook.url)
+	assert.Equal(t, "shh", webhook.secret)
+
+	pagerDuty, ok := sinks[2].(*pagerDutySink)
+	require.True(t, ok)
+	assert.Equal(t, "key", pagerDuty.routingKey)
+	assert.Equal(t, pagerDutyEventsURL, pagerDuty.url)
+}
+
+func TestAlertRuleSummary(t *testing.T) {
+	rule := &sqlcv1.TenantAlertRule{
+		Kind:      sqlcv1.TenantAlertRuleKindP95DURATION,
+		Threshold: 60,
+		Window:    "1h",
+	}
+
+	assert.Equal(t, "The p95 duration of process-order runs is 2m30s over the last 1h, above the threshold of 1m0s", alertRuleSummary(rule, "process-order", 150.2))
+
+	rule.Kind = sqlcv1.TenantAlertRuleKindFAILURERATE
+	rule.Threshold = 10
+
+	assert.Equal(t, "12.5% of process-order runs failed over the last 1h, above the threshold of 10.0%", alertRuleSummary(rule, "process-order", 12.5))
+
+	rule.Kind = sqlcv1.TenantAlertRuleKindQUEUEDEPTH
+	rule.Threshold = 100
+
+	assert.Equal(t, "process-order has 250 queued runs, above the threshold of 100", alertRuleSummary(rule, "process-order", 250))
+
+	rule.Kind = sqlcv1.TenantAlertRuleKindCRONMISSED
+	rule.Window = "24h"
+
+	assert.Equal(t, "process-order has had no successful cron runs in the last 24h", alertRuleSummary(rule, "process-order", 0))
+}
+
+func TestResourceLimitAlertSummary(t *testing.T) {
+	alert := &alerttypes.ResourceLimitAlert{
+		Resource:     "TASK_RUN",
+		AlertType:    string(sqlcv1.TenantResourceLimitAlertTypeAlarm),
+		CurrentValue: 85,
+		LimitValue:   100,
+		Percentage:   85,
+	}
+
+	assert.Equal(t, "Hatchet TASK_RUN resource is at 85% of its limit (85/100)", resourceLimitAlertSummary(alert))
+
+	alert.Scope = "workflow process-order"
+
+	assert.Equal(t, "Hatchet TASK_RUN (workflow process-order) resource is at 85% of its limit (85/100)", resourceLimitAlertSummary(alert))
+}
+
+func TestWebhookSinkAlertRule(t *testing.T) {
+	srv, reqs := newCaptureServer(t, http.StatusOK)
+
+	sink := newWebhookSink(srv.Client(), srv.URL, "shh")
+
+	item := &alerttypes.AlertRuleItem{
+		RuleId:       "rule-1",
+		RuleName:     "order-backlog",
+		Kind:         string(sqlcv1.TenantAlertRuleKindQUEUEDEPTH),
+		WorkflowName: "process-order",
+		Summary:      "process-order has 250 queued runs, above the threshold of 100",
+		Value:        250,
+		Threshold:    100,
+		Window:       "1h",
+	}
+
+	require.NoError(t, sink.SendAlertRuleAlert(context.Background(), testTenant, item))
+
+	req := <-reqs
+
+	assert.Equal(t, WebhookEventAlertRuleFiring, req.header.Get(WebhookEventHeader))
+
+	payload := struct {
+		WebhookAlertPayload
+		Data alerttypes.AlertRuleItem `json:"data"`
+	}{}
+
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+
+	assert.Equal(t, "Hatchet alert rule order-backlog is firing: process-order has 250 queued runs, above the threshold of 100", payload.Summary)
+	assert.Equal(t, *item, payload.Data)
+}
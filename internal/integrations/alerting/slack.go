@@ -168,11 +168,11 @@ func (t *TenantAlertManager) getSlackTenantResourceLimitTextAndBlocks(payload *a
 	var headerText string
 
 	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeAlarm) {
-		headerText = fmt.Sprintf(":warning: Limit Alarm! `%s` resource is at %d%% of its limit (%d/%d)", payload.Resource, payload.Percentage, payload.CurrentValue, payload.LimitValue)
+		headerText = fmt.Sprintf(":warning: Limit Alarm! `%s` resource is at %d%% of its limit (%d/%d)", resourceLimitAlertResource(payload), payload.Percentage, payload.CurrentValue, payload.LimitValue)
 	}
 
 	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeExhausted) {
-		headerText = fmt.Sprintf(":no_entry: Limit Exhausted! `%s` resource is at 100%% of its limit (%d/%d)", payload.Resource, payload.CurrentValue, payload.LimitValue)
+		headerText = fmt.Sprintf(":no_entry: Limit Exhausted! `%s` resource is at 100%% of its limit (%d/%d)", resourceLimitAlertResource(payload), payload.CurrentValue, payload.LimitValue)
 	}
 
 	res = append(res, slack.NewSectionBlock(
@@ -221,3 +221,63 @@ func (t *TenantAlertManager) getSlackTenantResourceLimitTextAndBlocks(payload *a
 		BlockSet: res,
 	}
 }
+
+func (t *TenantAlertManager) sendSlackAlertRuleAlert(slackWebhook *sqlcv1.SlackAppWebhook, payload *alerttypes.AlertRuleItem) error {
+	headerText, blocks := t.getSlackAlertRuleTextAndBlocks(payload)
+
+	// decrypt the webhook url
+	whDecrypted, err := t.enc.Decrypt(slackWebhook.WebhookURL, "incoming_webhook_url")
+
+	if err != nil {
+		return err
+	}
+
+	err = slack.PostWebhook(string(whDecrypted), &slack.WebhookMessage{
+		Text:   headerText,
+		Blocks: blocks,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TenantAlertManager) getSlackAlertRuleTextAndBlocks(payload *alerttypes.AlertRuleItem) (string, *slack.Blocks) {
+	res := make([]slack.Block, 0)
+
+	headerText := fmt.Sprintf(":rotating_light: Alert rule `%s` is firing", payload.RuleName)
+
+	res = append(res, slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, headerText, false, false),
+		nil,
+		nil,
+	))
+
+	buttonAccessory := slack.NewAccessory(
+		slack.NewButtonBlockElement(
+			"View",
+			payload.RuleId,
+			slack.NewTextBlockObject(slack.PlainTextType, "View", true, false),
+		),
+	)
+
+	buttonAccessory.ButtonElement.URL = payload.Link
+	buttonAccessory.ButtonElement.ActionID = "button-action"
+
+	res = append(res, slack.NewSectionBlock(
+		slack.NewTextBlockObject(
+			slack.MarkdownType,
+			payload.Summary,
+			false,
+			false,
+		),
+		nil,
+		buttonAccessory,
+	))
+
+	return headerText, &slack.Blocks{
+		BlockSet: res,
+	}
+}
@@ -0,0 +1,163 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsCardElement `json:"body"`
+	Actions []teamsCardAction  `json:"actions,omitempty"`
+}
+
+type teamsCardElement struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Wrap   bool   `json:"wrap"`
+	Weight string `json:"weight,omitempty"`
+	Size   string `json:"size,omitempty"`
+}
+
+type teamsCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// teamsSink posts alerts as Adaptive Cards to a Microsoft Teams incoming webhook.
+type teamsSink struct {
+	client *http.Client
+	url    string
+}
+
+func newTeamsSink(client *http.Client, url string) *teamsSink {
+	return &teamsSink{
+		client: client,
+		url:    url,
+	}
+}
+
+func (s *teamsSink) SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	body := []teamsCardElement{teamsHeader(workflowRunAlertSummary(numFailed))}
+	actions := make([]teamsCardAction, 0, len(failedRuns))
+
+	for _, workflowRun := range failedRuns {
+		body = append(body, teamsText(fmt.Sprintf("⚠️ **%s** failed %s", workflowRun.WorkflowName, workflowRun.RelativeDate)))
+
+		actions = append(actions, teamsCardAction{
+			Type:  "Action.OpenUrl",
+			Title: fmt.Sprintf("View %s", workflowRun.WorkflowRunReadableId),
+			URL:   workflowRun.Link,
+		})
+	}
+
+	return s.send(ctx, body, actions)
+}
+
+func (s *teamsSink) SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error {
+	return s.send(ctx, []teamsCardElement{
+		teamsHeader(fmt.Sprintf("🔒 Heads up! Your %s Hatchet token will expire %s", payload.TokenName, payload.ExpiresAtRelativeDate)),
+		teamsText("Once expired, any workers or clients using this token will no longer be able to connect to Hatchet."),
+	}, []teamsCardAction{
+		{
+			Type:  "Action.OpenUrl",
+			Title: "Manage Tokens",
+			URL:   payload.Link,
+		},
+	})
+}
+
+func (s *teamsSink) SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error {
+	body := []teamsCardElement{teamsHeader(resourceLimitAlertSummary(payload))}
+
+	if payload.AlertType == string(sqlcv1.TenantResourceLimitAlertTypeExhausted) {
+		body = append(body, teamsText("Any further resource usage will be denied until the limit is increased."))
+	}
+
+	body = append(body, teamsText("Please review your resource usage and consider upgrading your plan."))
+
+	return s.send(ctx, body, []teamsCardAction{
+		{
+			Type:  "Action.OpenUrl",
+			Title: "View Limits",
+			URL:   payload.Link,
+		},
+	})
+}
+
+func (s *teamsSink) SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error {
+	return s.send(ctx, []teamsCardElement{
+		teamsHeader(fmt.Sprintf("🚨 Alert rule %s is firing", payload.RuleName)),
+		teamsText(payload.Summary),
+	}, []teamsCardAction{
+		{
+			Type:  "Action.OpenUrl",
+			Title: fmt.Sprintf("View %s", payload.WorkflowName),
+			URL:   payload.Link,
+		},
+	})
+}
+
+func (s *teamsSink) send(ctx context.Context, body []teamsCardElement, actions []teamsCardAction) error {
+	msg, err := json.Marshal(&teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{
+			{
+				ContentType: "application/vnd.microsoft.card.adaptive",
+				Content: teamsCard{
+					Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+					Type:    "AdaptiveCard",
+					Version: "1.4",
+					Body:    body,
+					Actions: actions,
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal teams message: %w", err)
+	}
+
+	if err := postJSON(ctx, s.client, s.url, msg, nil); err != nil {
+		return fmt.Errorf("could not send teams alert: %w", err)
+	}
+
+	return nil
+}
+
+func teamsHeader(text string) teamsCardElement {
+	return teamsCardElement{
+		Type:   "TextBlock",
+		Text:   text,
+		Wrap:   true,
+		Weight: "Bolder",
+		Size:   "Medium",
+	}
+}
+
+func teamsText(text string) teamsCardElement {
+	return teamsCardElement{
+		Type: "TextBlock",
+		Text: text,
+		Wrap: true,
+	}
+}
@@ -0,0 +1,117 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/internal/signature"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const (
+	// WebhookSignatureHeader contains the hex-encoded HMAC-SHA256 of the timestamp, a "." and the request body,
+	// signed with the secret of the sink. Signing the timestamp lets receivers reject replayed requests.
+	WebhookSignatureHeader = "X-Hatchet-Signature"
+
+	// WebhookTimestampHeader contains the unix timestamp, in seconds, at which the request was signed.
+	WebhookTimestampHeader = "X-Hatchet-Timestamp"
+
+	// WebhookEventHeader contains the type of the alert.
+	WebhookEventHeader = "X-Hatchet-Event"
+)
+
+const (
+	WebhookEventWorkflowRunFailed  = "workflow_run.failed"
+	WebhookEventTokenExpiring      = "api_token.expiring"
+	WebhookEventResourceLimitAlert = "resource_limit.alert"
+	WebhookEventAlertRuleFiring    = "alert_rule.firing"
+)
+
+// WebhookAlertPayload is the body of the requests sent by webhook sinks.
+type WebhookAlertPayload struct {
+	Type       string      `json:"type"`
+	TenantId   string      `json:"tenantId"`
+	TenantName string      `json:"tenantName"`
+	Summary    string      `json:"summary"`
+	Data       interface{} `json:"data"`
+}
+
+type WebhookWorkflowRunFailedData struct {
+	NumFailed  int                                `json:"numFailed"`
+	FailedRuns []alerttypes.WorkflowRunFailedItem `json:"failedRuns"`
+}
+
+// webhookSink posts alerts as signed JSON to a generic HTTP endpoint.
+type webhookSink struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func newWebhookSink(client *http.Client, url, secret string) *webhookSink {
+	return &webhookSink{
+		client: client,
+		url:    url,
+		secret: secret,
+	}
+}
+
+func (w *webhookSink) SendWorkflowRunAlert(ctx context.Context, tenant *sqlcv1.Tenant, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	return w.send(ctx, tenant, WebhookEventWorkflowRunFailed, workflowRunAlertSummary(numFailed), &WebhookWorkflowRunFailedData{
+		NumFailed:  numFailed,
+		FailedRuns: failedRuns,
+	})
+}
+
+func (w *webhookSink) SendExpiringTokenAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ExpiringTokenItem) error {
+	return w.send(ctx, tenant, WebhookEventTokenExpiring, expiringTokenAlertSummary(payload), payload)
+}
+
+func (w *webhookSink) SendTenantResourceLimitAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.ResourceLimitAlert) error {
+	return w.send(ctx, tenant, WebhookEventResourceLimitAlert, resourceLimitAlertSummary(payload), payload)
+}
+
+func (w *webhookSink) SendAlertRuleAlert(ctx context.Context, tenant *sqlcv1.Tenant, payload *alerttypes.AlertRuleItem) error {
+	return w.send(ctx, tenant, WebhookEventAlertRuleFiring, alertRuleAlertSummary(payload), payload)
+}
+
+func (w *webhookSink) send(ctx context.Context, tenant *sqlcv1.Tenant, eventType, summary string, data interface{}) error {
+	body, err := json.Marshal(&WebhookAlertPayload{
+		Type:       eventType,
+		TenantId:   tenant.ID.String(),
+		TenantName: tenant.Name,
+		Summary:    summary,
+		Data:       data,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook payload: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	sig, err := signWebhookPayload(timestamp, body, w.secret)
+
+	if err != nil {
+		return fmt.Errorf("could not sign webhook payload: %w", err)
+	}
+
+	if err := postJSON(ctx, w.client, w.url, body, map[string]string{
+		WebhookSignatureHeader: sig,
+		WebhookTimestampHeader: timestamp,
+		WebhookEventHeader:     eventType,
+	}); err != nil {
+		return fmt.Errorf("could not send webhook alert: %w", err)
+	}
+
+	return nil
+}
+
+func signWebhookPayload(timestamp string, body []byte, secret string) (string, error) {
+	return signature.Sign(timestamp+"."+string(body), secret)
+}
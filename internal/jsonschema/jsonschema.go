@@ -0,0 +1,260 @@
+// Package jsonschema validates JSON documents against the JSON schemas which SDKs register as workflow input
+// schemas.
+//
+// Schemas are validated with the OpenAPI schema validator, so the JSON Schema keywords which it doesn't support
+// (for example "$defs", numeric "exclusiveMinimum" and "const") are rewritten to their OpenAPI equivalents before
+// the schema is compiled. Keywords without an equivalent are ignored.
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const rootSchemaName = "__root"
+
+// Violation is a single reason that a document doesn't match a schema.
+type Violation struct {
+	// Pointer is the JSON pointer (RFC 6901) of the value which doesn't match the schema, "" for the root document.
+	Pointer string `json:"pointer"`
+
+	// Message describes why the value doesn't match the schema.
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	pointer := v.Pointer
+
+	if pointer == "" {
+		pointer = "/"
+	}
+
+	return fmt.Sprintf("%s: %s", pointer, v.Message)
+}
+
+// Schema is a compiled JSON schema.
+type Schema struct {
+	schema *openapi3.Schema
+}
+
+// Compile compiles a JSON schema. Schema references must be local references to "$defs" or "definitions".
+func Compile(raw []byte) (*Schema, error) {
+	var root map[string]any
+
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, fmt.Errorf("schema is not a JSON object: %w", err)
+	}
+
+	schemas := map[string]any{}
+
+	for _, key := range []string{"$defs", "definitions"} {
+		defs, ok := root[key].(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		for name, def := range defs {
+			schemas[name] = normalize(def)
+		}
+	}
+
+	schemas[rootSchemaName] = normalize(root)
+
+	doc, err := json.Marshal(map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "input",
+			"version": "1",
+		},
+		"paths": map[string]any{},
+		"components": map[string]any{
+			"schemas": schemas,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal schema: %w", err)
+	}
+
+	loader := openapi3.NewLoader()
+
+	t, err := loader.LoadFromData(doc)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load schema: %w", err)
+	}
+
+	return &Schema{
+		schema: t.Components.Schemas[rootSchemaName].Value,
+	}, nil
+}
+
+// Validate validates a JSON document against the schema, and returns every violation. An empty document is
+// treated as an empty object.
+func (s *Schema) Validate(doc []byte) ([]Violation, error) {
+	var value any = map[string]any{}
+
+	if len(doc) > 0 {
+		if err := json.Unmarshal(doc, &value); err != nil {
+			return nil, fmt.Errorf("document is not valid JSON: %w", err)
+		}
+	}
+
+	err := s.schema.VisitJSON(value, openapi3.MultiErrors())
+
+	if err == nil {
+		return nil, nil
+	}
+
+	violations := make([]Violation, 0)
+
+	var multiErr openapi3.MultiError
+
+	if !errors.As(err, &multiErr) {
+		multiErr = openapi3.MultiError{err}
+	}
+
+	for _, e := range multiErr {
+		violations = append(violations, toViolation(e))
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Pointer < violations[j].Pointer
+	})
+
+	return violations, nil
+}
+
+func toViolation(err error) Violation {
+	var schemaErr *openapi3.SchemaError
+
+	if !errors.As(err, &schemaErr) {
+		return Violation{
+			Message: err.Error(),
+		}
+	}
+
+	message := schemaErr.Reason
+
+	if message == "" {
+		message = fmt.Sprintf("doesn't match schema %q", schemaErr.SchemaField)
+	}
+
+	return Violation{
+		Pointer: toPointer(schemaErr.JSONPointer()),
+		Message: message,
+	}
+}
+
+func toPointer(path []string) string {
+	var b strings.Builder
+
+	for _, token := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+
+	return b.String()
+}
+
+// normalize rewrites the JSON Schema keywords of a (sub)schema which the OpenAPI schema validator doesn't support.
+func normalize(node any) any {
+	switch n := node.(type) {
+	case map[string]any:
+		res := make(map[string]any, len(n))
+
+		for key, value := range n {
+			switch key {
+			case "$schema", "$id", "$defs", "definitions", "discriminator", "examples":
+				// handled at the root, or not needed for validation
+				continue
+			case "enum", "default", "example":
+				// values rather than schemas
+				res[key] = value
+
+				continue
+			case "$ref":
+				if ref, ok := value.(string); ok {
+					ref = strings.Replace(ref, "#/$defs/", "#/components/schemas/", 1)
+					ref = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+
+					res[key] = ref
+				}
+
+				continue
+			case "exclusiveMinimum", "exclusiveMaximum":
+				// JSON Schema 2019-09 and later use numeric bounds, OpenAPI 3.0 uses booleans
+				if bound, ok := value.(float64); ok {
+					res[key] = true
+
+					if key == "exclusiveMinimum" {
+						res["minimum"] = bound
+					} else {
+						res["maximum"] = bound
+					}
+
+					continue
+				}
+			case "const":
+				if _, hasEnum := n["enum"]; !hasEnum {
+					res["enum"] = []any{value}
+				}
+
+				continue
+			case "items", "additionalProperties", "not":
+				// boolean schemas are only supported for additionalProperties
+				if b, ok := value.(bool); ok {
+					if key == "additionalProperties" {
+						res[key] = b
+					}
+
+					continue
+				}
+			case "properties":
+				if props, ok := value.(map[string]any); ok {
+					normalized := make(map[string]any, len(props))
+
+					for name, prop := range props {
+						normalized[name] = normalize(prop)
+					}
+
+					res[key] = normalized
+
+					continue
+				}
+			}
+
+			res[key] = normalize(value)
+		}
+
+		// a $ref can't have sibling keywords in OpenAPI 3.0, so they're combined with allOf
+		if ref, ok := res["$ref"]; ok && len(res) > 1 {
+			delete(res, "$ref")
+
+			return map[string]any{
+				"allOf": []any{
+					map[string]any{"$ref": ref},
+					res,
+				},
+			}
+		}
+
+		return res
+	case []any:
+		res := make([]any, 0, len(n))
+
+		for _, value := range n {
+			res = append(res, normalize(value))
+		}
+
+		return res
+	default:
+		return node
+	}
+}
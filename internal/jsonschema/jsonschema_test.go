@@ -0,0 +1,119 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package jsonschema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// a schema in the format emitted by pydantic for:
+//
+//	class Item(BaseModel):
+//	    sku: str
+//	    quantity: int = Field(gt=0)
+//
+//	class Order(BaseModel):
+//	    kind: Literal["order"]
+//	    customer_id: str
+//	    note: str | None = None
+//	    items: list[Item]
+const pydanticSchema = `{
+	"$defs": {
+		"Item": {
+			"properties": {
+				"sku": {"title": "Sku", "type": "string"},
+				"quantity": {"exclusiveMinimum": 0, "title": "Quantity", "type": "integer"}
+			},
+			"required": ["sku", "quantity"],
+			"title": "Item",
+			"type": "object"
+		}
+	},
+	"properties": {
+		"kind": {"const": "order", "title": "Kind", "type": "string"},
+		"customer_id": {"title": "Customer Id", "type": "string"},
+		"note": {"anyOf": [{"type": "string"}, {"type": "null"}], "default": null, "title": "Note"},
+		"items": {"items": {"$ref": "#/$defs/Item"}, "title": "Items", "type": "array"}
+	},
+	"required": ["kind", "customer_id", "items"],
+	"title": "Order",
+	"type": "object"
+}`
+
+func TestValidate(t *testing.T) {
+	schema, err := Compile([]byte(pydanticSchema))
+	require.NoError(t, err)
+
+	violations, err := schema.Validate([]byte(`{"kind": "order", "customer_id": "c1", "note": null, "items": [{"sku": "a", "quantity": 2}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = schema.Validate([]byte(`{"kind": "refund", "items": [{"sku": "a", "quantity": 0}, {"sku": 1, "quantity": 1}]}`))
+	require.NoError(t, err)
+
+	pointers := make([]string, 0, len(violations))
+
+	for _, v := range violations {
+		pointers = append(pointers, v.Pointer)
+	}
+
+	// missing required properties are reported at the pointer of the missing property
+	assert.Equal(t, []string{"/customer_id", "/items/0/quantity", "/items/1/sku", "/kind"}, pointers)
+	assert.Contains(t, violations[0].Message, "missing")
+}
+
+func TestValidateEmptyDocument(t *testing.T) {
+	schema, err := Compile([]byte(`{"type": "object", "properties": {"name": {"type": "string"}}}`))
+	require.NoError(t, err)
+
+	violations, err := schema.Validate(nil)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	_, err = schema.Validate([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestValidateRecursiveSchema(t *testing.T) {
+	schema, err := Compile([]byte(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"definitions": {
+			"Node": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"$ref": "#/definitions/Node"}}
+				},
+				"required": ["name"],
+				"additionalProperties": false
+			}
+		},
+		"$ref": "#/definitions/Node"
+	}`))
+	require.NoError(t, err)
+
+	violations, err := schema.Validate([]byte(`{"name": "root", "children": [{"name": "a", "children": [{"extra": true}]}]}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, violations)
+
+	for _, v := range violations {
+		assert.True(t, strings.HasPrefix(v.Pointer, "/children/0/children/0"), v.Pointer)
+	}
+}
+
+func TestCompileInvalidSchema(t *testing.T) {
+	_, err := Compile([]byte(`[]`))
+	assert.Error(t, err)
+
+	_, err = Compile([]byte(`{"$ref": "#/$defs/Missing"}`))
+	assert.Error(t, err)
+}
+
+func TestToPointer(t *testing.T) {
+	assert.Equal(t, "", toPointer(nil))
+	assert.Equal(t, "/a~1b/c~0d/0", toPointer([]string{"a/b", "c~d", "0"}))
+}
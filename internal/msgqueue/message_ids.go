@@ -5,6 +5,8 @@ const (
 	MsgIDCancelTasks                  = "cancel-tasks"
 	MsgIDDurableCallbackCompleted     = "durable-callback-completed"
 	MsgIDDurableRestoreTask           = "durable-restore-task"
+	MsgIDDurableTaskQuery             = "durable-task-query"
+	MsgIDDurableTaskQueryResult       = "durable-task-query-result"
 	MsgIDCELEvaluationFailure         = "cel-evaluation-failure"
 	MsgIDCheckTenantQueue             = "check-tenant-queue"
 	MsgIDNewWorker                    = "new-worker"
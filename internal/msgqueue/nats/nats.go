@@ -0,0 +1,821 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	// consumerName is the name of the durable consumer shared by every engine instance which
+	// subscribes to a stream-backed queue, so that instances compete for messages.
+	consumerName = "hatchet"
+
+	// ackWait mirrors the x-consumer-timeout used by the RabbitMQ implementation.
+	ackWait = 5 * time.Minute
+
+	// expirableMessageTTL and expirableQueueTTL mirror the x-message-ttl and x-expires arguments
+	// which the RabbitMQ implementation sets on expirable queues.
+	expirableMessageTTL = 20 * time.Second
+	expirableQueueTTL   = 10 * time.Minute
+
+	reaperInterval       = 5 * time.Second
+	maxReapedPerInterval = 1000
+
+	metadataExpirable = "hatchet.expirable"
+	metadataDLQ       = "hatchet.dlq"
+)
+
+// MessageQueueImpl implements the MessageQueue interface on top of NATS. Durable queues are backed by
+// JetStream work-queue streams, while non-durable queues and tenant fanout exchanges use core NATS
+// subjects, which gives the same at-most-once semantics as auto-deleted RabbitMQ queues.
+type MessageQueueImpl struct {
+	ctx      context.Context
+	configFs []MessageQueueImplOpt
+
+	qos int
+
+	l *zerolog.Logger
+
+	nc *natsgo.Conn
+	js jetstream.JetStream
+
+	subjectPrefix string
+	replicas      int
+
+	disableTenantExchangePubs bool
+
+	deadLetterBackoff      time.Duration
+	enableMessageRejection bool
+	maxDeathCount          int
+
+	// lru cache of streams which have already been declared by this instance
+	streamCache *lru.Cache[string, bool]
+
+	reaperOnce sync.Once
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                         *zerolog.Logger
+	url                       string
+	qos                       int
+	subjectPrefix             string
+	replicas                  int
+	disableTenantExchangePubs bool
+	deadLetterBackoff         time.Duration
+	enableMessageRejection    bool
+	maxDeathCount             int
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("nats")
+
+	return &MessageQueueImplOpts{
+		l:                         &l,
+		url:                       natsgo.DefaultURL,
+		qos:                       100,
+		subjectPrefix:             "hatchet",
+		replicas:                  1,
+		disableTenantExchangePubs: false,
+		deadLetterBackoff:         5 * time.Second,
+		enableMessageRejection:    false,
+		maxDeathCount:             5,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithURL(url string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.url = url
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+// WithSubjectPrefix sets the prefix for every subject and stream created by the message queue, which
+// allows multiple Hatchet deployments to share a NATS cluster.
+func WithSubjectPrefix(prefix string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.subjectPrefix = prefix
+	}
+}
+
+// WithReplicas sets the number of replicas for each JetStream stream.
+func WithReplicas(replicas int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.replicas = replicas
+	}
+}
+
+func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantExchangePubs = disable
+	}
+}
+
+func WithDeadLetterBackoff(backoff time.Duration) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.deadLetterBackoff = backoff
+	}
+}
+
+func WithMessageRejection(enabled bool, maxDeathCount int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.enableMessageRejection = enabled
+
+		if maxDeathCount <= 0 {
+			maxDeathCount = 5
+		}
+
+		opts.maxDeathCount = maxDeathCount
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "nats").Logger()
+	opts.l = &newLogger
+
+	if opts.replicas <= 0 {
+		opts.replicas = 1
+	}
+
+	nc, err := natsgo.Connect(
+		opts.url,
+		natsgo.Name("hatchet-engine"),
+		natsgo.MaxReconnects(-1),
+		natsgo.ReconnectWait(2*time.Second),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				opts.l.Error().Err(err).Msg("disconnected from nats")
+			}
+		}),
+		natsgo.ReconnectHandler(func(_ *natsgo.Conn) {
+			opts.l.Info().Msg("reconnected to nats")
+		}),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("could not connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+
+	if err != nil {
+		nc.Close()
+		cancel()
+		return nil, nil, fmt.Errorf("could not create jetstream context: %w", err)
+	}
+
+	t := &MessageQueueImpl{
+		ctx:                       ctx,
+		configFs:                  fs,
+		qos:                       opts.qos,
+		l:                         opts.l,
+		nc:                        nc,
+		js:                        js,
+		subjectPrefix:             opts.subjectPrefix,
+		replicas:                  opts.replicas,
+		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+		deadLetterBackoff:         opts.deadLetterBackoff,
+		enableMessageRejection:    opts.enableMessageRejection,
+		maxDeathCount:             opts.maxDeathCount,
+	}
+
+	t.streamCache, _ = lru.New[string, bool](2000) // nolint: errcheck - this only returns an error if the size is less than 0
+
+	for _, q := range []msgqueue.Queue{msgqueue.TASK_PROCESSING_QUEUE, msgqueue.OLAP_QUEUE, msgqueue.DISPATCHER_DEAD_LETTER_QUEUE} {
+		if _, err := t.initQueue(ctx, q); err != nil {
+			nc.Close()
+			cancel()
+			return nil, nil, fmt.Errorf("failed to initialize queue: %w", err)
+		}
+	}
+
+	return func() error {
+		cancel()
+		return nc.Drain()
+	}, t, nil
+}
+
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue, error) {
+	return New(t.configFs...)
+}
+
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	return t.nc.IsConnected()
+}
+
+func (t *MessageQueueImpl) SendMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "MessageQueueImpl.SendMessage")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("MessageQueueImpl.SendMessage.queue_name", q.Name()),
+		attribute.String("MessageQueueImpl.SendMessage.tenant_id", msg.TenantID.String()),
+		attribute.String("MessageQueueImpl.SendMessage.message_id", msg.ID),
+		attribute.Int("MessageQueueImpl.SendMessage.num_payloads", len(msg.Payloads)),
+	)
+
+	err := t.pubMessage(ctx, q, msg)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "error publishing message")
+		return err
+	}
+
+	return nil
+}
+
+func (t *MessageQueueImpl) pubMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	otelCarrier := telemetry.GetCarrier(ctx)
+
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "publish-message", otelCarrier)
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: msg.TenantID})
+
+	msg.SetOtelCarrier(otelCarrier)
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		t.l.Error().Msgf("error marshaling msg queue: %v", err)
+		return err
+	}
+
+	maxPayloadSize := int(t.nc.MaxPayload())
+
+	if len(body) > maxPayloadSize {
+		if len(msg.Payloads) <= 1 {
+			err := fmt.Errorf("message size %d bytes exceeds maximum allowed size of %d bytes", len(body), maxPayloadSize)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "message size exceeds maximum allowed size")
+			return err
+		}
+
+		// split the payloads in half until each chunk fits within the server's max payload size
+		for chunk := range slices.Chunk(msg.Payloads, max(len(msg.Payloads)/2, 1)) {
+			err := t.pubMessage(ctx, q, &msgqueue.Message{
+				ID:                msg.ID,
+				Payloads:          chunk,
+				TenantID:          msg.TenantID,
+				ImmediatelyExpire: msg.ImmediatelyExpire,
+				Persistent:        msg.Persistent,
+				OtelCarrier:       msg.OtelCarrier,
+				Retries:           msg.Retries,
+				Compressed:        msg.Compressed,
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	t.l.Debug().Msgf("publishing msg to queue %s", q.Name())
+
+	if q.Durable() {
+		if _, err := t.initQueue(ctx, q); err != nil {
+			return err
+		}
+
+		pubOpts := []jetstream.PublishOpt{}
+
+		// this is the closest JetStream equivalent to a RabbitMQ expiration of 0, which drops the
+		// message if it can't be delivered to a consumer straight away
+		if msg.ImmediatelyExpire {
+			pubOpts = append(pubOpts, jetstream.WithMsgTTL(time.Second))
+		}
+
+		if _, err := t.js.Publish(ctx, t.queueSubject(q.Name()), body, pubOpts...); err != nil {
+			return fmt.Errorf("could not publish to queue %s: %w", q.Name(), err)
+		}
+	} else if err := t.nc.Publish(t.queueSubject(q.Name()), body); err != nil {
+		return fmt.Errorf("could not publish to queue %s: %w", q.Name(), err)
+	}
+
+	// if this is a tenant msg, publish to the tenant exchange
+	if (!t.disableTenantExchangePubs || msg.ID == "task-stream-event") && msg.TenantID != uuid.Nil {
+		t.l.Debug().Ctx(ctx).Str("tenant_id", msg.TenantID.String()).Msgf("publishing tenant msg to exchange %s", msg.TenantID)
+
+		if err := t.nc.Publish(t.exchangeSubject(msgqueue.GetTenantExchangeName(msg.TenantID)), body); err != nil {
+			t.l.Error().Ctx(ctx).Str("tenant_id", msg.TenantID.String()).Msgf("error publishing tenant msg: %v", err)
+			return err
+		}
+	}
+
+	t.l.Debug().Msgf("published msg to queue %s", q.Name())
+
+	return nil
+}
+
+// RegisterTenant is a no-op, since tenant exchanges are plain NATS subjects which don't need to be
+// declared before publishing.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId uuid.UUID) error {
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	// automatic DLQs are implemented by redelivering the failed message from the original queue with
+	// a backoff, so there's nothing to subscribe to.
+	if q.IsDLQ() && q.IsAutoDLQ() {
+		return func() error { return nil }, nil
+	}
+
+	if !q.Durable() {
+		return t.subscribeCore(q, preAck, postAck)
+	}
+
+	return t.subscribeStream(q, preAck, postAck)
+}
+
+// subscribeCore subscribes to a non-durable queue or a fanout exchange through core NATS. Messages
+// are not acknowledged, so a failed message is dropped, which matches the behavior of an
+// auto-deleted queue without a dead-letter exchange.
+func (t *MessageQueueImpl) subscribeCore(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, max(t.qos, 1))
+
+	handler := func(natsMsg *natsgo.Msg) {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			msg := &msgqueue.Message{}
+
+			if err := json.Unmarshal(natsMsg.Data, msg); err != nil {
+				t.l.Error().Msgf("error unmarshalling message: %v", err)
+				return
+			}
+
+			if err := preAck(msg); err != nil {
+				t.l.Error().Msgf("error in pre-ack on msg %s: %v", msg.ID, err)
+				return
+			}
+
+			if err := postAck(msg); err != nil {
+				t.l.Error().Msgf("error in post-ack: %v", err)
+			}
+		}()
+	}
+
+	var sub *natsgo.Subscription
+	var err error
+
+	switch {
+	case q.FanoutExchangeKey() != "":
+		// every subscriber to a fanout exchange receives its own copy of each message
+		sub, err = t.nc.Subscribe(t.exchangeSubject(q.FanoutExchangeKey()), handler)
+	case q.Exclusive():
+		sub, err = t.nc.Subscribe(t.queueSubject(q.Name()), handler)
+	default:
+		sub, err = t.nc.QueueSubscribe(t.queueSubject(q.Name()), q.Name(), handler)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe to queue %s: %w", q.Name(), err)
+	}
+
+	return func() error {
+		if err := sub.Unsubscribe(); err != nil && !errors.Is(err, natsgo.ErrConnectionClosed) {
+			t.l.Error().Msgf("error unsubscribing from queue %s: %v", q.Name(), err)
+		}
+
+		wg.Wait()
+
+		return nil
+	}, nil
+}
+
+func (t *MessageQueueImpl) subscribeStream(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	ctx, cancel := context.WithCancel(t.ctx)
+
+	streamName, err := t.initQueue(ctx, q)
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	consumerCfg := jetstream.ConsumerConfig{
+		Durable:   consumerName,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   ackWait,
+		// the number of in-flight messages is bounded per subscriber through the qos, not globally
+		MaxAckPending: -1,
+	}
+
+	if q.IsExpirable() {
+		consumerCfg.InactiveThreshold = expirableQueueTTL
+	}
+
+	consumer, err := t.js.CreateOrUpdateConsumer(ctx, streamName, consumerCfg)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create consumer for queue %s: %w", q.Name(), err)
+	}
+
+	// expirable queues dead-letter into the dispatcher DLQ, so its subscriber is responsible for
+	// moving expired messages into it
+	if q.Name() == msgqueue.DISPATCHER_DEAD_LETTER_QUEUE.Name() {
+		t.reaperOnce.Do(func() {
+			go t.runExpiredMessageReaper()
+		})
+	}
+
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, max(t.qos, 1))
+
+	cc, err := consumer.Consume(
+		func(jsMsg jetstream.Msg) {
+			// block the consume loop while we're at capacity, so we don't buffer more than qos messages
+			sem <- struct{}{}
+			wg.Add(1)
+
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				t.handleStreamMessage(ctx, q, jsMsg, preAck, postAck)
+			}()
+		},
+		jetstream.PullMaxMessages(max(t.qos, 1)),
+		jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+			if ctx.Err() == nil {
+				t.l.Error().Err(err).Msgf("error consuming from queue %s", q.Name())
+			}
+		}),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not consume from queue %s: %w", q.Name(), err)
+	}
+
+	return func() error {
+		t.l.Debug().Msgf("shutting down subscriber: %s", q.Name())
+
+		cc.Stop()
+		wg.Wait()
+		cancel()
+
+		t.l.Debug().Msgf("successfully shut down subscriber: %s", q.Name())
+
+		return nil
+	}, nil
+}
+
+func (t *MessageQueueImpl) handleStreamMessage(
+	ctx context.Context,
+	q msgqueue.Queue,
+	jsMsg jetstream.Msg,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) {
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(jsMsg.Data(), msg); err != nil {
+		t.l.Error().Msgf("error unmarshalling message: %v", err)
+
+		if err := jsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if md, err := jsMsg.Metadata(); err == nil && md.NumDelivered > 1 {
+		// the first delivery is not a retry, so the death count lags the delivery count by one
+		deathCount := int64(md.NumDelivered - 1) // nolint: gosec
+
+		if deathCount > 5 {
+			t.l.Error().
+				Int64("death_count", deathCount).
+				Str("message_id", msg.ID).
+				Str("tenant_id", msg.TenantID.String()).
+				Int("num_payloads", len(msg.Payloads)).
+				Msgf("message has been retried for %d times", deathCount)
+		}
+
+		if t.enableMessageRejection && deathCount > int64(t.maxDeathCount) {
+			t.l.Error().
+				Int64("death_count", deathCount).
+				Str("message_id", msg.ID).
+				Str("tenant_id", msg.TenantID.String()).
+				Int("max_death_count", t.maxDeathCount).
+				Msg("permanently rejecting message due to exceeding max death count")
+
+			if err := jsMsg.Term(); err != nil {
+				t.l.Error().Err(err).Msg("error permanently rejecting message")
+			}
+
+			return
+		}
+	}
+
+	if err := preAck(msg); err != nil {
+		if msgqueue.IsPermanentPreAckError(err) {
+			t.l.Error().
+				Err(err).
+				Str("message_id", msg.ID).
+				Str("tenant_id", msg.TenantID.String()).
+				Int("num_payloads", len(msg.Payloads)).
+				Msg("dropping message due to permanent pre-ack error")
+
+			if ackErr := jsMsg.Term(); ackErr != nil {
+				t.l.Error().Err(ackErr).Msg("error acknowledging message after permanent pre-ack error")
+			}
+
+			return
+		}
+
+		t.l.Error().Msgf("error in pre-ack on msg %s: %v", msg.ID, err)
+
+		t.deadLetter(ctx, q, jsMsg)
+
+		return
+	}
+
+	if err := jsMsg.Ack(); err != nil {
+		t.l.Error().Msgf("error acknowledging message: %v", err)
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Msgf("error in post-ack: %v", err)
+		return
+	}
+}
+
+// deadLetter handles a message which failed its pre-ack hook. Queues with an automatic DLQ redeliver
+// the message after the dead letter backoff, queues with a static DLQ move the message to the DLQ, and
+// all other messages are dropped.
+func (t *MessageQueueImpl) deadLetter(ctx context.Context, q msgqueue.Queue, jsMsg jetstream.Msg) {
+	dlq := q.DLQ()
+
+	switch {
+	case dlq != nil && dlq.IsAutoDLQ():
+		if err := jsMsg.NakWithDelay(t.deadLetterBackoff); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+	case dlq != nil:
+		if _, err := t.initQueue(ctx, dlq); err != nil {
+			t.l.Error().Err(err).Msgf("could not initialize dead letter queue %s", dlq.Name())
+			t.nak(jsMsg)
+			return
+		}
+
+		if _, err := t.js.Publish(ctx, t.queueSubject(dlq.Name()), jsMsg.Data()); err != nil {
+			t.l.Error().Err(err).Msgf("could not publish message to dead letter queue %s", dlq.Name())
+			t.nak(jsMsg)
+			return
+		}
+
+		if err := jsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+	default:
+		if err := jsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+	}
+}
+
+func (t *MessageQueueImpl) nak(jsMsg jetstream.Msg) {
+	if err := jsMsg.NakWithDelay(t.deadLetterBackoff); err != nil {
+		t.l.Error().Msgf("error rejecting message: %v", err)
+	}
+}
+
+// initQueue declares the JetStream stream backing a durable queue and returns the stream name.
+func (t *MessageQueueImpl) initQueue(ctx context.Context, q msgqueue.Queue) (string, error) {
+	name := t.streamName(q.Name())
+
+	if _, ok := t.streamCache.Get(name); ok {
+		return name, nil
+	}
+
+	cfg := jetstream.StreamConfig{
+		Name:        name,
+		Subjects:    []string{t.queueSubject(q.Name())},
+		Retention:   jetstream.WorkQueuePolicy,
+		Storage:     jetstream.FileStorage,
+		Replicas:    t.replicas,
+		AllowMsgTTL: true,
+	}
+
+	if q.IsExpirable() && q.DLQ() != nil {
+		if _, err := t.initQueue(ctx, q.DLQ()); err != nil {
+			return "", err
+		}
+
+		cfg.Metadata = map[string]string{
+			metadataExpirable: "true",
+			metadataDLQ:       q.DLQ().Name(),
+		}
+	}
+
+	if _, err := t.js.CreateOrUpdateStream(ctx, cfg); err != nil {
+		t.l.Error().Msgf("cannot declare stream: %q, %v", name, err)
+		return "", fmt.Errorf("could not declare stream for queue %s: %w", q.Name(), err)
+	}
+
+	t.streamCache.Add(name, true)
+
+	return name, nil
+}
+
+// runExpiredMessageReaper emulates the per-message TTL and dead-letter exchange which the RabbitMQ
+// implementation sets on expirable queues: messages which haven't been consumed within
+// expirableMessageTTL are moved to the queue's DLQ, and streams which have been empty and unused
+// for expirableQueueTTL are deleted.
+func (t *MessageQueueImpl) runExpiredMessageReaper() {
+	ticker := time.NewTicker(reaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.reapExpiredMessages(t.ctx); err != nil && t.ctx.Err() == nil {
+				t.l.Error().Err(err).Msg("error reaping expired messages")
+			}
+		}
+	}
+}
+
+func (t *MessageQueueImpl) reapExpiredMessages(ctx context.Context) error {
+	names := t.js.StreamNames(ctx)
+
+	for name := range names.Name() {
+		if !strings.HasPrefix(name, t.streamName("")) {
+			continue
+		}
+
+		stream, err := t.js.Stream(ctx, name)
+
+		if err != nil {
+			t.l.Error().Err(err).Msgf("error getting stream %s", name)
+			continue
+		}
+
+		if stream.CachedInfo().Config.Metadata[metadataExpirable] != "true" {
+			continue
+		}
+
+		if err := t.reapStream(ctx, stream); err != nil {
+			t.l.Error().Err(err).Msgf("error reaping stream %s", name)
+		}
+	}
+
+	return names.Err()
+}
+
+func (t *MessageQueueImpl) reapStream(ctx context.Context, stream jetstream.Stream) error {
+	info := stream.CachedInfo()
+
+	if info.State.Msgs == 0 {
+		if info.State.Consumers == 0 && time.Since(info.State.LastTime) > expirableQueueTTL && time.Since(info.Created) > expirableQueueTTL {
+			t.streamCache.Remove(info.Config.Name)
+			return t.js.DeleteStream(ctx, info.Config.Name)
+		}
+
+		return nil
+	}
+
+	var ackFloor, delivered uint64
+
+	if c, err := stream.Consumer(ctx, consumerName); err == nil {
+		if ci, err := c.Info(ctx); err == nil {
+			ackFloor = ci.AckFloor.Stream
+			delivered = ci.Delivered.Stream
+		}
+	}
+
+	dlqSubject := t.queueSubject(info.Config.Metadata[metadataDLQ])
+	cutoff := time.Now().Add(-expirableMessageTTL)
+	seq := info.State.FirstSeq
+
+	for range maxReapedPerInterval {
+		// fetch the next message at or after seq, skipping sequences that were already acked
+		raw, err := stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(info.Config.Subjects[0]))
+
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if raw.Time.After(cutoff) {
+			return nil
+		}
+
+		seq = raw.Sequence + 1
+
+		// messages which are currently delivered to a consumer are not expired, we wait for them to
+		// be acked or redelivered
+		if raw.Sequence > ackFloor && raw.Sequence <= delivered {
+			continue
+		}
+
+		// the message id makes this idempotent if multiple reapers race on the same message
+		msgId := fmt.Sprintf("%s-%d", info.Config.Name, raw.Sequence)
+
+		if _, err := t.js.Publish(ctx, dlqSubject, raw.Data, jetstream.WithMsgID(msgId)); err != nil {
+			return fmt.Errorf("could not move message to dead letter queue: %w", err)
+		}
+
+		if err := stream.DeleteMsg(ctx, raw.Sequence); err != nil && !errors.Is(err, jetstream.ErrMsgNotFound) {
+			return fmt.Errorf("could not delete expired message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (t *MessageQueueImpl) queueSubject(name string) string {
+	return fmt.Sprintf("%s.queue.%s", t.subjectPrefix, name)
+}
+
+func (t *MessageQueueImpl) exchangeSubject(name string) string {
+	return fmt.Sprintf("%s.exchange.%s", t.subjectPrefix, name)
+}
+
+// streamName returns a valid JetStream stream name for a queue, which may not contain dots.
+func (t *MessageQueueImpl) streamName(name string) string {
+	return strings.ReplaceAll(fmt.Sprintf("%s_%s", t.subjectPrefix, name), ".", "_")
+}
@@ -0,0 +1,218 @@
+//go:build integration
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+)
+
+var testTenantUUID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// newTestMQ connects to the nats-server at NATS_URL (default nats://localhost:4222), which must have
+// JetStream enabled. Every test uses a random subject prefix so that streams don't collide.
+func newTestMQ(t *testing.T, fs ...MessageQueueImplOpt) *MessageQueueImpl {
+	url := os.Getenv("NATS_URL")
+
+	if url == "" {
+		url = "nats://localhost:4222"
+	}
+
+	prefix, _ := random.Generate(8) // nolint: errcheck
+
+	fs = append([]MessageQueueImplOpt{
+		WithURL(url),
+		WithQos(100),
+		WithSubjectPrefix("hatchettest" + prefix),
+	}, fs...)
+
+	cleanup, tq, err := New(fs...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		tq.deleteStreams(t)
+
+		if err := cleanup(); err != nil {
+			t.Fatalf("error cleaning up queue: %v", err)
+		}
+	})
+
+	return tq
+}
+
+func TestMessageQueueIntegration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2) // we wait for 2 messages here
+
+	tq := newTestMQ(t)
+
+	id, _ := random.Generate(8) // nolint: errcheck
+
+	staticQueue := msgqueue.NewRandomStaticQueue()
+
+	task, err := msgqueue.NewTenantMessage(testTenantUUID, id, false, true, map[string]interface{}{"key": "value"})
+	require.NoError(t, err)
+
+	// messages sent before the subscription is created are buffered by the stream
+	err = tq.SendMessage(ctx, staticQueue, task)
+	require.NoError(t, err, "adding task to static queue should not error")
+
+	cleanupQueue, err := tq.Subscribe(staticQueue, func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received task ID should match sent task ID")
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	err = tq.RegisterTenant(ctx, testTenantUUID)
+	require.NoError(t, err, "registering tenant should not error")
+
+	cleanupTenantQueue, err := tq.Subscribe(msgqueue.TenantEventConsumerQueue(testTenantUUID), func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received tenant task ID should match sent task ID")
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to tenant-specific queue should not error")
+
+	// only the second message reaches the tenant exchange, since it didn't have a subscriber yet
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		err := tq.SendMessage(ctx, msgqueue.QueueTypeFromPartitionIDAndController("test", "controller"), task)
+		assert.NoError(t, err, "adding task to queue should not error")
+	}()
+
+	wg.Wait()
+
+	require.NoError(t, cleanupQueue())
+	require.NoError(t, cleanupTenantQueue())
+}
+
+func TestNonDurableQueueIntegration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tq := newTestMQ(t)
+
+	q := msgqueue.QueueTypeFromPartitionIDAndController(uuid.NewString(), msgqueue.Scheduler)
+	received := make(chan string, 1)
+
+	cleanupQueue, err := tq.Subscribe(q, func(receivedMessage *msgqueue.Message) error {
+		received <- receivedMessage.ID
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	task, err := msgqueue.NewTenantMessage(uuid.Nil, "non-durable", false, false, map[string]interface{}{"key": "value"})
+	require.NoError(t, err)
+
+	require.NoError(t, tq.SendMessage(ctx, q, task))
+
+	select {
+	case id := <-received:
+		assert.Equal(t, "non-durable", id)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for message")
+	}
+
+	require.NoError(t, cleanupQueue())
+}
+
+func TestDeadLetteringSuccess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var attempts atomic.Int32
+	done := make(chan struct{})
+
+	tq := newTestMQ(t, WithDeadLetterBackoff(time.Second))
+
+	id, _ := random.Generate(8) // nolint: errcheck
+
+	staticQueue := msgqueue.NewRandomStaticQueue()
+
+	task, err := msgqueue.NewTenantMessage(testTenantUUID, id, false, true, map[string]interface{}{"key": "value"})
+	require.NoError(t, err)
+
+	start := time.Now()
+
+	cleanupQueue, err := tq.Subscribe(staticQueue, func(receivedMessage *msgqueue.Message) error {
+		if n := attempts.Add(1); n <= 2 {
+			return fmt.Errorf("intentional error on attempt %d", n)
+		}
+
+		close(done)
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	require.NoError(t, tq.SendMessage(ctx, staticQueue, task))
+
+	select {
+	case <-done:
+		assert.GreaterOrEqual(t, time.Since(start), 2*time.Second, "retries should respect the dead letter backoff")
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for message to be retried")
+	}
+
+	require.NoError(t, cleanupQueue())
+}
+
+func TestExpiredMessagesAreDeadLettered(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	tq := newTestMQ(t)
+
+	// nobody consumes from this dispatcher queue, so its message should expire into the DLQ
+	dispatcherQueue := msgqueue.QueueTypeFromDispatcherID(uuid.New())
+
+	task, err := msgqueue.NewTenantMessage(testTenantUUID, "expired", false, true, map[string]interface{}{"key": "value"})
+	require.NoError(t, err)
+
+	require.NoError(t, tq.SendMessage(ctx, dispatcherQueue, task))
+
+	received := make(chan string, 1)
+
+	cleanupDLQ, err := tq.Subscribe(msgqueue.DISPATCHER_DEAD_LETTER_QUEUE, func(receivedMessage *msgqueue.Message) error {
+		received <- receivedMessage.ID
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err)
+
+	select {
+	case id := <-received:
+		assert.Equal(t, "expired", id)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for expired message")
+	}
+
+	require.NoError(t, cleanupDLQ())
+}
+
+// deleteStreams is a helper function for removing the streams created by a test.
+func (t *MessageQueueImpl) deleteStreams(tt *testing.T) {
+	ctx := context.Background()
+
+	names := t.js.StreamNames(ctx)
+
+	for name := range names.Name() {
+		if err := t.js.DeleteStream(ctx, name); err != nil {
+			tt.Logf("cannot delete stream %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package msgqueue
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// IsPermanentPreAckError returns true if the error returned from a pre-ack hook can never succeed
+// on redelivery, in which case implementations should drop the message instead of retrying it.
+func IsPermanentPreAckError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// invalid input syntax for type json / jsonb
+		if pgErr.Code == pgerrcode.InvalidTextRepresentation {
+			return true
+		}
+	}
+
+	// Fallback: some error paths may lose pg error type info.
+	errStr := err.Error()
+	if strings.Contains(errStr, fmt.Sprintf("SQLSTATE %s", pgerrcode.InvalidTextRepresentation)) {
+		return true
+	}
+	if strings.Contains(errStr, "invalid input syntax for type json") {
+		return true
+	}
+
+	return false
+}
@@ -4,18 +4,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"os"
 	"slices"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
-	"github.com/jackc/pgerrcode"
-	"github.com/jackc/pgx/v5/pgconn"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel/attribute"
@@ -831,7 +827,7 @@ func (t *MessageQueueImpl) subscribe(
 				t.l.Debug().Msgf("(session: %d) got msg", session)
 
 				if err := preAck(msg); err != nil {
-					if isPermanentPreAckError(err) {
+					if msgqueue.IsPermanentPreAckError(err) {
 						t.l.Error().
 							Err(err).
 							Str("message_id", msg.ID).
@@ -911,31 +907,6 @@ func (t *MessageQueueImpl) subscribe(
 	return cleanup, nil
 }
 
-func isPermanentPreAckError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	var pgErr *pgconn.PgError
-	if errors.As(err, &pgErr) {
-		// invalid input syntax for type json / jsonb
-		if pgErr.Code == pgerrcode.InvalidTextRepresentation {
-			return true
-		}
-	}
-
-	// Fallback: some error paths may lose pg error type info.
-	errStr := err.Error()
-	if strings.Contains(errStr, fmt.Sprintf("SQLSTATE %s", pgerrcode.InvalidTextRepresentation)) {
-		return true
-	}
-	if strings.Contains(errStr, "invalid input syntax for type json") {
-		return true
-	}
-
-	return false
-}
-
 // identity returns the same host/process unique string for the lifetime of
 // this process so that subscriber reconnections reuse the same queue name.
 func identity() string {
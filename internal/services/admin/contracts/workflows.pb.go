@@ -233,6 +233,55 @@ func (RateLimitDuration) EnumDescriptor() ([]byte, []int) {
 	return file_workflows_proto_rawDescGZIP(), []int{3}
 }
 
+type RateLimitMode int32
+
+const (
+	RateLimitMode_FIXED_WINDOW   RateLimitMode = 0 // the limit resets at the start of every window
+	RateLimitMode_SLIDING_WINDOW RateLimitMode = 1 // the limit applies to any window-length interval
+	RateLimitMode_TOKEN_BUCKET   RateLimitMode = 2 // the limit refills continuously, up to the burst size
+)
+
+// Enum value maps for RateLimitMode.
+var (
+	RateLimitMode_name = map[int32]string{
+		0: "FIXED_WINDOW",
+		1: "SLIDING_WINDOW",
+		2: "TOKEN_BUCKET",
+	}
+	RateLimitMode_value = map[string]int32{
+		"FIXED_WINDOW":   0,
+		"SLIDING_WINDOW": 1,
+		"TOKEN_BUCKET":   2,
+	}
+)
+
+func (x RateLimitMode) Enum() *RateLimitMode {
+	p := new(RateLimitMode)
+	*p = x
+	return p
+}
+
+func (x RateLimitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_workflows_proto_enumTypes[4].Descriptor()
+}
+
+func (RateLimitMode) Type() protoreflect.EnumType {
+	return &file_workflows_proto_enumTypes[4]
+}
+
+func (x RateLimitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitMode.Descriptor instead.
+func (RateLimitMode) EnumDescriptor() ([]byte, []int) {
+	return file_workflows_proto_rawDescGZIP(), []int{4}
+}
+
 type PutWorkflowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1357,6 +1406,10 @@ type PutRateLimitRequest struct {
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	// (required) the duration of time for the rate limit (second|minute|hour)
 	Duration RateLimitDuration `protobuf:"varint,3,opt,name=duration,proto3,enum=RateLimitDuration" json:"duration,omitempty"`
+	// (optional) the refill mode for the rate limit, defaults to FIXED_WINDOW
+	Mode *RateLimitMode `protobuf:"varint,4,opt,name=mode,proto3,enum=RateLimitMode,oneof" json:"mode,omitempty"`
+	// (optional) the maximum number of units which can be used in a burst, only valid for TOKEN_BUCKET. Defaults to the limit.
+	Burst *int32 `protobuf:"varint,5,opt,name=burst,proto3,oneof" json:"burst,omitempty"`
 }
 
 func (x *PutRateLimitRequest) Reset() {
@@ -1412,6 +1465,20 @@ func (x *PutRateLimitRequest) GetDuration() RateLimitDuration {
 	return RateLimitDuration_SECOND
 }
 
+func (x *PutRateLimitRequest) GetMode() RateLimitMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return RateLimitMode_FIXED_WINDOW
+}
+
+func (x *PutRateLimitRequest) GetBurst() int32 {
+	if x != nil && x.Burst != nil {
+		return *x.Burst
+	}
+	return 0
+}
+
 type PutRateLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1670,62 +1737,72 @@ var file_workflows_proto_rawDesc = []byte{
 	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
 	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x13,
-	0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x50,
-	0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2a, 0x24, 0x0a, 0x0e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x48, 0x41, 0x52, 0x44, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0c, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55, 0x4e,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x55, 0x52, 0x41, 0x42,
-	0x4c, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x47, 0x10, 0x02, 0x2a, 0x7f, 0x0a,
-	0x18, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e,
-	0x43, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10,
-	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x45,
-	0x53, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f,
-	0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43,
-	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x5d,
-	0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x08,
-	0x0a, 0x04, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54,
-	0x48, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x59, 0x45, 0x41, 0x52, 0x10, 0x06, 0x32, 0xdf, 0x02,
-	0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x12, 0x13, 0x2e, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1b, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x14, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a,
+	0x13, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x75,
+	0x72, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x24, 0x0a, 0x0e, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x52, 0x44, 0x10,
+	0x01, 0x2a, 0x32, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x55, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x44, 0x41, 0x47, 0x10, 0x02, 0x2a, 0x7f, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49,
+	0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4e, 0x45,
+	0x57, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x5d, 0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49, 0x4e, 0x55, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x59,
+	0x45, 0x41, 0x52, 0x10, 0x06, 0x2a, 0x47, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4c, 0x49, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x02, 0x32, 0xdf,
+	0x02, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x12, 0x13, 0x2e, 0x50, 0x75, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1b, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x50, 0x75, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1740,71 +1817,73 @@ func file_workflows_proto_rawDescGZIP() []byte {
 	return file_workflows_proto_rawDescData
 }
 
-var file_workflows_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_workflows_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_workflows_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_workflows_proto_goTypes = []interface{}{
 	(StickyStrategy)(0),                 // 0: StickyStrategy
 	(WorkflowKind)(0),                   // 1: WorkflowKind
 	(ConcurrencyLimitStrategy)(0),       // 2: ConcurrencyLimitStrategy
 	(RateLimitDuration)(0),              // 3: RateLimitDuration
-	(*PutWorkflowRequest)(nil),          // 4: PutWorkflowRequest
-	(*CreateWorkflowVersionOpts)(nil),   // 5: CreateWorkflowVersionOpts
-	(*WorkflowConcurrencyOpts)(nil),     // 6: WorkflowConcurrencyOpts
-	(*CreateWorkflowJobOpts)(nil),       // 7: CreateWorkflowJobOpts
-	(*CreateWorkflowStepOpts)(nil),      // 8: CreateWorkflowStepOpts
-	(*CreateStepRateLimit)(nil),         // 9: CreateStepRateLimit
-	(*ListWorkflowsRequest)(nil),        // 10: ListWorkflowsRequest
-	(*ScheduleWorkflowRequest)(nil),     // 11: ScheduleWorkflowRequest
-	(*ScheduledWorkflow)(nil),           // 12: ScheduledWorkflow
-	(*WorkflowVersion)(nil),             // 13: WorkflowVersion
-	(*WorkflowTriggerEventRef)(nil),     // 14: WorkflowTriggerEventRef
-	(*WorkflowTriggerCronRef)(nil),      // 15: WorkflowTriggerCronRef
-	(*BulkTriggerWorkflowRequest)(nil),  // 16: BulkTriggerWorkflowRequest
-	(*BulkTriggerWorkflowResponse)(nil), // 17: BulkTriggerWorkflowResponse
-	(*TriggerWorkflowResponse)(nil),     // 18: TriggerWorkflowResponse
-	(*PutRateLimitRequest)(nil),         // 19: PutRateLimitRequest
-	(*PutRateLimitResponse)(nil),        // 20: PutRateLimitResponse
-	nil,                                 // 21: CreateWorkflowStepOpts.WorkerLabelsEntry
-	(*timestamppb.Timestamp)(nil),       // 22: google.protobuf.Timestamp
-	(*v1.TriggerWorkflowRequest)(nil),   // 23: v1.TriggerWorkflowRequest
-	(*v1.DesiredWorkerLabels)(nil),      // 24: v1.DesiredWorkerLabels
+	(RateLimitMode)(0),                  // 4: RateLimitMode
+	(*PutWorkflowRequest)(nil),          // 5: PutWorkflowRequest
+	(*CreateWorkflowVersionOpts)(nil),   // 6: CreateWorkflowVersionOpts
+	(*WorkflowConcurrencyOpts)(nil),     // 7: WorkflowConcurrencyOpts
+	(*CreateWorkflowJobOpts)(nil),       // 8: CreateWorkflowJobOpts
+	(*CreateWorkflowStepOpts)(nil),      // 9: CreateWorkflowStepOpts
+	(*CreateStepRateLimit)(nil),         // 10: CreateStepRateLimit
+	(*ListWorkflowsRequest)(nil),        // 11: ListWorkflowsRequest
+	(*ScheduleWorkflowRequest)(nil),     // 12: ScheduleWorkflowRequest
+	(*ScheduledWorkflow)(nil),           // 13: ScheduledWorkflow
+	(*WorkflowVersion)(nil),             // 14: WorkflowVersion
+	(*WorkflowTriggerEventRef)(nil),     // 15: WorkflowTriggerEventRef
+	(*WorkflowTriggerCronRef)(nil),      // 16: WorkflowTriggerCronRef
+	(*BulkTriggerWorkflowRequest)(nil),  // 17: BulkTriggerWorkflowRequest
+	(*BulkTriggerWorkflowResponse)(nil), // 18: BulkTriggerWorkflowResponse
+	(*TriggerWorkflowResponse)(nil),     // 19: TriggerWorkflowResponse
+	(*PutRateLimitRequest)(nil),         // 20: PutRateLimitRequest
+	(*PutRateLimitResponse)(nil),        // 21: PutRateLimitResponse
+	nil,                                 // 22: CreateWorkflowStepOpts.WorkerLabelsEntry
+	(*timestamppb.Timestamp)(nil),       // 23: google.protobuf.Timestamp
+	(*v1.TriggerWorkflowRequest)(nil),   // 24: v1.TriggerWorkflowRequest
+	(*v1.DesiredWorkerLabels)(nil),      // 25: v1.DesiredWorkerLabels
 }
 var file_workflows_proto_depIdxs = []int32{
-	5,  // 0: PutWorkflowRequest.opts:type_name -> CreateWorkflowVersionOpts
-	22, // 1: CreateWorkflowVersionOpts.scheduled_triggers:type_name -> google.protobuf.Timestamp
-	7,  // 2: CreateWorkflowVersionOpts.jobs:type_name -> CreateWorkflowJobOpts
-	6,  // 3: CreateWorkflowVersionOpts.concurrency:type_name -> WorkflowConcurrencyOpts
-	7,  // 4: CreateWorkflowVersionOpts.on_failure_job:type_name -> CreateWorkflowJobOpts
+	6,  // 0: PutWorkflowRequest.opts:type_name -> CreateWorkflowVersionOpts
+	23, // 1: CreateWorkflowVersionOpts.scheduled_triggers:type_name -> google.protobuf.Timestamp
+	8,  // 2: CreateWorkflowVersionOpts.jobs:type_name -> CreateWorkflowJobOpts
+	7,  // 3: CreateWorkflowVersionOpts.concurrency:type_name -> WorkflowConcurrencyOpts
+	8,  // 4: CreateWorkflowVersionOpts.on_failure_job:type_name -> CreateWorkflowJobOpts
 	0,  // 5: CreateWorkflowVersionOpts.sticky:type_name -> StickyStrategy
 	1,  // 6: CreateWorkflowVersionOpts.kind:type_name -> WorkflowKind
 	2,  // 7: WorkflowConcurrencyOpts.limit_strategy:type_name -> ConcurrencyLimitStrategy
-	8,  // 8: CreateWorkflowJobOpts.steps:type_name -> CreateWorkflowStepOpts
-	9,  // 9: CreateWorkflowStepOpts.rate_limits:type_name -> CreateStepRateLimit
-	21, // 10: CreateWorkflowStepOpts.worker_labels:type_name -> CreateWorkflowStepOpts.WorkerLabelsEntry
+	9,  // 8: CreateWorkflowJobOpts.steps:type_name -> CreateWorkflowStepOpts
+	10, // 9: CreateWorkflowStepOpts.rate_limits:type_name -> CreateStepRateLimit
+	22, // 10: CreateWorkflowStepOpts.worker_labels:type_name -> CreateWorkflowStepOpts.WorkerLabelsEntry
 	3,  // 11: CreateStepRateLimit.duration:type_name -> RateLimitDuration
-	22, // 12: ScheduleWorkflowRequest.schedules:type_name -> google.protobuf.Timestamp
-	22, // 13: ScheduledWorkflow.trigger_at:type_name -> google.protobuf.Timestamp
-	22, // 14: WorkflowVersion.created_at:type_name -> google.protobuf.Timestamp
-	22, // 15: WorkflowVersion.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 16: WorkflowVersion.scheduled_workflows:type_name -> ScheduledWorkflow
-	23, // 17: BulkTriggerWorkflowRequest.workflows:type_name -> v1.TriggerWorkflowRequest
+	23, // 12: ScheduleWorkflowRequest.schedules:type_name -> google.protobuf.Timestamp
+	23, // 13: ScheduledWorkflow.trigger_at:type_name -> google.protobuf.Timestamp
+	23, // 14: WorkflowVersion.created_at:type_name -> google.protobuf.Timestamp
+	23, // 15: WorkflowVersion.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 16: WorkflowVersion.scheduled_workflows:type_name -> ScheduledWorkflow
+	24, // 17: BulkTriggerWorkflowRequest.workflows:type_name -> v1.TriggerWorkflowRequest
 	3,  // 18: PutRateLimitRequest.duration:type_name -> RateLimitDuration
-	24, // 19: CreateWorkflowStepOpts.WorkerLabelsEntry.value:type_name -> v1.DesiredWorkerLabels
-	4,  // 20: WorkflowService.PutWorkflow:input_type -> PutWorkflowRequest
-	11, // 21: WorkflowService.ScheduleWorkflow:input_type -> ScheduleWorkflowRequest
-	23, // 22: WorkflowService.TriggerWorkflow:input_type -> v1.TriggerWorkflowRequest
-	16, // 23: WorkflowService.BulkTriggerWorkflow:input_type -> BulkTriggerWorkflowRequest
-	19, // 24: WorkflowService.PutRateLimit:input_type -> PutRateLimitRequest
-	13, // 25: WorkflowService.PutWorkflow:output_type -> WorkflowVersion
-	13, // 26: WorkflowService.ScheduleWorkflow:output_type -> WorkflowVersion
-	18, // 27: WorkflowService.TriggerWorkflow:output_type -> TriggerWorkflowResponse
-	17, // 28: WorkflowService.BulkTriggerWorkflow:output_type -> BulkTriggerWorkflowResponse
-	20, // 29: WorkflowService.PutRateLimit:output_type -> PutRateLimitResponse
-	25, // [25:30] is the sub-list for method output_type
-	20, // [20:25] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	4,  // 19: PutRateLimitRequest.mode:type_name -> RateLimitMode
+	25, // 20: CreateWorkflowStepOpts.WorkerLabelsEntry.value:type_name -> v1.DesiredWorkerLabels
+	5,  // 21: WorkflowService.PutWorkflow:input_type -> PutWorkflowRequest
+	12, // 22: WorkflowService.ScheduleWorkflow:input_type -> ScheduleWorkflowRequest
+	24, // 23: WorkflowService.TriggerWorkflow:input_type -> v1.TriggerWorkflowRequest
+	17, // 24: WorkflowService.BulkTriggerWorkflow:input_type -> BulkTriggerWorkflowRequest
+	20, // 25: WorkflowService.PutRateLimit:input_type -> PutRateLimitRequest
+	14, // 26: WorkflowService.PutWorkflow:output_type -> WorkflowVersion
+	14, // 27: WorkflowService.ScheduleWorkflow:output_type -> WorkflowVersion
+	19, // 28: WorkflowService.TriggerWorkflow:output_type -> TriggerWorkflowResponse
+	18, // 29: WorkflowService.BulkTriggerWorkflow:output_type -> BulkTriggerWorkflowResponse
+	21, // 30: WorkflowService.PutRateLimit:output_type -> PutRateLimitResponse
+	26, // [26:31] is the sub-list for method output_type
+	21, // [21:26] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_workflows_proto_init() }
@@ -2023,12 +2102,13 @@ func file_workflows_proto_init() {
 	file_workflows_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_workflows_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_workflows_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_workflows_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_workflows_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
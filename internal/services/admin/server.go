@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/inputvalidation"
 	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 
@@ -224,6 +225,12 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		)
 
 		if err != nil {
+			invalidInput := &v1.ErrInvalidInput{}
+
+			if errors.As(err, &invalidInput) {
+				return nil, inputvalidation.InvalidInputStatus(invalidInput)
+			}
+
 			return nil, err
 		}
 
@@ -255,6 +262,23 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 		Duration: &duration,
 	}
 
+	if req.Mode != nil {
+		mode := req.Mode.String()
+		createOpts.Mode = &mode
+	}
+
+	if req.Burst != nil {
+		if req.GetMode() != contracts.RateLimitMode_TOKEN_BUCKET {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"burst is only supported for TOKEN_BUCKET rate limits",
+			)
+		}
+
+		burst := int(*req.Burst)
+		createOpts.Burst = &burst
+	}
+
 	_, err := a.repov1.RateLimit().UpsertRateLimit(ctx, tenantId, req.Key, createOpts)
 
 	if err != nil {
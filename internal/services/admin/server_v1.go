@@ -14,6 +14,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/inputvalidation"
 	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/pkg/constants"
@@ -247,6 +248,14 @@ func (i *AdminServiceImpl) ingest(ctx context.Context, tenantId uuid.UUID, opts
 		return nil
 	}
 
+	if err := inputvalidation.ValidateTriggerInput(ctx, i.l, i.mqv1, i.repov1, tenantId, optsToSend); err != nil {
+		return err
+	}
+
+	if err := i.checkScopedLimits(ctx, tenantId, optsToSend); err != nil {
+		return err
+	}
+
 	if i.localScheduler != nil {
 		localWorkerIds := map[uuid.UUID]struct{}{}
 
@@ -336,3 +345,20 @@ func (i *AdminServiceImpl) ingest(ctx context.Context, tenantId uuid.UUID, opts
 
 	return nil
 }
+
+// checkScopedLimits rejects the workflow runs if a scoped resource limit of the tenant has no task runs left for them
+func (i *AdminServiceImpl) checkScopedLimits(ctx context.Context, tenantId uuid.UUID, opts []*v1.WorkflowNameTriggerOpts) error {
+	err := i.repov1.Triggers().PreflightCheckScopedLimits(ctx, tenantId, opts)
+
+	if err != nil {
+		exhausted := &v1.ScopedResourceExhaustedError{}
+
+		if errors.As(err, &exhausted) {
+			return status.Error(codes.ResourceExhausted, exhausted.Error())
+		}
+
+		return fmt.Errorf("could not check scoped resource limits: %w", err)
+	}
+
+	return nil
+}
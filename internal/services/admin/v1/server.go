@@ -16,6 +16,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/listutils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/inputvalidation"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/internal/statusutils"
@@ -281,11 +282,8 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 		return nil, err
 	}
 
-	// Deduplicate based on TaskIdInsertedAtRetryCountWithExternalId
-	existingReplays := make(map[tasktypes.TaskIdInsertedAtRetryCountWithExternalId]bool)
-
 	for _, task := range tasks {
-		record := tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
+		tasksToReplay = append(tasksToReplay, tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
 			TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{
 				Id:         task.ID,
 				InsertedAt: task.InsertedAt,
@@ -293,50 +291,10 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 			},
 			WorkflowRunExternalId: task.WorkflowRunID,
 			TaskExternalId:        task.ExternalID,
-		}
-
-		if _, exists := existingReplays[record]; exists {
-			continue
-		}
-
-		existingReplays[record] = true
-		tasksToReplay = append(tasksToReplay, record)
-	}
-
-	workflowRunIdToTasksToReplay := make(map[uuid.UUID][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId)
-	for _, item := range tasksToReplay {
-		workflowRunIdToTasksToReplay[item.WorkflowRunExternalId] = append(
-			workflowRunIdToTasksToReplay[item.WorkflowRunExternalId],
-			item,
-		)
-	}
-
-	var batches [][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId
-	var currentBatch []tasktypes.TaskIdInsertedAtRetryCountWithExternalId
-	batchSize := 100
-
-	for _, tasksForWorkflowRun := range workflowRunIdToTasksToReplay {
-		if len(currentBatch) > 0 && len(currentBatch)+len(tasksForWorkflowRun) > batchSize {
-			// If the current batch would exceed the batch size if we added the current workflow run's tasks,
-			// we "finalize" the batch and start a new one
-			batches = append(batches, currentBatch)
-			currentBatch = nil
-		}
-
-		if len(tasksForWorkflowRun) > batchSize {
-			// If the current workflow run's task count exceeds the batch size on its own,
-			// we let it be its own batch
-			batches = append(batches, tasksForWorkflowRun)
-		} else {
-			// Otherwise, add it to the current batch
-			currentBatch = append(currentBatch, tasksForWorkflowRun...)
-		}
+		})
 	}
 
-	if len(currentBatch) > 0 {
-		// Last case to handle - add the last batch if it has any tasks
-		batches = append(batches, currentBatch)
-	}
+	batches := tasktypes.BatchReplayTasks(tasksToReplay, 100)
 
 	replayedIds := make([]string, 0)
 
@@ -565,6 +523,7 @@ func (a *AdminServiceImpl) newTriggerOpt(
 		WorkflowName:       req.WorkflowName,
 		Data:               req.Input,
 		AdditionalMetadata: req.AdditionalMetadata,
+		Version:            req.Version,
 	}
 
 	if len(req.DesiredWorkerLabels) > 0 {
@@ -602,6 +561,14 @@ func (a *AdminServiceImpl) ingest(ctx context.Context, tenantId uuid.UUID, opts
 		return nil
 	}
 
+	if err := inputvalidation.ValidateTriggerInput(ctx, a.l, a.mq, a.repo, tenantId, optsToSend); err != nil {
+		return err
+	}
+
+	if err := a.checkScopedLimits(ctx, tenantId, optsToSend); err != nil {
+		return err
+	}
+
 	if a.localScheduler != nil {
 		localWorkerIds := map[uuid.UUID]struct{}{}
 
@@ -836,6 +803,13 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		var limitStrategy *string
 
 		if req.Concurrency.LimitStrategy != nil && req.Concurrency.LimitStrategy.String() != "" {
+			if *req.Concurrency.LimitStrategy == contracts.ConcurrencyLimitStrategy_WEIGHTED_FAIR {
+				return nil, status.Error(
+					codes.InvalidArgument,
+					"WEIGHTED_FAIR concurrency is only supported on tasks",
+				)
+			}
+
 			s := req.Concurrency.LimitStrategy.String()
 			limitStrategy = &s
 		}
@@ -858,6 +832,13 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		var limitStrategy *string
 
 		if c.LimitStrategy != nil && c.LimitStrategy.String() != "" {
+			if *c.LimitStrategy == contracts.ConcurrencyLimitStrategy_WEIGHTED_FAIR {
+				return nil, status.Error(
+					codes.InvalidArgument,
+					"WEIGHTED_FAIR concurrency is only supported on tasks",
+				)
+			}
+
 			s := c.LimitStrategy.String()
 			limitStrategy = &s
 		}
@@ -898,18 +879,20 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 	}
 
 	return &v1.CreateWorkflowVersionOpts{
-		Name:            req.Name,
-		Concurrency:     concurrency,
-		Description:     &req.Description,
-		EventTriggers:   req.EventTriggers,
-		CronTriggers:    req.CronTriggers,
-		CronInput:       cronInput,
-		Tasks:           tasks,
-		OnFailure:       onFailureTask,
-		Sticky:          sticky,
-		DefaultPriority: req.DefaultPriority,
-		DefaultFilters:  defaultFilters,
-		InputJsonSchema: req.InputJsonSchema,
+		Name:             req.Name,
+		Concurrency:      concurrency,
+		Description:      &req.Description,
+		EventTriggers:    req.EventTriggers,
+		CronTriggers:     req.CronTriggers,
+		CronInput:        cronInput,
+		Tasks:            tasks,
+		OnFailure:        onFailureTask,
+		Sticky:           sticky,
+		DefaultPriority:  req.DefaultPriority,
+		DefaultFilters:   defaultFilters,
+		InputJsonSchema:  req.InputJsonSchema,
+		Version:          req.Version,
+		CanaryPercentage: req.CanaryPercentage,
 	}, nil
 }
 
@@ -992,6 +975,10 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 			steps[j].SlotRequests = stepCp.SlotRequests
 		}
 
+		if len(stepCp.OutputJsonSchema) > 0 {
+			steps[j].OutputJsonSchema = stepCp.OutputJsonSchema
+		}
+
 		// Safely set Parents
 		if stepCp.Parents != nil {
 			steps[j].Parents = stepCp.Parents
@@ -1013,6 +1000,39 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 			}
 		}
 
+		if stepCp.BackoffStrategy != nil {
+			strategy := stepCp.BackoffStrategy.String()
+			steps[j].RetryBackoffStrategy = &strategy
+		}
+
+		if stepCp.BackoffBaseSeconds != nil {
+			base := float64(*stepCp.BackoffBaseSeconds)
+			steps[j].RetryBackoffBaseSeconds = &base
+		}
+
+		for _, rule := range stepCp.RetryRules {
+			if rule == nil {
+				continue
+			}
+
+			ruleOpt := v1.CreateStepRetryRuleOpts{
+				ErrorType:  rule.ErrorType,
+				MaxRetries: rule.MaxRetries,
+			}
+
+			if rule.BackoffStrategy != nil {
+				strategy := rule.BackoffStrategy.String()
+				ruleOpt.BackoffStrategy = &strategy
+			}
+
+			if rule.BackoffBaseSeconds != nil {
+				base := float64(*rule.BackoffBaseSeconds)
+				ruleOpt.BackoffBaseSeconds = &base
+			}
+
+			steps[j].RetryRules = append(steps[j].RetryRules, ruleOpt)
+		}
+
 		if stepCp.Timeout != "" {
 			steps[j].Timeout = &stepCp.Timeout
 		}
@@ -1168,9 +1188,10 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 				}
 
 				steps[j].Concurrency = append(steps[j].Concurrency, v1.CreateConcurrencyOpts{
-					Expression:    concurrency.Expression,
-					MaxRuns:       concurrency.MaxRuns,
-					LimitStrategy: limitStrategy,
+					Expression:       concurrency.Expression,
+					MaxRuns:          concurrency.MaxRuns,
+					LimitStrategy:    limitStrategy,
+					WeightExpression: concurrency.WeightExpression,
 				})
 			}
 		}
@@ -1195,7 +1216,8 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 
 	var hasTaskRateLimits, hasTaskWorkerLabels, hasTaskRetries, hasTaskBackoff,
 		hasTaskTimeout, hasTaskDag, hasTaskConcurrency, hasTaskConditions,
-		hasTaskDurable, hasTaskSlotRequests, hasTaskScheduleTimeout bool
+		hasTaskDurable, hasTaskSlotRequests, hasTaskScheduleTimeout, hasTaskOutputSchema,
+		hasTaskBackoffStrategy, hasTaskRetryRules bool
 
 	for _, t := range req.Tasks {
 		if t == nil {
@@ -1212,6 +1234,9 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		hasTaskDurable = hasTaskDurable || t.IsDurable
 		hasTaskSlotRequests = hasTaskSlotRequests || len(t.SlotRequests) > 0
 		hasTaskScheduleTimeout = hasTaskScheduleTimeout || t.ScheduleTimeout != nil
+		hasTaskOutputSchema = hasTaskOutputSchema || len(t.OutputJsonSchema) > 0
+		hasTaskBackoffStrategy = hasTaskBackoffStrategy || t.BackoffStrategy != nil
+		hasTaskRetryRules = hasTaskRetryRules || len(t.RetryRules) > 0
 	}
 
 	return analytics.Props(
@@ -1224,6 +1249,8 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		"has_wf_cron_input", req.CronInput != nil,
 		"has_wf_default_filters", len(req.DefaultFilters) > 0,
 		"has_wf_input_schema", len(req.InputJsonSchema) > 0,
+		"has_wf_version", req.Version != "",
+		"has_wf_canary", req.CanaryPercentage != nil,
 		"has_task_rate_limits", hasTaskRateLimits,
 		"has_task_worker_labels", hasTaskWorkerLabels,
 		"has_task_retries", hasTaskRetries,
@@ -1235,6 +1262,9 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		"has_task_durable", hasTaskDurable,
 		"has_task_slot_requests", hasTaskSlotRequests,
 		"has_task_schedule_timeout", hasTaskScheduleTimeout,
+		"has_task_output_schema", hasTaskOutputSchema,
+		"has_task_backoff_strategy", hasTaskBackoffStrategy,
+		"has_task_retry_rules", hasTaskRetryRules,
 	)
 }
 
@@ -1257,3 +1287,20 @@ func protoMapToDesiredWorkerLabels(m map[string]*contracts.DesiredWorkerLabels)
 	}
 	return labels
 }
+
+// checkScopedLimits rejects the workflow runs if a scoped resource limit of the tenant has no task runs left for them
+func (a *AdminServiceImpl) checkScopedLimits(ctx context.Context, tenantId uuid.UUID, opts []*v1.WorkflowNameTriggerOpts) error {
+	err := a.repo.Triggers().PreflightCheckScopedLimits(ctx, tenantId, opts)
+
+	if err != nil {
+		exhausted := &v1.ScopedResourceExhaustedError{}
+
+		if errors.As(err, &exhausted) {
+			return status.Error(codes.ResourceExhausted, exhausted.Error())
+		}
+
+		return fmt.Errorf("could not check scoped resource limits: %w", err)
+	}
+
+	return nil
+}
@@ -67,29 +67,30 @@ func (tc *OLAPControllerImpl) logRepublish(ctx context.Context, entity string, c
 }
 
 type OLAPControllerImpl struct {
-	mq                           msgqueue.MessageQueue
-	l                            *zerolog.Logger
-	repo                         v1.Repository
-	dv                           datautils.DataDecoderValidator
-	a                            *hatcheterrors.Wrapped
-	p                            *partition.Partition
-	s                            gocron.Scheduler
-	ta                           *alerting.TenantAlertManager
-	processTenantAlertOperations *queueutils.OperationPool
-	samplingHashThreshold        *int64
-	olapConfig                   *server.ConfigFileOperations
-	maxRequeueCount              int
-	prometheusMetricsEnabled     bool
-	analyzeCronInterval          time.Duration
-	taskPrometheusUpdateCh       chan taskPrometheusUpdate
-	taskPrometheusWorkerCtx      context.Context
-	taskPrometheusWorkerCancel   context.CancelFunc
-	dagPrometheusUpdateCh        chan dagPrometheusUpdate
-	dagPrometheusWorkerCtx       context.Context
-	dagPrometheusWorkerCancel    context.CancelFunc
-	statusUpdateBatchSizeLimits  v1.StatusUpdateBatchSizeLimits
-	mqQos                        int
-	promGate                     *prometheus.Gate
+	mq                                msgqueue.MessageQueue
+	l                                 *zerolog.Logger
+	repo                              v1.Repository
+	dv                                datautils.DataDecoderValidator
+	a                                 *hatcheterrors.Wrapped
+	p                                 *partition.Partition
+	s                                 gocron.Scheduler
+	ta                                *alerting.TenantAlertManager
+	processTenantAlertOperations      *queueutils.OperationPool
+	processTenantDeadLetterOperations *queueutils.OperationPool
+	samplingHashThreshold             *int64
+	olapConfig                        *server.ConfigFileOperations
+	maxRequeueCount                   int
+	prometheusMetricsEnabled          bool
+	analyzeCronInterval               time.Duration
+	taskPrometheusUpdateCh            chan taskPrometheusUpdate
+	taskPrometheusWorkerCtx           context.Context
+	taskPrometheusWorkerCancel        context.CancelFunc
+	dagPrometheusUpdateCh             chan dagPrometheusUpdate
+	dagPrometheusWorkerCtx            context.Context
+	dagPrometheusWorkerCancel         context.CancelFunc
+	statusUpdateBatchSizeLimits       v1.StatusUpdateBatchSizeLimits
+	mqQos                             int
+	promGate                          *prometheus.Gate
 }
 
 type OLAPControllerOpt func(*OLAPControllerOpts)
@@ -307,6 +308,13 @@ func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 		o.processTenantAlerts,
 	).WithJitter(jitter)
 
+	o.processTenantDeadLetterOperations = queueutils.NewOperationPool(
+		opts.l,
+		timeout,
+		"process tenant dead letters",
+		o.processTenantDeadLetters,
+	).WithJitter(jitter)
+
 	return o, nil
 }
 
@@ -411,6 +419,19 @@ func (o *OLAPControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule process tenant alerts: %w", err)
 	}
 
+	_, err = o.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			o.runTenantProcessDeadLetters(ctx),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule process tenant dead letters: %w", err)
+	}
+
 	_, err = o.s.NewJob(
 		gocron.DurationJob(o.analyzeCronInterval),
 		gocron.NewTask(
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/telemetry"
@@ -34,6 +35,30 @@ func (oc *OLAPControllerImpl) runOLAPTablePartition(ctx context.Context) func()
 			}
 			oc.l.Error().Ctx(ctx).Err(err).Msg("could not create table partition")
 		}
+
+		oc.cleanupExpiredRuns(ctx)
+	}
+}
+
+// cleanupExpiredRuns deletes runs whose workflow or tenant retention period has passed before their partition is
+// dropped
+func (oc *OLAPControllerImpl) cleanupExpiredRuns(ctx context.Context) {
+	ctx, span := telemetry.NewSpan(ctx, "OLAPControllerImpl.cleanupExpiredRuns")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	shouldContinue := true
+	var err error
+
+	for shouldContinue {
+		shouldContinue, err = oc.repo.OLAP().CleanupExpiredRuns(ctx)
+
+		if err != nil {
+			oc.l.Error().Ctx(ctx).Err(err).Msg("could not clean up expired runs")
+			return
+		}
 	}
 }
 
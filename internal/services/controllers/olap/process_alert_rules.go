@@ -0,0 +1,131 @@
+package olap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// evaluateTenantAlertRules evaluates the tenant's alert rules against the OLAP repository, and sends an alert
+// for every rule which transitions from not firing to firing. Rules are re-armed once they stop firing.
+func (o *OLAPControllerImpl) evaluateTenantAlertRules(ctx context.Context, tenantId uuid.UUID) error {
+	rules, err := o.repo.TenantAlertingSettings().ListTenantAlertRules(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list tenant alert rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if innerErr := o.evaluateTenantAlertRule(ctx, tenantId, rule); innerErr != nil {
+			err = multierror.Append(err, fmt.Errorf("could not evaluate alert rule %s: %w", rule.ID, innerErr))
+		}
+	}
+
+	return err
+}
+
+func (o *OLAPControllerImpl) evaluateTenantAlertRule(ctx context.Context, tenantId uuid.UUID, rule *sqlcv1.TenantAlertRule) error {
+	window, err := time.ParseDuration(rule.Window)
+
+	if err != nil {
+		return fmt.Errorf("invalid window %s: %w", rule.Window, err)
+	}
+
+	now := time.Now().UTC()
+	since := now.Add(-window)
+
+	value, err := o.alertRuleValue(ctx, tenantId, rule, since)
+
+	if err != nil {
+		return err
+	}
+
+	isFiring := isAlertRuleFiring(rule.Kind, value, rule.Threshold)
+
+	// a missed cron can't be detected until the rule has existed for a full window
+	if rule.Kind == sqlcv1.TenantAlertRuleKindCRONMISSED && rule.CreatedAt.Time.After(since) {
+		isFiring = false
+	}
+
+	if isFiring && !rule.IsFiring {
+		workflow, err := o.repo.Workflows().GetWorkflowById(ctx, rule.WorkflowId)
+
+		if err != nil {
+			return fmt.Errorf("could not get workflow: %w", err)
+		}
+
+		// the state isn't updated if the alert can't be sent, so it's retried on the next evaluation
+		if err := o.ta.SendAlertRuleAlert(tenantId, rule, workflow.Workflow.Name, value); err != nil {
+			return fmt.Errorf("could not send alert: %w", err)
+		}
+	}
+
+	return o.repo.TenantAlertingSettings().UpdateTenantAlertRuleState(ctx, rule.ID, isFiring)
+}
+
+// alertRuleValue returns the metric which the rule is evaluated against, over the window starting at since. The
+// queue depth counts the runs queued within the last day, including those queued before the window.
+func (o *OLAPControllerImpl) alertRuleValue(ctx context.Context, tenantId uuid.UUID, rule *sqlcv1.TenantAlertRule, since time.Time) (float64, error) {
+	switch rule.Kind {
+	case sqlcv1.TenantAlertRuleKindQUEUEDEPTH, sqlcv1.TenantAlertRuleKindFAILURERATE:
+		counts, err := o.repo.OLAP().GetWorkflowRunStatusCounts(ctx, tenantId, rule.WorkflowId, since)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not get workflow run status counts: %w", err)
+		}
+
+		if rule.Kind == sqlcv1.TenantAlertRuleKindQUEUEDEPTH {
+			return float64(counts.QueuedCount), nil
+		}
+
+		return failureRate(counts.CompletedCount, counts.FailedCount), nil
+	case sqlcv1.TenantAlertRuleKindP95DURATION:
+		res, err := o.repo.OLAP().GetWorkflowRunDurationPercentile(ctx, tenantId, rule.WorkflowId, since, 0.95)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not get workflow run duration percentile: %w", err)
+		}
+
+		if res.RunCount == 0 {
+			return 0, nil
+		}
+
+		return res.DurationSeconds, nil
+	case sqlcv1.TenantAlertRuleKindCRONMISSED:
+		count, err := o.repo.OLAP().CountSuccessfulCronRuns(ctx, tenantId, rule.WorkflowId, since)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not count successful cron runs: %w", err)
+		}
+
+		return float64(count), nil
+	default:
+		return 0, fmt.Errorf("unknown alert rule kind %s", rule.Kind)
+	}
+}
+
+// isAlertRuleFiring returns whether a rule of the given kind fires for the value. Cron rules fire when there
+// were no successful runs, all other rules fire when the value exceeds the threshold.
+func isAlertRuleFiring(kind sqlcv1.TenantAlertRuleKind, value, threshold float64) bool {
+	if kind == sqlcv1.TenantAlertRuleKindCRONMISSED {
+		return value == 0
+	}
+
+	return value > threshold
+}
+
+// failureRate returns the percentage of finished runs which failed.
+func failureRate(completed, failed int64) float64 {
+	finished := completed + failed
+
+	if finished == 0 {
+		return 0
+	}
+
+	return float64(failed) / float64(finished) * 100
+}
@@ -0,0 +1,30 @@
+package olap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestIsAlertRuleFiring(t *testing.T) {
+	assert.True(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindQUEUEDEPTH, 101, 100))
+	assert.False(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindQUEUEDEPTH, 100, 100))
+
+	assert.True(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindP95DURATION, 30.5, 30))
+	assert.False(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindP95DURATION, 0, 30))
+
+	assert.True(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindFAILURERATE, 25, 10))
+	assert.False(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindFAILURERATE, 5, 10))
+
+	// the threshold is ignored for cron rules
+	assert.True(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindCRONMISSED, 0, 5))
+	assert.False(t, isAlertRuleFiring(sqlcv1.TenantAlertRuleKindCRONMISSED, 1, 5))
+}
+
+func TestFailureRate(t *testing.T) {
+	assert.Equal(t, float64(0), failureRate(0, 0))
+	assert.Equal(t, float64(25), failureRate(3, 1))
+	assert.Equal(t, float64(100), failureRate(0, 4))
+}
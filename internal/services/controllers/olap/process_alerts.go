@@ -41,6 +41,11 @@ func (o *OLAPControllerImpl) processTenantAlerts(ctx context.Context, tenantId s
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
 
+	// alert rules aren't subject to the failure alert settings, so they're always evaluated
+	if err := o.evaluateTenantAlertRules(ctx, uuid.MustParse(tenantId)); err != nil {
+		o.l.Error().Ctx(ctx).Err(err).Msg("could not evaluate tenant alert rules")
+	}
+
 	isActive, lastAlerted, err := o.repo.Ticker().IsTenantAlertActive(ctx, uuid.MustParse(tenantId))
 
 	if err != nil {
@@ -51,6 +51,7 @@ func (o *OLAPControllerImpl) runDAGStatusUpdates(ctx context.Context) func() {
 
 func (o *OLAPControllerImpl) notifyDAGsUpdated(ctx context.Context, rows []v1.UpdateDAGStatusRow) error {
 	tenantIdToPayloads := make(map[uuid.UUID][]tasktypes.NotifyFinalizedPayload)
+	tenantIdToFailedRuns := make(map[uuid.UUID][]v1.FailedWorkflowRun)
 
 	for _, row := range rows {
 		tenantIdToPayloads[row.TenantId] = append(tenantIdToPayloads[row.TenantId], tasktypes.NotifyFinalizedPayload{
@@ -60,9 +61,16 @@ func (o *OLAPControllerImpl) notifyDAGsUpdated(ctx context.Context, rows []v1.Up
 
 		if row.ReadableStatus == sqlcv1.V1ReadableStatusOlapFAILED {
 			o.processTenantAlertOperations.RunOrContinue(row.TenantId.String())
+
+			tenantIdToFailedRuns[row.TenantId] = append(tenantIdToFailedRuns[row.TenantId], v1.FailedWorkflowRun{
+				ExternalId: row.ExternalId,
+				WorkflowId: row.WorkflowId,
+			})
 		}
 	}
 
+	o.enqueueDeadLetterRuns(ctx, tenantIdToFailedRuns)
+
 	// Send prometheus updates asynchronously
 	if o.prometheusMetricsEnabled && o.dagPrometheusUpdateCh != nil {
 		for _, row := range rows {
@@ -0,0 +1,246 @@
+package olap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/constants"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// deadLetterBatchSize is the maximum number of failed runs which are sent to a dead-letter workflow per operation
+const deadLetterBatchSize = 50
+
+// deadLetterRunNamespace is the namespace of the external ids which are derived for dead-letter runs
+var deadLetterRunNamespace = uuid.MustParse("6f1c8a52-3e0b-4d5e-9a7f-2c4b8e1d0f36")
+
+// deadLetterTask is a task of the failed run, as passed to the dead-letter workflow
+type deadLetterTask struct {
+	TaskRunId string          `json:"task_run_id"`
+	Name      string          `json:"name"`
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	Output    json.RawMessage `json:"output,omitempty"`
+}
+
+// deadLetterInput is the input of the dead-letter workflow
+type deadLetterInput struct {
+	WorkflowRunId      string           `json:"workflow_run_id"`
+	WorkflowId         string           `json:"workflow_id"`
+	WorkflowName       string           `json:"workflow_name"`
+	DisplayName        string           `json:"display_name"`
+	Error              string           `json:"error"`
+	Input              json.RawMessage  `json:"input"`
+	AdditionalMetadata json.RawMessage  `json:"additional_metadata"`
+	Tasks              []deadLetterTask `json:"tasks"`
+}
+
+// enqueueDeadLetterRuns stores the failed runs which have a dead-letter policy, and starts sending them to their
+// dead-letter workflow. Errors are logged rather than returned, as the status updates have already been committed.
+func (o *OLAPControllerImpl) enqueueDeadLetterRuns(ctx context.Context, tenantIdToRuns map[uuid.UUID][]v1.FailedWorkflowRun) {
+	for tenantId, runs := range tenantIdToRuns {
+		enqueued, err := o.repo.DeadLetter().EnqueueDeadLetterRuns(ctx, tenantId, runs)
+
+		if err != nil {
+			o.l.Error().Ctx(ctx).Err(err).Msgf("could not enqueue dead-letter runs for tenant %s", tenantId)
+			continue
+		}
+
+		if len(enqueued) > 0 {
+			o.processTenantDeadLetterOperations.RunOrContinue(tenantId.String())
+		}
+	}
+}
+
+func (o *OLAPControllerImpl) runTenantProcessDeadLetters(ctx context.Context) func() {
+	return func() {
+		o.l.Debug().Ctx(ctx).Msgf("partition: processing tenant dead-letter runs")
+
+		tenants, err := o.p.ListTenantsForController(ctx)
+
+		if err != nil {
+			o.l.Error().Ctx(ctx).Err(err).Msg("could not list tenants")
+			return
+		}
+
+		o.processTenantDeadLetterOperations.SetTenants(tenants)
+
+		for _, tenantId := range tenants {
+			o.processTenantDeadLetterOperations.RunOrContinue(tenantId.String())
+		}
+	}
+}
+
+func (o *OLAPControllerImpl) processTenantDeadLetters(ctx context.Context, tenantId string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	ctx, span := telemetry.NewSpan(ctx, "process-tenant-dead-letters")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+
+	tenantUUID := uuid.MustParse(tenantId)
+
+	runs, err := o.repo.DeadLetter().ListDeadLetterRuns(ctx, tenantUUID, deadLetterBatchSize)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list dead-letter runs: %w", err)
+	}
+
+	processed := make([]uuid.UUID, 0, len(runs))
+
+	for _, run := range runs {
+		sendErr := o.sendDeadLetterRun(ctx, tenantUUID, run)
+
+		// a run which can't be found anymore is dropped, all other errors are retried on the next operation
+		if errors.Is(sendErr, pgx.ErrNoRows) {
+			o.l.Warn().Ctx(ctx).Msgf("dropping dead-letter run %s which no longer exists", run.WorkflowRunExternalID)
+		} else if sendErr != nil {
+			err = multierror.Append(err, fmt.Errorf("could not send run %s to dead-letter workflow: %w", run.WorkflowRunExternalID, sendErr))
+			continue
+		}
+
+		processed = append(processed, run.WorkflowRunExternalID)
+	}
+
+	if deleteErr := o.repo.DeadLetter().DeleteDeadLetterRuns(ctx, tenantUUID, processed); deleteErr != nil {
+		return false, multierror.Append(err, fmt.Errorf("could not delete dead-letter runs: %w", deleteErr))
+	}
+
+	return len(runs) == deadLetterBatchSize && len(processed) > 0, err
+}
+
+// sendDeadLetterRun triggers the dead-letter workflow with the input, error and task outputs of the failed run
+func (o *OLAPControllerImpl) sendDeadLetterRun(ctx context.Context, tenantId uuid.UUID, run *sqlcv1.V1DeadLetterRun) error {
+	externalId := deadLetterRunExternalId(run)
+
+	// a failed run which was sent but not removed from the queue, for instance because the engine restarted in
+	// between, already has its dead-letter run
+	existing, err := o.repo.Tasks().FlattenExternalIds(ctx, tenantId, []uuid.UUID{externalId})
+
+	if err != nil {
+		return fmt.Errorf("could not look up dead-letter run: %w", err)
+	}
+
+	if len(existing) > 0 {
+		return nil
+	}
+
+	populator, err := o.repo.OLAP().ReadWorkflowRun(ctx, run.WorkflowRunExternalID)
+
+	if err != nil {
+		return fmt.Errorf("could not read workflow run: %w", err)
+	}
+
+	tasks, err := o.repo.OLAP().ListTasksByIdAndInsertedAt(ctx, tenantId, populator.TaskMetadata, true)
+
+	if err != nil {
+		return fmt.Errorf("could not list tasks: %w", err)
+	}
+
+	workflow, err := o.repo.Workflows().GetWorkflowById(ctx, populator.WorkflowRun.WorkflowID)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	input, err := json.Marshal(newDeadLetterInput(populator.WorkflowRun, workflow.Workflow.Name, tasks))
+
+	if err != nil {
+		return fmt.Errorf("could not marshal dead-letter input: %w", err)
+	}
+
+	additionalMetadata, err := json.Marshal(map[string]any{
+		constants.DeadLetterSourceRunIdKey.String():      run.WorkflowRunExternalID.String(),
+		constants.DeadLetterSourceWorkflowIdKey.String(): populator.WorkflowRun.WorkflowID.String(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal additional metadata: %w", err)
+	}
+
+	msg, err := tasktypes.TriggerTaskMessage(tenantId, &v1.WorkflowNameTriggerOpts{
+		TriggerTaskData: &v1.TriggerTaskData{
+			WorkflowName:       run.DeadLetterWorkflowName,
+			Data:               input,
+			AdditionalMetadata: additionalMetadata,
+		},
+		ExternalId: externalId,
+		ShouldSkip: false,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create trigger task message: %w", err)
+	}
+
+	return o.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+}
+
+// deadLetterRunExternalId derives the external id of the dead-letter run from the failed run and the time at which
+// it was enqueued, so that sending the same failure again doesn't trigger another run. A replayed run which fails
+// again is enqueued again, and is sent to a new dead-letter run.
+func deadLetterRunExternalId(run *sqlcv1.V1DeadLetterRun) uuid.UUID {
+	name := run.WorkflowRunExternalID.String() + "/" + strconv.FormatInt(run.InsertedAt.Time.UnixMicro(), 10)
+
+	return uuid.NewSHA1(deadLetterRunNamespace, []byte(name))
+}
+
+// newDeadLetterInput builds the input of the dead-letter workflow from the failed run and its tasks. If the run
+// doesn't have an error message of its own, the error of the first failed task is used.
+func newDeadLetterInput(run *v1.WorkflowRunData, workflowName string, tasks []*v1.TaskWithPayloads) *deadLetterInput {
+	res := &deadLetterInput{
+		WorkflowRunId:      run.ExternalID.String(),
+		WorkflowId:         run.WorkflowID.String(),
+		WorkflowName:       workflowName,
+		DisplayName:        run.DisplayName,
+		Error:              run.ErrorMessage,
+		Input:              rawJSONOrNull(run.Input),
+		AdditionalMetadata: rawJSONOrNull(run.AdditionalMetadata),
+		Tasks:              make([]deadLetterTask, 0, len(tasks)),
+	}
+
+	for _, task := range tasks {
+		res.Tasks = append(res.Tasks, deadLetterTask{
+			TaskRunId: task.ExternalID.String(),
+			Name:      task.DisplayName,
+			Status:    string(task.Status),
+			Error:     task.ErrorMessage.String,
+			Output:    rawJSONOrNil(task.OutputPayload),
+		})
+
+		if res.Error == "" && task.Status == sqlcv1.V1ReadableStatusOlapFAILED {
+			res.Error = task.ErrorMessage.String
+		}
+	}
+
+	return res
+}
+
+func rawJSONOrNil(b []byte) json.RawMessage {
+	if len(b) == 0 || !json.Valid(b) {
+		return nil
+	}
+
+	return json.RawMessage(b)
+}
+
+func rawJSONOrNull(b []byte) json.RawMessage {
+	if raw := rawJSONOrNil(b); raw != nil {
+		return raw
+	}
+
+	return json.RawMessage("null")
+}
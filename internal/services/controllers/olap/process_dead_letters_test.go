@@ -0,0 +1,115 @@
+package olap
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestNewDeadLetterInput(t *testing.T) {
+	run := &v1.WorkflowRunData{
+		ExternalID:         uuid.New(),
+		WorkflowID:         uuid.New(),
+		DisplayName:        "order-1234",
+		Input:              []byte(`{"order_id":1234}`),
+		AdditionalMetadata: []byte(`{"customer":"acme"}`),
+	}
+
+	chargeId := uuid.New()
+	shipId := uuid.New()
+
+	tasks := []*v1.TaskWithPayloads{
+		{
+			PopulateTaskRunDataRow: &sqlcv1.PopulateTaskRunDataRow{
+				ExternalID:  chargeId,
+				DisplayName: "charge",
+				Status:      sqlcv1.V1ReadableStatusOlapCOMPLETED,
+			},
+			OutputPayload: []byte(`{"charge_id":"ch_1"}`),
+		},
+		{
+			PopulateTaskRunDataRow: &sqlcv1.PopulateTaskRunDataRow{
+				ExternalID:   shipId,
+				DisplayName:  "ship",
+				Status:       sqlcv1.V1ReadableStatusOlapFAILED,
+				ErrorMessage: pgtype.Text{String: "carrier unavailable", Valid: true},
+			},
+		},
+	}
+
+	input := newDeadLetterInput(run, "process-order", tasks)
+
+	assert.Equal(t, run.ExternalID.String(), input.WorkflowRunId)
+	assert.Equal(t, "process-order", input.WorkflowName)
+	assert.Equal(t, "carrier unavailable", input.Error, "the error of the failed task is used when the run has none")
+	require.Len(t, input.Tasks, 2)
+	assert.Equal(t, chargeId.String(), input.Tasks[0].TaskRunId)
+	assert.Equal(t, "COMPLETED", input.Tasks[0].Status)
+	assert.Nil(t, input.Tasks[1].Output)
+
+	b, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(b, &decoded))
+
+	assert.Equal(t, map[string]any{"order_id": float64(1234)}, decoded["input"])
+	assert.Equal(t, map[string]any{"customer": "acme"}, decoded["additional_metadata"])
+	assert.Equal(t, map[string]any{"charge_id": "ch_1"}, decoded["tasks"].([]any)[0].(map[string]any)["output"])
+}
+
+func TestNewDeadLetterInput_RunError(t *testing.T) {
+	run := &v1.WorkflowRunData{
+		ErrorMessage: "timed out",
+	}
+
+	tasks := []*v1.TaskWithPayloads{
+		{
+			PopulateTaskRunDataRow: &sqlcv1.PopulateTaskRunDataRow{
+				Status:       sqlcv1.V1ReadableStatusOlapFAILED,
+				ErrorMessage: pgtype.Text{String: "task error", Valid: true},
+			},
+		},
+	}
+
+	input := newDeadLetterInput(run, "wf", tasks)
+
+	assert.Equal(t, "timed out", input.Error)
+	assert.Equal(t, json.RawMessage("null"), input.Input, "missing payloads are passed as null")
+}
+
+func TestDeadLetterRunExternalId(t *testing.T) {
+	insertedAt := pgtype.Timestamptz{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}
+
+	run := &sqlcv1.V1DeadLetterRun{
+		WorkflowRunExternalID: uuid.New(),
+		InsertedAt:            insertedAt,
+	}
+
+	// sending the same failure again triggers the same dead-letter run
+	assert.Equal(t, deadLetterRunExternalId(run), deadLetterRunExternalId(&sqlcv1.V1DeadLetterRun{
+		WorkflowRunExternalID: run.WorkflowRunExternalID,
+		InsertedAt:            insertedAt,
+	}))
+
+	assert.NotEqual(t, run.WorkflowRunExternalID, deadLetterRunExternalId(run))
+
+	// the run failed again after a replay
+	assert.NotEqual(t, deadLetterRunExternalId(run), deadLetterRunExternalId(&sqlcv1.V1DeadLetterRun{
+		WorkflowRunExternalID: run.WorkflowRunExternalID,
+		InsertedAt:            pgtype.Timestamptz{Time: insertedAt.Time.Add(time.Hour), Valid: true},
+	}))
+
+	assert.NotEqual(t, deadLetterRunExternalId(run), deadLetterRunExternalId(&sqlcv1.V1DeadLetterRun{
+		WorkflowRunExternalID: uuid.New(),
+		InsertedAt:            insertedAt,
+	}))
+}
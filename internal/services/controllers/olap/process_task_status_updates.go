@@ -50,6 +50,7 @@ func (o *OLAPControllerImpl) runTaskStatusUpdates(ctx context.Context) func() {
 
 func (o *OLAPControllerImpl) notifyTasksUpdated(ctx context.Context, rows []v1.UpdateTaskStatusRow) error {
 	tenantIdToPayloads := make(map[uuid.UUID][]tasktypes.NotifyFinalizedPayload)
+	tenantIdToFailedRuns := make(map[uuid.UUID][]v1.FailedWorkflowRun)
 
 	for _, row := range rows {
 		if row.ReadableStatus != sqlcv1.V1ReadableStatusOlapCOMPLETED && row.ReadableStatus != sqlcv1.V1ReadableStatusOlapCANCELLED && row.ReadableStatus != sqlcv1.V1ReadableStatusOlapFAILED {
@@ -60,8 +61,18 @@ func (o *OLAPControllerImpl) notifyTasksUpdated(ctx context.Context, rows []v1.U
 			ExternalId: row.ExternalId,
 			Status:     row.ReadableStatus,
 		})
+
+		// failed tasks of a DAG are dead-lettered when the DAG's status is updated
+		if row.ReadableStatus == sqlcv1.V1ReadableStatusOlapFAILED && !row.IsDAGTask {
+			tenantIdToFailedRuns[row.TenantId] = append(tenantIdToFailedRuns[row.TenantId], v1.FailedWorkflowRun{
+				ExternalId: row.ExternalId,
+				WorkflowId: row.WorkflowId,
+			})
+		}
 	}
 
+	o.enqueueDeadLetterRuns(ctx, tenantIdToFailedRuns)
+
 	// Send prometheus updates asynchronously
 	if o.prometheusMetricsEnabled && o.taskPrometheusUpdateCh != nil {
 		for _, row := range rows {
@@ -21,6 +21,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/olap/signal"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/recoveryutils"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
@@ -60,12 +61,16 @@ type TasksControllerImpl struct {
 	retryTaskOperations                   *operation.TenantOperationPool
 	emitSleepOperations                   *operation.TenantOperationPool
 	evictExpiredIdempotencyKeysOperations *operation.TenantOperationPool
+	processBulkOperationsOperations       *operation.TenantOperationPool
 	// deactivateStaleStepConcurrencyOperations *operation.TenantOperationPool
 	replayEnabled       bool
 	analyzeCronInterval time.Duration
 	signaler            *signal.OLAPSignaler
 	tw                  *trigger.TriggerWriter
 	promGate            *prometheus.Gate
+
+	// ingestor pushes the events which are replayed by event replay operations
+	ingestor ingestor.Ingestor
 }
 
 type TasksControllerOpt func(*TasksControllerOpts)
@@ -217,6 +222,16 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 	signaler := signal.NewOLAPSignaler(opts.mq, opts.repov1, opts.l, pubBuffer, opts.promGate)
 	tw := trigger.NewTriggerWriter(opts.mq, opts.repov1, opts.l, pubBuffer, 0, opts.promGate)
 
+	ing, err := ingestor.NewIngestor(
+		ingestor.WithMessageQueueV1(opts.mq),
+		ingestor.WithRepositoryV1(opts.repov1),
+		ingestor.WithLogger(opts.l),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create ingestor: %w", err)
+	}
+
 	t := &TasksControllerImpl{
 		mq:                  opts.mq,
 		pubBuffer:           pubBuffer,
@@ -236,6 +251,7 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 		signaler:            signaler,
 		tw:                  tw,
 		promGate:            opts.promGate,
+		ingestor:            ing,
 	}
 
 	jitter := t.opsPoolJitter
@@ -286,6 +302,15 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 		opts.repov1.Tasks().DefaultTaskActivityGauge,
 	))
 
+	t.processBulkOperationsOperations = operation.NewTenantOperationPool(opts.p, opts.l, "process-bulk-operations", timeout, "process bulk operations", t.processBulkOperations, operation.WithPoolInterval(
+		opts.repov1.IntervalSettings(),
+		jitter,
+		1*time.Second,
+		30*time.Second,
+		3,
+		opts.repov1.Tasks().DefaultTaskActivityGauge,
+	))
+
 	// FIXME(mnafees): temporarily disabling this operation for the meantime
 	//
 	// t.deactivateStaleStepConcurrencyOperations = operation.NewTenantOperationPool(opts.p, opts.l, "deactivate-stale-step-concurrency", timeout, "deactivate stale step concurrency", t.deactivateStaleStepConcurrency, operation.WithPoolInterval(
@@ -412,6 +437,7 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		tc.retryTaskOperations.Cleanup()
 		tc.emitSleepOperations.Cleanup()
 		tc.evictExpiredIdempotencyKeysOperations.Cleanup()
+		tc.processBulkOperationsOperations.Cleanup()
 		// tc.deactivateStaleStepConcurrencyOperations.Cleanup()
 
 		tc.pubBuffer.Stop()
@@ -538,6 +564,7 @@ func (tc *TasksControllerImpl) handleTaskFailed(ctx context.Context, tenantId uu
 			IsAppError:     msg.IsAppError,
 			ErrorMessage:   msg.ErrorMsg,
 			IsNonRetryable: msg.IsNonRetryable,
+			ErrorType:      msg.ErrorType,
 		})
 
 		if msg.ErrorMsg != "" {
@@ -1187,18 +1214,38 @@ func (tc *TasksControllerImpl) processInternalEvents(ctx context.Context, tenant
 	return nil
 }
 
+// retryBackoffDuration returns the delay before a retried task is requeued, and false if the task is requeued
+// immediately. The delay computed by the retry strategy of the step takes precedence over exponential backoff.
+func retryBackoffDuration(task v1.RetriedTask) (time.Duration, bool) {
+	if task.RetryDelaySeconds.Valid {
+		return time.Duration(task.RetryDelaySeconds.Float64 * float64(time.Second)).Round(time.Millisecond), true
+	}
+
+	if !task.RetryBackoffFactor.Valid {
+		return 0, false
+	}
+
+	backoffSeconds := math.Pow(task.RetryBackoffFactor.Float64, float64(task.AppRetryCount))
+
+	// like LEAST in the update trigger, a missing max backoff doesn't cap the delay
+	if task.RetryMaxBackoff.Valid {
+		backoffSeconds = min(float64(task.RetryMaxBackoff.Int32), backoffSeconds)
+	}
+
+	// compute the backoff duration
+	durationMilliseconds := 1000 * backoffSeconds
+
+	return time.Duration(int(durationMilliseconds)) * time.Millisecond, true
+}
+
 func (tc *TasksControllerImpl) pubRetryEvent(ctx context.Context, tenantId uuid.UUID, task v1.RetriedTask) error {
 	taskId := task.Id
 
 	retryMsg := fmt.Sprintf("This is retry number %d.", task.AppRetryCount)
 
-	if task.RetryBackoffFactor.Valid && task.RetryMaxBackoff.Valid {
-		maxBackoffSeconds := int(task.RetryMaxBackoff.Int32)
-		backoffFactor := task.RetryBackoffFactor.Float64
+	retryDur, hasBackoff := retryBackoffDuration(task)
 
-		// compute the backoff duration
-		durationMilliseconds := 1000 * min(float64(maxBackoffSeconds), math.Pow(backoffFactor, float64(task.AppRetryCount)))
-		retryDur := time.Duration(int(durationMilliseconds)) * time.Millisecond
+	if hasBackoff {
 		retryTime := time.Now().Add(retryDur)
 
 		retryMsg = fmt.Sprintf("%s Retrying in %s (%s).", retryMsg, retryDur.String(), retryTime.Format(time.RFC3339))
@@ -1230,7 +1277,7 @@ func (tc *TasksControllerImpl) pubRetryEvent(ctx context.Context, tenantId uuid.
 		return fmt.Errorf("could not publish monitoring event message: %w", err)
 	}
 
-	if !task.RetryBackoffFactor.Valid {
+	if !hasBackoff {
 		olapMsg, err = tasktypes.MonitoringEventMessageFromInternal(
 			tenantId,
 			tasktypes.CreateMonitoringEventPayload{
@@ -0,0 +1,353 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	// bulkOperationBatchSize is the maximum number of workflow runs which are processed per operation
+	bulkOperationBatchSize = 500
+
+	// bulkOperationReplayBatchSize is the maximum number of tasks per replay message
+	bulkOperationReplayBatchSize = 100
+
+	// eventReplayBatchSize is the maximum number of events which are replayed per event replay operation
+	eventReplayBatchSize = 100
+)
+
+// processBulkOperations advances the oldest pending or running operation on runs of the tenant, and the oldest
+// pending or running event replay. Event replays are rate limited, so they're queued separately from, and processed
+// alongside, operations on runs.
+func (tc *TasksControllerImpl) processBulkOperations(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "process-bulk-operations")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+
+	tenantIdUUID, err := uuid.Parse(tenantId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not parse tenant id %s: %w", tenantId, err)
+	}
+
+	var processedRunOperation, processedEventReplay bool
+
+	eg := &errgroup.Group{}
+
+	eg.Go(func() error {
+		var err error
+		processedRunOperation, err = tc.processNextRunOperation(ctx, tenantIdUUID)
+		return err
+	})
+
+	eg.Go(func() error {
+		var err error
+		processedEventReplay, err = tc.processNextEventReplay(ctx, tenantIdUUID)
+		return err
+	})
+
+	err = eg.Wait()
+
+	return processedRunOperation || processedEventReplay, err
+}
+
+// processNextEventReplay advances the oldest pending or running event replay of the tenant.
+func (tc *TasksControllerImpl) processNextEventReplay(ctx context.Context, tenantId uuid.UUID) (bool, error) {
+	op, err := tc.repov1.BulkOperations().GetNextBulkOperation(ctx, tenantId, true)
+
+	if err != nil {
+		return false, fmt.Errorf("could not get next event replay for tenant %s: %w", tenantId, err)
+	}
+
+	if op == nil {
+		return false, nil
+	}
+
+	if err := tc.processEventReplayOperation(ctx, tenantId, op); err != nil {
+		return false, fmt.Errorf("could not process event replay operation %s: %w", op.ID, err)
+	}
+
+	return true, nil
+}
+
+// processNextRunOperation advances the oldest pending or running cancellation or replay of runs of the tenant. A
+// pending operation is started by resolving its filter into the list of matching runs, and a running operation
+// cancels or replays the next batch of runs.
+func (tc *TasksControllerImpl) processNextRunOperation(ctx context.Context, tenantId uuid.UUID) (bool, error) {
+	op, err := tc.repov1.BulkOperations().GetNextBulkOperation(ctx, tenantId, false)
+
+	if err != nil {
+		return false, fmt.Errorf("could not get next bulk operation for tenant %s: %w", tenantId, err)
+	}
+
+	if op == nil {
+		return false, nil
+	}
+
+	if op.Status == sqlcv1.V1BulkOperationStatusPENDING {
+		if err := tc.startBulkOperation(ctx, tenantId, op); err != nil {
+			return false, fmt.Errorf("could not start bulk operation %s: %w", op.ID, err)
+		}
+
+		return true, nil
+	}
+
+	externalIds, err := tc.repov1.BulkOperations().ListBulkOperationRuns(ctx, op.ID, bulkOperationBatchSize)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list runs of bulk operation %s: %w", op.ID, err)
+	}
+
+	if len(externalIds) == 0 {
+		if _, err := tc.repov1.BulkOperations().FinishBulkOperation(ctx, op.ID); err != nil {
+			return false, fmt.Errorf("could not finish bulk operation %s: %w", op.ID, err)
+		}
+
+		// there may be another operation queued behind this one
+		return true, nil
+	}
+
+	if err := tc.processBulkOperationBatch(ctx, tenantId, op.Kind, externalIds); err != nil {
+		return false, fmt.Errorf("could not process batch of bulk operation %s: %w", op.ID, err)
+	}
+
+	// if the operation was cancelled while the batch was processed, CompleteBulkOperationBatch returns nil, and
+	// the next iteration picks up the next operation
+	if _, err := tc.repov1.BulkOperations().CompleteBulkOperationBatch(ctx, op.ID, externalIds); err != nil {
+		return false, fmt.Errorf("could not update progress of bulk operation %s: %w", op.ID, err)
+	}
+
+	return true, nil
+}
+
+// startBulkOperation resolves the filter of a pending operation into the runs which it applies to, up to
+// MaxBulkOperationRuns, and marks it as running.
+func (tc *TasksControllerImpl) startBulkOperation(ctx context.Context, tenantId uuid.UUID, op *sqlcv1.V1BulkOperation) error {
+	filter, err := v1.ParseBulkOperationFilter(op)
+
+	if err != nil {
+		return err
+	}
+
+	opts := filter.ToListWorkflowRunOpts()
+
+	// filters which match more runs are rejected when the operation is created, but more runs may have matched
+	// since, and the operation is bounded regardless
+	opts.Limit = v1.MaxBulkOperationRuns
+
+	externalIds, err := tc.repov1.OLAP().ListWorkflowRunExternalIds(ctx, tenantId, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not list workflow runs: %w", err)
+	}
+
+	_, err = tc.repov1.BulkOperations().StartBulkOperation(ctx, op.ID, externalIds)
+
+	return err
+}
+
+// processBulkOperationBatch sends the cancel or replay messages for the tasks of the workflow runs
+func (tc *TasksControllerImpl) processBulkOperationBatch(ctx context.Context, tenantId uuid.UUID, kind sqlcv1.V1BulkOperationKind, externalIds []uuid.UUID) error {
+	tasks, err := tc.repov1.Tasks().FlattenExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return fmt.Errorf("could not flatten external ids: %w", err)
+	}
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	switch kind {
+	case sqlcv1.V1BulkOperationKindCANCEL:
+		tasksToCancel := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+		for _, task := range tasks {
+			tasksToCancel = append(tasksToCancel, v1.TaskIdInsertedAtRetryCount{
+				Id:         task.ID,
+				InsertedAt: task.InsertedAt,
+				RetryCount: task.RetryCount,
+			})
+		}
+
+		return tc.sendBulkOperationMessage(ctx, tenantId, msgqueue.MsgIDCancelTasks, tasktypes.CancelTasksPayload{
+			Tasks: tasksToCancel,
+		})
+	case sqlcv1.V1BulkOperationKindREPLAY:
+		tasksToReplay := make([]tasktypes.TaskIdInsertedAtRetryCountWithExternalId, 0, len(tasks))
+
+		for _, task := range tasks {
+			tasksToReplay = append(tasksToReplay, tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
+				TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{
+					Id:         task.ID,
+					InsertedAt: task.InsertedAt,
+					RetryCount: task.RetryCount,
+				},
+				WorkflowRunExternalId: task.WorkflowRunID,
+				TaskExternalId:        task.ExternalID,
+			})
+		}
+
+		for _, batch := range tasktypes.BatchReplayTasks(tasksToReplay, bulkOperationReplayBatchSize) {
+			err := tc.sendBulkOperationMessage(ctx, tenantId, msgqueue.MsgIDReplayTasks, tasktypes.ReplayTasksPayload{
+				Tasks: batch,
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown bulk operation kind %s", kind)
+	}
+}
+
+// processEventReplayOperation starts a pending event replay operation by counting the matching events, or replays the
+// next batch of a running one. Events are listed most recent first, so batches are read from the end of the range to
+// replay the events in the order in which they were originally seen, and the number of processed events is the
+// offset from the end of the range. Batches are spaced out from the time the operation was last updated, which limits
+// the rate at which events are replayed.
+func (tc *TasksControllerImpl) processEventReplayOperation(ctx context.Context, tenantId uuid.UUID, op *sqlcv1.V1BulkOperation) error {
+	filter, err := v1.ParseEventReplayFilter(op)
+
+	if err != nil {
+		return err
+	}
+
+	opts, err := filter.ToListEventsParams(tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	if op.Status == sqlcv1.V1BulkOperationStatusPENDING {
+		// the count is returned alongside the first page, so there's no need to read more than a single event here
+		opts.Limit = pgtype.Int8{Int64: 1, Valid: true}
+
+		_, maybeTotal, err := tc.repov1.OLAP().ListEvents(ctx, opts)
+
+		if err != nil {
+			return fmt.Errorf("could not count events: %w", err)
+		}
+
+		var total int64
+
+		if maybeTotal != nil {
+			total = *maybeTotal
+		}
+
+		_, err = tc.repov1.BulkOperations().StartEventReplayOperation(ctx, op.ID, int32(total)) // nolint: gosec
+
+		return err
+	}
+
+	remaining := int64(op.TotalCount.Int32 - op.ProcessedCount)
+
+	if remaining <= 0 {
+		_, err := tc.repov1.BulkOperations().FinishBulkOperation(ctx, op.ID)
+		return err
+	}
+
+	batchSize := min(int64(filter.EventsPerSecond), eventReplayBatchSize, remaining)
+	batchInterval := time.Duration(batchSize) * time.Second / time.Duration(filter.EventsPerSecond)
+
+	if wait := time.Until(op.UpdatedAt.Time.Add(batchInterval)); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	opts.Offset = pgtype.Int8{Int64: remaining - batchSize, Valid: true}
+	opts.Limit = pgtype.Int8{Int64: batchSize, Valid: true}
+
+	events, _, err := tc.repov1.OLAP().ListEvents(ctx, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not list events: %w", err)
+	}
+
+	eventOpts := make([]*ingestor.CreateEventOpts, 0, len(events))
+
+	for i := len(events) - 1; i >= 0; i-- {
+		eventOpts = append(eventOpts, toReplayedEventOpts(tenantId, events[i]))
+	}
+
+	if len(eventOpts) > 0 {
+		tenant, err := tc.repov1.Tenant().GetTenantByID(ctx, tenantId)
+
+		if err != nil {
+			return fmt.Errorf("could not get tenant: %w", err)
+		}
+
+		if _, err := tc.ingestor.BulkIngestEvent(ctx, tenant, eventOpts); err != nil {
+			// the tenant has run out of events, so the remaining events can't be replayed until the limit resets
+			if e, ok := status.FromError(err); ok && e.Code() == codes.ResourceExhausted {
+				tc.l.Warn().Ctx(ctx).Msgf("cancelling event replay operation %s after %d events: %s", op.ID, op.ProcessedCount, e.Message())
+
+				_, err := tc.repov1.BulkOperations().CancelBulkOperation(ctx, op.ID)
+				return err
+			}
+
+			return fmt.Errorf("could not replay events: %w", err)
+		}
+	}
+
+	// events which were deleted since the operation started are counted as processed, so the operation finishes
+	_, err = tc.repov1.BulkOperations().CompleteEventReplayBatch(ctx, op.ID, int32(batchSize)) // nolint: gosec
+
+	return err
+}
+
+func toReplayedEventOpts(tenantId uuid.UUID, event *v1.EventWithPayload) *ingestor.CreateEventOpts {
+	replayedEventId := event.EventExternalID
+
+	opts := &ingestor.CreateEventOpts{
+		TenantId:           tenantId,
+		ReplayedEvent:      &replayedEventId,
+		Key:                event.EventKey,
+		Data:               event.Payload,
+		AdditionalMetadata: event.EventAdditionalMetadata,
+	}
+
+	if event.EventScope != "" {
+		scope := event.EventScope
+		opts.Scope = &scope
+	}
+
+	return opts
+}
+
+func (tc *TasksControllerImpl) sendBulkOperationMessage(ctx context.Context, tenantId uuid.UUID, msgId string, payload any) error {
+	msg, err := msgqueue.NewTenantMessage(
+		tenantId,
+		msgId,
+		false,
+		true,
+		payload,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create message: %w", err)
+	}
+
+	return tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+}
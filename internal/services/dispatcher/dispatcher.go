@@ -439,6 +439,10 @@ func (d *DispatcherImpl) handleV1Task(ctx context.Context, task *msgqueue.Messag
 		err = d.a.WrapErr(d.handleTaskCancelled(ctx, task), map[string]interface{}{})
 	case msgqueue.MsgIDDurableCallbackCompleted:
 		err = d.a.WrapErr(d.handleDurableCallbackCompleted(ctx, task), map[string]interface{}{})
+	case msgqueue.MsgIDDurableTaskQuery:
+		err = d.a.WrapErr(d.handleDurableTaskQuery(ctx, task), map[string]interface{}{})
+	case msgqueue.MsgIDDurableTaskQueryResult:
+		err = d.a.WrapErr(d.handleDurableTaskQueryResult(ctx, task), map[string]interface{}{})
 	default:
 		err = fmt.Errorf("unknown task: %s", task.ID)
 	}
@@ -472,6 +476,30 @@ func (d *DispatcherImpl) handleDurableCallbackCompleted(ctx context.Context, tas
 	return nil
 }
 
+func (d *DispatcherImpl) handleDurableTaskQuery(ctx context.Context, task *msgqueue.Message) error {
+	payloads := msgqueue.JSONConvert[tasktypesv1.DurableTaskQueryPayload](task.Payloads)
+
+	for _, payload := range payloads {
+		if err := d.serviceV1.HandleDurableTaskQuery(ctx, task.TenantID, payload); err != nil {
+			d.l.Error().Err(err).Msgf("failed to handle query %s for task %s", payload.QueryId, payload.TaskExternalId)
+		}
+	}
+
+	return nil
+}
+
+func (d *DispatcherImpl) handleDurableTaskQueryResult(ctx context.Context, task *msgqueue.Message) error {
+	payloads := msgqueue.JSONConvert[tasktypesv1.DurableTaskQueryResultPayload](task.Payloads)
+
+	for _, payload := range payloads {
+		if err := d.serviceV1.DeliverDurableTaskQueryResult(payload); err != nil {
+			d.l.Debug().Err(err).Msg("dropping query result which is no longer awaited")
+		}
+	}
+
+	return nil
+}
+
 func (d *DispatcherImpl) runUpdateHeartbeat(ctx context.Context) func() {
 	return func() {
 		d.l.Debug().Ctx(ctx).Msgf("dispatcher: updating heartbeat")
@@ -935,6 +963,7 @@ func (d *DispatcherImpl) handleRetries(
 					"This likely means that too many slots have been configured for the number of workers "+
 					"or the network latency between engine and worker is unusually high.",
 				false,
+				"",
 			)
 
 			if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/streams"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/internal/syncx"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
@@ -28,6 +29,12 @@ type DispatcherServiceImpl struct {
 	durableInvocations syncx.Map[uuid.UUID, *durableTaskInvocation]
 	workerInvocations  syncx.Map[uuid.UUID, *durableTaskInvocation]
 	dispatcherId       uuid.UUID
+
+	// pendingQueries are the queries started on this dispatcher which are waiting for a result
+	pendingQueries syncx.Map[uuid.UUID, chan *tasktypes.DurableTaskQueryResultPayload]
+
+	// forwardedQueries maps the queries sent to workers on this dispatcher to the dispatcher waiting for the result
+	forwardedQueries syncx.Map[uuid.UUID, uuid.UUID]
 }
 
 // CancelStreamSessions hangs up all registered long-lived streams (durable event
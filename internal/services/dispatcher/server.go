@@ -42,10 +42,11 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 	}
 
 	opts := &v1.CreateWorkerOpts{
-		DispatcherId: s.dispatcherId,
-		Name:         request.WorkerName,
-		Actions:      request.Actions,
-		Services:     svcs,
+		DispatcherId:     s.dispatcherId,
+		Name:             request.WorkerName,
+		Actions:          request.Actions,
+		Services:         svcs,
+		WorkflowVersions: request.WorkflowVersions,
 	}
 
 	if request.RuntimeInfo != nil {
@@ -1267,6 +1268,24 @@ func (s *DispatcherImpl) sendStepActionEventV1(ctx context.Context, request *con
 		}
 	}
 
+	// outputs which don't match the output schema of the task fail the task without retrying it, since the task
+	// would most likely return an output of the same shape if it was retried
+	if request.EventType == contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED {
+		err := s.repov1.Tasks().ValidateTaskOutput(ctx, task.StepID, task.StepReadableID, []byte(request.EventPayload))
+
+		invalidOutput := &v1.ErrInvalidOutput{}
+
+		if errors.As(err, &invalidOutput) {
+			shouldNotRetry := true
+
+			request.EventPayload = invalidOutput.Error()
+			request.EventType = contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED
+			request.ShouldNotRetry = &shouldNotRetry
+		} else if err != nil {
+			s.l.Warn().Ctx(ctx).Err(err).Msgf("could not validate output of task %s, skipping output validation", task.ExternalID)
+		}
+	}
+
 	var durableInvCount int32
 	invocationCounts, err := s.repov1.DurableEvents().GetDurableTaskInvocationCounts(ctx, tenant.ID, []v1.IdInsertedAt{
 		{ID: task.ID, InsertedAt: task.InsertedAt},
@@ -1401,6 +1420,7 @@ func (s *DispatcherImpl) handleTaskFailed(inputCtx context.Context, task *sqlcv1
 		true,
 		request.EventPayload,
 		shouldNotRetry,
+		request.GetErrorType(),
 	)
 
 	if err != nil {
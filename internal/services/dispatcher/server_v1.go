@@ -415,6 +415,10 @@ func (d *DispatcherServiceImpl) DurableTask(server contracts.V1Dispatcher_Durabl
 				registerTask(msg.TriggerRuns.DurableTaskExternalId)
 			case *contracts.DurableTaskRequest_WaitFor:
 				registerTask(msg.WaitFor.DurableTaskExternalId)
+			case *contracts.DurableTaskRequest_WaitForSignal:
+				registerTask(msg.WaitForSignal.DurableTaskExternalId)
+			case *contracts.DurableTaskRequest_Patch:
+				registerTask(msg.Patch.DurableTaskExternalId)
 			}
 
 			if err := d.handleDurableTaskRequest(ctx, invocation, r.req); err != nil {
@@ -444,6 +448,12 @@ func (d *DispatcherServiceImpl) handleDurableTaskRequest(
 		return d.handleWorkerStatus(ctx, invocation, msg.WorkerStatus)
 	case *contracts.DurableTaskRequest_CompleteMemo:
 		return d.handleCompleteMemo(ctx, invocation, msg.CompleteMemo)
+	case *contracts.DurableTaskRequest_WaitForSignal:
+		return d.handleWaitForSignal(ctx, invocation, msg.WaitForSignal)
+	case *contracts.DurableTaskRequest_QueryResult:
+		return d.handleQueryResult(ctx, invocation, msg.QueryResult)
+	case *contracts.DurableTaskRequest_Patch:
+		return d.handlePatch(ctx, invocation, msg.Patch)
 	default:
 		return status.Errorf(codes.InvalidArgument, "unknown message type: %T", msg)
 	}
@@ -565,6 +575,21 @@ func (d *DispatcherServiceImpl) deliverSatisfiedEntries(taskExternalId string, r
 				return fmt.Errorf("failed to deliver callback completion for node %d: %w", result.WaitForResult.NodeId, err)
 			}
 		}
+	case sqlcv1.V1DurableEventLogKindSIGNAL:
+		if result.SignalResult.IsSatisfied {
+			taskExtId, _ := uuid.Parse(taskExternalId)
+			if err := d.DeliverDurableEventLogEntryCompletion(
+				taskExtId,
+				result.SignalResult.InvocationCount,
+				result.SignalResult.BranchId,
+				result.SignalResult.NodeId,
+				result.SignalResult.ResultPayload,
+				false,
+				nil,
+			); err != nil {
+				return fmt.Errorf("failed to deliver callback completion for node %d: %w", result.SignalResult.NodeId, err)
+			}
+		}
 	default:
 		return fmt.Errorf("unknown durable event log kind: %s", result.Kind)
 	}
@@ -0,0 +1,81 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (d *DispatcherServiceImpl) handlePatch(
+	ctx context.Context,
+	invocation *durableTaskInvocation,
+	req *contracts.DurableTaskPatchRequest,
+) error {
+	taskExternalId, err := uuid.Parse(req.DurableTaskExternalId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid durable_task_external_id: %v", err)
+	}
+
+	if req.PatchId == "" {
+		return status.Error(codes.InvalidArgument, "patch_id is required")
+	}
+
+	d.analytics.Count(ctx, analytics.DurableTask, analytics.Patch, analytics.Props(
+		"deprecated", req.Deprecated,
+	))
+
+	task, err := d.repo.Tasks().GetTaskByExternalId(ctx, invocation.tenantId, taskExternalId, false)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "task not found: %v", err)
+	}
+
+	ingestionResult, err := d.repo.DurableEvents().IngestDurableTaskEvent(ctx, v1.IngestDurableTaskEventOpts{
+		BaseIngestEventOpts: &v1.BaseIngestEventOpts{
+			TenantId:        invocation.tenantId,
+			Task:            task,
+			Kind:            sqlcv1.V1DurableEventLogKindPATCH,
+			InvocationCount: req.InvocationCount,
+		},
+		Patch: &v1.IngestPatchOpts{
+			PatchId:    req.PatchId,
+			Deprecated: req.Deprecated,
+		},
+	})
+
+	var nde *v1.NonDeterminismError
+	var sie *v1.StaleInvocationError
+
+	switch {
+	case err != nil && errors.As(err, &nde):
+		return d.sendNonDeterminismError(invocation, nde, req.InvocationCount)
+	case err != nil && errors.As(err, &sie):
+		return d.sendStaleInvocationEviction(invocation, sie)
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed to ingest patch event: %v", err)
+	}
+
+	err = invocation.send(&contracts.DurableTaskResponse{
+		Message: &contracts.DurableTaskResponse_PatchAck{
+			PatchAck: &contracts.DurableTaskEventPatchAckResponse{
+				Ref: newEntryRef(req.DurableTaskExternalId, req.InvocationCount, v1.NodeIdBranchIdTuple{
+					NodeId:   ingestionResult.PatchResult.NodeId,
+					BranchId: ingestionResult.PatchResult.BranchId,
+				}),
+				Patched: ingestionResult.PatchResult.IsPatched,
+			},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send patch ack: %v", err)
+	}
+
+	return nil
+}
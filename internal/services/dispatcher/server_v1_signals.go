@@ -0,0 +1,293 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/durable"
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// durableTaskQueryTimeout is how long a query waits for the worker running the task to return a result
+const durableTaskQueryTimeout = 30 * time.Second
+
+func (d *DispatcherServiceImpl) SendDurableTaskSignal(ctx context.Context, req *contracts.SendDurableTaskSignalRequest) (*contracts.SendDurableTaskSignalResponse, error) {
+	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	taskExternalId, err := uuid.Parse(req.DurableTaskExternalId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid durable_task_external_id: %v", err)
+	}
+
+	if req.SignalName == "" {
+		return nil, status.Error(codes.InvalidArgument, "signal_name is required")
+	}
+
+	d.analytics.Count(ctx, analytics.DurableTask, analytics.Signal)
+
+	entry, err := d.repo.DurableEvents().SendDurableTaskSignal(ctx, v1.SendDurableTaskSignalOpts{
+		TenantId:       tenantId,
+		TaskExternalId: taskExternalId,
+		Name:           req.SignalName,
+		Payload:        req.Payload,
+	})
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "task %s not found", req.DurableTaskExternalId)
+	case errors.Is(err, v1.ErrDurableTaskNotStarted), errors.Is(err, v1.ErrDurableTaskFinalized):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to send signal: %v", err)
+	}
+
+	if entry == nil {
+		return &contracts.SendDurableTaskSignalResponse{Delivered: false}, nil
+	}
+
+	// the signal is already recorded in the event log, so if the callback can't be dispatched the worker picks
+	// it up the next time it reports the entries it's waiting on
+	if err := durable.DispatchCallbacks(ctx, d.l, d.mq, d.repo, tenantId, []v1.SatisfiedEntry{*entry}); err != nil {
+		d.l.Error().Err(err).Msgf("failed to dispatch signal %s to task %s", req.SignalName, req.DurableTaskExternalId)
+	}
+
+	return &contracts.SendDurableTaskSignalResponse{Delivered: true}, nil
+}
+
+func (d *DispatcherServiceImpl) handleWaitForSignal(
+	ctx context.Context,
+	invocation *durableTaskInvocation,
+	req *contracts.DurableTaskWaitForSignalRequest,
+) error {
+	taskExternalId, err := uuid.Parse(req.DurableTaskExternalId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid durable_task_external_id: %v", err)
+	}
+
+	d.analytics.Count(ctx, analytics.DurableTask, analytics.WaitFor, analytics.Props(
+		"has_signal", true,
+	))
+
+	task, err := d.repo.Tasks().GetTaskByExternalId(ctx, invocation.tenantId, taskExternalId, false)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "task not found: %v", err)
+	}
+
+	ingestionResult, err := d.repo.DurableEvents().IngestDurableTaskEvent(ctx, v1.IngestDurableTaskEventOpts{
+		BaseIngestEventOpts: &v1.BaseIngestEventOpts{
+			TenantId:        invocation.tenantId,
+			Task:            task,
+			Kind:            sqlcv1.V1DurableEventLogKindSIGNAL,
+			InvocationCount: req.InvocationCount,
+		},
+		Signal: &v1.IngestSignalOpts{
+			Name: req.SignalName,
+		},
+	})
+
+	var nde *v1.NonDeterminismError
+	var sie *v1.StaleInvocationError
+
+	switch {
+	case err != nil && errors.As(err, &nde):
+		return d.sendNonDeterminismError(invocation, nde, req.InvocationCount)
+	case err != nil && errors.As(err, &sie):
+		return d.sendStaleInvocationEviction(invocation, sie)
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed to ingest wait_for_signal event: %v", err)
+	}
+
+	err = invocation.send(&contracts.DurableTaskResponse{
+		Message: &contracts.DurableTaskResponse_WaitForAck{
+			WaitForAck: &contracts.DurableTaskEventWaitForAckResponse{
+				Ref: newEntryRef(req.DurableTaskExternalId, req.InvocationCount, v1.NodeIdBranchIdTuple{
+					NodeId:   ingestionResult.SignalResult.NodeId,
+					BranchId: ingestionResult.SignalResult.BranchId,
+				}),
+			},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send wait_for_signal ack: %v", err)
+	}
+
+	return d.deliverSatisfiedEntries(req.DurableTaskExternalId, ingestionResult)
+}
+
+func (d *DispatcherServiceImpl) QueryDurableTask(ctx context.Context, req *contracts.QueryDurableTaskRequest) (*contracts.QueryDurableTaskResponse, error) {
+	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	taskExternalId, err := uuid.Parse(req.DurableTaskExternalId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid durable_task_external_id: %v", err)
+	}
+
+	if req.QueryName == "" {
+		return nil, status.Error(codes.InvalidArgument, "query_name is required")
+	}
+
+	d.analytics.Count(ctx, analytics.DurableTask, analytics.Query)
+
+	task, err := d.repo.Tasks().GetTaskByExternalId(ctx, tenantId, taskExternalId, false)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "task not found: %v", err)
+	}
+
+	key := v1.IdInsertedAt{
+		ID:         task.ID,
+		InsertedAt: task.InsertedAt,
+	}
+
+	lookups, err := d.repo.Workers().GetDurableDispatcherIdsForTasks(ctx, tenantId, []v1.IdInsertedAt{key})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get dispatcher for task: %v", err)
+	}
+
+	lookup, ok := lookups[key]
+
+	switch {
+	case !ok:
+		return nil, status.Errorf(codes.FailedPrecondition, "task %s is not running", req.DurableTaskExternalId)
+	case lookup.IsEvicted:
+		return nil, status.Errorf(codes.FailedPrecondition, "task %s is evicted, restore it before querying it", req.DurableTaskExternalId)
+	case lookup.DispatcherId == nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "task %s is not running on a worker which supports durable tasks", req.DurableTaskExternalId)
+	}
+
+	queryId := uuid.New()
+	resultCh := make(chan *tasktypes.DurableTaskQueryResultPayload, 1)
+
+	d.pendingQueries.Store(queryId, resultCh)
+	defer d.pendingQueries.Delete(queryId)
+
+	msg, err := tasktypes.DurableTaskQueryMessage(tenantId, taskExternalId, queryId, lookup.WorkerId, req.QueryName, req.Input, d.dispatcherId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not create query message: %v", err)
+	}
+
+	if err := d.mq.SendMessage(ctx, msgqueue.QueueTypeFromDispatcherID(*lookup.DispatcherId), msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not send query to dispatcher: %v", err)
+	}
+
+	timer := time.NewTimer(durableTaskQueryTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-timer.C:
+		return nil, status.Errorf(codes.DeadlineExceeded, "timed out waiting for task %s to answer query %s", req.DurableTaskExternalId, req.QueryName)
+	case res := <-resultCh:
+		if res.ErrorMessage != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "query %s failed: %s", req.QueryName, *res.ErrorMessage)
+		}
+
+		return &contracts.QueryDurableTaskResponse{Payload: res.Payload}, nil
+	}
+}
+
+// HandleDurableTaskQuery sends a query to the worker running the durable task. It's called on the dispatcher which
+// the worker is connected to, which may not be the dispatcher waiting for the result.
+func (d *DispatcherServiceImpl) HandleDurableTaskQuery(ctx context.Context, tenantId uuid.UUID, query *tasktypes.DurableTaskQueryPayload) error {
+	inv, ok := d.durableInvocations.Load(query.TaskExternalId)
+
+	// a task which hasn't made a durable request yet is only known by the stream of its worker
+	if !ok && query.WorkerId != nil {
+		inv, ok = d.workerInvocations.Load(*query.WorkerId)
+	}
+
+	if !ok {
+		errMsg := fmt.Sprintf("no active invocation found for task %s", query.TaskExternalId)
+		return d.replyDurableTaskQuery(ctx, tenantId, query.ReplyDispatcherId, query.QueryId, nil, &errMsg)
+	}
+
+	d.forwardedQueries.Store(query.QueryId, query.ReplyDispatcherId)
+
+	// the waiting dispatcher gives up after the timeout, so a worker which never answers doesn't leak the entry
+	time.AfterFunc(durableTaskQueryTimeout, func() {
+		d.forwardedQueries.Delete(query.QueryId)
+	})
+
+	err := inv.send(&contracts.DurableTaskResponse{
+		Message: &contracts.DurableTaskResponse_Query{
+			Query: &contracts.DurableTaskQueryRequest{
+				QueryId:               query.QueryId.String(),
+				DurableTaskExternalId: query.TaskExternalId.String(),
+				QueryName:             query.QueryName,
+				Input:                 query.Input,
+			},
+		},
+	})
+
+	if err != nil {
+		d.forwardedQueries.Delete(query.QueryId)
+
+		errMsg := fmt.Sprintf("could not send query to worker: %v", err)
+		return d.replyDurableTaskQuery(ctx, tenantId, query.ReplyDispatcherId, query.QueryId, nil, &errMsg)
+	}
+
+	return nil
+}
+
+func (d *DispatcherServiceImpl) handleQueryResult(
+	ctx context.Context,
+	invocation *durableTaskInvocation,
+	req *contracts.DurableTaskQueryResultRequest,
+) error {
+	queryId, err := uuid.Parse(req.QueryId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid query_id: %v", err)
+	}
+
+	replyDispatcherId, ok := d.forwardedQueries.Load(queryId)
+	if !ok {
+		d.l.Debug().Msgf("dropping result of query %s for task %s, which has timed out", req.QueryId, req.DurableTaskExternalId)
+		return nil
+	}
+
+	d.forwardedQueries.Delete(queryId)
+
+	return d.replyDurableTaskQuery(ctx, invocation.tenantId, replyDispatcherId, queryId, req.Payload, req.ErrorMessage)
+}
+
+func (d *DispatcherServiceImpl) replyDurableTaskQuery(ctx context.Context, tenantId, replyDispatcherId, queryId uuid.UUID, payload []byte, errorMessage *string) error {
+	msg, err := tasktypes.DurableTaskQueryResultMessage(tenantId, queryId, payload, errorMessage)
+	if err != nil {
+		return fmt.Errorf("could not create query result message: %w", err)
+	}
+
+	if err := d.mq.SendMessage(ctx, msgqueue.QueueTypeFromDispatcherID(replyDispatcherId), msg); err != nil {
+		return fmt.Errorf("could not send query result to dispatcher %s: %w", replyDispatcherId, err)
+	}
+
+	return nil
+}
+
+// DeliverDurableTaskQueryResult hands the result of a query to the QueryDurableTask call waiting for it
+func (d *DispatcherServiceImpl) DeliverDurableTaskQueryResult(result *tasktypes.DurableTaskQueryResultPayload) error {
+	resultCh, ok := d.pendingQueries.Load(result.QueryId)
+	if !ok {
+		return fmt.Errorf("no pending query %s", result.QueryId)
+	}
+
+	select {
+	case resultCh <- result:
+	default:
+	}
+
+	return nil
+}
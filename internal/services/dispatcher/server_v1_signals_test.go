@@ -0,0 +1,43 @@
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+)
+
+func TestDeliverDurableTaskQueryResultToPendingQuery(t *testing.T) {
+	d := &DispatcherServiceImpl{}
+
+	queryId := uuid.New()
+	resultCh := make(chan *tasktypes.DurableTaskQueryResultPayload, 1)
+	d.pendingQueries.Store(queryId, resultCh)
+
+	err := d.DeliverDurableTaskQueryResult(&tasktypes.DurableTaskQueryResultPayload{
+		QueryId: queryId,
+		Payload: []byte(`{"processed":3}`),
+	})
+	require.NoError(t, err)
+
+	select {
+	case res := <-resultCh:
+		assert.Equal(t, []byte(`{"processed":3}`), res.Payload)
+	default:
+		t.Fatal("expected result to be delivered")
+	}
+
+	// a duplicate result must not block once the channel is full
+	require.NoError(t, d.DeliverDurableTaskQueryResult(&tasktypes.DurableTaskQueryResultPayload{QueryId: queryId}))
+	require.NoError(t, d.DeliverDurableTaskQueryResult(&tasktypes.DurableTaskQueryResultPayload{QueryId: queryId}))
+}
+
+func TestDeliverDurableTaskQueryResultWithoutPendingQuery(t *testing.T) {
+	d := &DispatcherServiceImpl{}
+
+	err := d.DeliverDurableTaskQueryResult(&tasktypes.DurableTaskQueryResultPayload{QueryId: uuid.New()})
+	assert.Error(t, err)
+}
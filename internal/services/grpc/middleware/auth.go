@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -37,7 +38,7 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 		return nil, forbidden
 	}
 
-	tenantId, tokenUUID, err := a.config.Auth.JWTManager.ValidateTenantToken(ctx, token)
+	tenantId, tokenUUID, scopes, err := a.config.Auth.JWTManager.ValidateTenantToken(ctx, token)
 
 	if err != nil {
 		a.l.Debug().Ctx(ctx).Err(err).Msgf("error validating tenant token: %s", err)
@@ -45,6 +46,16 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 		return nil, forbidden
 	}
 
+	if method, ok := grpc.Method(ctx); ok && !a.config.Auth.ScopeAuthorizer.IsAuthorizedGRPC(scopes, method) {
+		a.l.Debug().Ctx(ctx).Msgf("api token scopes do not include method %s", method)
+
+		return nil, status.Errorf(codes.PermissionDenied, "api token is not authorized to call %s", method)
+	}
+
+	if scopes != nil {
+		ctx = context.WithValue(ctx, apiTokenScopesKey, scopes)
+	}
+
 	ctx = context.WithValue(ctx, analytics.APITokenIDKey, tokenUUID)
 	ctx = context.WithValue(ctx, analytics.TenantIDKey, tenantId)
 
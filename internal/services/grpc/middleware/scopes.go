@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+)
+
+type apiTokenScopesKeyType string
+
+const apiTokenScopesKey apiTokenScopesKeyType = "api-token-scopes"
+
+// APITokenScopesFromContext returns the scopes of the API token which authenticated the request, or nil if
+// the token is unrestricted.
+func APITokenScopesFromContext(ctx context.Context) *rbac.Scopes {
+	scopes, _ := ctx.Value(apiTokenScopesKey).(*rbac.Scopes)
+	return scopes
+}
+
+// WorkflowScopesUnaryInterceptor rejects trigger requests for workflows which aren't included in the scopes
+// of the API token. It must run after the auth interceptor.
+func WorkflowScopesUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	scopes := APITokenScopesFromContext(ctx)
+
+	if !scopes.RestrictsWorkflows() {
+		return handler(ctx, req)
+	}
+
+	for _, name := range requestWorkflowNames(req) {
+		if !scopes.AllowsWorkflow(name) {
+			return nil, status.Errorf(codes.PermissionDenied, "api token is not authorized to access workflow %s", name)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func requestWorkflowNames(req interface{}) []string {
+	switch r := req.(type) {
+	case *v1contracts.TriggerWorkflowRequest:
+		return []string{r.GetName()}
+	case *admincontracts.BulkTriggerWorkflowRequest:
+		names := make([]string, 0, len(r.GetWorkflows()))
+
+		for _, w := range r.GetWorkflows() {
+			names = append(names, w.GetName())
+		}
+
+		return names
+	case *admincontracts.ScheduleWorkflowRequest:
+		return []string{r.GetName()}
+	case *v1contracts.TriggerWorkflowRunRequest:
+		return []string{r.GetWorkflowName()}
+	}
+
+	return nil
+}
@@ -310,6 +310,7 @@ func (s *Server) startGRPC() (func() error, error) {
 	baseUnaryInterceptors := []grpc.UnaryServerInterceptor{
 		logging.UnaryServerInterceptor(middleware.InterceptorLogger(s.l), opts...),
 		auth.UnaryServerInterceptor(authMiddleware.Middleware),
+		middleware.WorkflowScopesUnaryInterceptor,
 		middleware.AttachServerNameInterceptor,
 		ratelimit.UnaryServerInterceptor(limiter),
 		errorInterceptor.ErrorUnaryServerInterceptor(),
@@ -2,6 +2,7 @@ package ingestor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -15,6 +16,7 @@ import (
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/constants"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -241,7 +243,17 @@ func (i *IngestorImpl) bulkIngestEventV1(ctx context.Context, tenant *sqlcv1.Ten
 	payloads := make([]tasktypes.UserEventTaskPayload, 0, len(eventOpts))
 
 	for _, event := range eventOpts {
-		payloads = append(payloads, eventToPayload(tenantId, event.Key, event.Data, event.AdditionalMetadata, event.Priority, event.Scope, event.TriggeringWebhookName))
+		additionalMetadata := event.AdditionalMetadata
+
+		if event.ReplayedEvent != nil {
+			additionalMetadata, err = withReplayedEventId(additionalMetadata, *event.ReplayedEvent)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		payloads = append(payloads, eventToPayload(tenantId, event.Key, event.Data, additionalMetadata, event.Priority, event.Scope, event.TriggeringWebhookName))
 	}
 
 	return i.ingest(ctx, tenant, payloads...)
@@ -253,7 +265,13 @@ func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *sqlcv1
 
 	tenantId := tenant.ID
 
-	opt := eventToPayload(tenantId, replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata, nil, nil, nil)
+	additionalMetadata, err := withReplayedEventId(replayedEvent.AdditionalMetadata, replayedEvent.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	opt := eventToPayload(tenantId, replayedEvent.Key, replayedEvent.Data, additionalMetadata, nil, nil, nil)
 
 	events, err := i.ingest(ctx, tenant, opt)
 
@@ -268,6 +286,26 @@ func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *sqlcv1
 	return events[0], nil
 }
 
+// withReplayedEventId marks the additional metadata of a replayed event with the id of the original event, so
+// replays can be told apart from the events which were originally ingested
+func withReplayedEventId(additionalMetadata []byte, replayedEventId uuid.UUID) ([]byte, error) {
+	meta := make(map[string]interface{})
+
+	if len(additionalMetadata) > 0 {
+		if err := json.Unmarshal(additionalMetadata, &meta); err != nil {
+			return nil, fmt.Errorf("could not unmarshal additional metadata of replayed event: %w", err)
+		}
+	}
+
+	if meta == nil {
+		meta = make(map[string]interface{})
+	}
+
+	meta[constants.ReplayedEventIdKey.String()] = replayedEventId.String()
+
+	return json.Marshal(meta)
+}
+
 func eventToPayload(tenantId uuid.UUID, key string, data, additionalMeta []byte, priority *int32, scope *string, triggeringWebhookName *string) tasktypes.UserEventTaskPayload {
 	eventId := uuid.New()
 
@@ -674,6 +674,7 @@ func (s *Scheduler) internalRetry(ctx context.Context, tenantId uuid.UUID, assig
 			false,
 			"could not assign step run to worker",
 			false,
+			"",
 		)
 
 		if err != nil {
@@ -838,6 +839,7 @@ func (s *Scheduler) handleDeadLetteredTaskBulkAssigned(ctx context.Context, msg
 			false,
 			"Could not send task to worker",
 			false,
+			"",
 		)
 
 		if err != nil {
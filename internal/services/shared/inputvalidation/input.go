@@ -0,0 +1,73 @@
+package inputvalidation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// ValidateTriggerInput validates the input of each workflow run against its workflow's input schema. Invalid input
+// for a workflow which rejects invalid input returns an InvalidArgument status with a field violation for each JSON
+// pointer which doesn't match the schema, while invalid input for other workflows is recorded as a CEL evaluation
+// failure.
+func ValidateTriggerInput(ctx context.Context, l *zerolog.Logger, mq msgqueue.MessageQueue, repo v1.Repository, tenantId uuid.UUID, opts []*v1.WorkflowNameTriggerOpts) error {
+	failures, err := repo.Triggers().ValidateWorkflowNameOptsInput(ctx, tenantId, opts)
+
+	if err != nil {
+		invalidInput := &v1.ErrInvalidInput{}
+
+		if errors.As(err, &invalidInput) {
+			return InvalidInputStatus(invalidInput)
+		}
+
+		return fmt.Errorf("could not validate workflow input: %w", err)
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	msg, err := tasktypes.CELEvaluationFailureMessage(tenantId, failures)
+
+	if err != nil {
+		return fmt.Errorf("could not create CEL evaluation failure message: %w", err)
+	}
+
+	// we don't fail the trigger if we can't record the failures
+	if err := mq.SendMessage(ctx, msgqueue.OLAP_QUEUE, msg); err != nil {
+		l.Error().Ctx(ctx).Err(err).Msg("could not send input validation failures")
+	}
+
+	return nil
+}
+
+// InvalidInputStatus converts an input validation error to an InvalidArgument status with a field violation for each
+// JSON pointer which doesn't match the schema.
+func InvalidInputStatus(err *v1.ErrInvalidInput) error {
+	badRequest := &errdetails.BadRequest{}
+
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Pointer,
+			Description: v.Message,
+		})
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
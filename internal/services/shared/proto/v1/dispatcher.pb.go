@@ -467,6 +467,62 @@ func (x *DurableTaskEventWaitForAckResponse) GetRef() *DurableEventLogEntryRef {
 	return nil
 }
 
+type DurableTaskEventPatchAckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *DurableEventLogEntryRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// whether the task should take the patched code path
+	Patched bool `protobuf:"varint,2,opt,name=patched,proto3" json:"patched,omitempty"`
+}
+
+func (x *DurableTaskEventPatchAckResponse) Reset() {
+	*x = DurableTaskEventPatchAckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurableTaskEventPatchAckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurableTaskEventPatchAckResponse) ProtoMessage() {}
+
+func (x *DurableTaskEventPatchAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurableTaskEventPatchAckResponse.ProtoReflect.Descriptor instead.
+func (*DurableTaskEventPatchAckResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DurableTaskEventPatchAckResponse) GetRef() *DurableEventLogEntryRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DurableTaskEventPatchAckResponse) GetPatched() bool {
+	if x != nil {
+		return x.Patched
+	}
+	return false
+}
+
 type DurableTaskEventLogEntryCompletedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -481,7 +537,7 @@ type DurableTaskEventLogEntryCompletedResponse struct {
 func (x *DurableTaskEventLogEntryCompletedResponse) Reset() {
 	*x = DurableTaskEventLogEntryCompletedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[7]
+		mi := &file_v1_dispatcher_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +550,7 @@ func (x *DurableTaskEventLogEntryCompletedResponse) String() string {
 func (*DurableTaskEventLogEntryCompletedResponse) ProtoMessage() {}
 
 func (x *DurableTaskEventLogEntryCompletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[7]
+	mi := &file_v1_dispatcher_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +563,7 @@ func (x *DurableTaskEventLogEntryCompletedResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use DurableTaskEventLogEntryCompletedResponse.ProtoReflect.Descriptor instead.
 func (*DurableTaskEventLogEntryCompletedResponse) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{7}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DurableTaskEventLogEntryCompletedResponse) GetRef() *DurableEventLogEntryRef {
@@ -551,7 +607,7 @@ type DurableTaskEvictInvocationRequest struct {
 func (x *DurableTaskEvictInvocationRequest) Reset() {
 	*x = DurableTaskEvictInvocationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[8]
+		mi := &file_v1_dispatcher_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -564,7 +620,7 @@ func (x *DurableTaskEvictInvocationRequest) String() string {
 func (*DurableTaskEvictInvocationRequest) ProtoMessage() {}
 
 func (x *DurableTaskEvictInvocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[8]
+	mi := &file_v1_dispatcher_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +633,7 @@ func (x *DurableTaskEvictInvocationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DurableTaskEvictInvocationRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskEvictInvocationRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{8}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DurableTaskEvictInvocationRequest) GetInvocationCount() int32 {
@@ -614,7 +670,7 @@ type DurableTaskEvictionAckResponse struct {
 func (x *DurableTaskEvictionAckResponse) Reset() {
 	*x = DurableTaskEvictionAckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[9]
+		mi := &file_v1_dispatcher_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +683,7 @@ func (x *DurableTaskEvictionAckResponse) String() string {
 func (*DurableTaskEvictionAckResponse) ProtoMessage() {}
 
 func (x *DurableTaskEvictionAckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[9]
+	mi := &file_v1_dispatcher_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +696,7 @@ func (x *DurableTaskEvictionAckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskEvictionAckResponse.ProtoReflect.Descriptor instead.
 func (*DurableTaskEvictionAckResponse) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{9}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DurableTaskEvictionAckResponse) GetInvocationCount() int32 {
@@ -671,7 +727,7 @@ type DurableTaskAwaitedCompletedEntry struct {
 func (x *DurableTaskAwaitedCompletedEntry) Reset() {
 	*x = DurableTaskAwaitedCompletedEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[10]
+		mi := &file_v1_dispatcher_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +740,7 @@ func (x *DurableTaskAwaitedCompletedEntry) String() string {
 func (*DurableTaskAwaitedCompletedEntry) ProtoMessage() {}
 
 func (x *DurableTaskAwaitedCompletedEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[10]
+	mi := &file_v1_dispatcher_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +753,7 @@ func (x *DurableTaskAwaitedCompletedEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskAwaitedCompletedEntry.ProtoReflect.Descriptor instead.
 func (*DurableTaskAwaitedCompletedEntry) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{10}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DurableTaskAwaitedCompletedEntry) GetDurableTaskExternalId() string {
@@ -742,7 +798,7 @@ type DurableTaskServerEvictNotice struct {
 func (x *DurableTaskServerEvictNotice) Reset() {
 	*x = DurableTaskServerEvictNotice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[11]
+		mi := &file_v1_dispatcher_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +811,7 @@ func (x *DurableTaskServerEvictNotice) String() string {
 func (*DurableTaskServerEvictNotice) ProtoMessage() {}
 
 func (x *DurableTaskServerEvictNotice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[11]
+	mi := &file_v1_dispatcher_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +824,7 @@ func (x *DurableTaskServerEvictNotice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskServerEvictNotice.ProtoReflect.Descriptor instead.
 func (*DurableTaskServerEvictNotice) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{11}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DurableTaskServerEvictNotice) GetDurableTaskExternalId() string {
@@ -804,7 +860,7 @@ type DurableTaskWorkerStatusRequest struct {
 func (x *DurableTaskWorkerStatusRequest) Reset() {
 	*x = DurableTaskWorkerStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[12]
+		mi := &file_v1_dispatcher_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +873,7 @@ func (x *DurableTaskWorkerStatusRequest) String() string {
 func (*DurableTaskWorkerStatusRequest) ProtoMessage() {}
 
 func (x *DurableTaskWorkerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[12]
+	mi := &file_v1_dispatcher_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +886,7 @@ func (x *DurableTaskWorkerStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskWorkerStatusRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskWorkerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{12}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DurableTaskWorkerStatusRequest) GetWorkerId() string {
@@ -860,7 +916,7 @@ type DurableTaskCompleteMemoRequest struct {
 func (x *DurableTaskCompleteMemoRequest) Reset() {
 	*x = DurableTaskCompleteMemoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[13]
+		mi := &file_v1_dispatcher_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -873,7 +929,7 @@ func (x *DurableTaskCompleteMemoRequest) String() string {
 func (*DurableTaskCompleteMemoRequest) ProtoMessage() {}
 
 func (x *DurableTaskCompleteMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[13]
+	mi := &file_v1_dispatcher_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +942,7 @@ func (x *DurableTaskCompleteMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskCompleteMemoRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskCompleteMemoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{13}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DurableTaskCompleteMemoRequest) GetRef() *DurableEventLogEntryRef {
@@ -930,7 +986,7 @@ type DurableTaskMemoRequest struct {
 func (x *DurableTaskMemoRequest) Reset() {
 	*x = DurableTaskMemoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[14]
+		mi := &file_v1_dispatcher_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +999,7 @@ func (x *DurableTaskMemoRequest) String() string {
 func (*DurableTaskMemoRequest) ProtoMessage() {}
 
 func (x *DurableTaskMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[14]
+	mi := &file_v1_dispatcher_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1012,7 @@ func (x *DurableTaskMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskMemoRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskMemoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{14}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DurableTaskMemoRequest) GetInvocationCount() int32 {
@@ -1005,7 +1061,7 @@ type DurableTaskTriggerRunsRequest struct {
 func (x *DurableTaskTriggerRunsRequest) Reset() {
 	*x = DurableTaskTriggerRunsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[15]
+		mi := &file_v1_dispatcher_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1018,7 +1074,7 @@ func (x *DurableTaskTriggerRunsRequest) String() string {
 func (*DurableTaskTriggerRunsRequest) ProtoMessage() {}
 
 func (x *DurableTaskTriggerRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[15]
+	mi := &file_v1_dispatcher_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1087,7 @@ func (x *DurableTaskTriggerRunsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskTriggerRunsRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskTriggerRunsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{15}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DurableTaskTriggerRunsRequest) GetInvocationCount() int32 {
@@ -1077,7 +1133,7 @@ type DurableTaskWaitForRequest struct {
 func (x *DurableTaskWaitForRequest) Reset() {
 	*x = DurableTaskWaitForRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[16]
+		mi := &file_v1_dispatcher_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1090,7 +1146,7 @@ func (x *DurableTaskWaitForRequest) String() string {
 func (*DurableTaskWaitForRequest) ProtoMessage() {}
 
 func (x *DurableTaskWaitForRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[16]
+	mi := &file_v1_dispatcher_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1159,7 @@ func (x *DurableTaskWaitForRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskWaitForRequest.ProtoReflect.Descriptor instead.
 func (*DurableTaskWaitForRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{16}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DurableTaskWaitForRequest) GetInvocationCount() int32 {
@@ -1134,40 +1190,39 @@ func (x *DurableTaskWaitForRequest) GetLabel() string {
 	return ""
 }
 
-type DurableTaskRequest struct {
+type DurableTaskWaitForSignalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Message:
-	//
-	//	*DurableTaskRequest_RegisterWorker
-	//	*DurableTaskRequest_Memo
-	//	*DurableTaskRequest_TriggerRuns
-	//	*DurableTaskRequest_WaitFor
-	//	*DurableTaskRequest_EvictInvocation
-	//	*DurableTaskRequest_WorkerStatus
-	//	*DurableTaskRequest_CompleteMemo
-	Message isDurableTaskRequest_Message `protobuf_oneof:"message"`
+	// The invocation_count is a monotonically increasing count that uniquely identifies an "attempt"
+	// at running a durable task. Each time the task is started, it gets a new invocation count (which has)
+	// incremented by one since the previous invocation. This allows the server (and the worker) to have a way of
+	// differentiating between different attempts of the same task running in different places, to prevent race conditions
+	// and other problems from duplication. It also allows for older invocations to be evicted cleanly
+	InvocationCount       int32  `protobuf:"varint,1,opt,name=invocation_count,json=invocationCount,proto3" json:"invocation_count,omitempty"`
+	DurableTaskExternalId string `protobuf:"bytes,2,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	// the name of the signal to wait for
+	SignalName string `protobuf:"bytes,3,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
 }
 
-func (x *DurableTaskRequest) Reset() {
-	*x = DurableTaskRequest{}
+func (x *DurableTaskWaitForSignalRequest) Reset() {
+	*x = DurableTaskWaitForSignalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[17]
+		mi := &file_v1_dispatcher_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DurableTaskRequest) String() string {
+func (x *DurableTaskWaitForSignalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DurableTaskRequest) ProtoMessage() {}
+func (*DurableTaskWaitForSignalRequest) ProtoMessage() {}
 
-func (x *DurableTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[17]
+func (x *DurableTaskWaitForSignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,104 +1233,361 @@ func (x *DurableTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DurableTaskRequest.ProtoReflect.Descriptor instead.
-func (*DurableTaskRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DurableTaskWaitForSignalRequest.ProtoReflect.Descriptor instead.
+func (*DurableTaskWaitForSignalRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{18}
 }
 
-func (m *DurableTaskRequest) GetMessage() isDurableTaskRequest_Message {
-	if m != nil {
-		return m.Message
+func (x *DurableTaskWaitForSignalRequest) GetInvocationCount() int32 {
+	if x != nil {
+		return x.InvocationCount
 	}
-	return nil
+	return 0
 }
 
-func (x *DurableTaskRequest) GetRegisterWorker() *DurableTaskRequestRegisterWorker {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_RegisterWorker); ok {
-		return x.RegisterWorker
+func (x *DurableTaskWaitForSignalRequest) GetDurableTaskExternalId() string {
+	if x != nil {
+		return x.DurableTaskExternalId
 	}
-	return nil
+	return ""
 }
 
-func (x *DurableTaskRequest) GetMemo() *DurableTaskMemoRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_Memo); ok {
-		return x.Memo
+func (x *DurableTaskWaitForSignalRequest) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
 	}
-	return nil
+	return ""
 }
 
-func (x *DurableTaskRequest) GetTriggerRuns() *DurableTaskTriggerRunsRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_TriggerRuns); ok {
-		return x.TriggerRuns
-	}
-	return nil
-}
+type DurableTaskPatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *DurableTaskRequest) GetWaitFor() *DurableTaskWaitForRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_WaitFor); ok {
-		return x.WaitFor
-	}
-	return nil
+	// The invocation_count is a monotonically increasing count that uniquely identifies an "attempt"
+	// at running a durable task. Each time the task is started, it gets a new invocation count (which has)
+	// incremented by one since the previous invocation. This allows the server (and the worker) to have a way of
+	// differentiating between different attempts of the same task running in different places, to prevent race conditions
+	// and other problems from duplication. It also allows for older invocations to be evicted cleanly
+	InvocationCount       int32  `protobuf:"varint,1,opt,name=invocation_count,json=invocationCount,proto3" json:"invocation_count,omitempty"`
+	DurableTaskExternalId string `protobuf:"bytes,2,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	// the id of the code change which is guarded by the patch
+	PatchId string `protobuf:"bytes,3,opt,name=patch_id,json=patchId,proto3" json:"patch_id,omitempty"`
+	// whether the old code path of the patch has been removed, in which case the marker is no longer recorded for new
+	// runs, but is still matched by runs which recorded it
+	Deprecated bool `protobuf:"varint,4,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
-func (x *DurableTaskRequest) GetEvictInvocation() *DurableTaskEvictInvocationRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_EvictInvocation); ok {
-		return x.EvictInvocation
+func (x *DurableTaskPatchRequest) Reset() {
+	*x = DurableTaskPatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DurableTaskRequest) GetWorkerStatus() *DurableTaskWorkerStatusRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_WorkerStatus); ok {
-		return x.WorkerStatus
-	}
-	return nil
+func (x *DurableTaskPatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DurableTaskRequest) GetCompleteMemo() *DurableTaskCompleteMemoRequest {
-	if x, ok := x.GetMessage().(*DurableTaskRequest_CompleteMemo); ok {
-		return x.CompleteMemo
+func (*DurableTaskPatchRequest) ProtoMessage() {}
+
+func (x *DurableTaskPatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isDurableTaskRequest_Message interface {
-	isDurableTaskRequest_Message()
+// Deprecated: Use DurableTaskPatchRequest.ProtoReflect.Descriptor instead.
+func (*DurableTaskPatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{19}
 }
 
-type DurableTaskRequest_RegisterWorker struct {
-	RegisterWorker *DurableTaskRequestRegisterWorker `protobuf:"bytes,1,opt,name=register_worker,json=registerWorker,proto3,oneof"`
+func (x *DurableTaskPatchRequest) GetInvocationCount() int32 {
+	if x != nil {
+		return x.InvocationCount
+	}
+	return 0
 }
 
-type DurableTaskRequest_Memo struct {
-	Memo *DurableTaskMemoRequest `protobuf:"bytes,2,opt,name=memo,proto3,oneof"`
+func (x *DurableTaskPatchRequest) GetDurableTaskExternalId() string {
+	if x != nil {
+		return x.DurableTaskExternalId
+	}
+	return ""
 }
 
-type DurableTaskRequest_TriggerRuns struct {
-	TriggerRuns *DurableTaskTriggerRunsRequest `protobuf:"bytes,3,opt,name=trigger_runs,json=triggerRuns,proto3,oneof"`
+func (x *DurableTaskPatchRequest) GetPatchId() string {
+	if x != nil {
+		return x.PatchId
+	}
+	return ""
 }
 
-type DurableTaskRequest_WaitFor struct {
-	WaitFor *DurableTaskWaitForRequest `protobuf:"bytes,4,opt,name=wait_for,json=waitFor,proto3,oneof"`
+func (x *DurableTaskPatchRequest) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
 }
 
-type DurableTaskRequest_EvictInvocation struct {
-	EvictInvocation *DurableTaskEvictInvocationRequest `protobuf:"bytes,5,opt,name=evict_invocation,json=evictInvocation,proto3,oneof"`
+type DurableTaskQueryResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId               string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	DurableTaskExternalId string `protobuf:"bytes,2,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	// the JSON result of the query handler
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// set if the query handler failed or no handler is registered for the query
+	ErrorMessage *string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
 }
 
-type DurableTaskRequest_WorkerStatus struct {
-	WorkerStatus *DurableTaskWorkerStatusRequest `protobuf:"bytes,6,opt,name=worker_status,json=workerStatus,proto3,oneof"`
+func (x *DurableTaskQueryResultRequest) Reset() {
+	*x = DurableTaskQueryResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type DurableTaskRequest_CompleteMemo struct {
-	CompleteMemo *DurableTaskCompleteMemoRequest `protobuf:"bytes,7,opt,name=complete_memo,json=completeMemo,proto3,oneof"`
+func (x *DurableTaskQueryResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DurableTaskRequest_RegisterWorker) isDurableTaskRequest_Message() {}
+func (*DurableTaskQueryResultRequest) ProtoMessage() {}
 
-func (*DurableTaskRequest_Memo) isDurableTaskRequest_Message() {}
+func (x *DurableTaskQueryResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*DurableTaskRequest_TriggerRuns) isDurableTaskRequest_Message() {}
+// Deprecated: Use DurableTaskQueryResultRequest.ProtoReflect.Descriptor instead.
+func (*DurableTaskQueryResultRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DurableTaskQueryResultRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *DurableTaskQueryResultRequest) GetDurableTaskExternalId() string {
+	if x != nil {
+		return x.DurableTaskExternalId
+	}
+	return ""
+}
+
+func (x *DurableTaskQueryResultRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DurableTaskQueryResultRequest) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+type DurableTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*DurableTaskRequest_RegisterWorker
+	//	*DurableTaskRequest_Memo
+	//	*DurableTaskRequest_TriggerRuns
+	//	*DurableTaskRequest_WaitFor
+	//	*DurableTaskRequest_EvictInvocation
+	//	*DurableTaskRequest_WorkerStatus
+	//	*DurableTaskRequest_CompleteMemo
+	//	*DurableTaskRequest_WaitForSignal
+	//	*DurableTaskRequest_QueryResult
+	//	*DurableTaskRequest_Patch
+	Message isDurableTaskRequest_Message `protobuf_oneof:"message"`
+}
+
+func (x *DurableTaskRequest) Reset() {
+	*x = DurableTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurableTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurableTaskRequest) ProtoMessage() {}
+
+func (x *DurableTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurableTaskRequest.ProtoReflect.Descriptor instead.
+func (*DurableTaskRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *DurableTaskRequest) GetMessage() isDurableTaskRequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetRegisterWorker() *DurableTaskRequestRegisterWorker {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_RegisterWorker); ok {
+		return x.RegisterWorker
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetMemo() *DurableTaskMemoRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_Memo); ok {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetTriggerRuns() *DurableTaskTriggerRunsRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_TriggerRuns); ok {
+		return x.TriggerRuns
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetWaitFor() *DurableTaskWaitForRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_WaitFor); ok {
+		return x.WaitFor
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetEvictInvocation() *DurableTaskEvictInvocationRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_EvictInvocation); ok {
+		return x.EvictInvocation
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetWorkerStatus() *DurableTaskWorkerStatusRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_WorkerStatus); ok {
+		return x.WorkerStatus
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetCompleteMemo() *DurableTaskCompleteMemoRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_CompleteMemo); ok {
+		return x.CompleteMemo
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetWaitForSignal() *DurableTaskWaitForSignalRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_WaitForSignal); ok {
+		return x.WaitForSignal
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetQueryResult() *DurableTaskQueryResultRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_QueryResult); ok {
+		return x.QueryResult
+	}
+	return nil
+}
+
+func (x *DurableTaskRequest) GetPatch() *DurableTaskPatchRequest {
+	if x, ok := x.GetMessage().(*DurableTaskRequest_Patch); ok {
+		return x.Patch
+	}
+	return nil
+}
+
+type isDurableTaskRequest_Message interface {
+	isDurableTaskRequest_Message()
+}
+
+type DurableTaskRequest_RegisterWorker struct {
+	RegisterWorker *DurableTaskRequestRegisterWorker `protobuf:"bytes,1,opt,name=register_worker,json=registerWorker,proto3,oneof"`
+}
+
+type DurableTaskRequest_Memo struct {
+	Memo *DurableTaskMemoRequest `protobuf:"bytes,2,opt,name=memo,proto3,oneof"`
+}
+
+type DurableTaskRequest_TriggerRuns struct {
+	TriggerRuns *DurableTaskTriggerRunsRequest `protobuf:"bytes,3,opt,name=trigger_runs,json=triggerRuns,proto3,oneof"`
+}
+
+type DurableTaskRequest_WaitFor struct {
+	WaitFor *DurableTaskWaitForRequest `protobuf:"bytes,4,opt,name=wait_for,json=waitFor,proto3,oneof"`
+}
+
+type DurableTaskRequest_EvictInvocation struct {
+	EvictInvocation *DurableTaskEvictInvocationRequest `protobuf:"bytes,5,opt,name=evict_invocation,json=evictInvocation,proto3,oneof"`
+}
+
+type DurableTaskRequest_WorkerStatus struct {
+	WorkerStatus *DurableTaskWorkerStatusRequest `protobuf:"bytes,6,opt,name=worker_status,json=workerStatus,proto3,oneof"`
+}
+
+type DurableTaskRequest_CompleteMemo struct {
+	CompleteMemo *DurableTaskCompleteMemoRequest `protobuf:"bytes,7,opt,name=complete_memo,json=completeMemo,proto3,oneof"`
+}
+
+type DurableTaskRequest_WaitForSignal struct {
+	WaitForSignal *DurableTaskWaitForSignalRequest `protobuf:"bytes,8,opt,name=wait_for_signal,json=waitForSignal,proto3,oneof"`
+}
+
+type DurableTaskRequest_QueryResult struct {
+	QueryResult *DurableTaskQueryResultRequest `protobuf:"bytes,9,opt,name=query_result,json=queryResult,proto3,oneof"`
+}
+
+type DurableTaskRequest_Patch struct {
+	Patch *DurableTaskPatchRequest `protobuf:"bytes,10,opt,name=patch,proto3,oneof"`
+}
+
+func (*DurableTaskRequest_RegisterWorker) isDurableTaskRequest_Message() {}
+
+func (*DurableTaskRequest_Memo) isDurableTaskRequest_Message() {}
+
+func (*DurableTaskRequest_TriggerRuns) isDurableTaskRequest_Message() {}
 
 func (*DurableTaskRequest_WaitFor) isDurableTaskRequest_Message() {}
 
@@ -1285,6 +1597,12 @@ func (*DurableTaskRequest_WorkerStatus) isDurableTaskRequest_Message() {}
 
 func (*DurableTaskRequest_CompleteMemo) isDurableTaskRequest_Message() {}
 
+func (*DurableTaskRequest_WaitForSignal) isDurableTaskRequest_Message() {}
+
+func (*DurableTaskRequest_QueryResult) isDurableTaskRequest_Message() {}
+
+func (*DurableTaskRequest_Patch) isDurableTaskRequest_Message() {}
+
 type DurableTaskErrorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1298,7 +1616,7 @@ type DurableTaskErrorResponse struct {
 func (x *DurableTaskErrorResponse) Reset() {
 	*x = DurableTaskErrorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[18]
+		mi := &file_v1_dispatcher_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1311,7 +1629,7 @@ func (x *DurableTaskErrorResponse) String() string {
 func (*DurableTaskErrorResponse) ProtoMessage() {}
 
 func (x *DurableTaskErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[18]
+	mi := &file_v1_dispatcher_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1642,7 @@ func (x *DurableTaskErrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskErrorResponse.ProtoReflect.Descriptor instead.
 func (*DurableTaskErrorResponse) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{18}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DurableTaskErrorResponse) GetRef() *DurableEventLogEntryRef {
@@ -1348,13 +1666,84 @@ func (x *DurableTaskErrorResponse) GetErrorMessage() string {
 	return ""
 }
 
+type DurableTaskQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryId               string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	DurableTaskExternalId string `protobuf:"bytes,2,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	QueryName             string `protobuf:"bytes,3,opt,name=query_name,json=queryName,proto3" json:"query_name,omitempty"`
+	// the JSON input of the query
+	Input []byte `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *DurableTaskQueryRequest) Reset() {
+	*x = DurableTaskQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurableTaskQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurableTaskQueryRequest) ProtoMessage() {}
+
+func (x *DurableTaskQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurableTaskQueryRequest.ProtoReflect.Descriptor instead.
+func (*DurableTaskQueryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DurableTaskQueryRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+func (x *DurableTaskQueryRequest) GetDurableTaskExternalId() string {
+	if x != nil {
+		return x.DurableTaskExternalId
+	}
+	return ""
+}
+
+func (x *DurableTaskQueryRequest) GetQueryName() string {
+	if x != nil {
+		return x.QueryName
+	}
+	return ""
+}
+
+func (x *DurableTaskQueryRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
 type DurableTaskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Message:
-	//
 	//	*DurableTaskResponse_RegisterWorker
 	//	*DurableTaskResponse_MemoAck
 	//	*DurableTaskResponse_TriggerRunsAck
@@ -1363,13 +1752,15 @@ type DurableTaskResponse struct {
 	//	*DurableTaskResponse_Error
 	//	*DurableTaskResponse_EvictionAck
 	//	*DurableTaskResponse_ServerEvict
+	//	*DurableTaskResponse_Query
+	//	*DurableTaskResponse_PatchAck
 	Message isDurableTaskResponse_Message `protobuf_oneof:"message"`
 }
 
 func (x *DurableTaskResponse) Reset() {
 	*x = DurableTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[19]
+		mi := &file_v1_dispatcher_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1382,7 +1773,7 @@ func (x *DurableTaskResponse) String() string {
 func (*DurableTaskResponse) ProtoMessage() {}
 
 func (x *DurableTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[19]
+	mi := &file_v1_dispatcher_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +1786,7 @@ func (x *DurableTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DurableTaskResponse.ProtoReflect.Descriptor instead.
 func (*DurableTaskResponse) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{19}
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{24}
 }
 
 func (m *DurableTaskResponse) GetMessage() isDurableTaskResponse_Message {
@@ -1461,6 +1852,20 @@ func (x *DurableTaskResponse) GetServerEvict() *DurableTaskServerEvictNotice {
 	return nil
 }
 
+func (x *DurableTaskResponse) GetQuery() *DurableTaskQueryRequest {
+	if x, ok := x.GetMessage().(*DurableTaskResponse_Query); ok {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *DurableTaskResponse) GetPatchAck() *DurableTaskEventPatchAckResponse {
+	if x, ok := x.GetMessage().(*DurableTaskResponse_PatchAck); ok {
+		return x.PatchAck
+	}
+	return nil
+}
+
 type isDurableTaskResponse_Message interface {
 	isDurableTaskResponse_Message()
 }
@@ -1497,6 +1902,14 @@ type DurableTaskResponse_ServerEvict struct {
 	ServerEvict *DurableTaskServerEvictNotice `protobuf:"bytes,8,opt,name=server_evict,json=serverEvict,proto3,oneof"`
 }
 
+type DurableTaskResponse_Query struct {
+	Query *DurableTaskQueryRequest `protobuf:"bytes,9,opt,name=query,proto3,oneof"`
+}
+
+type DurableTaskResponse_PatchAck struct {
+	PatchAck *DurableTaskEventPatchAckResponse `protobuf:"bytes,10,opt,name=patch_ack,json=patchAck,proto3,oneof"`
+}
+
 func (*DurableTaskResponse_RegisterWorker) isDurableTaskResponse_Message() {}
 
 func (*DurableTaskResponse_MemoAck) isDurableTaskResponse_Message() {}
@@ -1513,33 +1926,257 @@ func (*DurableTaskResponse_EvictionAck) isDurableTaskResponse_Message() {}
 
 func (*DurableTaskResponse_ServerEvict) isDurableTaskResponse_Message() {}
 
-type RegisterDurableEventRequest struct {
+func (*DurableTaskResponse_Query) isDurableTaskResponse_Message() {}
+
+func (*DurableTaskResponse_PatchAck) isDurableTaskResponse_Message() {}
+
+type RegisterDurableEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId     string                          `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // external uuid for the task run
+	SignalKey  string                          `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"` // the signal key for the event
+	Conditions *DurableEventListenerConditions `protobuf:"bytes,3,opt,name=conditions,proto3" json:"conditions,omitempty"`                // the task conditions for creating the task
+}
+
+func (x *RegisterDurableEventRequest) Reset() {
+	*x = RegisterDurableEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterDurableEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDurableEventRequest) ProtoMessage() {}
+
+func (x *RegisterDurableEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDurableEventRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDurableEventRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RegisterDurableEventRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RegisterDurableEventRequest) GetSignalKey() string {
+	if x != nil {
+		return x.SignalKey
+	}
+	return ""
+}
+
+func (x *RegisterDurableEventRequest) GetConditions() *DurableEventListenerConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type RegisterDurableEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterDurableEventResponse) Reset() {
+	*x = RegisterDurableEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterDurableEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDurableEventResponse) ProtoMessage() {}
+
+func (x *RegisterDurableEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDurableEventResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDurableEventResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{26}
+}
+
+type ListenForDurableEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // single listener per worker
+	SignalKey string `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"` // the match id for the listener
+}
+
+func (x *ListenForDurableEventRequest) Reset() {
+	*x = ListenForDurableEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenForDurableEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenForDurableEventRequest) ProtoMessage() {}
+
+func (x *ListenForDurableEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenForDurableEventRequest.ProtoReflect.Descriptor instead.
+func (*ListenForDurableEventRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListenForDurableEventRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListenForDurableEventRequest) GetSignalKey() string {
+	if x != nil {
+		return x.SignalKey
+	}
+	return ""
+}
+
+type DurableEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	SignalKey string `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"`
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // the data for the event
+}
+
+func (x *DurableEvent) Reset() {
+	*x = DurableEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_dispatcher_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurableEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurableEvent) ProtoMessage() {}
+
+func (x *DurableEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurableEvent.ProtoReflect.Descriptor instead.
+func (*DurableEvent) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DurableEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DurableEvent) GetSignalKey() string {
+	if x != nil {
+		return x.SignalKey
+	}
+	return ""
+}
+
+func (x *DurableEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SendDurableTaskSignalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId     string                          `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // external uuid for the task run
-	SignalKey  string                          `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"` // the signal key for the event
-	Conditions *DurableEventListenerConditions `protobuf:"bytes,3,opt,name=conditions,proto3" json:"conditions,omitempty"`                // the task conditions for creating the task
+	DurableTaskExternalId string `protobuf:"bytes,1,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	SignalName            string `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	// the JSON payload of the signal
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *RegisterDurableEventRequest) Reset() {
-	*x = RegisterDurableEventRequest{}
+func (x *SendDurableTaskSignalRequest) Reset() {
+	*x = SendDurableTaskSignalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[20]
+		mi := &file_v1_dispatcher_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterDurableEventRequest) String() string {
+func (x *SendDurableTaskSignalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDurableEventRequest) ProtoMessage() {}
+func (*SendDurableTaskSignalRequest) ProtoMessage() {}
 
-func (x *RegisterDurableEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[20]
+func (x *SendDurableTaskSignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,55 +2187,58 @@ func (x *RegisterDurableEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDurableEventRequest.ProtoReflect.Descriptor instead.
-func (*RegisterDurableEventRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SendDurableTaskSignalRequest.ProtoReflect.Descriptor instead.
+func (*SendDurableTaskSignalRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RegisterDurableEventRequest) GetTaskId() string {
+func (x *SendDurableTaskSignalRequest) GetDurableTaskExternalId() string {
 	if x != nil {
-		return x.TaskId
+		return x.DurableTaskExternalId
 	}
 	return ""
 }
 
-func (x *RegisterDurableEventRequest) GetSignalKey() string {
+func (x *SendDurableTaskSignalRequest) GetSignalName() string {
 	if x != nil {
-		return x.SignalKey
+		return x.SignalName
 	}
 	return ""
 }
 
-func (x *RegisterDurableEventRequest) GetConditions() *DurableEventListenerConditions {
+func (x *SendDurableTaskSignalRequest) GetPayload() []byte {
 	if x != nil {
-		return x.Conditions
+		return x.Payload
 	}
 	return nil
 }
 
-type RegisterDurableEventResponse struct {
+type SendDurableTaskSignalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// whether the task was waiting for the signal, otherwise the signal is buffered until the task waits for it
+	Delivered bool `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
 }
 
-func (x *RegisterDurableEventResponse) Reset() {
-	*x = RegisterDurableEventResponse{}
+func (x *SendDurableTaskSignalResponse) Reset() {
+	*x = SendDurableTaskSignalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[21]
+		mi := &file_v1_dispatcher_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterDurableEventResponse) String() string {
+func (x *SendDurableTaskSignalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDurableEventResponse) ProtoMessage() {}
+func (*SendDurableTaskSignalResponse) ProtoMessage() {}
 
-func (x *RegisterDurableEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[21]
+func (x *SendDurableTaskSignalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,37 +2249,46 @@ func (x *RegisterDurableEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDurableEventResponse.ProtoReflect.Descriptor instead.
-func (*RegisterDurableEventResponse) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SendDurableTaskSignalResponse.ProtoReflect.Descriptor instead.
+func (*SendDurableTaskSignalResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{30}
 }
 
-type ListenForDurableEventRequest struct {
+func (x *SendDurableTaskSignalResponse) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+type QueryDurableTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // single listener per worker
-	SignalKey string `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"` // the match id for the listener
+	DurableTaskExternalId string `protobuf:"bytes,1,opt,name=durable_task_external_id,json=durableTaskExternalId,proto3" json:"durable_task_external_id,omitempty"`
+	QueryName             string `protobuf:"bytes,2,opt,name=query_name,json=queryName,proto3" json:"query_name,omitempty"`
+	// the JSON input of the query
+	Input []byte `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
 }
 
-func (x *ListenForDurableEventRequest) Reset() {
-	*x = ListenForDurableEventRequest{}
+func (x *QueryDurableTaskRequest) Reset() {
+	*x = QueryDurableTaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[22]
+		mi := &file_v1_dispatcher_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListenForDurableEventRequest) String() string {
+func (x *QueryDurableTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenForDurableEventRequest) ProtoMessage() {}
+func (*QueryDurableTaskRequest) ProtoMessage() {}
 
-func (x *ListenForDurableEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[22]
+func (x *QueryDurableTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,52 +2299,58 @@ func (x *ListenForDurableEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenForDurableEventRequest.ProtoReflect.Descriptor instead.
-func (*ListenForDurableEventRequest) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QueryDurableTaskRequest.ProtoReflect.Descriptor instead.
+func (*QueryDurableTaskRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListenForDurableEventRequest) GetTaskId() string {
+func (x *QueryDurableTaskRequest) GetDurableTaskExternalId() string {
 	if x != nil {
-		return x.TaskId
+		return x.DurableTaskExternalId
 	}
 	return ""
 }
 
-func (x *ListenForDurableEventRequest) GetSignalKey() string {
+func (x *QueryDurableTaskRequest) GetQueryName() string {
 	if x != nil {
-		return x.SignalKey
+		return x.QueryName
 	}
 	return ""
 }
 
-type DurableEvent struct {
+func (x *QueryDurableTaskRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type QueryDurableTaskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	SignalKey string `protobuf:"bytes,2,opt,name=signal_key,json=signalKey,proto3" json:"signal_key,omitempty"`
-	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // the data for the event
+	// the JSON result of the query handler
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *DurableEvent) Reset() {
-	*x = DurableEvent{}
+func (x *QueryDurableTaskResponse) Reset() {
+	*x = QueryDurableTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_dispatcher_proto_msgTypes[23]
+		mi := &file_v1_dispatcher_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DurableEvent) String() string {
+func (x *QueryDurableTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DurableEvent) ProtoMessage() {}
+func (*QueryDurableTaskResponse) ProtoMessage() {}
 
-func (x *DurableEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_dispatcher_proto_msgTypes[23]
+func (x *QueryDurableTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dispatcher_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,28 +2361,14 @@ func (x *DurableEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DurableEvent.ProtoReflect.Descriptor instead.
-func (*DurableEvent) Descriptor() ([]byte, []int) {
-	return file_v1_dispatcher_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *DurableEvent) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *DurableEvent) GetSignalKey() string {
-	if x != nil {
-		return x.SignalKey
-	}
-	return ""
+// Deprecated: Use QueryDurableTaskResponse.ProtoReflect.Descriptor instead.
+func (*QueryDurableTaskResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dispatcher_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DurableEvent) GetData() []byte {
+func (x *QueryDurableTaskResponse) GetPayload() []byte {
 	if x != nil {
-		return x.Data
+		return x.Payload
 	}
 	return nil
 }
@@ -1799,255 +2440,363 @@ var file_v1_dispatcher_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76,
 	0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x03,
-	0x72, 0x65, 0x66, 0x22, 0xcf, 0x01, 0x0a, 0x29, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
-	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x69, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x21, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x72, 0x65, 0x66, 0x22, 0x6b, 0x0a, 0x20, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x22, 0xcf, 0x01, 0x0a, 0x29, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x21, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x20,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x77, 0x61, 0x69, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x1c, 0x44,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x45, 0x76, 0x69, 0x63, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x64,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x41, 0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x6f, 0x4b, 0x65, 0x79, 0x22, 0xb9, 0x01,
+	0x0a, 0x16, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x6d,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x1d, 0x44, 0x75,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69,
 	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
 	0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
 	0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x3d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x73, 0x22, 0x95,
+	0x02, 0x0a, 0x19, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x57, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x48, 0x00, 0x52, 0x11, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xa6, 0x01, 0x0a, 0x1f, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
 	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
 	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xbc,
-	0x01, 0x0a, 0x20, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x77,
-	0x61, 0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e,
-	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a, 0x01,
-	0x0a, 0x1c, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x45, 0x76, 0x69, 0x63, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x37,
-	0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x1e, 0x44,
-	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x41, 0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x44, 0x75,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03,
-	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x6f, 0x4b, 0x65, 0x79,
-	0x22, 0xb9, 0x01, 0x0a, 0x16, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xb8, 0x01, 0x0a, 0x17, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69,
 	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
 	0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
 	0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xc2, 0x01, 0x0a,
-	0x1d, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
-	0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6f, 0x70,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74,
-	0x73, 0x22, 0x95, 0x02, 0x0a, 0x19, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f,
-	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x11, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x77, 0x61, 0x69, 0x74,
-	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x90, 0x04, 0x0a, 0x12, 0x44, 0x75,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48,
-	0x00, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6d,
-	0x65, 0x6d, 0x6f, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72,
-	0x75, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x77,
-	0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x61,
-	0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07,
-	0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x10, 0x65, 0x76, 0x69, 0x63, 0x74,
-	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x76, 0x69, 0x63,
-	0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a,
-	0x18, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf8, 0x04, 0x0a, 0x13, 0x44, 0x75, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00,
-	0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x6f, 0x41,
-	0x63, 0x6b, 0x12, 0x56, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x75,
-	0x6e, 0x73, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76,
+	0x19, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x1d, 0x44,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdc, 0x05, 0x0a, 0x12, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a,
+	0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x6d,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x46,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x77, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x10, 0x65, 0x76, 0x69, 0x63, 0x74, 0x5f, 0x69, 0x6e,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45,
+	0x76, 0x69, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x4d,
+	0x0a, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x46, 0x0a,
+	0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x18, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x12, 0x37, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xa2, 0x01, 0x0a, 0x17, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x22, 0xf2, 0x05, 0x0a, 0x13, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x40,
+	0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x6f, 0x41, 0x63, 0x6b,
+	0x12, 0x56, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x73,
+	0x5f, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x75, 0x6e, 0x73, 0x41, 0x63, 0x6b, 0x12, 0x4a, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x41, 0x63, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x34,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0c, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x45, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x69, 0x63, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45,
+	0x76, 0x69, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x09, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76,
 	0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x41, 0x63, 0x6b, 0x12, 0x4a, 0x0a, 0x0c, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74,
-	0x46, 0x6f, 0x72, 0x41, 0x63, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x34, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0c, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x69,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12,
-	0x45, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x69, 0x63,
-	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x45, 0x76, 0x69, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1e, 0x0a,
-	0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a,
-	0x1c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x6e, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x6b, 0x42, 0x09,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46,
+	0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a,
+	0x0c, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
 	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
 	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x0c, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x2a, 0x6b, 0x0a, 0x14, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x44, 0x55, 0x52,
-	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x55, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x41,
-	0x53, 0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f,
-	0x4e, 0x44, 0x45, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x01, 0x32, 0x84,
-	0x02, 0x0a, 0x0c, 0x56, 0x31, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12,
-	0x44, 0x0a, 0x0b, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x44,
-	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f,
-	0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x92, 0x01, 0x0a, 0x1c, 0x53, 0x65,
+	0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3d,
+	0x0a, 0x1d, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22, 0x87, 0x01,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x6b, 0x0a,
+	0x14, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x44, 0x55, 0x52, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a,
+	0x0a, 0x26, 0x44, 0x55, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x44, 0x45, 0x54,
+	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x01, 0x32, 0xb5, 0x03, 0x0a, 0x0c, 0x56,
+	0x31, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x44,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x5b, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x5e, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2063,7 +2812,7 @@ func file_v1_dispatcher_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_dispatcher_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_dispatcher_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_v1_dispatcher_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_v1_dispatcher_proto_goTypes = []interface{}{
 	(DurableTaskErrorType)(0),                         // 0: v1.DurableTaskErrorType
 	(*DurableTaskRequestRegisterWorker)(nil),          // 1: v1.DurableTaskRequestRegisterWorker
@@ -2073,64 +2822,83 @@ var file_v1_dispatcher_proto_goTypes = []interface{}{
 	(*DurableTaskEventMemoAckResponse)(nil),           // 5: v1.DurableTaskEventMemoAckResponse
 	(*DurableTaskEventTriggerRunsAckResponse)(nil),    // 6: v1.DurableTaskEventTriggerRunsAckResponse
 	(*DurableTaskEventWaitForAckResponse)(nil),        // 7: v1.DurableTaskEventWaitForAckResponse
-	(*DurableTaskEventLogEntryCompletedResponse)(nil), // 8: v1.DurableTaskEventLogEntryCompletedResponse
-	(*DurableTaskEvictInvocationRequest)(nil),         // 9: v1.DurableTaskEvictInvocationRequest
-	(*DurableTaskEvictionAckResponse)(nil),            // 10: v1.DurableTaskEvictionAckResponse
-	(*DurableTaskAwaitedCompletedEntry)(nil),          // 11: v1.DurableTaskAwaitedCompletedEntry
-	(*DurableTaskServerEvictNotice)(nil),              // 12: v1.DurableTaskServerEvictNotice
-	(*DurableTaskWorkerStatusRequest)(nil),            // 13: v1.DurableTaskWorkerStatusRequest
-	(*DurableTaskCompleteMemoRequest)(nil),            // 14: v1.DurableTaskCompleteMemoRequest
-	(*DurableTaskMemoRequest)(nil),                    // 15: v1.DurableTaskMemoRequest
-	(*DurableTaskTriggerRunsRequest)(nil),             // 16: v1.DurableTaskTriggerRunsRequest
-	(*DurableTaskWaitForRequest)(nil),                 // 17: v1.DurableTaskWaitForRequest
-	(*DurableTaskRequest)(nil),                        // 18: v1.DurableTaskRequest
-	(*DurableTaskErrorResponse)(nil),                  // 19: v1.DurableTaskErrorResponse
-	(*DurableTaskResponse)(nil),                       // 20: v1.DurableTaskResponse
-	(*RegisterDurableEventRequest)(nil),               // 21: v1.RegisterDurableEventRequest
-	(*RegisterDurableEventResponse)(nil),              // 22: v1.RegisterDurableEventResponse
-	(*ListenForDurableEventRequest)(nil),              // 23: v1.ListenForDurableEventRequest
-	(*DurableEvent)(nil),                              // 24: v1.DurableEvent
-	(*TriggerWorkflowRequest)(nil),                    // 25: v1.TriggerWorkflowRequest
-	(*DurableEventListenerConditions)(nil),            // 26: v1.DurableEventListenerConditions
+	(*DurableTaskEventPatchAckResponse)(nil),          // 8: v1.DurableTaskEventPatchAckResponse
+	(*DurableTaskEventLogEntryCompletedResponse)(nil), // 9: v1.DurableTaskEventLogEntryCompletedResponse
+	(*DurableTaskEvictInvocationRequest)(nil),         // 10: v1.DurableTaskEvictInvocationRequest
+	(*DurableTaskEvictionAckResponse)(nil),            // 11: v1.DurableTaskEvictionAckResponse
+	(*DurableTaskAwaitedCompletedEntry)(nil),          // 12: v1.DurableTaskAwaitedCompletedEntry
+	(*DurableTaskServerEvictNotice)(nil),              // 13: v1.DurableTaskServerEvictNotice
+	(*DurableTaskWorkerStatusRequest)(nil),            // 14: v1.DurableTaskWorkerStatusRequest
+	(*DurableTaskCompleteMemoRequest)(nil),            // 15: v1.DurableTaskCompleteMemoRequest
+	(*DurableTaskMemoRequest)(nil),                    // 16: v1.DurableTaskMemoRequest
+	(*DurableTaskTriggerRunsRequest)(nil),             // 17: v1.DurableTaskTriggerRunsRequest
+	(*DurableTaskWaitForRequest)(nil),                 // 18: v1.DurableTaskWaitForRequest
+	(*DurableTaskWaitForSignalRequest)(nil),           // 19: v1.DurableTaskWaitForSignalRequest
+	(*DurableTaskPatchRequest)(nil),                   // 20: v1.DurableTaskPatchRequest
+	(*DurableTaskQueryResultRequest)(nil),             // 21: v1.DurableTaskQueryResultRequest
+	(*DurableTaskRequest)(nil),                        // 22: v1.DurableTaskRequest
+	(*DurableTaskErrorResponse)(nil),                  // 23: v1.DurableTaskErrorResponse
+	(*DurableTaskQueryRequest)(nil),                   // 24: v1.DurableTaskQueryRequest
+	(*DurableTaskResponse)(nil),                       // 25: v1.DurableTaskResponse
+	(*RegisterDurableEventRequest)(nil),               // 26: v1.RegisterDurableEventRequest
+	(*RegisterDurableEventResponse)(nil),              // 27: v1.RegisterDurableEventResponse
+	(*ListenForDurableEventRequest)(nil),              // 28: v1.ListenForDurableEventRequest
+	(*DurableEvent)(nil),                              // 29: v1.DurableEvent
+	(*SendDurableTaskSignalRequest)(nil),              // 30: v1.SendDurableTaskSignalRequest
+	(*SendDurableTaskSignalResponse)(nil),             // 31: v1.SendDurableTaskSignalResponse
+	(*QueryDurableTaskRequest)(nil),                   // 32: v1.QueryDurableTaskRequest
+	(*QueryDurableTaskResponse)(nil),                  // 33: v1.QueryDurableTaskResponse
+	(*TriggerWorkflowRequest)(nil),                    // 34: v1.TriggerWorkflowRequest
+	(*DurableEventListenerConditions)(nil),            // 35: v1.DurableEventListenerConditions
 }
 var file_v1_dispatcher_proto_depIdxs = []int32{
 	3,  // 0: v1.DurableTaskEventMemoAckResponse.ref:type_name -> v1.DurableEventLogEntryRef
 	4,  // 1: v1.DurableTaskEventTriggerRunsAckResponse.run_entries:type_name -> v1.DurableTaskRunAckEntry
 	3,  // 2: v1.DurableTaskEventWaitForAckResponse.ref:type_name -> v1.DurableEventLogEntryRef
-	3,  // 3: v1.DurableTaskEventLogEntryCompletedResponse.ref:type_name -> v1.DurableEventLogEntryRef
-	11, // 4: v1.DurableTaskWorkerStatusRequest.waiting_entries:type_name -> v1.DurableTaskAwaitedCompletedEntry
-	3,  // 5: v1.DurableTaskCompleteMemoRequest.ref:type_name -> v1.DurableEventLogEntryRef
-	25, // 6: v1.DurableTaskTriggerRunsRequest.trigger_opts:type_name -> v1.TriggerWorkflowRequest
-	26, // 7: v1.DurableTaskWaitForRequest.wait_for_conditions:type_name -> v1.DurableEventListenerConditions
-	1,  // 8: v1.DurableTaskRequest.register_worker:type_name -> v1.DurableTaskRequestRegisterWorker
-	15, // 9: v1.DurableTaskRequest.memo:type_name -> v1.DurableTaskMemoRequest
-	16, // 10: v1.DurableTaskRequest.trigger_runs:type_name -> v1.DurableTaskTriggerRunsRequest
-	17, // 11: v1.DurableTaskRequest.wait_for:type_name -> v1.DurableTaskWaitForRequest
-	9,  // 12: v1.DurableTaskRequest.evict_invocation:type_name -> v1.DurableTaskEvictInvocationRequest
-	13, // 13: v1.DurableTaskRequest.worker_status:type_name -> v1.DurableTaskWorkerStatusRequest
-	14, // 14: v1.DurableTaskRequest.complete_memo:type_name -> v1.DurableTaskCompleteMemoRequest
-	3,  // 15: v1.DurableTaskErrorResponse.ref:type_name -> v1.DurableEventLogEntryRef
-	0,  // 16: v1.DurableTaskErrorResponse.error_type:type_name -> v1.DurableTaskErrorType
-	2,  // 17: v1.DurableTaskResponse.register_worker:type_name -> v1.DurableTaskResponseRegisterWorker
-	5,  // 18: v1.DurableTaskResponse.memo_ack:type_name -> v1.DurableTaskEventMemoAckResponse
-	6,  // 19: v1.DurableTaskResponse.trigger_runs_ack:type_name -> v1.DurableTaskEventTriggerRunsAckResponse
-	7,  // 20: v1.DurableTaskResponse.wait_for_ack:type_name -> v1.DurableTaskEventWaitForAckResponse
-	8,  // 21: v1.DurableTaskResponse.entry_completed:type_name -> v1.DurableTaskEventLogEntryCompletedResponse
-	19, // 22: v1.DurableTaskResponse.error:type_name -> v1.DurableTaskErrorResponse
-	10, // 23: v1.DurableTaskResponse.eviction_ack:type_name -> v1.DurableTaskEvictionAckResponse
-	12, // 24: v1.DurableTaskResponse.server_evict:type_name -> v1.DurableTaskServerEvictNotice
-	26, // 25: v1.RegisterDurableEventRequest.conditions:type_name -> v1.DurableEventListenerConditions
-	18, // 26: v1.V1Dispatcher.DurableTask:input_type -> v1.DurableTaskRequest
-	21, // 27: v1.V1Dispatcher.RegisterDurableEvent:input_type -> v1.RegisterDurableEventRequest
-	23, // 28: v1.V1Dispatcher.ListenForDurableEvent:input_type -> v1.ListenForDurableEventRequest
-	20, // 29: v1.V1Dispatcher.DurableTask:output_type -> v1.DurableTaskResponse
-	22, // 30: v1.V1Dispatcher.RegisterDurableEvent:output_type -> v1.RegisterDurableEventResponse
-	24, // 31: v1.V1Dispatcher.ListenForDurableEvent:output_type -> v1.DurableEvent
-	29, // [29:32] is the sub-list for method output_type
-	26, // [26:29] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	3,  // 3: v1.DurableTaskEventPatchAckResponse.ref:type_name -> v1.DurableEventLogEntryRef
+	3,  // 4: v1.DurableTaskEventLogEntryCompletedResponse.ref:type_name -> v1.DurableEventLogEntryRef
+	12, // 5: v1.DurableTaskWorkerStatusRequest.waiting_entries:type_name -> v1.DurableTaskAwaitedCompletedEntry
+	3,  // 6: v1.DurableTaskCompleteMemoRequest.ref:type_name -> v1.DurableEventLogEntryRef
+	34, // 7: v1.DurableTaskTriggerRunsRequest.trigger_opts:type_name -> v1.TriggerWorkflowRequest
+	35, // 8: v1.DurableTaskWaitForRequest.wait_for_conditions:type_name -> v1.DurableEventListenerConditions
+	1,  // 9: v1.DurableTaskRequest.register_worker:type_name -> v1.DurableTaskRequestRegisterWorker
+	16, // 10: v1.DurableTaskRequest.memo:type_name -> v1.DurableTaskMemoRequest
+	17, // 11: v1.DurableTaskRequest.trigger_runs:type_name -> v1.DurableTaskTriggerRunsRequest
+	18, // 12: v1.DurableTaskRequest.wait_for:type_name -> v1.DurableTaskWaitForRequest
+	10, // 13: v1.DurableTaskRequest.evict_invocation:type_name -> v1.DurableTaskEvictInvocationRequest
+	14, // 14: v1.DurableTaskRequest.worker_status:type_name -> v1.DurableTaskWorkerStatusRequest
+	15, // 15: v1.DurableTaskRequest.complete_memo:type_name -> v1.DurableTaskCompleteMemoRequest
+	19, // 16: v1.DurableTaskRequest.wait_for_signal:type_name -> v1.DurableTaskWaitForSignalRequest
+	21, // 17: v1.DurableTaskRequest.query_result:type_name -> v1.DurableTaskQueryResultRequest
+	20, // 18: v1.DurableTaskRequest.patch:type_name -> v1.DurableTaskPatchRequest
+	3,  // 19: v1.DurableTaskErrorResponse.ref:type_name -> v1.DurableEventLogEntryRef
+	0,  // 20: v1.DurableTaskErrorResponse.error_type:type_name -> v1.DurableTaskErrorType
+	2,  // 21: v1.DurableTaskResponse.register_worker:type_name -> v1.DurableTaskResponseRegisterWorker
+	5,  // 22: v1.DurableTaskResponse.memo_ack:type_name -> v1.DurableTaskEventMemoAckResponse
+	6,  // 23: v1.DurableTaskResponse.trigger_runs_ack:type_name -> v1.DurableTaskEventTriggerRunsAckResponse
+	7,  // 24: v1.DurableTaskResponse.wait_for_ack:type_name -> v1.DurableTaskEventWaitForAckResponse
+	9,  // 25: v1.DurableTaskResponse.entry_completed:type_name -> v1.DurableTaskEventLogEntryCompletedResponse
+	23, // 26: v1.DurableTaskResponse.error:type_name -> v1.DurableTaskErrorResponse
+	11, // 27: v1.DurableTaskResponse.eviction_ack:type_name -> v1.DurableTaskEvictionAckResponse
+	13, // 28: v1.DurableTaskResponse.server_evict:type_name -> v1.DurableTaskServerEvictNotice
+	24, // 29: v1.DurableTaskResponse.query:type_name -> v1.DurableTaskQueryRequest
+	8,  // 30: v1.DurableTaskResponse.patch_ack:type_name -> v1.DurableTaskEventPatchAckResponse
+	35, // 31: v1.RegisterDurableEventRequest.conditions:type_name -> v1.DurableEventListenerConditions
+	22, // 32: v1.V1Dispatcher.DurableTask:input_type -> v1.DurableTaskRequest
+	26, // 33: v1.V1Dispatcher.RegisterDurableEvent:input_type -> v1.RegisterDurableEventRequest
+	28, // 34: v1.V1Dispatcher.ListenForDurableEvent:input_type -> v1.ListenForDurableEventRequest
+	30, // 35: v1.V1Dispatcher.SendDurableTaskSignal:input_type -> v1.SendDurableTaskSignalRequest
+	32, // 36: v1.V1Dispatcher.QueryDurableTask:input_type -> v1.QueryDurableTaskRequest
+	25, // 37: v1.V1Dispatcher.DurableTask:output_type -> v1.DurableTaskResponse
+	27, // 38: v1.V1Dispatcher.RegisterDurableEvent:output_type -> v1.RegisterDurableEventResponse
+	29, // 39: v1.V1Dispatcher.ListenForDurableEvent:output_type -> v1.DurableEvent
+	31, // 40: v1.V1Dispatcher.SendDurableTaskSignal:output_type -> v1.SendDurableTaskSignalResponse
+	33, // 41: v1.V1Dispatcher.QueryDurableTask:output_type -> v1.QueryDurableTaskResponse
+	37, // [37:42] is the sub-list for method output_type
+	32, // [32:37] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_v1_dispatcher_proto_init() }
@@ -2226,7 +2994,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskEventLogEntryCompletedResponse); i {
+			switch v := v.(*DurableTaskEventPatchAckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2238,7 +3006,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskEvictInvocationRequest); i {
+			switch v := v.(*DurableTaskEventLogEntryCompletedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2250,7 +3018,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskEvictionAckResponse); i {
+			switch v := v.(*DurableTaskEvictInvocationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2262,7 +3030,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskAwaitedCompletedEntry); i {
+			switch v := v.(*DurableTaskEvictionAckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2274,7 +3042,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskServerEvictNotice); i {
+			switch v := v.(*DurableTaskAwaitedCompletedEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2286,7 +3054,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskWorkerStatusRequest); i {
+			switch v := v.(*DurableTaskServerEvictNotice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2298,7 +3066,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskCompleteMemoRequest); i {
+			switch v := v.(*DurableTaskWorkerStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2310,7 +3078,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskMemoRequest); i {
+			switch v := v.(*DurableTaskCompleteMemoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2322,7 +3090,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskTriggerRunsRequest); i {
+			switch v := v.(*DurableTaskMemoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2334,7 +3102,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskWaitForRequest); i {
+			switch v := v.(*DurableTaskTriggerRunsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2346,7 +3114,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskRequest); i {
+			switch v := v.(*DurableTaskWaitForRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2358,7 +3126,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskErrorResponse); i {
+			switch v := v.(*DurableTaskWaitForSignalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2370,7 +3138,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurableTaskResponse); i {
+			switch v := v.(*DurableTaskPatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2382,7 +3150,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterDurableEventRequest); i {
+			switch v := v.(*DurableTaskQueryResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2394,7 +3162,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterDurableEventResponse); i {
+			switch v := v.(*DurableTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2406,7 +3174,7 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListenForDurableEventRequest); i {
+			switch v := v.(*DurableTaskErrorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2418,6 +3186,66 @@ func file_v1_dispatcher_proto_init() {
 			}
 		}
 		file_v1_dispatcher_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurableTaskQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurableTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterDurableEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterDurableEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenForDurableEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DurableEvent); i {
 			case 0:
 				return &v.state
@@ -2429,13 +3257,62 @@ func file_v1_dispatcher_proto_init() {
 				return nil
 			}
 		}
+		file_v1_dispatcher_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendDurableTaskSignalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendDurableTaskSignalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDurableTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_dispatcher_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDurableTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_v1_dispatcher_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_v1_dispatcher_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	file_v1_dispatcher_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_v1_dispatcher_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_v1_dispatcher_proto_msgTypes[16].OneofWrappers = []interface{}{}
-	file_v1_dispatcher_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_v1_dispatcher_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_v1_dispatcher_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_v1_dispatcher_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_v1_dispatcher_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_v1_dispatcher_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*DurableTaskRequest_RegisterWorker)(nil),
 		(*DurableTaskRequest_Memo)(nil),
 		(*DurableTaskRequest_TriggerRuns)(nil),
@@ -2443,8 +3320,11 @@ func file_v1_dispatcher_proto_init() {
 		(*DurableTaskRequest_EvictInvocation)(nil),
 		(*DurableTaskRequest_WorkerStatus)(nil),
 		(*DurableTaskRequest_CompleteMemo)(nil),
+		(*DurableTaskRequest_WaitForSignal)(nil),
+		(*DurableTaskRequest_QueryResult)(nil),
+		(*DurableTaskRequest_Patch)(nil),
 	}
-	file_v1_dispatcher_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_v1_dispatcher_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*DurableTaskResponse_RegisterWorker)(nil),
 		(*DurableTaskResponse_MemoAck)(nil),
 		(*DurableTaskResponse_TriggerRunsAck)(nil),
@@ -2453,6 +3333,8 @@ func file_v1_dispatcher_proto_init() {
 		(*DurableTaskResponse_Error)(nil),
 		(*DurableTaskResponse_EvictionAck)(nil),
 		(*DurableTaskResponse_ServerEvict)(nil),
+		(*DurableTaskResponse_Query)(nil),
+		(*DurableTaskResponse_PatchAck)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2460,7 +3342,7 @@ func file_v1_dispatcher_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_dispatcher_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
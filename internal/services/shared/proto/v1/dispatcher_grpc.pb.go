@@ -26,6 +26,10 @@ type V1DispatcherClient interface {
 	// NOTE: deprecated after DurableEventLog is implemented
 	RegisterDurableEvent(ctx context.Context, in *RegisterDurableEventRequest, opts ...grpc.CallOption) (*RegisterDurableEventResponse, error)
 	ListenForDurableEvent(ctx context.Context, opts ...grpc.CallOption) (V1Dispatcher_ListenForDurableEventClient, error)
+	// Sends a named signal to a running durable task, which is recorded in its durable event log
+	SendDurableTaskSignal(ctx context.Context, in *SendDurableTaskSignalRequest, opts ...grpc.CallOption) (*SendDurableTaskSignalResponse, error)
+	// Runs a query handler registered by a running durable task, without recording it in the durable event log
+	QueryDurableTask(ctx context.Context, in *QueryDurableTaskRequest, opts ...grpc.CallOption) (*QueryDurableTaskResponse, error)
 }
 
 type v1DispatcherClient struct {
@@ -107,6 +111,24 @@ func (x *v1DispatcherListenForDurableEventClient) Recv() (*DurableEvent, error)
 	return m, nil
 }
 
+func (c *v1DispatcherClient) SendDurableTaskSignal(ctx context.Context, in *SendDurableTaskSignalRequest, opts ...grpc.CallOption) (*SendDurableTaskSignalResponse, error) {
+	out := new(SendDurableTaskSignalResponse)
+	err := c.cc.Invoke(ctx, "/v1.V1Dispatcher/SendDurableTaskSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *v1DispatcherClient) QueryDurableTask(ctx context.Context, in *QueryDurableTaskRequest, opts ...grpc.CallOption) (*QueryDurableTaskResponse, error) {
+	out := new(QueryDurableTaskResponse)
+	err := c.cc.Invoke(ctx, "/v1.V1Dispatcher/QueryDurableTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // V1DispatcherServer is the server API for V1Dispatcher service.
 // All implementations must embed UnimplementedV1DispatcherServer
 // for forward compatibility
@@ -115,6 +137,10 @@ type V1DispatcherServer interface {
 	// NOTE: deprecated after DurableEventLog is implemented
 	RegisterDurableEvent(context.Context, *RegisterDurableEventRequest) (*RegisterDurableEventResponse, error)
 	ListenForDurableEvent(V1Dispatcher_ListenForDurableEventServer) error
+	// Sends a named signal to a running durable task, which is recorded in its durable event log
+	SendDurableTaskSignal(context.Context, *SendDurableTaskSignalRequest) (*SendDurableTaskSignalResponse, error)
+	// Runs a query handler registered by a running durable task, without recording it in the durable event log
+	QueryDurableTask(context.Context, *QueryDurableTaskRequest) (*QueryDurableTaskResponse, error)
 	mustEmbedUnimplementedV1DispatcherServer()
 }
 
@@ -131,6 +157,12 @@ func (UnimplementedV1DispatcherServer) RegisterDurableEvent(context.Context, *Re
 func (UnimplementedV1DispatcherServer) ListenForDurableEvent(V1Dispatcher_ListenForDurableEventServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListenForDurableEvent not implemented")
 }
+func (UnimplementedV1DispatcherServer) SendDurableTaskSignal(context.Context, *SendDurableTaskSignalRequest) (*SendDurableTaskSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDurableTaskSignal not implemented")
+}
+func (UnimplementedV1DispatcherServer) QueryDurableTask(context.Context, *QueryDurableTaskRequest) (*QueryDurableTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDurableTask not implemented")
+}
 func (UnimplementedV1DispatcherServer) mustEmbedUnimplementedV1DispatcherServer() {}
 
 // UnsafeV1DispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -214,6 +246,42 @@ func (x *v1DispatcherListenForDurableEventServer) Recv() (*ListenForDurableEvent
 	return m, nil
 }
 
+func _V1Dispatcher_SendDurableTaskSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDurableTaskSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1DispatcherServer).SendDurableTaskSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.V1Dispatcher/SendDurableTaskSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1DispatcherServer).SendDurableTaskSignal(ctx, req.(*SendDurableTaskSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _V1Dispatcher_QueryDurableTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDurableTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(V1DispatcherServer).QueryDurableTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.V1Dispatcher/QueryDurableTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(V1DispatcherServer).QueryDurableTask(ctx, req.(*QueryDurableTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // V1Dispatcher_ServiceDesc is the grpc.ServiceDesc for V1Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +293,14 @@ var V1Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterDurableEvent",
 			Handler:    _V1Dispatcher_RegisterDurableEvent_Handler,
 		},
+		{
+			MethodName: "SendDurableTaskSignal",
+			Handler:    _V1Dispatcher_SendDurableTaskSignal_Handler,
+		},
+		{
+			MethodName: "QueryDurableTask",
+			Handler:    _V1Dispatcher_QueryDurableTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
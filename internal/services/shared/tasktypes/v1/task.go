@@ -88,6 +88,9 @@ type FailedTaskPayload struct {
 
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool `json:"is_non_retryable"`
+
+	// (optional) the type of the error reported by the worker, used to match the retry rules of the step
+	ErrorType string `json:"error_type,omitempty"`
 }
 
 func FailedTaskMessage(
@@ -100,6 +103,7 @@ func FailedTaskMessage(
 	isAppError bool,
 	errorMsg string,
 	isNonRetryable bool,
+	errorType string,
 ) (*msgqueue.Message, error) {
 	return msgqueue.NewTenantMessage(
 		tenantId,
@@ -115,6 +119,7 @@ func FailedTaskMessage(
 			IsAppError:     isAppError,
 			ErrorMsg:       errorMsg,
 			IsNonRetryable: isNonRetryable,
+			ErrorType:      errorType,
 		},
 	)
 }
@@ -202,6 +207,62 @@ type ReplayTasksPayload struct {
 	Tasks []TaskIdInsertedAtRetryCountWithExternalId `json:"tasks"`
 }
 
+// BatchReplayTasks deduplicates the tasks to replay, and groups them into batches of at most batchSize tasks. The
+// tasks of a workflow run are never split across batches, so a workflow run with more than batchSize tasks is a
+// batch of its own.
+func BatchReplayTasks(tasks []TaskIdInsertedAtRetryCountWithExternalId, batchSize int) [][]TaskIdInsertedAtRetryCountWithExternalId {
+	existingReplays := make(map[TaskIdInsertedAtRetryCountWithExternalId]bool)
+	workflowRunIds := make([]uuid.UUID, 0)
+	workflowRunIdToTasksToReplay := make(map[uuid.UUID][]TaskIdInsertedAtRetryCountWithExternalId)
+
+	for _, task := range tasks {
+		if _, exists := existingReplays[task]; exists {
+			continue
+		}
+
+		existingReplays[task] = true
+
+		if _, ok := workflowRunIdToTasksToReplay[task.WorkflowRunExternalId]; !ok {
+			workflowRunIds = append(workflowRunIds, task.WorkflowRunExternalId)
+		}
+
+		workflowRunIdToTasksToReplay[task.WorkflowRunExternalId] = append(
+			workflowRunIdToTasksToReplay[task.WorkflowRunExternalId],
+			task,
+		)
+	}
+
+	var batches [][]TaskIdInsertedAtRetryCountWithExternalId
+	var currentBatch []TaskIdInsertedAtRetryCountWithExternalId
+
+	for _, workflowRunId := range workflowRunIds {
+		tasksForWorkflowRun := workflowRunIdToTasksToReplay[workflowRunId]
+
+		if len(currentBatch) > 0 && len(currentBatch)+len(tasksForWorkflowRun) > batchSize {
+			// If the current batch would exceed the batch size if we added the current workflow run's tasks,
+			// we "finalize" the batch and start a new one
+			batches = append(batches, currentBatch)
+			currentBatch = nil
+		}
+
+		if len(tasksForWorkflowRun) > batchSize {
+			// If the current workflow run's task count exceeds the batch size on its own,
+			// we let it be its own batch
+			batches = append(batches, tasksForWorkflowRun)
+		} else {
+			// Otherwise, add it to the current batch
+			currentBatch = append(currentBatch, tasksForWorkflowRun...)
+		}
+	}
+
+	if len(currentBatch) > 0 {
+		// Last case to handle - add the last batch if it has any tasks
+		batches = append(batches, currentBatch)
+	}
+
+	return batches
+}
+
 type NotifyFinalizedPayload struct {
 	// (required) the external id (can either be a workflow run id or single task)
 	ExternalId uuid.UUID `validate:"required"`
@@ -262,3 +323,56 @@ func DurableCallbackCompletedMessage(
 		},
 	)
 }
+
+type DurableTaskQueryPayload struct {
+	Input          []byte
+	QueryName      string
+	QueryId        uuid.UUID
+	TaskExternalId uuid.UUID
+
+	// WorkerId is the worker the task is assigned to, which answers the query if the task hasn't made a durable
+	// request on its current stream yet
+	WorkerId *uuid.UUID
+
+	// ReplyDispatcherId is the dispatcher which is waiting for the result of the query
+	ReplyDispatcherId uuid.UUID
+}
+
+func DurableTaskQueryMessage(
+	tenantId, taskExternalId, queryId uuid.UUID, workerId *uuid.UUID, queryName string, input []byte, replyDispatcherId uuid.UUID,
+) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDDurableTaskQuery,
+		false,
+		true,
+		DurableTaskQueryPayload{
+			QueryId:           queryId,
+			TaskExternalId:    taskExternalId,
+			WorkerId:          workerId,
+			QueryName:         queryName,
+			Input:             input,
+			ReplyDispatcherId: replyDispatcherId,
+		},
+	)
+}
+
+type DurableTaskQueryResultPayload struct {
+	Payload      []byte
+	ErrorMessage *string
+	QueryId      uuid.UUID
+}
+
+func DurableTaskQueryResultMessage(tenantId, queryId uuid.UUID, payload []byte, errorMessage *string) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDDurableTaskQueryResult,
+		false,
+		true,
+		DurableTaskQueryResultPayload{
+			QueryId:      queryId,
+			Payload:      payload,
+			ErrorMessage: errorMessage,
+		},
+	)
+}
@@ -0,0 +1,48 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func replayTasksForRun(workflowRunId uuid.UUID, n int) []TaskIdInsertedAtRetryCountWithExternalId {
+	res := make([]TaskIdInsertedAtRetryCountWithExternalId, 0, n)
+
+	for i := 0; i < n; i++ {
+		res = append(res, TaskIdInsertedAtRetryCountWithExternalId{
+			TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{Id: int64(i)},
+			WorkflowRunExternalId:      workflowRunId,
+			TaskExternalId:             uuid.New(),
+		})
+	}
+
+	return res
+}
+
+func TestBatchReplayTasks_Deduplicates(t *testing.T) {
+	tasks := replayTasksForRun(uuid.New(), 2)
+	tasks = append(tasks, tasks[0])
+
+	batches := BatchReplayTasks(tasks, 100)
+
+	assert.Len(t, batches, 1)
+	assert.Len(t, batches[0], 2)
+}
+
+func TestBatchReplayTasks_KeepsWorkflowRunsTogether(t *testing.T) {
+	first := replayTasksForRun(uuid.New(), 3)
+	second := replayTasksForRun(uuid.New(), 3)
+	large := replayTasksForRun(uuid.New(), 6)
+
+	tasks := append(append(append([]TaskIdInsertedAtRetryCountWithExternalId{}, first...), second...), large...)
+
+	batches := BatchReplayTasks(tasks, 5)
+
+	assert.Equal(t, [][]TaskIdInsertedAtRetryCountWithExternalId{first, second, large}, batches)
+}
@@ -89,6 +89,8 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *sqlcv1.PollCr
 		}
 	}
 
+	crontab := getCrontab(cron.Cron, cron.Timezone)
+
 	cronUUID := uuid.New()
 
 	var job gocron.Job
@@ -97,7 +99,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *sqlcv1.PollCr
 	// schedule the cron
 	job, err := t.userCronScheduler.NewJob(
 		// the gocron library accepts either 5 or 6 term crontabs when withSeconds is true
-		gocron.CronJob(cron.Cron, true),
+		gocron.CronJob(crontab, true),
 		gocron.NewTask(func() {
 			scheduledAt := scheduledAtPtr.Load()
 			if scheduledAt == nil {
@@ -108,7 +110,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *sqlcv1.PollCr
 			}
 
 			t.runCronWorkflow(
-				tenantId, workflowVersionId, cron.Cron,
+				tenantId, workflowVersionId, cron.ID, cron.OverlapPolicy, cron.Cron,
 				cronParentId, &cron.Name.String, cron.Input,
 				additionalMetadata, &cron.Priority,
 				*scheduledAt,
@@ -143,10 +145,31 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *sqlcv1.PollCr
 	// NOTE: we already have a lock on the userCronSchedulerLock when we call this function, so we don't need to lock here
 	t.userCronSchedulesToIds[getCronKey(cron)] = cronUUID.String()
 
+	missedRuns, err := getMissedCronRuns(crontab, cron.MisfirePolicy, cron.MisfireLimit, cron.LastFiredAt, time.Now().UTC())
+
+	if err != nil {
+		return fmt.Errorf("could not get missed cron runs: %w", err)
+	}
+
+	if len(missedRuns) > 0 {
+		t.l.Info().Ctx(ctx).Msgf("ticker: firing %d missed runs for cron %s", len(missedRuns), cron.ID)
+
+		go func() {
+			for _, scheduledAt := range missedRuns {
+				t.runCronWorkflow(
+					tenantId, workflowVersionId, cron.ID, cron.OverlapPolicy, cron.Cron,
+					cronParentId, &cron.Name.String, cron.Input,
+					additionalMetadata, &cron.Priority,
+					scheduledAt,
+				)()
+			}
+		}()
+	}
+
 	return nil
 }
 
-func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId uuid.UUID, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, priority *int32, scheduledAt time.Time) func() {
+func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cronId uuid.UUID, overlapPolicy sqlcv1.WorkflowTriggerCronRefOverlapPolicy, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, priority *int32, scheduledAt time.Time) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -160,10 +183,15 @@ func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId uuid.UUID, cron
 			return
 		}
 
-		err = t.runCronWorkflowV1(ctx, tenantId, workflowVersion, cron, cronParentId, cronName, input, additionalMetadata, priority, scheduledAt)
+		err = t.runCronWorkflowV1(ctx, tenantId, workflowVersion, cronId, overlapPolicy, cron, cronParentId, cronName, input, additionalMetadata, priority, scheduledAt)
 
 		if err != nil {
 			t.l.Error().Ctx(ctx).Err(err).Msg("could not run cron workflow")
+			return
+		}
+
+		if err := t.repov1.Ticker().UpdateCronLastFiredAt(ctx, cronId, scheduledAt); err != nil {
+			t.l.Error().Ctx(ctx).Err(err).Msg("could not update cron last fired at")
 		}
 	}
 }
@@ -0,0 +1,90 @@
+package ticker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/robfig/cron/v3"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// defaultCronMisfireLimit is the number of missed runs which are fired with the FIRE_ALL misfire policy when the
+// cron doesn't set a limit.
+const defaultCronMisfireLimit = 100
+
+// cronParser matches the parser which gocron uses for crontabs with optional seconds.
+var cronParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// getCrontab returns the crontab for a cron expression, evaluated in the timezone of the cron if it has one.
+func getCrontab(expression string, timezone pgtype.Text) string {
+	if !timezone.Valid || timezone.String == "" {
+		return expression
+	}
+
+	return fmt.Sprintf("CRON_TZ=%s %s", timezone.String, expression)
+}
+
+// getMissedCronRuns returns the times at which a cron should have fired after it last fired and before now, and
+// which should be fired to catch up according to its misfire policy. The times are in chronological order.
+func getMissedCronRuns(crontab string, policy sqlcv1.WorkflowTriggerCronRefMisfirePolicy, misfireLimit pgtype.Int4, lastFiredAt pgtype.Timestamp, now time.Time) ([]time.Time, error) {
+	// crons which have never fired have nothing to catch up on
+	if !lastFiredAt.Valid {
+		return nil, nil
+	}
+
+	var limit int
+
+	switch policy {
+	case sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREONCE:
+		limit = 1
+	case sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL:
+		limit = defaultCronMisfireLimit
+
+		if misfireLimit.Valid && misfireLimit.Int32 > 0 {
+			limit = int(misfireLimit.Int32)
+		}
+	default:
+		return nil, nil
+	}
+
+	schedule, err := cronParser.Parse(crontab)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse cron: %w", err)
+	}
+
+	return lastRunsBetween(schedule, lastFiredAt.Time, now, limit), nil
+}
+
+// lastRunsBetween returns up to limit of the most recent runs of a schedule after from and before to. Rather than
+// walking the schedule from the start, which can be arbitrarily far in the past, it searches backwards from the end
+// in doubling windows until it finds enough runs.
+func lastRunsBetween(schedule cron.Schedule, from, to time.Time, limit int) []time.Time {
+	if limit <= 0 || !from.Before(to) {
+		return nil
+	}
+
+	for window := time.Minute; ; window *= 2 {
+		start := from
+
+		if window < to.Sub(from) {
+			start = to.Add(-window)
+		}
+
+		runs := make([]time.Time, 0)
+
+		for next := schedule.Next(start); !next.IsZero() && next.Before(to); next = schedule.Next(next) {
+			runs = append(runs, next)
+		}
+
+		if len(runs) >= limit {
+			return runs[len(runs)-limit:]
+		}
+
+		if start.Equal(from) {
+			return runs
+		}
+	}
+}
@@ -0,0 +1,115 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestGetCrontab(t *testing.T) {
+	assert.Equal(t, "0 9 * * *", getCrontab("0 9 * * *", pgtype.Text{}))
+	assert.Equal(t, "CRON_TZ=Europe/Berlin 0 9 * * *", getCrontab("0 9 * * *", pgtype.Text{String: "Europe/Berlin", Valid: true}))
+}
+
+func TestGetMissedCronRuns(t *testing.T) {
+	lastFiredAt := pgtype.Timestamp{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	now := time.Date(2026, 3, 1, 17, 30, 0, 0, time.UTC)
+
+	hourly := func(hours ...int) []time.Time {
+		res := make([]time.Time, 0, len(hours))
+
+		for _, h := range hours {
+			res = append(res, time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC))
+		}
+
+		return res
+	}
+
+	tests := []struct {
+		name        string
+		policy      sqlcv1.WorkflowTriggerCronRefMisfirePolicy
+		limit       pgtype.Int4
+		lastFiredAt pgtype.Timestamp
+		expected    []time.Time
+	}{
+		{
+			name:        "skip",
+			policy:      sqlcv1.WorkflowTriggerCronRefMisfirePolicySKIP,
+			lastFiredAt: lastFiredAt,
+		},
+		{
+			name:        "fire once fires the latest missed run",
+			policy:      sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREONCE,
+			lastFiredAt: lastFiredAt,
+			expected:    hourly(17),
+		},
+		{
+			name:        "fire all fires every missed run",
+			policy:      sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL,
+			lastFiredAt: lastFiredAt,
+			expected:    hourly(13, 14, 15, 16, 17),
+		},
+		{
+			name:        "fire all fires the most recent missed runs up to the limit",
+			policy:      sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL,
+			limit:       pgtype.Int4{Int32: 2, Valid: true},
+			lastFiredAt: lastFiredAt,
+			expected:    hourly(16, 17),
+		},
+		{
+			name:   "never fired",
+			policy: sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, err := getMissedCronRuns("0 * * * *", tt.policy, tt.limit, tt.lastFiredAt, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, runs)
+		})
+	}
+}
+
+func TestGetMissedCronRunsTimezone(t *testing.T) {
+	// 09:00 in New York is 14:00 UTC during standard time
+	lastFiredAt := pgtype.Timestamp{Time: time.Date(2026, 1, 10, 14, 0, 0, 0, time.UTC), Valid: true}
+	now := time.Date(2026, 1, 13, 12, 0, 0, 0, time.UTC)
+
+	runs, err := getMissedCronRuns(
+		getCrontab("0 9 * * *", pgtype.Text{String: "America/New_York", Valid: true}),
+		sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL,
+		pgtype.Int4{},
+		lastFiredAt,
+		now,
+	)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	assert.True(t, runs[0].Equal(time.Date(2026, 1, 11, 14, 0, 0, 0, time.UTC)))
+	assert.True(t, runs[1].Equal(time.Date(2026, 1, 12, 14, 0, 0, 0, time.UTC)))
+}
+
+func TestGetMissedCronRunsLongOutage(t *testing.T) {
+	// a cron which fires every second and missed a year of runs only walks back far enough to find the limit
+	lastFiredAt := pgtype.Timestamp{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runs, err := getMissedCronRuns(
+		"* * * * * *",
+		sqlcv1.WorkflowTriggerCronRefMisfirePolicyFIREALL,
+		pgtype.Int4{Int32: 3, Valid: true},
+		lastFiredAt,
+		now,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []time.Time{now.Add(-3 * time.Second), now.Add(-2 * time.Second), now.Add(-1 * time.Second)}, runs)
+}
@@ -0,0 +1,120 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// cronOverlapGracePeriod is how long a run triggered by a cron is considered to be running before it shows up in
+// the OLAP tables, which are written to asynchronously.
+const cronOverlapGracePeriod = 5 * time.Minute
+
+// isCronRunActive returns whether the previous run of a cron is still active, given its readable status (nil if the
+// run could not be found) and the time it was fired at.
+func isCronRunActive(status *sqlcv1.V1ReadableStatusOlap, firedAt, now time.Time) bool {
+	if status == nil {
+		return now.Sub(firedAt) < cronOverlapGracePeriod
+	}
+
+	// evicted durable tasks are waiting to be resumed, so they're still active
+	switch *status {
+	case sqlcv1.V1ReadableStatusOlapQUEUED, sqlcv1.V1ReadableStatusOlapRUNNING, sqlcv1.V1ReadableStatusOlapEVICTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// handleCronOverlap applies the overlap policy of a cron before it fires, and returns whether the fire should be
+// skipped.
+func (t *TickerImpl) handleCronOverlap(ctx context.Context, tenantId, cronId uuid.UUID, policy sqlcv1.WorkflowTriggerCronRefOverlapPolicy) (bool, error) {
+	if policy == "" || policy == sqlcv1.WorkflowTriggerCronRefOverlapPolicyALLOW {
+		return false, nil
+	}
+
+	prev, err := t.repov1.WorkflowSchedules().GetLatestTriggeredCronFire(ctx, tenantId, cronId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not get latest cron fire: %w", err)
+	}
+
+	if prev == nil || prev.WorkflowRunExternalID == nil {
+		return false, nil
+	}
+
+	statuses, err := t.repov1.OLAP().ListWorkflowRunStatuses(ctx, tenantId, []uuid.UUID{*prev.WorkflowRunExternalID})
+
+	if err != nil {
+		return false, fmt.Errorf("could not get status of previous cron run: %w", err)
+	}
+
+	var status *sqlcv1.V1ReadableStatusOlap
+
+	if s, ok := statuses[*prev.WorkflowRunExternalID]; ok {
+		status = &s
+	}
+
+	if !isCronRunActive(status, prev.FiredAt.Time, time.Now()) {
+		return false, nil
+	}
+
+	switch policy {
+	case sqlcv1.WorkflowTriggerCronRefOverlapPolicySKIPIFRUNNING:
+		return true, nil
+	case sqlcv1.WorkflowTriggerCronRefOverlapPolicyCANCELPREVIOUS:
+		return false, t.cancelCronRun(ctx, tenantId, *prev.WorkflowRunExternalID)
+	default:
+		return false, nil
+	}
+}
+
+func (t *TickerImpl) cancelCronRun(ctx context.Context, tenantId, externalId uuid.UUID) error {
+	tasks, err := t.repov1.Tasks().FlattenExternalIds(ctx, tenantId, []uuid.UUID{externalId})
+
+	if err != nil {
+		return fmt.Errorf("could not get tasks of previous cron run: %w", err)
+	}
+
+	// the run may not have been written yet, in which case there's nothing to cancel
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	tasksToCancel := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	for _, task := range tasks {
+		tasksToCancel = append(tasksToCancel, v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		})
+	}
+
+	msg, err := msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDCancelTasks,
+		false,
+		true,
+		tasktypes.CancelTasksPayload{
+			Tasks: tasksToCancel,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create cancel tasks message: %w", err)
+	}
+
+	if err := t.mqv1.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg); err != nil {
+		return fmt.Errorf("could not send cancel tasks message: %w", err)
+	}
+
+	return nil
+}
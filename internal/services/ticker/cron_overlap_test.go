@@ -0,0 +1,71 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestIsCronRunActive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	status := func(s sqlcv1.V1ReadableStatusOlap) *sqlcv1.V1ReadableStatusOlap {
+		return &s
+	}
+
+	tests := []struct {
+		name     string
+		status   *sqlcv1.V1ReadableStatusOlap
+		firedAt  time.Time
+		expected bool
+	}{
+		{
+			name:     "queued",
+			status:   status(sqlcv1.V1ReadableStatusOlapQUEUED),
+			firedAt:  now.Add(-time.Hour),
+			expected: true,
+		},
+		{
+			name:     "running",
+			status:   status(sqlcv1.V1ReadableStatusOlapRUNNING),
+			firedAt:  now.Add(-time.Hour),
+			expected: true,
+		},
+		{
+			name:     "evicted",
+			status:   status(sqlcv1.V1ReadableStatusOlapEVICTED),
+			firedAt:  now.Add(-time.Hour),
+			expected: true,
+		},
+		{
+			name:    "completed",
+			status:  status(sqlcv1.V1ReadableStatusOlapCOMPLETED),
+			firedAt: now.Add(-time.Minute),
+		},
+		{
+			name:    "cancelled",
+			status:  status(sqlcv1.V1ReadableStatusOlapCANCELLED),
+			firedAt: now.Add(-time.Minute),
+		},
+		{
+			name:     "not yet written",
+			firedAt:  now.Add(-time.Minute),
+			expected: true,
+		},
+		{
+			name:    "missing after the grace period",
+			firedAt: now.Add(-time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isCronRunActive(tt.status, tt.firedAt, now))
+		})
+	}
+}
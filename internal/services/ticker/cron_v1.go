@@ -3,6 +3,7 @@ package ticker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"time"
@@ -63,7 +64,57 @@ func RunCronWorkflow(ctx context.Context, mq msgqueue.MessageQueue, tenantId uui
 	return &externalId, nil
 }
 
-func (t *TickerImpl) runCronWorkflowV1(ctx context.Context, tenantId uuid.UUID, workflowVersion *sqlcv1.GetWorkflowVersionForEngineRow, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, priority *int32, scheduledAt time.Time) error {
-	_, err := RunCronWorkflow(ctx, t.mqv1, tenantId, cron, workflowVersion.WorkflowName, cronName, input, additionalMetadata, priority, scheduledAt)
-	return err
+func (t *TickerImpl) runCronWorkflowV1(ctx context.Context, tenantId uuid.UUID, workflowVersion *sqlcv1.GetWorkflowVersionForEngineRow, cronId uuid.UUID, overlapPolicy sqlcv1.WorkflowTriggerCronRefOverlapPolicy, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, priority *int32, scheduledAt time.Time) error {
+	err := ValidateInput(ctx, t.mqv1, t.repov1, tenantId, workflowVersion.WorkflowName, input)
+
+	if err != nil {
+		invalidInput := &v1.ErrInvalidInput{}
+
+		// we skip this run, but the cron will fire again in case its input or the workflow's schema is fixed
+		if errors.As(err, &invalidInput) {
+			t.recordCronFire(ctx, tenantId, cronId, scheduledAt, sqlcv1.V1CronFireOutcomeSKIPPEDINVALIDINPUT, nil)
+
+			return recordInvalidInput(ctx, t.mqv1, tenantId, invalidInput)
+		}
+
+		return fmt.Errorf("could not validate cron input: %w", err)
+	}
+
+	skip, err := t.handleCronOverlap(ctx, tenantId, cronId, overlapPolicy)
+
+	if err != nil {
+		return fmt.Errorf("could not handle cron overlap: %w", err)
+	}
+
+	if skip {
+		t.l.Debug().Ctx(ctx).Msgf("ticker: skipping cron %s because its previous run is still running", cronId)
+		t.recordCronFire(ctx, tenantId, cronId, scheduledAt, sqlcv1.V1CronFireOutcomeSKIPPEDOVERLAP, nil)
+
+		return nil
+	}
+
+	externalId, err := RunCronWorkflow(ctx, t.mqv1, tenantId, cron, workflowVersion.WorkflowName, cronName, input, additionalMetadata, priority, scheduledAt)
+
+	if err != nil {
+		return err
+	}
+
+	t.recordCronFire(ctx, tenantId, cronId, scheduledAt, sqlcv1.V1CronFireOutcomeTRIGGERED, externalId)
+
+	return nil
+}
+
+// recordCronFire records the outcome of a cron firing in its history. Failing to record a fire doesn't fail the run,
+// but a missing TRIGGERED fire means the overlap policy won't see the run.
+func (t *TickerImpl) recordCronFire(ctx context.Context, tenantId, cronId uuid.UUID, scheduledAt time.Time, outcome sqlcv1.V1CronFireOutcome, externalId *uuid.UUID) {
+	err := t.repov1.WorkflowSchedules().RecordCronFire(ctx, tenantId, &v1.RecordCronFireOpts{
+		CronId:                cronId,
+		ScheduledAt:           scheduledAt,
+		Outcome:               outcome,
+		WorkflowRunExternalId: externalId,
+	})
+
+	if err != nil {
+		t.l.Error().Ctx(ctx).Err(err).Msgf("could not record fire of cron %s", cronId)
+	}
 }
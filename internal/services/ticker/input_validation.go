@@ -0,0 +1,59 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ValidateInput validates the input of a cron or scheduled run against the input schema of its workflow. Invalid
+// input for a workflow which rejects invalid input returns a *v1.ErrInvalidInput, while invalid input for other
+// workflows is recorded as a CEL evaluation failure.
+func ValidateInput(ctx context.Context, mq msgqueue.MessageQueue, repo v1.Repository, tenantId uuid.UUID, workflowName string, input []byte) error {
+	failures, err := repo.Triggers().ValidateWorkflowNameOptsInput(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: workflowName,
+				Data:         input,
+			},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return sendCELEvaluationFailures(ctx, mq, tenantId, failures...)
+}
+
+// recordInvalidInput records a run which was skipped because its input doesn't match the input schema of its workflow.
+func recordInvalidInput(ctx context.Context, mq msgqueue.MessageQueue, tenantId uuid.UUID, invalidInput *v1.ErrInvalidInput) error {
+	return sendCELEvaluationFailures(ctx, mq, tenantId, v1.CELEvaluationFailure{
+		Source:       sqlcv1.V1CelEvaluationFailureSourceINPUTSCHEMA,
+		ErrorMessage: invalidInput.Error(),
+	})
+}
+
+func sendCELEvaluationFailures(ctx context.Context, mq msgqueue.MessageQueue, tenantId uuid.UUID, failures ...v1.CELEvaluationFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	msg, err := tasktypes.CELEvaluationFailureMessage(tenantId, failures)
+
+	if err != nil {
+		return fmt.Errorf("could not create CEL evaluation failure message: %w", err)
+	}
+
+	if err := mq.SendMessage(ctx, msgqueue.OLAP_QUEUE, msg); err != nil {
+		return fmt.Errorf("could not send CEL evaluation failure message: %w", err)
+	}
+
+	return nil
+}
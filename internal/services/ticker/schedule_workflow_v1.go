@@ -19,10 +19,26 @@ import (
 
 func (t *TickerImpl) RunScheduledWorkflowV1(ctx context.Context, tenantId uuid.UUID, opts v1.RunScheduledWorkflowV1Opts) error {
 	_, err := RunScheduledWorkflow(ctx, t.l, t.mqv1, t.repov1, tenantId, opts)
+
+	invalidInput := &v1.ErrInvalidInput{}
+
+	if errors.As(err, &invalidInput) {
+		// the scheduled run can never be triggered, so we record the failure and delete it
+		if err := recordInvalidInput(ctx, t.mqv1, tenantId, invalidInput); err != nil {
+			return err
+		}
+
+		return t.repov1.WorkflowSchedules().DeleteScheduledWorkflow(ctx, tenantId, opts.ID)
+	}
+
 	return err
 }
 
 func RunScheduledWorkflow(ctx context.Context, l *zerolog.Logger, mq msgqueue.MessageQueue, repo v1.Repository, tenantId uuid.UUID, opts v1.RunScheduledWorkflowV1Opts) (*uuid.UUID, error) {
+	if err := ValidateInput(ctx, mq, repo, tenantId, opts.WorkflowName, opts.Input); err != nil {
+		return nil, err
+	}
+
 	expiresAt := opts.TriggerAt.Add(time.Second * 30)
 	err := repo.Idempotency().CreateIdempotencyKey(ctx, tenantId, opts.ID.String(), sqlchelpers.TimestamptzFromTime(expiresAt))
 
@@ -85,5 +85,28 @@ func (t *TickerImpl) runTenantResourceLimitAlerts(ctx context.Context) func() {
 		if err != nil {
 			t.l.Err(err).Ctx(ctx).Msg("could not handle tenant resource limit alerts")
 		}
+
+		t.l.Debug().Ctx(ctx).Msg("ticker: polling scoped resource limit alerts")
+
+		scopedAlerts, err := t.repov1.Ticker().PollScopedResourceLimitAlerts(ctx)
+
+		if err != nil {
+			t.l.Err(err).Ctx(ctx).Msg("could not poll scoped resource limit alerts")
+			return
+		}
+
+		t.l.Debug().Ctx(ctx).Msgf("ticker: alerting %d scoped resource limit alerts", len(scopedAlerts))
+
+		for _, alert := range scopedAlerts {
+			innerErr := t.ta.SendScopedResourceLimitAlert(alert.TenantID, alert)
+
+			if innerErr != nil {
+				err = multierror.Append(err, innerErr)
+			}
+		}
+
+		if err != nil {
+			t.l.Err(err).Ctx(ctx).Msg("could not handle scoped resource limit alerts")
+		}
 	}
 }
@@ -52,6 +52,9 @@ const (
 	Branch    Action = "branch"
 	Memo      Action = "memo"
 	WaitFor   Action = "wait-for"
+	Signal    Action = "signal"
+	Query     Action = "query"
+	Patch     Action = "patch"
 )
 
 type Properties map[string]interface{}
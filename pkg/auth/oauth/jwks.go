@@ -0,0 +1,284 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // registers SHA-256 for crypto.Hash
+	_ "crypto/sha512" // registers SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwksRefreshInterval is the minimum time between fetches of the key set triggered by an unknown key id, so that
+// tokens with made up key ids can't be used to flood the provider with requests.
+const jwksRefreshInterval = time.Minute
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// signingKey is a public key of the provider which can verify ID token signatures.
+type signingKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// verifyIDTokenSignature verifies the JWS signature of an ID token against the provider's key set, which is
+// read from the jwks_uri of the discovery document. The key set is refetched when the token is signed with an
+// unknown key id, so that key rotation doesn't require a restart.
+func (p *OIDCProvider) verifyIDTokenSignature(ctx context.Context, discovery *oidcDiscoveryDocument, rawIDToken string) error {
+	parts := strings.Split(rawIDToken, ".")
+
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed id_token")
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+
+	if err != nil {
+		return fmt.Errorf("malformed id_token header: %w", err)
+	}
+
+	header := &jwsHeader{}
+
+	if err := json.Unmarshal(headerBytes, header); err != nil {
+		return fmt.Errorf("malformed id_token header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+
+	if err != nil {
+		return fmt.Errorf("malformed id_token signature: %w", err)
+	}
+
+	keys, err := p.getSigningKeys(ctx, discovery, header.Kid)
+
+	if err != nil {
+		return err
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+
+	for _, k := range keys {
+		if header.Kid != "" && k.kid != header.Kid {
+			continue
+		}
+
+		if k.alg != "" && k.alg != header.Alg {
+			continue
+		}
+
+		if err := verifyJWSSignature(header.Alg, k.key, signed, signature); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("id_token signature could not be verified with the provider's keys")
+}
+
+// getSigningKeys returns the provider's signing keys, fetching the key set if it hasn't been fetched yet or if
+// it doesn't contain the key id.
+func (p *OIDCProvider) getSigningKeys(ctx context.Context, discovery *oidcDiscoveryDocument, kid string) ([]signingKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil && (kid == "" || hasSigningKey(p.keys, kid) || time.Since(p.keysFetchedAt) < jwksRefreshInterval) {
+		return p.keys, nil
+	}
+
+	keySet := &jsonWebKeySet{}
+
+	if err := p.getJSON(ctx, discovery.JwksURI, "", keySet); err != nil {
+		return nil, fmt.Errorf("could not get the provider's key set: %w", err)
+	}
+
+	keys := make([]signingKey, 0, len(keySet.Keys))
+
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+
+		// keys of unsupported types are skipped, since the provider may publish keys for other purposes
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, signingKey{
+			kid: jwk.Kid,
+			alg: jwk.Alg,
+			key: key,
+		})
+	}
+
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	return keys, nil
+}
+
+func hasSigningKey(keys []signingKey, kid string) bool {
+	for _, k := range keys {
+		if k.kid == kid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// points which aren't on the curve are rejected by ecdsa.Verify
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid key parameter")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verifyJWSSignature verifies a JWS signature with one of the asymmetric algorithms of RFC 7518. Symmetric
+// algorithms and "none" are rejected.
+func verifyJWSSignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	var hash crypto.Hash
+
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported id_token signing algorithm %q", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+
+		if !ok {
+			return errors.New("key is not an RSA key")
+		}
+
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+	case "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+
+		if !ok {
+			return errors.New("key is not an RSA key")
+		}
+
+		return rsa.VerifyPSS(rsaKey, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	default:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+
+		if !ok {
+			return errors.New("key is not an EC key")
+		}
+
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+
+		if len(signature) != 2*size {
+			return errors.New("invalid EC signature length")
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("invalid EC signature")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,413 @@
+package oauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+)
+
+// OIDCConfig configures a generic OpenID Connect provider, such as Okta, Keycloak or dex.
+type OIDCConfig struct {
+	Config
+
+	// IssuerURL is the URL of the issuer. The discovery document is read from
+	// {IssuerURL}/.well-known/openid-configuration.
+	IssuerURL string
+
+	// EmailClaim is the claim which contains the user's email.
+	EmailClaim string
+
+	// NameClaim is the claim which contains the user's display name.
+	NameClaim string
+
+	// GroupsClaim is the claim which contains the user's groups. Nested claims can be referenced with dots,
+	// for example realm_access.roles.
+	GroupsClaim string
+
+	// AllowedDomains restricts logins to emails in these domains. If empty, all domains are allowed.
+	AllowedDomains []string
+
+	// AutoJoinTenantId is the tenant which users are added to when they first log in. If nil, users are not
+	// added to a tenant.
+	AutoJoinTenantId *uuid.UUID
+
+	// GroupRoles maps groups to tenant roles, in order of precedence.
+	GroupRoles []OIDCGroupRole
+
+	// DefaultRole is the tenant role of users who aren't in any of the groups in GroupRoles. If empty, these
+	// users are not added to the tenant.
+	DefaultRole string
+}
+
+// OIDCGroupRole maps a group claim value to a tenant role. The role is either a built-in role or the name of a
+// custom role in the tenant.
+type OIDCGroupRole struct {
+	Group string
+	Role  string
+}
+
+// ParseOIDCGroupRoles parses group role mappings of the form group=role.
+func ParseOIDCGroupRoles(mappings []string) ([]OIDCGroupRole, error) {
+	res := make([]OIDCGroupRole, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		group, role, ok := strings.Cut(mapping, "=")
+
+		group = strings.TrimSpace(group)
+		role = strings.TrimSpace(role)
+
+		if !ok || group == "" || role == "" {
+			return nil, fmt.Errorf("invalid group role mapping %q, expected group=role", mapping)
+		}
+
+		res = append(res, OIDCGroupRole{
+			Group: group,
+			Role:  role,
+		})
+	}
+
+	return res, nil
+}
+
+// OIDCClaims are the claims of an authenticated OIDC user.
+type OIDCClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Groups        []string
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider authenticates users against an OpenID Connect provider. The provider's endpoints are read
+// from its discovery document the first time they're needed, so that an unavailable provider doesn't prevent
+// the server from starting.
+type OIDCProvider struct {
+	cfg *OIDCConfig
+
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *oidcDiscoveryDocument
+	keys          []signingKey
+	keysFetchedAt time.Time
+}
+
+func NewOIDCProvider(cfg *OIDCConfig) *OIDCProvider {
+	return &OIDCProvider{
+		cfg: cfg,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// OAuth2Config returns the OAuth2 config for the provider, fetching the discovery document if it hasn't
+// been fetched yet.
+func (p *OIDCProvider) OAuth2Config(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := p.getDiscoveryDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+		RedirectURL: p.cfg.BaseURL + "/api/v1/users/oidc/callback",
+		Scopes:      p.cfg.Scopes,
+	}, nil
+}
+
+func (p *OIDCProvider) getDiscoveryDocument(ctx context.Context) (*oidcDiscoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.IssuerURL, "/")
+
+	discovery := &oidcDiscoveryDocument{}
+
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", "", discovery); err != nil {
+		return nil, fmt.Errorf("could not get discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery document issuer %q does not match issuer %q", discovery.Issuer, p.cfg.IssuerURL)
+	}
+
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("discovery document is missing the authorization endpoint, token endpoint or jwks_uri")
+	}
+
+	p.discovery = discovery
+
+	return discovery, nil
+}
+
+// Claims returns the claims of the user who the token was issued to. Claims are read from the ID token,
+// and claims which aren't in the ID token are read from the userinfo endpoint. The nonce is the value which
+// was sent with the authorization request, and must match the nonce of the ID token.
+func (p *OIDCProvider) Claims(ctx context.Context, tok *oauth2.Token, nonce string) (*OIDCClaims, error) {
+	discovery, err := p.getDiscoveryDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := tok.Extra("id_token").(string)
+
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("token response does not contain an id_token")
+	}
+
+	if err := p.verifyIDTokenSignature(ctx, discovery, rawIDToken); err != nil {
+		return nil, err
+	}
+
+	claims, err := p.parseIDToken(discovery, rawIDToken, nonce)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if discovery.UserinfoEndpoint != "" {
+		userinfo := map[string]interface{}{}
+
+		if err := p.getJSON(ctx, discovery.UserinfoEndpoint, tok.AccessToken, &userinfo); err != nil {
+			return nil, fmt.Errorf("could not get userinfo: %w", err)
+		}
+
+		// the userinfo response must be for the same user as the ID token
+		if sub, _ := userinfo["sub"].(string); sub != claims["sub"] {
+			return nil, fmt.Errorf("userinfo subject does not match the id_token subject")
+		}
+
+		for k, v := range userinfo {
+			if _, exists := claims[k]; !exists {
+				claims[k] = v
+			}
+		}
+	}
+
+	return p.mapClaims(claims), nil
+}
+
+// parseIDToken reads the claims of an ID token and validates its issuer, audience, expiry and nonce. The
+// signature must have been verified with verifyIDTokenSignature.
+func (p *OIDCProvider) parseIDToken(discovery *oidcDiscoveryDocument, rawIDToken, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(rawIDToken, ".")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed id_token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+
+	if err != nil {
+		return nil, fmt.Errorf("malformed id_token payload: %w", err)
+	}
+
+	claims := map[string]interface{}{}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed id_token payload: %w", err)
+	}
+
+	if iss, _ := claims["iss"].(string); iss != discovery.Issuer {
+		return nil, fmt.Errorf("id_token issuer %q does not match issuer %q", iss, discovery.Issuer)
+	}
+
+	if !containsString(stringsClaim(claims["aud"]), p.cfg.ClientID) {
+		return nil, fmt.Errorf("id_token audience does not contain the client id")
+	}
+
+	exp, ok := claims["exp"].(float64)
+
+	if !ok || time.Unix(int64(exp), 0).Before(time.Now()) {
+		return nil, fmt.Errorf("id_token is expired")
+	}
+
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, fmt.Errorf("id_token does not contain a subject")
+	}
+
+	if tokenNonce, _ := claims["nonce"].(string); nonce == "" || subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("id_token nonce does not match the nonce of the login")
+	}
+
+	return claims, nil
+}
+
+func (p *OIDCProvider) mapClaims(claims map[string]interface{}) *OIDCClaims {
+	res := &OIDCClaims{}
+
+	res.Subject, _ = claims["sub"].(string)
+	res.Email, _ = lookupClaim(claims, p.cfg.EmailClaim).(string)
+	res.Name, _ = lookupClaim(claims, p.cfg.NameClaim).(string)
+	res.Groups = stringsClaim(lookupClaim(claims, p.cfg.GroupsClaim))
+
+	// some providers return email_verified as a string
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		res.EmailVerified = verified
+	case string:
+		res.EmailVerified = verified == "true"
+	}
+
+	return res
+}
+
+// IsAllowedDomain returns true if the email is in one of the allowed domains.
+func (p *OIDCProvider) IsAllowedDomain(email string) bool {
+	if len(p.cfg.AllowedDomains) == 0 {
+		return true
+	}
+
+	if strings.Count(email, "@") != 1 {
+		return false
+	}
+
+	domain := strings.Split(email, "@")[1]
+
+	for _, allowed := range p.cfg.AllowedDomains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AutoJoinTenantId returns the tenant which users are added to when they first log in, or nil if users
+// aren't added to a tenant.
+func (p *OIDCProvider) AutoJoinTenantId() *uuid.UUID {
+	return p.cfg.AutoJoinTenantId
+}
+
+// TenantRole returns the tenant role for a user in the given groups. The first mapping in GroupRoles which
+// matches one of the groups wins. It returns false if the user shouldn't be added to the tenant.
+func (p *OIDCProvider) TenantRole(groups []string) (string, bool) {
+	for _, mapping := range p.cfg.GroupRoles {
+		if containsString(groups, mapping.Group) {
+			return mapping.Role, true
+		}
+	}
+
+	if p.cfg.DefaultRole != "" {
+		return p.cfg.DefaultRole, true
+	}
+
+	return "", false
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, url, accessToken string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed creating request: %w", err)
+	}
+
+	if accessToken != "" {
+		req.Header.Add("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := p.httpClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return fmt.Errorf("failed reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, dest); err != nil {
+		return fmt.Errorf("failed parsing response body: %w", err)
+	}
+
+	return nil
+}
+
+// lookupClaim returns the value of a claim, following dots into nested objects.
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	if v, ok := claims[name]; ok {
+		return v
+	}
+
+	first, rest, ok := strings.Cut(name, ".")
+
+	if !ok {
+		return nil
+	}
+
+	nested, ok := claims[first].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	return lookupClaim(nested, rest)
+}
+
+// stringsClaim converts a claim which is either a single string or an array of strings to a slice.
+func stringsClaim(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		res := make([]string, 0, len(val))
+
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				res = append(res, s)
+			}
+		}
+
+		return res
+	}
+
+	return nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+
+	return false
+}
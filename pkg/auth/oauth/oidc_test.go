@@ -0,0 +1,305 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const testNonce = "test-nonce"
+
+// testSigningKey is the key which the test provider signs ID tokens with. It's served from the jwks_uri
+// with the key id "test-key".
+var testSigningKey = mustGenerateRSAKey()
+
+func mustGenerateRSAKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
+func newTestOIDCServer(t *testing.T, userinfo map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/auth",
+			"token_endpoint":         srv.URL + "/token",
+			"userinfo_endpoint":      srv.URL + "/userinfo",
+			"jwks_uri":               srv.URL + "/jwks",
+		})
+	})
+
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "test-key",
+					"use": "sig",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(testSigningKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(testSigningKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(userinfo)
+	})
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestToken(t *testing.T, claims map[string]interface{}) *oauth2.Token {
+	t.Helper()
+
+	return newTestTokenWithKey(t, claims, "test-key", testSigningKey)
+}
+
+// newTestTokenWithKey returns a token with an ID token signed with RS256 by the key.
+func newTestTokenWithKey(t *testing.T, claims map[string]interface{}, kid string, key *rsa.PrivateKey) *oauth2.Token {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return newTestTokenFromIDToken(signed + "." + base64.RawURLEncoding.EncodeToString(signature))
+}
+
+func newTestTokenFromIDToken(idToken string) *oauth2.Token {
+	return (&oauth2.Token{AccessToken: "access-token"}).WithExtra(map[string]interface{}{
+		"id_token": idToken,
+	})
+}
+
+func newTestOIDCProvider(issuer string) *OIDCProvider {
+	return NewOIDCProvider(&OIDCConfig{
+		Config: Config{
+			ClientID: "hatchet",
+			BaseURL:  "http://localhost:8080",
+			Scopes:   []string{"openid", "email", "profile"},
+		},
+		IssuerURL:   issuer,
+		EmailClaim:  "email",
+		NameClaim:   "name",
+		GroupsClaim: "realm_access.roles",
+	})
+}
+
+func TestOIDCProviderOAuth2Config(t *testing.T) {
+	srv := newTestOIDCServer(t, nil)
+	p := newTestOIDCProvider(srv.URL + "/")
+
+	cfg, err := p.OAuth2Config(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, srv.URL+"/auth", cfg.Endpoint.AuthURL)
+	assert.Equal(t, srv.URL+"/token", cfg.Endpoint.TokenURL)
+	assert.Equal(t, "http://localhost:8080/api/v1/users/oidc/callback", cfg.RedirectURL)
+
+	_, err = newTestOIDCProvider("http://127.0.0.1:1").OAuth2Config(context.Background())
+	assert.Error(t, err)
+}
+
+func TestOIDCProviderClaims(t *testing.T) {
+	srv := newTestOIDCServer(t, map[string]interface{}{
+		"sub":            "user-1",
+		"email_verified": "true",
+		"realm_access": map[string]interface{}{
+			"roles": []string{"hatchet-admins", "offline_access"},
+		},
+	})
+	p := newTestOIDCProvider(srv.URL)
+
+	claims, err := p.Claims(context.Background(), newTestToken(t, map[string]interface{}{
+		"iss":   srv.URL,
+		"aud":   []string{"hatchet", "other"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"sub":   "user-1",
+		"nonce": testNonce,
+		"email": "alice@example.com",
+		"name":  "Alice",
+	}), testNonce)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", claims.Subject)
+	assert.Equal(t, "alice@example.com", claims.Email)
+	assert.Equal(t, "Alice", claims.Name)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, []string{"hatchet-admins", "offline_access"}, claims.Groups)
+}
+
+func TestOIDCProviderClaimsInvalidIDToken(t *testing.T) {
+	srv := newTestOIDCServer(t, map[string]interface{}{"sub": "user-1"})
+	p := newTestOIDCProvider(srv.URL)
+
+	valid := map[string]interface{}{
+		"iss":   srv.URL,
+		"aud":   "hatchet",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"sub":   "user-1",
+		"nonce": testNonce,
+	}
+
+	_, err := p.Claims(context.Background(), newTestToken(t, valid), testNonce)
+	assert.NoError(t, err)
+
+	for name, override := range map[string]map[string]interface{}{
+		"wrong issuer":   {"iss": "https://evil.example.com"},
+		"wrong audience": {"aud": "other"},
+		"expired":        {"exp": time.Now().Add(-time.Hour).Unix()},
+		"other subject":  {"sub": "user-2"},
+		"wrong nonce":    {"nonce": "other-nonce"},
+		"missing nonce":  {"nonce": nil},
+	} {
+		claims := map[string]interface{}{}
+
+		for k, v := range valid {
+			claims[k] = v
+		}
+
+		for k, v := range override {
+			claims[k] = v
+		}
+
+		_, err := p.Claims(context.Background(), newTestToken(t, claims), testNonce)
+		assert.Error(t, err, name)
+	}
+
+	// a login without a nonce in the session is rejected
+	_, err = p.Claims(context.Background(), newTestToken(t, valid), "")
+	assert.Error(t, err)
+
+	_, err = p.Claims(context.Background(), &oauth2.Token{AccessToken: "access-token"}, testNonce)
+	assert.Error(t, err)
+}
+
+func TestOIDCProviderClaimsInvalidSignature(t *testing.T) {
+	srv := newTestOIDCServer(t, map[string]interface{}{"sub": "user-1"})
+	p := newTestOIDCProvider(srv.URL)
+
+	claims := map[string]interface{}{
+		"iss":   srv.URL,
+		"aud":   "hatchet",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"sub":   "user-1",
+		"nonce": testNonce,
+	}
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+
+	// signed by a key which isn't in the provider's key set, both with the provider's key id and an unknown one
+	otherKey := mustGenerateRSAKey()
+
+	_, err = p.Claims(context.Background(), newTestTokenWithKey(t, claims, "test-key", otherKey), testNonce)
+	assert.Error(t, err)
+
+	_, err = p.Claims(context.Background(), newTestTokenWithKey(t, claims, "other-key", otherKey), testNonce)
+	assert.Error(t, err)
+
+	// a valid signature over different claims
+	valid := newTestToken(t, claims).Extra("id_token").(string)
+	parts := strings.Split(valid, ".")
+
+	tampered := map[string]interface{}{}
+
+	for k, v := range claims {
+		tampered[k] = v
+	}
+
+	tampered["sub"] = "user-2"
+
+	tamperedPayload, err := json.Marshal(tampered)
+	require.NoError(t, err)
+
+	_, err = p.Claims(context.Background(), newTestTokenFromIDToken(parts[0]+"."+base64.RawURLEncoding.EncodeToString(tamperedPayload)+"."+parts[2]), testNonce)
+	assert.Error(t, err)
+
+	// unsigned and symmetrically signed tokens are rejected
+	for _, alg := range []string{"none", "HS256"} {
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"` + alg + `","kid":"test-key"}`))
+
+		_, err = p.Claims(context.Background(), newTestTokenFromIDToken(header+"."+encodedPayload+"."+parts[2]), testNonce)
+		assert.Error(t, err, alg)
+	}
+}
+
+func TestOIDCProviderRestrictions(t *testing.T) {
+	groupRoles, err := ParseOIDCGroupRoles([]string{"hatchet-admins=ADMIN", " operators = operator "})
+	require.NoError(t, err)
+
+	p := NewOIDCProvider(&OIDCConfig{
+		AllowedDomains: []string{"example.com"},
+		GroupRoles:     groupRoles,
+	})
+
+	assert.True(t, p.IsAllowedDomain("alice@Example.com"))
+	assert.False(t, p.IsAllowedDomain("alice@example.org"))
+	assert.False(t, p.IsAllowedDomain("alice@example.com@example.org"))
+
+	role, ok := p.TenantRole([]string{"operators", "hatchet-admins"})
+	assert.True(t, ok)
+	assert.Equal(t, "ADMIN", role)
+
+	role, ok = p.TenantRole([]string{"operators"})
+	assert.True(t, ok)
+	assert.Equal(t, "operator", role)
+
+	_, ok = p.TenantRole([]string{"everyone"})
+	assert.False(t, ok)
+
+	p.cfg.DefaultRole = "MEMBER"
+
+	role, ok = p.TenantRole(nil)
+	assert.True(t, ok)
+	assert.Equal(t, "MEMBER", role)
+
+	_, err = ParseOIDCGroupRoles([]string{"hatchet-admins"})
+	assert.Error(t, err)
+}
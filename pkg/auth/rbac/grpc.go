@@ -0,0 +1,66 @@
+package rbac
+
+// grpcOperations maps gRPC methods to the equivalent OpenAPI operation, so that the same scopes apply to
+// both APIs. Methods which aren't listed here are part of the worker protocol, and can only be called by
+// tokens which aren't restricted to a subset of operations.
+var grpcOperations = map[string]string{
+	"/WorkflowService/TriggerWorkflow":       "WorkflowRunCreate",
+	"/WorkflowService/BulkTriggerWorkflow":   "WorkflowRunCreate",
+	"/WorkflowService/ScheduleWorkflow":      "ScheduledWorkflowRunCreate",
+	"/v1.AdminService/TriggerWorkflowRun":    "V1WorkflowRunCreate",
+	"/v1.AdminService/GetRunDetails":         "V1WorkflowRunGet",
+	"/v1.AdminService/CancelTasks":           "V1TaskCancel",
+	"/v1.AdminService/ReplayTasks":           "V1TaskReplay",
+	"/v1.AdminService/BranchDurableTask":     "V1DurableTaskBranch",
+	"/v1.V1Dispatcher/SendDurableTaskSignal": "V1TaskSignal",
+	"/v1.V1Dispatcher/QueryDurableTask":      "V1TaskQuery",
+	"/EventsService/Push":                    "EventCreate",
+	"/EventsService/BulkPush":                "EventCreateBulk",
+	"/EventsService/ReplaySingleEvent":       "EventUpdateReplay",
+	"/Dispatcher/SubscribeToWorkflowEvents":  "V1WorkflowRunGet",
+	"/Dispatcher/SubscribeToWorkflowRuns":    "V1WorkflowRunGet",
+}
+
+// grpcUnscopedMethods can be called by any token, regardless of its scopes.
+var grpcUnscopedMethods = []string{
+	"/Dispatcher/GetVersion",
+}
+
+// grpcWorkflowScopedMethods are the gRPC methods which can be called by tokens restricted to a subset of
+// workflows, whose workflow is checked against the request. Subscriptions aren't included, since the
+// workflow scopes interceptor doesn't run for streams and they could subscribe to runs of any workflow.
+var grpcWorkflowScopedMethods = []string{
+	"/WorkflowService/TriggerWorkflow",
+	"/WorkflowService/BulkTriggerWorkflow",
+	"/WorkflowService/ScheduleWorkflow",
+	"/v1.AdminService/TriggerWorkflowRun",
+}
+
+// GRPCOperationID returns the OpenAPI operation which is equivalent to a gRPC method, if one exists.
+func GRPCOperationID(fullMethod string) (string, bool) {
+	operationId, ok := grpcOperations[fullMethod]
+	return operationId, ok
+}
+
+// IsAuthorizedGRPC returns true if the scopes allow calling the gRPC method.
+func (a *ScopeAuthorizer) IsAuthorizedGRPC(scopes *Scopes, fullMethod string) bool {
+	if OperationIn(fullMethod, grpcUnscopedMethods) {
+		return true
+	}
+
+	if scopes.RestrictsWorkflows() && !OperationIn(fullMethod, grpcWorkflowScopedMethods) {
+		return false
+	}
+
+	if !scopes.RestrictsOperations() {
+		return true
+	}
+
+	operationId, ok := GRPCOperationID(fullMethod)
+
+	if !ok {
+		return false
+	}
+
+	return a.IsAuthorized(scopes, operationId)
+}
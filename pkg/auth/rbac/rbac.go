@@ -21,6 +21,7 @@ func OperationIn(operationId string, operationIds []string) bool {
 
 type Authorizer struct {
 	permissionMap PermissionMap
+	spec          *openapi3.T
 }
 
 func NewAuthorizer(
@@ -33,6 +34,7 @@ func NewAuthorizer(
 	}
 	return &Authorizer{
 		permissionMap: *permMap,
+		spec:          spec,
 	}, nil
 }
 
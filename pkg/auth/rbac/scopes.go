@@ -0,0 +1,169 @@
+package rbac
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	// ScopeReadOnly grants every operation which doesn't modify state (all GET operations in the spec).
+	ScopeReadOnly = "read-only"
+
+	// ScopeTriggerOnly grants the operations which trigger, schedule or push events to workflows.
+	ScopeTriggerOnly = "trigger-only"
+)
+
+var triggerOperations = []string{
+	"WorkflowRunCreate",
+	"V1WorkflowRunCreate",
+	"ScheduledWorkflowRunCreate",
+	"CronWorkflowTriggerCreate",
+	"EventCreate",
+	"EventCreateBulk",
+}
+
+// Scopes restrict what an API token can do. A nil *Scopes is unrestricted.
+type Scopes struct {
+	// Operations is a list of OpenAPI operation IDs or scope presets (read-only, trigger-only) which the token
+	// can perform. If empty, the token can perform any operation.
+	Operations []string
+
+	// Workflows is a list of workflow names the token is restricted to. If empty, the token can access
+	// every workflow.
+	Workflows []string
+}
+
+// NewScopes returns nil if neither operations nor workflows are set, so that unrestricted tokens are
+// represented consistently.
+func NewScopes(operations, workflows []string) *Scopes {
+	if len(operations) == 0 && len(workflows) == 0 {
+		return nil
+	}
+
+	return &Scopes{
+		Operations: operations,
+		Workflows:  workflows,
+	}
+}
+
+// RestrictsOperations returns true if the token can only perform a subset of operations.
+func (s *Scopes) RestrictsOperations() bool {
+	return s != nil && len(s.Operations) > 0
+}
+
+// RestrictsWorkflows returns true if the token can only access a subset of workflows.
+func (s *Scopes) RestrictsWorkflows() bool {
+	return s != nil && len(s.Workflows) > 0
+}
+
+// AllowsWorkflow returns true if the token can access the workflow with the given name.
+func (s *Scopes) AllowsWorkflow(name string) bool {
+	if !s.RestrictsWorkflows() {
+		return true
+	}
+
+	return slices.Contains(s.Workflows, name)
+}
+
+type ScopeAuthorizer struct {
+	operations map[string]struct{}
+	presets    map[string][]string
+}
+
+// NewScopeAuthorizer builds the scope presets from the operations in the spec.
+func NewScopeAuthorizer(spec *openapi3.T) (*ScopeAuthorizer, error) {
+	operations := map[string]struct{}{}
+	readOnly := make([]string, 0)
+
+	for _, pathItem := range spec.Paths.Map() {
+		for method, op := range pathItem.Operations() {
+			operations[op.OperationID] = struct{}{}
+
+			if method == http.MethodGet {
+				readOnly = append(readOnly, op.OperationID)
+			}
+		}
+	}
+
+	for _, operationId := range triggerOperations {
+		if _, ok := operations[operationId]; !ok {
+			return nil, &RBACError{
+				Message: fmt.Sprintf("%s is part of the %s scope but does not exist in specs", operationId, ScopeTriggerOnly),
+			}
+		}
+	}
+
+	return &ScopeAuthorizer{
+		operations: operations,
+		presets: map[string][]string{
+			ScopeReadOnly:    readOnly,
+			ScopeTriggerOnly: triggerOperations,
+		},
+	}, nil
+}
+
+// Validate ensures that every operation in the scopes is either a preset or an operation in the spec.
+func (a *ScopeAuthorizer) Validate(scopes *Scopes) error {
+	if scopes == nil {
+		return nil
+	}
+
+	for _, operation := range scopes.Operations {
+		if _, ok := a.presets[strings.ToLower(operation)]; ok {
+			continue
+		}
+
+		if !a.isOperation(operation) {
+			return &RBACError{
+				Message: fmt.Sprintf("%s is not a valid scope", operation),
+			}
+		}
+	}
+
+	for _, workflow := range scopes.Workflows {
+		if strings.TrimSpace(workflow) == "" {
+			return &RBACError{
+				Message: "workflow names in scopes cannot be empty",
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsAuthorized returns true if the scopes grant the operation.
+func (a *ScopeAuthorizer) IsAuthorized(scopes *Scopes, operationId string) bool {
+	if !scopes.RestrictsOperations() {
+		return true
+	}
+
+	for _, operation := range scopes.Operations {
+		if preset, ok := a.presets[strings.ToLower(operation)]; ok {
+			if OperationIn(operationId, preset) {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(operation, operationId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *ScopeAuthorizer) isOperation(operation string) bool {
+	for operationId := range a.operations {
+		if strings.EqualFold(operation, operationId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,13 +8,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/tink-crypto/tink-go/jwt"
 
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
 type JWTManager interface {
 	GenerateTenantToken(ctx context.Context, tenantId uuid.UUID, name string, internal bool, expires *time.Time) (*Token, error)
-	ValidateTenantToken(ctx context.Context, token string) (uuid.UUID, uuid.UUID, error)
+
+	// GenerateScopedTenantToken generates a tenant token which is restricted to the given scopes. The scopes
+	// are expected to be validated by the caller.
+	GenerateScopedTenantToken(ctx context.Context, tenantId uuid.UUID, name string, expires *time.Time, scopes *rbac.Scopes) (*Token, error)
+
+	// ValidateTenantToken returns the tenant id, the token id and the scopes of the token. The scopes are nil if
+	// the token is unrestricted.
+	ValidateTenantToken(ctx context.Context, token string) (uuid.UUID, uuid.UUID, *rbac.Scopes, error)
 }
 
 type TokenOpts struct {
@@ -82,19 +90,34 @@ func (j *jwtManagerImpl) createToken(ctx context.Context, tenantId uuid.UUID, na
 }
 
 func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId uuid.UUID, name string, internal bool, expires *time.Time) (*Token, error) {
+	return j.generateTenantToken(ctx, tenantId, name, internal, expires, nil)
+}
+
+func (j *jwtManagerImpl) GenerateScopedTenantToken(ctx context.Context, tenantId uuid.UUID, name string, expires *time.Time, scopes *rbac.Scopes) (*Token, error) {
+	return j.generateTenantToken(ctx, tenantId, name, false, expires, scopes)
+}
+
+func (j *jwtManagerImpl) generateTenantToken(ctx context.Context, tenantId uuid.UUID, name string, internal bool, expires *time.Time, scopes *rbac.Scopes) (*Token, error) {
 	token, err := j.createToken(ctx, tenantId, name, nil, expires)
 	if err != nil {
 		return nil, err
 	}
 
-	// write the token to the database
-	_, err = j.tokenRepo.CreateAPIToken(ctx, &v1.CreateAPITokenOpts{
+	createOpts := &v1.CreateAPITokenOpts{
 		ID:        token.TokenId,
 		ExpiresAt: token.ExpiresAt,
 		TenantId:  &tenantId,
 		Name:      &name,
 		Internal:  internal,
-	})
+	}
+
+	if scopes != nil {
+		createOpts.Scopes = scopes.Operations
+		createOpts.Workflows = scopes.Workflows
+	}
+
+	// write the token to the database
+	_, err = j.tokenRepo.CreateAPIToken(ctx, createOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to write token to database: %v", err)
 	}
@@ -102,7 +125,7 @@ func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId uuid.
 	return token, nil
 }
 
-func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string) (tenantId uuid.UUID, tokenUUID uuid.UUID, err error) {
+func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string) (tenantId uuid.UUID, tokenUUID uuid.UUID, scopes *rbac.Scopes, err error) {
 	// Verify the signed token.
 	audience := j.opts.Audience
 
@@ -114,30 +137,30 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	})
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to create JWT Validator: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to create JWT Validator: %v", err)
 	}
 
 	verifiedJwt, err := j.verifier.VerifyAndDecode(token, validator)
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to verify and decode JWT: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to verify and decode JWT: %v", err)
 	}
 
 	// Read the token from the database and make sure it's not revoked
 	if hasTokenId := verifiedJwt.HasStringClaim("token_id"); !hasTokenId {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("token does not have token_id claim")
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("token does not have token_id claim")
 	}
 
 	tokenId, err := verifiedJwt.StringClaim("token_id")
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to read token_id claim: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to read token_id claim: %v", err)
 	}
 
 	tokenIdUuid, err := uuid.Parse(tokenId)
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to parse token_id claim: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to parse token_id claim: %v", err)
 	}
 
 	// ensure the current server url matches the token, if present
@@ -145,11 +168,11 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 		serverURL, err := verifiedJwt.StringClaim("server_url")
 
 		if err != nil {
-			return uuid.Nil, uuid.Nil, fmt.Errorf("failed to read server_url claim: %v", err)
+			return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to read server_url claim: %v", err)
 		}
 
 		if serverURL != j.opts.ServerURL {
-			return uuid.Nil, uuid.Nil, fmt.Errorf("server_url claim does not match")
+			return uuid.Nil, uuid.Nil, nil, fmt.Errorf("server_url claim does not match")
 		}
 	}
 
@@ -157,35 +180,35 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	dbToken, err := j.tokenRepo.GetAPITokenById(ctx, tokenIdUuid)
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to read token from database: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to read token from database: %v", err)
 	}
 
 	if dbToken.Revoked {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("token has been revoked")
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("token has been revoked")
 	}
 
 	if expiresAt := dbToken.ExpiresAt.Time; expiresAt.Before(time.Now().UTC()) {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("token has expired")
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("token has expired")
 	}
 
 	// ensure the subject of the token matches the tenantId
 	if hasSubject := verifiedJwt.HasSubject(); !hasSubject {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("token does not have subject claim")
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("token does not have subject claim")
 	}
 
 	subject, err := verifiedJwt.Subject()
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to read subject claim: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to read subject claim: %v", err)
 	}
 
 	parsedSubject, err := uuid.Parse(subject)
 
 	if err != nil {
-		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to parse subject claim: %v", err)
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("failed to parse subject claim: %v", err)
 	}
 
-	return parsedSubject, dbToken.ID, nil
+	return parsedSubject, dbToken.ID, rbac.NewScopes(dbToken.Scopes, dbToken.Workflows), nil
 }
 
 func (j *jwtManagerImpl) getJWTOptionsForTenant(tenantId uuid.UUID, id *uuid.UUID, expires *time.Time) (tokenId uuid.UUID, expiresAt time.Time, opts *jwt.RawJWTOptions) {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
@@ -52,7 +53,7 @@ func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tes
 		}
 
 		// validate the token
-		newTenantId, _, err := jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		newTenantId, _, _, err := jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
 		assert.Equal(t, tenantId, newTenantId)
@@ -61,6 +62,67 @@ func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tes
 	})
 }
 
+func TestCreateScopedTenantToken(t *testing.T) {
+	_ = os.Setenv("CACHE_DURATION", "0")
+
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		jwtManager := getJWTManager(t, conf)
+
+		tenantId := uuid.New()
+
+		// create the tenant
+		slugSuffix, err := random.Generate(8)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, err = conf.V1.Tenant().CreateTenant(context.Background(), &v1.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		token, err := jwtManager.GenerateScopedTenantToken(context.Background(), tenantId, "test token", nil, &rbac.Scopes{
+			Operations: []string{rbac.ScopeTriggerOnly},
+			Workflows:  []string{"my-workflow"},
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// validate the token
+		newTenantId, _, scopes, err := jwtManager.ValidateTenantToken(context.Background(), token.Token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tenantId, newTenantId)
+
+		if assert.NotNil(t, scopes) {
+			assert.Equal(t, []string{rbac.ScopeTriggerOnly}, scopes.Operations)
+			assert.Equal(t, []string{"my-workflow"}, scopes.Workflows)
+		}
+
+		// unscoped tokens have nil scopes
+		unscopedToken, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, _, scopes, err = jwtManager.ValidateTenantToken(context.Background(), unscopedToken.Token)
+
+		assert.NoError(t, err)
+		assert.Nil(t, scopes)
+
+		return nil
+	})
+}
+
 func TestRevokeTenantToken(t *testing.T) {
 	_ = os.Setenv("CACHE_DURATION", "0")
 
@@ -93,7 +155,7 @@ func TestRevokeTenantToken(t *testing.T) {
 		}
 
 		// validate the token
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, _, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
 
@@ -116,7 +178,7 @@ func TestRevokeTenantToken(t *testing.T) {
 		time.Sleep(5 * time.Millisecond)
 
 		// validate the token again
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, _, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		// error as the token was revoked
 		assert.Error(t, err)
@@ -157,7 +219,7 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 		}
 
 		// validate the token
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, _, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
 
@@ -176,7 +238,7 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 		}
 
 		// validate the token again
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, _, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		// no error as it is cached
 		assert.NoError(t, err)
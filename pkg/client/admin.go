@@ -34,6 +34,7 @@ type ChildWorkflowOpts struct {
 	AdditionalMetadata  *map[string]string
 	Priority            *int32
 	DesiredWorkerLabels map[string]*types.DesiredWorkerLabel
+	Version             *string
 }
 
 // NewChildWorkflowTriggerRequest builds the trigger request used to start a child workflow.
@@ -60,6 +61,7 @@ func NewChildWorkflowTriggerRequest(workflowName string, input interface{}, opts
 		ChildIndex:              &childIndex,
 		ChildKey:                opts.ChildKey,
 		DesiredWorkerId:         opts.DesiredWorkerId,
+		Version:                 opts.Version,
 	}
 
 	additionalMetadata := mergeAdditionalMetadata(sharedMeta, opts.AdditionalMetadata)
@@ -346,6 +348,15 @@ func WithPriority(priority int32) RunOptFunc {
 	}
 }
 
+// WithVersion pins the run to a version of the workflow.
+func WithVersion(version string) RunOptFunc {
+	return func(r *v1contracts.TriggerWorkflowRequest) error {
+		r.Version = &version
+
+		return nil
+	}
+}
+
 func WithDesiredWorkerLabels(labels map[string]*types.DesiredWorkerLabel) RunOptFunc {
 	return func(r *admincontracts.TriggerWorkflowRequest) error {
 		r.DesiredWorkerLabels = desiredWorkerLabelsToProto(labels)
@@ -554,10 +565,28 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 		putParams.Duration = admincontracts.RateLimitDuration_MINUTE
 	case types.Hour:
 		putParams.Duration = admincontracts.RateLimitDuration_HOUR
+	case types.Day:
+		putParams.Duration = admincontracts.RateLimitDuration_DAY
+	case types.Week:
+		putParams.Duration = admincontracts.RateLimitDuration_WEEK
+	case types.Month:
+		putParams.Duration = admincontracts.RateLimitDuration_MONTH
+	case types.Year:
+		putParams.Duration = admincontracts.RateLimitDuration_YEAR
 	default:
 		putParams.Duration = admincontracts.RateLimitDuration_SECOND
 	}
 
+	if opts.Mode != "" {
+		mode := admincontracts.RateLimitMode(admincontracts.RateLimitMode_value[string(opts.Mode)])
+		putParams.Mode = &mode
+	}
+
+	if opts.Burst != nil {
+		burst := int32(*opts.Burst) // nolint: gosec
+		putParams.Burst = &burst
+	}
+
 	_, err := a.client.PutRateLimit(a.ctx.newContext(context.Background()), putParams)
 
 	if err != nil {
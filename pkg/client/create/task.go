@@ -43,6 +43,15 @@ type WorkflowTask[I, O any] struct {
 	// (optional) RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds int32
 
+	// (optional) RetryBackoffStrategy is the strategy used to compute the delay between retries
+	RetryBackoffStrategy types.RetryBackoffStrategy
+
+	// (optional) RetryBackoffBaseSeconds is the base delay for the FIXED and DECORRELATED_JITTER strategies
+	RetryBackoffBaseSeconds float32
+
+	// (optional) RetryRules override the retry policy of the task for specific error types
+	RetryRules []*types.RetryRule
+
 	// (optional) RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
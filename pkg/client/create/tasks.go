@@ -34,6 +34,10 @@ type WorkflowCreateOpts[I any] struct {
 	// (optional) The version of the workflow
 	Version string
 
+	// (optional) The percentage of new triggers (0-100) routed to this version while the
+	// previous version keeps receiving the rest. Requires Version to be set.
+	CanaryPercentage *int32
+
 	// (optional) The human-readable description of the workflow
 	Description string
 
@@ -58,6 +58,10 @@ type GetActionListenerRequest struct {
 	Labels     map[string]interface{}
 	WebhookId  *string
 
+	// WorkflowVersions maps the names of versioned workflows registered by the worker to their versions, so
+	// that runs pinned to a version are only assigned to workers running that version.
+	WorkflowVersions map[string]string
+
 	// LegacySlots, when non-nil, causes the registration to use the deprecated
 	// `slots` proto field instead of `slot_config`. This is for backward
 	// compatibility with engines that do not support multiple slot types.
@@ -172,6 +176,9 @@ type ActionEvent struct {
 
 	// If this is an error, whether to retry on failure
 	ShouldNotRetry *bool
+
+	// If this is an error, the error type which is matched against the retry rules of the task
+	ErrorType *string
 }
 
 type ActionEventResponse struct {
@@ -259,11 +266,12 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 	os := runtime.GOOS
 
 	registerReq := &dispatchercontracts.WorkerRegisterRequest{
-		WorkerName: req.WorkerName,
-		Actions:    req.Actions,
-		Services:   req.Services,
-		WebhookId:  req.WebhookId,
-		Labels:     map[string]*dispatchercontracts.WorkerLabels{},
+		WorkerName:       req.WorkerName,
+		Actions:          req.Actions,
+		Services:         req.Services,
+		WebhookId:        req.WebhookId,
+		Labels:           map[string]*dispatchercontracts.WorkerLabels{},
+		WorkflowVersions: req.WorkflowVersions,
 		RuntimeInfo: &dispatchercontracts.RuntimeInfo{
 			Language:        dispatchercontracts.SDKS_GO.Enum(),
 			LanguageVersion: &goVersion,
@@ -606,6 +614,7 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventPayload:      string(payloadBytes),
 		RetryCount:        &in.RetryCount,
 		ShouldNotRetry:    in.ShouldNotRetry,
+		ErrorType:         in.ErrorType,
 	})
 
 	if err != nil {
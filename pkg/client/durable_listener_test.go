@@ -46,6 +46,14 @@ func (m *mockV1DispatcherClient) ListenForDurableEvent(ctx context.Context, opts
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
+func (m *mockV1DispatcherClient) SendDurableTaskSignal(ctx context.Context, in *contracts.SendDurableTaskSignalRequest, opts ...grpc.CallOption) (*contracts.SendDurableTaskSignalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (m *mockV1DispatcherClient) QueryDurableTask(ctx context.Context, in *contracts.QueryDurableTaskRequest, opts ...grpc.CallOption) (*contracts.QueryDurableTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
 func (m *mockDurableEventClient) Send(req *contracts.ListenForDurableEventRequest) error {
 	m.sendCount.Add(1)
 	if m.sendFn != nil {
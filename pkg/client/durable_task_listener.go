@@ -75,6 +75,16 @@ func (e *NonDeterminismError) Error() string {
 	)
 }
 
+// QueryHandler answers a query sent to a running durable task. It runs alongside the task, so it must only read
+// state which is safe to access concurrently, and must not make durable requests.
+type QueryHandler func(input []byte) ([]byte, error)
+
+// queryHandlerSet holds the query handlers registered by one invocation of a durable task.
+type queryHandlerSet struct {
+	handlers        map[string]QueryHandler
+	invocationCount int32
+}
+
 // ServerEvictCallback is invoked when the engine notifies the worker that a
 // durable task invocation should be evicted.
 type ServerEvictCallback func(taskExternalID string, invocationCount int32, reason string)
@@ -90,6 +100,7 @@ type DurableTaskListener struct {
 	bufferedCompletions   map[PendingCallbackKey]*v1.DurableTaskResponse
 	pendingEventAcks      map[PendingAckKey]chan EventAckResult
 	pendingCallbacks      map[PendingCallbackKey]chan CallbackResult
+	queryHandlers         map[string]*queryHandlerSet
 	workerID              string
 	streamSeq             int
 	reconnectInterval     time.Duration
@@ -100,6 +111,7 @@ type DurableTaskListener struct {
 	pendingCallbacksMu    sync.Mutex
 	pendingEvictionAcksMu sync.Mutex
 	pendingEventAcksMu    sync.Mutex
+	queryHandlersMu       sync.RWMutex
 	mu                    sync.Mutex
 	running               bool
 }
@@ -143,6 +155,7 @@ func NewDurableTaskListener(
 		pendingEvictionAcks: make(map[PendingAckKey]chan error),
 		pendingCallbacks:    make(map[PendingCallbackKey]chan CallbackResult),
 		bufferedCompletions: make(map[PendingCallbackKey]*v1.DurableTaskResponse),
+		queryHandlers:       make(map[string]*queryHandlerSet),
 		requestQueue:        make(chan *v1.DurableTaskRequest, 100),
 	}
 
@@ -314,6 +327,8 @@ func (l *DurableTaskListener) CleanupTaskState(taskExternalID string, invocation
 		}
 	}
 	l.bufferedCompletionsMu.Unlock()
+
+	l.RemoveQueryHandlers(taskExternalID, invocationCount)
 }
 
 func (l *DurableTaskListener) receiveLoop(ctx context.Context) {
@@ -622,6 +637,125 @@ func (l *DurableTaskListener) SendWaitForRequest(
 	return l.WaitForCallback(ctx, taskExternalID, invocationCount, ref.GetBranchId(), ref.GetNodeId())
 }
 
+// SendWaitForSignalRequest waits for a named signal sent to the task, returning the signal payload. Like
+// SendWaitForRequest, it blocks for the WaitForAck and then for the EntryCompleted response. If the signal was sent
+// before the task waited for it, the entry is completed as soon as it's created.
+func (l *DurableTaskListener) SendWaitForSignalRequest(
+	ctx context.Context,
+	taskExternalID string,
+	invocationCount int32,
+	signalName string,
+) ([]byte, error) {
+	ackKey := PendingAckKey{TaskID: taskExternalID, SignalKey: int64(invocationCount)}
+	ackCh := l.AddPendingEventAck(ackKey)
+
+	l.l.Debug().
+		Str("step_run_id", taskExternalID).
+		Int32("invocation_count", invocationCount).
+		Str("signal_name", signalName).
+		Msg("DurableTaskListener: sending wait_for_signal request")
+
+	l.SendRequest(&v1.DurableTaskRequest{
+		Message: &v1.DurableTaskRequest_WaitForSignal{
+			WaitForSignal: &v1.DurableTaskWaitForSignalRequest{
+				InvocationCount:       invocationCount,
+				DurableTaskExternalId: taskExternalID,
+				SignalName:            signalName,
+			},
+		},
+	})
+
+	var ackResp *v1.DurableTaskResponse
+	select {
+	case <-ctx.Done():
+		l.removePendingEventAck(ackKey)
+		return nil, ctx.Err()
+	case ack := <-ackCh:
+		if ack.Err != nil {
+			return nil, ack.Err
+		}
+		ackResp = ack.Resp
+	}
+
+	waitAck := ackResp.GetWaitForAck()
+	if waitAck == nil || waitAck.GetRef() == nil {
+		return nil, fmt.Errorf("wait_for_signal ack missing ref for task %s invocation %d", taskExternalID, invocationCount)
+	}
+	ref := waitAck.GetRef()
+
+	return l.WaitForCallback(ctx, taskExternalID, invocationCount, ref.GetBranchId(), ref.GetNodeId())
+}
+
+// SetQueryHandler registers the handler which answers queries with the given name for an invocation of a task.
+// Handlers registered by an older invocation of the task are discarded.
+func (l *DurableTaskListener) SetQueryHandler(taskExternalID string, invocationCount int32, name string, handler QueryHandler) {
+	l.queryHandlersMu.Lock()
+	defer l.queryHandlersMu.Unlock()
+
+	set, ok := l.queryHandlers[taskExternalID]
+	if !ok || set.invocationCount < invocationCount {
+		set = &queryHandlerSet{
+			handlers:        make(map[string]QueryHandler),
+			invocationCount: invocationCount,
+		}
+		l.queryHandlers[taskExternalID] = set
+	}
+
+	if set.invocationCount > invocationCount {
+		return
+	}
+
+	set.handlers[name] = handler
+}
+
+// RemoveQueryHandlers removes the query handlers registered by an invocation of a task, once it has finished.
+func (l *DurableTaskListener) RemoveQueryHandlers(taskExternalID string, invocationCount int32) {
+	l.queryHandlersMu.Lock()
+	defer l.queryHandlersMu.Unlock()
+
+	if set, ok := l.queryHandlers[taskExternalID]; ok && set.invocationCount <= invocationCount {
+		delete(l.queryHandlers, taskExternalID)
+	}
+}
+
+// QueryHandlerCount returns the number of tasks with registered query handlers.
+func (l *DurableTaskListener) QueryHandlerCount() int {
+	l.queryHandlersMu.RLock()
+	defer l.queryHandlersMu.RUnlock()
+	return len(l.queryHandlers)
+}
+
+// handleQuery runs the query handler registered by the task and sends its result back to the engine.
+func (l *DurableTaskListener) handleQuery(query *v1.DurableTaskQueryRequest) {
+	result := &v1.DurableTaskQueryResultRequest{
+		QueryId:               query.GetQueryId(),
+		DurableTaskExternalId: query.GetDurableTaskExternalId(),
+	}
+
+	l.queryHandlersMu.RLock()
+	var handler QueryHandler
+	if set, ok := l.queryHandlers[query.GetDurableTaskExternalId()]; ok {
+		handler = set.handlers[query.GetQueryName()]
+	}
+	l.queryHandlersMu.RUnlock()
+
+	if handler == nil {
+		errMsg := fmt.Sprintf("task has no handler for query %s", query.GetQueryName())
+		result.ErrorMessage = &errMsg
+	} else if payload, err := handler(query.GetInput()); err != nil {
+		errMsg := err.Error()
+		result.ErrorMessage = &errMsg
+	} else {
+		result.Payload = payload
+	}
+
+	l.SendRequest(&v1.DurableTaskRequest{
+		Message: &v1.DurableTaskRequest_QueryResult{
+			QueryResult: result,
+		},
+	})
+}
+
 // MemoAckResult is what SendMemoRequest returns on a successful ack: the server-assigned
 // log entry ref, whether a memo already existed, and (if it did) the cached payload.
 type MemoAckResult struct {
@@ -690,6 +824,45 @@ func (l *DurableTaskListener) SendMemoCompleted(
 	})
 }
 
+// SendPatchRequest resolves a patch marker over the bidi DurableTask stream and blocks until the engine acks with
+// whether the task should take the patched code path.
+func (l *DurableTaskListener) SendPatchRequest(
+	ctx context.Context,
+	taskExternalID string,
+	invocationCount int32,
+	patchID string,
+	deprecated bool,
+) (bool, error) {
+	ackKey := PendingAckKey{TaskID: taskExternalID, SignalKey: int64(invocationCount)}
+	ackCh := l.AddPendingEventAck(ackKey)
+
+	l.SendRequest(&v1.DurableTaskRequest{
+		Message: &v1.DurableTaskRequest_Patch{
+			Patch: &v1.DurableTaskPatchRequest{
+				InvocationCount:       invocationCount,
+				DurableTaskExternalId: taskExternalID,
+				PatchId:               patchID,
+				Deprecated:            deprecated,
+			},
+		},
+	})
+
+	select {
+	case <-ctx.Done():
+		l.removePendingEventAck(ackKey)
+		return false, ctx.Err()
+	case ack := <-ackCh:
+		if ack.Err != nil {
+			return false, ack.Err
+		}
+		patchAck := ack.Resp.GetPatchAck()
+		if patchAck == nil {
+			return false, fmt.Errorf("missing patch ack for task %s invocation %d", taskExternalID, invocationCount)
+		}
+		return patchAck.GetPatched(), nil
+	}
+}
+
 func (l *DurableTaskListener) dispatchResponse(resp *v1.DurableTaskResponse) {
 	switch msg := resp.GetMessage().(type) {
 	case *v1.DurableTaskResponse_RegisterWorker:
@@ -730,6 +903,13 @@ func (l *DurableTaskListener) dispatchResponse(resp *v1.DurableTaskResponse) {
 			SignalKey: int64(waitAck.GetRef().GetInvocationCount()),
 		}
 		l.deliverEventAck(key, resp)
+	case *v1.DurableTaskResponse_PatchAck:
+		patchAck := msg.PatchAck
+		key := PendingAckKey{
+			TaskID:    patchAck.GetRef().GetDurableTaskExternalId(),
+			SignalKey: int64(patchAck.GetRef().GetInvocationCount()),
+		}
+		l.deliverEventAck(key, resp)
 	case *v1.DurableTaskResponse_EntryCompleted:
 		completed := msg.EntryCompleted
 		ref := completed.GetRef()
@@ -758,6 +938,9 @@ func (l *DurableTaskListener) dispatchResponse(resp *v1.DurableTaskResponse) {
 		l.bufferedCompletionsMu.Lock()
 		l.bufferedCompletions[key] = resp
 		l.bufferedCompletionsMu.Unlock()
+	case *v1.DurableTaskResponse_Query:
+		// handlers run off the receive loop, so a slow handler doesn't hold up other responses
+		go l.handleQuery(msg.Query)
 	case *v1.DurableTaskResponse_Error:
 		l.dispatchError(msg.Error)
 	case *v1.DurableTaskResponse_ServerEvict:
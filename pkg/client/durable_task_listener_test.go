@@ -491,3 +491,145 @@ func TestBufferedCompletionDeliveredToLateConsumer(t *testing.T) {
 
 	assert.Equal(t, 0, listener.BufferedCompletionCount())
 }
+
+func TestQueryAnsweredByRegisteredHandler(t *testing.T) {
+	l := zerolog.Nop()
+	listener := NewDurableTaskListener(
+		"test-worker",
+		func(ctx context.Context) (v1.V1Dispatcher_DurableTaskClient, error) { return nil, io.EOF },
+		&l,
+	)
+
+	listener.SetQueryHandler("task-q", 1, "progress", func(input []byte) ([]byte, error) {
+		return append([]byte("progress:"), input...), nil
+	})
+
+	listener.dispatchResponse(&v1.DurableTaskResponse{
+		Message: &v1.DurableTaskResponse_Query{
+			Query: &v1.DurableTaskQueryRequest{
+				QueryId:               "query-1",
+				DurableTaskExternalId: "task-q",
+				QueryName:             "progress",
+				Input:                 []byte("42"),
+			},
+		},
+	})
+
+	select {
+	case req := <-listener.requestQueue:
+		result := req.GetQueryResult()
+		require.NotNil(t, result)
+		assert.Equal(t, "query-1", result.GetQueryId())
+		assert.Equal(t, []byte("progress:42"), result.GetPayload())
+		assert.Nil(t, result.ErrorMessage)
+	case <-time.After(time.Second):
+		t.Fatal("expected query result to be sent")
+	}
+}
+
+func TestQueryWithoutHandlerReturnsError(t *testing.T) {
+	l := zerolog.Nop()
+	listener := NewDurableTaskListener(
+		"test-worker",
+		func(ctx context.Context) (v1.V1Dispatcher_DurableTaskClient, error) { return nil, io.EOF },
+		&l,
+	)
+
+	listener.SetQueryHandler("task-q", 1, "progress", func(input []byte) ([]byte, error) {
+		return nil, nil
+	})
+
+	listener.dispatchResponse(&v1.DurableTaskResponse{
+		Message: &v1.DurableTaskResponse_Query{
+			Query: &v1.DurableTaskQueryRequest{
+				QueryId:               "query-2",
+				DurableTaskExternalId: "task-q",
+				QueryName:             "status",
+			},
+		},
+	})
+
+	select {
+	case req := <-listener.requestQueue:
+		result := req.GetQueryResult()
+		require.NotNil(t, result)
+		require.NotNil(t, result.ErrorMessage)
+		assert.Contains(t, result.GetErrorMessage(), "status")
+	case <-time.After(time.Second):
+		t.Fatal("expected query error to be sent")
+	}
+}
+
+func TestQueryHandlersOfOlderInvocationAreReplaced(t *testing.T) {
+	l := zerolog.Nop()
+	listener := NewDurableTaskListener(
+		"test-worker",
+		func(ctx context.Context) (v1.V1Dispatcher_DurableTaskClient, error) { return nil, io.EOF },
+		&l,
+	)
+
+	listener.SetQueryHandler("task-q", 1, "progress", func(input []byte) ([]byte, error) { return nil, nil })
+	listener.SetQueryHandler("task-q", 2, "status", func(input []byte) ([]byte, error) { return nil, nil })
+
+	// the first invocation finishing after the second one started must not remove its handlers
+	listener.RemoveQueryHandlers("task-q", 1)
+	assert.Equal(t, 1, listener.QueryHandlerCount())
+
+	listener.RemoveQueryHandlers("task-q", 2)
+	assert.Equal(t, 0, listener.QueryHandlerCount())
+}
+
+func TestSendPatchRequestReturnsPatched(t *testing.T) {
+	l := zerolog.Nop()
+	listener := NewDurableTaskListener(
+		"test-worker",
+		func(ctx context.Context) (v1.V1Dispatcher_DurableTaskClient, error) { return nil, io.EOF },
+		&l,
+	)
+
+	type patchResult struct {
+		patched bool
+		err     error
+	}
+
+	resultCh := make(chan patchResult, 1)
+
+	go func() {
+		patched, err := listener.SendPatchRequest(context.Background(), "task-p", 3, "new-pricing", false)
+		resultCh <- patchResult{patched, err}
+	}()
+
+	select {
+	case req := <-listener.requestQueue:
+		patch := req.GetPatch()
+		require.NotNil(t, patch)
+		assert.Equal(t, "task-p", patch.GetDurableTaskExternalId())
+		assert.Equal(t, int32(3), patch.GetInvocationCount())
+		assert.Equal(t, "new-pricing", patch.GetPatchId())
+		assert.False(t, patch.GetDeprecated())
+	case <-time.After(time.Second):
+		t.Fatal("expected patch request to be sent")
+	}
+
+	listener.dispatchResponse(&v1.DurableTaskResponse{
+		Message: &v1.DurableTaskResponse_PatchAck{
+			PatchAck: &v1.DurableTaskEventPatchAckResponse{
+				Ref: &v1.DurableEventLogEntryRef{
+					DurableTaskExternalId: "task-p",
+					InvocationCount:       3,
+					NodeId:                2,
+					BranchId:              1,
+				},
+				Patched: true,
+			},
+		},
+	})
+
+	select {
+	case res := <-resultCh:
+		require.NoError(t, res.err)
+		assert.True(t, res.patched)
+	case <-time.After(time.Second):
+		t.Fatal("expected patch ack to be delivered")
+	}
+}
@@ -24,6 +24,43 @@ type Concurrency struct {
 	Expression    string                            `yaml:"expression,omitempty"`
 	MaxRuns       *int32                            `yaml:"maxRuns,omitempty"`
 	LimitStrategy *WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
+
+	// WeightExpression is a CEL expression over the concurrency key (available as `key`) and the
+	// `input` and `additional_metadata` of a run queued for the key, which returns the key's weight.
+	// Only used by the WEIGHTED_FAIR strategy on tasks.
+	WeightExpression *string `yaml:"weightExpression,omitempty"`
+}
+
+type RetryBackoffStrategy string
+
+const (
+	// RetryBackoffExponential waits min(backoff factor ^ retry count, max backoff seconds) between retries.
+	RetryBackoffExponential RetryBackoffStrategy = "EXPONENTIAL"
+
+	// RetryBackoffFullJitter waits a random duration between zero and the exponential delay.
+	RetryBackoffFullJitter RetryBackoffStrategy = "FULL_JITTER"
+
+	// RetryBackoffDecorrelatedJitter waits a random duration between the base delay and three times the
+	// previous delay, capped at the max backoff seconds.
+	RetryBackoffDecorrelatedJitter RetryBackoffStrategy = "DECORRELATED_JITTER"
+
+	// RetryBackoffFixed waits the base delay between every retry.
+	RetryBackoffFixed RetryBackoffStrategy = "FIXED"
+)
+
+// RetryRule overrides the retry policy of a task when it fails with a matching error type.
+type RetryRule struct {
+	// ErrorType is matched against the error type reported by the worker when the task fails.
+	ErrorType string `yaml:"errorType"`
+
+	// MaxRetries is the number of retries for this error type. Defaults to the retries of the task.
+	MaxRetries *int32 `yaml:"maxRetries,omitempty"`
+
+	// BackoffStrategy is the backoff strategy for this error type. Defaults to the strategy of the task.
+	BackoffStrategy *RetryBackoffStrategy `yaml:"backoffStrategy,omitempty"`
+
+	// BackoffBaseSeconds is the base delay for this error type. Defaults to the base delay of the task.
+	BackoffBaseSeconds *float32 `yaml:"backoffBaseSeconds,omitempty"`
 }
 
 // Deprecated: Workflow is part of the legacy v0 workflow definition system.
@@ -56,6 +93,7 @@ const (
 	GroupRoundRobin  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
 	DropNewest       WorkflowConcurrencyLimitStrategy = "DROP_NEWEST"
 	QueueNewest      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	WeightedFair     WorkflowConcurrencyLimitStrategy = "WEIGHTED_FAIR"
 )
 
 type WorkflowConcurrency struct {
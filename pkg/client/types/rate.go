@@ -12,7 +12,28 @@ const (
 	Year   RateLimitDuration = "year"
 )
 
+type RateLimitMode string
+
+const (
+	// FixedWindow resets the limit at the start of every window.
+	FixedWindow RateLimitMode = "FIXED_WINDOW"
+
+	// SlidingWindow applies the limit to any window-length interval, so usage doesn't burst at window
+	// boundaries.
+	SlidingWindow RateLimitMode = "SLIDING_WINDOW"
+
+	// TokenBucket refills the limit continuously over the window, up to the burst size.
+	TokenBucket RateLimitMode = "TOKEN_BUCKET"
+)
+
 type RateLimitOpts struct {
 	Max      int
 	Duration RateLimitDuration
+
+	// (optional) the refill mode, defaults to FixedWindow
+	Mode RateLimitMode `validate:"omitempty,oneof=FIXED_WINDOW SLIDING_WINDOW TOKEN_BUCKET"`
+
+	// (optional) the maximum number of units which can be used in a burst, only valid for TokenBucket.
+	// Defaults to Max.
+	Burst *int `validate:"omitnil,min=1"`
 }
@@ -21,8 +21,9 @@ import (
 )
 
 type GRPCClient struct {
-	l     *zerolog.Logger
-	admin admincontracts.AdminServiceClient
+	l          *zerolog.Logger
+	admin      admincontracts.AdminServiceClient
+	dispatcher admincontracts.V1DispatcherClient
 }
 
 type clientOpts struct {
@@ -145,8 +146,9 @@ func NewGRPCClient(fs ...GRPCClientOpt) (*GRPCClient, error) {
 	}
 
 	return &GRPCClient{
-		l:     opts.l,
-		admin: admincontracts.NewAdminServiceClient(conn),
+		l:          opts.l,
+		admin:      admincontracts.NewAdminServiceClient(conn),
+		dispatcher: admincontracts.NewV1DispatcherClient(conn),
 	}, nil
 }
 
@@ -154,6 +156,10 @@ func (c *GRPCClient) Admin() admincontracts.AdminServiceClient {
 	return c.admin
 }
 
+func (c *GRPCClient) Dispatcher() admincontracts.V1DispatcherClient {
+	return c.dispatcher
+}
+
 func AuthContext(ctx context.Context, token string) context.Context {
 	md := grpcMetadata.New(map[string]string{
 		"authorization": "Bearer " + token,
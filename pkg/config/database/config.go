@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/archive"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
@@ -78,6 +79,9 @@ type Layer struct {
 
 	V1 v1.Repository
 
+	// Archiver archives expired partitions before they're dropped, it's nil if archiving is disabled
+	Archiver *archive.Archiver
+
 	Seed SeedConfigFile
 }
 
@@ -1,10 +1,18 @@
 package limits
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type LimitConfigFile struct {
 	DefaultTenantRetentionPeriod string `mapstructure:"defaultTenantRetentionPeriod" json:"defaultTenantRetentionPeriod,omitempty" default:"720h"`
 
+	// MaxWorkflowRetentionPeriod is the longest retention period which can be set for a workflow. Partitions are kept
+	// for the longer of this and the default tenant retention period, so it defaults to the default tenant retention
+	// period.
+	MaxWorkflowRetentionPeriod string `mapstructure:"maxWorkflowRetentionPeriod" json:"maxWorkflowRetentionPeriod,omitempty"`
+
 	DefaultWorkflowRunLimit      int32         `mapstructure:"defaultWorkflowRunLimit" json:"defaultWorkflowRunLimit,omitempty" default:"2000"`
 	DefaultWorkflowRunAlarmLimit int32         `mapstructure:"defaultWorkflowRunAlarmLimit" json:"defaultWorkflowRunAlarmLimit,omitempty" default:"1600"`
 	DefaultWorkflowRunWindow     time.Duration `mapstructure:"defaultWorkflowRunWindow" json:"defaultWorkflowRunWindow,omitempty" default:"24h"`
@@ -32,3 +40,20 @@ type LimitConfigFile struct {
 	DefaultIncomingWebhookLimit      int32 `mapstructure:"defaultIncomingWebhookLimit" json:"defaultIncomingWebhookLimit,omitempty" default:"5"`
 	DefaultIncomingWebhookAlarmLimit int32 `mapstructure:"defaultIncomingWebhookAlarmLimit" json:"defaultIncomingWebhookALarmLimit,omitempty" default:"4"`
 }
+
+// GetMaxWorkflowRetentionPeriod returns the longest retention period which can be set for a workflow
+func (c *LimitConfigFile) GetMaxWorkflowRetentionPeriod() (time.Duration, error) {
+	period := c.MaxWorkflowRetentionPeriod
+
+	if period == "" {
+		period = c.DefaultTenantRetentionPeriod
+	}
+
+	d, err := time.ParseDuration(period)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not parse max workflow retention period %s: %w", period, err)
+	}
+
+	return d, nil
+}
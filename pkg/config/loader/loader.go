@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/exaring/otelpgx"
+	"github.com/google/uuid"
 	"github.com/hatchet-dev/pgoutbox"
 	pgxzero "github.com/jackc/pgx-zerolog"
 	"github.com/jackc/pgx/v5"
@@ -22,6 +23,8 @@ import (
 	"github.com/rs/zerolog"
 	"golang.org/x/oauth2"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
@@ -29,6 +32,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
 	"github.com/hatchet-dev/hatchet/pkg/auth/exchangetoken"
 	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/client"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
@@ -38,10 +42,12 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/errors"
 	"github.com/hatchet-dev/hatchet/pkg/errors/sentry"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/archive"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/email"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/email/postmark"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/email/smtp"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/payloadstore"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/debugger"
@@ -51,6 +57,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	natsmq "github.com/hatchet-dev/hatchet/internal/msgqueue/nats"
 	pgmq "github.com/hatchet-dev/hatchet/internal/msgqueue/postgres"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
 	clientv1 "github.com/hatchet-dev/hatchet/pkg/client/v1"
@@ -320,6 +327,10 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 		return nil, fmt.Errorf("could not parse retention period %s: %w", scf.Runtime.Limits.DefaultTenantRetentionPeriod, err)
 	}
 
+	if _, err := scf.Runtime.Limits.GetMaxWorkflowRetentionPeriod(); err != nil {
+		return nil, err
+	}
+
 	taskLimits := repov1.TaskOperationLimits{
 		TimeoutLimit:      scf.Runtime.TaskOperationLimits.TimeoutLimit,
 		ReassignLimit:     scf.Runtime.TaskOperationLimits.ReassignLimit,
@@ -371,16 +382,39 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 		v1.OLAP().SetReadReplicaPool(readReplicaPool)
 	}
 
+	externalStore, err := newPayloadExternalStore(&scf.PayloadStore.ExternalStore)
+
+	if err != nil {
+		cleanupV1() // nolint: errcheck
+		return nil, fmt.Errorf("could not create payload external store: %w", err)
+	}
+
+	if externalStore != nil {
+		v1.OverwriteExternalPayloadStore(externalStore)
+	}
+
+	archiver, err := newArchiver(&scf.Archive, &l)
+
+	if err != nil {
+		cleanupV1() // nolint: errcheck
+		return nil, fmt.Errorf("could not create archiver: %w", err)
+	}
+
+	if archiver != nil {
+		v1.SetPartitionArchiver(archiver)
+	}
+
 	return &database.Layer{
 		Disconnect: func() error {
 			ch.Stop()
 
 			return cleanupV1()
 		},
-		Pool:    pool,
-		DDLPool: ddlPool,
-		V1:      v1,
-		Seed:    cf.Seed,
+		Pool:     pool,
+		DDLPool:  ddlPool,
+		V1:       v1,
+		Archiver: archiver,
+		Seed:     cf.Seed,
 	}, nil
 }
 
@@ -489,6 +523,32 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 			cleanup1 = func() error {
 				return cleanupv1()
 			}
+		case "nats":
+			if cf.MessageQueue.NATS.URL == "" {
+				return nil, nil, fmt.Errorf("using NATS as message queue requires a URL to be set")
+			}
+
+			var cleanupv1 func() error
+
+			cleanupv1, mqv1, err = natsmq.New(
+				natsmq.WithURL(cf.MessageQueue.NATS.URL),
+				natsmq.WithLogger(&l),
+				natsmq.WithQos(cf.MessageQueue.NATS.Qos),
+				natsmq.WithSubjectPrefix(cf.MessageQueue.NATS.SubjectPrefix),
+				natsmq.WithReplicas(cf.MessageQueue.NATS.Replicas),
+				natsmq.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
+				natsmq.WithMessageRejection(cf.MessageQueue.NATS.EnableMessageRejection, cf.MessageQueue.NATS.MaxDeathCount),
+			)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not init nats: %w", err)
+			}
+
+			cleanup1 = func() error {
+				return cleanupv1()
+			}
+		default:
+			return nil, nil, fmt.Errorf("invalid message queue of type %s, must be 'rabbitmq', 'postgres' or 'nats'", cf.MessageQueue.Kind)
 		}
 
 		ing, err = ingestor.NewIngestor(
@@ -501,6 +561,12 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		}
 	}
 
+	mqMaxDeathCount := cf.MessageQueue.RabbitMQ.MaxDeathCount
+
+	if strings.EqualFold(cf.MessageQueue.Kind, "nats") {
+		mqMaxDeathCount = cf.MessageQueue.NATS.MaxDeathCount
+	}
+
 	var alerter errors.Alerter
 
 	if cf.Alerting.Sentry.Enabled {
@@ -632,6 +698,14 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		})
 	}
 
+	if cf.Auth.OIDC.Enabled {
+		auth.OIDCProvider, err = loadOIDCProvider(cf)
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	encryptionSvc, err := LoadEncryptionSvc(cf)
 
 	if err != nil {
@@ -650,6 +724,24 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		return nil, nil, fmt.Errorf("could not create JWT manager: %w", err)
 	}
 
+	spec, err := gen.GetSwagger()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load openapi spec: %w", err)
+	}
+
+	auth.ScopeAuthorizer, err = rbac.NewScopeAuthorizer(spec)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create scope authorizer: %w", err)
+	}
+
+	auth.RoleAuthorizer, err = authz.NewHatchetAuthorizer()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create role authorizer: %w", err)
+	}
+
 	if cf.Auth.ControlPlaneExchangeTokenConfig.Enabled {
 		if cf.Auth.ControlPlaneExchangeTokenConfig.JWTPublicKeyset == "" && cf.Auth.ControlPlaneExchangeTokenConfig.JWTPublicKeysetFile == "" {
 			return nil, nil, fmt.Errorf("control plane exchange token JWT public keyset is required when exchange token config is enabled (set jwtPublicKeyset or jwtPublicKeysetFile)")
@@ -847,7 +939,7 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		Operations:             cf.OLAP,
 		CronOperations:         cf.CronOperations,
 		OLAPStatusUpdates:      cf.OLAPStatusUpdates,
-		MQMaxDeathCount:        cf.MessageQueue.RabbitMQ.MaxDeathCount,
+		MQMaxDeathCount:        mqMaxDeathCount,
 	}, nil
 }
 
@@ -855,6 +947,59 @@ func getStrArr(v string) []string {
 	return strings.Split(v, " ")
 }
 
+func loadOIDCProvider(cf *server.ServerConfigFile) (*oauth.OIDCProvider, error) {
+	oidcConf := cf.Auth.OIDC
+
+	if oidcConf.IssuerURL == "" {
+		return nil, fmt.Errorf("oidc issuer url is required")
+	}
+
+	if oidcConf.ClientID == "" {
+		return nil, fmt.Errorf("oidc client id is required")
+	}
+
+	if oidcConf.ClientSecret == "" {
+		return nil, fmt.Errorf("oidc client secret is required")
+	}
+
+	groupRoles, err := oauth.ParseOIDCGroupRoles(oidcConf.GroupRoles)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse oidc group roles: %w", err)
+	}
+
+	var autoJoinTenantId *uuid.UUID
+
+	if oidcConf.AutoJoinTenantID != "" {
+		tenantId, err := uuid.Parse(oidcConf.AutoJoinTenantID)
+
+		if err != nil {
+			return nil, fmt.Errorf("oidc auto join tenant id is not a valid uuid: %w", err)
+		}
+
+		autoJoinTenantId = &tenantId
+	} else if len(groupRoles) > 0 || oidcConf.DefaultRole != "" {
+		return nil, fmt.Errorf("oidc auto join tenant id is required when group roles or a default role are set")
+	}
+
+	return oauth.NewOIDCProvider(&oauth.OIDCConfig{
+		Config: oauth.Config{
+			ClientID:     oidcConf.ClientID,
+			ClientSecret: oidcConf.ClientSecret,
+			BaseURL:      cf.Runtime.ServerURL,
+			Scopes:       oidcConf.Scopes,
+		},
+		IssuerURL:        oidcConf.IssuerURL,
+		EmailClaim:       oidcConf.EmailClaim,
+		NameClaim:        oidcConf.NameClaim,
+		GroupsClaim:      oidcConf.GroupsClaim,
+		AllowedDomains:   oidcConf.AllowedDomains,
+		AutoJoinTenantId: autoJoinTenantId,
+		GroupRoles:       groupRoles,
+		DefaultRole:      oidcConf.DefaultRole,
+	}), nil
+}
+
 func LoadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionService, error) {
 	var err error
 
@@ -1065,6 +1210,64 @@ func firstNWords(s string, n int) string {
 	return strings.ToUpper(strings.TrimSpace(s[:end]))
 }
 
+func newPayloadExternalStore(cf *server.PayloadExternalStoreConfigFile) (repov1.ExternalStore, error) {
+	switch strings.ToLower(cf.Kind) {
+	case "", "none":
+		return nil, nil
+	case "filesystem":
+		return payloadstore.NewFilesystemExternalStore(cf.Filesystem.Directory)
+	case "s3":
+		return payloadstore.NewS3ExternalStore(context.Background(), payloadstore.S3Opts{
+			Bucket:          cf.S3.Bucket,
+			Region:          cf.S3.Region,
+			Endpoint:        cf.S3.Endpoint,
+			AccessKeyID:     cf.S3.AccessKeyID,
+			SecretAccessKey: cf.S3.SecretAccessKey,
+			UsePathStyle:    cf.S3.UsePathStyle,
+		}, cf.S3.Prefix)
+	default:
+		return nil, fmt.Errorf("invalid payload external store of type %s, must be 'none', 'filesystem' or 's3'", cf.Kind)
+	}
+}
+
+func newArchiver(cf *server.ArchiveConfigFile, l *zerolog.Logger) (*archive.Archiver, error) {
+	var sink archive.Sink
+	var prefix string
+
+	switch strings.ToLower(cf.Kind) {
+	case "", "none":
+		return nil, nil
+	case "filesystem":
+		blobs, err := payloadstore.NewFilesystemBlobStore(cf.Filesystem.Directory)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sink = blobs
+	case "s3":
+		blobs, err := payloadstore.NewS3BlobStore(context.Background(), payloadstore.S3Opts{
+			Bucket:          cf.S3.Bucket,
+			Region:          cf.S3.Region,
+			Endpoint:        cf.S3.Endpoint,
+			AccessKeyID:     cf.S3.AccessKeyID,
+			SecretAccessKey: cf.S3.SecretAccessKey,
+			UsePathStyle:    cf.S3.UsePathStyle,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		sink = blobs
+		prefix = cf.S3.Prefix
+	default:
+		return nil, fmt.Errorf("invalid archive of type %s, must be 'none', 'filesystem' or 's3'", cf.Kind)
+	}
+
+	return archive.NewArchiver(sink, prefix, cf.PartitionTimeout, l), nil
+}
+
 func newConcurrencyOutbox(pool *pgxpool.Pool, l zerolog.Logger) (pgoutbox.Outbox, func(), error) {
 	ctx, cancel := context.WithCancel(context.Background()) // nolint:govet
 
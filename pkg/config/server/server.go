@@ -17,6 +17,8 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
 	"github.com/hatchet-dev/hatchet/pkg/auth/exchangetoken"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	client "github.com/hatchet-dev/hatchet/pkg/client/v1"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
@@ -84,6 +86,8 @@ type ServerConfigFile struct {
 
 	PayloadStore PayloadStoreConfig `mapstructure:"payloadStore" json:"payloadStore,omitempty"`
 
+	Archive ArchiveConfigFile `mapstructure:"archive" json:"archive,omitempty"`
+
 	CronOperations CronOperationsConfigFile `mapstructure:"cronOperations" json:"cronOperations,omitempty"`
 
 	OLAPStatusUpdates OLAPStatusUpdateConfigFile `mapstructure:"statusUpdates" json:"statusUpdates,omitempty"`
@@ -461,6 +465,8 @@ type ConfigFileAuth struct {
 
 	Github ConfigFileAuthGithub `mapstructure:"github" json:"github,omitempty"`
 
+	OIDC ConfigFileAuthOIDC `mapstructure:"oidc" json:"oidc,omitempty"`
+
 	ControlPlaneExchangeTokenConfig ConfigFileAuthControlPlaneExchangeToken `mapstructure:"controlPlaneExchangeToken" json:"controlPlaneExchangeToken,omitempty"`
 }
 
@@ -514,6 +520,44 @@ type ConfigFileAuthGithub struct {
 	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"read:user\", \"user:email\"]"`
 }
 
+type ConfigFileAuthOIDC struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Name is the name of the provider which is shown on the login page
+	Name string `mapstructure:"name" json:"name,omitempty" default:"OIDC"`
+
+	// IssuerURL is the URL of the issuer, which must serve a discovery document at /.well-known/openid-configuration
+	IssuerURL string `mapstructure:"issuerURL" json:"issuerURL,omitempty"`
+
+	ClientID     string   `mapstructure:"clientID" json:"clientID,omitempty"`
+	ClientSecret string   `mapstructure:"clientSecret" json:"clientSecret,omitempty"`
+	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"openid\", \"profile\", \"email\"]"`
+
+	// EmailClaim, NameClaim and GroupsClaim are the claims which contain the user's email, name and groups. Nested
+	// claims can be referenced with dots, for example realm_access.roles.
+	EmailClaim  string `mapstructure:"emailClaim" json:"emailClaim,omitempty" default:"email"`
+	NameClaim   string `mapstructure:"nameClaim" json:"nameClaim,omitempty" default:"name"`
+	GroupsClaim string `mapstructure:"groupsClaim" json:"groupsClaim,omitempty" default:"groups"`
+
+	// AllowedDomains restricts logins to emails in these domains
+	AllowedDomains []string `mapstructure:"allowedDomains" json:"allowedDomains,omitempty"`
+
+	// RequireEmailVerified rejects logins if the provider doesn't set the email_verified claim. Disable this for
+	// providers which don't return the claim, in which case emails are trusted as verified.
+	RequireEmailVerified bool `mapstructure:"requireEmailVerified" json:"requireEmailVerified,omitempty" default:"true"`
+
+	// AutoJoinTenantID is the id of a tenant which users are added to when they log in for the first time
+	AutoJoinTenantID string `mapstructure:"autoJoinTenantID" json:"autoJoinTenantID,omitempty"`
+
+	// GroupRoles maps groups to the role users are given when they're added to the tenant, in the form
+	// group=role. The role can be a built-in role or the name of a custom role, and the first matching group wins.
+	GroupRoles []string `mapstructure:"groupRoles" json:"groupRoles,omitempty"`
+
+	// DefaultRole is the role of users who aren't in any of the groups in GroupRoles. If empty, these users
+	// aren't added to the tenant.
+	DefaultRole string `mapstructure:"defaultRole" json:"defaultRole,omitempty"`
+}
+
 type ConfigFileAuthCookie struct {
 	Name     string `mapstructure:"name" json:"name,omitempty" default:"hatchet"`
 	Domain   string `mapstructure:"domain" json:"domain,omitempty"`
@@ -524,17 +568,34 @@ type ConfigFileAuthCookie struct {
 type MessageQueueConfigFile struct {
 	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"true"`
 
-	Kind string `mapstructure:"kind" json:"kind,omitempty" validate:"required,oneof=rabbitmq postgres" default:"rabbitmq"`
+	Kind string `mapstructure:"kind" json:"kind,omitempty" validate:"required,oneof=rabbitmq postgres nats" default:"rabbitmq"`
 
 	Postgres PostgresMQConfigFile `mapstructure:"postgres" json:"postgres,omitempty"`
 
 	RabbitMQ RabbitMQConfigFile `mapstructure:"rabbitmq" json:"rabbitmq,omitempty" validate:"required"`
+
+	NATS NATSMQConfigFile `mapstructure:"nats" json:"nats,omitempty"`
 }
 
 type PostgresMQConfigFile struct {
 	Qos int `mapstructure:"qos" json:"qos,omitempty" default:"100"`
 }
 
+type NATSMQConfigFile struct {
+	URL string `mapstructure:"url" json:"url,omitempty"`
+	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+
+	// SubjectPrefix is prepended to every subject and stream name, which allows multiple Hatchet
+	// deployments to share a NATS cluster.
+	SubjectPrefix string `mapstructure:"subjectPrefix" json:"subjectPrefix,omitempty" default:"hatchet"`
+
+	// Replicas is the number of replicas for each JetStream stream.
+	Replicas int `mapstructure:"replicas" json:"replicas,omitempty" default:"1"`
+
+	EnableMessageRejection bool `mapstructure:"enableMessageRejection" json:"enableMessageRejection,omitempty" default:"false"`
+	MaxDeathCount          int  `mapstructure:"maxDeathCount" json:"maxDeathCount,omitempty" default:"1000"`
+}
+
 type RabbitMQConfigFile struct {
 	URL                    string `mapstructure:"url" json:"url,omitempty" validate:"required"`
 	Qos                    int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
@@ -611,8 +672,16 @@ type AuthConfig struct {
 
 	GithubOAuthConfig *oauth2.Config
 
+	OIDCProvider *oauth.OIDCProvider
+
 	JWTManager token.JWTManager
 
+	// ScopeAuthorizer checks the scopes of API tokens against OpenAPI operations and gRPC methods
+	ScopeAuthorizer *rbac.ScopeAuthorizer
+
+	// RoleAuthorizer checks the permissions of built-in and custom tenant member roles against OpenAPI operations
+	RoleAuthorizer *rbac.Authorizer
+
 	ExchangeTokenClient exchangetoken.ExchangeTokenClient
 
 	CustomAuthenticator CustomAuthenticator
@@ -718,6 +787,80 @@ type PayloadStoreConfig struct {
 	ExternalCutoverNumConcurrentOffloads int32         `mapstructure:"externalCutoverNumConcurrentOffloads" json:"externalCutoverNumConcurrentOffloads,omitempty" default:"10"`
 	InlineStoreTTLDays                   int32         `mapstructure:"inlineStoreTTLDays" json:"inlineStoreTTLDays,omitempty" default:"2"`
 	EnableWindowSizeOptimization         bool          `mapstructure:"enableWindowSizeOptimization" json:"enableWindowSizeOptimization,omitempty" default:"true"`
+
+	// ExternalStore configures where payloads are offloaded once they are older than the inline store TTL.
+	ExternalStore PayloadExternalStoreConfigFile `mapstructure:"externalStore" json:"externalStore,omitempty"`
+}
+
+type PayloadExternalStoreConfigFile struct {
+	// Kind is the external store backend. One of "none", "filesystem" or "s3".
+	Kind string `mapstructure:"kind" json:"kind,omitempty" default:"none"`
+
+	Filesystem PayloadExternalStoreFilesystemConfigFile `mapstructure:"filesystem" json:"filesystem,omitempty"`
+
+	S3 PayloadExternalStoreS3ConfigFile `mapstructure:"s3" json:"s3,omitempty"`
+}
+
+type PayloadExternalStoreFilesystemConfigFile struct {
+	// Directory is the directory that index files are written to. It must be shared between all
+	// engine instances.
+	Directory string `mapstructure:"directory" json:"directory,omitempty"`
+}
+
+type PayloadExternalStoreS3ConfigFile struct {
+	Bucket string `mapstructure:"bucket" json:"bucket,omitempty"`
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	// Prefix is prepended to every object key written by the store.
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty" default:"payloads"`
+
+	// Endpoint overrides the S3 endpoint, for example to point at a MinIO deployment.
+	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
+
+	// AccessKeyID and SecretAccessKey are optional, the default AWS credential chain is used if unset.
+	AccessKeyID     string `mapstructure:"accessKeyId" json:"accessKeyId,omitempty"`
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+
+	// UsePathStyle should be enabled for most S3-compatible stores, such as MinIO.
+	UsePathStyle bool `mapstructure:"usePathStyle" json:"usePathStyle,omitempty" default:"false"`
+}
+
+// ArchiveConfigFile configures where expired task and OLAP partitions are archived before they're dropped by the
+// retention cleanup.
+type ArchiveConfigFile struct {
+	// Kind is the archive backend. One of "none", "filesystem" or "s3".
+	Kind string `mapstructure:"kind" json:"kind,omitempty" default:"none"`
+
+	Filesystem ArchiveFilesystemConfigFile `mapstructure:"filesystem" json:"filesystem,omitempty"`
+
+	S3 ArchiveS3ConfigFile `mapstructure:"s3" json:"s3,omitempty"`
+
+	// PartitionTimeout is how long archiving a single partition may take. A partition which isn't archived in time
+	// is kept, and archiving it is retried the next time partitions are cleaned up.
+	PartitionTimeout time.Duration `mapstructure:"partitionTimeout" json:"partitionTimeout,omitempty" default:"1h"`
+}
+
+type ArchiveFilesystemConfigFile struct {
+	// Directory is the directory that archives are written to. It must be shared between all engine instances.
+	Directory string `mapstructure:"directory" json:"directory,omitempty"`
+}
+
+type ArchiveS3ConfigFile struct {
+	Bucket string `mapstructure:"bucket" json:"bucket,omitempty"`
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	// Prefix is prepended to every object key written by the archiver.
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty" default:"archive"`
+
+	// Endpoint overrides the S3 endpoint, for example to point at a MinIO deployment.
+	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
+
+	// AccessKeyID and SecretAccessKey are optional, the default AWS credential chain is used if unset.
+	AccessKeyID     string `mapstructure:"accessKeyId" json:"accessKeyId,omitempty"`
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+
+	// UsePathStyle should be enabled for most S3-compatible stores, such as MinIO.
+	UsePathStyle bool `mapstructure:"usePathStyle" json:"usePathStyle,omitempty" default:"false"`
 }
 
 func (c *ServerConfig) HasService(name string) bool {
@@ -782,6 +925,7 @@ func BindAllEnv(v *viper.Viper) {
 
 	// limit options
 	_ = v.BindEnv("runtime.limits.defaultTenantRetentionPeriod", "SERVER_LIMITS_DEFAULT_TENANT_RETENTION_PERIOD")
+	_ = v.BindEnv("runtime.limits.maxWorkflowRetentionPeriod", "SERVER_LIMITS_MAX_WORKFLOW_RETENTION_PERIOD")
 
 	_ = v.BindEnv("runtime.limits.defaultTaskRunLimit", "SERVER_LIMITS_DEFAULT_TASK_RUN_LIMIT")
 	_ = v.BindEnv("runtime.limits.defaultTaskRunAlarmLimit", "SERVER_LIMITS_DEFAULT_TASK_RUN_ALARM_LIMIT")
@@ -863,6 +1007,20 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("auth.github.clientID", "SERVER_AUTH_GITHUB_CLIENT_ID")
 	_ = v.BindEnv("auth.github.clientSecret", "SERVER_AUTH_GITHUB_CLIENT_SECRET")
 	_ = v.BindEnv("auth.github.scopes", "SERVER_AUTH_GITHUB_SCOPES")
+	_ = v.BindEnv("auth.oidc.enabled", "SERVER_AUTH_OIDC_ENABLED")
+	_ = v.BindEnv("auth.oidc.name", "SERVER_AUTH_OIDC_NAME")
+	_ = v.BindEnv("auth.oidc.issuerURL", "SERVER_AUTH_OIDC_ISSUER_URL")
+	_ = v.BindEnv("auth.oidc.clientID", "SERVER_AUTH_OIDC_CLIENT_ID")
+	_ = v.BindEnv("auth.oidc.clientSecret", "SERVER_AUTH_OIDC_CLIENT_SECRET")
+	_ = v.BindEnv("auth.oidc.scopes", "SERVER_AUTH_OIDC_SCOPES")
+	_ = v.BindEnv("auth.oidc.emailClaim", "SERVER_AUTH_OIDC_EMAIL_CLAIM")
+	_ = v.BindEnv("auth.oidc.nameClaim", "SERVER_AUTH_OIDC_NAME_CLAIM")
+	_ = v.BindEnv("auth.oidc.groupsClaim", "SERVER_AUTH_OIDC_GROUPS_CLAIM")
+	_ = v.BindEnv("auth.oidc.allowedDomains", "SERVER_AUTH_OIDC_ALLOWED_DOMAINS")
+	_ = v.BindEnv("auth.oidc.requireEmailVerified", "SERVER_AUTH_OIDC_REQUIRE_EMAIL_VERIFIED")
+	_ = v.BindEnv("auth.oidc.autoJoinTenantID", "SERVER_AUTH_OIDC_AUTO_JOIN_TENANT_ID")
+	_ = v.BindEnv("auth.oidc.groupRoles", "SERVER_AUTH_OIDC_GROUP_ROLES")
+	_ = v.BindEnv("auth.oidc.defaultRole", "SERVER_AUTH_OIDC_DEFAULT_ROLE")
 
 	// task queue options
 	// legacy options
@@ -880,6 +1038,12 @@ func BindAllEnv(v *viper.Viper) {
 
 	// throughput options
 	_ = v.BindEnv("msgQueue.rabbitmq.qos", "SERVER_MSGQUEUE_RABBITMQ_QOS")
+	_ = v.BindEnv("msgQueue.nats.url", "SERVER_MSGQUEUE_NATS_URL")
+	_ = v.BindEnv("msgQueue.nats.qos", "SERVER_MSGQUEUE_NATS_QOS")
+	_ = v.BindEnv("msgQueue.nats.subjectPrefix", "SERVER_MSGQUEUE_NATS_SUBJECT_PREFIX")
+	_ = v.BindEnv("msgQueue.nats.replicas", "SERVER_MSGQUEUE_NATS_REPLICAS")
+	_ = v.BindEnv("msgQueue.nats.enableMessageRejection", "SERVER_MSGQUEUE_NATS_ENABLE_MESSAGE_REJECTION")
+	_ = v.BindEnv("msgQueue.nats.maxDeathCount", "SERVER_MSGQUEUE_NATS_MAX_DEATH_COUNT")
 	_ = v.BindEnv("runtime.requeueLimit", "SERVER_REQUEUE_LIMIT")
 	_ = v.BindEnv("runtime.singleQueueLimit", "SERVER_SINGLE_QUEUE_LIMIT")
 	_ = v.BindEnv("runtime.optimisticSchedulingEnabled", "SERVER_OPTIMISTIC_SCHEDULING_ENABLED")
@@ -1004,6 +1168,27 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("payloadStore.externalCutoverNumConcurrentOffloads", "SERVER_PAYLOAD_STORE_EXTERNAL_CUTOVER_NUM_CONCURRENT_OFFLOADS")
 	_ = v.BindEnv("payloadStore.inlineStoreTTLDays", "SERVER_PAYLOAD_STORE_INLINE_STORE_TTL_DAYS")
 	_ = v.BindEnv("payloadStore.enableWindowSizeOptimization", "SERVER_PAYLOAD_STORE_ENABLE_WINDOW_SIZE_OPTIMIZATION")
+	_ = v.BindEnv("payloadStore.externalStore.kind", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_KIND")
+	_ = v.BindEnv("payloadStore.externalStore.filesystem.directory", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_FILESYSTEM_DIRECTORY")
+	_ = v.BindEnv("payloadStore.externalStore.s3.bucket", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_BUCKET")
+	_ = v.BindEnv("payloadStore.externalStore.s3.region", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_REGION")
+	_ = v.BindEnv("payloadStore.externalStore.s3.prefix", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_PREFIX")
+	_ = v.BindEnv("payloadStore.externalStore.s3.endpoint", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_ENDPOINT")
+	_ = v.BindEnv("payloadStore.externalStore.s3.accessKeyId", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_ACCESS_KEY_ID")
+	_ = v.BindEnv("payloadStore.externalStore.s3.secretAccessKey", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("payloadStore.externalStore.s3.usePathStyle", "SERVER_PAYLOAD_STORE_EXTERNAL_STORE_S3_USE_PATH_STYLE")
+
+	// archive options
+	_ = v.BindEnv("archive.kind", "SERVER_ARCHIVE_KIND")
+	_ = v.BindEnv("archive.partitionTimeout", "SERVER_ARCHIVE_PARTITION_TIMEOUT")
+	_ = v.BindEnv("archive.filesystem.directory", "SERVER_ARCHIVE_FILESYSTEM_DIRECTORY")
+	_ = v.BindEnv("archive.s3.bucket", "SERVER_ARCHIVE_S3_BUCKET")
+	_ = v.BindEnv("archive.s3.region", "SERVER_ARCHIVE_S3_REGION")
+	_ = v.BindEnv("archive.s3.prefix", "SERVER_ARCHIVE_S3_PREFIX")
+	_ = v.BindEnv("archive.s3.endpoint", "SERVER_ARCHIVE_S3_ENDPOINT")
+	_ = v.BindEnv("archive.s3.accessKeyId", "SERVER_ARCHIVE_S3_ACCESS_KEY_ID")
+	_ = v.BindEnv("archive.s3.secretAccessKey", "SERVER_ARCHIVE_S3_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("archive.s3.usePathStyle", "SERVER_ARCHIVE_S3_USE_PATH_STYLE")
 
 	// cron operations options
 	_ = v.BindEnv("cronOperations.taskAnalyzeCronInterval", "SERVER_CRON_OPERATIONS_TASK_ANALYZE_CRON_INTERVAL")
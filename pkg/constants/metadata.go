@@ -3,15 +3,18 @@ package constants
 type MetadataKey string
 
 const (
-	CorrelationIdKey   MetadataKey = "correlationId"
-	ResourceIdKey      MetadataKey = "resourceId"
-	ResourceTypeKey    MetadataKey = "resourceType"
-	GRPCMethodKey      MetadataKey = "grpc_method"
-	EventIDKey         MetadataKey = "hatchet__event_id"
-	EventKeyKey        MetadataKey = "hatchet__event_key"
-	CronExpressionKey  MetadataKey = "hatchet__cron_expression"
-	CronNameKey        MetadataKey = "hatchet__cron_name"
-	CronScheduledAtKey MetadataKey = "hatchet__cron_scheduled_at"
+	CorrelationIdKey              MetadataKey = "correlationId"
+	ResourceIdKey                 MetadataKey = "resourceId"
+	ResourceTypeKey               MetadataKey = "resourceType"
+	GRPCMethodKey                 MetadataKey = "grpc_method"
+	EventIDKey                    MetadataKey = "hatchet__event_id"
+	EventKeyKey                   MetadataKey = "hatchet__event_key"
+	CronExpressionKey             MetadataKey = "hatchet__cron_expression"
+	CronNameKey                   MetadataKey = "hatchet__cron_name"
+	CronScheduledAtKey            MetadataKey = "hatchet__cron_scheduled_at"
+	DeadLetterSourceRunIdKey      MetadataKey = "hatchet__dead_letter_source_run_id"
+	DeadLetterSourceWorkflowIdKey MetadataKey = "hatchet__dead_letter_source_workflow_id"
+	ReplayedEventIdKey            MetadataKey = "hatchet__replayed_event_id"
 )
 
 func (k MetadataKey) String() string {
@@ -24,6 +27,7 @@ const (
 	ResourceTypeApiToken          ResourceTypeValue = "api-token"
 	ResourceTypeTenantMember      ResourceTypeValue = "tenant-member"
 	ResourceTypeTenantInvite      ResourceTypeValue = "tenant-invite"
+	ResourceTypeTenantRole        ResourceTypeValue = "tenant-role"
 	ResourceTypeWorkflow          ResourceTypeValue = "workflow"
 	ResourceTypeWorkflowRun       ResourceTypeValue = "workflow-run"
 	ResourceTypeScheduledWorkflow ResourceTypeValue = "scheduled-workflow"
@@ -0,0 +1,330 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/integrations/payloadstore"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// manifestVersion is written into every manifest so that the layout of archives can evolve without breaking the
+// rehydration of partitions which were archived by an older engine.
+const manifestVersion = 1
+
+const manifestFileName = "manifest.json.gz"
+
+// defaultMaxPartSize is the compressed size at which a part is flushed to the sink and a new part is started.
+const defaultMaxPartSize = 32 * 1024 * 1024
+
+// defaultBatchBlocks is the number of heap blocks of a partition which are read at a time, about 8 MB with the
+// default block size.
+const defaultBatchBlocks = 1024
+
+// dateLayout is the layout of the date directory which partitions are archived under.
+const dateLayout = "2006-01-02"
+
+// Sink is the object storage which archives are written to. Keys are slash-separated paths relative to the root
+// of the sink.
+type Sink interface {
+	payloadstore.BlobStore
+
+	// List returns the keys of every blob underneath prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Archiver implements repository.PartitionArchiver by exporting every row of an expired partition as
+// gzip-compressed NDJSON. Partitions are written to <prefix>/<YYYY-MM-DD>/<parent table>/<partition>/ as a series
+// of part files, followed by a manifest which lists the parts. A partition without a manifest was not fully archived.
+type Archiver struct {
+	sink        Sink
+	prefix      string
+	maxPartSize int
+	batchBlocks int64
+	timeout     time.Duration
+	l           *zerolog.Logger
+}
+
+// NewArchiver creates an archiver which writes to the sink. Archiving a single partition is canceled after timeout,
+// unless it's 0.
+func NewArchiver(sink Sink, prefix string, timeout time.Duration, l *zerolog.Logger) *Archiver {
+	return &Archiver{
+		sink:        sink,
+		prefix:      strings.Trim(prefix, "/"),
+		maxPartSize: defaultMaxPartSize,
+		batchBlocks: defaultBatchBlocks,
+		timeout:     timeout,
+		l:           l,
+	}
+}
+
+type manifest struct {
+	Version       int       `json:"version"`
+	ParentTable   string    `json:"parent_table"`
+	PartitionName string    `json:"partition_name"`
+	Date          string    `json:"date"`
+	Rows          int64     `json:"rows"`
+	Parts         []string  `json:"parts"`
+	ArchivedAt    time.Time `json:"archived_at"`
+}
+
+func (a *Archiver) ArchivePartition(ctx context.Context, db sqlcv1.DBTX, partition repository.ExpiredPartition) error {
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+
+	date, err := PartitionDate(partition.PartitionName)
+
+	if err != nil {
+		return err
+	}
+
+	dir := a.partitionDir(date, partition)
+	manifestKey := path.Join(dir, manifestFileName)
+
+	// expired partitions no longer receive writes, so a partition which was archived by a previous cleanup that
+	// failed to drop it doesn't need to be exported again
+	if _, err := a.sink.Get(ctx, manifestKey); err == nil {
+		a.l.Debug().Ctx(ctx).Msgf("partition %s was already archived", partition.PartitionName)
+		return nil
+	} else if !errors.Is(err, payloadstore.ErrBlobNotFound) {
+		return fmt.Errorf("could not check for existing manifest: %w", err)
+	}
+
+	var blocks int64
+
+	err = db.QueryRow(
+		ctx,
+		"SELECT pg_relation_size($1::text::regclass) / current_setting('block_size')::bigint",
+		partition.PartitionName,
+	).Scan(&blocks)
+
+	if err != nil {
+		return fmt.Errorf("could not get size of partition: %w", err)
+	}
+
+	w := newPartWriter(a.sink, dir, a.maxPartSize)
+
+	// the partition is read in batches of blocks, keyed by ctid, so that no single query has to read the whole
+	// partition. Since the partition no longer receives writes, the ctids of its rows don't change in between batches.
+	for start := int64(0); start < blocks; start += a.batchBlocks {
+		if err := a.archiveBlocks(ctx, db, partition, w, start, start+a.batchBlocks); err != nil {
+			return err
+		}
+	}
+
+	if err := w.close(ctx); err != nil {
+		return err
+	}
+
+	m := &manifest{
+		Version:       manifestVersion,
+		ParentTable:   partition.ParentTable,
+		PartitionName: partition.PartitionName,
+		Date:          date.Format(dateLayout),
+		Rows:          w.rows,
+		Parts:         w.parts,
+		ArchivedAt:    time.Now().UTC(),
+	}
+
+	data, err := encodeManifest(m)
+
+	if err != nil {
+		return err
+	}
+
+	// the manifest is written last, it marks the partition as completely archived
+	if err := a.sink.Put(ctx, manifestKey, data); err != nil {
+		return fmt.Errorf("could not write manifest: %w", err)
+	}
+
+	a.l.Info().Ctx(ctx).Msgf("archived %d rows from partition %s in %d parts", w.rows, partition.PartitionName, len(w.parts))
+
+	return nil
+}
+
+// archiveBlocks writes the rows of the partition in the blocks [start, end) to w.
+func (a *Archiver) archiveBlocks(ctx context.Context, db sqlcv1.DBTX, partition repository.ExpiredPartition, w *partWriter, start, end int64) error {
+	// the partition name comes from the pg_inherits catalog, so it's safe to interpolate, just like in the DETACH
+	// and DROP statements which follow archiving
+	rows, err := db.Query(
+		ctx,
+		fmt.Sprintf("SELECT row_to_json(t)::text FROM %s t WHERE t.ctid >= $1::text::tid AND t.ctid < $2::text::tid", partition.PartitionName),
+		fmt.Sprintf("(%d,0)", start),
+		fmt.Sprintf("(%d,0)", end),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not read partition: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var line string
+
+		if err := rows.Scan(&line); err != nil {
+			return fmt.Errorf("could not scan row: %w", err)
+		}
+
+		if err := w.writeRow(ctx, []byte(line)); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("could not read partition: %w", err)
+	}
+
+	return nil
+}
+
+func (a *Archiver) dateDir(date time.Time) string {
+	return path.Join(a.prefix, date.Format(dateLayout))
+}
+
+func (a *Archiver) partitionDir(date time.Time, partition repository.ExpiredPartition) string {
+	return path.Join(a.dateDir(date), partition.ParentTable, partition.PartitionName)
+}
+
+// PartitionDate returns the date of a partition from the _YYYYMMDD suffix of its name. For weekly partitions, this
+// is the first day of the week.
+func PartitionDate(partitionName string) (time.Time, error) {
+	i := strings.LastIndex(partitionName, "_")
+
+	if i == -1 {
+		return time.Time{}, fmt.Errorf("partition %s does not have a date suffix", partitionName)
+	}
+
+	date, err := time.Parse("20060102", partitionName[i+1:])
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("partition %s does not have a date suffix: %w", partitionName, err)
+	}
+
+	return date, nil
+}
+
+// partWriter buffers gzip-compressed NDJSON rows and writes them to the sink in parts of roughly maxPartSize bytes,
+// so that the memory used to archive a partition doesn't depend on its size.
+type partWriter struct {
+	sink        Sink
+	dir         string
+	maxPartSize int
+
+	buf bytes.Buffer
+	gz  *gzip.Writer
+
+	partRows int64
+	rows     int64
+	parts    []string
+}
+
+func newPartWriter(sink Sink, dir string, maxPartSize int) *partWriter {
+	w := &partWriter{
+		sink:        sink,
+		dir:         dir,
+		maxPartSize: maxPartSize,
+		parts:       make([]string, 0),
+	}
+
+	w.gz = gzip.NewWriter(&w.buf)
+
+	return w
+}
+
+func (w *partWriter) writeRow(ctx context.Context, line []byte) error {
+	if _, err := w.gz.Write(line); err != nil {
+		return fmt.Errorf("could not compress row: %w", err)
+	}
+
+	if _, err := w.gz.Write([]byte{'\n'}); err != nil {
+		return fmt.Errorf("could not compress row: %w", err)
+	}
+
+	w.partRows++
+	w.rows++
+
+	if w.buf.Len() >= w.maxPartSize {
+		return w.flush(ctx)
+	}
+
+	return nil
+}
+
+func (w *partWriter) flush(ctx context.Context) error {
+	if w.partRows == 0 {
+		return nil
+	}
+
+	if err := w.gz.Close(); err != nil {
+		return fmt.Errorf("could not compress part: %w", err)
+	}
+
+	key := path.Join(w.dir, fmt.Sprintf("part-%05d.ndjson.gz", len(w.parts)))
+
+	if err := w.sink.Put(ctx, key, w.buf.Bytes()); err != nil {
+		return fmt.Errorf("could not write part %s: %w", key, err)
+	}
+
+	w.parts = append(w.parts, key)
+	w.partRows = 0
+	w.buf.Reset()
+	w.gz.Reset(&w.buf)
+
+	return nil
+}
+
+func (w *partWriter) close(ctx context.Context) error {
+	return w.flush(ctx)
+}
+
+func encodeManifest(m *manifest) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+
+	if err := json.NewEncoder(gz).Encode(m); err != nil {
+		return nil, fmt.Errorf("could not encode manifest: %w", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("could not compress manifest: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decodeManifest(data []byte) (*manifest, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress manifest: %w", err)
+	}
+
+	defer gz.Close()
+
+	m := &manifest{}
+
+	if err := json.NewDecoder(gz).Decode(m); err != nil {
+		return nil, fmt.Errorf("could not decode manifest: %w", err)
+	}
+
+	if m.Version > manifestVersion {
+		return nil, fmt.Errorf("manifest version %d is not supported, upgrade hatchet to rehydrate this archive", m.Version)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,181 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/integrations/payloadstore"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestPartitionDate(t *testing.T) {
+	date, err := PartitionDate("v1_task_event_20260102")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), date)
+
+	_, err = PartitionDate("v1_task_event")
+	assert.Error(t, err)
+
+	_, err = PartitionDate("v1task")
+	assert.Error(t, err)
+}
+
+func TestPartWriterSplitsParts(t *testing.T) {
+	ctx := context.Background()
+
+	sink, err := payloadstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	// a tiny part size flushes a part after every row
+	w := newPartWriter(sink, "2026-01-02/v1_task/v1_task_20260102", 1)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.writeRow(ctx, []byte(fmt.Sprintf(`{"id":%d}`, i))))
+	}
+
+	require.NoError(t, w.close(ctx))
+
+	assert.Equal(t, int64(3), w.rows)
+	require.Len(t, w.parts, 3)
+	assert.Equal(t, "2026-01-02/v1_task/v1_task_20260102/part-00000.ndjson.gz", w.parts[0])
+
+	rows := make([]string, 0)
+
+	for _, key := range w.parts {
+		data, err := sink.Get(ctx, key)
+		require.NoError(t, err)
+
+		require.NoError(t, readPart(data, func(line []byte) error {
+			rows = append(rows, string(line))
+			return nil
+		}))
+	}
+
+	assert.Equal(t, []string{`{"id":0}`, `{"id":1}`, `{"id":2}`}, rows)
+}
+
+func TestListManifests(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+
+	sink, err := payloadstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	a := NewArchiver(sink, "archive", time.Hour, &l)
+	date := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, p := range []repository.ExpiredPartition{
+		{ParentTable: "v1_task", PartitionName: "v1_task_20260102"},
+		{ParentTable: "v1_dags_olap", PartitionName: "v1_dags_olap_20260102"},
+	} {
+		data, err := encodeManifest(&manifest{
+			Version:       manifestVersion,
+			ParentTable:   p.ParentTable,
+			PartitionName: p.PartitionName,
+			Date:          "2026-01-02",
+			Parts:         []string{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Put(ctx, a.partitionDir(date, p)+"/"+manifestFileName, data))
+	}
+
+	// partitions from other days and partitions without a manifest are not rehydrated
+	require.NoError(t, sink.Put(ctx, "archive/2026-01-03/v1_task/v1_task_20260103/manifest.json.gz", []byte{}))
+	require.NoError(t, sink.Put(ctx, "archive/2026-01-02/v1_log_line/v1_log_line_20260102/part-00000.ndjson.gz", []byte{}))
+
+	manifests, err := a.listManifests(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	assert.Equal(t, "v1_dags_olap_20260102", manifests[0].PartitionName)
+	assert.Equal(t, "v1_task_20260102", manifests[1].PartitionName)
+}
+
+// fakePartitionDB serves a partition of the given number of blocks with one row per batch, and records the block
+// ranges which are read.
+type fakePartitionDB struct {
+	sqlcv1.DBTX
+
+	blocks int64
+	ranges [][2]string
+}
+
+func (db *fakePartitionDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return fakeRow{blocks: db.blocks}
+}
+
+func (db *fakePartitionDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	db.ranges = append(db.ranges, [2]string{args[0].(string), args[1].(string)})
+
+	return &fakeRows{lines: []string{fmt.Sprintf(`{"range":%q}`, args[0])}}, nil
+}
+
+type fakeRow struct {
+	blocks int64
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	*dest[0].(*int64) = r.blocks
+	return nil
+}
+
+type fakeRows struct {
+	pgx.Rows
+
+	lines []string
+	line  string
+}
+
+func (r *fakeRows) Next() bool {
+	if len(r.lines) == 0 {
+		return false
+	}
+
+	r.line, r.lines = r.lines[0], r.lines[1:]
+
+	return true
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	*dest[0].(*string) = r.line
+	return nil
+}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+func (r *fakeRows) Close() {}
+
+func TestArchivePartitionReadsInBatches(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+
+	sink, err := payloadstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	a := NewArchiver(sink, "archive", time.Hour, &l)
+	a.batchBlocks = 2
+
+	db := &fakePartitionDB{blocks: 5}
+	partition := repository.ExpiredPartition{ParentTable: "v1_task", PartitionName: "v1_task_20260102"}
+
+	require.NoError(t, a.ArchivePartition(ctx, db, partition))
+
+	assert.Equal(t, [][2]string{{"(0,0)", "(2,0)"}, {"(2,0)", "(4,0)"}, {"(4,0)", "(6,0)"}}, db.ranges)
+
+	manifests, err := a.listManifests(ctx, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, int64(3), manifests[0].Rows)
+}
@@ -0,0 +1,261 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// rehydrateBatchSize is the number of archived rows which are inserted at a time
+const rehydrateBatchSize = 1000
+
+// maxArchivedRowSize is the maximum size of a single archived row, which bounds the memory used to read a part
+const maxArchivedRowSize = 64 * 1024 * 1024
+
+type RehydrateOpts struct {
+	// Date is the day to rehydrate
+	Date time.Time
+
+	// Schema is the schema which the archived tables are created in. It must not exist yet.
+	Schema string
+}
+
+type RehydratedTable struct {
+	Table      string
+	Partitions int
+	Rows       int64
+}
+
+// Rehydrate loads every partition which was archived for a day into a new read-only schema, with a table per
+// archived parent table. Tables are created like their counterparts in the current schema, and columns which no
+// longer exist are dropped from the archived rows.
+func (a *Archiver) Rehydrate(ctx context.Context, pool *pgxpool.Pool, opts RehydrateOpts) ([]*RehydratedTable, error) {
+	if opts.Schema == "" || strings.EqualFold(opts.Schema, "public") {
+		return nil, fmt.Errorf("a schema other than public is required")
+	}
+
+	manifests, err := a.listManifests(ctx, opts.Date)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("no partitions were archived on %s", opts.Date.Format(dateLayout))
+	}
+
+	tx, err := pool.Begin(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) // nolint: errcheck
+
+	if _, err := tx.Exec(ctx, "SET LOCAL statement_timeout = 0"); err != nil {
+		return nil, fmt.Errorf("could not disable statement timeout: %w", err)
+	}
+
+	schema := pgx.Identifier{opts.Schema}.Sanitize()
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf("CREATE SCHEMA %s", schema)); err != nil {
+		return nil, fmt.Errorf("could not create schema %s: %w", opts.Schema, err)
+	}
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE FUNCTION %s.reject_writes() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'rehydrated archive tables are read-only';
+END;
+$$`, schema)); err != nil {
+		return nil, fmt.Errorf("could not create read-only trigger function: %w", err)
+	}
+
+	tables := make(map[string]*RehydratedTable)
+	res := make([]*RehydratedTable, 0)
+
+	for _, m := range manifests {
+		t, ok := tables[m.ParentTable]
+
+		if !ok {
+			table := schema + "." + pgx.Identifier{m.ParentTable}.Sanitize()
+
+			if _, err := tx.Exec(ctx, fmt.Sprintf("CREATE TABLE %s (LIKE public.%s)", table, pgx.Identifier{m.ParentTable}.Sanitize())); err != nil {
+				return nil, fmt.Errorf("could not create table %s: %w", m.ParentTable, err)
+			}
+
+			t = &RehydratedTable{Table: m.ParentTable}
+			tables[m.ParentTable] = t
+			res = append(res, t)
+		}
+
+		rows, err := a.loadPartition(ctx, tx, opts.Schema, m)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not load partition %s: %w", m.PartitionName, err)
+		}
+
+		t.Partitions++
+		t.Rows += rows
+	}
+
+	for _, t := range res {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(
+			"CREATE TRIGGER reject_writes BEFORE INSERT OR UPDATE OR DELETE OR TRUNCATE ON %s.%s FOR EACH STATEMENT EXECUTE FUNCTION %s.reject_writes()",
+			schema,
+			pgx.Identifier{t.Table}.Sanitize(),
+			schema,
+		)); err != nil {
+			return nil, fmt.Errorf("could not make table %s read-only: %w", t.Table, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+func (a *Archiver) listManifests(ctx context.Context, date time.Time) ([]*manifest, error) {
+	keys, err := a.sink.List(ctx, a.dateDir(date)+"/")
+
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := make([]*manifest, 0)
+
+	for _, key := range keys {
+		if path.Base(key) != manifestFileName {
+			continue
+		}
+
+		data, err := a.sink.Get(ctx, key)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read manifest %s: %w", key, err)
+		}
+
+		m, err := decodeManifest(data)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read manifest %s: %w", key, err)
+		}
+
+		manifests = append(manifests, m)
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].PartitionName < manifests[j].PartitionName
+	})
+
+	return manifests, nil
+}
+
+func (a *Archiver) loadPartition(ctx context.Context, tx pgx.Tx, schemaName string, m *manifest) (int64, error) {
+	table := pgx.Identifier{schemaName, m.ParentTable}.Sanitize()
+
+	// jsonb_populate_recordset ignores keys which don't match a column, so archives remain loadable after columns
+	// are dropped, and columns which were added after the partition was archived are left null
+	query := fmt.Sprintf("INSERT INTO %s SELECT * FROM jsonb_populate_recordset(NULL::%s, $1::jsonb)", table, table)
+
+	var loaded int64
+
+	insert := func(batch []json.RawMessage) error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		data, err := json.Marshal(batch)
+
+		if err != nil {
+			return fmt.Errorf("could not encode rows: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, query, data); err != nil {
+			return fmt.Errorf("could not insert rows: %w", err)
+		}
+
+		loaded += int64(len(batch))
+
+		return nil
+	}
+
+	for _, key := range m.Parts {
+		data, err := a.sink.Get(ctx, key)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not read part %s: %w", key, err)
+		}
+
+		batch := make([]json.RawMessage, 0, rehydrateBatchSize)
+
+		err = readPart(data, func(line []byte) error {
+			batch = append(batch, json.RawMessage(bytes.Clone(line)))
+
+			if len(batch) < rehydrateBatchSize {
+				return nil
+			}
+
+			err := insert(batch)
+			batch = batch[:0]
+
+			return err
+		})
+
+		if err != nil {
+			return 0, fmt.Errorf("could not load part %s: %w", key, err)
+		}
+
+		if err := insert(batch); err != nil {
+			return 0, fmt.Errorf("could not load part %s: %w", key, err)
+		}
+	}
+
+	if loaded != m.Rows {
+		return 0, fmt.Errorf("expected %d rows but loaded %d", m.Rows, loaded)
+	}
+
+	return loaded, nil
+}
+
+// readPart calls fn for every row in a gzip-compressed NDJSON part
+func readPart(data []byte, fn func(line []byte) error) error {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		return fmt.Errorf("could not decompress part: %w", err)
+	}
+
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxArchivedRowSize)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		if err := fn(scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read part: %w", err)
+	}
+
+	return nil
+}
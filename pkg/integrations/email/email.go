@@ -12,6 +12,7 @@ const (
 	TokenAlertExpiringTemplate = "token-expiring" // nolint: gosec
 	ResourceLimitAlertTemplate = "resource-limit-alert"
 	OrganizationInviteTemplate = "organization-invite"
+	AlertRuleTemplate          = "alert-rule"
 )
 
 type TenantInviteEmailData struct {
@@ -52,6 +53,16 @@ type ResourceLimitAlertData struct {
 	SettingsLink string `json:"settings_link"`
 }
 
+type AlertRuleEmailData struct {
+	Subject      string `json:"subject"`
+	Summary      string `json:"summary"`
+	TenantName   string `json:"tenant_name"`
+	RuleName     string `json:"rule_name"`
+	WorkflowName string `json:"workflow_name"`
+	Link         string `json:"link"`
+	SettingsLink string `json:"settings_link"`
+}
+
 type SendEmailFromTemplateRequest struct {
 	TemplateModel interface{} `json:"TemplateModel"`
 	From          string      `json:"From"`
@@ -68,6 +79,7 @@ type EmailService interface {
 	SendWorkflowRunFailedAlerts(ctx context.Context, emails []string, data WorkflowRunsFailedEmailData) error
 	SendExpiringTokenEmail(ctx context.Context, emails []string, data ExpiringTokenEmailData) error
 	SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error
+	SendAlertRuleAlert(ctx context.Context, emails []string, data AlertRuleEmailData) error
 
 	// Used for extending the email provider for sending additional templated emails
 	SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error
@@ -96,6 +108,10 @@ func (s *NoOpService) SendTenantResourceLimitAlert(ctx context.Context, emails [
 	return nil
 }
 
+func (s *NoOpService) SendAlertRuleAlert(ctx context.Context, emails []string, data AlertRuleEmailData) error {
+	return nil
+}
+
 func (s *NoOpService) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	return nil
 }
@@ -57,6 +57,10 @@ func (c *PostmarkClient) SendTenantResourceLimitAlert(ctx context.Context, email
 	return c.SendTemplateEmailBCC(ctx, strings.Join(emails, ","), email.ResourceLimitAlertTemplate, data, true)
 }
 
+func (c *PostmarkClient) SendAlertRuleAlert(ctx context.Context, emails []string, data email.AlertRuleEmailData) error {
+	return c.SendTemplateEmailBCC(ctx, strings.Join(emails, ","), email.AlertRuleTemplate, data, false)
+}
+
 func (c *PostmarkClient) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	var bcc string
 
@@ -104,6 +104,15 @@ func (s *SMTPService) SendTenantResourceLimitAlert(ctx context.Context, emails [
 	})
 }
 
+func (s *SMTPService) SendAlertRuleAlert(ctx context.Context, emails []string, data email.AlertRuleEmailData) error {
+	return s.sendRequest(ctx, &email.SendEmailFromTemplateRequest{
+		From:          fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		Bcc:           strings.Join(emails, ","),
+		TemplateAlias: email.AlertRuleTemplate,
+		TemplateModel: data,
+	})
+}
+
 func (s *SMTPService) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	var bcc string
 
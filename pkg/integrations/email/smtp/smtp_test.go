@@ -110,6 +110,25 @@ func TestSMTPServiceSendMail(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:               "alert rule",
+			expectedTemplate:   templateRegistry[email.AlertRuleTemplate].bodyTmpl,
+			expectedSubject:    "[Acme Corp] Alert rule slow-orders is firing",
+			expectedRecipients: []string{"admin@example.com"},
+			templateData: email.AlertRuleEmailData{
+				TenantName:   "Acme Corp",
+				Subject:      "Alert rule slow-orders is firing",
+				Summary:      "The p95 duration of process-order runs is 2m30s over the last 1h, above the threshold of 1m0s",
+				RuleName:     "slow-orders",
+				WorkflowName: "process-order",
+				Link:         "https://app.example.com/workflows/1",
+			},
+			sendFunc: func(s *SMTPService, ctx context.Context, d interface{}) error {
+				return s.SendAlertRuleAlert(
+					ctx, []string{"admin@example.com"}, d.(email.AlertRuleEmailData),
+				)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,160 @@
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FilesystemBlobStore stores blobs as files underneath a root directory on the local filesystem.
+// It is intended for single-node deployments or deployments with a shared network volume.
+type FilesystemBlobStore struct {
+	root string
+}
+
+// NewFilesystemBlobStore creates a blob store rooted at dir, creating the directory if needed.
+func NewFilesystemBlobStore(dir string) (*FilesystemBlobStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("directory is required")
+	}
+
+	root, err := filepath.Abs(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve directory %s: %w", dir, err)
+	}
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("could not create directory %s: %w", root, err)
+	}
+
+	return &FilesystemBlobStore{root: root}, nil
+}
+
+// NewFilesystemExternalStore creates an external payload store which writes index files to dir.
+func NewFilesystemExternalStore(dir string) (*IndexFileExternalStore, error) {
+	blobs, err := NewFilesystemBlobStore(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndexFileExternalStore(blobs, ""), nil
+}
+
+func (s *FilesystemBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	p, err := s.resolve(key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return fmt.Errorf("could not create directory for %s: %w", key, err)
+	}
+
+	// write to a temporary file and rename so that readers never observe a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+
+	if err != nil {
+		return fmt.Errorf("could not create temporary file for %s: %w", key, err)
+	}
+
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() // nolint: errcheck
+		return fmt.Errorf("could not write %s: %w", key, err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close() // nolint: errcheck
+		return fmt.Errorf("could not sync %s: %w", key, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not close %s: %w", key, err)
+	}
+
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		return fmt.Errorf("could not rename %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	p, err := s.resolve(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p) // nolint: gosec
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// List returns the keys of every blob underneath prefix, in lexical order.
+func (s *FilesystemBlobStore) List(ctx context.Context, prefix string) ([]string, error) {
+	dir, err := s.resolve(prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0)
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, p)
+
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, filepath.ToSlash(rel))
+
+		return nil
+	})
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return keys, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", prefix, err)
+	}
+
+	return keys, nil
+}
+
+// resolve maps a key to a path within the root directory, rejecting keys which would escape it.
+func (s *FilesystemBlobStore) resolve(key string) (string, error) {
+	p := filepath.Join(s.root, filepath.FromSlash(key))
+
+	if p != s.root && !strings.HasPrefix(p, s.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid key %s", key)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,238 @@
+package payloadstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// ErrBlobNotFound is returned by a BlobStore when the requested key does not exist.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// indexFileVersion is written into every index file so that the encoding can evolve without
+// breaking payloads which were offloaded by an older engine.
+const indexFileVersion = 1
+
+// maxConcurrentIndexFileReads bounds the number of index files fetched in parallel for a single
+// Retrieve call.
+const maxConcurrentIndexFileReads = 10
+
+// BlobStore is the minimal object storage primitive that the built-in external stores are
+// built on. Keys are slash-separated paths relative to the root of the store.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns ErrBlobNotFound if the key does not exist.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+type indexFile struct {
+	Version int               `json:"version"`
+	Entries []*indexFileEntry `json:"entries"`
+}
+
+type indexFileEntry struct {
+	ExternalId uuid.UUID `json:"external_id"`
+	TenantId   uuid.UUID `json:"tenant_id"`
+	InsertedAt time.Time `json:"inserted_at"`
+	Payload    []byte    `json:"payload"`
+}
+
+// IndexFileExternalStore implements repository.ExternalStore on top of a BlobStore. Each call to
+// Store writes a single gzip-compressed index file which contains every payload in the batch, and
+// returns the key of that file so that the payload store can record it as an index block.
+type IndexFileExternalStore struct {
+	blobs  BlobStore
+	prefix string
+}
+
+// NewIndexFileExternalStore creates an external store which writes index files under prefix in
+// the given blob store.
+func NewIndexFileExternalStore(blobs BlobStore, prefix string) *IndexFileExternalStore {
+	return &IndexFileExternalStore{
+		blobs:  blobs,
+		prefix: prefix,
+	}
+}
+
+func (s *IndexFileExternalStore) Store(ctx context.Context, payloads ...repository.OffloadToExternalStoreOpts) (*repository.ExternalIndexFileLocationKey, error) {
+	if len(payloads) == 0 {
+		return nil, nil
+	}
+
+	f := &indexFile{
+		Version: indexFileVersion,
+		Entries: make([]*indexFileEntry, 0, len(payloads)),
+	}
+
+	for _, p := range payloads {
+		f.Entries = append(f.Entries, &indexFileEntry{
+			ExternalId: p.ExternalID,
+			TenantId:   p.TenantId,
+			InsertedAt: p.InsertedAt.Time.UTC(),
+			Payload:    p.Payload,
+		})
+	}
+
+	data, err := encodeIndexFile(f)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encode index file: %w", err)
+	}
+
+	// payloads in a batch are always read from a single partition, so we group index files by
+	// the partition date of the first payload to keep the layout browsable.
+	partitionDate := payloads[0].InsertedAt.Time.UTC().Format(time.DateOnly)
+
+	key := path.Join(s.prefix, "index", partitionDate, uuid.NewString()+".json.gz")
+
+	if err := s.blobs.Put(ctx, key, data); err != nil {
+		return nil, fmt.Errorf("could not write index file %s: %w", key, err)
+	}
+
+	res := repository.ExternalIndexFileLocationKey(key)
+
+	return &res, nil
+}
+
+func (s *IndexFileExternalStore) Retrieve(ctx context.Context, opts ...repository.RetrieveFromExternalOpts) (map[repository.RetrieveFromExternalOpts][]byte, error) {
+	res := make(map[repository.RetrieveFromExternalOpts][]byte, len(opts))
+
+	byKey := make([]repository.RetrieveFromExternalOpts, 0)
+	byIndexFile := make(map[repository.ExternalIndexFileLocationKey][]repository.RetrieveFromExternalOpts)
+
+	for _, opt := range opts {
+		switch opt.Method {
+		case repository.RetrieveFromExternalByKey:
+			if opt.ByKey == nil {
+				return nil, fmt.Errorf("retrieve by key requires a key")
+			}
+
+			byKey = append(byKey, opt)
+		case repository.RetrieveFromExternalByIndexFile:
+			if opt.ByIndexFile == nil {
+				return nil, fmt.Errorf("retrieve by index file requires an index file key")
+			}
+
+			byIndexFile[opt.ByIndexFile.IndexFileKey] = append(byIndexFile[opt.ByIndexFile.IndexFileKey], opt)
+		default:
+			return nil, fmt.Errorf("unsupported retrieve method %s", opt.Method.String())
+		}
+	}
+
+	for _, opt := range byKey {
+		data, err := s.blobs.Get(ctx, string(opt.ByKey.Key))
+
+		if errors.Is(err, ErrBlobNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read payload %s: %w", opt.ByKey.Key, err)
+		}
+
+		res[opt] = data
+	}
+
+	results := make(map[repository.ExternalIndexFileLocationKey]map[uuid.UUID][]byte, len(byIndexFile))
+	mu := sync.Mutex{}
+	eg := errgroup.Group{}
+	eg.SetLimit(maxConcurrentIndexFileReads)
+
+	for key := range byIndexFile {
+		eg.Go(func() error {
+			// index files are only recorded once they've been written, so a missing index file means that its
+			// payloads were lost. unlike a payload which isn't in its index file, this isn't reported as absent.
+			data, err := s.blobs.Get(ctx, string(key))
+
+			if err != nil {
+				return fmt.Errorf("could not read index file %s: %w", key, err)
+			}
+
+			f, err := decodeIndexFile(data)
+
+			if err != nil {
+				return fmt.Errorf("could not decode index file %s: %w", key, err)
+			}
+
+			payloads := make(map[uuid.UUID][]byte, len(f.Entries))
+
+			for _, e := range f.Entries {
+				payloads[e.ExternalId] = e.Payload
+			}
+
+			mu.Lock()
+			results[key] = payloads
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	for key, keyOpts := range byIndexFile {
+		payloads := results[key]
+
+		for _, opt := range keyOpts {
+			// index blocks cover a range of external ids, so a lookup can resolve to an index
+			// file which doesn't contain the payload. we treat this the same as a missing row.
+			if data, ok := payloads[opt.ByIndexFile.ExternalId]; ok {
+				res[opt] = data
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func encodeIndexFile(f *indexFile) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+
+	if err := json.NewEncoder(gw).Encode(f); err != nil {
+		return nil, err
+	}
+
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decodeIndexFile(data []byte) (*indexFile, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer gr.Close()
+
+	f := &indexFile{}
+
+	if err := json.NewDecoder(gr).Decode(f); err != nil {
+		return nil, err
+	}
+
+	if f.Version != indexFileVersion {
+		return nil, fmt.Errorf("unsupported index file version %d", f.Version)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,103 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package payloadstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestFilesystemExternalStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFilesystemExternalStore(t.TempDir())
+	require.NoError(t, err)
+
+	tenantId := uuid.New()
+	insertedAt := pgtype.Timestamptz{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}
+
+	payloads := []repository.OffloadToExternalStoreOpts{
+		{TenantId: tenantId, ExternalID: uuid.New(), InsertedAt: insertedAt, Payload: []byte(`{"a":1}`)},
+		{TenantId: tenantId, ExternalID: uuid.New(), InsertedAt: insertedAt, Payload: []byte(`{"b":2}`)},
+	}
+
+	key, err := store.Store(ctx, payloads...)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.Contains(t, string(*key), "2026-01-02")
+
+	opts := make([]repository.RetrieveFromExternalOpts, 0, len(payloads)+1)
+
+	for _, p := range payloads {
+		opts = append(opts, repository.RetrieveFromExternalOpts{
+			Method: repository.RetrieveFromExternalByIndexFile,
+			ByIndexFile: &repository.RetrieveFromExternalByIndexFileOpt{
+				IndexFileKey: *key,
+				ExternalId:   p.ExternalID,
+			},
+		})
+	}
+
+	// an external id that falls within the index block range but was never offloaded
+	missing := repository.RetrieveFromExternalOpts{
+		Method: repository.RetrieveFromExternalByIndexFile,
+		ByIndexFile: &repository.RetrieveFromExternalByIndexFileOpt{
+			IndexFileKey: *key,
+			ExternalId:   uuid.New(),
+		},
+	}
+
+	opts = append(opts, missing)
+
+	res, err := store.Retrieve(ctx, opts...)
+	require.NoError(t, err)
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, []byte(`{"a":1}`), res[opts[0]])
+	assert.Equal(t, []byte(`{"b":2}`), res[opts[1]])
+	assert.NotContains(t, res, missing)
+}
+
+func TestFilesystemExternalStoreEmptyBatch(t *testing.T) {
+	store, err := NewFilesystemExternalStore(t.TempDir())
+	require.NoError(t, err)
+
+	key, err := store.Store(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func TestFilesystemBlobStoreRejectsEscapingKeys(t *testing.T) {
+	blobs, err := NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	err = blobs.Put(context.Background(), "../outside", []byte("x"))
+	assert.Error(t, err)
+
+	_, err = blobs.Get(context.Background(), "does/not/exist")
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestIndexFileExternalStoreMissingIndexFile(t *testing.T) {
+	store, err := NewFilesystemExternalStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Retrieve(context.Background(), repository.RetrieveFromExternalOpts{
+		Method: repository.RetrieveFromExternalByIndexFile,
+		ByIndexFile: &repository.RetrieveFromExternalByIndexFileOpt{
+			IndexFileKey: repository.ExternalIndexFileLocationKey("index/2026-01-02/missing.json.gz"),
+			ExternalId:   uuid.New(),
+		},
+	})
+
+	// the payloads of a lost index file are reported as an error rather than as absent
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+}
@@ -0,0 +1,161 @@
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type S3Opts struct {
+	Bucket string
+	Region string
+
+	// Endpoint overrides the S3 endpoint, for example to point at a MinIO or R2 deployment.
+	Endpoint string
+
+	// AccessKeyID and SecretAccessKey are optional. If unset, the default AWS credential chain
+	// is used.
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// UsePathStyle addresses objects as <endpoint>/<bucket>/<key>, which most S3-compatible
+	// stores require.
+	UsePathStyle bool
+}
+
+// s3Client is the subset of the S3 API which S3BlobStore uses.
+type s3Client interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	s3.ListObjectsV2APIClient
+}
+
+// S3BlobStore stores blobs as objects in an S3-compatible bucket.
+type S3BlobStore struct {
+	client s3Client
+	bucket string
+}
+
+// NewS3BlobStore creates a blob store backed by an S3-compatible bucket.
+func NewS3BlobStore(ctx context.Context, opts S3Opts) (*S3BlobStore, error) {
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	loadOpts := []func(*config.LoadOptions) error{}
+
+	if opts.Region != "" {
+		loadOpts = append(loadOpts, config.WithRegion(opts.Region))
+	}
+
+	if opts.AccessKeyID != "" || opts.SecretAccessKey != "" {
+		loadOpts = append(loadOpts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(opts.AccessKeyID, opts.SecretAccessKey, ""),
+		))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load s3 config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if opts.Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.Endpoint)
+		}
+
+		o.UsePathStyle = opts.UsePathStyle
+	})
+
+	return &S3BlobStore{
+		client: client,
+		bucket: opts.Bucket,
+	}, nil
+}
+
+// NewS3ExternalStore creates an external payload store which writes index files under prefix in
+// an S3-compatible bucket.
+func NewS3ExternalStore(ctx context.Context, opts S3Opts, prefix string) (*IndexFileExternalStore, error) {
+	blobs, err := NewS3BlobStore(ctx, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndexFileExternalStore(blobs, prefix), nil
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/gzip"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not put object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrBlobNotFound
+		}
+
+		return nil, fmt.Errorf("could not get object %s: %w", key, err)
+	}
+
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read object %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// List returns the keys of every object underneath prefix, in lexical order.
+func (s *S3BlobStore) List(ctx context.Context, prefix string) ([]string, error) {
+	keys := make([]string, 0)
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list objects under %s: %w", prefix, err)
+		}
+
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,189 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// fakeS3Client is an in-memory bucket which lists at most pageSize keys per page.
+type fakeS3Client struct {
+	bucket   string
+	objects  map[string][]byte
+	pageSize int
+	getErr   error
+}
+
+func newFakeS3Client(bucket string) *fakeS3Client {
+	return &fakeS3Client{
+		bucket:   bucket,
+		objects:  make(map[string][]byte),
+		pageSize: 1000,
+	}
+}
+
+func (c *fakeS3Client) checkBucket(bucket *string) error {
+	if aws.ToString(bucket) != c.bucket {
+		return fmt.Errorf("operation error S3: %w", &types.NoSuchBucket{})
+	}
+
+	return nil
+}
+
+func (c *fakeS3Client) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	if err := c.checkBucket(params.Bucket); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(params.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.objects[aws.ToString(params.Key)] = data
+
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (c *fakeS3Client) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	if err := c.checkBucket(params.Bucket); err != nil {
+		return nil, err
+	}
+
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+
+	data, ok := c.objects[aws.ToString(params.Key)]
+
+	if !ok {
+		// the SDK wraps the API error in an operation error
+		return nil, fmt.Errorf("operation error S3: GetObject: %w", &types.NoSuchKey{})
+	}
+
+	return &s3.GetObjectOutput{
+		Body: io.NopCloser(strings.NewReader(string(data))),
+	}, nil
+}
+
+func (c *fakeS3Client) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	if err := c.checkBucket(params.Bucket); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0)
+
+	for key := range c.objects {
+		if strings.HasPrefix(key, aws.ToString(params.Prefix)) && key > aws.ToString(params.ContinuationToken) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	out := &s3.ListObjectsV2Output{}
+
+	if len(keys) > c.pageSize {
+		keys = keys[:c.pageSize]
+		out.IsTruncated = aws.Bool(true)
+		out.NextContinuationToken = aws.String(keys[len(keys)-1])
+	}
+
+	for _, key := range keys {
+		out.Contents = append(out.Contents, types.Object{Key: aws.String(key)})
+	}
+
+	return out, nil
+}
+
+func TestS3BlobStorePutGet(t *testing.T) {
+	ctx := context.Background()
+	blobs := &S3BlobStore{client: newFakeS3Client("payloads"), bucket: "payloads"}
+
+	require.NoError(t, blobs.Put(ctx, "index/2026-01-02/a.json.gz", []byte("a")))
+
+	data, err := blobs.Get(ctx, "index/2026-01-02/a.json.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), data)
+
+	_, err = blobs.Get(ctx, "index/2026-01-02/missing.json.gz")
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestS3BlobStoreGetError(t *testing.T) {
+	client := newFakeS3Client("payloads")
+	client.getErr = errors.New("connection reset")
+
+	blobs := &S3BlobStore{client: client, bucket: "payloads"}
+
+	_, err := blobs.Get(context.Background(), "index/2026-01-02/a.json.gz")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrBlobNotFound, "only missing objects are reported as not found")
+}
+
+func TestS3BlobStoreListPages(t *testing.T) {
+	ctx := context.Background()
+
+	client := newFakeS3Client("payloads")
+	client.pageSize = 2
+
+	blobs := &S3BlobStore{client: client, bucket: "payloads"}
+
+	for _, key := range []string{"archive/c", "archive/a", "archive/b", "index/d"} {
+		require.NoError(t, blobs.Put(ctx, key, []byte(key)))
+	}
+
+	keys, err := blobs.List(ctx, "archive/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archive/a", "archive/b", "archive/c"}, keys)
+}
+
+func TestS3ExternalStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	client := newFakeS3Client("payloads")
+	store := NewIndexFileExternalStore(&S3BlobStore{client: client, bucket: "payloads"}, "hatchet")
+
+	payload := repository.OffloadToExternalStoreOpts{
+		TenantId:   uuid.New(),
+		ExternalID: uuid.New(),
+		InsertedAt: pgtype.Timestamptz{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true},
+		Payload:    []byte(`{"a":1}`),
+	}
+
+	key, err := store.Store(ctx, payload)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.True(t, strings.HasPrefix(string(*key), "hatchet/index/2026-01-02/"))
+	assert.Contains(t, client.objects, string(*key))
+
+	opt := repository.RetrieveFromExternalOpts{
+		Method: repository.RetrieveFromExternalByIndexFile,
+		ByIndexFile: &repository.RetrieveFromExternalByIndexFileOpt{
+			IndexFileKey: *key,
+			ExternalId:   payload.ExternalID,
+		},
+	}
+
+	res, err := store.Retrieve(ctx, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"a":1}`), res[opt])
+}
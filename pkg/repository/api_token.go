@@ -25,6 +25,12 @@ type CreateAPITokenOpts struct {
 	Name *string `validate:"omitempty,max=255"`
 
 	Internal bool
+
+	// (optional) OpenAPI operation IDs or scope presets the token is restricted to
+	Scopes []string `validate:"omitempty,dive,required,max=255"`
+
+	// (optional) workflow names the token is restricted to
+	Workflows []string `validate:"omitempty,dive,required,max=255"`
 }
 
 type APITokenGenerator func(ctx context.Context, tenantId uuid.UUID, name string, internal bool, expires *time.Time) (string, error)
@@ -69,6 +75,8 @@ func (a *apiTokenRepository) CreateAPIToken(ctx context.Context, opts *CreateAPI
 		ID:        opts.ID,
 		Expiresat: sqlchelpers.TimestampFromTime(opts.ExpiresAt),
 		Internal:  sqlchelpers.BoolFromBoolean(opts.Internal),
+		Scopes:    opts.Scopes,
+		Workflows: opts.Workflows,
 	}
 
 	if opts.TenantId != nil {
@@ -0,0 +1,413 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const (
+	// bulkOperationRunChunkSize is the maximum number of runs which are stored per insert when an operation is started
+	bulkOperationRunChunkSize = 5000
+
+	// MaxBulkOperationRuns is the maximum number of runs which a single bulk operation applies to
+	MaxBulkOperationRuns = 100000
+)
+
+// BulkOperationFilter selects the workflow runs which a bulk operation applies to. It's stored on the operation,
+// and resolved into a list of runs when the operation starts.
+type BulkOperationFilter struct {
+	Since              time.Time                     `json:"since" validate:"required"`
+	Until              *time.Time                    `json:"until,omitempty"`
+	Statuses           []sqlcv1.V1ReadableStatusOlap `json:"statuses,omitempty"`
+	WorkflowIds        []uuid.UUID                   `json:"workflowIds,omitempty"`
+	AdditionalMetadata map[string]string             `json:"additionalMetadata,omitempty"`
+}
+
+// ToListWorkflowRunOpts converts the filter into the options which are used to list the matching workflow runs
+func (f *BulkOperationFilter) ToListWorkflowRunOpts() ListWorkflowRunOpts {
+	opts := ListWorkflowRunOpts{
+		CreatedAfter:   f.Since,
+		FinishedBefore: f.Until,
+		Statuses:       f.Statuses,
+		WorkflowIds:    f.WorkflowIds,
+	}
+
+	if len(f.AdditionalMetadata) > 0 {
+		opts.AdditionalMetadata = make(map[string]interface{}, len(f.AdditionalMetadata))
+
+		for k, v := range f.AdditionalMetadata {
+			opts.AdditionalMetadata[k] = v
+		}
+	}
+
+	return opts
+}
+
+// ParseBulkOperationFilter decodes the filter which is stored on a bulk operation
+func ParseBulkOperationFilter(op *sqlcv1.V1BulkOperation) (*BulkOperationFilter, error) {
+	var filter BulkOperationFilter
+
+	if err := json.Unmarshal(op.Filter, &filter); err != nil {
+		return nil, fmt.Errorf("could not unmarshal bulk operation filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+// EventReplayFilter selects the events which a REPLAY_EVENTS operation replays, and the rate at which they're
+// replayed. It's stored on the operation in place of a BulkOperationFilter.
+type EventReplayFilter struct {
+	Since time.Time `json:"since" validate:"required"`
+
+	// Until is set to the time the operation was created if the request doesn't set an earlier time, so replayed
+	// events never match the filter of the operation which replayed them
+	Until              time.Time         `json:"until" validate:"required"`
+	KeyPattern         *string           `json:"keyPattern,omitempty" validate:"omitnil,min=1"`
+	Scopes             []string          `json:"scopes,omitempty"`
+	AdditionalMetadata map[string]string `json:"additionalMetadata,omitempty"`
+	EventsPerSecond    int               `json:"eventsPerSecond" validate:"min=1,max=1000"`
+}
+
+// ToListEventsParams converts the filter into the parameters which are used to list the matching events
+func (f *EventReplayFilter) ToListEventsParams(tenantId uuid.UUID) (sqlcv1.ListEventsParams, error) {
+	opts := sqlcv1.ListEventsParams{
+		Tenantid: tenantId,
+		Since:    sqlchelpers.TimestamptzFromTime(f.Since),
+		Until:    sqlchelpers.TimestamptzFromTime(f.Until),
+		Scopes:   f.Scopes,
+	}
+
+	if f.KeyPattern != nil {
+		opts.KeyPattern = pgtype.Text{
+			String: EventKeyGlobToLikePattern(*f.KeyPattern),
+			Valid:  true,
+		}
+	}
+
+	if len(f.AdditionalMetadata) > 0 {
+		additionalMetadata, err := json.Marshal(f.AdditionalMetadata)
+
+		if err != nil {
+			return sqlcv1.ListEventsParams{}, fmt.Errorf("could not marshal additional metadata: %w", err)
+		}
+
+		opts.AdditionalMetadata = additionalMetadata
+	}
+
+	return opts, nil
+}
+
+// ParseEventReplayFilter decodes the filter which is stored on a REPLAY_EVENTS operation
+func ParseEventReplayFilter(op *sqlcv1.V1BulkOperation) (*EventReplayFilter, error) {
+	var filter EventReplayFilter
+
+	if err := json.Unmarshal(op.Filter, &filter); err != nil {
+		return nil, fmt.Errorf("could not unmarshal event replay filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+type CreateBulkOperationOpts struct {
+	// (required) whether the matching runs are cancelled or replayed, or the matching events are replayed
+	Kind sqlcv1.V1BulkOperationKind `validate:"required,oneof=CANCEL REPLAY REPLAY_EVENTS"`
+
+	// (required for CANCEL and REPLAY) the filter which selects the runs
+	Filter *BulkOperationFilter `validate:"required_unless=Kind REPLAY_EVENTS,excluded_if=Kind REPLAY_EVENTS"`
+
+	// (required for REPLAY_EVENTS) the filter which selects the events
+	EventFilter *EventReplayFilter `validate:"required_if=Kind REPLAY_EVENTS,excluded_unless=Kind REPLAY_EVENTS"`
+}
+
+type ListBulkOperationsOpts struct {
+	// (optional) number of operations to skip
+	Offset *int `validate:"omitempty,min=0"`
+
+	// (optional) number of operations to return
+	Limit *int `validate:"omitempty,min=1,max=100"`
+}
+
+type BulkOperationRepository interface {
+	// CreateBulkOperation stores a pending bulk operation, which is picked up by the task controller
+	CreateBulkOperation(ctx context.Context, tenantId uuid.UUID, opts *CreateBulkOperationOpts) (*sqlcv1.V1BulkOperation, error)
+
+	// GetBulkOperationById returns the bulk operation with the given id
+	GetBulkOperationById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error)
+
+	// ListBulkOperations returns the bulk operations of the tenant, most recent first, along with the total count
+	ListBulkOperations(ctx context.Context, tenantId uuid.UUID, opts *ListBulkOperationsOpts) ([]*sqlcv1.V1BulkOperation, int64, error)
+
+	// GetNextBulkOperation returns the oldest pending or running bulk operation of the tenant, or nil if there is none.
+	// Event replays and run operations are separate queues, which are selected with eventReplays.
+	GetNextBulkOperation(ctx context.Context, tenantId uuid.UUID, eventReplays bool) (*sqlcv1.V1BulkOperation, error)
+
+	// StartBulkOperation stores the runs which the operation applies to and marks it as running
+	StartBulkOperation(ctx context.Context, id uuid.UUID, workflowRunExternalIds []uuid.UUID) (*sqlcv1.V1BulkOperation, error)
+
+	// StartEventReplayOperation marks a REPLAY_EVENTS operation as running with the number of matching events. The
+	// events are read from the filter as they're replayed, so they aren't stored with the operation.
+	StartEventReplayOperation(ctx context.Context, id uuid.UUID, totalCount int32) (*sqlcv1.V1BulkOperation, error)
+
+	// ListBulkOperationRuns returns up to limit runs which the operation has yet to process
+	ListBulkOperationRuns(ctx context.Context, id uuid.UUID, limit int) ([]uuid.UUID, error)
+
+	// CompleteBulkOperationBatch removes the processed runs from the operation and increments its progress. It
+	// returns nil if the operation is no longer running, for instance because it was cancelled.
+	CompleteBulkOperationBatch(ctx context.Context, id uuid.UUID, workflowRunExternalIds []uuid.UUID) (*sqlcv1.V1BulkOperation, error)
+
+	// CompleteEventReplayBatch increments the progress of a REPLAY_EVENTS operation. It returns nil if the operation
+	// is no longer running, for instance because it was cancelled.
+	CompleteEventReplayBatch(ctx context.Context, id uuid.UUID, count int32) (*sqlcv1.V1BulkOperation, error)
+
+	// FinishBulkOperation marks a pending or running operation as completed
+	FinishBulkOperation(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error)
+
+	// CancelBulkOperation marks a pending or running operation as cancelled, and removes its remaining runs. Runs
+	// which have already been processed are not affected.
+	CancelBulkOperation(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error)
+}
+
+type bulkOperationRepository struct {
+	*sharedRepository
+}
+
+func newBulkOperationRepository(shared *sharedRepository) BulkOperationRepository {
+	return &bulkOperationRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *bulkOperationRepository) CreateBulkOperation(ctx context.Context, tenantId uuid.UUID, opts *CreateBulkOperationOpts) (*sqlcv1.V1BulkOperation, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var filter []byte
+	var err error
+
+	if opts.EventFilter != nil {
+		filter, err = json.Marshal(opts.EventFilter)
+	} else {
+		filter, err = json.Marshal(opts.Filter)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal bulk operation filter: %w", err)
+	}
+
+	return r.queries.CreateBulkOperation(ctx, r.pool, sqlcv1.CreateBulkOperationParams{
+		Tenantid: tenantId,
+		Kind:     opts.Kind,
+		Filter:   filter,
+	})
+}
+
+func (r *bulkOperationRepository) GetBulkOperationById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error) {
+	return r.queries.GetBulkOperationById(ctx, r.pool, id)
+}
+
+func (r *bulkOperationRepository) ListBulkOperations(ctx context.Context, tenantId uuid.UUID, opts *ListBulkOperationsOpts) ([]*sqlcv1.V1BulkOperation, int64, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, 0, err
+	}
+
+	params := sqlcv1.ListBulkOperationsParams{
+		Tenantid:        tenantId,
+		Operationoffset: 0,
+		Operationlimit:  50,
+	}
+
+	if opts.Offset != nil {
+		params.Operationoffset = int32(*opts.Offset) // nolint: gosec
+	}
+
+	if opts.Limit != nil {
+		params.Operationlimit = int32(*opts.Limit) // nolint: gosec
+	}
+
+	ops, err := r.queries.ListBulkOperations(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bulk operations: %w", err)
+	}
+
+	count, err := r.queries.CountBulkOperations(ctx, r.pool, tenantId)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count bulk operations: %w", err)
+	}
+
+	return ops, count, nil
+}
+
+func (r *bulkOperationRepository) GetNextBulkOperation(ctx context.Context, tenantId uuid.UUID, eventReplays bool) (*sqlcv1.V1BulkOperation, error) {
+	op, err := r.queries.GetNextBulkOperation(ctx, r.pool, sqlcv1.GetNextBulkOperationParams{
+		Tenantid:     tenantId,
+		Eventreplays: eventReplays,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return op, err
+}
+
+func (r *bulkOperationRepository) StartBulkOperation(ctx context.Context, id uuid.UUID, workflowRunExternalIds []uuid.UUID) (*sqlcv1.V1BulkOperation, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	for start := 0; start < len(workflowRunExternalIds); start += bulkOperationRunChunkSize {
+		end := min(start+bulkOperationRunChunkSize, len(workflowRunExternalIds))
+
+		err = r.queries.CreateBulkOperationRuns(ctx, tx, sqlcv1.CreateBulkOperationRunsParams{
+			Operationid:            id,
+			Workflowrunexternalids: workflowRunExternalIds[start:end],
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bulk operation runs: %w", err)
+		}
+	}
+
+	op, err := r.queries.StartBulkOperation(ctx, tx, sqlcv1.StartBulkOperationParams{
+		Totalcount: int32(len(workflowRunExternalIds)), // nolint: gosec
+		ID:         id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start bulk operation: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func (r *bulkOperationRepository) StartEventReplayOperation(ctx context.Context, id uuid.UUID, totalCount int32) (*sqlcv1.V1BulkOperation, error) {
+	op, err := r.queries.StartBulkOperation(ctx, r.pool, sqlcv1.StartBulkOperationParams{
+		Totalcount: totalCount,
+		ID:         id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start event replay operation: %w", err)
+	}
+
+	return op, nil
+}
+
+func (r *bulkOperationRepository) ListBulkOperationRuns(ctx context.Context, id uuid.UUID, limit int) ([]uuid.UUID, error) {
+	return r.queries.ListBulkOperationRuns(ctx, r.pool, sqlcv1.ListBulkOperationRunsParams{
+		Operationid: id,
+		Batchsize:   int32(limit), // nolint: gosec
+	})
+}
+
+func (r *bulkOperationRepository) CompleteBulkOperationBatch(ctx context.Context, id uuid.UUID, workflowRunExternalIds []uuid.UUID) (*sqlcv1.V1BulkOperation, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	err = r.queries.DeleteBulkOperationRuns(ctx, tx, sqlcv1.DeleteBulkOperationRunsParams{
+		Operationid:            id,
+		Workflowrunexternalids: workflowRunExternalIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete bulk operation runs: %w", err)
+	}
+
+	op, err := r.queries.UpdateBulkOperationProgress(ctx, tx, sqlcv1.UpdateBulkOperationProgressParams{
+		Processedcount: int32(len(workflowRunExternalIds)), // nolint: gosec
+		ID:             id,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bulk operation progress: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func (r *bulkOperationRepository) CompleteEventReplayBatch(ctx context.Context, id uuid.UUID, count int32) (*sqlcv1.V1BulkOperation, error) {
+	op, err := r.queries.UpdateBulkOperationProgress(ctx, r.pool, sqlcv1.UpdateBulkOperationProgressParams{
+		Processedcount: count,
+		ID:             id,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event replay operation progress: %w", err)
+	}
+
+	return op, nil
+}
+
+func (r *bulkOperationRepository) FinishBulkOperation(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error) {
+	return r.queries.FinishBulkOperation(ctx, r.pool, sqlcv1.FinishBulkOperationParams{
+		Status: sqlcv1.V1BulkOperationStatusCOMPLETED,
+		ID:     id,
+	})
+}
+
+func (r *bulkOperationRepository) CancelBulkOperation(ctx context.Context, id uuid.UUID) (*sqlcv1.V1BulkOperation, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	op, err := r.queries.FinishBulkOperation(ctx, tx, sqlcv1.FinishBulkOperationParams{
+		Status: sqlcv1.V1BulkOperationStatusCANCELLED,
+		ID:     id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel bulk operation: %w", err)
+	}
+
+	if err := r.queries.DeleteAllBulkOperationRuns(ctx, tx, id); err != nil {
+		return nil, fmt.Errorf("failed to delete bulk operation runs: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,152 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+func TestBulkOperationFilter_ToListWorkflowRunOpts(t *testing.T) {
+	since := time.Now().Add(-time.Hour).UTC()
+	until := time.Now().UTC()
+	workflowId := uuid.New()
+
+	filter := &BulkOperationFilter{
+		Since:              since,
+		Until:              &until,
+		Statuses:           []sqlcv1.V1ReadableStatusOlap{sqlcv1.V1ReadableStatusOlapFAILED},
+		WorkflowIds:        []uuid.UUID{workflowId},
+		AdditionalMetadata: map[string]string{"customer": "acme"},
+	}
+
+	opts := filter.ToListWorkflowRunOpts()
+
+	assert.Equal(t, since, opts.CreatedAfter)
+	assert.Equal(t, &until, opts.FinishedBefore)
+	assert.Equal(t, filter.Statuses, opts.Statuses)
+	assert.Equal(t, []uuid.UUID{workflowId}, opts.WorkflowIds)
+	assert.Equal(t, map[string]interface{}{"customer": "acme"}, opts.AdditionalMetadata)
+	assert.Zero(t, opts.Limit, "bulk operations apply to every matching run")
+}
+
+func TestParseBulkOperationFilter(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	filter := &BulkOperationFilter{
+		Since:    since,
+		Statuses: []sqlcv1.V1ReadableStatusOlap{sqlcv1.V1ReadableStatusOlapRUNNING},
+	}
+
+	b, err := json.Marshal(filter)
+	require.NoError(t, err)
+
+	parsed, err := ParseBulkOperationFilter(&sqlcv1.V1BulkOperation{Filter: b})
+	require.NoError(t, err)
+
+	assert.True(t, since.Equal(parsed.Since))
+	assert.Nil(t, parsed.Until)
+	assert.Equal(t, filter.Statuses, parsed.Statuses)
+	assert.Nil(t, parsed.AdditionalMetadata)
+}
+
+func TestEventReplayFilter_ToListEventsParams(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	until := since.Add(time.Hour)
+	tenantId := uuid.New()
+	keyPattern := "order:*"
+
+	filter := &EventReplayFilter{
+		Since:              since,
+		Until:              until,
+		KeyPattern:         &keyPattern,
+		Scopes:             []string{"eu"},
+		AdditionalMetadata: map[string]string{"customer": "acme"},
+		EventsPerSecond:    50,
+	}
+
+	b, err := json.Marshal(filter)
+	require.NoError(t, err)
+
+	parsed, err := ParseEventReplayFilter(&sqlcv1.V1BulkOperation{Filter: b})
+	require.NoError(t, err)
+
+	params, err := parsed.ToListEventsParams(tenantId)
+	require.NoError(t, err)
+
+	assert.Equal(t, tenantId, params.Tenantid)
+	assert.True(t, since.Equal(params.Since.Time))
+	assert.True(t, until.Equal(params.Until.Time))
+	assert.Equal(t, "order:%", params.KeyPattern.String)
+	assert.Equal(t, []string{"eu"}, params.Scopes)
+	assert.JSONEq(t, `{"customer":"acme"}`, string(params.AdditionalMetadata))
+	assert.Equal(t, 50, parsed.EventsPerSecond)
+}
+
+func TestCreateBulkOperationOpts_Validate(t *testing.T) {
+	v := validator.NewDefaultValidator()
+
+	runFilter := &BulkOperationFilter{Since: time.Now().Add(-time.Hour)}
+	eventFilter := &EventReplayFilter{Since: time.Now().Add(-time.Hour), Until: time.Now(), EventsPerSecond: 100}
+
+	assert.NoError(t, v.Validate(&CreateBulkOperationOpts{Kind: sqlcv1.V1BulkOperationKindCANCEL, Filter: runFilter}))
+	assert.NoError(t, v.Validate(&CreateBulkOperationOpts{Kind: sqlcv1.V1BulkOperationKindREPLAYEVENTS, EventFilter: eventFilter}))
+
+	assert.Error(t, v.Validate(&CreateBulkOperationOpts{Kind: sqlcv1.V1BulkOperationKindREPLAY, EventFilter: eventFilter}))
+	assert.Error(t, v.Validate(&CreateBulkOperationOpts{Kind: sqlcv1.V1BulkOperationKindREPLAYEVENTS, Filter: runFilter}))
+
+	eventFilter.EventsPerSecond = 0
+	assert.Error(t, v.Validate(&CreateBulkOperationOpts{Kind: sqlcv1.V1BulkOperationKindREPLAYEVENTS, EventFilter: eventFilter}))
+}
+
+func TestGetNextBulkOperationQueuesEventReplaysSeparately(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	repo := newBulkOperationRepository(&sharedRepository{
+		pool:    pool,
+		l:       &logger,
+		queries: sqlcv1.New(),
+		v:       validator.NewDefaultValidator(),
+	})
+
+	tenantId := uuid.New()
+
+	create := func(kind sqlcv1.V1BulkOperationKind) *sqlcv1.V1BulkOperation {
+		op, err := sqlcv1.New().CreateBulkOperation(ctx, pool, sqlcv1.CreateBulkOperationParams{
+			Tenantid: tenantId,
+			Kind:     kind,
+			Filter:   []byte(`{}`),
+		})
+		require.NoError(t, err)
+
+		return op
+	}
+
+	// the replay is older, but it doesn't hold up the cancellation
+	replay := create(sqlcv1.V1BulkOperationKindREPLAYEVENTS)
+	cancel := create(sqlcv1.V1BulkOperationKindCANCEL)
+
+	next, err := repo.GetNextBulkOperation(ctx, tenantId, false)
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, cancel.ID, next.ID)
+
+	next, err = repo.GetNextBulkOperation(ctx, tenantId, true)
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, replay.ID, next.ID)
+}
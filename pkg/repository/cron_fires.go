@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// maxCronFiresPerCron is the number of fires which are kept in the history of each cron.
+const maxCronFiresPerCron = 100
+
+type RecordCronFireOpts struct {
+	CronId uuid.UUID `validate:"required"`
+
+	// the time which the cron was scheduled to fire at
+	ScheduledAt time.Time `validate:"required"`
+
+	Outcome sqlcv1.V1CronFireOutcome `validate:"required,oneof=TRIGGERED SKIPPED_OVERLAP SKIPPED_INVALID_INPUT"`
+
+	// (optional) the external id of the workflow run which was triggered
+	WorkflowRunExternalId *uuid.UUID
+}
+
+type ListCronFiresOpts struct {
+	// (optional) number of fires to skip
+	Offset *int `validate:"omitempty,min=0"`
+
+	// (optional) number of fires to return
+	Limit *int `validate:"omitempty,min=1,max=100"`
+}
+
+func (w *workflowScheduleRepository) RecordCronFire(ctx context.Context, tenantId uuid.UUID, opts *RecordCronFireOpts) error {
+	if err := w.v.Validate(opts); err != nil {
+		return err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, w.pool, w.l)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	err = w.queries.CreateCronFire(ctx, tx, sqlcv1.CreateCronFireParams{
+		Tenantid:              tenantId,
+		Cronid:                opts.CronId,
+		Scheduledat:           sqlchelpers.TimestamptzFromTime(opts.ScheduledAt),
+		Outcome:               opts.Outcome,
+		WorkflowRunExternalId: opts.WorkflowRunExternalId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create cron fire: %w", err)
+	}
+
+	err = w.queries.DeleteOldCronFires(ctx, tx, sqlcv1.DeleteOldCronFiresParams{
+		Cronid: opts.CronId,
+		Keep:   maxCronFiresPerCron,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete old cron fires: %w", err)
+	}
+
+	return commit(ctx)
+}
+
+func (w *workflowScheduleRepository) GetLatestTriggeredCronFire(ctx context.Context, tenantId, cronId uuid.UUID) (*sqlcv1.V1CronFire, error) {
+	fire, err := w.queries.GetLatestTriggeredCronFire(ctx, w.pool, sqlcv1.GetLatestTriggeredCronFireParams{
+		Tenantid: tenantId,
+		Cronid:   cronId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get latest cron fire: %w", err)
+	}
+
+	return fire, nil
+}
+
+func (w *workflowScheduleRepository) ListCronFires(ctx context.Context, tenantId, cronId uuid.UUID, opts *ListCronFiresOpts) ([]*sqlcv1.V1CronFire, int64, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, 0, err
+	}
+
+	params := sqlcv1.ListCronFiresParams{
+		Tenantid:   tenantId,
+		Cronid:     cronId,
+		Fireoffset: 0,
+		Firelimit:  50,
+	}
+
+	if opts.Offset != nil {
+		params.Fireoffset = int32(*opts.Offset) // nolint: gosec
+	}
+
+	if opts.Limit != nil {
+		params.Firelimit = int32(*opts.Limit) // nolint: gosec
+	}
+
+	fires, err := w.queries.ListCronFires(ctx, w.pool, params)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list cron fires: %w", err)
+	}
+
+	count, err := w.queries.CountCronFires(ctx, w.pool, sqlcv1.CountCronFiresParams{
+		Tenantid: tenantId,
+		Cronid:   cronId,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count cron fires: %w", err)
+	}
+
+	return fires, count, nil
+}
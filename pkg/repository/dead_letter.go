@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ErrDeadLetterCycle is returned when a dead-letter policy would send the failed runs of a workflow back to it
+// through other dead-letter workflows, for instance A to B and B to A.
+var ErrDeadLetterCycle = errors.New("dead-letter policies can't form a cycle")
+
+type UpsertDeadLetterPolicyOpts struct {
+	// (optional) the workflow which the policy applies to. If nil, the policy is the tenant default, which
+	// applies to every workflow without its own policy.
+	SourceWorkflowId *uuid.UUID
+
+	// (required) the name of the workflow which is triggered for permanently failed runs
+	DeadLetterWorkflowName string `validate:"required,hatchetName"`
+}
+
+// FailedWorkflowRun identifies a workflow run which has permanently failed.
+type FailedWorkflowRun struct {
+	ExternalId uuid.UUID
+	WorkflowId uuid.UUID
+}
+
+type DeadLetterRepository interface {
+	// UpsertDeadLetterPolicy creates the dead-letter policy for the source workflow, or replaces the dead-letter
+	// workflow of the existing policy
+	UpsertDeadLetterPolicy(ctx context.Context, tenantId uuid.UUID, opts *UpsertDeadLetterPolicyOpts) (*sqlcv1.V1DeadLetterPolicy, error)
+
+	// GetDeadLetterPolicyById returns the dead-letter policy with the given id
+	GetDeadLetterPolicyById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1DeadLetterPolicy, error)
+
+	// ListDeadLetterPolicies returns the dead-letter policies of the tenant, with the tenant default first
+	ListDeadLetterPolicies(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1DeadLetterPolicy, error)
+
+	// DeleteDeadLetterPolicy deletes the dead-letter policy with the given id
+	DeleteDeadLetterPolicy(ctx context.Context, tenantId, id uuid.UUID) error
+
+	// EnqueueDeadLetterRuns stores the failed runs which have a dead-letter policy, and returns the external ids
+	// of the runs which were enqueued. Runs of a dead-letter workflow are never enqueued.
+	EnqueueDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, runs []FailedWorkflowRun) ([]uuid.UUID, error)
+
+	// ListDeadLetterRuns returns up to limit enqueued runs of the tenant, oldest first
+	ListDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, limit int) ([]*sqlcv1.V1DeadLetterRun, error)
+
+	// DeleteDeadLetterRuns removes runs from the queue once they've been sent to their dead-letter workflow
+	DeleteDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) error
+}
+
+type deadLetterRepository struct {
+	*sharedRepository
+}
+
+func newDeadLetterRepository(shared *sharedRepository) DeadLetterRepository {
+	return &deadLetterRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *deadLetterRepository) UpsertDeadLetterPolicy(ctx context.Context, tenantId uuid.UUID, opts *UpsertDeadLetterPolicyOpts) (*sqlcv1.V1DeadLetterPolicy, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	policy, err := r.queries.UpsertDeadLetterPolicy(ctx, tx, sqlcv1.UpsertDeadLetterPolicyParams{
+		Tenantid:               tenantId,
+		SourceWorkflowId:       opts.SourceWorkflowId,
+		Deadletterworkflowname: opts.DeadLetterWorkflowName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the policies are checked with the new policy in place, since it may close a cycle through other policies
+	// or the tenant default
+	rows, err := r.queries.ListDeadLetterPolicyWorkflowNames(ctx, tx, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list dead-letter policies: %w", err)
+	}
+
+	var defaultTarget *string
+	targets := make(map[string]string, len(rows))
+
+	for _, row := range rows {
+		if row.SourceWorkflowName.Valid {
+			targets[row.SourceWorkflowName.String] = row.DeadLetterWorkflowName
+		} else {
+			defaultTarget = &row.DeadLetterWorkflowName
+		}
+	}
+
+	if cycle := findDeadLetterCycle(targets, defaultTarget); cycle != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDeadLetterCycle, strings.Join(cycle, " -> "))
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// findDeadLetterCycle returns the workflows of a cycle in the dead-letter policies, starting and ending with the
+// same workflow, or nil if there is none. targets maps workflows with their own policy to their dead-letter
+// workflow, and every other workflow is sent to the tenant default. A workflow is never sent to itself, so it
+// ends a chain rather than forming a cycle.
+func findDeadLetterCycle(targets map[string]string, defaultTarget *string) []string {
+	next := func(name string) (string, bool) {
+		target, ok := targets[name]
+
+		if !ok {
+			if defaultTarget == nil {
+				return "", false
+			}
+
+			target = *defaultTarget
+		}
+
+		return target, target != name
+	}
+
+	// every chain passes through either a workflow with its own policy or the tenant default
+	starts := make([]string, 0, len(targets)+1)
+
+	for name := range targets {
+		starts = append(starts, name)
+	}
+
+	if defaultTarget != nil {
+		starts = append(starts, *defaultTarget)
+	}
+
+	sort.Strings(starts)
+
+	for _, start := range starts {
+		path := []string{start}
+		seen := map[string]int{start: 0}
+
+		for name := start; ; {
+			target, ok := next(name)
+
+			if !ok {
+				break
+			}
+
+			if i, ok := seen[target]; ok {
+				return append(path[i:], target)
+			}
+
+			seen[target] = len(path)
+			path = append(path, target)
+			name = target
+		}
+	}
+
+	return nil
+}
+
+func (r *deadLetterRepository) GetDeadLetterPolicyById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1DeadLetterPolicy, error) {
+	return r.queries.GetDeadLetterPolicyById(ctx, r.pool, id)
+}
+
+func (r *deadLetterRepository) ListDeadLetterPolicies(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1DeadLetterPolicy, error) {
+	return r.queries.ListDeadLetterPolicies(ctx, r.pool, tenantId)
+}
+
+func (r *deadLetterRepository) DeleteDeadLetterPolicy(ctx context.Context, tenantId, id uuid.UUID) error {
+	return r.queries.DeleteDeadLetterPolicy(ctx, r.pool, sqlcv1.DeleteDeadLetterPolicyParams{
+		Tenantid: tenantId,
+		ID:       id,
+	})
+}
+
+func (r *deadLetterRepository) EnqueueDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, runs []FailedWorkflowRun) ([]uuid.UUID, error) {
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	externalIds := make([]uuid.UUID, 0, len(runs))
+	workflowIds := make([]uuid.UUID, 0, len(runs))
+
+	for _, run := range runs {
+		externalIds = append(externalIds, run.ExternalId)
+		workflowIds = append(workflowIds, run.WorkflowId)
+	}
+
+	return r.queries.EnqueueDeadLetterRuns(ctx, r.pool, sqlcv1.EnqueueDeadLetterRunsParams{
+		Workflowrunexternalids: externalIds,
+		Workflowids:            workflowIds,
+		Tenantid:               tenantId,
+	})
+}
+
+func (r *deadLetterRepository) ListDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, limit int) ([]*sqlcv1.V1DeadLetterRun, error) {
+	return r.queries.ListDeadLetterRuns(ctx, r.pool, sqlcv1.ListDeadLetterRunsParams{
+		Tenantid:  tenantId,
+		Batchsize: int32(limit), // nolint: gosec
+	})
+}
+
+func (r *deadLetterRepository) DeleteDeadLetterRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) error {
+	if len(externalIds) == 0 {
+		return nil
+	}
+
+	return r.queries.DeleteDeadLetterRuns(ctx, r.pool, sqlcv1.DeleteDeadLetterRunsParams{
+		Tenantid:               tenantId,
+		Workflowrunexternalids: externalIds,
+	})
+}
@@ -0,0 +1,63 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDeadLetterCycle(t *testing.T) {
+	strPtr := func(s string) *string {
+		return &s
+	}
+
+	tests := []struct {
+		name          string
+		targets       map[string]string
+		defaultTarget *string
+		want          []string
+	}{
+		{
+			name:    "no policies",
+			targets: map[string]string{},
+		},
+		{
+			name:    "chain",
+			targets: map[string]string{"a": "b", "b": "c"},
+		},
+		{
+			name:    "two workflows",
+			targets: map[string]string{"a": "b", "b": "a"},
+			want:    []string{"a", "b", "a"},
+		},
+		{
+			name:    "three workflows",
+			targets: map[string]string{"a": "b", "b": "c", "c": "a"},
+			want:    []string{"a", "b", "c", "a"},
+		},
+		{
+			name:          "default is never sent to itself",
+			targets:       map[string]string{"a": "dlq"},
+			defaultTarget: strPtr("dlq"),
+		},
+		{
+			name:          "through the default",
+			targets:       map[string]string{"dlq": "b"},
+			defaultTarget: strPtr("dlq"),
+			want:          []string{"dlq", "b", "dlq"},
+		},
+		{
+			name:          "workflow sent to itself ends the chain",
+			targets:       map[string]string{"a": "a"},
+			defaultTarget: strPtr("a"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findDeadLetterCycle(tt.targets, tt.defaultTarget))
+		})
+	}
+}
@@ -59,11 +59,27 @@ type IngestWaitForOpts struct {
 	Label *string
 }
 
+type IngestSignalOpts struct {
+	// Name is the name of the signal the task is waiting for
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type IngestPatchOpts struct {
+	// PatchId is the id of the code change which is guarded by the patch
+	PatchId string `json:"patchId" validate:"required,max=255"`
+
+	// Deprecated is set once the old code path of the patch has been removed. Deprecated patches are still matched by
+	// runs which recorded the marker, but aren't recorded for new runs.
+	Deprecated bool `json:"-"`
+}
+
 type IngestDurableTaskEventOpts struct {
 	*BaseIngestEventOpts
 	Memo        *IngestMemoOpts
 	TriggerRuns *IngestTriggerRunsOpts
 	WaitFor     *IngestWaitForOpts
+	Signal      *IngestSignalOpts
+	Patch       *IngestPatchOpts
 }
 
 type IngestMemoResult struct {
@@ -102,10 +118,30 @@ type IngestWaitForResult struct {
 	AlreadyExisted  bool
 }
 
+type IngestSignalResult struct {
+	ResultPayload   []byte
+	NodeId          int64
+	BranchId        int64
+	InvocationCount int32
+	IsSatisfied     bool
+	AlreadyExisted  bool
+}
+
+type IngestPatchResult struct {
+	NodeId          int64
+	BranchId        int64
+	InvocationCount int32
+	// IsPatched is whether the task should take the patched code path
+	IsPatched      bool
+	AlreadyExisted bool
+}
+
 type IngestDurableTaskEventResult struct {
 	MemoResult        *IngestMemoResult
 	TriggerRunsResult *IngestTriggerRunsResult
 	WaitForResult     *IngestWaitForResult
+	SignalResult      *IngestSignalResult
+	PatchResult       *IngestPatchResult
 	Kind              sqlcv1.V1DurableEventLogKind
 }
 
@@ -144,6 +180,15 @@ type DurableEventsRepository interface {
 	GetDurableTaskInvocationCounts(ctx context.Context, tenantId uuid.UUID, tasks []IdInsertedAt) (map[IdInsertedAt]*int32, error)
 	CompleteMemoEntry(ctx context.Context, opts CompleteMemoEntryOpts) error
 	ListDurableEventLog(ctx context.Context, tenantId uuid.UUID, taskInsertedAt pgtype.Timestamptz, taskId, limit, offset int64) ([]*sqlcv1.ListDurableEventLogForTaskRow, error)
+
+	// SendDurableTaskSignal sends a named signal to a running durable task. If the task is waiting for the signal, the
+	// waiting log entry is satisfied and returned so the result can be delivered to the task. Otherwise the signal is
+	// buffered until the task waits for it, and a nil entry is returned.
+	SendDurableTaskSignal(ctx context.Context, opts SendDurableTaskSignalOpts) (*SatisfiedEntry, error)
+
+	// ListInFlightDurableTasksWithPatch lists the durable tasks which recorded the given patch marker and haven't
+	// finished yet. Once none are left, the code path guarded by a deprecated patch can be removed.
+	ListInFlightDurableTasksWithPatch(ctx context.Context, opts ListInFlightDurableTasksWithPatchOpts) ([]*sqlcv1.ListInFlightDurableTasksWithPatchRow, error)
 }
 
 type durableEventsRepository struct {
@@ -203,6 +248,7 @@ const (
 	DurableWaitConditionKindSleep         DurableWaitConditionKind = "SLEEP"
 	DurableWaitConditionKindUserEvent     DurableWaitConditionKind = "USER_EVENT"
 	DurableWaitConditionKindChildWorkflow DurableWaitConditionKind = "CHILD_WORKFLOW"
+	DurableWaitConditionKindSignal        DurableWaitConditionKind = "SIGNAL"
 )
 
 type DurableWaitCondition struct {
@@ -210,6 +256,7 @@ type DurableWaitCondition struct {
 	SleepDurationMs *int64                   `json:"sleepDurationMs,omitempty"`
 	EventKey        *string                  `json:"eventKey,omitempty"`
 	WorkflowName    *string                  `json:"workflowName,omitempty"`
+	SignalName      *string                  `json:"signalName,omitempty"`
 }
 
 type DurableWaitOrGroup struct {
@@ -253,6 +300,11 @@ func describeCondition(c DurableWaitCondition) string {
 			return "run(" + *c.WorkflowName + ")"
 		}
 		return "run"
+	case DurableWaitConditionKindSignal:
+		if c.SignalName != nil {
+			return "signal(" + *c.SignalName + ")"
+		}
+		return "signal"
 	default:
 		return string(c.Kind)
 	}
@@ -351,6 +403,17 @@ func waitDataFromTriggerOpt(triggerOpt *WorkflowNameTriggerOpts) *WaitData {
 	}
 }
 
+func waitDataFromSignalOpts(signalOpts *IngestSignalOpts) *WaitData {
+	if signalOpts == nil {
+		return nil
+	}
+
+	name := signalOpts.Name
+	return &WaitData{
+		Conditions: []DurableWaitCondition{{Kind: DurableWaitConditionKindSignal, SignalName: &name}},
+	}
+}
+
 func marshalWaitData(wd *WaitData) string {
 	if wd == nil {
 		return ""
@@ -383,6 +446,14 @@ func (opts IngestDurableTaskEventOpts) formatCall() string {
 		}
 	case sqlcv1.V1DurableEventLogKindMEMO:
 		return "memo"
+	case sqlcv1.V1DurableEventLogKindSIGNAL:
+		if opts.Signal != nil {
+			return waitDataFromSignalOpts(opts.Signal).ToReadableMessage()
+		}
+	case sqlcv1.V1DurableEventLogKindPATCH:
+		if opts.Patch != nil {
+			return "patched(" + opts.Patch.PatchId + ")"
+		}
 	}
 
 	return string(opts.Kind)
@@ -419,6 +490,26 @@ func formatStoredPayload(kind sqlcv1.V1DurableEventLogKind, payload []byte) stri
 		}
 	case sqlcv1.V1DurableEventLogKindMEMO:
 		return "memo"
+	case sqlcv1.V1DurableEventLogKindSIGNAL:
+		var signalOpts IngestSignalOpts
+
+		if err := json.Unmarshal(payload, &signalOpts); err != nil {
+			return string(kind)
+		}
+
+		if signalOpts.Name != "" {
+			return waitDataFromSignalOpts(&signalOpts).ToReadableMessage()
+		}
+	case sqlcv1.V1DurableEventLogKindPATCH:
+		var patchOpts IngestPatchOpts
+
+		if err := json.Unmarshal(payload, &patchOpts); err != nil {
+			return string(kind)
+		}
+
+		if patchOpts.PatchId != "" {
+			return "patched(" + patchOpts.PatchId + ")"
+		}
 	}
 
 	return string(kind)
@@ -603,13 +694,23 @@ func (r *durableEventsRepository) createIdempotencyKey(kind sqlcv1.V1DurableEven
 		}
 	}
 
+	return hashIdempotencyKey(dataToHash), nil
+}
+
+// createSignalIdempotencyKey returns the idempotency key of a log entry which waits for the named signal, which is
+// also used to find the entry when the signal is sent
+func createSignalIdempotencyKey(name string) []byte {
+	return hashIdempotencyKey(append([]byte(sqlcv1.V1DurableEventLogKindSIGNAL), []byte(name)...))
+}
+
+func hashIdempotencyKey(dataToHash []byte) []byte {
 	h := sha256.New()
 	h.Write(dataToHash)
 	hashBytes := h.Sum(nil)
 	idempotencyKey := make([]byte, hex.EncodedLen(len(hashBytes)))
 	hex.Encode(idempotencyKey, hashBytes)
 
-	return idempotencyKey, nil
+	return idempotencyKey
 }
 
 func (r *sharedRepository) incrementDurableTaskInvocationCounts(ctx context.Context, tx sqlcv1.DBTX, opts []IncrementDurableTaskInvocationCountsOpts) (map[IncrementDurableTaskInvocationCountsOpts]*int32, error) {
@@ -1025,6 +1126,14 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 		return nil, fmt.Errorf("TriggerOptsList is required and must be non-empty for RUN kind")
 	}
 
+	if opts.Kind == sqlcv1.V1DurableEventLogKindSIGNAL && opts.Signal == nil {
+		return nil, fmt.Errorf("Signal is required for SIGNAL kind")
+	}
+
+	if opts.Kind == sqlcv1.V1DurableEventLogKindPATCH && opts.Patch == nil {
+		return nil, fmt.Errorf("Patch is required for PATCH kind")
+	}
+
 	tenantId := opts.TenantId
 	task := opts.Task
 
@@ -1056,6 +1165,24 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 
 	baseNodeId := logFile.LatestNodeID + 1
 
+	if opts.Kind == sqlcv1.V1DurableEventLogKindPATCH {
+		branchId := resolveBranchForNode(baseNodeId, logFile.LatestBranchID, nextBranchIdToBranchPoint)
+
+		patchResult, err := r.ingestPatch(ctx, tx, opts, baseNodeId, branchId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := optTx.Commit(ctx); err != nil {
+			return nil, err
+		}
+
+		return &IngestDurableTaskEventResult{
+			Kind:        opts.Kind,
+			PatchResult: patchResult,
+		}, nil
+	}
+
 	var getOrCreateOpts GetOrCreateLogEntryOpts
 
 	nodeIdBranchIdToTriggerOpts := make(map[NodeIdBranchIdTuple]*WorkflowNameTriggerOpts)
@@ -1170,6 +1297,29 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 				ResultPayload:   resultPayload,
 			}},
 		}
+	case sqlcv1.V1DurableEventLogKindSIGNAL:
+		branchId := resolveBranchForNode(baseNodeId, logFile.LatestBranchID, nextBranchIdToBranchPoint)
+
+		inputPayload, marshalErr := json.Marshal(opts.Signal)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to marshal signal opts: %w", marshalErr)
+		}
+
+		getOrCreateOpts = GetOrCreateLogEntryOpts{
+			TenantId:              tenantId,
+			DurableTaskExternalId: task.ExternalID,
+			DurableTaskId:         task.ID,
+			DurableTaskInsertedAt: task.InsertedAt,
+			Entries: []GetOrCreateLogEntryOpt{{
+				Kind:            sqlcv1.V1DurableEventLogKindSIGNAL,
+				NodeId:          baseNodeId,
+				BranchId:        branchId,
+				InvocationCount: opts.InvocationCount,
+				IdempotencyKey:  createSignalIdempotencyKey(opts.Signal.Name),
+				InputPayload:    inputPayload,
+				WaitData:        marshalWaitData(waitDataFromSignalOpts(opts.Signal)),
+			}},
+		}
 	default:
 		return nil, fmt.Errorf("unsupported durable event log entry kind: %s", opts.Kind)
 	}
@@ -1206,6 +1356,7 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 	var memoResult *IngestMemoResult
 	var waitForResult *IngestWaitForResult
 	var triggerRunsResult *IngestTriggerRunsResult
+	var signalResult *IngestSignalResult
 
 	switch opts.Kind {
 	case sqlcv1.V1DurableEventLogKindRUN:
@@ -1449,6 +1600,36 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 			ResultPayload:   le.ResultPayload,
 			AlreadyExisted:  le.AlreadyExisted,
 		}
+	case sqlcv1.V1DurableEventLogKindSIGNAL:
+		if len(logEntries) != 1 {
+			// note: we implicitly assume that there will only be one log entry for a signal
+			// if we get more than one, it's an indication something is wrong
+			return nil, fmt.Errorf("expected to get exactly one log entry for signal, but got %d", len(logEntries))
+		}
+
+		le := logEntries[0]
+
+		signalResult = &IngestSignalResult{
+			InvocationCount: opts.InvocationCount,
+			IsSatisfied:     le.Entry.IsSatisfied,
+			NodeId:          le.Entry.NodeID,
+			BranchId:        le.Entry.BranchID,
+			ResultPayload:   le.ResultPayload,
+			AlreadyExisted:  le.AlreadyExisted,
+		}
+
+		if !le.AlreadyExisted {
+			// the signal may have been sent before the task started waiting for it
+			payload, found, popErr := r.consumeBufferedSignal(ctx, tx, tenantId, task, le.Entry.BranchID, le.Entry.NodeID, opts.Signal.Name)
+			if popErr != nil {
+				return nil, fmt.Errorf("failed to consume buffered signal: %w", popErr)
+			}
+
+			if found {
+				signalResult.IsSatisfied = true
+				signalResult.ResultPayload = payload
+			}
+		}
 	}
 
 	maxNodeId := int64(0)
@@ -1483,6 +1664,7 @@ func (r *durableEventsRepository) IngestDurableTaskEvent(ctx context.Context, op
 		MemoResult:        memoResult,
 		WaitForResult:     waitForResult,
 		TriggerRunsResult: triggerRunsResult,
+		SignalResult:      signalResult,
 	}, nil
 }
 
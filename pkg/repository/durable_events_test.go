@@ -279,6 +279,17 @@ func TestCreateIdempotencyKey_AdditionalMetadataIgnored(t *testing.T) {
 	assert.Equal(t, base, withMeta)
 }
 
+func TestCreateSignalIdempotencyKey_DifferentNames(t *testing.T) {
+	assert.Equal(t, createSignalIdempotencyKey("approval"), createSignalIdempotencyKey("approval"))
+	assert.NotEqual(t, createSignalIdempotencyKey("approval"), createSignalIdempotencyKey("rejection"))
+}
+
+func TestCreatePatchIdempotencyKey(t *testing.T) {
+	assert.Equal(t, createPatchIdempotencyKey("new-pricing"), createPatchIdempotencyKey("new-pricing"))
+	assert.NotEqual(t, createPatchIdempotencyKey("new-pricing"), createPatchIdempotencyKey("new-tax"))
+	assert.NotEqual(t, createPatchIdempotencyKey("approval"), createSignalIdempotencyKey("approval"))
+}
+
 func TestNonDeterminismError_SameKind(t *testing.T) {
 	id := uuid.New()
 	err := &NonDeterminismError{
@@ -366,6 +377,22 @@ func TestFormatCall_Memo(t *testing.T) {
 	assert.Equal(t, "memo", opts.formatCall())
 }
 
+func TestFormatCall_Signal(t *testing.T) {
+	opts := IngestDurableTaskEventOpts{
+		BaseIngestEventOpts: &BaseIngestEventOpts{Kind: sqlcv1.V1DurableEventLogKindSIGNAL},
+		Signal:              &IngestSignalOpts{Name: "approval"},
+	}
+	assert.Equal(t, "signal(approval)", opts.formatCall())
+}
+
+func TestFormatCall_Patch(t *testing.T) {
+	opts := IngestDurableTaskEventOpts{
+		BaseIngestEventOpts: &BaseIngestEventOpts{Kind: sqlcv1.V1DurableEventLogKindPATCH},
+		Patch:               &IngestPatchOpts{PatchId: "new-pricing"},
+	}
+	assert.Equal(t, "patched(new-pricing)", opts.formatCall())
+}
+
 func TestFormatCall_RunBulkWithDuplicates(t *testing.T) {
 	triggers := make([]*WorkflowNameTriggerOpts, 0, 8)
 	for i := 0; i < 6; i++ {
@@ -448,6 +475,17 @@ func TestFormatStoredPayload_WaitFor(t *testing.T) {
 	assert.Equal(t, "sleep(2s)", formatStoredPayload(sqlcv1.V1DurableEventLogKindWAITFOR, payload))
 }
 
+func TestFormatStoredPayload_Signal(t *testing.T) {
+	payload, _ := json.Marshal(IngestSignalOpts{Name: "approval"})
+	assert.Equal(t, "signal(approval)", formatStoredPayload(sqlcv1.V1DurableEventLogKindSIGNAL, payload))
+}
+
+func TestFormatStoredPayload_Patch(t *testing.T) {
+	payload, _ := json.Marshal(IngestPatchOpts{PatchId: "new-pricing", Deprecated: true})
+	assert.JSONEq(t, `{"patchId":"new-pricing"}`, string(payload))
+	assert.Equal(t, "patched(new-pricing)", formatStoredPayload(sqlcv1.V1DurableEventLogKindPATCH, payload))
+}
+
 func TestFormatStoredPayload_NoPayload(t *testing.T) {
 	assert.Equal(t, "MEMO", formatStoredPayload(sqlcv1.V1DurableEventLogKindMEMO, nil))
 	assert.Equal(t, "RUN", formatStoredPayload(sqlcv1.V1DurableEventLogKindRUN, nil))
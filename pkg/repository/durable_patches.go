@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+type ListInFlightDurableTasksWithPatchOpts struct {
+	TenantId uuid.UUID `validate:"required"`
+
+	// PatchId is the id of the patch marker to list tasks for
+	PatchId string `validate:"required,max=255"`
+
+	Limit  int64
+	Offset int64
+}
+
+// ingestPatch resolves a patch marker at the next node of the durable event log. A run which recorded the marker the
+// last time it reached this point replays the patched code path, and a run which reached this point before the patch
+// was deployed replays the old code path. A run which reaches this point for the first time records the marker and
+// takes the patched code path.
+func (r *durableEventsRepository) ingestPatch(ctx context.Context, tx sqlcv1.DBTX, opts IngestDurableTaskEventOpts, nodeId, branchId int64) (*IngestPatchResult, error) {
+	task := opts.Task
+	idempotencyKey := createPatchIdempotencyKey(opts.Patch.PatchId)
+
+	result := &IngestPatchResult{
+		NodeId:          nodeId,
+		BranchId:        branchId,
+		InvocationCount: opts.InvocationCount,
+	}
+
+	existing, err := r.queries.GetDurableEventLogEntry(ctx, tx, sqlcv1.GetDurableEventLogEntryParams{
+		Durabletaskid:         task.ID,
+		Durabletaskinsertedat: task.InsertedAt,
+		Branchid:              branchId,
+		Nodeid:                nodeId,
+	})
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		result.IsPatched = true
+
+		// deprecated patches aren't recorded for new runs, so the call can be removed once the runs which recorded the
+		// marker have finished
+		if opts.Patch.Deprecated {
+			return result, nil
+		}
+
+		inputPayload, marshalErr := json.Marshal(opts.Patch)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to marshal patch opts: %w", marshalErr)
+		}
+
+		_, err = r.getOrCreateEventLogEntries(ctx, tx, GetOrCreateLogEntryOpts{
+			TenantId:              opts.TenantId,
+			DurableTaskExternalId: task.ExternalID,
+			DurableTaskId:         task.ID,
+			DurableTaskInsertedAt: task.InsertedAt,
+			Entries: []GetOrCreateLogEntryOpt{{
+				Kind:            sqlcv1.V1DurableEventLogKindPATCH,
+				NodeId:          nodeId,
+				BranchId:        branchId,
+				InvocationCount: opts.InvocationCount,
+				IdempotencyKey:  idempotencyKey,
+				InputPayload:    inputPayload,
+				IsSatisfied:     true,
+				UserMessage:     &opts.Patch.PatchId,
+			}},
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create patch marker: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get log entry: %w", err)
+	case existing.Kind == sqlcv1.V1DurableEventLogKindPATCH && bytes.Equal(existing.IdempotencyKey, idempotencyKey):
+		result.IsPatched = true
+		result.AlreadyExisted = true
+	default:
+		// the run reached this point before the patch was deployed, so the entry at this node belongs to the old code
+		// path. The node isn't consumed, so the next call of the task is matched against it. A deprecated patch has no
+		// old code path left, so the task continues on the patched code path.
+		result.IsPatched = opts.Patch.Deprecated
+
+		return result, nil
+	}
+
+	_, err = r.queries.UpdateLogFile(ctx, tx, sqlcv1.UpdateLogFileParams{
+		NodeId:                sqlchelpers.ToBigInt(&nodeId),
+		Durabletaskid:         task.ID,
+		Durabletaskinsertedat: task.InsertedAt,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update latest node id: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *durableEventsRepository) ListInFlightDurableTasksWithPatch(ctx context.Context, opts ListInFlightDurableTasksWithPatchOpts) ([]*sqlcv1.ListInFlightDurableTasksWithPatchRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, fmt.Errorf("invalid opts: %w", err)
+	}
+
+	return r.queries.ListInFlightDurableTasksWithPatch(ctx, r.pool, sqlcv1.ListInFlightDurableTasksWithPatchParams{
+		Tenantid:       opts.TenantId,
+		Idempotencykey: createPatchIdempotencyKey(opts.PatchId),
+		Patchoffset:    opts.Offset,
+		Patchlimit:     opts.Limit,
+	})
+}
+
+// createPatchIdempotencyKey returns the idempotency key of the marker of the given patch, which is also used to find
+// the runs which recorded the marker
+func createPatchIdempotencyKey(patchId string) []byte {
+	return hashIdempotencyKey(append([]byte(sqlcv1.V1DurableEventLogKindPATCH), []byte(patchId)...))
+}
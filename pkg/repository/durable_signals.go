@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ErrDurableTaskNotStarted is returned when a signal is sent to a task which has no durable event log, either
+// because it isn't a durable task or because it hasn't started running yet
+var ErrDurableTaskNotStarted = errors.New("task is not a durable task or has not started running")
+
+// ErrDurableTaskFinalized is returned when a signal is sent to a task which has completed, failed or been
+// cancelled, since a buffered signal would never be consumed
+var ErrDurableTaskFinalized = errors.New("task is in a final state and can no longer receive signals")
+
+type SendDurableTaskSignalOpts struct {
+	TenantId       uuid.UUID `validate:"required"`
+	TaskExternalId uuid.UUID `validate:"required"`
+
+	// Name is the name of the signal, which must match the name the task waits for
+	Name string `validate:"required,max=255"`
+
+	// (optional) the payload of the signal, which is returned to the task when it waits for the signal
+	Payload []byte
+}
+
+func (r *durableEventsRepository) SendDurableTaskSignal(ctx context.Context, opts SendDurableTaskSignalOpts) (*SatisfiedEntry, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, fmt.Errorf("invalid opts: %w", err)
+	}
+
+	task, err := r.GetTaskByExternalId(ctx, opts.TenantId, opts.TaskExternalId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task by external id: %w", err)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare tx: %w", err)
+	}
+	defer rollback()
+
+	// lock the log file so a concurrent wait for the same signal either sees the buffered signal or is found here
+	logFile, err := r.getAndLockLogFile(ctx, tx, opts.TenantId, task.ID, task.InsertedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrDurableTaskNotStarted
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock log file: %w", err)
+	}
+
+	finalEvents, err := r.queries.ListMatchingTaskEvents(ctx, tx, sqlcv1.ListMatchingTaskEventsParams{
+		Tenantid:        opts.TenantId,
+		Taskexternalids: []uuid.UUID{task.ExternalID},
+		Eventtypes: [][]string{{
+			string(sqlcv1.V1TaskEventTypeCOMPLETED),
+			string(sqlcv1.V1TaskEventTypeFAILED),
+			string(sqlcv1.V1TaskEventTypeCANCELLED),
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list final task events: %w", err)
+	}
+
+	if len(finalEvents) > 0 {
+		return nil, ErrDurableTaskFinalized
+	}
+
+	waiting, err := r.queries.GetWaitingSignalEntry(ctx, tx, sqlcv1.GetWaitingSignalEntryParams{
+		Durabletaskid:         task.ID,
+		Durabletaskinsertedat: task.InsertedAt,
+		Idempotencykey:        createSignalIdempotencyKey(opts.Name),
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = r.queries.CreateDurableSignal(ctx, tx, sqlcv1.CreateDurableSignalParams{
+			Tenantid:              opts.TenantId,
+			Durabletaskid:         task.ID,
+			Durabletaskinsertedat: task.InsertedAt,
+			Name:                  opts.Name,
+			Payload:               opts.Payload,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to buffer signal: %w", err)
+		}
+
+		if err := commit(ctx); err != nil {
+			return nil, fmt.Errorf("failed to commit tx: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log entry waiting for signal: %w", err)
+	}
+
+	if err := r.satisfySignalEntry(ctx, tx, opts.TenantId, task.ID, task.InsertedAt, waiting.BranchID, waiting.NodeID, opts.Payload); err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return &SatisfiedEntry{
+		DurableTaskInsertedAt: task.InsertedAt,
+		Data:                  opts.Payload,
+		DurableTaskId:         task.ID,
+		NodeId:                waiting.NodeID,
+		BranchId:              waiting.BranchID,
+		InvocationCount:       logFile.LatestInvocationCount,
+		DurableTaskExternalId: task.ExternalID,
+	}, nil
+}
+
+// consumeBufferedSignal satisfies a new SIGNAL log entry with the oldest signal of the same name which was sent
+// before the task waited for it, if there is one
+func (r *durableEventsRepository) consumeBufferedSignal(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, task *sqlcv1.FlattenExternalIdsRow, branchId, nodeId int64, name string) ([]byte, bool, error) {
+	signal, err := r.queries.PopDurableSignal(ctx, tx, sqlcv1.PopDurableSignalParams{
+		Durabletaskid:         task.ID,
+		Durabletaskinsertedat: task.InsertedAt,
+		Name:                  name,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to pop buffered signal: %w", err)
+	}
+
+	if err := r.satisfySignalEntry(ctx, tx, tenantId, task.ID, task.InsertedAt, branchId, nodeId, signal.Payload); err != nil {
+		return nil, false, err
+	}
+
+	return signal.Payload, true, nil
+}
+
+// satisfySignalEntry marks a SIGNAL log entry as satisfied and stores the signal payload as its result, so replays
+// of the task receive the same payload
+func (r *durableEventsRepository) satisfySignalEntry(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz, branchId, nodeId int64, payload []byte) error {
+	entry, err := r.queries.MarkDurableEventLogEntrySatisfied(ctx, tx, sqlcv1.MarkDurableEventLogEntrySatisfiedParams{
+		Durabletaskid:         taskId,
+		Durabletaskinsertedat: taskInsertedAt,
+		Nodeid:                nodeId,
+		Branchid:              branchId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark signal entry as satisfied: %w", err)
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	err = r.payloadStore.Store(ctx, tx, StorePayloadOpts{
+		Id:         entry.ID,
+		InsertedAt: entry.InsertedAt,
+		ExternalId: entry.ResultPayloadExternalID,
+		Type:       sqlcv1.V1PayloadTypeDURABLEEVENTLOGENTRYRESULTDATA,
+		Payload:    payload,
+		TenantId:   tenantId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to store signal result payload: %w", err)
+	}
+
+	return nil
+}
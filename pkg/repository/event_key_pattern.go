@@ -0,0 +1,26 @@
+package repository
+
+import "strings"
+
+// EventKeyGlobToLikePattern converts a glob pattern for event keys into a SQL LIKE pattern, where `*` matches any
+// characters and `?` matches a single character. Characters which are special to LIKE are escaped, so they only
+// match themselves.
+func EventKeyGlobToLikePattern(glob string) string {
+	var sb strings.Builder
+
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteRune('%')
+		case '?':
+			sb.WriteRune('_')
+		case '%', '_', '\\':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventKeyGlobToLikePattern(t *testing.T) {
+	tests := []struct {
+		glob     string
+		expected string
+	}{
+		{glob: "order:created", expected: "order:created"},
+		{glob: "order:*", expected: "order:%"},
+		{glob: "order:?", expected: "order:_"},
+		{glob: "*:created", expected: "%:created"},
+		{glob: "user_signup%", expected: `user\_signup\%`},
+		{glob: `a\b`, expected: `a\\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob, func(t *testing.T) {
+			assert.Equal(t, tt.expected, EventKeyGlobToLikePattern(tt.glob))
+		})
+	}
+}
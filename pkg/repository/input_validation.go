@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ErrInvalidInput is returned when the input of a workflow run doesn't match the input JSON schema of a workflow
+// which rejects invalid input.
+type ErrInvalidInput struct {
+	WorkflowName string
+	Violations   []jsonschema.Violation
+}
+
+func (e *ErrInvalidInput) Error() string {
+	return fmt.Sprintf("input for workflow %s does not match its input schema: %s", e.WorkflowName, joinViolations(e.Violations))
+}
+
+func joinViolations(violations []jsonschema.Violation) string {
+	res := make([]string, 0, len(violations))
+
+	for _, v := range violations {
+		res = append(res, v.String())
+	}
+
+	return strings.Join(res, "; ")
+}
+
+// getInputSchema returns the compiled input schema for a workflow version, or nil if the workflow version doesn't
+// have an input schema or the schema can't be compiled.
+func (s *sharedRepository) getInputSchema(workflowVersionId uuid.UUID, rawSchema []byte) *jsonschema.Schema {
+	if len(rawSchema) == 0 {
+		return nil
+	}
+
+	if schema, ok := s.inputSchemaCache.Get(workflowVersionId); ok {
+		return schema
+	}
+
+	schema, err := jsonschema.Compile(rawSchema)
+
+	if err != nil {
+		// we don't block triggers on a schema which we can't compile, the schema is registered by the SDK
+		s.l.Warn().Err(err).Str("workflow_version_id", workflowVersionId.String()).Msg("could not compile workflow input schema, skipping input validation")
+
+		schema = nil
+	}
+
+	s.inputSchemaCache.Add(workflowVersionId, schema)
+
+	return schema
+}
+
+// validateInput validates the input of a workflow run against the input schema of the workflow version. If the
+// input is invalid, it returns an *ErrInvalidInput when the workflow rejects invalid input, and otherwise a
+// CEL evaluation failure which should be recorded. It returns neither if the input is valid.
+func (s *sharedRepository) validateInput(
+	workflowVersionId uuid.UUID,
+	workflowName string,
+	rawSchema []byte,
+	mode sqlcv1.WorkflowInputValidationMode,
+	input []byte,
+) (*CELEvaluationFailure, error) {
+	schema := s.getInputSchema(workflowVersionId, rawSchema)
+
+	if schema == nil {
+		return nil, nil
+	}
+
+	violations, err := schema.Validate(input)
+
+	if err != nil {
+		violations = []jsonschema.Violation{
+			{
+				Message: "input is not valid JSON",
+			},
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	if mode == sqlcv1.WorkflowInputValidationModeWARN {
+		return &CELEvaluationFailure{
+			Source:       sqlcv1.V1CelEvaluationFailureSourceINPUTSCHEMA,
+			ErrorMessage: fmt.Sprintf("input for workflow %s does not match its input schema: %s", workflowName, joinViolations(violations)),
+		}, nil
+	}
+
+	return nil, &ErrInvalidInput{
+		WorkflowName: workflowName,
+		Violations:   violations,
+	}
+}
+
+func (r *TriggerRepositoryImpl) ValidateWorkflowNameOptsInput(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) ([]CELEvaluationFailure, error) {
+	uniqueNames := make(map[string]struct{})
+	workflowNames := make([]string, 0, len(opts))
+
+	for _, opt := range opts {
+		if _, ok := uniqueNames[opt.WorkflowName]; ok {
+			continue
+		}
+
+		uniqueNames[opt.WorkflowName] = struct{}{}
+		workflowNames = append(workflowNames, opt.WorkflowName)
+	}
+
+	rows, err := r.listWorkflowsByNames(ctx, r.pool, tenantId, workflowNames)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows by names: %w", err)
+	}
+
+	pinnedVersions, err := r.listWorkflowVersionsByVersions(ctx, r.pool, tenantId, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow versions: %w", err)
+	}
+
+	namesToRows := make(map[string]*sqlcv1.ListWorkflowsByNamesRow, len(rows))
+
+	for _, row := range rows {
+		namesToRows[row.WorkflowName] = row
+	}
+
+	failures := make([]CELEvaluationFailure, 0)
+
+	for _, opt := range opts {
+		row, ok := namesToRows[opt.WorkflowName]
+
+		if !ok {
+			continue
+		}
+
+		var versions []inputSchemaVersion
+
+		if isPinnedToVersion(opt) {
+			pinned, ok := pinnedVersions[workflowNameAndVersion{name: opt.WorkflowName, version: *opt.Version}]
+
+			// the trigger fails because the version doesn't exist, so there's nothing to validate against
+			if !ok {
+				continue
+			}
+
+			versions = []inputSchemaVersion{{workflowVersionId: pinned.WorkflowVersionId, schema: pinned.InputJsonSchema}}
+		} else {
+			versions = runInputSchemaVersions(opt.ExternalId, row.WorkflowVersionId, row.InputJsonSchema, row.CanaryPercentage, row.StableWorkflowVersionId, row.StableInputJsonSchema)
+		}
+
+		for _, version := range versions {
+			failure, err := r.validateInput(version.workflowVersionId, row.WorkflowName, version.schema, row.InputValidationMode, opt.Data)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if failure != nil {
+				failures = append(failures, *failure)
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// validateWorkflowIdInput validates the input of a future run of a workflow against the input schema of each version
+// which the run could use. It only returns an *ErrInvalidInput if the workflow rejects invalid input, otherwise invalid
+// input is recorded when the run is triggered.
+func (s *sharedRepository) validateWorkflowIdInput(ctx context.Context, tenantId, workflowId uuid.UUID, input []byte) error {
+	workflow, err := s.queries.GetWorkflowById(ctx, s.pool, workflowId)
+
+	if err != nil {
+		return fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	rows, err := s.listWorkflowsByNames(ctx, s.pool, tenantId, []string{workflow.Workflow.Name})
+
+	if err != nil {
+		return fmt.Errorf("failed to list workflows by names: %w", err)
+	}
+
+	for _, row := range rows {
+		for _, version := range runInputSchemaVersions(uuid.Nil, row.WorkflowVersionId, row.InputJsonSchema, row.CanaryPercentage, row.StableWorkflowVersionId, row.StableInputJsonSchema) {
+			if _, err := s.validateInput(version.workflowVersionId, row.WorkflowName, version.schema, row.InputValidationMode, input); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// inputSchemaVersion is a workflow version along with its input schema
+type inputSchemaVersion struct {
+	workflowVersionId uuid.UUID
+	schema            []byte
+}
+
+// runInputSchemaVersions returns the workflow versions whose input schema an unpinned run is validated against. If
+// the external id of the run is known, this is the version selectWorkflowVersion picks for it. Otherwise the run
+// hasn't been created yet, and it's validated against both versions of a canary rollout.
+func runInputSchemaVersions(externalId, latestVersionId uuid.UUID, latestSchema []byte, canaryPercentage pgtype.Int4, stableVersionId *uuid.UUID, stableSchema []byte) []inputSchemaVersion {
+	latest := inputSchemaVersion{workflowVersionId: latestVersionId, schema: latestSchema}
+
+	if !canaryPercentage.Valid || stableVersionId == nil {
+		return []inputSchemaVersion{latest}
+	}
+
+	stable := inputSchemaVersion{workflowVersionId: *stableVersionId, schema: stableSchema}
+
+	if externalId == uuid.Nil {
+		return []inputSchemaVersion{latest, stable}
+	}
+
+	if selectWorkflowVersion(externalId, latestVersionId, canaryPercentage, stableVersionId) == latestVersionId {
+		return []inputSchemaVersion{latest}
+	}
+
+	return []inputSchemaVersion{stable}
+}
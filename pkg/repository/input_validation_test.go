@@ -0,0 +1,128 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const testInputSchema = `{
+	"type": "object",
+	"properties": {"name": {"type": "string"}, "count": {"type": "integer", "minimum": 1}},
+	"required": ["name"]
+}`
+
+func newTestInputValidationRepository(t *testing.T) *sharedRepository {
+	cache, err := lru.New[uuid.UUID, *jsonschema.Schema](10)
+	require.NoError(t, err)
+
+	l := zerolog.Nop()
+
+	return &sharedRepository{
+		l:                &l,
+		inputSchemaCache: cache,
+	}
+}
+
+func TestValidateInput(t *testing.T) {
+	r := newTestInputValidationRepository(t)
+	versionId := uuid.New()
+
+	failure, err := r.validateInput(versionId, "wf", []byte(testInputSchema), sqlcv1.WorkflowInputValidationModeREJECT, []byte(`{"name": "a", "count": 2}`))
+	require.NoError(t, err)
+	assert.Nil(t, failure)
+
+	failure, err = r.validateInput(versionId, "wf", []byte(testInputSchema), sqlcv1.WorkflowInputValidationModeREJECT, []byte(`{"count": 0}`))
+	assert.Nil(t, failure)
+
+	invalidInput := &ErrInvalidInput{}
+	require.True(t, errors.As(err, &invalidInput))
+	assert.Equal(t, "wf", invalidInput.WorkflowName)
+	assert.Len(t, invalidInput.Violations, 2)
+	assert.Equal(t, "/count", invalidInput.Violations[0].Pointer)
+	assert.Equal(t, "/name", invalidInput.Violations[1].Pointer)
+
+	failure, err = r.validateInput(versionId, "wf", []byte(testInputSchema), sqlcv1.WorkflowInputValidationModeWARN, []byte(`{"count": 0}`))
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.Equal(t, sqlcv1.V1CelEvaluationFailureSourceINPUTSCHEMA, failure.Source)
+	assert.Contains(t, failure.ErrorMessage, "/count")
+	assert.Contains(t, failure.ErrorMessage, "/name")
+
+	_, err = r.validateInput(versionId, "wf", []byte(testInputSchema), sqlcv1.WorkflowInputValidationModeREJECT, []byte(`{`))
+	require.True(t, errors.As(err, &invalidInput))
+	assert.Equal(t, "", invalidInput.Violations[0].Pointer)
+}
+
+func TestValidateInputWithoutSchema(t *testing.T) {
+	r := newTestInputValidationRepository(t)
+
+	// workflows without an input schema aren't validated
+	failure, err := r.validateInput(uuid.New(), "wf", nil, sqlcv1.WorkflowInputValidationModeREJECT, []byte(`{"anything": true}`))
+	require.NoError(t, err)
+	assert.Nil(t, failure)
+
+	// schemas which can't be compiled are skipped, and cached so they aren't compiled again
+	versionId := uuid.New()
+
+	failure, err = r.validateInput(versionId, "wf", []byte(`[]`), sqlcv1.WorkflowInputValidationModeREJECT, []byte(`{}`))
+	require.NoError(t, err)
+	assert.Nil(t, failure)
+
+	cached, ok := r.inputSchemaCache.Get(versionId)
+	assert.True(t, ok)
+	assert.Nil(t, cached)
+}
+
+func TestRunInputSchemaVersions(t *testing.T) {
+	latest, stable := uuid.New(), uuid.New()
+	latestSchema, stableSchema := []byte(`{"type": "object"}`), []byte(testInputSchema)
+	canary := pgtype.Int4{Int32: 50, Valid: true}
+
+	versionIds := func(versions []inputSchemaVersion) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(versions))
+
+		for _, v := range versions {
+			ids = append(ids, v.workflowVersionId)
+		}
+
+		return ids
+	}
+
+	t.Run("no canary uses the latest version", func(t *testing.T) {
+		versions := runInputSchemaVersions(uuid.New(), latest, latestSchema, pgtype.Int4{}, nil, nil)
+		assert.Equal(t, []uuid.UUID{latest}, versionIds(versions))
+	})
+
+	t.Run("future runs are validated against both versions of a canary", func(t *testing.T) {
+		versions := runInputSchemaVersions(uuid.Nil, latest, latestSchema, canary, &stable, stableSchema)
+		assert.Equal(t, []uuid.UUID{latest, stable}, versionIds(versions))
+	})
+
+	t.Run("runs are validated against the version selected for them", func(t *testing.T) {
+		for range 50 {
+			externalId := uuid.New()
+			versions := runInputSchemaVersions(externalId, latest, latestSchema, canary, &stable, stableSchema)
+
+			require.Len(t, versions, 1)
+			assert.Equal(t, selectWorkflowVersion(externalId, latest, canary, &stable), versions[0].workflowVersionId)
+
+			if versions[0].workflowVersionId == stable {
+				assert.Equal(t, stableSchema, versions[0].schema)
+			} else {
+				assert.Equal(t, latestSchema, versions[0].schema)
+			}
+		}
+	})
+}
@@ -231,6 +231,11 @@ type TaskEventWithPayloads struct {
 
 type OLAPRepository interface {
 	UpdateTablePartitions(ctx context.Context) error
+
+	// CleanupExpiredRuns deletes a batch of runs whose workflow or tenant retention period has passed before their
+	// partition is dropped, and returns true if there may be more runs to delete
+	CleanupExpiredRuns(ctx context.Context) (bool, error)
+
 	SetReadReplicaPool(pool *pgxpool.Pool)
 
 	ReadTaskRun(ctx context.Context, taskExternalId uuid.UUID) (*sqlcv1.V1TasksOlap, error)
@@ -242,6 +247,10 @@ type OLAPRepository interface {
 	ListTaskRunEvents(ctx context.Context, tenantId uuid.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset int64) ([]*sqlcv1.ListTaskEventsRow, error)
 	ListTaskRunEventsByWorkflowRunId(ctx context.Context, tenantId uuid.UUID, workflowRunId uuid.UUID) ([]*TaskEventWithPayloads, error)
 	ListWorkflowRunDisplayNames(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]*sqlcv1.ListWorkflowRunDisplayNamesRow, error)
+
+	// ListWorkflowRunStatuses returns the statuses of workflow runs by external id. Runs which haven't been written to
+	// the OLAP tables yet are omitted.
+	ListWorkflowRunStatuses(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) (map[uuid.UUID]sqlcv1.V1ReadableStatusOlap, error)
 	ReadTaskRunMetrics(ctx context.Context, tenantId uuid.UUID, opts ReadTaskRunMetricsOpts) ([]TaskRunMetric, error)
 	CreateTasks(ctx context.Context, tenantId uuid.UUID, tasks []*V1TaskWithPayload) (*StatusUpdateResult, map[uuid.UUID]struct{}, error)
 	CreateTaskEvents(ctx context.Context, tenantId uuid.UUID, events []sqlcv1.CreateTaskEventsOLAPParams, eventExternalIdToWorkflowRunId map[uuid.UUID]uuid.UUID) (*StatusUpdateResult, map[uuid.UUID]struct{}, error)
@@ -289,6 +298,11 @@ type OLAPRepository interface {
 	CountOLAPTempTableSizeForTaskStatusUpdates(ctx context.Context) (int64, error)
 	ListYesterdayRunCountsByStatus(ctx context.Context) (map[sqlcv1.V1ReadableStatusOlap]int64, error)
 
+	// Alert rule queries
+	GetWorkflowRunStatusCounts(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time) (*sqlcv1.GetWorkflowRunStatusCountsRow, error)
+	GetWorkflowRunDurationPercentile(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time, percentile float64) (*sqlcv1.GetWorkflowRunDurationPercentileRow, error)
+	CountSuccessfulCronRuns(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time) (int64, error)
+
 	CreateSpans(ctx context.Context, tenantId uuid.UUID, opts *CreateSpansOpts) error
 	ListSpansByTraceId(ctx context.Context, tenantId uuid.UUID, traceId []byte, offset, limit int64) (*ListSpansResult, error)
 	CreateSpanLookupTableEntries(ctx context.Context, tenantId uuid.UUID, opts *CreateSpansOpts) error
@@ -397,9 +411,13 @@ func (r *OLAPRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 		}
 	}()
 
+	// partitions are kept until the longest retention period which can be set for a workflow has passed, runs with a
+	// shorter retention period are deleted by CleanupExpiredRuns
+	retentionPeriod := r.partitionRetentionPeriod(r.olapRetentionPeriod)
+
 	today := time.Now().UTC()
 	tomorrow := today.AddDate(0, 0, 1)
-	removeBefore := today.Add(-1 * r.olapRetentionPeriod)
+	removeBefore := today.Add(-1 * retentionPeriod)
 
 	// important: uses the ddlPool because these operations are critical (shouldn't be blocked by the main app pool)
 	// and not all can run inside of a transaction (e.g. DETACH PARTITION CONCURRENTLY),
@@ -473,10 +491,26 @@ func (r *OLAPRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 	}
 
 	if len(partitions) > 0 {
-		r.l.Warn().Ctx(ctx).Msgf("removing partitions before %s using retention period of %s", removeBefore.Format(time.RFC3339), r.olapRetentionPeriod)
+		r.l.Warn().Ctx(ctx).Msgf("removing partitions before %s using retention period of %s", removeBefore.Format(time.RFC3339), retentionPeriod)
 	}
 
+	expiredPartitions := make([]ExpiredPartition, 0, len(partitions))
+
 	for _, partition := range partitions {
+		expiredPartitions = append(expiredPartitions, ExpiredPartition{
+			ParentTable:   partition.ParentTable,
+			PartitionName: partition.PartitionName,
+		})
+	}
+
+	// partitions are archived before any of them are detached, and partitions which fail to archive are kept
+	unarchivedPartitions := r.archiveExpiredPartitions(ctx, expiredPartitions)
+
+	for _, partition := range partitions {
+		if _, ok := unarchivedPartitions[partition.PartitionName]; ok {
+			continue
+		}
+
 		r.l.Debug().Ctx(ctx).Msgf("detaching partition %s", partition.PartitionName)
 
 		conn, release, err := sqlchelpers.AcquireConnectionWithStatementTimeout(ctx, r.ddlPool, r.l, 30*60*1000) // 30 minutes
@@ -555,6 +589,56 @@ func (r *OLAPRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 	return nil
 }
 
+func (r *OLAPRepositoryImpl) CleanupExpiredRuns(ctx context.Context) (bool, error) {
+	const lockName = "cleanup-v1-olap-workflow-retention"
+	const batchSize = 1000
+
+	shortestRetentionPeriod, ok, err := r.shortestRetentionPeriod(ctx, r.olapRetentionPeriod)
+
+	if err != nil {
+		return false, err
+	}
+
+	// every run expires when its partition is dropped
+	if !ok {
+		return false, nil
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTxWithStatementTimeout(ctx, r.pool, r.l, 60*1000)
+
+	if err != nil {
+		return false, fmt.Errorf("error beginning transaction for %s: %w", lockName, err)
+	}
+
+	defer rollback()
+
+	acquired, err := r.queries.TryAdvisoryLock(ctx, tx, hash(lockName))
+
+	if err != nil {
+		return false, fmt.Errorf("error acquiring advisory lock for %s: %w", lockName, err)
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	result, err := r.queries.DeleteExpiredOLAPRunsByRetention(ctx, tx, sqlcv1.DeleteExpiredOLAPRunsByRetentionParams{
+		Shortestretentionperiod: sqlchelpers.DurationToPgInterval(shortestRetentionPeriod),
+		Defaultretentionperiod:  sqlchelpers.DurationToPgInterval(r.olapRetentionPeriod),
+		Batchsize:               batchSize,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("error deleting expired runs: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, fmt.Errorf("error committing transaction for %s: %w", lockName, err)
+	}
+
+	return result.RowsAffected() == batchSize, nil
+}
+
 func (r *OLAPRepositoryImpl) SetReadReplicaPool(pool *pgxpool.Pool) {
 	r.readPool = pool
 }
@@ -1528,6 +1612,10 @@ func (r *OLAPRepositoryImpl) ListWorkflowRunExternalIds(ctx context.Context, ten
 		params.AdditionalMetaValues = append(params.AdditionalMetaValues, value.(string))
 	}
 
+	if opts.Limit > 0 {
+		params.Limit = pgtype.Int4{Int32: int32(opts.Limit), Valid: true} // nolint: gosec
+	}
+
 	externalIds, err := r.queries.ListWorkflowRunExternalIds(ctx, tx, params)
 
 	if err != nil {
@@ -2488,6 +2576,30 @@ func (r *OLAPRepositoryImpl) ListWorkflowRunDisplayNames(ctx context.Context, te
 	})
 }
 
+func (r *OLAPRepositoryImpl) ListWorkflowRunStatuses(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) (map[uuid.UUID]sqlcv1.V1ReadableStatusOlap, error) {
+	res := make(map[uuid.UUID]sqlcv1.V1ReadableStatusOlap, len(externalIds))
+
+	if len(externalIds) == 0 {
+		return res, nil
+	}
+
+	// we read from the primary, as the statuses are used to decide whether to trigger new runs
+	rows, err := r.queries.ListWorkflowRunStatuses(ctx, r.pool, sqlcv1.ListWorkflowRunStatusesParams{
+		Tenantid:    tenantId,
+		Externalids: externalIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow run statuses: %w", err)
+	}
+
+	for _, row := range rows {
+		res[row.ExternalID] = row.ReadableStatus
+	}
+
+	return res, nil
+}
+
 func (r *OLAPRepositoryImpl) GetTaskTimings(ctx context.Context, tenantId uuid.UUID, workflowRunId uuid.UUID, depth int32) ([]*sqlcv1.PopulateTaskRunDataRow, map[uuid.UUID]int32, error) {
 	ctx, span := telemetry.NewSpan(ctx, "get-task-timings-olap")
 	defer span.End()
@@ -2796,6 +2908,7 @@ func (r *OLAPRepositoryImpl) ListEvents(ctx context.Context, opts sqlcv1.ListEve
 			AdditionalMetadata: opts.AdditionalMetadata,
 			Statuses:           opts.Statuses,
 			Scopes:             opts.Scopes,
+			KeyPattern:         opts.KeyPattern,
 		})
 
 		if err != nil {
@@ -3390,6 +3503,36 @@ func (r *OLAPRepositoryImpl) ListYesterdayRunCountsByStatus(ctx context.Context)
 	return statusToCount, nil
 }
 
+// maxQueuedRunAge bounds how long ago the queued runs counted by GetWorkflowRunStatusCounts were inserted, so that
+// counting them doesn't scan every partition of the runs
+const maxQueuedRunAge = 24 * time.Hour
+
+func (r *OLAPRepositoryImpl) GetWorkflowRunStatusCounts(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time) (*sqlcv1.GetWorkflowRunStatusCountsRow, error) {
+	return r.queries.GetWorkflowRunStatusCounts(ctx, r.readPool, sqlcv1.GetWorkflowRunStatusCountsParams{
+		Tenantid:    tenantId,
+		Workflowid:  workflowId,
+		Since:       sqlchelpers.TimestamptzFromTime(since),
+		Queuedsince: sqlchelpers.TimestamptzFromTime(time.Now().Add(-maxQueuedRunAge)),
+	})
+}
+
+func (r *OLAPRepositoryImpl) GetWorkflowRunDurationPercentile(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time, percentile float64) (*sqlcv1.GetWorkflowRunDurationPercentileRow, error) {
+	return r.queries.GetWorkflowRunDurationPercentile(ctx, r.readPool, sqlcv1.GetWorkflowRunDurationPercentileParams{
+		Tenantid:   tenantId,
+		Workflowid: workflowId,
+		Since:      sqlchelpers.TimestamptzFromTime(since),
+		Percentile: percentile,
+	})
+}
+
+func (r *OLAPRepositoryImpl) CountSuccessfulCronRuns(ctx context.Context, tenantId, workflowId uuid.UUID, since time.Time) (int64, error) {
+	return r.queries.CountSuccessfulCronRuns(ctx, r.readPool, sqlcv1.CountSuccessfulCronRunsParams{
+		Tenantid:   tenantId,
+		Workflowid: workflowId,
+		Since:      sqlchelpers.TimestamptzFromTime(since),
+	})
+}
+
 type BulkCutOverOLAPPayload struct {
 	TenantID            uuid.UUID
 	InsertedAt          pgtype.Timestamptz
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
+)
+
+// ErrInvalidOutput is returned when the output of a task doesn't match the output JSON schema of its step.
+type ErrInvalidOutput struct {
+	StepReadableId string
+	Violations     []jsonschema.Violation
+}
+
+func (e *ErrInvalidOutput) Error() string {
+	return fmt.Sprintf("output of task %s does not match its output schema: %s", e.StepReadableId, joinViolations(e.Violations))
+}
+
+// getOutputSchema returns the compiled output schema for a step, or nil if the step doesn't have an output schema or
+// the schema can't be compiled.
+func (s *sharedRepository) getOutputSchema(ctx context.Context, stepId uuid.UUID) (*jsonschema.Schema, error) {
+	if schema, ok := s.outputSchemaCache.Get(stepId); ok {
+		return schema, nil
+	}
+
+	rawSchema, err := s.queries.GetStepOutputSchema(ctx, s.pool, stepId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get step output schema: %w", err)
+	}
+
+	var schema *jsonschema.Schema
+
+	if len(rawSchema) > 0 {
+		schema, err = jsonschema.Compile(rawSchema)
+
+		if err != nil {
+			// we don't fail tasks on a schema which we can't compile, the schema is registered by the SDK
+			s.l.Warn().Err(err).Str("step_id", stepId.String()).Msg("could not compile task output schema, skipping output validation")
+
+			schema = nil
+		}
+	}
+
+	s.outputSchemaCache.Add(stepId, schema)
+
+	return schema, nil
+}
+
+func (r *TaskRepositoryImpl) ValidateTaskOutput(ctx context.Context, stepId uuid.UUID, stepReadableId string, output []byte) error {
+	schema, err := r.getOutputSchema(ctx, stepId)
+
+	if err != nil {
+		return err
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	violations, err := schema.Validate(output)
+
+	if err != nil {
+		violations = []jsonschema.Violation{
+			{
+				Message: "output is not valid JSON",
+			},
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ErrInvalidOutput{
+		StepReadableId: stepReadableId,
+		Violations:     violations,
+	}
+}
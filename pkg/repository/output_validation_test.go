@@ -0,0 +1,74 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
+)
+
+const testOutputSchema = `{
+	"type": "object",
+	"properties": {"total": {"type": "number"}, "items": {"type": "array", "items": {"type": "string"}}},
+	"required": ["total"]
+}`
+
+func newTestOutputValidationRepository(t *testing.T, stepId uuid.UUID, rawSchema string) *TaskRepositoryImpl {
+	cache, err := lru.New[uuid.UUID, *jsonschema.Schema](10)
+	require.NoError(t, err)
+
+	// seed the cache so the schema isn't read from the database
+	if rawSchema == "" {
+		cache.Add(stepId, nil)
+	} else {
+		schema, err := jsonschema.Compile([]byte(rawSchema))
+		require.NoError(t, err)
+
+		cache.Add(stepId, schema)
+	}
+
+	l := zerolog.Nop()
+
+	return &TaskRepositoryImpl{
+		sharedRepository: &sharedRepository{
+			l:                 &l,
+			outputSchemaCache: cache,
+		},
+	}
+}
+
+func TestValidateTaskOutput(t *testing.T) {
+	ctx := context.Background()
+	stepId := uuid.New()
+	r := newTestOutputValidationRepository(t, stepId, testOutputSchema)
+
+	require.NoError(t, r.ValidateTaskOutput(ctx, stepId, "step", []byte(`{"total": 1.5, "items": ["a"]}`)))
+
+	err := r.ValidateTaskOutput(ctx, stepId, "step", []byte(`{"items": [1]}`))
+
+	invalidOutput := &ErrInvalidOutput{}
+	require.True(t, errors.As(err, &invalidOutput))
+	assert.Equal(t, "step", invalidOutput.StepReadableId)
+	assert.Len(t, invalidOutput.Violations, 2)
+	assert.Contains(t, err.Error(), "output of task step does not match its output schema")
+
+	err = r.ValidateTaskOutput(ctx, stepId, "step", []byte(`not json`))
+	require.True(t, errors.As(err, &invalidOutput))
+	assert.Equal(t, "output is not valid JSON", invalidOutput.Violations[0].Message)
+}
+
+func TestValidateTaskOutputWithoutSchema(t *testing.T) {
+	stepId := uuid.New()
+	r := newTestOutputValidationRepository(t, stepId, "")
+
+	require.NoError(t, r.ValidateTaskOutput(context.Background(), stepId, "step", []byte(`{"anything": true}`)))
+}
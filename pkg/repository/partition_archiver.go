@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ExpiredPartition is a partition which is about to be dropped because it's older than the retention period.
+type ExpiredPartition struct {
+	ParentTable   string
+	PartitionName string
+}
+
+// PartitionArchiver exports the contents of expired partitions before they're dropped. If ArchivePartition returns
+// an error, the partition is kept and archiving is retried the next time partitions are cleaned up.
+type PartitionArchiver interface {
+	ArchivePartition(ctx context.Context, db sqlcv1.DBTX, partition ExpiredPartition) error
+}
+
+// archiveExpiredPartitions archives the expired partitions with the configured archiver, if there is one, before
+// any of them are dropped. It returns the names of the partitions which couldn't be archived, which must be kept,
+// so that a partition which fails to archive doesn't stop the others from being dropped.
+func (s *sharedRepository) archiveExpiredPartitions(ctx context.Context, partitions []ExpiredPartition) map[string]struct{} {
+	failed := make(map[string]struct{})
+
+	if s.partitionArchiver == nil {
+		return failed
+	}
+
+	for _, partition := range partitions {
+		s.l.Debug().Ctx(ctx).Msgf("archiving partition %s", partition.PartitionName)
+
+		if err := s.partitionArchiver.ArchivePartition(ctx, s.ddlPool, partition); err != nil {
+			s.l.Error().Ctx(ctx).Err(err).Msgf("failed to archive partition %s, it will not be dropped", partition.PartitionName)
+
+			failed[partition.PartitionName] = struct{}{}
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,50 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+type failingPartitionArchiver struct {
+	failing  map[string]bool
+	archived []string
+}
+
+func (a *failingPartitionArchiver) ArchivePartition(ctx context.Context, db sqlcv1.DBTX, partition ExpiredPartition) error {
+	if a.failing[partition.PartitionName] {
+		return errors.New("sink unavailable")
+	}
+
+	a.archived = append(a.archived, partition.PartitionName)
+
+	return nil
+}
+
+func TestArchiveExpiredPartitionsKeepsFailedPartitions(t *testing.T) {
+	l := zerolog.Nop()
+	archiver := &failingPartitionArchiver{failing: map[string]bool{"v1_task_20260102": true}}
+	shared := &sharedRepository{l: &l, partitionArchiver: archiver}
+
+	unarchived := shared.archiveExpiredPartitions(context.Background(), []ExpiredPartition{
+		{ParentTable: "v1_task", PartitionName: "v1_task_20260101"},
+		{ParentTable: "v1_task", PartitionName: "v1_task_20260102"},
+		{ParentTable: "v1_task", PartitionName: "v1_task_20260103"},
+	})
+
+	// a failed partition doesn't stop the partitions after it from being archived
+	assert.Equal(t, []string{"v1_task_20260101", "v1_task_20260103"}, archiver.archived)
+	assert.Equal(t, map[string]struct{}{"v1_task_20260102": {}}, unarchived)
+
+	// without an archiver, every partition is dropped
+	assert.Empty(t, (&sharedRepository{l: &l}).archiveExpiredPartitions(context.Background(), []ExpiredPartition{
+		{ParentTable: "v1_task", PartitionName: "v1_task_20260101"},
+	}))
+}
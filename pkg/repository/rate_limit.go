@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -42,6 +43,13 @@ type UpsertRateLimitOpts struct {
 
 	// The rate limit duration
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the refill mode of the rate limit, defaults to FIXED_WINDOW
+	Mode *string `validate:"omitnil,oneof=FIXED_WINDOW SLIDING_WINDOW TOKEN_BUCKET"`
+
+	// (optional) the maximum number of units which can be used in a burst, only valid for TOKEN_BUCKET
+	// rate limits. Defaults to the limit.
+	Burst *int `validate:"omitnil,min=1"`
 }
 
 type RateLimitRepository interface {
@@ -145,6 +153,28 @@ func (r *rateLimitRepository) UpsertRateLimit(ctx context.Context, tenantId uuid
 		upsertParams.Window = sqlchelpers.TextFromStr(getWindowParamFromDurString(*opts.Duration))
 	}
 
+	if opts.Mode != nil {
+		upsertParams.Mode = sqlcv1.NullRateLimitMode{
+			RateLimitMode: sqlcv1.RateLimitMode(*opts.Mode),
+			Valid:         true,
+		}
+	}
+
+	if opts.Burst != nil {
+		if !upsertParams.Mode.Valid || upsertParams.Mode.RateLimitMode != sqlcv1.RateLimitModeTOKENBUCKET {
+			return nil, fmt.Errorf("burst is only supported for %s rate limits", sqlcv1.RateLimitModeTOKENBUCKET)
+		}
+
+		upsertParams.Burst = pgtype.Int4{
+			Int32: int32(*opts.Burst), // nolint: gosec
+			Valid: true,
+		}
+	}
+
+	if upsertParams.Mode.Valid && upsertParams.Mode.RateLimitMode == sqlcv1.RateLimitModeTOKENBUCKET && opts.Limit < 1 {
+		return nil, fmt.Errorf("%s rate limits must have a limit of at least 1", sqlcv1.RateLimitModeTOKENBUCKET)
+	}
+
 	rateLimit, err := r.queries.UpsertRateLimit(ctx, r.pool, upsertParams)
 
 	if err != nil {
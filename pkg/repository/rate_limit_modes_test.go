@@ -0,0 +1,173 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+func newRateLimitTestRepository(pool *pgxpool.Pool) *rateLimitRepository {
+	logger := zerolog.Nop()
+	shared := &sharedRepository{
+		pool:       pool,
+		ddlPool:    pool,
+		l:          &logger,
+		queries:    sqlcv1.New(),
+		v:          validator.NewDefaultValidator(),
+		queueCache: cache.New(5 * time.Minute),
+	}
+	return newRateLimitRepository(shared)
+}
+
+// rewindRateLimit simulates the passage of time by moving the last refill of a rate limit into the past.
+func rewindRateLimit(ctx context.Context, t *testing.T, pool *pgxpool.Pool, tenantId uuid.UUID, key string, d time.Duration) {
+	t.Helper()
+
+	_, err := pool.Exec(ctx, `
+		UPDATE "RateLimit"
+		SET "lastRefill" = "lastRefill" - make_interval(secs => $3)
+		WHERE "tenantId" = $1 AND "key" = $2
+	`, tenantId, key, d.Seconds())
+	require.NoError(t, err)
+}
+
+func rateLimitValue(ctx context.Context, t *testing.T, repo *rateLimitRepository, tenantId uuid.UUID, key string, units int) int32 {
+	t.Helper()
+
+	updates := map[string]int{}
+
+	if units > 0 {
+		updates[key] = units
+	}
+
+	rls, _, err := repo.UpdateRateLimits(ctx, tenantId, updates)
+	require.NoError(t, err)
+
+	for _, rl := range rls {
+		if rl.Key == key {
+			return rl.Value
+		}
+	}
+
+	t.Fatalf("rate limit %s not found", key)
+	return 0
+}
+
+func TestRateLimitModes(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := newRateLimitTestRepository(pool)
+
+	t.Run("token bucket refills continuously up to the burst size", func(t *testing.T) {
+		tenantId := uuid.New()
+		mode := string(sqlcv1.RateLimitModeTOKENBUCKET)
+		duration := "MINUTE"
+		burst := 10
+
+		rl, err := repo.UpsertRateLimit(ctx, tenantId, "tb", &UpsertRateLimitOpts{
+			Limit:    60,
+			Duration: &duration,
+			Mode:     &mode,
+			Burst:    &burst,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), rl.Value, "a new token bucket starts full")
+
+		assert.Equal(t, int32(0), rateLimitValue(ctx, t, repo, tenantId, "tb", 10))
+
+		// 60 per minute accrues one token per second
+		rewindRateLimit(ctx, t, pool, tenantId, "tb", 5*time.Second)
+		assert.Equal(t, int32(5), rateLimitValue(ctx, t, repo, tenantId, "tb", 0))
+
+		rewindRateLimit(ctx, t, pool, tenantId, "tb", time.Hour)
+		assert.Equal(t, int32(10), rateLimitValue(ctx, t, repo, tenantId, "tb", 0), "tokens are capped at the burst size")
+	})
+
+	t.Run("sliding window weights the previous window's usage", func(t *testing.T) {
+		tenantId := uuid.New()
+		mode := string(sqlcv1.RateLimitModeSLIDINGWINDOW)
+		duration := "MINUTE"
+
+		_, err := repo.UpsertRateLimit(ctx, tenantId, "sw", &UpsertRateLimitOpts{
+			Limit:    10,
+			Duration: &duration,
+			Mode:     &mode,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(0), rateLimitValue(ctx, t, repo, tenantId, "sw", 10))
+
+		// a fixed window would be full again here, but half of the previous window still overlaps
+		rewindRateLimit(ctx, t, pool, tenantId, "sw", 90*time.Second)
+		assert.Equal(t, int32(5), rateLimitValue(ctx, t, repo, tenantId, "sw", 0))
+
+		rewindRateLimit(ctx, t, pool, tenantId, "sw", 2*time.Minute)
+		assert.Equal(t, int32(10), rateLimitValue(ctx, t, repo, tenantId, "sw", 0))
+	})
+
+	t.Run("fixed window resets at the end of the window", func(t *testing.T) {
+		tenantId := uuid.New()
+		duration := "MINUTE"
+
+		rl, err := repo.UpsertRateLimit(ctx, tenantId, "fw", &UpsertRateLimitOpts{
+			Limit:    10,
+			Duration: &duration,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sqlcv1.RateLimitModeFIXEDWINDOW, rl.Mode)
+
+		assert.Equal(t, int32(0), rateLimitValue(ctx, t, repo, tenantId, "fw", 10))
+
+		rewindRateLimit(ctx, t, pool, tenantId, "fw", 30*time.Second)
+		assert.Equal(t, int32(0), rateLimitValue(ctx, t, repo, tenantId, "fw", 0))
+
+		rewindRateLimit(ctx, t, pool, tenantId, "fw", 30*time.Second)
+		assert.Equal(t, int32(10), rateLimitValue(ctx, t, repo, tenantId, "fw", 0))
+	})
+
+	t.Run("upserting without a mode keeps the mode", func(t *testing.T) {
+		tenantId := uuid.New()
+		mode := string(sqlcv1.RateLimitModeSLIDINGWINDOW)
+		duration := "MINUTE"
+
+		_, err := repo.UpsertRateLimit(ctx, tenantId, "keep", &UpsertRateLimitOpts{
+			Limit:    10,
+			Duration: &duration,
+			Mode:     &mode,
+		})
+		require.NoError(t, err)
+
+		// e.g. a worker which declares the limit without a mode when it starts
+		rl, err := repo.UpsertRateLimit(ctx, tenantId, "keep", &UpsertRateLimitOpts{
+			Limit:    20,
+			Duration: &duration,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sqlcv1.RateLimitModeSLIDINGWINDOW, rl.Mode)
+		assert.Equal(t, int32(20), rl.LimitValue)
+	})
+
+	t.Run("burst requires a token bucket", func(t *testing.T) {
+		burst := 5
+
+		_, err := repo.UpsertRateLimit(ctx, uuid.New(), "invalid", &UpsertRateLimitOpts{
+			Limit: 10,
+			Burst: &burst,
+		})
+		require.Error(t, err)
+	})
+}
@@ -35,6 +35,7 @@ type Repository interface {
 	OverwriteLogsRepository(l LogLineRepository)
 	Payloads() PayloadStoreRepository
 	OverwriteExternalPayloadStore(o ExternalStore)
+	SetPartitionArchiver(a PartitionArchiver)
 	Workers() WorkerRepository
 	Workflows() WorkflowRepository
 	Ticker() TickerRepository
@@ -47,6 +48,7 @@ type Repository interface {
 	Slack() SlackRepository
 	SNS() SNSRepository
 	TenantInvite() TenantInviteRepository
+	TenantRole() TenantRoleRepository
 	TenantLimit() TenantLimitRepository
 	TenantEntitlement() TenantEntitlementRepository
 	TenantAlertingSettings() TenantAlertingRepository
@@ -54,6 +56,9 @@ type Repository interface {
 	User() UserRepository
 	UserSession() UserSessionRepository
 	WorkflowSchedules() WorkflowScheduleRepository
+	DeadLetter() DeadLetterRepository
+	BulkOperations() BulkOperationRepository
+	WorkflowRetention() WorkflowRetentionRepository
 	Sync() SyncRepository
 }
 
@@ -83,6 +88,7 @@ type repositoryImpl struct {
 	slack             SlackRepository
 	sns               SNSRepository
 	tenantInvite      TenantInviteRepository
+	tenantRole        TenantRoleRepository
 	tenantLimit       TenantLimitRepository
 	tenantEntitlement TenantEntitlementRepository
 	tenantAlerting    TenantAlertingRepository
@@ -90,7 +96,12 @@ type repositoryImpl struct {
 	user              UserRepository
 	userSession       UserSessionRepository
 	workflowSchedules WorkflowScheduleRepository
+	deadLetter        DeadLetterRepository
+	bulkOperations    BulkOperationRepository
+	workflowRetention WorkflowRetentionRepository
 	sync              SyncRepository
+
+	shared *sharedRepository
 }
 
 func NewRepository(
@@ -137,6 +148,7 @@ func NewRepository(
 		slack:             newSlackRepository(shared),
 		sns:               newSNSRepository(shared),
 		tenantInvite:      newTenantInviteRepository(shared),
+		tenantRole:        newTenantRoleRepository(shared),
 		tenantLimit:       newTenantLimitRepository(shared, tenantLimitConfig, enforceLimits, cacheDuration),
 		tenantEntitlement: newTenantEntitlementRepository(shared),
 		tenantAlerting:    newTenantAlertingRepository(shared, cacheDuration),
@@ -144,7 +156,11 @@ func NewRepository(
 		user:              newUserRepository(shared),
 		userSession:       newUserSessionRepository(shared),
 		workflowSchedules: newWorkflowScheduleRepository(shared),
+		deadLetter:        newDeadLetterRepository(shared),
+		bulkOperations:    newBulkOperationRepository(shared),
+		workflowRetention: newWorkflowRetentionRepository(shared),
 		sync:              NewSyncRepository(pool, l),
+		shared:            shared,
 	}
 
 	return impl, func() error {
@@ -226,6 +242,12 @@ func (r *repositoryImpl) OverwriteExternalPayloadStore(o ExternalStore) {
 	r.payloadStore.OverwriteExternalStore(o)
 }
 
+// SetPartitionArchiver sets the archiver which exports task and OLAP partitions before they're dropped. It must be
+// called before partitions are cleaned up, and doesn't apply to an OLAP repository set with OverwriteOLAPRepository.
+func (r *repositoryImpl) SetPartitionArchiver(a PartitionArchiver) {
+	r.shared.partitionArchiver = a
+}
+
 func (r *repositoryImpl) Workers() WorkerRepository {
 	return r.workers
 }
@@ -274,6 +296,10 @@ func (r *repositoryImpl) TenantInvite() TenantInviteRepository {
 	return r.tenantInvite
 }
 
+func (r *repositoryImpl) TenantRole() TenantRoleRepository {
+	return r.tenantRole
+}
+
 func (r *repositoryImpl) TenantLimit() TenantLimitRepository {
 	return r.tenantLimit
 }
@@ -302,6 +328,18 @@ func (r *repositoryImpl) WorkflowSchedules() WorkflowScheduleRepository {
 	return r.workflowSchedules
 }
 
+func (r *repositoryImpl) DeadLetter() DeadLetterRepository {
+	return r.deadLetter
+}
+
+func (r *repositoryImpl) BulkOperations() BulkOperationRepository {
+	return r.bulkOperations
+}
+
+func (r *repositoryImpl) WorkflowRetention() WorkflowRetentionRepository {
+	return r.workflowRetention
+}
+
 func (r *repositoryImpl) Sync() SyncRepository {
 	return r.sync
 }
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// toStepRetryPolicyParams flattens the retry strategy and retry rules of a step into the rows of
+// v1_step_retry_policy. The step-level policy is stored with an empty error type, and retry rules inherit the
+// strategy and base delay of the step unless they set their own. Returns nil if the step uses the default
+// exponential backoff without any retry rules, in which case no rows need to be written.
+func toStepRetryPolicyParams(tenantId, stepId uuid.UUID, opts CreateStepOpts) *sqlcv1.CreateStepRetryPoliciesParams {
+	if opts.RetryBackoffStrategy == nil && opts.RetryBackoffBaseSeconds == nil && len(opts.RetryRules) == 0 {
+		return nil
+	}
+
+	strategy := string(sqlcv1.V1RetryBackoffStrategyEXPONENTIAL)
+
+	if opts.RetryBackoffStrategy != nil {
+		strategy = *opts.RetryBackoffStrategy
+	}
+
+	// negative values are written as NULL
+	baseSeconds := float64(-1)
+
+	if opts.RetryBackoffBaseSeconds != nil {
+		baseSeconds = *opts.RetryBackoffBaseSeconds
+	}
+
+	params := &sqlcv1.CreateStepRetryPoliciesParams{
+		Tenantid:           tenantId,
+		Stepid:             stepId,
+		Errortypes:         []string{""},
+		Maxretries:         []int32{-1},
+		Backoffstrategies:  []string{strategy},
+		Backoffbaseseconds: []float64{baseSeconds},
+	}
+
+	seen := make(map[string]struct{}, len(opts.RetryRules))
+
+	for _, rule := range opts.RetryRules {
+		// the first rule for an error type wins, which keeps the insert from violating the primary key
+		if _, ok := seen[rule.ErrorType]; ok || rule.ErrorType == "" {
+			continue
+		}
+
+		seen[rule.ErrorType] = struct{}{}
+
+		maxRetries := int32(-1)
+
+		if rule.MaxRetries != nil {
+			maxRetries = *rule.MaxRetries
+		}
+
+		ruleStrategy := strategy
+
+		if rule.BackoffStrategy != nil {
+			ruleStrategy = *rule.BackoffStrategy
+		}
+
+		ruleBaseSeconds := baseSeconds
+
+		if rule.BackoffBaseSeconds != nil {
+			ruleBaseSeconds = *rule.BackoffBaseSeconds
+		}
+
+		params.Errortypes = append(params.Errortypes, rule.ErrorType)
+		params.Maxretries = append(params.Maxretries, maxRetries)
+		params.Backoffstrategies = append(params.Backoffstrategies, ruleStrategy)
+		params.Backoffbaseseconds = append(params.Backoffbaseseconds, ruleBaseSeconds)
+	}
+
+	return params
+}
@@ -0,0 +1,75 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToStepRetryPolicyParams_DefaultBackoff(t *testing.T) {
+	factor := 2.0
+
+	params := toStepRetryPolicyParams(uuid.New(), uuid.New(), CreateStepOpts{
+		RetryBackoffFactor: &factor,
+	})
+
+	assert.Nil(t, params, "steps with the default exponential backoff don't need a retry policy")
+}
+
+func TestToStepRetryPolicyParams_StepStrategy(t *testing.T) {
+	tenantId := uuid.New()
+	stepId := uuid.New()
+	strategy := "FIXED"
+	base := 5.0
+
+	params := toStepRetryPolicyParams(tenantId, stepId, CreateStepOpts{
+		RetryBackoffStrategy:    &strategy,
+		RetryBackoffBaseSeconds: &base,
+	})
+
+	require.NotNil(t, params)
+	assert.Equal(t, tenantId, params.Tenantid)
+	assert.Equal(t, stepId, params.Stepid)
+	assert.Equal(t, []string{""}, params.Errortypes)
+	assert.Equal(t, []int32{-1}, params.Maxretries)
+	assert.Equal(t, []string{"FIXED"}, params.Backoffstrategies)
+	assert.Equal(t, []float64{5}, params.Backoffbaseseconds)
+}
+
+func TestToStepRetryPolicyParams_RulesInheritStepPolicy(t *testing.T) {
+	strategy := "FULL_JITTER"
+	ruleStrategy := "FIXED"
+	ruleBase := 30.0
+	noRetries := int32(0)
+
+	params := toStepRetryPolicyParams(uuid.New(), uuid.New(), CreateStepOpts{
+		RetryBackoffStrategy: &strategy,
+		RetryRules: []CreateStepRetryRuleOpts{
+			{ErrorType: "RateLimitError", BackoffStrategy: &ruleStrategy, BackoffBaseSeconds: &ruleBase},
+			{ErrorType: "ValidationError", MaxRetries: &noRetries},
+			{ErrorType: "RateLimitError", MaxRetries: &noRetries},
+		},
+	})
+
+	require.NotNil(t, params)
+	assert.Equal(t, []string{"", "RateLimitError", "ValidationError"}, params.Errortypes)
+	assert.Equal(t, []int32{-1, -1, 0}, params.Maxretries)
+	assert.Equal(t, []string{"FULL_JITTER", "FIXED", "FULL_JITTER"}, params.Backoffstrategies)
+	assert.Equal(t, []float64{-1, 30, -1}, params.Backoffbaseseconds)
+}
+
+func TestToStepRetryPolicyParams_RulesOnly(t *testing.T) {
+	params := toStepRetryPolicyParams(uuid.New(), uuid.New(), CreateStepOpts{
+		RetryRules: []CreateStepRetryRuleOpts{
+			{ErrorType: "TimeoutError"},
+		},
+	})
+
+	require.NotNil(t, params)
+	assert.Equal(t, []string{"", "TimeoutError"}, params.Errortypes)
+	assert.Equal(t, []string{"EXPONENTIAL", "EXPONENTIAL"}, params.Backoffstrategies)
+}
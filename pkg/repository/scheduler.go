@@ -40,6 +40,7 @@ type QueueRepository interface {
 	RequeueRateLimitedItems(ctx context.Context, tenantId uuid.UUID, queueName string) ([]*sqlcv1.RequeueRateLimitedQueueItemsRow, error)
 	GetDesiredLabels(ctx context.Context, tx *OptimisticTx, stepIds []uuid.UUID) (map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow, error)
 	GetStepSlotRequests(ctx context.Context, tx *OptimisticTx, stepIds []uuid.UUID) (map[uuid.UUID]map[string]int32, error)
+	GetStepWorkflowVersions(ctx context.Context, tx *OptimisticTx, stepIds []uuid.UUID) (map[uuid.UUID]string, error)
 	Cleanup()
 }
 
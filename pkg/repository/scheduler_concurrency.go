@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -58,6 +59,14 @@ type RunConcurrencyResult struct {
 	FailedAdvisoryLock bool
 }
 
+// ConcurrencyWeightInput is the data of a task which a WEIGHTED_FAIR weight expression can read, next to the
+// concurrency key.
+type ConcurrencyWeightInput struct {
+	Input map[string]interface{}
+
+	AdditionalMetadata map[string]interface{}
+}
+
 type ConcurrencyRepository interface {
 	// Checks whether the concurrency strategy is active, and if not, sets is_active=False
 	UpdateConcurrencyStrategyIsActive(ctx context.Context, tenantId uuid.UUID, strategy *sqlcv1.V1StepConcurrency) error
@@ -70,6 +79,10 @@ type ConcurrencyRepository interface {
 
 	ReadConcurrencySlotsForIndexing(ctx context.Context, tenantId uuid.UUID, strategyId int64, writeCh chan<- *sqlcv1.ListConcurrencySlotsForIndexingRow) error
 
+	// ListConcurrencyWeightInputs returns the input and additional metadata of each task, keyed by task id,
+	// which a WEIGHTED_FAIR weight expression is evaluated against. Tasks which no longer exist are omitted.
+	ListConcurrencyWeightInputs(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) (map[int64]*ConcurrencyWeightInput, error)
+
 	// UpdateConcurrencySlots manages its own transaction, for callers (e.g. the post-build queueing
 	// pass) that have no transaction to attach to. Callers that already hold a transaction (e.g. the
 	// WAL flush riding the outbox transaction) should use UpdateConcurrencySlotsTx instead.
@@ -173,6 +186,9 @@ func (c *ConcurrencyRepositoryImpl) RunConcurrencyStrategy(
 		if err != nil {
 			return nil, fmt.Errorf("cancel newest (strategy ID: %d): %w", strategy.ID, err)
 		}
+	case sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR:
+		// weighted fair strategies are run by the in-memory concurrency index
+		return nil, fmt.Errorf("weighted fair (strategy ID: %d): not supported without the in-memory concurrency index", strategy.ID)
 	}
 
 	return res, nil
@@ -900,6 +916,71 @@ func (c *ConcurrencyRepositoryImpl) ReadConcurrencySlotsForIndexing(ctx context.
 	return nil
 }
 
+func (c *ConcurrencyRepositoryImpl) ListConcurrencyWeightInputs(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) (map[int64]*ConcurrencyWeightInput, error) {
+	res := make(map[int64]*ConcurrencyWeightInput, len(tasks))
+
+	if len(tasks) == 0 {
+		return res, nil
+	}
+
+	taskIds := make([]int64, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.Id
+	}
+
+	rows, err := c.queries.ListTasks(ctx, c.pool, sqlcv1.ListTasksParams{
+		TenantID: tenantId,
+		Ids:      taskIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	retrieveOpts := make([]RetrievePayloadOpts, len(rows))
+
+	for i, row := range rows {
+		retrieveOpts[i] = RetrievePayloadOpts{
+			Id:         row.ID,
+			InsertedAt: row.InsertedAt,
+			Type:       sqlcv1.V1PayloadTypeTASKINPUT,
+			TenantId:   tenantId,
+			ExternalId: row.ExternalID,
+		}
+	}
+
+	payloads, err := c.payloadStore.Retrieve(ctx, c.pool, retrieveOpts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve task inputs: %w", err)
+	}
+
+	for i, row := range rows {
+		input, ok := payloads[retrieveOpts[i]]
+
+		if !ok {
+			// fall back to the input stored on the task if it wasn't found in the payload store
+			input = row.Input
+		}
+
+		weightInput := &ConcurrencyWeightInput{
+			Input:              c.newTaskInputFromExistingBytes(input).Input,
+			AdditionalMetadata: make(map[string]interface{}),
+		}
+
+		if len(row.AdditionalMetadata) > 0 {
+			if err := json.Unmarshal(row.AdditionalMetadata, &weightInput.AdditionalMetadata); err != nil {
+				c.l.Warn().Err(err).Int64("task_id", row.ID).Msg("could not unmarshal additional metadata for concurrency weight")
+			}
+		}
+
+		res[row.ID] = weightInput
+	}
+
+	return res, nil
+}
+
 func (c *ConcurrencyRepositoryImpl) UpdateConcurrencySlotsTx(
 	ctx context.Context,
 	tx pgx.Tx,
@@ -16,6 +16,9 @@ type ListActiveWorkersResult struct {
 	ID     uuid.UUID
 	Name   string
 	Labels []*sqlcv1.ListManyWorkerLabelsRow
+
+	// WorkflowVersions maps workflow ids to the version of the workflow the worker registered
+	WorkflowVersions map[uuid.UUID]string
 }
 
 type leaseRepository struct {
@@ -144,13 +147,20 @@ func (d *leaseRepository) ListActiveWorkers(ctx context.Context, tenantId uuid.U
 		workerIdsToLabels[label.WorkerId] = append(workerIdsToLabels[label.WorkerId], label)
 	}
 
+	workerIdsToWorkflowVersions, err := d.listWorkerWorkflowVersions(ctx, tenantId, workerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
 	res := make([]*ListActiveWorkersResult, 0, len(activeWorkers))
 
 	for _, worker := range activeWorkers {
 		res = append(res, &ListActiveWorkersResult{
-			ID:     worker.ID,
-			Labels: workerIdsToLabels[worker.ID],
-			Name:   worker.Name,
+			ID:               worker.ID,
+			Labels:           workerIdsToLabels[worker.ID],
+			Name:             worker.Name,
+			WorkflowVersions: workerIdsToWorkflowVersions[worker.ID],
 		})
 	}
 
@@ -186,13 +196,43 @@ func (d *leaseRepository) GetActiveWorker(ctx context.Context, tenantId, workerI
 		workerIdsToLabels[label.WorkerId] = append(workerIdsToLabels[label.WorkerId], label)
 	}
 
+	workerIdsToWorkflowVersions, err := d.listWorkerWorkflowVersions(ctx, tenantId, []uuid.UUID{workerId})
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &ListActiveWorkersResult{
-		ID:     worker.Worker.ID,
-		Labels: workerIdsToLabels[worker.Worker.ID],
-		Name:   worker.Worker.Name,
+		ID:               worker.Worker.ID,
+		Labels:           workerIdsToLabels[worker.Worker.ID],
+		Name:             worker.Worker.Name,
+		WorkflowVersions: workerIdsToWorkflowVersions[worker.Worker.ID],
 	}, nil
 }
 
+func (d *leaseRepository) listWorkerWorkflowVersions(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) (map[uuid.UUID]map[uuid.UUID]string, error) {
+	workflowVersions, err := d.queries.ListWorkerWorkflowVersions(ctx, d.pool, sqlcv1.ListWorkerWorkflowVersionsParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID]map[uuid.UUID]string)
+
+	for _, wv := range workflowVersions {
+		if _, ok := res[wv.WorkerID]; !ok {
+			res[wv.WorkerID] = make(map[uuid.UUID]string)
+		}
+
+		res[wv.WorkerID][wv.WorkflowID] = wv.Version
+	}
+
+	return res, nil
+}
+
 func (d *leaseRepository) ListConcurrencyStrategies(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1StepConcurrency, error) {
 	ctx, span := telemetry.NewSpan(ctx, "list-concurrency-strategies")
 	defer span.End()
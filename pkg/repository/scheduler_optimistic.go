@@ -79,12 +79,14 @@ func (r *optimisticSchedulingRepositoryImpl) TriggerFromNames(ctx context.Contex
 		return nil, nil, nil, fmt.Errorf("failed to prepare trigger from workflow names: %w", err)
 	}
 
-	tasks, dags, err := r.triggerWorkflows(ctx, tx, tenantId, triggerOpts, nil)
+	tasks, dags, scopedLimitFailures, err := r.triggerWorkflows(ctx, tx, tenantId, triggerOpts, nil)
 
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to trigger workflows: %w", err)
 	}
 
+	r.logScopedLimitFailures(ctx, tenantId, scopedLimitFailures)
+
 	// get the queue items for the tasks that were created
 	taskIds := make([]int64, 0, len(tasks))
 	taskInsertedAts := make([]pgtype.Timestamptz, 0, len(tasks))
@@ -766,6 +766,59 @@ func (d *queueRepository) GetStepSlotRequests(ctx context.Context, tx *Optimisti
 	return stepIdToRequests, nil
 }
 
+// GetStepWorkflowVersions returns the version of the workflow which each step belongs to. Steps of unversioned
+// workflows are omitted.
+func (d *queueRepository) GetStepWorkflowVersions(ctx context.Context, tx *OptimisticTx, stepIds []uuid.UUID) (map[uuid.UUID]string, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-workflow-versions")
+	defer span.End()
+
+	uniqueStepIds := listutils.Uniq(stepIds)
+
+	stepIdsToLookup := make([]uuid.UUID, 0, len(uniqueStepIds))
+	stepIdToVersion := make(map[uuid.UUID]string, len(uniqueStepIds))
+
+	for _, stepId := range uniqueStepIds {
+		if value, found := d.stepIdWorkflowVersionCache.Get(stepId); found {
+			if value != "" {
+				stepIdToVersion[stepId] = value
+			}
+		} else {
+			stepIdsToLookup = append(stepIdsToLookup, stepId)
+		}
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return stepIdToVersion, nil
+	}
+
+	var queryTx sqlcv1.DBTX
+
+	if tx != nil {
+		queryTx = tx.tx
+	} else {
+		queryTx = d.pool
+	}
+
+	rows, err := d.queries.GetStepWorkflowVersions(ctx, queryTx, sqlcv1.GetStepWorkflowVersionsParams{
+		Stepids:  stepIdsToLookup,
+		Tenantid: d.tenantId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stepIdToVersion[row.StepId] = row.Version
+	}
+
+	// cache empty results so we skip DB lookups for steps of unversioned workflows
+	for _, stepId := range stepIdsToLookup {
+		d.stepIdWorkflowVersionCache.Add(stepId, stepIdToVersion[stepId])
+	}
+
+	return stepIdToVersion, nil
+}
+
 func (d *queueRepository) RequeueRateLimitedItems(ctx context.Context, tenantId uuid.UUID, queueName string) ([]*sqlcv1.RequeueRateLimitedQueueItemsRow, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l)
 
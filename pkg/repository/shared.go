@@ -10,6 +10,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/jsonschema"
 	"github.com/hatchet-dev/hatchet/pkg/config/limits"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -40,6 +41,7 @@ type sharedRepository struct {
 	stepsInWorkflowVersionCache *expirable.LRU[uuid.UUID, []*sqlcv1.ListStepsByWorkflowVersionIdsRow]
 	stepIdLabelsCache           *expirable.LRU[uuid.UUID, []*sqlcv1.GetDesiredLabelsRow]
 	stepIdSlotRequestsCache     *expirable.LRU[uuid.UUID, map[string]int32]
+	stepIdWorkflowVersionCache  *expirable.LRU[uuid.UUID, string]
 
 	celParser       *cel.CELParser
 	env             *celgo.Env
@@ -47,6 +49,18 @@ type sharedRepository struct {
 	taskLookupCache *lru.Cache[taskExternalIdTenantIdTuple, *sqlcv1.FlattenExternalIdsRow]
 	payloadStore    PayloadStoreRepository
 	m               TenantLimitRepository
+
+	// partitionArchiver is called before expired partitions are dropped, it's nil if archiving is disabled
+	partitionArchiver PartitionArchiver
+
+	// input schemas are immutable for a workflow version, so they're cached by workflow version id
+	inputSchemaCache *lru.Cache[uuid.UUID, *jsonschema.Schema]
+
+	// output schemas are immutable for a step, so they're cached by step id
+	outputSchemaCache *lru.Cache[uuid.UUID, *jsonschema.Schema]
+
+	// maxWorkflowRetentionPeriod is the longest retention period which can be set for a workflow
+	maxWorkflowRetentionPeriod time.Duration
 }
 
 func newSharedRepository(
@@ -69,6 +83,7 @@ func newSharedRepository(
 	stepsInWorkflowVersionCache := expirable.NewLRU(10000, func(key uuid.UUID, value []*sqlcv1.ListStepsByWorkflowVersionIdsRow) {}, 5*time.Minute)
 	stepIdLabelsCache := expirable.NewLRU(10000, func(key uuid.UUID, value []*sqlcv1.GetDesiredLabelsRow) {}, 5*time.Minute)
 	stepIdSlotRequestsCache := expirable.NewLRU(10000, func(key uuid.UUID, value map[string]int32) {}, 5*time.Minute)
+	stepIdWorkflowVersionCache := expirable.NewLRU(10000, func(key uuid.UUID, value string) {}, 5*time.Minute)
 
 	celParser := cel.NewCELParser()
 
@@ -93,6 +108,26 @@ func newSharedRepository(
 		log.Fatalf("failed to create CEL program cache: %v", err)
 	}
 
+	inputSchemaCache, err := lru.New[uuid.UUID, *jsonschema.Schema](10000)
+
+	if err != nil {
+		log.Fatalf("failed to create input schema cache: %v", err)
+	}
+
+	outputSchemaCache, err := lru.New[uuid.UUID, *jsonschema.Schema](10000)
+
+	if err != nil {
+		log.Fatalf("failed to create output schema cache: %v", err)
+	}
+
+	maxWorkflowRetentionPeriod, err := c.GetMaxWorkflowRetentionPeriod()
+
+	// the config loader validates the period, so this only happens with an incomplete config, in which case retention
+	// periods can't be set for workflows
+	if err != nil {
+		l.Error().Err(err).Msg("could not get max workflow retention period")
+	}
+
 	s := &sharedRepository{
 		pool:                        pool,
 		ddlPool:                     ddlPool,
@@ -106,11 +141,15 @@ func newSharedRepository(
 		stepsInWorkflowVersionCache: stepsInWorkflowVersionCache,
 		stepIdLabelsCache:           stepIdLabelsCache,
 		stepIdSlotRequestsCache:     stepIdSlotRequestsCache,
+		stepIdWorkflowVersionCache:  stepIdWorkflowVersionCache,
 		celParser:                   celParser,
 		env:                         env,
 		celProgramCache:             celProgramCache,
 		taskLookupCache:             lookupCache,
+		inputSchemaCache:            inputSchemaCache,
+		outputSchemaCache:           outputSchemaCache,
 		payloadStore:                payloadStore,
+		maxWorkflowRetentionPeriod:  maxWorkflowRetentionPeriod,
 	}
 
 	tenantLimitRepository := newTenantLimitRepository(s, c, shouldEnforceLimits, cacheDuration)
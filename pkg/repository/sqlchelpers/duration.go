@@ -15,3 +15,10 @@ func DurationToPgInterval(d time.Duration) pgtype.Interval {
 		Valid:        true,
 	}
 }
+
+// PgIntervalToDuration converts an interval to a duration, treating a day as 24 hours and a month as 30 days
+func PgIntervalToDuration(i pgtype.Interval) time.Duration {
+	return time.Duration(i.Microseconds)*time.Microsecond +
+		time.Duration(i.Days)*24*time.Hour +
+		time.Duration(i.Months)*30*24*time.Hour
+}
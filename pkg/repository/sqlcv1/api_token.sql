@@ -14,7 +14,9 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes",
+    "workflows"
 ) VALUES (
     coalesce(@id::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -22,7 +24,9 @@ INSERT INTO "APIToken" (
     sqlc.narg('tenantId')::uuid,
     sqlc.narg('name')::text,
     @expiresAt::timestamp,
-    COALESCE(sqlc.narg('internal')::boolean, FALSE)
+    COALESCE(sqlc.narg('internal')::boolean, FALSE),
+    sqlc.narg('scopes')::text[],
+    sqlc.narg('workflows')::text[]
 ) RETURNING *;
 
 
@@ -20,7 +20,9 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes",
+    "workflows"
 ) VALUES (
     coalesce($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -28,8 +30,10 @@ INSERT INTO "APIToken" (
     $2::uuid,
     $3::text,
     $4::timestamp,
-    COALESCE($5::boolean, FALSE)
-) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    COALESCE($5::boolean, FALSE),
+    $6::text[],
+    $7::text[]
+) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, workflows
 `
 
 type CreateAPITokenParams struct {
@@ -38,6 +42,8 @@ type CreateAPITokenParams struct {
 	Name      pgtype.Text      `json:"name"`
 	Expiresat pgtype.Timestamp `json:"expiresat"`
 	Internal  pgtype.Bool      `json:"internal"`
+	Scopes    []string         `json:"scopes"`
+	Workflows []string         `json:"workflows"`
 }
 
 func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPITokenParams) (*APIToken, error) {
@@ -47,6 +53,8 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		arg.Name,
 		arg.Expiresat,
 		arg.Internal,
+		arg.Scopes,
+		arg.Workflows,
 	)
 	var i APIToken
 	err := row.Scan(
@@ -59,6 +67,8 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
+		&i.Workflows,
 	)
 	return &i, err
 }
@@ -83,7 +93,7 @@ func (q *Queries) DeleteAPIToken(ctx context.Context, db DBTX, arg DeleteAPIToke
 
 const getAPITokenById = `-- name: GetAPITokenById :one
 SELECT
-    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, workflows
 FROM
     "APIToken"
 WHERE
@@ -103,13 +113,15 @@ func (q *Queries) GetAPITokenById(ctx context.Context, db DBTX, id uuid.UUID) (*
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
+		&i.Workflows,
 	)
 	return &i, err
 }
 
 const listAPITokensByTenant = `-- name: ListAPITokensByTenant :many
 SELECT
-    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, workflows
 FROM
     "APIToken"
 WHERE
@@ -137,6 +149,8 @@ func (q *Queries) ListAPITokensByTenant(ctx context.Context, db DBTX, tenantid u
 			&i.TenantId,
 			&i.NextAlertAt,
 			&i.Internal,
+			&i.Scopes,
+			&i.Workflows,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,90 @@
+-- name: CreateBulkOperation :one
+INSERT INTO v1_bulk_operation (tenant_id, kind, filter)
+VALUES (@tenantId::uuid, @kind::v1_bulk_operation_kind, @filter::jsonb)
+RETURNING *;
+
+-- name: GetBulkOperationById :one
+SELECT *
+FROM v1_bulk_operation
+WHERE id = @id::uuid;
+
+-- name: ListBulkOperations :many
+SELECT *
+FROM v1_bulk_operation
+WHERE tenant_id = @tenantId::uuid
+ORDER BY created_at DESC
+OFFSET @operationOffset::integer
+LIMIT @operationLimit::integer;
+
+-- name: CountBulkOperations :one
+SELECT count(*)
+FROM v1_bulk_operation
+WHERE tenant_id = @tenantId::uuid;
+
+-- name: GetNextBulkOperation :one
+-- Event replays are queued separately from run operations, so that a long replay doesn't hold up cancellations
+-- and replays of runs
+SELECT *
+FROM v1_bulk_operation
+WHERE
+    tenant_id = @tenantId::uuid
+    AND status IN ('PENDING', 'RUNNING')
+    AND (kind = 'REPLAY_EVENTS') = @eventReplays::boolean
+ORDER BY created_at
+LIMIT 1;
+
+-- name: CreateBulkOperationRuns :exec
+INSERT INTO v1_bulk_operation_run (operation_id, workflow_run_external_id)
+SELECT
+    @operationId::uuid,
+    UNNEST(@workflowRunExternalIds::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: StartBulkOperation :one
+UPDATE v1_bulk_operation
+SET
+    status = 'RUNNING',
+    total_count = @totalCount::integer,
+    updated_at = NOW()
+WHERE
+    id = @id::uuid
+    AND status = 'PENDING'
+RETURNING *;
+
+-- name: ListBulkOperationRuns :many
+SELECT workflow_run_external_id
+FROM v1_bulk_operation_run
+WHERE operation_id = @operationId::uuid
+ORDER BY workflow_run_external_id
+LIMIT @batchSize::integer;
+
+-- name: DeleteBulkOperationRuns :exec
+DELETE FROM v1_bulk_operation_run
+WHERE
+    operation_id = @operationId::uuid
+    AND workflow_run_external_id = ANY(@workflowRunExternalIds::uuid[]);
+
+-- name: DeleteAllBulkOperationRuns :exec
+DELETE FROM v1_bulk_operation_run
+WHERE operation_id = @operationId::uuid;
+
+-- name: UpdateBulkOperationProgress :one
+UPDATE v1_bulk_operation
+SET
+    processed_count = processed_count + @processedCount::integer,
+    updated_at = NOW()
+WHERE
+    id = @id::uuid
+    AND status = 'RUNNING'
+RETURNING *;
+
+-- name: FinishBulkOperation :one
+UPDATE v1_bulk_operation
+SET
+    status = @status::v1_bulk_operation_status,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE
+    id = @id::uuid
+    AND status IN ('PENDING', 'RUNNING')
+RETURNING *;
@@ -144,7 +144,7 @@ func (q *Queries) DeactivateStaleStepConcurrency(ctx context.Context, db DBTX, t
 
 const getConcurrencyStrategyById = `-- name: GetConcurrencyStrategyById :one
 SELECT
-    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency
+    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.weight_expression
 FROM
     v1_step_concurrency sc
 WHERE
@@ -171,6 +171,7 @@ func (q *Queries) GetConcurrencyStrategyById(ctx context.Context, db DBTX, arg G
 		&i.Expression,
 		&i.TenantID,
 		&i.MaxConcurrency,
+		&i.WeightExpression,
 	)
 	return &i, err
 }
@@ -220,7 +221,7 @@ func (q *Queries) GetWorkflowConcurrencyQueueCounts(ctx context.Context, db DBTX
 
 const listActiveConcurrencyStrategies = `-- name: ListActiveConcurrencyStrategies :many
 SELECT
-    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency
+    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.weight_expression
 FROM
     v1_step_concurrency sc
 JOIN
@@ -250,6 +251,7 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
 		); err != nil {
 			return nil, err
 		}
@@ -341,7 +343,7 @@ func (q *Queries) ListConcurrencySlotsForIndexing(ctx context.Context, db DBTX,
 
 const listConcurrencyStrategiesByStepId = `-- name: ListConcurrencyStrategiesByStepId :many
 SELECT
-    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, weight_expression
 FROM
     v1_step_concurrency
 WHERE
@@ -374,6 +376,7 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
 		); err != nil {
 			return nil, err
 		}
@@ -386,7 +389,7 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 }
 
 const listConcurrencyStrategiesByWorkflowVersionId = `-- name: ListConcurrencyStrategiesByWorkflowVersionId :many
-SELECT c.id, c.parent_strategy_id, c.workflow_id, c.workflow_version_id, c.step_id, c.is_active, c.strategy, c.expression, c.tenant_id, c.max_concurrency, s."readableId" AS step_readable_id
+SELECT c.id, c.parent_strategy_id, c.workflow_id, c.workflow_version_id, c.step_id, c.is_active, c.strategy, c.expression, c.tenant_id, c.max_concurrency, c.weight_expression, s."readableId" AS step_readable_id
 FROM v1_step_concurrency c
 JOIN "Step" s ON s.id = c.step_id
 WHERE
@@ -420,6 +423,7 @@ type ListConcurrencyStrategiesByWorkflowVersionIdRow struct {
 	Expression        string                `json:"expression"`
 	TenantID          uuid.UUID             `json:"tenant_id"`
 	MaxConcurrency    int32                 `json:"max_concurrency"`
+	WeightExpression  pgtype.Text           `json:"weight_expression"`
 	StepReadableID    pgtype.Text           `json:"step_readable_id"`
 }
 
@@ -443,6 +447,7 @@ func (q *Queries) ListConcurrencyStrategiesByWorkflowVersionId(ctx context.Conte
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
 			&i.StepReadableID,
 		); err != nil {
 			return nil, err
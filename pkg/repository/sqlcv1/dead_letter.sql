@@ -0,0 +1,84 @@
+-- name: UpsertDeadLetterPolicy :one
+INSERT INTO v1_dead_letter_policy (tenant_id, source_workflow_id, dead_letter_workflow_name)
+VALUES (@tenantId::uuid, sqlc.narg('sourceWorkflowId')::uuid, @deadLetterWorkflowName::text)
+ON CONFLICT (tenant_id, COALESCE(source_workflow_id, '00000000-0000-0000-0000-000000000000'::UUID)) DO UPDATE
+SET
+    dead_letter_workflow_name = EXCLUDED.dead_letter_workflow_name,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetDeadLetterPolicyById :one
+SELECT *
+FROM v1_dead_letter_policy
+WHERE id = @id::uuid;
+
+-- name: ListDeadLetterPolicies :many
+SELECT *
+FROM v1_dead_letter_policy
+WHERE tenant_id = @tenantId::uuid
+ORDER BY source_workflow_id NULLS FIRST, created_at;
+
+-- name: ListDeadLetterPolicyWorkflowNames :many
+-- Lists the name of the source workflow of each dead-letter policy of the tenant, which is NULL for the tenant default,
+-- along with the name of its dead-letter workflow
+SELECT
+    w."name" AS source_workflow_name,
+    p.dead_letter_workflow_name
+FROM v1_dead_letter_policy p
+LEFT JOIN "Workflow" w ON w."id" = p.source_workflow_id AND w."deletedAt" IS NULL
+WHERE
+    p.tenant_id = @tenantId::uuid
+    AND (p.source_workflow_id IS NULL OR w."id" IS NOT NULL);
+
+-- name: DeleteDeadLetterPolicy :exec
+DELETE FROM v1_dead_letter_policy
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid;
+
+-- name: EnqueueDeadLetterRuns :many
+WITH input AS (
+    SELECT
+        UNNEST(@workflowRunExternalIds::uuid[]) AS workflow_run_external_id,
+        UNNEST(@workflowIds::uuid[]) AS workflow_id
+)
+INSERT INTO v1_dead_letter_run (tenant_id, workflow_run_external_id, dead_letter_workflow_name)
+SELECT
+    @tenantId::uuid,
+    i.workflow_run_external_id,
+    p.dead_letter_workflow_name
+FROM input i
+JOIN LATERAL (
+    -- a policy for the workflow takes precedence over the tenant default
+    SELECT p.dead_letter_workflow_name
+    FROM v1_dead_letter_policy p
+    WHERE
+        p.tenant_id = @tenantId::uuid
+        AND (p.source_workflow_id = i.workflow_id OR p.source_workflow_id IS NULL)
+    ORDER BY p.source_workflow_id NULLS LAST
+    LIMIT 1
+) p ON TRUE
+WHERE NOT EXISTS (
+    -- failed runs of the dead-letter workflow itself are skipped, so a failing dead-letter workflow can't loop
+    SELECT 1
+    FROM "Workflow" w
+    WHERE
+        w."id" = i.workflow_id
+        AND w."tenantId" = @tenantId::uuid
+        AND w."name" = p.dead_letter_workflow_name
+)
+ON CONFLICT (tenant_id, workflow_run_external_id) DO NOTHING
+RETURNING workflow_run_external_id;
+
+-- name: ListDeadLetterRuns :many
+SELECT *
+FROM v1_dead_letter_run
+WHERE tenant_id = @tenantId::uuid
+ORDER BY inserted_at
+LIMIT @batchSize::int;
+
+-- name: DeleteDeadLetterRuns :exec
+DELETE FROM v1_dead_letter_run
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_run_external_id = ANY(@workflowRunExternalIds::uuid[]);
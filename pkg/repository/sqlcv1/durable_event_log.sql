@@ -266,3 +266,34 @@ ORDER BY e.branch_id ASC, e.node_id ASC
 OFFSET @eventLogOffset::BIGINT
 LIMIT @eventLogLimit::BIGINT
 ;
+
+-- name: ListInFlightDurableTasksWithPatch :many
+-- Lists durable tasks which recorded the patch marker with the given idempotency key and haven't finished yet, with the
+-- first entry for the marker in each task. A task has finished if its current retry has a terminal event.
+SELECT DISTINCT ON (e.durable_task_inserted_at, e.durable_task_id)
+    t.external_id AS durable_task_external_id,
+    t.display_name AS durable_task_display_name,
+    t.workflow_id,
+    t.workflow_run_id,
+    e.node_id,
+    e.branch_id,
+    e.inserted_at
+FROM v1_durable_event_log_entry e
+JOIN v1_task t ON (t.id, t.inserted_at) = (e.durable_task_id, e.durable_task_inserted_at)
+WHERE
+    e.tenant_id = @tenantId::UUID
+    AND e.kind = 'PATCH'
+    AND e.idempotency_key = @idempotencyKey::BYTEA
+    AND NOT EXISTS (
+        SELECT 1
+        FROM v1_task_event ev
+        WHERE
+            ev.task_id = t.id
+            AND ev.task_inserted_at = t.inserted_at
+            AND ev.retry_count = t.retry_count
+            AND ev.event_type IN ('COMPLETED', 'FAILED', 'CANCELLED')
+    )
+ORDER BY e.durable_task_inserted_at ASC, e.durable_task_id ASC, e.branch_id ASC, e.node_id ASC
+OFFSET @patchOffset::BIGINT
+LIMIT @patchLimit::BIGINT
+;
@@ -695,6 +695,87 @@ func (q *Queries) ListDurableEventLogForTask(ctx context.Context, db DBTX, arg L
 	return items, nil
 }
 
+const listInFlightDurableTasksWithPatch = `-- name: ListInFlightDurableTasksWithPatch :many
+SELECT DISTINCT ON (e.durable_task_inserted_at, e.durable_task_id)
+    t.external_id AS durable_task_external_id,
+    t.display_name AS durable_task_display_name,
+    t.workflow_id,
+    t.workflow_run_id,
+    e.node_id,
+    e.branch_id,
+    e.inserted_at
+FROM v1_durable_event_log_entry e
+JOIN v1_task t ON (t.id, t.inserted_at) = (e.durable_task_id, e.durable_task_inserted_at)
+WHERE
+    e.tenant_id = $1::UUID
+    AND e.kind = 'PATCH'
+    AND e.idempotency_key = $2::BYTEA
+    AND NOT EXISTS (
+        SELECT 1
+        FROM v1_task_event ev
+        WHERE
+            ev.task_id = t.id
+            AND ev.task_inserted_at = t.inserted_at
+            AND ev.retry_count = t.retry_count
+            AND ev.event_type IN ('COMPLETED', 'FAILED', 'CANCELLED')
+    )
+ORDER BY e.durable_task_inserted_at ASC, e.durable_task_id ASC, e.branch_id ASC, e.node_id ASC
+OFFSET $3::BIGINT
+LIMIT $4::BIGINT
+`
+
+type ListInFlightDurableTasksWithPatchParams struct {
+	Tenantid       uuid.UUID `json:"tenantid"`
+	Idempotencykey []byte    `json:"idempotencykey"`
+	Patchoffset    int64     `json:"patchoffset"`
+	Patchlimit     int64     `json:"patchlimit"`
+}
+
+type ListInFlightDurableTasksWithPatchRow struct {
+	DurableTaskExternalID  uuid.UUID          `json:"durable_task_external_id"`
+	DurableTaskDisplayName string             `json:"durable_task_display_name"`
+	WorkflowID             uuid.UUID          `json:"workflow_id"`
+	WorkflowRunID          uuid.UUID          `json:"workflow_run_id"`
+	NodeID                 int64              `json:"node_id"`
+	BranchID               int64              `json:"branch_id"`
+	InsertedAt             pgtype.Timestamptz `json:"inserted_at"`
+}
+
+// Lists durable tasks which recorded the patch marker with the given idempotency key and haven't finished yet, with the
+// first entry for the marker in each task. A task has finished if its current retry has a terminal event.
+func (q *Queries) ListInFlightDurableTasksWithPatch(ctx context.Context, db DBTX, arg ListInFlightDurableTasksWithPatchParams) ([]*ListInFlightDurableTasksWithPatchRow, error) {
+	rows, err := db.Query(ctx, listInFlightDurableTasksWithPatch,
+		arg.Tenantid,
+		arg.Idempotencykey,
+		arg.Patchoffset,
+		arg.Patchlimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListInFlightDurableTasksWithPatchRow
+	for rows.Next() {
+		var i ListInFlightDurableTasksWithPatchRow
+		if err := rows.Scan(
+			&i.DurableTaskExternalID,
+			&i.DurableTaskDisplayName,
+			&i.WorkflowID,
+			&i.WorkflowRunID,
+			&i.NodeID,
+			&i.BranchID,
+			&i.InsertedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSatisfiedEntries = `-- name: ListSatisfiedEntries :many
 WITH inputs AS (
     SELECT
@@ -702,7 +783,7 @@ WITH inputs AS (
         UNNEST($2::BIGINT[]) AS node_id,
         UNNEST($3::BIGINT[]) AS branch_id
 ), tasks_with_nodes AS (
-    SELECT t.id, t.inserted_at, t.tenant_id, t.queue, t.action_id, t.step_id, t.step_readable_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.external_id, t.display_name, t.input, t.retry_count, t.internal_retry_count, t.app_retry_count, t.step_index, t.additional_metadata, t.dag_id, t.dag_inserted_at, t.parent_task_external_id, t.parent_task_id, t.parent_task_inserted_at, t.child_index, t.child_key, t.initial_state, t.initial_state_reason, t.concurrency_parent_strategy_ids, t.concurrency_strategy_ids, t.concurrency_keys, t.retry_backoff_factor, t.retry_max_backoff, t.is_durable, t.desired_worker_label, t.triggering_event_external_id, t.triggering_event_key, i.node_id AS requested_node_id, i.branch_id AS requested_branch_id
+    SELECT t.id, t.inserted_at, t.tenant_id, t.queue, t.action_id, t.step_id, t.step_readable_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.external_id, t.display_name, t.input, t.retry_count, t.internal_retry_count, t.app_retry_count, t.step_index, t.additional_metadata, t.dag_id, t.dag_inserted_at, t.parent_task_external_id, t.parent_task_id, t.parent_task_inserted_at, t.child_index, t.child_key, t.initial_state, t.initial_state_reason, t.concurrency_parent_strategy_ids, t.concurrency_strategy_ids, t.concurrency_keys, t.retry_backoff_factor, t.retry_max_backoff, t.retry_delay_seconds, t.is_durable, t.desired_worker_label, t.triggering_event_external_id, t.triggering_event_key, i.node_id AS requested_node_id, i.branch_id AS requested_branch_id
     FROM inputs i
     JOIN v1_lookup_table lt ON lt.external_id = i.external_id
     JOIN v1_task t ON (t.id, t.inserted_at) = (lt.task_id, lt.inserted_at)
@@ -0,0 +1,46 @@
+-- name: CreateDurableSignal :exec
+INSERT INTO v1_durable_signal (
+    tenant_id,
+    durable_task_id,
+    durable_task_inserted_at,
+    name,
+    payload
+)
+VALUES (
+    @tenantId::UUID,
+    @durableTaskId::BIGINT,
+    @durableTaskInsertedAt::TIMESTAMPTZ,
+    @name::TEXT,
+    @payload::BYTEA
+);
+
+-- name: PopDurableSignal :one
+-- Deletes and returns the oldest signal with the given name which was sent to the durable task before it waited for it
+DELETE FROM v1_durable_signal
+WHERE (durable_task_id, durable_task_inserted_at, id) = (
+    SELECT durable_task_id, durable_task_inserted_at, id
+    FROM v1_durable_signal
+    WHERE
+        durable_task_id = @durableTaskId::BIGINT
+        AND durable_task_inserted_at = @durableTaskInsertedAt::TIMESTAMPTZ
+        AND name = @name::TEXT
+    ORDER BY id
+    LIMIT 1
+)
+RETURNING *;
+
+-- name: GetWaitingSignalEntry :one
+-- Returns the oldest unsatisfied SIGNAL entry on the latest branch of the durable event log which is waiting for a
+-- signal with the given idempotency key
+SELECT e.*
+FROM v1_durable_event_log_entry e
+JOIN v1_durable_event_log_file lf ON (lf.durable_task_id, lf.durable_task_inserted_at) = (e.durable_task_id, e.durable_task_inserted_at)
+WHERE
+    e.durable_task_id = @durableTaskId::BIGINT
+    AND e.durable_task_inserted_at = @durableTaskInsertedAt::TIMESTAMPTZ
+    AND e.kind = 'SIGNAL'
+    AND e.branch_id = lf.latest_branch_id
+    AND e.idempotency_key = @idempotencyKey::BYTEA
+    AND NOT e.is_satisfied
+ORDER BY e.node_id
+LIMIT 1;
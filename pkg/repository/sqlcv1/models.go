@@ -411,6 +411,49 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitMode string
+
+const (
+	RateLimitModeFIXEDWINDOW   RateLimitMode = "FIXED_WINDOW"
+	RateLimitModeSLIDINGWINDOW RateLimitMode = "SLIDING_WINDOW"
+	RateLimitModeTOKENBUCKET   RateLimitMode = "TOKEN_BUCKET"
+)
+
+func (e *RateLimitMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitMode(s)
+	case string:
+		*e = RateLimitMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitMode: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitMode struct {
+	RateLimitMode RateLimitMode `json:"RateLimitMode"`
+	Valid         bool          `json:"valid"` // Valid is true if RateLimitMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitMode), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -690,6 +733,93 @@ func (ns NullStickyStrategy) Value() (driver.Value, error) {
 	return string(ns.StickyStrategy), nil
 }
 
+type TenantAlertRuleKind string
+
+const (
+	TenantAlertRuleKindQUEUEDEPTH  TenantAlertRuleKind = "QUEUE_DEPTH"
+	TenantAlertRuleKindP95DURATION TenantAlertRuleKind = "P95_DURATION"
+	TenantAlertRuleKindFAILURERATE TenantAlertRuleKind = "FAILURE_RATE"
+	TenantAlertRuleKindCRONMISSED  TenantAlertRuleKind = "CRON_MISSED"
+)
+
+func (e *TenantAlertRuleKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TenantAlertRuleKind(s)
+	case string:
+		*e = TenantAlertRuleKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TenantAlertRuleKind: %T", src)
+	}
+	return nil
+}
+
+type NullTenantAlertRuleKind struct {
+	TenantAlertRuleKind TenantAlertRuleKind `json:"TenantAlertRuleKind"`
+	Valid               bool                `json:"valid"` // Valid is true if TenantAlertRuleKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTenantAlertRuleKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.TenantAlertRuleKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TenantAlertRuleKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTenantAlertRuleKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TenantAlertRuleKind), nil
+}
+
+type TenantAlertSinkKind string
+
+const (
+	TenantAlertSinkKindWEBHOOK   TenantAlertSinkKind = "WEBHOOK"
+	TenantAlertSinkKindTEAMS     TenantAlertSinkKind = "TEAMS"
+	TenantAlertSinkKindPAGERDUTY TenantAlertSinkKind = "PAGERDUTY"
+)
+
+func (e *TenantAlertSinkKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TenantAlertSinkKind(s)
+	case string:
+		*e = TenantAlertSinkKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TenantAlertSinkKind: %T", src)
+	}
+	return nil
+}
+
+type NullTenantAlertSinkKind struct {
+	TenantAlertSinkKind TenantAlertSinkKind `json:"TenantAlertSinkKind"`
+	Valid               bool                `json:"valid"` // Valid is true if TenantAlertSinkKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTenantAlertSinkKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.TenantAlertSinkKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TenantAlertSinkKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTenantAlertSinkKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TenantAlertSinkKind), nil
+}
+
 type TenantEnvironment string
 
 const (
@@ -902,11 +1032,100 @@ func (ns NullTenantResourceLimitAlertType) Value() (driver.Value, error) {
 	return string(ns.TenantResourceLimitAlertType), nil
 }
 
+type V1BulkOperationKind string
+
+const (
+	V1BulkOperationKindCANCEL       V1BulkOperationKind = "CANCEL"
+	V1BulkOperationKindREPLAY       V1BulkOperationKind = "REPLAY"
+	V1BulkOperationKindREPLAYEVENTS V1BulkOperationKind = "REPLAY_EVENTS"
+)
+
+func (e *V1BulkOperationKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkOperationKind(s)
+	case string:
+		*e = V1BulkOperationKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkOperationKind: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkOperationKind struct {
+	V1BulkOperationKind V1BulkOperationKind `json:"V1BulkOperationKind"`
+	Valid               bool                `json:"valid"` // Valid is true if V1BulkOperationKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkOperationKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkOperationKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkOperationKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkOperationKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkOperationKind), nil
+}
+
+type V1BulkOperationStatus string
+
+const (
+	V1BulkOperationStatusPENDING   V1BulkOperationStatus = "PENDING"
+	V1BulkOperationStatusRUNNING   V1BulkOperationStatus = "RUNNING"
+	V1BulkOperationStatusCOMPLETED V1BulkOperationStatus = "COMPLETED"
+	V1BulkOperationStatusCANCELLED V1BulkOperationStatus = "CANCELLED"
+)
+
+func (e *V1BulkOperationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkOperationStatus(s)
+	case string:
+		*e = V1BulkOperationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkOperationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkOperationStatus struct {
+	V1BulkOperationStatus V1BulkOperationStatus `json:"V1BulkOperationStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if V1BulkOperationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkOperationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkOperationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkOperationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkOperationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkOperationStatus), nil
+}
+
 type V1CelEvaluationFailureSource string
 
 const (
-	V1CelEvaluationFailureSourceFILTER  V1CelEvaluationFailureSource = "FILTER"
-	V1CelEvaluationFailureSourceWEBHOOK V1CelEvaluationFailureSource = "WEBHOOK"
+	V1CelEvaluationFailureSourceFILTER      V1CelEvaluationFailureSource = "FILTER"
+	V1CelEvaluationFailureSourceWEBHOOK     V1CelEvaluationFailureSource = "WEBHOOK"
+	V1CelEvaluationFailureSourceINPUTSCHEMA V1CelEvaluationFailureSource = "INPUT_SCHEMA"
+	V1CelEvaluationFailureSourceSCOPEDLIMIT V1CelEvaluationFailureSource = "SCOPED_LIMIT"
 )
 
 func (e *V1CelEvaluationFailureSource) Scan(src interface{}) error {
@@ -951,6 +1170,7 @@ const (
 	V1ConcurrencyStrategyGROUPROUNDROBIN  V1ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
 	V1ConcurrencyStrategyCANCELINPROGRESS V1ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
 	V1ConcurrencyStrategyCANCELNEWEST     V1ConcurrencyStrategy = "CANCEL_NEWEST"
+	V1ConcurrencyStrategyWEIGHTEDFAIR     V1ConcurrencyStrategy = "WEIGHTED_FAIR"
 )
 
 func (e *V1ConcurrencyStrategy) Scan(src interface{}) error {
@@ -988,12 +1208,57 @@ func (ns NullV1ConcurrencyStrategy) Value() (driver.Value, error) {
 	return string(ns.V1ConcurrencyStrategy), nil
 }
 
+type V1CronFireOutcome string
+
+const (
+	V1CronFireOutcomeTRIGGERED           V1CronFireOutcome = "TRIGGERED"
+	V1CronFireOutcomeSKIPPEDOVERLAP      V1CronFireOutcome = "SKIPPED_OVERLAP"
+	V1CronFireOutcomeSKIPPEDINVALIDINPUT V1CronFireOutcome = "SKIPPED_INVALID_INPUT"
+)
+
+func (e *V1CronFireOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1CronFireOutcome(s)
+	case string:
+		*e = V1CronFireOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1CronFireOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullV1CronFireOutcome struct {
+	V1CronFireOutcome V1CronFireOutcome `json:"V1CronFireOutcome"`
+	Valid             bool              `json:"valid"` // Valid is true if V1CronFireOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1CronFireOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1CronFireOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1CronFireOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1CronFireOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1CronFireOutcome), nil
+}
+
 type V1DurableEventLogKind string
 
 const (
 	V1DurableEventLogKindRUN     V1DurableEventLogKind = "RUN"
 	V1DurableEventLogKindWAITFOR V1DurableEventLogKind = "WAIT_FOR"
 	V1DurableEventLogKindMEMO    V1DurableEventLogKind = "MEMO"
+	V1DurableEventLogKindSIGNAL  V1DurableEventLogKind = "SIGNAL"
+	V1DurableEventLogKindPATCH   V1DurableEventLogKind = "PATCH"
 )
 
 func (e *V1DurableEventLogKind) Scan(src interface{}) error {
@@ -1182,10 +1447,11 @@ func (ns NullV1IncomingWebhookAuthType) Value() (driver.Value, error) {
 type V1IncomingWebhookHmacAlgorithm string
 
 const (
-	V1IncomingWebhookHmacAlgorithmSHA1   V1IncomingWebhookHmacAlgorithm = "SHA1"
-	V1IncomingWebhookHmacAlgorithmSHA256 V1IncomingWebhookHmacAlgorithm = "SHA256"
-	V1IncomingWebhookHmacAlgorithmSHA512 V1IncomingWebhookHmacAlgorithm = "SHA512"
-	V1IncomingWebhookHmacAlgorithmMD5    V1IncomingWebhookHmacAlgorithm = "MD5"
+	V1IncomingWebhookHmacAlgorithmSHA1    V1IncomingWebhookHmacAlgorithm = "SHA1"
+	V1IncomingWebhookHmacAlgorithmSHA256  V1IncomingWebhookHmacAlgorithm = "SHA256"
+	V1IncomingWebhookHmacAlgorithmSHA512  V1IncomingWebhookHmacAlgorithm = "SHA512"
+	V1IncomingWebhookHmacAlgorithmMD5     V1IncomingWebhookHmacAlgorithm = "MD5"
+	V1IncomingWebhookHmacAlgorithmED25519 V1IncomingWebhookHmacAlgorithm = "ED25519"
 )
 
 func (e *V1IncomingWebhookHmacAlgorithm) Scan(src interface{}) error {
@@ -1269,12 +1535,17 @@ func (ns NullV1IncomingWebhookHmacEncoding) Value() (driver.Value, error) {
 type V1IncomingWebhookSourceName string
 
 const (
-	V1IncomingWebhookSourceNameGENERIC V1IncomingWebhookSourceName = "GENERIC"
-	V1IncomingWebhookSourceNameGITHUB  V1IncomingWebhookSourceName = "GITHUB"
-	V1IncomingWebhookSourceNameSTRIPE  V1IncomingWebhookSourceName = "STRIPE"
-	V1IncomingWebhookSourceNameSLACK   V1IncomingWebhookSourceName = "SLACK"
-	V1IncomingWebhookSourceNameLINEAR  V1IncomingWebhookSourceName = "LINEAR"
-	V1IncomingWebhookSourceNameSVIX    V1IncomingWebhookSourceName = "SVIX"
+	V1IncomingWebhookSourceNameGENERIC   V1IncomingWebhookSourceName = "GENERIC"
+	V1IncomingWebhookSourceNameGITHUB    V1IncomingWebhookSourceName = "GITHUB"
+	V1IncomingWebhookSourceNameSTRIPE    V1IncomingWebhookSourceName = "STRIPE"
+	V1IncomingWebhookSourceNameSLACK     V1IncomingWebhookSourceName = "SLACK"
+	V1IncomingWebhookSourceNameLINEAR    V1IncomingWebhookSourceName = "LINEAR"
+	V1IncomingWebhookSourceNameSVIX      V1IncomingWebhookSourceName = "SVIX"
+	V1IncomingWebhookSourceNameGITLAB    V1IncomingWebhookSourceName = "GITLAB"
+	V1IncomingWebhookSourceNameBITBUCKET V1IncomingWebhookSourceName = "BITBUCKET"
+	V1IncomingWebhookSourceNameSHOPIFY   V1IncomingWebhookSourceName = "SHOPIFY"
+	V1IncomingWebhookSourceNameTWILIO    V1IncomingWebhookSourceName = "TWILIO"
+	V1IncomingWebhookSourceNameDISCORD   V1IncomingWebhookSourceName = "DISCORD"
 )
 
 func (e *V1IncomingWebhookSourceName) Scan(src interface{}) error {
@@ -1709,6 +1980,92 @@ func (ns NullV1ReadableStatusOlap) Value() (driver.Value, error) {
 	return string(ns.V1ReadableStatusOlap), nil
 }
 
+type V1ResourceLimitScope string
+
+const (
+	V1ResourceLimitScopeWORKFLOW V1ResourceLimitScope = "WORKFLOW"
+	V1ResourceLimitScopeMETADATA V1ResourceLimitScope = "METADATA"
+)
+
+func (e *V1ResourceLimitScope) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1ResourceLimitScope(s)
+	case string:
+		*e = V1ResourceLimitScope(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1ResourceLimitScope: %T", src)
+	}
+	return nil
+}
+
+type NullV1ResourceLimitScope struct {
+	V1ResourceLimitScope V1ResourceLimitScope `json:"v1_resource_limit_scope"`
+	Valid                bool                 `json:"valid"` // Valid is true if V1ResourceLimitScope is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1ResourceLimitScope) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1ResourceLimitScope, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1ResourceLimitScope.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1ResourceLimitScope) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1ResourceLimitScope), nil
+}
+
+type V1RetryBackoffStrategy string
+
+const (
+	V1RetryBackoffStrategyEXPONENTIAL        V1RetryBackoffStrategy = "EXPONENTIAL"
+	V1RetryBackoffStrategyFULLJITTER         V1RetryBackoffStrategy = "FULL_JITTER"
+	V1RetryBackoffStrategyDECORRELATEDJITTER V1RetryBackoffStrategy = "DECORRELATED_JITTER"
+	V1RetryBackoffStrategyFIXED              V1RetryBackoffStrategy = "FIXED"
+)
+
+func (e *V1RetryBackoffStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1RetryBackoffStrategy(s)
+	case string:
+		*e = V1RetryBackoffStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1RetryBackoffStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullV1RetryBackoffStrategy struct {
+	V1RetryBackoffStrategy V1RetryBackoffStrategy `json:"V1RetryBackoffStrategy"`
+	Valid                  bool                   `json:"valid"` // Valid is true if V1RetryBackoffStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1RetryBackoffStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1RetryBackoffStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1RetryBackoffStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1RetryBackoffStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1RetryBackoffStrategy), nil
+}
+
 type V1RunKind string
 
 const (
@@ -2229,6 +2586,48 @@ func (ns NullWorkerType) Value() (driver.Value, error) {
 	return string(ns.WorkerType), nil
 }
 
+type WorkflowInputValidationMode string
+
+const (
+	WorkflowInputValidationModeREJECT WorkflowInputValidationMode = "REJECT"
+	WorkflowInputValidationModeWARN   WorkflowInputValidationMode = "WARN"
+)
+
+func (e *WorkflowInputValidationMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowInputValidationMode(s)
+	case string:
+		*e = WorkflowInputValidationMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowInputValidationMode: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowInputValidationMode struct {
+	WorkflowInputValidationMode WorkflowInputValidationMode `json:"WorkflowInputValidationMode"`
+	Valid                       bool                        `json:"valid"` // Valid is true if WorkflowInputValidationMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowInputValidationMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowInputValidationMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowInputValidationMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowInputValidationMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowInputValidationMode), nil
+}
+
 type WorkflowKind string
 
 const (
@@ -2362,6 +2761,92 @@ func (ns NullWorkflowTriggerCronRefMethods) Value() (driver.Value, error) {
 	return string(ns.WorkflowTriggerCronRefMethods), nil
 }
 
+type WorkflowTriggerCronRefMisfirePolicy string
+
+const (
+	WorkflowTriggerCronRefMisfirePolicySKIP     WorkflowTriggerCronRefMisfirePolicy = "SKIP"
+	WorkflowTriggerCronRefMisfirePolicyFIREONCE WorkflowTriggerCronRefMisfirePolicy = "FIRE_ONCE"
+	WorkflowTriggerCronRefMisfirePolicyFIREALL  WorkflowTriggerCronRefMisfirePolicy = "FIRE_ALL"
+)
+
+func (e *WorkflowTriggerCronRefMisfirePolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowTriggerCronRefMisfirePolicy(s)
+	case string:
+		*e = WorkflowTriggerCronRefMisfirePolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowTriggerCronRefMisfirePolicy: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowTriggerCronRefMisfirePolicy struct {
+	WorkflowTriggerCronRefMisfirePolicy WorkflowTriggerCronRefMisfirePolicy `json:"WorkflowTriggerCronRefMisfirePolicy"`
+	Valid                               bool                                `json:"valid"` // Valid is true if WorkflowTriggerCronRefMisfirePolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowTriggerCronRefMisfirePolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowTriggerCronRefMisfirePolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowTriggerCronRefMisfirePolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowTriggerCronRefMisfirePolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowTriggerCronRefMisfirePolicy), nil
+}
+
+type WorkflowTriggerCronRefOverlapPolicy string
+
+const (
+	WorkflowTriggerCronRefOverlapPolicyALLOW          WorkflowTriggerCronRefOverlapPolicy = "ALLOW"
+	WorkflowTriggerCronRefOverlapPolicySKIPIFRUNNING  WorkflowTriggerCronRefOverlapPolicy = "SKIP_IF_RUNNING"
+	WorkflowTriggerCronRefOverlapPolicyCANCELPREVIOUS WorkflowTriggerCronRefOverlapPolicy = "CANCEL_PREVIOUS"
+)
+
+func (e *WorkflowTriggerCronRefOverlapPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowTriggerCronRefOverlapPolicy(s)
+	case string:
+		*e = WorkflowTriggerCronRefOverlapPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowTriggerCronRefOverlapPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowTriggerCronRefOverlapPolicy struct {
+	WorkflowTriggerCronRefOverlapPolicy WorkflowTriggerCronRefOverlapPolicy `json:"WorkflowTriggerCronRefOverlapPolicy"`
+	Valid                               bool                                `json:"valid"` // Valid is true if WorkflowTriggerCronRefOverlapPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowTriggerCronRefOverlapPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowTriggerCronRefOverlapPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowTriggerCronRefOverlapPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowTriggerCronRefOverlapPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowTriggerCronRefOverlapPolicy), nil
+}
+
 type WorkflowTriggerScheduledRefMethods string
 
 const (
@@ -2414,6 +2899,8 @@ type APIToken struct {
 	TenantId    *uuid.UUID       `json:"tenantId"`
 	NextAlertAt pgtype.Timestamp `json:"nextAlertAt"`
 	Internal    bool             `json:"internal"`
+	Scopes      []string         `json:"scopes"`
+	Workflows   []string         `json:"workflows"`
 }
 
 type Action struct {
@@ -2694,12 +3181,15 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   uuid.UUID        `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId           uuid.UUID        `json:"tenantId"`
+	Key                string           `json:"key"`
+	LimitValue         int32            `json:"limitValue"`
+	Value              int32            `json:"value"`
+	Window             string           `json:"window"`
+	LastRefill         pgtype.Timestamp `json:"lastRefill"`
+	Mode               RateLimitMode    `json:"mode"`
+	Burst              pgtype.Int4      `json:"burst"`
+	PreviousWindowUsed int32            `json:"previousWindowUsed"`
 }
 
 type RetryQueueItem struct {
@@ -2931,6 +3421,31 @@ type TenantAlertEmailGroup struct {
 	Emails    string           `json:"emails"`
 }
 
+type TenantAlertRule struct {
+	ID              uuid.UUID           `json:"id"`
+	CreatedAt       pgtype.Timestamp    `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp    `json:"updatedAt"`
+	TenantId        uuid.UUID           `json:"tenantId"`
+	WorkflowId      uuid.UUID           `json:"workflowId"`
+	Name            string              `json:"name"`
+	Kind            TenantAlertRuleKind `json:"kind"`
+	Threshold       float64             `json:"threshold"`
+	Window          string              `json:"window"`
+	IsFiring        bool                `json:"isFiring"`
+	LastEvaluatedAt pgtype.Timestamp    `json:"lastEvaluatedAt"`
+	LastFiredAt     pgtype.Timestamp    `json:"lastFiredAt"`
+}
+
+type TenantAlertSink struct {
+	ID        uuid.UUID           `json:"id"`
+	CreatedAt pgtype.Timestamp    `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp    `json:"updatedAt"`
+	TenantId  uuid.UUID           `json:"tenantId"`
+	Kind      TenantAlertSinkKind `json:"kind"`
+	Name      string              `json:"name"`
+	Config    []byte              `json:"config"`
+}
+
 type TenantAlertingSettings struct {
 	ID                              uuid.UUID        `json:"id"`
 	CreatedAt                       pgtype.Timestamp `json:"createdAt"`
@@ -2966,12 +3481,13 @@ type TenantInviteLink struct {
 }
 
 type TenantMember struct {
-	ID        uuid.UUID        `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	TenantId  uuid.UUID        `json:"tenantId"`
-	UserId    uuid.UUID        `json:"userId"`
-	Role      TenantMemberRole `json:"role"`
+	ID           uuid.UUID        `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamp `json:"updatedAt"`
+	TenantId     uuid.UUID        `json:"tenantId"`
+	UserId       uuid.UUID        `json:"userId"`
+	Role         TenantMemberRole `json:"role"`
+	CustomRoleId *uuid.UUID       `json:"customRoleId"`
 }
 
 type TenantResourceLimit struct {
@@ -3000,6 +3516,17 @@ type TenantResourceLimitAlert struct {
 	Limit           int32                        `json:"limit"`
 }
 
+type TenantRole struct {
+	ID          uuid.UUID        `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	TenantId    uuid.UUID        `json:"tenantId"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Inherits    []string         `json:"inherits"`
+	Permissions []string         `json:"permissions"`
+}
+
 type TenantVcsProvider struct {
 	ID          uuid.UUID        `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -3085,6 +3612,24 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type V1BulkOperation struct {
+	ID             uuid.UUID             `json:"id"`
+	TenantID       uuid.UUID             `json:"tenant_id"`
+	Kind           V1BulkOperationKind   `json:"kind"`
+	Status         V1BulkOperationStatus `json:"status"`
+	Filter         []byte                `json:"filter"`
+	TotalCount     pgtype.Int4           `json:"total_count"`
+	ProcessedCount int32                 `json:"processed_count"`
+	CreatedAt      pgtype.Timestamptz    `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz    `json:"updated_at"`
+	FinishedAt     pgtype.Timestamptz    `json:"finished_at"`
+}
+
+type V1BulkOperationRun struct {
+	OperationID           uuid.UUID `json:"operation_id"`
+	WorkflowRunExternalID uuid.UUID `json:"workflow_run_external_id"`
+}
+
 type V1CelEvaluationFailuresOlap struct {
 	ID         int64                        `json:"id"`
 	TenantID   uuid.UUID                    `json:"tenant_id"`
@@ -3116,6 +3661,16 @@ type V1ConcurrencySlot struct {
 	ScheduleTimeoutAt     pgtype.Timestamp   `json:"schedule_timeout_at"`
 }
 
+type V1CronFire struct {
+	ID                    int64              `json:"id"`
+	TenantID              uuid.UUID          `json:"tenant_id"`
+	CronID                uuid.UUID          `json:"cron_id"`
+	ScheduledAt           pgtype.Timestamptz `json:"scheduled_at"`
+	FiredAt               pgtype.Timestamptz `json:"fired_at"`
+	Outcome               V1CronFireOutcome  `json:"outcome"`
+	WorkflowRunExternalID *uuid.UUID         `json:"workflow_run_external_id"`
+}
+
 type V1Dag struct {
 	ID                   int64              `json:"id"`
 	InsertedAt           pgtype.Timestamptz `json:"inserted_at"`
@@ -3164,6 +3719,22 @@ type V1DagsOlap struct {
 	TotalTasks           int32                `json:"total_tasks"`
 }
 
+type V1DeadLetterPolicy struct {
+	ID                     uuid.UUID          `json:"id"`
+	TenantID               uuid.UUID          `json:"tenant_id"`
+	SourceWorkflowID       *uuid.UUID         `json:"source_workflow_id"`
+	DeadLetterWorkflowName string             `json:"dead_letter_workflow_name"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+}
+
+type V1DeadLetterRun struct {
+	TenantID               uuid.UUID          `json:"tenant_id"`
+	WorkflowRunExternalID  uuid.UUID          `json:"workflow_run_external_id"`
+	DeadLetterWorkflowName string             `json:"dead_letter_workflow_name"`
+	InsertedAt             pgtype.Timestamptz `json:"inserted_at"`
+}
+
 type V1DurableEventLogBranchPoint struct {
 	TenantID               uuid.UUID          `json:"tenant_id"`
 	ID                     int64              `json:"id"`
@@ -3206,6 +3777,16 @@ type V1DurableEventLogFile struct {
 	LatestBranchID        int64              `json:"latest_branch_id"`
 }
 
+type V1DurableSignal struct {
+	TenantID              uuid.UUID          `json:"tenant_id"`
+	ID                    int64              `json:"id"`
+	DurableTaskID         int64              `json:"durable_task_id"`
+	DurableTaskInsertedAt pgtype.Timestamptz `json:"durable_task_inserted_at"`
+	Name                  string             `json:"name"`
+	Payload               []byte             `json:"payload"`
+	InsertedAt            pgtype.Timestamptz `json:"inserted_at"`
+}
+
 type V1DurableSleep struct {
 	ID            int64              `json:"id"`
 	TenantID      uuid.UUID          `json:"tenant_id"`
@@ -3547,6 +4128,25 @@ type V1RunsOlap struct {
 	ParentTaskExternalID *uuid.UUID           `json:"parent_task_external_id"`
 }
 
+type V1ScopedResourceLimit struct {
+	ID                 uuid.UUID            `json:"id"`
+	TenantID           uuid.UUID            `json:"tenant_id"`
+	Resource           LimitResource        `json:"resource"`
+	Scope              V1ResourceLimitScope `json:"scope"`
+	WorkflowID         *uuid.UUID           `json:"workflow_id"`
+	MetadataKey        pgtype.Text          `json:"metadata_key"`
+	MetadataValue      pgtype.Text          `json:"metadata_value"`
+	LimitValue         int32                `json:"limit_value"`
+	SoftLimitValue     pgtype.Int4          `json:"soft_limit_value"`
+	LimitWindow        pgtype.Interval      `json:"limit_window"`
+	Value              int32                `json:"value"`
+	LastRefill         pgtype.Timestamptz   `json:"last_refill"`
+	SoftLimitAlertedAt pgtype.Timestamptz   `json:"soft_limit_alerted_at"`
+	HardLimitAlertedAt pgtype.Timestamptz   `json:"hard_limit_alerted_at"`
+	CreatedAt          pgtype.Timestamptz   `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz   `json:"updated_at"`
+}
+
 type V1StatusesOlap struct {
 	ExternalID     uuid.UUID            `json:"external_id"`
 	InsertedAt     pgtype.Timestamptz   `json:"inserted_at"`
@@ -3567,6 +4167,7 @@ type V1StepConcurrency struct {
 	Expression        string                `json:"expression"`
 	TenantID          uuid.UUID             `json:"tenant_id"`
 	MaxConcurrency    int32                 `json:"max_concurrency"`
+	WeightExpression  pgtype.Text           `json:"weight_expression"`
 }
 
 type V1StepMatchCondition struct {
@@ -3583,6 +4184,23 @@ type V1StepMatchCondition struct {
 	ParentReadableID pgtype.Text              `json:"parent_readable_id"`
 }
 
+type V1StepOutputSchema struct {
+	TenantID     uuid.UUID          `json:"tenant_id"`
+	StepID       uuid.UUID          `json:"step_id"`
+	OutputSchema []byte             `json:"output_schema"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type V1StepRetryPolicy struct {
+	TenantID           uuid.UUID              `json:"tenant_id"`
+	StepID             uuid.UUID              `json:"step_id"`
+	ErrorType          string                 `json:"error_type"`
+	MaxRetries         pgtype.Int4            `json:"max_retries"`
+	BackoffStrategy    V1RetryBackoffStrategy `json:"backoff_strategy"`
+	BackoffBaseSeconds pgtype.Float8          `json:"backoff_base_seconds"`
+	CreatedAt          pgtype.Timestamptz     `json:"created_at"`
+}
+
 type V1StepSlotRequest struct {
 	TenantID  uuid.UUID          `json:"tenant_id"`
 	StepID    uuid.UUID          `json:"step_id"`
@@ -3630,6 +4248,7 @@ type V1Task struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	RetryDelaySeconds            pgtype.Float8      `json:"retry_delay_seconds"`
 	IsDurable                    pgtype.Bool        `json:"is_durable"`
 	DesiredWorkerLabel           []byte             `json:"desired_worker_label"`
 	TriggeringEventExternalID    *uuid.UUID         `json:"triggering_event_external_id"`
@@ -3760,6 +4379,14 @@ type V1WorkerSlotConfig struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type V1WorkerWorkflowVersion struct {
+	TenantID   uuid.UUID          `json:"tenant_id"`
+	WorkerID   uuid.UUID          `json:"worker_id"`
+	WorkflowID uuid.UUID          `json:"workflow_id"`
+	Version    string             `json:"version"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
 type V1WorkflowConcurrency struct {
 	ID                int64                 `json:"id"`
 	WorkflowID        uuid.UUID             `json:"workflow_id"`
@@ -3786,6 +4413,18 @@ type V1WorkflowConcurrencySlot struct {
 	IsFilled                  bool      `json:"is_filled"`
 }
 
+type V1WorkflowRetentionPolicy struct {
+	ID                       uuid.UUID          `json:"id"`
+	TenantID                 uuid.UUID          `json:"tenant_id"`
+	WorkflowID               uuid.UUID          `json:"workflow_id"`
+	RetentionPeriod          pgtype.Interval    `json:"retention_period"`
+	CompletedRetentionPeriod pgtype.Interval    `json:"completed_retention_period"`
+	FailedRetentionPeriod    pgtype.Interval    `json:"failed_retention_period"`
+	CancelledRetentionPeriod pgtype.Interval    `json:"cancelled_retention_period"`
+	CreatedAt                pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+}
+
 type WebhookWorker struct {
 	ID         uuid.UUID        `json:"id"`
 	CreatedAt  pgtype.Timestamp `json:"createdAt"`
@@ -3854,14 +4493,15 @@ type WorkerLabel struct {
 }
 
 type Workflow struct {
-	ID          uuid.UUID        `json:"id"`
-	CreatedAt   pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt   pgtype.Timestamp `json:"deletedAt"`
-	TenantId    uuid.UUID        `json:"tenantId"`
-	Name        string           `json:"name"`
-	Description pgtype.Text      `json:"description"`
-	IsPaused    pgtype.Bool      `json:"isPaused"`
+	ID                  uuid.UUID                   `json:"id"`
+	CreatedAt           pgtype.Timestamp            `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp            `json:"updatedAt"`
+	DeletedAt           pgtype.Timestamp            `json:"deletedAt"`
+	TenantId            uuid.UUID                   `json:"tenantId"`
+	Name                string                      `json:"name"`
+	Description         pgtype.Text                 `json:"description"`
+	IsPaused            pgtype.Bool                 `json:"isPaused"`
+	InputValidationMode WorkflowInputValidationMode `json:"inputValidationMode"`
 }
 
 type WorkflowConcurrency struct {
@@ -3948,19 +4588,24 @@ type WorkflowToWorkflowTag struct {
 }
 
 type WorkflowTriggerCronRef struct {
-	ParentId           uuid.UUID                     `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           *uuid.UUID                    `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 uuid.UUID                     `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
-	Priority           int32                         `json:"priority"`
+	ParentId           uuid.UUID                           `json:"parentId"`
+	Cron               string                              `json:"cron"`
+	TickerId           *uuid.UUID                          `json:"tickerId"`
+	Input              []byte                              `json:"input"`
+	Enabled            bool                                `json:"enabled"`
+	AdditionalMetadata []byte                              `json:"additionalMetadata"`
+	CreatedAt          pgtype.Timestamp                    `json:"createdAt"`
+	DeletedAt          pgtype.Timestamp                    `json:"deletedAt"`
+	UpdatedAt          pgtype.Timestamp                    `json:"updatedAt"`
+	Name               pgtype.Text                         `json:"name"`
+	ID                 uuid.UUID                           `json:"id"`
+	Method             WorkflowTriggerCronRefMethods       `json:"method"`
+	Priority           int32                               `json:"priority"`
+	Timezone           pgtype.Text                         `json:"timezone"`
+	MisfirePolicy      WorkflowTriggerCronRefMisfirePolicy `json:"misfirePolicy"`
+	MisfireLimit       pgtype.Int4                         `json:"misfireLimit"`
+	LastFiredAt        pgtype.Timestamp                    `json:"lastFiredAt"`
+	OverlapPolicy      WorkflowTriggerCronRefOverlapPolicy `json:"overlapPolicy"`
 }
 
 type WorkflowTriggerEventRef struct {
@@ -4011,4 +4656,5 @@ type WorkflowVersion struct {
 	DefaultPriority           pgtype.Int4        `json:"defaultPriority"`
 	CreateWorkflowVersionOpts []byte             `json:"createWorkflowVersionOpts"`
 	InputJsonSchema           []byte             `json:"inputJsonSchema"`
+	CanaryPercentage          pgtype.Int4        `json:"canaryPercentage"`
 }
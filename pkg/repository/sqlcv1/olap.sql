@@ -1718,6 +1718,19 @@ WHERE
 LIMIT 10000
 ;
 
+-- name: ListWorkflowRunStatuses :many
+SELECT
+    lt.external_id,
+    COALESCE(t.readable_status, d.readable_status)::v1_readable_status_olap AS readable_status
+FROM v1_lookup_table_olap lt
+LEFT JOIN v1_dags_olap d ON (lt.dag_id, lt.inserted_at) = (d.id, d.inserted_at)
+LEFT JOIN v1_tasks_olap t ON (lt.task_id, lt.inserted_at) = (t.id, t.inserted_at)
+WHERE
+    lt.external_id = ANY(@externalIds::uuid[])
+    AND lt.tenant_id = @tenantId::uuid
+    AND (t.id IS NOT NULL OR d.id IS NOT NULL)
+;
+
 -- name: GetRunsListRecursive :many
 WITH RECURSIVE all_runs AS (
   -- seed term
@@ -1901,6 +1914,10 @@ WHERE
         sqlc.narg('scopes')::TEXT[] IS NULL OR
         e.scope = ANY(sqlc.narg('scopes')::TEXT[])
     )
+    AND (
+        sqlc.narg('keyPattern')::TEXT IS NULL OR
+        e.key LIKE sqlc.narg('keyPattern')::TEXT
+    )
 ORDER BY e.seen_at DESC, e.id
 OFFSET
     COALESCE(sqlc.narg('offset')::BIGINT, 0)
@@ -1963,6 +1980,10 @@ WITH included_events AS (
             sqlc.narg('scopes')::TEXT[] IS NULL OR
             e.scope = ANY(sqlc.narg('scopes')::TEXT[])
         )
+        AND (
+            sqlc.narg('keyPattern')::TEXT IS NULL OR
+            e.key LIKE sqlc.narg('keyPattern')::TEXT
+        )
         ORDER BY e.seen_at DESC, e.id
     LIMIT 20000
 )
@@ -2183,6 +2204,7 @@ WHERE
     AND (
         sqlc.narg('workflowIds')::UUID[] IS NULL OR workflow_id = ANY(sqlc.narg('workflowIds')::UUID[])
     )
+LIMIT sqlc.narg('limit')::INTEGER
 ;
 
 -- name: CountOLAPTempTableSizeForDAGStatusUpdates :one
@@ -2408,3 +2430,69 @@ WHERE
         OR (s.retry_count = t.latest_retry_count AND t.readable_status = 'EVICTED' AND s.status != 'EVICTED')
     )
 RETURNING t.tenant_id, t.id, t.inserted_at, t.external_id, t.readable_status, t.latest_worker_id, t.workflow_id, t.dag_id, t.dag_inserted_at;
+
+-- name: GetWorkflowRunStatusCounts :one
+-- Runs which are still queued are counted from queuedSince, so the queue depth includes the backlog from before
+-- the window. Finished runs are only counted within the window. The lower bound on inserted_at lets the partitions
+-- before both of them be pruned.
+SELECT
+    COUNT(*) FILTER (WHERE readable_status = 'QUEUED') AS queued_count,
+    COUNT(*) FILTER (WHERE readable_status = 'COMPLETED' AND inserted_at >= @since::timestamptz) AS completed_count,
+    COUNT(*) FILTER (WHERE readable_status = 'FAILED' AND inserted_at >= @since::timestamptz) AS failed_count
+FROM v1_runs_olap
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_id = @workflowId::uuid
+    AND inserted_at >= LEAST(@since::timestamptz, @queuedSince::timestamptz)
+    AND (
+        inserted_at >= @since::timestamptz
+        OR readable_status = 'QUEUED'
+    );
+
+-- name: GetWorkflowRunDurationPercentile :one
+WITH runs AS (
+    SELECT external_id
+    FROM v1_runs_olap
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND workflow_id = @workflowId::uuid
+        AND inserted_at >= @since::timestamptz
+        AND readable_status = ANY(ARRAY['COMPLETED', 'FAILED']::v1_readable_status_olap[])
+), run_durations AS (
+    SELECT
+        t.workflow_run_id,
+        MIN(e.event_timestamp) FILTER (WHERE e.event_type = 'STARTED') AS started_at,
+        MAX(e.event_timestamp) FILTER (WHERE e.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')) AS finished_at
+    FROM
+        runs r
+    JOIN
+        v1_tasks_olap t ON t.tenant_id = @tenantId::uuid AND t.workflow_run_id = r.external_id
+    JOIN
+        v1_task_events_olap e ON (e.tenant_id, e.task_id, e.task_inserted_at) = (t.tenant_id, t.id, t.inserted_at)
+    WHERE
+        t.inserted_at >= @since::timestamptz
+    GROUP BY
+        t.workflow_run_id
+)
+SELECT
+    COUNT(*) AS run_count,
+    COALESCE(
+        PERCENTILE_CONT(@percentile::float8) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - started_at)),
+        0
+    )::float8 AS duration_seconds
+FROM
+    run_durations
+WHERE
+    started_at IS NOT NULL
+    AND finished_at IS NOT NULL;
+
+-- name: CountSuccessfulCronRuns :one
+SELECT COUNT(*) AS total
+FROM v1_runs_olap
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_id = @workflowId::uuid
+    AND inserted_at >= @since::timestamptz
+    AND readable_status = 'COMPLETED'
+    -- runs triggered by a cron have the cron expression in their additional metadata
+    AND additional_metadata ? 'hatchet__cron_expression';
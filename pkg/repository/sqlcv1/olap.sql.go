@@ -269,6 +269,10 @@ WITH included_events AS (
             $9::TEXT[] IS NULL OR
             e.scope = ANY($9::TEXT[])
         )
+        AND (
+            $10::TEXT IS NULL OR
+            e.key LIKE $10::TEXT
+        )
         ORDER BY e.seen_at DESC, e.id
     LIMIT 20000
 )
@@ -287,6 +291,7 @@ type CountEventsParams struct {
 	AdditionalMetadata []byte             `json:"additionalMetadata"`
 	Statuses           []string           `json:"statuses"`
 	Scopes             []string           `json:"scopes"`
+	KeyPattern         pgtype.Text        `json:"keyPattern"`
 }
 
 func (q *Queries) CountEvents(ctx context.Context, db DBTX, arg CountEventsParams) (int64, error) {
@@ -300,6 +305,7 @@ func (q *Queries) CountEvents(ctx context.Context, db DBTX, arg CountEventsParam
 		arg.AdditionalMetadata,
 		arg.Statuses,
 		arg.Scopes,
+		arg.KeyPattern,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -330,6 +336,31 @@ func (q *Queries) CountOLAPTempTableSizeForTaskStatusUpdates(ctx context.Context
 	return total, err
 }
 
+const countSuccessfulCronRuns = `-- name: CountSuccessfulCronRuns :one
+SELECT COUNT(*) AS total
+FROM v1_runs_olap
+WHERE
+    tenant_id = $1::uuid
+    AND workflow_id = $2::uuid
+    AND inserted_at >= $3::timestamptz
+    AND readable_status = 'COMPLETED'
+    -- runs triggered by a cron have the cron expression in their additional metadata
+    AND additional_metadata ? 'hatchet__cron_expression'
+`
+
+type CountSuccessfulCronRunsParams struct {
+	Tenantid   uuid.UUID          `json:"tenantid"`
+	Workflowid uuid.UUID          `json:"workflowid"`
+	Since      pgtype.Timestamptz `json:"since"`
+}
+
+func (q *Queries) CountSuccessfulCronRuns(ctx context.Context, db DBTX, arg CountSuccessfulCronRunsParams) (int64, error) {
+	row := db.QueryRow(ctx, countSuccessfulCronRuns, arg.Tenantid, arg.Workflowid, arg.Since)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createIncomingWebhookValidationFailureLogs = `-- name: CreateIncomingWebhookValidationFailureLogs :exec
 WITH inputs AS (
     SELECT
@@ -1257,6 +1288,68 @@ func (q *Queries) GetTenantStatusMetrics(ctx context.Context, db DBTX, arg GetTe
 	return &i, err
 }
 
+const getWorkflowRunDurationPercentile = `-- name: GetWorkflowRunDurationPercentile :one
+WITH runs AS (
+    SELECT external_id
+    FROM v1_runs_olap
+    WHERE
+        tenant_id = $1::uuid
+        AND workflow_id = $2::uuid
+        AND inserted_at >= $3::timestamptz
+        AND readable_status = ANY(ARRAY['COMPLETED', 'FAILED']::v1_readable_status_olap[])
+), run_durations AS (
+    SELECT
+        t.workflow_run_id,
+        MIN(e.event_timestamp) FILTER (WHERE e.event_type = 'STARTED') AS started_at,
+        MAX(e.event_timestamp) FILTER (WHERE e.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')) AS finished_at
+    FROM
+        runs r
+    JOIN
+        v1_tasks_olap t ON t.tenant_id = $1::uuid AND t.workflow_run_id = r.external_id
+    JOIN
+        v1_task_events_olap e ON (e.tenant_id, e.task_id, e.task_inserted_at) = (t.tenant_id, t.id, t.inserted_at)
+    WHERE
+        t.inserted_at >= $3::timestamptz
+    GROUP BY
+        t.workflow_run_id
+)
+SELECT
+    COUNT(*) AS run_count,
+    COALESCE(
+        PERCENTILE_CONT($4::float8) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - started_at)),
+        0
+    )::float8 AS duration_seconds
+FROM
+    run_durations
+WHERE
+    started_at IS NOT NULL
+    AND finished_at IS NOT NULL
+`
+
+type GetWorkflowRunDurationPercentileParams struct {
+	Tenantid   uuid.UUID          `json:"tenantid"`
+	Workflowid uuid.UUID          `json:"workflowid"`
+	Since      pgtype.Timestamptz `json:"since"`
+	Percentile float64            `json:"percentile"`
+}
+
+type GetWorkflowRunDurationPercentileRow struct {
+	RunCount        int64   `json:"run_count"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+func (q *Queries) GetWorkflowRunDurationPercentile(ctx context.Context, db DBTX, arg GetWorkflowRunDurationPercentileParams) (*GetWorkflowRunDurationPercentileRow, error) {
+	row := db.QueryRow(ctx, getWorkflowRunDurationPercentile,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Since,
+		arg.Percentile,
+	)
+	var i GetWorkflowRunDurationPercentileRow
+	err := row.Scan(&i.RunCount, &i.DurationSeconds)
+	return &i, err
+}
+
 const getWorkflowRunIdFromDagIdInsertedAt = `-- name: GetWorkflowRunIdFromDagIdInsertedAt :one
 SELECT external_id
 FROM v1_dags_olap
@@ -1277,6 +1370,48 @@ func (q *Queries) GetWorkflowRunIdFromDagIdInsertedAt(ctx context.Context, db DB
 	return external_id, err
 }
 
+const getWorkflowRunStatusCounts = `-- name: GetWorkflowRunStatusCounts :one
+-- Runs which are still queued are counted from queuedSince, so the queue depth includes the backlog from before
+-- the window. Finished runs are only counted within the window. The lower bound on inserted_at lets the partitions
+-- before both of them be pruned.
+SELECT
+    COUNT(*) FILTER (WHERE readable_status = 'QUEUED') AS queued_count,
+    COUNT(*) FILTER (WHERE readable_status = 'COMPLETED' AND inserted_at >= $3::timestamptz) AS completed_count,
+    COUNT(*) FILTER (WHERE readable_status = 'FAILED' AND inserted_at >= $3::timestamptz) AS failed_count
+FROM v1_runs_olap
+WHERE
+    tenant_id = $1::uuid
+    AND workflow_id = $2::uuid
+    AND inserted_at >= LEAST($3::timestamptz, $4::timestamptz)
+    AND (
+        inserted_at >= $3::timestamptz
+        OR readable_status = 'QUEUED'
+    )
+`
+
+type GetWorkflowRunStatusCountsParams struct {
+	Tenantid    uuid.UUID          `json:"tenantid"`
+	Workflowid  uuid.UUID          `json:"workflowid"`
+	Since       pgtype.Timestamptz `json:"since"`
+	Queuedsince pgtype.Timestamptz `json:"queuedsince"`
+}
+
+type GetWorkflowRunStatusCountsRow struct {
+	QueuedCount    int64 `json:"queued_count"`
+	CompletedCount int64 `json:"completed_count"`
+	FailedCount    int64 `json:"failed_count"`
+}
+
+// Runs which are still queued are counted from queuedSince, so the queue depth includes the backlog from before
+// the window. Finished runs are only counted within the window. The lower bound on inserted_at lets the partitions
+// before both of them be pruned.
+func (q *Queries) GetWorkflowRunStatusCounts(ctx context.Context, db DBTX, arg GetWorkflowRunStatusCountsParams) (*GetWorkflowRunStatusCountsRow, error) {
+	row := db.QueryRow(ctx, getWorkflowRunStatusCounts, arg.Tenantid, arg.Workflowid, arg.Since, arg.Queuedsince)
+	var i GetWorkflowRunStatusCountsRow
+	err := row.Scan(&i.QueuedCount, &i.CompletedCount, &i.FailedCount)
+	return &i, err
+}
+
 const listEventKeys = `-- name: ListEventKeys :many
 SELECT DISTINCT key
 FROM
@@ -1360,11 +1495,15 @@ WHERE
         $9::TEXT[] IS NULL OR
         e.scope = ANY($9::TEXT[])
     )
+    AND (
+        $10::TEXT IS NULL OR
+        e.key LIKE $10::TEXT
+    )
 ORDER BY e.seen_at DESC, e.id
 OFFSET
-    COALESCE($10::BIGINT, 0)
+    COALESCE($11::BIGINT, 0)
 LIMIT
-    COALESCE($11::BIGINT, 50)
+    COALESCE($12::BIGINT, 50)
 `
 
 type ListEventsParams struct {
@@ -1377,6 +1516,7 @@ type ListEventsParams struct {
 	AdditionalMetadata []byte             `json:"additionalMetadata"`
 	Statuses           []string           `json:"statuses"`
 	Scopes             []string           `json:"scopes"`
+	KeyPattern         pgtype.Text        `json:"keyPattern"`
 	Offset             pgtype.Int8        `json:"offset"`
 	Limit              pgtype.Int8        `json:"limit"`
 }
@@ -1392,6 +1532,7 @@ func (q *Queries) ListEvents(ctx context.Context, db DBTX, arg ListEventsParams)
 		arg.AdditionalMetadata,
 		arg.Statuses,
 		arg.Scopes,
+		arg.KeyPattern,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -2055,6 +2196,7 @@ WHERE
     AND (
         $7::UUID[] IS NULL OR workflow_id = ANY($7::UUID[])
     )
+LIMIT $8::INTEGER
 `
 
 type ListWorkflowRunExternalIdsParams struct {
@@ -2065,6 +2207,7 @@ type ListWorkflowRunExternalIdsParams struct {
 	AdditionalMetaKeys   []string           `json:"additionalMetaKeys"`
 	AdditionalMetaValues []string           `json:"additionalMetaValues"`
 	WorkflowIds          []uuid.UUID        `json:"workflowIds"`
+	Limit                pgtype.Int4        `json:"limit"`
 }
 
 func (q *Queries) ListWorkflowRunExternalIds(ctx context.Context, db DBTX, arg ListWorkflowRunExternalIdsParams) ([]uuid.UUID, error) {
@@ -2076,6 +2219,7 @@ func (q *Queries) ListWorkflowRunExternalIds(ctx context.Context, db DBTX, arg L
 		arg.AdditionalMetaKeys,
 		arg.AdditionalMetaValues,
 		arg.WorkflowIds,
+		arg.Limit,
 	)
 	if err != nil {
 		return nil, err
@@ -2095,6 +2239,49 @@ func (q *Queries) ListWorkflowRunExternalIds(ctx context.Context, db DBTX, arg L
 	return items, nil
 }
 
+const listWorkflowRunStatuses = `-- name: ListWorkflowRunStatuses :many
+SELECT
+    lt.external_id,
+    COALESCE(t.readable_status, d.readable_status)::v1_readable_status_olap AS readable_status
+FROM v1_lookup_table_olap lt
+LEFT JOIN v1_dags_olap d ON (lt.dag_id, lt.inserted_at) = (d.id, d.inserted_at)
+LEFT JOIN v1_tasks_olap t ON (lt.task_id, lt.inserted_at) = (t.id, t.inserted_at)
+WHERE
+    lt.external_id = ANY($1::uuid[])
+    AND lt.tenant_id = $2::uuid
+    AND (t.id IS NOT NULL OR d.id IS NOT NULL)
+`
+
+type ListWorkflowRunStatusesParams struct {
+	Externalids []uuid.UUID `json:"externalids"`
+	Tenantid    uuid.UUID   `json:"tenantid"`
+}
+
+type ListWorkflowRunStatusesRow struct {
+	ExternalID     uuid.UUID            `json:"external_id"`
+	ReadableStatus V1ReadableStatusOlap `json:"readable_status"`
+}
+
+func (q *Queries) ListWorkflowRunStatuses(ctx context.Context, db DBTX, arg ListWorkflowRunStatusesParams) ([]*ListWorkflowRunStatusesRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunStatuses, arg.Externalids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunStatusesRow
+	for rows.Next() {
+		var i ListWorkflowRunStatusesRow
+		if err := rows.Scan(&i.ExternalID, &i.ReadableStatus); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listYesterdayRunCountsByStatus = `-- name: ListYesterdayRunCountsByStatus :many
 SELECT readable_status, COUNT(*)
 FROM v1_runs_olap
@@ -308,6 +308,21 @@ WHERE
     step_id = ANY(@stepIds::uuid[])
     AND tenant_id = @tenantId::uuid;
 
+-- name: GetStepWorkflowVersions :many
+SELECT
+    s."id" AS "stepId",
+    wv."version"::text AS "version"
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND s."tenantId" = @tenantId::uuid
+    AND wv."version" IS NOT NULL;
+
 -- name: GetQueuedCounts :many
 SELECT
     queue,
@@ -397,6 +397,52 @@ func (q *Queries) GetStepSlotRequests(ctx context.Context, db DBTX, arg GetStepS
 	return items, nil
 }
 
+const getStepWorkflowVersions = `-- name: GetStepWorkflowVersions :many
+SELECT
+    s."id" AS "stepId",
+    wv."version"::text AS "version"
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND s."tenantId" = $2::uuid
+    AND wv."version" IS NOT NULL
+`
+
+type GetStepWorkflowVersionsParams struct {
+	Stepids  []uuid.UUID `json:"stepids"`
+	Tenantid uuid.UUID   `json:"tenantid"`
+}
+
+type GetStepWorkflowVersionsRow struct {
+	StepId  uuid.UUID `json:"stepId"`
+	Version string    `json:"version"`
+}
+
+func (q *Queries) GetStepWorkflowVersions(ctx context.Context, db DBTX, arg GetStepWorkflowVersionsParams) ([]*GetStepWorkflowVersionsRow, error) {
+	rows, err := db.Query(ctx, getStepWorkflowVersions, arg.Stepids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetStepWorkflowVersionsRow
+	for rows.Next() {
+		var i GetStepWorkflowVersionsRow
+		if err := rows.Scan(&i.StepId, &i.Version); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listActionsForWorkers = `-- name: ListActionsForWorkers :many
 SELECT
     w."id" as "workerId",
@@ -4,17 +4,25 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "mode",
+    "burst"
 ) VALUES (
     @tenantId::uuid,
     @key::text,
     sqlc.arg('limit')::int,
-    sqlc.arg('limit')::int,
-    COALESCE(sqlc.narg('window')::text, '1 minute')
+    COALESCE(sqlc.narg('burst')::int, sqlc.arg('limit')::int),
+    COALESCE(sqlc.narg('window')::text, '1 minute'),
+    COALESCE(sqlc.narg('mode')::"RateLimitMode", 'FIXED_WINDOW'),
+    sqlc.narg('burst')::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = sqlc.arg('limit')::int,
     "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    -- an upsert which doesn't set a mode keeps the mode of the existing limit
+    "mode" = COALESCE(sqlc.narg('mode')::"RateLimitMode", "RateLimit"."mode"),
+    "burst" = EXCLUDED."burst",
+    "previousWindowUsed" = CASE WHEN COALESCE(sqlc.narg('mode')::"RateLimitMode", "RateLimit"."mode") = "RateLimit"."mode" THEN "RateLimit"."previousWindowUsed" ELSE 0 END,
+    "value" = LEAST("RateLimit"."value", COALESCE(EXCLUDED."burst", EXCLUDED."limitValue"))
 RETURNING *;
 
 -- name: UpsertRateLimitsBulk :exec
@@ -49,7 +57,7 @@ FROM
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END;
+    "value" = LEAST("RateLimit"."value", COALESCE("RateLimit"."burst", EXCLUDED."limitValue"));
 
 -- name: CountRateLimits :one
 WITH rate_limits AS (
@@ -88,19 +96,11 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_timestamp(rl)::timestamp AS "lastRefill",
+    "mode",
+    "burst"
 FROM
     "RateLimit" rl
 WHERE
@@ -130,7 +130,11 @@ WITH rls_to_update AS (
         "RateLimit" rl
     WHERE
         rl."tenantId" = @tenantId::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
+        AND (
+            -- token buckets only need a refill once a whole token has accrued
+            (rl."mode" = 'TOKEN_BUCKET' AND get_refill_value(rl) > rl."value")
+            OR (rl."mode" != 'TOKEN_BUCKET' AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds'))
+        )
     ORDER BY
         rl."tenantId" ASC, rl."key" ASC
     FOR UPDATE
@@ -139,17 +143,35 @@ WITH rls_to_update AS (
         "RateLimit" rl
     SET
         "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
+        "previousWindowUsed" = get_previous_window_used(rl),
+        "lastRefill" = get_refill_timestamp(rl)
     FROM
         rls_to_update
     WHERE
         rl."tenantId" = rls_to_update."tenantId"
         AND rl."key" = rls_to_update."key"
-    RETURNING rl.*
+    RETURNING
+        rl."tenantId",
+        rl."key",
+        rl."limitValue",
+        get_available_value(rl) AS "value",
+        rl."window",
+        rl."lastRefill",
+        rl."mode",
+        rl."burst",
+        get_next_refill_at(rl) AS "nextRefillAt"
 )
 SELECT
-    rl.*,
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    rl."tenantId",
+    rl."key",
+    rl."limitValue",
+    get_available_value(rl)::int AS "value",
+    rl."window",
+    rl."lastRefill",
+    rl."mode",
+    rl."burst",
+    -- return the next time the available value can increase
+    get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 FROM
     "RateLimit" rl
 WHERE
@@ -159,9 +181,15 @@ WHERE
 UNION ALL
 
 SELECT
-    refill.*,
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    refill."tenantId",
+    refill."key",
+    refill."limitValue",
+    refill."value"::int AS "value",
+    refill."window",
+    refill."lastRefill",
+    refill."mode",
+    refill."burst",
+    refill."nextRefillAt"::timestamp AS "nextRefillAt"
 FROM
     refill;
 
@@ -200,12 +228,8 @@ UPDATE
     "RateLimit" rl
 SET
     "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "previousWindowUsed" = get_previous_window_used(rl),
+    "lastRefill" = get_refill_timestamp(rl)
 FROM
     rls_to_update rl2
 WHERE
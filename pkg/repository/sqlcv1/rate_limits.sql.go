@@ -24,7 +24,7 @@ WITH input AS (
         ) AS subquery
 ), rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.mode, rl.burst, rl."previousWindowUsed"
     FROM
         "RateLimit" rl
     WHERE
@@ -38,18 +38,14 @@ UPDATE
     "RateLimit" rl
 SET
     "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "previousWindowUsed" = get_previous_window_used(rl),
+    "lastRefill" = get_refill_timestamp(rl)
 FROM
     rls_to_update rl2
 WHERE
     rl2."tenantId" = rl."tenantId"
     AND rl2."key" = rl."key"
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.mode, rl.burst, rl."previousWindowUsed"
 `
 
 type BulkUpdateRateLimitsParams struct {
@@ -74,6 +70,9 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Mode,
+			&i.Burst,
+			&i.PreviousWindowUsed,
 		); err != nil {
 			return nil, err
 		}
@@ -187,19 +186,11 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_timestamp(rl)::timestamp AS "lastRefill",
+    "mode",
+    "burst"
 FROM
     "RateLimit" rl
 WHERE
@@ -237,6 +228,8 @@ type ListRateLimitsForTenantNoMutateRow struct {
 	Value      int32            `json:"value"`
 	Window     string           `json:"window"`
 	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	Mode       RateLimitMode    `json:"mode"`
+	Burst      pgtype.Int4      `json:"burst"`
 }
 
 // Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
@@ -262,6 +255,8 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Mode,
+			&i.Burst,
 		); err != nil {
 			return nil, err
 		}
@@ -276,12 +271,16 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 const listRateLimitsForTenantWithMutate = `-- name: ListRateLimitsForTenantWithMutate :many
 WITH rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.mode, rl.burst, rl."previousWindowUsed"
     FROM
         "RateLimit" rl
     WHERE
         rl."tenantId" = $1::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
+        AND (
+            -- token buckets only need a refill once a whole token has accrued
+            (rl."mode" = 'TOKEN_BUCKET' AND get_refill_value(rl) > rl."value")
+            OR (rl."mode" != 'TOKEN_BUCKET' AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds'))
+        )
     ORDER BY
         rl."tenantId" ASC, rl."key" ASC
     FOR UPDATE
@@ -290,17 +289,35 @@ WITH rls_to_update AS (
         "RateLimit" rl
     SET
         "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
+        "previousWindowUsed" = get_previous_window_used(rl),
+        "lastRefill" = get_refill_timestamp(rl)
     FROM
         rls_to_update
     WHERE
         rl."tenantId" = rls_to_update."tenantId"
         AND rl."key" = rls_to_update."key"
-    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+    RETURNING
+        rl."tenantId",
+        rl."key",
+        rl."limitValue",
+        get_available_value(rl) AS "value",
+        rl."window",
+        rl."lastRefill",
+        rl."mode",
+        rl."burst",
+        get_next_refill_at(rl) AS "nextRefillAt"
 )
 SELECT
-    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill",
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    rl."tenantId",
+    rl."key",
+    rl."limitValue",
+    get_available_value(rl)::int AS "value",
+    rl."window",
+    rl."lastRefill",
+    rl."mode",
+    rl."burst",
+    -- return the next time the available value can increase
+    get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 FROM
     "RateLimit" rl
 WHERE
@@ -310,9 +327,15 @@ WHERE
 UNION ALL
 
 SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    refill."tenantId",
+    refill."key",
+    refill."limitValue",
+    refill."value"::int AS "value",
+    refill."window",
+    refill."lastRefill",
+    refill."mode",
+    refill."burst",
+    refill."nextRefillAt"::timestamp AS "nextRefillAt"
 FROM
     refill
 `
@@ -324,6 +347,8 @@ type ListRateLimitsForTenantWithMutateRow struct {
 	Value        int32            `json:"value"`
 	Window       string           `json:"window"`
 	LastRefill   pgtype.Timestamp `json:"lastRefill"`
+	Mode         RateLimitMode    `json:"mode"`
+	Burst        pgtype.Int4      `json:"burst"`
 	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
 }
 
@@ -343,6 +368,8 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Mode,
+			&i.Burst,
 			&i.NextRefillAt,
 		); err != nil {
 			return nil, err
@@ -361,25 +388,35 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "mode",
+    "burst"
 ) VALUES (
     $1::uuid,
     $2::text,
     $3::int,
-    $3::int,
-    COALESCE($4::text, '1 minute')
+    COALESCE($4::int, $3::int),
+    COALESCE($5::text, '1 minute'),
+    COALESCE($6::"RateLimitMode", 'FIXED_WINDOW'),
+    $4::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = $3::int,
-    "window" = COALESCE($4::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
-RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    "window" = COALESCE($5::text, '1 minute'),
+    -- an upsert which doesn't set a mode keeps the mode of the existing limit
+    "mode" = COALESCE($6::"RateLimitMode", "RateLimit"."mode"),
+    "burst" = EXCLUDED."burst",
+    "previousWindowUsed" = CASE WHEN COALESCE($6::"RateLimitMode", "RateLimit"."mode") = "RateLimit"."mode" THEN "RateLimit"."previousWindowUsed" ELSE 0 END,
+    "value" = LEAST("RateLimit"."value", COALESCE(EXCLUDED."burst", EXCLUDED."limitValue"))
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", mode, burst, "previousWindowUsed"
 `
 
 type UpsertRateLimitParams struct {
-	Tenantid uuid.UUID   `json:"tenantid"`
-	Key      string      `json:"key"`
-	Limit    int32       `json:"limit"`
-	Window   pgtype.Text `json:"window"`
+	Tenantid uuid.UUID         `json:"tenantid"`
+	Key      string            `json:"key"`
+	Limit    int32             `json:"limit"`
+	Burst    pgtype.Int4       `json:"burst"`
+	Window   pgtype.Text       `json:"window"`
+	Mode     NullRateLimitMode `json:"mode"`
 }
 
 func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLimitParams) (*RateLimit, error) {
@@ -387,7 +424,9 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		arg.Tenantid,
 		arg.Key,
 		arg.Limit,
+		arg.Burst,
 		arg.Window,
+		arg.Mode,
 	)
 	var i RateLimit
 	err := row.Scan(
@@ -397,6 +436,9 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		&i.Value,
 		&i.Window,
 		&i.LastRefill,
+		&i.Mode,
+		&i.Burst,
+		&i.PreviousWindowUsed,
 	)
 	return &i, err
 }
@@ -433,7 +475,7 @@ FROM
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "value" = LEAST("RateLimit"."value", COALESCE("RateLimit"."burst", EXCLUDED."limitValue"))
 `
 
 type UpsertRateLimitsBulkParams struct {
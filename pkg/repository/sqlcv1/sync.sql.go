@@ -129,7 +129,7 @@ SET
     "updatedAt" = $1::timestamp,
     "role" = $2::"TenantMemberRole"
 WHERE "id" = $3::uuid
-RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role
+RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 `
 
 type SyncUpdateTenantMemberParams struct {
@@ -148,6 +148,7 @@ func (q *Queries) SyncUpdateTenantMember(ctx context.Context, db DBTX, arg SyncU
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
@@ -372,7 +373,7 @@ INSERT INTO "TenantMember" (
 ) ON CONFLICT ("id") DO UPDATE SET
     "updatedAt" = $3::timestamp,
     "role" = $6::"TenantMemberRole"
-RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role
+RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 `
 
 type SyncUpsertTenantMemberParams struct {
@@ -401,6 +402,7 @@ func (q *Queries) SyncUpsertTenantMember(ctx context.Context, db DBTX, arg SyncU
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
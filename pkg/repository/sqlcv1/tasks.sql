@@ -10,7 +10,8 @@ SELECT
     create_v1_range_partition('v1_event_to_run', @date::date),
     create_v1_range_partition('v1_durable_event_log_file', @date::date),
     create_v1_range_partition('v1_durable_event_log_entry', @date::date, 80),
-    create_v1_range_partition('v1_durable_event_log_branch_point', @date::date, 80)
+    create_v1_range_partition('v1_durable_event_log_branch_point', @date::date, 80),
+    create_v1_range_partition('v1_durable_signal', @date::date)
 ;
 
 -- name: EnsureTablePartitionsExist :one
@@ -35,6 +36,8 @@ WITH tomorrow_date AS (
     SELECT 'v1_durable_event_log_entry_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
     UNION ALL
     SELECT 'v1_durable_event_log_branch_point_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
+    UNION ALL
+    SELECT 'v1_durable_signal_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
 ), partition_check AS (
     SELECT
         COUNT(*) AS total_tables,
@@ -72,6 +75,8 @@ WITH task_partitions AS (
     SELECT 'v1_durable_event_log_entry' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_event_log_entry', @date::date) AS p
 ), durable_event_log_branch_point_partitions AS (
     SELECT 'v1_durable_event_log_branch_point' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_event_log_branch_point', @date::date) AS p
+), durable_signal_partitions AS (
+    SELECT 'v1_durable_signal' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_signal', @date::date) AS p
 )
 
 SELECT
@@ -148,6 +153,13 @@ SELECT
     *
 FROM
     durable_event_log_branch_point_partitions
+
+UNION ALL
+
+SELECT
+    *
+FROM
+    durable_signal_partitions
 ;
 
 -- name: DefaultTaskActivityGauge :one
@@ -273,7 +285,8 @@ WITH input AS (
                 unnest(@taskIds::bigint[]) AS task_id,
                 unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
                 unnest(@taskRetryCounts::integer[]) AS task_retry_count,
-                unnest(@isNonRetryables::boolean[]) AS is_non_retryable
+                unnest(@isNonRetryables::boolean[]) AS is_non_retryable,
+                unnest(@errorTypes::text[]) AS error_type
         ) AS subquery
 ), locked_tasks AS (
     SELECT
@@ -299,26 +312,61 @@ WITH input AS (
 ), tasks_to_steps AS (
     SELECT
         t.id,
-        t.step_id,
-        s."retries"
+        t.inserted_at,
+        i.is_non_retryable,
+        COALESCE(rp.max_retries, s."retries") AS retries,
+        rp.backoff_strategy,
+        COALESCE(rp.backoff_base_seconds, 1) AS backoff_base_seconds
     FROM
         locked_tasks t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at
     JOIN
         "Step" s ON s."id" = t.step_id
+    -- a retry policy matching the reported error type takes precedence over the step's default policy
+    LEFT JOIN LATERAL (
+        SELECT
+            p.max_retries,
+            p.backoff_strategy,
+            p.backoff_base_seconds
+        FROM
+            v1_step_retry_policy p
+        WHERE
+            p.step_id = t.step_id
+            AND p.error_type IN (i.error_type, '')
+        ORDER BY
+            p.error_type = '' ASC
+        LIMIT 1
+    ) rp ON TRUE
 )
 UPDATE
     v1_task
 SET
     retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    app_retry_count = app_retry_count + 1,
+    -- a null delay falls back to min(retry_backoff_factor ^ app_retry_count, retry_max_backoff) in the update trigger
+    retry_delay_seconds = CASE tasks_to_steps.backoff_strategy
+        WHEN 'FULL_JITTER' THEN
+            random() * LEAST(
+                COALESCE(v1_task.retry_max_backoff, 86400),
+                POWER(COALESCE(v1_task.retry_backoff_factor, 2), v1_task.app_retry_count + 1)
+            )
+        WHEN 'DECORRELATED_JITTER' THEN
+            LEAST(
+                COALESCE(v1_task.retry_max_backoff, 86400),
+                tasks_to_steps.backoff_base_seconds + random() * GREATEST(
+                    3 * COALESCE(v1_task.retry_delay_seconds, tasks_to_steps.backoff_base_seconds) - tasks_to_steps.backoff_base_seconds,
+                    0
+                )
+            )
+        WHEN 'FIXED' THEN tasks_to_steps.backoff_base_seconds
+        ELSE NULL
+    END
 FROM
     tasks_to_steps
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
+    (v1_task.id, v1_task.inserted_at) = (tasks_to_steps.id, tasks_to_steps.inserted_at)
+    AND tasks_to_steps.is_non_retryable = FALSE
     AND tasks_to_steps."retries" > v1_task.app_retry_count
 RETURNING
     v1_task.id,
@@ -326,7 +374,8 @@ RETURNING
     v1_task.retry_count,
     v1_task.app_retry_count,
     v1_task.retry_backoff_factor,
-    v1_task.retry_max_backoff;
+    v1_task.retry_max_backoff,
+    v1_task.retry_delay_seconds;
 
 -- name: FailTaskInternalFailure :many
 -- Fails a task due to an internal error
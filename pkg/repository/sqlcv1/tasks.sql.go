@@ -243,7 +243,8 @@ SELECT
     create_v1_range_partition('v1_event_to_run', $1::date),
     create_v1_range_partition('v1_durable_event_log_file', $1::date),
     create_v1_range_partition('v1_durable_event_log_entry', $1::date, 80),
-    create_v1_range_partition('v1_durable_event_log_branch_point', $1::date, 80)
+    create_v1_range_partition('v1_durable_event_log_branch_point', $1::date, 80),
+    create_v1_range_partition('v1_durable_signal', $1::date)
 `
 
 func (q *Queries) CreatePartitions(ctx context.Context, db DBTX, date pgtype.Date) error {
@@ -345,6 +346,8 @@ WITH tomorrow_date AS (
     SELECT 'v1_durable_event_log_entry_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
     UNION ALL
     SELECT 'v1_durable_event_log_branch_point_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
+    UNION ALL
+    SELECT 'v1_durable_signal_' || to_char((SELECT date FROM tomorrow_date), 'YYYYMMDD')
 ), partition_check AS (
     SELECT
         COUNT(*) AS total_tables,
@@ -428,14 +431,15 @@ func (q *Queries) EvictTask(ctx context.Context, db DBTX, arg EvictTaskParams) (
 const failTaskAppFailure = `-- name: FailTaskAppFailure :many
 WITH input AS (
     SELECT
-        task_id, task_inserted_at, task_retry_count, is_non_retryable
+        task_id, task_inserted_at, task_retry_count, is_non_retryable, error_type
     FROM
         (
             SELECT
                 unnest($1::bigint[]) AS task_id,
                 unnest($2::timestamptz[]) AS task_inserted_at,
                 unnest($3::integer[]) AS task_retry_count,
-                unnest($4::boolean[]) AS is_non_retryable
+                unnest($4::boolean[]) AS is_non_retryable,
+                unnest($5::text[]) AS error_type
         ) AS subquery
 ), locked_tasks AS (
     SELECT
@@ -447,7 +451,7 @@ WITH input AS (
     JOIN
         input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
     WHERE
-        t.tenant_id = $5::uuid
+        t.tenant_id = $6::uuid
         -- only fail tasks which still have a v1_task_runtime for the current retry count.
         -- a cancellation deletes the v1_task_runtime, so a late failure event should not trigger a retry.
         AND EXISTS (
@@ -461,26 +465,61 @@ WITH input AS (
 ), tasks_to_steps AS (
     SELECT
         t.id,
-        t.step_id,
-        s."retries"
+        t.inserted_at,
+        i.is_non_retryable,
+        COALESCE(rp.max_retries, s."retries") AS retries,
+        rp.backoff_strategy,
+        COALESCE(rp.backoff_base_seconds, 1) AS backoff_base_seconds
     FROM
         locked_tasks t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at
     JOIN
         "Step" s ON s."id" = t.step_id
+    -- a retry policy matching the reported error type takes precedence over the step's default policy
+    LEFT JOIN LATERAL (
+        SELECT
+            p.max_retries,
+            p.backoff_strategy,
+            p.backoff_base_seconds
+        FROM
+            v1_step_retry_policy p
+        WHERE
+            p.step_id = t.step_id
+            AND p.error_type IN (i.error_type, '')
+        ORDER BY
+            p.error_type = '' ASC
+        LIMIT 1
+    ) rp ON TRUE
 )
 UPDATE
     v1_task
 SET
     retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    app_retry_count = app_retry_count + 1,
+    -- a null delay falls back to min(retry_backoff_factor ^ app_retry_count, retry_max_backoff) in the update trigger
+    retry_delay_seconds = CASE tasks_to_steps.backoff_strategy
+        WHEN 'FULL_JITTER' THEN
+            random() * LEAST(
+                COALESCE(v1_task.retry_max_backoff, 86400),
+                POWER(COALESCE(v1_task.retry_backoff_factor, 2), v1_task.app_retry_count + 1)
+            )
+        WHEN 'DECORRELATED_JITTER' THEN
+            LEAST(
+                COALESCE(v1_task.retry_max_backoff, 86400),
+                tasks_to_steps.backoff_base_seconds + random() * GREATEST(
+                    3 * COALESCE(v1_task.retry_delay_seconds, tasks_to_steps.backoff_base_seconds) - tasks_to_steps.backoff_base_seconds,
+                    0
+                )
+            )
+        WHEN 'FIXED' THEN tasks_to_steps.backoff_base_seconds
+        ELSE NULL
+    END
 FROM
     tasks_to_steps
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
+    (v1_task.id, v1_task.inserted_at) = (tasks_to_steps.id, tasks_to_steps.inserted_at)
+    AND tasks_to_steps.is_non_retryable = FALSE
     AND tasks_to_steps."retries" > v1_task.app_retry_count
 RETURNING
     v1_task.id,
@@ -488,7 +527,8 @@ RETURNING
     v1_task.retry_count,
     v1_task.app_retry_count,
     v1_task.retry_backoff_factor,
-    v1_task.retry_max_backoff
+    v1_task.retry_max_backoff,
+    v1_task.retry_delay_seconds
 `
 
 type FailTaskAppFailureParams struct {
@@ -496,6 +536,7 @@ type FailTaskAppFailureParams struct {
 	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
 	Taskretrycounts []int32              `json:"taskretrycounts"`
 	Isnonretryables []bool               `json:"isnonretryables"`
+	Errortypes      []string             `json:"errortypes"`
 	Tenantid        uuid.UUID            `json:"tenantid"`
 }
 
@@ -506,6 +547,7 @@ type FailTaskAppFailureRow struct {
 	AppRetryCount      int32              `json:"app_retry_count"`
 	RetryBackoffFactor pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff    pgtype.Int4        `json:"retry_max_backoff"`
+	RetryDelaySeconds  pgtype.Float8      `json:"retry_delay_seconds"`
 }
 
 // Fails a task due to an application-level error
@@ -515,6 +557,7 @@ func (q *Queries) FailTaskAppFailure(ctx context.Context, db DBTX, arg FailTaskA
 		arg.Taskinsertedats,
 		arg.Taskretrycounts,
 		arg.Isnonretryables,
+		arg.Errortypes,
 		arg.Tenantid,
 	)
 	if err != nil {
@@ -531,6 +574,7 @@ func (q *Queries) FailTaskAppFailure(ctx context.Context, db DBTX, arg FailTaskA
 			&i.AppRetryCount,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.RetryDelaySeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -722,7 +766,7 @@ func (q *Queries) FindOldestRunningTask(ctx context.Context, db DBTX) (*FindOlde
 }
 
 const findOldestTask = `-- name: FindOldestTask :one
-SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
+SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, retry_delay_seconds, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
 FROM v1_task
 ORDER BY id, inserted_at
 LIMIT 1
@@ -769,6 +813,7 @@ func (q *Queries) FindOldestTask(ctx context.Context, db DBTX) (*V1Task, error)
 		&i.ConcurrencyKeys,
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
+		&i.RetryDelaySeconds,
 		&i.IsDurable,
 		&i.DesiredWorkerLabel,
 		&i.TriggeringEventExternalID,
@@ -1351,6 +1396,8 @@ WITH task_partitions AS (
     SELECT 'v1_durable_event_log_entry' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_event_log_entry', $1::date) AS p
 ), durable_event_log_branch_point_partitions AS (
     SELECT 'v1_durable_event_log_branch_point' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_event_log_branch_point', $1::date) AS p
+), durable_signal_partitions AS (
+    SELECT 'v1_durable_signal' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_durable_signal', $1::date) AS p
 )
 
 SELECT
@@ -1427,6 +1474,13 @@ SELECT
     parent_table, partition_name
 FROM
     durable_event_log_branch_point_partitions
+
+UNION ALL
+
+SELECT
+    parent_table, partition_name
+FROM
+    durable_signal_partitions
 `
 
 type ListPartitionsBeforeDateRow struct {
@@ -1756,7 +1810,7 @@ func (q *Queries) ListTaskRunningStatuses(ctx context.Context, db DBTX, arg List
 }
 
 const listTasks = `-- name: ListTasks :many
-SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
+SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, retry_delay_seconds, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
 FROM
     v1_task
 WHERE
@@ -1816,6 +1870,7 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.RetryDelaySeconds,
 			&i.IsDurable,
 			&i.DesiredWorkerLabel,
 			&i.TriggeringEventExternalID,
@@ -2519,7 +2574,7 @@ WITH input AS (
         UNNEST($3::bigint[]) AS task_id,
         UNNEST($4::timestamptz[]) AS task_inserted_at
 ), relevant_tasks AS (
-    SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key, task_id, task_inserted_at
+    SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, retry_delay_seconds, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key, task_id, task_inserted_at
     FROM
         v1_task t
     JOIN
@@ -0,0 +1,58 @@
+-- name: CreateTenantRole :one
+INSERT INTO "TenantRole" (
+    "id",
+    "tenantId",
+    "name",
+    "description",
+    "inherits",
+    "permissions"
+) VALUES (
+    gen_random_uuid(),
+    @tenantId::uuid,
+    @name::text,
+    sqlc.narg('description')::text,
+    @inherits::text[],
+    @permissions::text[]
+) RETURNING *;
+
+-- name: GetTenantRoleById :one
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantRoles :many
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "name" ASC;
+
+-- name: UpdateTenantRole :one
+UPDATE "TenantRole"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "description" = COALESCE(sqlc.narg('description')::text, "description"),
+    "inherits" = COALESCE(sqlc.narg('inherits')::text[], "inherits"),
+    "permissions" = COALESCE(sqlc.narg('permissions')::text[], "permissions")
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: CountTenantRoleMembers :one
+SELECT
+    COUNT(*)
+FROM
+    "TenantMember"
+WHERE
+    "customRoleId" = @customRoleId::uuid;
+
+-- name: DeleteTenantRole :exec
+DELETE FROM "TenantRole"
+WHERE
+    "id" = @id::uuid;
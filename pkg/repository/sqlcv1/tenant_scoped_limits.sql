@@ -0,0 +1,117 @@
+-- name: UpsertScopedResourceLimit :one
+INSERT INTO v1_scoped_resource_limit (
+    tenant_id,
+    resource,
+    scope,
+    workflow_id,
+    metadata_key,
+    metadata_value,
+    limit_value,
+    soft_limit_value,
+    limit_window
+)
+VALUES (
+    @tenantId::uuid,
+    @resource::"LimitResource",
+    @scope::v1_resource_limit_scope,
+    sqlc.narg('workflowId')::uuid,
+    sqlc.narg('metadataKey')::text,
+    sqlc.narg('metadataValue')::text,
+    @limitValue::int,
+    sqlc.narg('softLimitValue')::int,
+    @limitWindow::interval
+)
+ON CONFLICT (
+    tenant_id,
+    resource,
+    scope,
+    COALESCE(workflow_id, '00000000-0000-0000-0000-000000000000'::uuid),
+    COALESCE(metadata_key, ''),
+    COALESCE(metadata_value, '')
+) DO UPDATE
+SET
+    limit_value = EXCLUDED.limit_value,
+    soft_limit_value = EXCLUDED.soft_limit_value,
+    limit_window = EXCLUDED.limit_window,
+    -- the thresholds may have changed, so they alert again once they're crossed
+    soft_limit_alerted_at = NULL,
+    hard_limit_alerted_at = NULL,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetScopedResourceLimitById :one
+SELECT *
+FROM v1_scoped_resource_limit
+WHERE id = @id::uuid;
+
+-- name: ListScopedResourceLimits :many
+SELECT *
+FROM v1_scoped_resource_limit
+WHERE tenant_id = @tenantId::uuid
+ORDER BY created_at;
+
+-- name: DeleteScopedResourceLimit :exec
+DELETE FROM v1_scoped_resource_limit
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid;
+
+-- name: ListScopedResourceLimitUsage :many
+-- Returns the usage of the given scoped limits, which is 0 for limits whose window has passed since they were last
+-- refilled
+SELECT
+    id,
+    limit_value,
+    (CASE
+        WHEN NOW() - last_refill >= limit_window THEN 0
+        ELSE value
+    END)::int AS value
+FROM v1_scoped_resource_limit
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = ANY(@ids::uuid[]);
+
+-- name: MeterScopedResourceLimits :exec
+WITH input AS (
+    SELECT
+        unnest(@ids::uuid[]) AS id,
+        unnest(@amounts::int[]) AS amount
+), locked_limits AS (
+    SELECT l.id
+    FROM v1_scoped_resource_limit l
+    JOIN input i ON i.id = l.id
+    WHERE l.tenant_id = @tenantId::uuid
+    ORDER BY l.id
+    FOR UPDATE OF l
+)
+UPDATE v1_scoped_resource_limit l
+SET
+    value = CASE
+        WHEN NOW() - l.last_refill >= l.limit_window THEN i.amount -- the window has passed, so usage starts over
+        ELSE l.value + i.amount
+    END,
+    last_refill = CASE
+        WHEN NOW() - l.last_refill >= l.limit_window THEN NOW()
+        ELSE l.last_refill
+    END,
+    soft_limit_alerted_at = CASE
+        WHEN NOW() - l.last_refill >= l.limit_window THEN NULL
+        ELSE l.soft_limit_alerted_at
+    END,
+    hard_limit_alerted_at = CASE
+        WHEN NOW() - l.last_refill >= l.limit_window THEN NULL
+        ELSE l.hard_limit_alerted_at
+    END
+FROM input i
+WHERE
+    l.id = i.id
+    AND l.id IN (SELECT id FROM locked_limits);
+
+-- name: ResolveAllScopedResourceLimitsIfWindowPassed :exec
+UPDATE v1_scoped_resource_limit
+SET
+    value = 0,
+    last_refill = NOW(),
+    soft_limit_alerted_at = NULL,
+    hard_limit_alerted_at = NULL
+WHERE NOW() - last_refill >= limit_window;
@@ -556,6 +556,102 @@ WHERE
     "tenantId" = @tenantId::uuid
     AND "id" = @id::uuid;
 
+-- name: CreateTenantAlertSink :one
+INSERT INTO "TenantAlertSink" (
+    "id",
+    "tenantId",
+    "kind",
+    "name",
+    "config"
+) VALUES (
+    gen_random_uuid(),
+    @tenantId::uuid,
+    @kind::"TenantAlertSinkKind",
+    @name::text,
+    @config::bytea
+) RETURNING *;
+
+-- name: GetTenantAlertSinkById :one
+SELECT
+    *
+FROM
+    "TenantAlertSink"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantAlertSinks :many
+SELECT
+    *
+FROM
+    "TenantAlertSink"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: DeleteTenantAlertSink :exec
+DELETE FROM
+    "TenantAlertSink"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = @id::uuid;
+
+-- name: CreateTenantAlertRule :one
+INSERT INTO "TenantAlertRule" (
+    "id",
+    "tenantId",
+    "workflowId",
+    "name",
+    "kind",
+    "threshold",
+    "window"
+) VALUES (
+    gen_random_uuid(),
+    @tenantId::uuid,
+    @workflowId::uuid,
+    @name::text,
+    @kind::"TenantAlertRuleKind",
+    @threshold::float8,
+    @window::text
+) RETURNING *;
+
+-- name: GetTenantAlertRuleById :one
+SELECT
+    *
+FROM
+    "TenantAlertRule"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantAlertRules :many
+SELECT
+    *
+FROM
+    "TenantAlertRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: DeleteTenantAlertRule :exec
+DELETE FROM
+    "TenantAlertRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = @id::uuid;
+
+-- name: UpdateTenantAlertRuleState :exec
+UPDATE "TenantAlertRule"
+SET
+    "isFiring" = @isFiring::boolean,
+    "lastEvaluatedAt" = CURRENT_TIMESTAMP,
+    "lastFiredAt" = CASE
+        WHEN @isFiring::boolean AND NOT "isFiring" THEN CURRENT_TIMESTAMP
+        ELSE "lastFiredAt"
+    END
+WHERE
+    "id" = @id::uuid;
+
 -- name: CreateTenantMember :one
 INSERT INTO "TenantMember" (
     "id",
@@ -609,13 +705,18 @@ SELECT
     t."alertMemberEmails" as "alertMemberEmails",
     t."analyticsOptOut" as "analyticsOptOut",
     t."version" as "tenantVersion",
-    t."environment" as "tenantEnvironment"
+    t."environment" as "tenantEnvironment",
+    tr."name" as "customRoleName",
+    tr."inherits" as "customRoleInherits",
+    tr."permissions" as "customRolePermissions"
 FROM
     "TenantMember" tm
 JOIN
     "User" u ON tm."userId" = u."id"
 JOIN
     "Tenant" t ON tm."tenantId" = t."id"
+LEFT JOIN
+    "TenantRole" tr ON tm."customRoleId" = tr."id"
 WHERE
     tm."id" = ANY(@ids::uuid[]);
 
@@ -633,7 +734,9 @@ WHERE
 -- name: UpdateTenantMember :one
 UPDATE "TenantMember"
 SET
-    "role" = COALESCE(sqlc.narg('role')::"TenantMemberRole", "role")
+    "role" = COALESCE(sqlc.narg('role')::"TenantMemberRole", "role"),
+    -- the custom role is replaced whenever the role is updated
+    "customRoleId" = CASE WHEN sqlc.narg('role')::"TenantMemberRole" IS NOT NULL THEN sqlc.narg('customRoleId')::uuid ELSE "customRoleId" END
 WHERE "id" = @id::uuid
 RETURNING *;
 
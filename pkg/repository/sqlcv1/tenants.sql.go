@@ -180,6 +180,105 @@ func (q *Queries) CreateTenantAlertGroup(ctx context.Context, db DBTX, arg Creat
 	return &i, err
 }
 
+const createTenantAlertRule = `-- name: CreateTenantAlertRule :one
+INSERT INTO "TenantAlertRule" (
+    "id",
+    "tenantId",
+    "workflowId",
+    "name",
+    "kind",
+    "threshold",
+    "window"
+) VALUES (
+    gen_random_uuid(),
+    $1::uuid,
+    $2::uuid,
+    $3::text,
+    $4::"TenantAlertRuleKind",
+    $5::float8,
+    $6::text
+) RETURNING id, "createdAt", "updatedAt", "tenantId", "workflowId", name, kind, threshold, "window", "isFiring", "lastEvaluatedAt", "lastFiredAt"
+`
+
+type CreateTenantAlertRuleParams struct {
+	Tenantid   uuid.UUID           `json:"tenantid"`
+	Workflowid uuid.UUID           `json:"workflowid"`
+	Name       string              `json:"name"`
+	Kind       TenantAlertRuleKind `json:"kind"`
+	Threshold  float64             `json:"threshold"`
+	Window     string              `json:"window"`
+}
+
+func (q *Queries) CreateTenantAlertRule(ctx context.Context, db DBTX, arg CreateTenantAlertRuleParams) (*TenantAlertRule, error) {
+	row := db.QueryRow(ctx, createTenantAlertRule,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Name,
+		arg.Kind,
+		arg.Threshold,
+		arg.Window,
+	)
+	var i TenantAlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantId,
+		&i.WorkflowId,
+		&i.Name,
+		&i.Kind,
+		&i.Threshold,
+		&i.Window,
+		&i.IsFiring,
+		&i.LastEvaluatedAt,
+		&i.LastFiredAt,
+	)
+	return &i, err
+}
+
+const createTenantAlertSink = `-- name: CreateTenantAlertSink :one
+INSERT INTO "TenantAlertSink" (
+    "id",
+    "tenantId",
+    "kind",
+    "name",
+    "config"
+) VALUES (
+    gen_random_uuid(),
+    $1::uuid,
+    $2::"TenantAlertSinkKind",
+    $3::text,
+    $4::bytea
+) RETURNING id, "createdAt", "updatedAt", "tenantId", kind, name, config
+`
+
+type CreateTenantAlertSinkParams struct {
+	Tenantid uuid.UUID           `json:"tenantid"`
+	Kind     TenantAlertSinkKind `json:"kind"`
+	Name     string              `json:"name"`
+	Config   []byte              `json:"config"`
+}
+
+func (q *Queries) CreateTenantAlertSink(ctx context.Context, db DBTX, arg CreateTenantAlertSinkParams) (*TenantAlertSink, error) {
+	row := db.QueryRow(ctx, createTenantAlertSink,
+		arg.Tenantid,
+		arg.Kind,
+		arg.Name,
+		arg.Config,
+	)
+	var i TenantAlertSink
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantId,
+		&i.Kind,
+		&i.Name,
+		&i.Config,
+	)
+	return &i, err
+}
+
 const createTenantAlertingSettings = `-- name: CreateTenantAlertingSettings :one
 INSERT INTO "TenantAlertingSettings" ("id", "tenantId")
 VALUES (gen_random_uuid(), $1::uuid)
@@ -218,7 +317,7 @@ INSERT INTO "TenantMember" (
     $3::"TenantMemberRole"
 ) ON CONFLICT ("tenantId", "userId") DO UPDATE SET
     "role" = $3::"TenantMemberRole"
-RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role
+RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 `
 
 type CreateTenantMemberParams struct {
@@ -237,6 +336,7 @@ func (q *Queries) CreateTenantMember(ctx context.Context, db DBTX, arg CreateTen
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
@@ -350,6 +450,42 @@ func (q *Queries) DeleteTenantAlertGroup(ctx context.Context, db DBTX, arg Delet
 	return err
 }
 
+const deleteTenantAlertRule = `-- name: DeleteTenantAlertRule :exec
+DELETE FROM
+    "TenantAlertRule"
+WHERE
+    "tenantId" = $1::uuid
+    AND "id" = $2::uuid
+`
+
+type DeleteTenantAlertRuleParams struct {
+	Tenantid uuid.UUID `json:"tenantid"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (q *Queries) DeleteTenantAlertRule(ctx context.Context, db DBTX, arg DeleteTenantAlertRuleParams) error {
+	_, err := db.Exec(ctx, deleteTenantAlertRule, arg.Tenantid, arg.ID)
+	return err
+}
+
+const deleteTenantAlertSink = `-- name: DeleteTenantAlertSink :exec
+DELETE FROM
+    "TenantAlertSink"
+WHERE
+    "tenantId" = $1::uuid
+    AND "id" = $2::uuid
+`
+
+type DeleteTenantAlertSinkParams struct {
+	Tenantid uuid.UUID `json:"tenantid"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (q *Queries) DeleteTenantAlertSink(ctx context.Context, db DBTX, arg DeleteTenantAlertSinkParams) error {
+	_, err := db.Exec(ctx, deleteTenantAlertSink, arg.Tenantid, arg.ID)
+	return err
+}
+
 const deleteTenantMember = `-- name: DeleteTenantMember :exec
 DELETE FROM "TenantMember"
 WHERE "id" = $1::uuid
@@ -541,6 +677,59 @@ func (q *Queries) GetTenantAlertGroupById(ctx context.Context, db DBTX, id uuid.
 	return &i, err
 }
 
+const getTenantAlertRuleById = `-- name: GetTenantAlertRuleById :one
+SELECT
+    id, "createdAt", "updatedAt", "tenantId", "workflowId", name, kind, threshold, "window", "isFiring", "lastEvaluatedAt", "lastFiredAt"
+FROM
+    "TenantAlertRule"
+WHERE
+    "id" = $1::uuid
+`
+
+func (q *Queries) GetTenantAlertRuleById(ctx context.Context, db DBTX, id uuid.UUID) (*TenantAlertRule, error) {
+	row := db.QueryRow(ctx, getTenantAlertRuleById, id)
+	var i TenantAlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantId,
+		&i.WorkflowId,
+		&i.Name,
+		&i.Kind,
+		&i.Threshold,
+		&i.Window,
+		&i.IsFiring,
+		&i.LastEvaluatedAt,
+		&i.LastFiredAt,
+	)
+	return &i, err
+}
+
+const getTenantAlertSinkById = `-- name: GetTenantAlertSinkById :one
+SELECT
+    id, "createdAt", "updatedAt", "tenantId", kind, name, config
+FROM
+    "TenantAlertSink"
+WHERE
+    "id" = $1::uuid
+`
+
+func (q *Queries) GetTenantAlertSinkById(ctx context.Context, db DBTX, id uuid.UUID) (*TenantAlertSink, error) {
+	row := db.QueryRow(ctx, getTenantAlertSinkById, id)
+	var i TenantAlertSink
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantId,
+		&i.Kind,
+		&i.Name,
+		&i.Config,
+	)
+	return &i, err
+}
+
 const getTenantAlertingSettings = `-- name: GetTenantAlertingSettings :one
 SELECT
     id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts"
@@ -641,7 +830,7 @@ func (q *Queries) GetTenantBySlug(ctx context.Context, db DBTX, slug string) (*T
 
 const getTenantMemberByEmail = `-- name: GetTenantMemberByEmail :one
 SELECT
-    tm.id, tm."createdAt", tm."updatedAt", tm."tenantId", tm."userId", tm.role
+    tm.id, tm."createdAt", tm."updatedAt", tm."tenantId", tm."userId", tm.role, tm."customRoleId"
 FROM
     "TenantMember" tm
 JOIN
@@ -666,13 +855,14 @@ func (q *Queries) GetTenantMemberByEmail(ctx context.Context, db DBTX, arg GetTe
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
 
 const getTenantMemberByID = `-- name: GetTenantMemberByID :one
 SELECT
-    id, "createdAt", "updatedAt", "tenantId", "userId", role
+    id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 FROM
     "TenantMember"
 WHERE
@@ -689,13 +879,14 @@ func (q *Queries) GetTenantMemberByID(ctx context.Context, db DBTX, id uuid.UUID
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
 
 const getTenantMemberByUserID = `-- name: GetTenantMemberByUserID :one
 SELECT
-    id, "createdAt", "updatedAt", "tenantId", "userId", role
+    id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 FROM
     "TenantMember"
 WHERE
@@ -718,6 +909,7 @@ func (q *Queries) GetTenantMemberByUserID(ctx context.Context, db DBTX, arg GetT
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
@@ -931,9 +1123,92 @@ func (q *Queries) ListTenantAlertGroups(ctx context.Context, db DBTX, tenantid u
 	return items, nil
 }
 
+const listTenantAlertRules = `-- name: ListTenantAlertRules :many
+SELECT
+    id, "createdAt", "updatedAt", "tenantId", "workflowId", name, kind, threshold, "window", "isFiring", "lastEvaluatedAt", "lastFiredAt"
+FROM
+    "TenantAlertRule"
+WHERE
+    "tenantId" = $1::uuid
+ORDER BY
+    "createdAt" ASC
+`
+
+func (q *Queries) ListTenantAlertRules(ctx context.Context, db DBTX, tenantid uuid.UUID) ([]*TenantAlertRule, error) {
+	rows, err := db.Query(ctx, listTenantAlertRules, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantAlertRule
+	for rows.Next() {
+		var i TenantAlertRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantId,
+			&i.WorkflowId,
+			&i.Name,
+			&i.Kind,
+			&i.Threshold,
+			&i.Window,
+			&i.IsFiring,
+			&i.LastEvaluatedAt,
+			&i.LastFiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantAlertSinks = `-- name: ListTenantAlertSinks :many
+SELECT
+    id, "createdAt", "updatedAt", "tenantId", kind, name, config
+FROM
+    "TenantAlertSink"
+WHERE
+    "tenantId" = $1::uuid
+ORDER BY
+    "createdAt" ASC
+`
+
+func (q *Queries) ListTenantAlertSinks(ctx context.Context, db DBTX, tenantid uuid.UUID) ([]*TenantAlertSink, error) {
+	rows, err := db.Query(ctx, listTenantAlertSinks, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantAlertSink
+	for rows.Next() {
+		var i TenantAlertSink
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantId,
+			&i.Kind,
+			&i.Name,
+			&i.Config,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTenantMembers = `-- name: ListTenantMembers :many
 SELECT
-    id, "createdAt", "updatedAt", "tenantId", "userId", role
+    id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 FROM
     "TenantMember"
 WHERE
@@ -956,6 +1231,7 @@ func (q *Queries) ListTenantMembers(ctx context.Context, db DBTX, tenantid uuid.
 			&i.TenantId,
 			&i.UserId,
 			&i.Role,
+			&i.CustomRoleId,
 		); err != nil {
 			return nil, err
 		}
@@ -1143,7 +1419,7 @@ func (q *Queries) ListTenantsByTenantWorkerPartitionId(ctx context.Context, db D
 
 const populateTenantMembers = `-- name: PopulateTenantMembers :many
 SELECT
-    tm.id, tm."createdAt", tm."updatedAt", tm."tenantId", tm."userId", tm.role,
+    tm.id, tm."createdAt", tm."updatedAt", tm."tenantId", tm."userId", tm.role, tm."customRoleId",
     u."email",
     u."name",
     t."id" as "tenantId",
@@ -1154,35 +1430,44 @@ SELECT
     t."alertMemberEmails" as "alertMemberEmails",
     t."analyticsOptOut" as "analyticsOptOut",
     t."version" as "tenantVersion",
-    t."environment" as "tenantEnvironment"
+    t."environment" as "tenantEnvironment",
+    tr."name" as "customRoleName",
+    tr."inherits" as "customRoleInherits",
+    tr."permissions" as "customRolePermissions"
 FROM
     "TenantMember" tm
 JOIN
     "User" u ON tm."userId" = u."id"
 JOIN
     "Tenant" t ON tm."tenantId" = t."id"
+LEFT JOIN
+    "TenantRole" tr ON tm."customRoleId" = tr."id"
 WHERE
     tm."id" = ANY($1::uuid[])
 `
 
 type PopulateTenantMembersRow struct {
-	ID                uuid.UUID                `json:"id"`
-	CreatedAt         pgtype.Timestamp         `json:"createdAt"`
-	UpdatedAt         pgtype.Timestamp         `json:"updatedAt"`
-	TenantId          uuid.UUID                `json:"tenantId"`
-	UserId            uuid.UUID                `json:"userId"`
-	Role              TenantMemberRole         `json:"role"`
-	Email             string                   `json:"email"`
-	Name              pgtype.Text              `json:"name"`
-	TenantId_2        uuid.UUID                `json:"tenantId_2"`
-	TenantCreatedAt   pgtype.Timestamp         `json:"tenantCreatedAt"`
-	TenantUpdatedAt   pgtype.Timestamp         `json:"tenantUpdatedAt"`
-	TenantName        string                   `json:"tenantName"`
-	TenantSlug        string                   `json:"tenantSlug"`
-	AlertMemberEmails bool                     `json:"alertMemberEmails"`
-	AnalyticsOptOut   bool                     `json:"analyticsOptOut"`
-	TenantVersion     TenantMajorEngineVersion `json:"tenantVersion"`
-	TenantEnvironment NullTenantEnvironment    `json:"tenantEnvironment"`
+	ID                    uuid.UUID                `json:"id"`
+	CreatedAt             pgtype.Timestamp         `json:"createdAt"`
+	UpdatedAt             pgtype.Timestamp         `json:"updatedAt"`
+	TenantId              uuid.UUID                `json:"tenantId"`
+	UserId                uuid.UUID                `json:"userId"`
+	Role                  TenantMemberRole         `json:"role"`
+	CustomRoleId          *uuid.UUID               `json:"customRoleId"`
+	Email                 string                   `json:"email"`
+	Name                  pgtype.Text              `json:"name"`
+	TenantId_2            uuid.UUID                `json:"tenantId_2"`
+	TenantCreatedAt       pgtype.Timestamp         `json:"tenantCreatedAt"`
+	TenantUpdatedAt       pgtype.Timestamp         `json:"tenantUpdatedAt"`
+	TenantName            string                   `json:"tenantName"`
+	TenantSlug            string                   `json:"tenantSlug"`
+	AlertMemberEmails     bool                     `json:"alertMemberEmails"`
+	AnalyticsOptOut       bool                     `json:"analyticsOptOut"`
+	TenantVersion         TenantMajorEngineVersion `json:"tenantVersion"`
+	TenantEnvironment     NullTenantEnvironment    `json:"tenantEnvironment"`
+	CustomRoleName        pgtype.Text              `json:"customRoleName"`
+	CustomRoleInherits    []string                 `json:"customRoleInherits"`
+	CustomRolePermissions []string                 `json:"customRolePermissions"`
 }
 
 func (q *Queries) PopulateTenantMembers(ctx context.Context, db DBTX, ids []uuid.UUID) ([]*PopulateTenantMembersRow, error) {
@@ -1201,6 +1486,7 @@ func (q *Queries) PopulateTenantMembers(ctx context.Context, db DBTX, ids []uuid
 			&i.TenantId,
 			&i.UserId,
 			&i.Role,
+			&i.CustomRoleId,
 			&i.Email,
 			&i.Name,
 			&i.TenantId_2,
@@ -1212,6 +1498,9 @@ func (q *Queries) PopulateTenantMembers(ctx context.Context, db DBTX, ids []uuid
 			&i.AnalyticsOptOut,
 			&i.TenantVersion,
 			&i.TenantEnvironment,
+			&i.CustomRoleName,
+			&i.CustomRoleInherits,
+			&i.CustomRolePermissions,
 		); err != nil {
 			return nil, err
 		}
@@ -1569,6 +1858,29 @@ func (q *Queries) UpdateTenantAlertGroup(ctx context.Context, db DBTX, arg Updat
 	return &i, err
 }
 
+const updateTenantAlertRuleState = `-- name: UpdateTenantAlertRuleState :exec
+UPDATE "TenantAlertRule"
+SET
+    "isFiring" = $1::boolean,
+    "lastEvaluatedAt" = CURRENT_TIMESTAMP,
+    "lastFiredAt" = CASE
+        WHEN $1::boolean AND NOT "isFiring" THEN CURRENT_TIMESTAMP
+        ELSE "lastFiredAt"
+    END
+WHERE
+    "id" = $2::uuid
+`
+
+type UpdateTenantAlertRuleStateParams struct {
+	Isfiring bool      `json:"isfiring"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateTenantAlertRuleState(ctx context.Context, db DBTX, arg UpdateTenantAlertRuleStateParams) error {
+	_, err := db.Exec(ctx, updateTenantAlertRuleState, arg.Isfiring, arg.ID)
+	return err
+}
+
 const updateTenantAlertingSettings = `-- name: UpdateTenantAlertingSettings :one
 UPDATE
     "TenantAlertingSettings" as tenantAlertingSettings
@@ -1606,18 +1918,21 @@ func (q *Queries) UpdateTenantAlertingSettings(ctx context.Context, db DBTX, arg
 const updateTenantMember = `-- name: UpdateTenantMember :one
 UPDATE "TenantMember"
 SET
-    "role" = COALESCE($1::"TenantMemberRole", "role")
-WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role
+    "role" = COALESCE($1::"TenantMemberRole", "role"),
+    -- the custom role is replaced whenever the role is updated
+    "customRoleId" = CASE WHEN $1::"TenantMemberRole" IS NOT NULL THEN $2::uuid ELSE "customRoleId" END
+WHERE "id" = $3::uuid
+RETURNING id, "createdAt", "updatedAt", "tenantId", "userId", role, "customRoleId"
 `
 
 type UpdateTenantMemberParams struct {
-	Role NullTenantMemberRole `json:"role"`
-	ID   uuid.UUID            `json:"id"`
+	Role         NullTenantMemberRole `json:"role"`
+	CustomRoleId *uuid.UUID           `json:"customRoleId"`
+	ID           uuid.UUID            `json:"id"`
 }
 
 func (q *Queries) UpdateTenantMember(ctx context.Context, db DBTX, arg UpdateTenantMemberParams) (*TenantMember, error) {
-	row := db.QueryRow(ctx, updateTenantMember, arg.Role, arg.ID)
+	row := db.QueryRow(ctx, updateTenantMember, arg.Role, arg.CustomRoleId, arg.ID)
 	var i TenantMember
 	err := row.Scan(
 		&i.ID,
@@ -1626,6 +1941,7 @@ func (q *Queries) UpdateTenantMember(ctx context.Context, db DBTX, arg UpdateTen
 		&i.TenantId,
 		&i.UserId,
 		&i.Role,
+		&i.CustomRoleId,
 	)
 	return &i, err
 }
@@ -159,6 +159,14 @@ WHERE
 
 RETURNING cronSchedules.*, eligible_cron_schedules."workflowVersionId", eligible_cron_schedules."tenantId";
 
+-- name: UpdateCronLastFiredAt :exec
+UPDATE
+    "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = GREATEST(COALESCE("lastFiredAt", @firedAt::timestamp), @firedAt::timestamp)
+WHERE
+    "id" = @id::uuid;
+
 -- name: PollScheduledWorkflows :many
 -- Finds workflows that are either past their execution time or will be in the next 5 seconds and assigns them
 -- to a ticker, or finds workflows that were assigned to a ticker that is no longer active
@@ -390,3 +398,49 @@ FROM
 WHERE
     na."existingAlert" = false
 RETURNING *;
+
+-- name: PollScopedResourceLimitAlerts :many
+-- Marks the scoped resource limits whose usage crossed their soft or hard limit as alerted, and returns them. Each
+-- threshold alerts once per window, and a limit which crossed both thresholds only alerts that it's exhausted.
+WITH alerting_limits AS (
+    SELECT
+        l.id,
+        CASE
+            WHEN l.value >= l.limit_value THEN 'Exhausted'
+            ELSE 'Alarm'
+        END AS alert_type,
+        w."name" AS workflow_name
+    FROM
+        v1_scoped_resource_limit l
+    JOIN
+        "TenantAlertingSettings" AS ta ON ta."tenantId" = l.tenant_id
+    JOIN
+        "Tenant" AS tenant ON tenant."id" = l.tenant_id
+    LEFT JOIN
+        "Workflow" AS w ON w."id" = l.workflow_id
+    WHERE
+        tenant."deletedAt" IS NULL
+        AND ta."enableTenantResourceLimitAlerts" = true
+        AND (
+            (l.value >= l.limit_value AND l.hard_limit_alerted_at IS NULL)
+            OR (
+                l.soft_limit_value IS NOT NULL
+                AND l.value >= l.soft_limit_value
+                AND l.value < l.limit_value
+                AND l.soft_limit_alerted_at IS NULL
+            )
+        )
+    FOR UPDATE OF l SKIP LOCKED
+)
+UPDATE v1_scoped_resource_limit l
+SET
+    soft_limit_alerted_at = CASE WHEN al.alert_type = 'Alarm' THEN NOW() ELSE l.soft_limit_alerted_at END,
+    hard_limit_alerted_at = CASE WHEN al.alert_type = 'Exhausted' THEN NOW() ELSE l.hard_limit_alerted_at END
+FROM
+    alerting_limits al
+WHERE
+    l.id = al.id
+RETURNING
+    l.*,
+    al.alert_type::"TenantResourceLimitAlertType" AS alert_type,
+    COALESCE(al.workflow_name, '')::text AS workflow_name;
@@ -287,25 +287,30 @@ WHERE
     AND cronSchedules."cron" = eligible_cron_schedules."cron"
     AND cronSchedules."name" = eligible_cron_schedules."name"
 
-RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, cronschedules.priority, eligible_cron_schedules."workflowVersionId", eligible_cron_schedules."tenantId"
+RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, cronschedules.priority, cronschedules.timezone, cronschedules."misfirePolicy", cronschedules."misfireLimit", cronschedules."lastFiredAt", cronschedules."overlapPolicy", eligible_cron_schedules."workflowVersionId", eligible_cron_schedules."tenantId"
 `
 
 type PollCronSchedulesRow struct {
-	ParentId           uuid.UUID                     `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           *uuid.UUID                    `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 uuid.UUID                     `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
-	Priority           int32                         `json:"priority"`
-	WorkflowVersionId  uuid.UUID                     `json:"workflowVersionId"`
-	TenantId           uuid.UUID                     `json:"tenantId"`
+	ParentId           uuid.UUID                           `json:"parentId"`
+	Cron               string                              `json:"cron"`
+	TickerId           *uuid.UUID                          `json:"tickerId"`
+	Input              []byte                              `json:"input"`
+	Enabled            bool                                `json:"enabled"`
+	AdditionalMetadata []byte                              `json:"additionalMetadata"`
+	CreatedAt          pgtype.Timestamp                    `json:"createdAt"`
+	DeletedAt          pgtype.Timestamp                    `json:"deletedAt"`
+	UpdatedAt          pgtype.Timestamp                    `json:"updatedAt"`
+	Name               pgtype.Text                         `json:"name"`
+	ID                 uuid.UUID                           `json:"id"`
+	Method             WorkflowTriggerCronRefMethods       `json:"method"`
+	Priority           int32                               `json:"priority"`
+	Timezone           pgtype.Text                         `json:"timezone"`
+	MisfirePolicy      WorkflowTriggerCronRefMisfirePolicy `json:"misfirePolicy"`
+	MisfireLimit       pgtype.Int4                         `json:"misfireLimit"`
+	LastFiredAt        pgtype.Timestamp                    `json:"lastFiredAt"`
+	OverlapPolicy      WorkflowTriggerCronRefOverlapPolicy `json:"overlapPolicy"`
+	WorkflowVersionId  uuid.UUID                           `json:"workflowVersionId"`
+	TenantId           uuid.UUID                           `json:"tenantId"`
 }
 
 func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid uuid.UUID) ([]*PollCronSchedulesRow, error) {
@@ -331,6 +336,11 @@ func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid uuid.
 			&i.ID,
 			&i.Method,
 			&i.Priority,
+			&i.Timezone,
+			&i.MisfirePolicy,
+			&i.MisfireLimit,
+			&i.LastFiredAt,
+			&i.OverlapPolicy,
 			&i.WorkflowVersionId,
 			&i.TenantId,
 		); err != nil {
@@ -541,6 +551,113 @@ func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, tickerid
 	return items, nil
 }
 
+const pollScopedResourceLimitAlerts = `-- name: PollScopedResourceLimitAlerts :many
+WITH alerting_limits AS (
+    SELECT
+        l.id,
+        CASE
+            WHEN l.value >= l.limit_value THEN 'Exhausted'
+            ELSE 'Alarm'
+        END AS alert_type,
+        w."name" AS workflow_name
+    FROM
+        v1_scoped_resource_limit l
+    JOIN
+        "TenantAlertingSettings" AS ta ON ta."tenantId" = l.tenant_id
+    JOIN
+        "Tenant" AS tenant ON tenant."id" = l.tenant_id
+    LEFT JOIN
+        "Workflow" AS w ON w."id" = l.workflow_id
+    WHERE
+        tenant."deletedAt" IS NULL
+        AND ta."enableTenantResourceLimitAlerts" = true
+        AND (
+            (l.value >= l.limit_value AND l.hard_limit_alerted_at IS NULL)
+            OR (
+                l.soft_limit_value IS NOT NULL
+                AND l.value >= l.soft_limit_value
+                AND l.value < l.limit_value
+                AND l.soft_limit_alerted_at IS NULL
+            )
+        )
+    FOR UPDATE OF l SKIP LOCKED
+)
+UPDATE v1_scoped_resource_limit l
+SET
+    soft_limit_alerted_at = CASE WHEN al.alert_type = 'Alarm' THEN NOW() ELSE l.soft_limit_alerted_at END,
+    hard_limit_alerted_at = CASE WHEN al.alert_type = 'Exhausted' THEN NOW() ELSE l.hard_limit_alerted_at END
+FROM
+    alerting_limits al
+WHERE
+    l.id = al.id
+RETURNING
+    l.id, l.tenant_id, l.resource, l.scope, l.workflow_id, l.metadata_key, l.metadata_value, l.limit_value, l.soft_limit_value, l.limit_window, l.value, l.last_refill, l.soft_limit_alerted_at, l.hard_limit_alerted_at, l.created_at, l.updated_at,
+    al.alert_type::"TenantResourceLimitAlertType" AS alert_type,
+    COALESCE(al.workflow_name, '')::text AS workflow_name;
+`
+
+type PollScopedResourceLimitAlertsRow struct {
+	ID                 uuid.UUID                    `json:"id"`
+	TenantID           uuid.UUID                    `json:"tenant_id"`
+	Resource           LimitResource                `json:"resource"`
+	Scope              V1ResourceLimitScope         `json:"scope"`
+	WorkflowID         *uuid.UUID                   `json:"workflow_id"`
+	MetadataKey        pgtype.Text                  `json:"metadata_key"`
+	MetadataValue      pgtype.Text                  `json:"metadata_value"`
+	LimitValue         int32                        `json:"limit_value"`
+	SoftLimitValue     pgtype.Int4                  `json:"soft_limit_value"`
+	LimitWindow        pgtype.Interval              `json:"limit_window"`
+	Value              int32                        `json:"value"`
+	LastRefill         pgtype.Timestamptz           `json:"last_refill"`
+	SoftLimitAlertedAt pgtype.Timestamptz           `json:"soft_limit_alerted_at"`
+	HardLimitAlertedAt pgtype.Timestamptz           `json:"hard_limit_alerted_at"`
+	CreatedAt          pgtype.Timestamptz           `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz           `json:"updated_at"`
+	AlertType          TenantResourceLimitAlertType `json:"alert_type"`
+	WorkflowName       string                       `json:"workflow_name"`
+}
+
+// Marks the scoped resource limits whose usage crossed their soft or hard limit as alerted, and returns them. Each
+// threshold alerts once per window, and a limit which crossed both thresholds only alerts that it's exhausted.
+func (q *Queries) PollScopedResourceLimitAlerts(ctx context.Context, db DBTX) ([]*PollScopedResourceLimitAlertsRow, error) {
+	rows, err := db.Query(ctx, pollScopedResourceLimitAlerts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollScopedResourceLimitAlertsRow
+	for rows.Next() {
+		var i PollScopedResourceLimitAlertsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Resource,
+			&i.Scope,
+			&i.WorkflowID,
+			&i.MetadataKey,
+			&i.MetadataValue,
+			&i.LimitValue,
+			&i.SoftLimitValue,
+			&i.LimitWindow,
+			&i.Value,
+			&i.LastRefill,
+			&i.SoftLimitAlertedAt,
+			&i.HardLimitAlertedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AlertType,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const pollTenantAlerts = `-- name: PollTenantAlerts :many
 WITH active_tenant_alerts AS (
     SELECT
@@ -788,6 +905,25 @@ func (q *Queries) SetTickersInactive(ctx context.Context, db DBTX, ids []uuid.UU
 	return items, nil
 }
 
+const updateCronLastFiredAt = `-- name: UpdateCronLastFiredAt :exec
+UPDATE
+    "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = GREATEST(COALESCE("lastFiredAt", $1::timestamp), $1::timestamp)
+WHERE
+    "id" = $2::uuid
+`
+
+type UpdateCronLastFiredAtParams struct {
+	Firedat pgtype.Timestamp `json:"firedat"`
+	ID      uuid.UUID        `json:"id"`
+}
+
+func (q *Queries) UpdateCronLastFiredAt(ctx context.Context, db DBTX, arg UpdateCronLastFiredAtParams) error {
+	_, err := db.Exec(ctx, updateCronLastFiredAt, arg.Firedat, arg.ID)
+	return err
+}
+
 const updateTicker = `-- name: UpdateTicker :one
 UPDATE
     "Ticker" as tickers
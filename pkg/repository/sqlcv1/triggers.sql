@@ -4,7 +4,11 @@ WITH latest_versions AS (
     SELECT DISTINCT ON("workflowId")
         "workflowId",
         workflowVersions."id" AS "workflowVersionId",
-        workflow."name" AS "workflowName"
+        workflow."name" AS "workflowName",
+        workflowVersions."inputJsonSchema",
+        workflow."inputValidationMode",
+        workflowVersions."canaryPercentage",
+        workflowVersions."order"
     FROM
         "WorkflowVersion" as workflowVersions
     JOIN
@@ -23,6 +27,11 @@ SELECT
     latest_versions."workflowVersionId",
     latest_versions."workflowId",
     latest_versions."workflowName",
+    latest_versions."inputJsonSchema",
+    latest_versions."inputValidationMode",
+    latest_versions."canaryPercentage",
+    stable."id" AS "stableWorkflowVersionId",
+    stable."inputJsonSchema" AS "stableInputJsonSchema",
     eventRef."eventKey" as "workflowTriggeringEventKeyPattern",
     k.event_key::TEXT as "incomingEventKey"
 FROM
@@ -32,19 +41,93 @@ JOIN
 JOIN
     "WorkflowTriggerEventRef" as eventRef ON eventRef."parentId" = triggers."id"
 JOIN event_keys k ON k.event_key LIKE REPLACE(eventRef."eventKey", '*', '%')
+-- if the latest version is a canary, the rest of the runs use the most recent version which isn't a canary
+LEFT JOIN LATERAL (
+    SELECT
+        stableVersions."id",
+        stableVersions."inputJsonSchema"
+    FROM
+        "WorkflowVersion" as stableVersions
+    WHERE
+        latest_versions."canaryPercentage" IS NOT NULL
+        AND stableVersions."workflowId" = latest_versions."workflowId"
+        AND stableVersions."deletedAt" IS NULL
+        AND stableVersions."canaryPercentage" IS NULL
+        AND stableVersions."order" < latest_versions."order"
+    ORDER BY stableVersions."order" DESC
+    LIMIT 1
+) stable ON TRUE
 ;
 
 -- name: ListWorkflowsByNames :many
-SELECT DISTINCT ON("workflowId")
-    "workflowId",
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        "workflowId",
+        workflowVersions."id" AS "workflowVersionId",
+        workflow."name" AS "workflowName",
+        workflowVersions."inputJsonSchema",
+        workflow."inputValidationMode",
+        workflowVersions."canaryPercentage",
+        workflowVersions."order"
+    FROM
+        "WorkflowVersion" as workflowVersions
+    JOIN
+        "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    WHERE
+        workflow."tenantId" = @tenantId::uuid
+        AND workflowVersions."deletedAt" IS NULL
+        AND workflow."name" = ANY(@workflowNames::text[])
+    ORDER BY "workflowId", "order" DESC
+)
+SELECT
+    latest_versions."workflowId",
+    latest_versions."workflowVersionId",
+    latest_versions."workflowName",
+    latest_versions."inputJsonSchema",
+    latest_versions."inputValidationMode",
+    latest_versions."canaryPercentage",
+    stable."id" AS "stableWorkflowVersionId",
+    stable."inputJsonSchema" AS "stableInputJsonSchema"
+FROM
+    latest_versions
+-- if the latest version is a canary, the rest of the runs use the most recent version which isn't a canary
+LEFT JOIN LATERAL (
+    SELECT
+        stableVersions."id",
+        stableVersions."inputJsonSchema"
+    FROM
+        "WorkflowVersion" as stableVersions
+    WHERE
+        latest_versions."canaryPercentage" IS NOT NULL
+        AND stableVersions."workflowId" = latest_versions."workflowId"
+        AND stableVersions."deletedAt" IS NULL
+        AND stableVersions."canaryPercentage" IS NULL
+        AND stableVersions."order" < latest_versions."order"
+    ORDER BY stableVersions."order" DESC
+    LIMIT 1
+) stable ON TRUE;
+
+-- name: ListWorkflowVersionsByVersions :many
+-- Get the most recent workflow version matching each (workflow name, version) pair, used for pinned triggers
+WITH input AS (
+    SELECT
+        UNNEST(@workflowNames::text[]) AS "workflowName",
+        UNNEST(@versions::text[]) AS "version"
+)
+SELECT DISTINCT ON (workflow."id", workflowVersions."version")
+    workflow."id" AS "workflowId",
     workflowVersions."id" AS "workflowVersionId",
-    workflow."name" AS "workflowName"
+    workflow."name" AS "workflowName",
+    workflowVersions."version"::text AS "version",
+    workflowVersions."inputJsonSchema",
+    workflow."inputValidationMode"
 FROM
-    "WorkflowVersion" as workflowVersions
+    input
+JOIN
+    "Workflow" as workflow ON workflow."name" = input."workflowName"
 JOIN
-    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    "WorkflowVersion" as workflowVersions ON workflowVersions."workflowId" = workflow."id" AND workflowVersions."version" = input."version"
 WHERE
     workflow."tenantId" = @tenantId::uuid
     AND workflowVersions."deletedAt" IS NULL
-    AND workflow."name" = ANY(@workflowNames::text[])
-ORDER BY "workflowId", "order" DESC;
+ORDER BY workflow."id", workflowVersions."version", workflowVersions."order" DESC;
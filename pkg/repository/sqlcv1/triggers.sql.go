@@ -9,22 +9,123 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const listWorkflowsByNames = `-- name: ListWorkflowsByNames :many
-SELECT DISTINCT ON("workflowId")
-    "workflowId",
+const listWorkflowVersionsByVersions = `-- name: ListWorkflowVersionsByVersions :many
+WITH input AS (
+    SELECT
+        UNNEST($1::text[]) AS "workflowName",
+        UNNEST($2::text[]) AS "version"
+)
+SELECT DISTINCT ON (workflow."id", workflowVersions."version")
+    workflow."id" AS "workflowId",
     workflowVersions."id" AS "workflowVersionId",
-    workflow."name" AS "workflowName"
+    workflow."name" AS "workflowName",
+    workflowVersions."version"::text AS "version",
+    workflowVersions."inputJsonSchema",
+    workflow."inputValidationMode"
 FROM
-    "WorkflowVersion" as workflowVersions
+    input
+JOIN
+    "Workflow" as workflow ON workflow."name" = input."workflowName"
 JOIN
-    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    "WorkflowVersion" as workflowVersions ON workflowVersions."workflowId" = workflow."id" AND workflowVersions."version" = input."version"
 WHERE
-    workflow."tenantId" = $1::uuid
+    workflow."tenantId" = $3::uuid
     AND workflowVersions."deletedAt" IS NULL
-    AND workflow."name" = ANY($2::text[])
-ORDER BY "workflowId", "order" DESC
+ORDER BY workflow."id", workflowVersions."version", workflowVersions."order" DESC
+`
+
+type ListWorkflowVersionsByVersionsParams struct {
+	Workflownames []string  `json:"workflownames"`
+	Versions      []string  `json:"versions"`
+	Tenantid      uuid.UUID `json:"tenantid"`
+}
+
+type ListWorkflowVersionsByVersionsRow struct {
+	WorkflowId          uuid.UUID                   `json:"workflowId"`
+	WorkflowVersionId   uuid.UUID                   `json:"workflowVersionId"`
+	WorkflowName        string                      `json:"workflowName"`
+	Version             string                      `json:"version"`
+	InputJsonSchema     []byte                      `json:"inputJsonSchema"`
+	InputValidationMode WorkflowInputValidationMode `json:"inputValidationMode"`
+}
+
+// Get the most recent workflow version matching each (workflow name, version) pair, used for pinned triggers
+func (q *Queries) ListWorkflowVersionsByVersions(ctx context.Context, db DBTX, arg ListWorkflowVersionsByVersionsParams) ([]*ListWorkflowVersionsByVersionsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionsByVersions, arg.Workflownames, arg.Versions, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowVersionsByVersionsRow
+	for rows.Next() {
+		var i ListWorkflowVersionsByVersionsRow
+		if err := rows.Scan(
+			&i.WorkflowId,
+			&i.WorkflowVersionId,
+			&i.WorkflowName,
+			&i.Version,
+			&i.InputJsonSchema,
+			&i.InputValidationMode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowsByNames = `-- name: ListWorkflowsByNames :many
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        "workflowId",
+        workflowVersions."id" AS "workflowVersionId",
+        workflow."name" AS "workflowName",
+        workflowVersions."inputJsonSchema",
+        workflow."inputValidationMode",
+        workflowVersions."canaryPercentage",
+        workflowVersions."order"
+    FROM
+        "WorkflowVersion" as workflowVersions
+    JOIN
+        "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    WHERE
+        workflow."tenantId" = $1::uuid
+        AND workflowVersions."deletedAt" IS NULL
+        AND workflow."name" = ANY($2::text[])
+    ORDER BY "workflowId", "order" DESC
+)
+SELECT
+    latest_versions."workflowId",
+    latest_versions."workflowVersionId",
+    latest_versions."workflowName",
+    latest_versions."inputJsonSchema",
+    latest_versions."inputValidationMode",
+    latest_versions."canaryPercentage",
+    stable."id" AS "stableWorkflowVersionId",
+    stable."inputJsonSchema" AS "stableInputJsonSchema"
+FROM
+    latest_versions
+LEFT JOIN LATERAL (
+    SELECT
+        stableVersions."id",
+        stableVersions."inputJsonSchema"
+    FROM
+        "WorkflowVersion" as stableVersions
+    WHERE
+        latest_versions."canaryPercentage" IS NOT NULL
+        AND stableVersions."workflowId" = latest_versions."workflowId"
+        AND stableVersions."deletedAt" IS NULL
+        AND stableVersions."canaryPercentage" IS NULL
+        AND stableVersions."order" < latest_versions."order"
+    ORDER BY stableVersions."order" DESC
+    LIMIT 1
+) stable ON TRUE
 `
 
 type ListWorkflowsByNamesParams struct {
@@ -33,11 +134,17 @@ type ListWorkflowsByNamesParams struct {
 }
 
 type ListWorkflowsByNamesRow struct {
-	WorkflowId        uuid.UUID `json:"workflowId"`
-	WorkflowVersionId uuid.UUID `json:"workflowVersionId"`
-	WorkflowName      string    `json:"workflowName"`
+	WorkflowId              uuid.UUID                   `json:"workflowId"`
+	WorkflowVersionId       uuid.UUID                   `json:"workflowVersionId"`
+	WorkflowName            string                      `json:"workflowName"`
+	InputJsonSchema         []byte                      `json:"inputJsonSchema"`
+	InputValidationMode     WorkflowInputValidationMode `json:"inputValidationMode"`
+	CanaryPercentage        pgtype.Int4                 `json:"canaryPercentage"`
+	StableWorkflowVersionId *uuid.UUID                  `json:"stableWorkflowVersionId"`
+	StableInputJsonSchema   []byte                      `json:"stableInputJsonSchema"`
 }
 
+// if the latest version is a canary, the rest of the runs use the most recent version which isn't a canary
 func (q *Queries) ListWorkflowsByNames(ctx context.Context, db DBTX, arg ListWorkflowsByNamesParams) ([]*ListWorkflowsByNamesRow, error) {
 	rows, err := db.Query(ctx, listWorkflowsByNames, arg.Tenantid, arg.Workflownames)
 	if err != nil {
@@ -47,7 +154,16 @@ func (q *Queries) ListWorkflowsByNames(ctx context.Context, db DBTX, arg ListWor
 	var items []*ListWorkflowsByNamesRow
 	for rows.Next() {
 		var i ListWorkflowsByNamesRow
-		if err := rows.Scan(&i.WorkflowId, &i.WorkflowVersionId, &i.WorkflowName); err != nil {
+		if err := rows.Scan(
+			&i.WorkflowId,
+			&i.WorkflowVersionId,
+			&i.WorkflowName,
+			&i.InputJsonSchema,
+			&i.InputValidationMode,
+			&i.CanaryPercentage,
+			&i.StableWorkflowVersionId,
+			&i.StableInputJsonSchema,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -63,7 +179,11 @@ WITH latest_versions AS (
     SELECT DISTINCT ON("workflowId")
         "workflowId",
         workflowVersions."id" AS "workflowVersionId",
-        workflow."name" AS "workflowName"
+        workflow."name" AS "workflowName",
+        workflowVersions."inputJsonSchema",
+        workflow."inputValidationMode",
+        workflowVersions."canaryPercentage",
+        workflowVersions."order"
     FROM
         "WorkflowVersion" as workflowVersions
     JOIN
@@ -81,6 +201,11 @@ SELECT
     latest_versions."workflowVersionId",
     latest_versions."workflowId",
     latest_versions."workflowName",
+    latest_versions."inputJsonSchema",
+    latest_versions."inputValidationMode",
+    latest_versions."canaryPercentage",
+    stable."id" AS "stableWorkflowVersionId",
+    stable."inputJsonSchema" AS "stableInputJsonSchema",
     eventRef."eventKey" as "workflowTriggeringEventKeyPattern",
     k.event_key::TEXT as "incomingEventKey"
 FROM
@@ -90,6 +215,21 @@ JOIN
 JOIN
     "WorkflowTriggerEventRef" as eventRef ON eventRef."parentId" = triggers."id"
 JOIN event_keys k ON k.event_key LIKE REPLACE(eventRef."eventKey", '*', '%')
+LEFT JOIN LATERAL (
+    SELECT
+        stableVersions."id",
+        stableVersions."inputJsonSchema"
+    FROM
+        "WorkflowVersion" as stableVersions
+    WHERE
+        latest_versions."canaryPercentage" IS NOT NULL
+        AND stableVersions."workflowId" = latest_versions."workflowId"
+        AND stableVersions."deletedAt" IS NULL
+        AND stableVersions."canaryPercentage" IS NULL
+        AND stableVersions."order" < latest_versions."order"
+    ORDER BY stableVersions."order" DESC
+    LIMIT 1
+) stable ON TRUE
 `
 
 type ListWorkflowsForEventsParams struct {
@@ -98,15 +238,21 @@ type ListWorkflowsForEventsParams struct {
 }
 
 type ListWorkflowsForEventsRow struct {
-	WorkflowVersionId                 uuid.UUID `json:"workflowVersionId"`
-	WorkflowId                        uuid.UUID `json:"workflowId"`
-	WorkflowName                      string    `json:"workflowName"`
-	WorkflowTriggeringEventKeyPattern string    `json:"workflowTriggeringEventKeyPattern"`
-	IncomingEventKey                  string    `json:"incomingEventKey"`
+	WorkflowVersionId                 uuid.UUID                   `json:"workflowVersionId"`
+	WorkflowId                        uuid.UUID                   `json:"workflowId"`
+	WorkflowName                      string                      `json:"workflowName"`
+	InputJsonSchema                   []byte                      `json:"inputJsonSchema"`
+	InputValidationMode               WorkflowInputValidationMode `json:"inputValidationMode"`
+	CanaryPercentage                  pgtype.Int4                 `json:"canaryPercentage"`
+	StableWorkflowVersionId           *uuid.UUID                  `json:"stableWorkflowVersionId"`
+	StableInputJsonSchema             []byte                      `json:"stableInputJsonSchema"`
+	WorkflowTriggeringEventKeyPattern string                      `json:"workflowTriggeringEventKeyPattern"`
+	IncomingEventKey                  string                      `json:"incomingEventKey"`
 }
 
 // Get all of the latest workflow versions
 // select the workflow versions that have the event trigger
+// if the latest version is a canary, the rest of the runs use the most recent version which isn't a canary
 func (q *Queries) ListWorkflowsForEvents(ctx context.Context, db DBTX, arg ListWorkflowsForEventsParams) ([]*ListWorkflowsForEventsRow, error) {
 	rows, err := db.Query(ctx, listWorkflowsForEvents, arg.Tenantid, arg.Eventkeys)
 	if err != nil {
@@ -120,6 +266,11 @@ func (q *Queries) ListWorkflowsForEvents(ctx context.Context, db DBTX, arg ListW
 			&i.WorkflowVersionId,
 			&i.WorkflowId,
 			&i.WorkflowName,
+			&i.InputJsonSchema,
+			&i.InputValidationMode,
+			&i.CanaryPercentage,
+			&i.StableWorkflowVersionId,
+			&i.StableInputJsonSchema,
 			&i.WorkflowTriggeringEventKeyPattern,
 			&i.IncomingEventKey,
 		); err != nil {
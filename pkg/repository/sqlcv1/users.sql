@@ -47,6 +47,17 @@ FROM
 WHERE
     "email" = @email::text;
 
+-- name: GetUserByOAuthProviderUserId :one
+SELECT
+    "User".*
+FROM
+    "User"
+JOIN
+    "UserOAuth" ON "UserOAuth"."userId" = "User"."id"
+WHERE
+    "UserOAuth"."provider" = @provider::text
+    AND "UserOAuth"."providerUserId" = @providerUserId::text;
+
 -- name: GetUserByID :one
 SELECT
     *
@@ -238,6 +238,38 @@ func (q *Queries) GetUserByEmail(ctx context.Context, db DBTX, email string) (*U
 	return &i, err
 }
 
+const getUserByOAuthProviderUserId = `-- name: GetUserByOAuthProviderUserId :one
+SELECT
+    "User".id, "User"."createdAt", "User"."updatedAt", "User"."deletedAt", "User".email, "User"."emailVerified", "User".name
+FROM
+    "User"
+JOIN
+    "UserOAuth" ON "UserOAuth"."userId" = "User"."id"
+WHERE
+    "UserOAuth"."provider" = $1::text
+    AND "UserOAuth"."providerUserId" = $2::text
+`
+
+type GetUserByOAuthProviderUserIdParams struct {
+	Provider       string `json:"provider"`
+	Provideruserid string `json:"provideruserid"`
+}
+
+func (q *Queries) GetUserByOAuthProviderUserId(ctx context.Context, db DBTX, arg GetUserByOAuthProviderUserIdParams) (*User, error) {
+	row := db.QueryRow(ctx, getUserByOAuthProviderUserId, arg.Provider, arg.Provideruserid)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Email,
+		&i.EmailVerified,
+		&i.Name,
+	)
+	return &i, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT
     id, "createdAt", "updatedAt", "deletedAt", email, "emailVerified", name
@@ -303,7 +335,7 @@ func (q *Queries) GetUserSession(ctx context.Context, db DBTX, id uuid.UUID) (*U
 
 const listTenantMemberships = `-- name: ListTenantMemberships :many
 SELECT
-    "TenantMember".id, "TenantMember"."createdAt", "TenantMember"."updatedAt", "TenantMember"."tenantId", "TenantMember"."userId", "TenantMember".role
+    "TenantMember".id, "TenantMember"."createdAt", "TenantMember"."updatedAt", "TenantMember"."tenantId", "TenantMember"."userId", "TenantMember".role, "TenantMember"."customRoleId"
 FROM
     "TenantMember"
 JOIN
@@ -329,6 +361,7 @@ func (q *Queries) ListTenantMemberships(ctx context.Context, db DBTX, userid uui
 			&i.TenantId,
 			&i.UserId,
 			&i.Role,
+			&i.CustomRoleId,
 		); err != nil {
 			return nil, err
 		}
@@ -42,6 +42,41 @@ ON CONFLICT (tenant_id, worker_id, slot_type) DO UPDATE SET
     max_units = EXCLUDED.max_units,
     updated_at = CURRENT_TIMESTAMP;
 
+-- name: ListWorkerWorkflowVersions :many
+SELECT
+    worker_id,
+    workflow_id,
+    version
+FROM
+    v1_worker_workflow_version
+WHERE
+    tenant_id = @tenantId::uuid
+    AND worker_id = ANY(@workerIds::uuid[]);
+
+-- name: CreateWorkerWorkflowVersions :exec
+WITH input AS (
+    SELECT
+        unnest(@workflowNames::text[]) AS workflow_name,
+        unnest(@versions::text[]) AS version
+)
+INSERT INTO v1_worker_workflow_version (
+    tenant_id,
+    worker_id,
+    workflow_id,
+    version
+)
+SELECT
+    @tenantId::uuid,
+    @workerId::uuid,
+    w."id",
+    input.version
+FROM
+    input
+JOIN
+    "Workflow" w ON w."tenantId" = @tenantId::uuid AND w."name" = input.workflow_name AND w."deletedAt" IS NULL
+ON CONFLICT (tenant_id, worker_id, workflow_id) DO UPDATE SET
+    version = EXCLUDED.version;
+
 -- name: ListAvailableSlotsForWorkers :many
 WITH worker_capacities AS (
     SELECT
@@ -449,6 +484,9 @@ WITH old_workers AS (
 ), deleted_worker_slot_configs AS (
     DELETE FROM v1_worker_slot_config
     WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_workflow_versions AS (
+    DELETE FROM v1_worker_workflow_version
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
 )
 DELETE FROM "Worker"
 WHERE "id" IN (SELECT "id" FROM old_workers);
@@ -23,6 +23,9 @@ WITH old_workers AS (
 ), deleted_worker_slot_configs AS (
     DELETE FROM v1_worker_slot_config
     WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_workflow_versions AS (
+    DELETE FROM v1_worker_workflow_version
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
 )
 DELETE FROM "Worker"
 WHERE "id" IN (SELECT "id" FROM old_workers)
@@ -224,6 +227,48 @@ func (q *Queries) CreateWorkerSlotConfigs(ctx context.Context, db DBTX, arg Crea
 	return err
 }
 
+const createWorkerWorkflowVersions = `-- name: CreateWorkerWorkflowVersions :exec
+WITH input AS (
+    SELECT
+        unnest($3::text[]) AS workflow_name,
+        unnest($4::text[]) AS version
+)
+INSERT INTO v1_worker_workflow_version (
+    tenant_id,
+    worker_id,
+    workflow_id,
+    version
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    w."id",
+    input.version
+FROM
+    input
+JOIN
+    "Workflow" w ON w."tenantId" = $1::uuid AND w."name" = input.workflow_name AND w."deletedAt" IS NULL
+ON CONFLICT (tenant_id, worker_id, workflow_id) DO UPDATE SET
+    version = EXCLUDED.version
+`
+
+type CreateWorkerWorkflowVersionsParams struct {
+	Tenantid      uuid.UUID `json:"tenantid"`
+	Workerid      uuid.UUID `json:"workerid"`
+	Workflownames []string  `json:"workflownames"`
+	Versions      []string  `json:"versions"`
+}
+
+func (q *Queries) CreateWorkerWorkflowVersions(ctx context.Context, db DBTX, arg CreateWorkerWorkflowVersionsParams) error {
+	_, err := db.Exec(ctx, createWorkerWorkflowVersions,
+		arg.Tenantid,
+		arg.Workerid,
+		arg.Workflownames,
+		arg.Versions,
+	)
+	return err
+}
+
 const deleteWorker = `-- name: DeleteWorker :one
 DELETE FROM
   "Worker"
@@ -502,7 +547,7 @@ func (q *Queries) GetWorkerForEngine(ctx context.Context, db DBTX, arg GetWorker
 }
 
 const getWorkerWorkflowsByWorkerId = `-- name: GetWorkerWorkflowsByWorkerId :many
-SELECT wf.id, wf."createdAt", wf."updatedAt", wf."deletedAt", wf."tenantId", wf.name, wf.description, wf."isPaused"
+SELECT wf.id, wf."createdAt", wf."updatedAt", wf."deletedAt", wf."tenantId", wf.name, wf.description, wf."isPaused", wf."inputValidationMode"
 FROM "Worker" w
 JOIN "_ActionToWorker" aw ON w.id = aw."B"
 JOIN "Action" a ON aw."A" = a.id
@@ -538,6 +583,7 @@ func (q *Queries) GetWorkerWorkflowsByWorkerId(ctx context.Context, db DBTX, arg
 			&i.Name,
 			&i.Description,
 			&i.IsPaused,
+			&i.InputValidationMode,
 		); err != nil {
 			return nil, err
 		}
@@ -1014,7 +1060,7 @@ func (q *Queries) ListManyWorkerLabels(ctx context.Context, db DBTX, workerids [
 
 const listSemaphoreSlotsWithStateForWorker = `-- name: ListSemaphoreSlotsWithStateForWorker :many
 SELECT
-    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, evicted_at, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
+    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, evicted_at, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, retry_delay_seconds, is_durable, desired_worker_label, triggering_event_external_id, triggering_event_key
 FROM
     v1_task_runtime runtime
 JOIN
@@ -1077,6 +1123,7 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	RetryDelaySeconds            pgtype.Float8      `json:"retry_delay_seconds"`
 	IsDurable                    pgtype.Bool        `json:"is_durable"`
 	DesiredWorkerLabel           []byte             `json:"desired_worker_label"`
 	TriggeringEventExternalID    *uuid.UUID         `json:"triggering_event_external_id"`
@@ -1137,6 +1184,7 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.RetryDelaySeconds,
 			&i.IsDurable,
 			&i.DesiredWorkerLabel,
 			&i.TriggeringEventExternalID,
@@ -1285,6 +1333,49 @@ func (q *Queries) ListWorkerSlotConfigs(ctx context.Context, db DBTX, arg ListWo
 	return items, nil
 }
 
+const listWorkerWorkflowVersions = `-- name: ListWorkerWorkflowVersions :many
+SELECT
+    worker_id,
+    workflow_id,
+    version
+FROM
+    v1_worker_workflow_version
+WHERE
+    tenant_id = $1::uuid
+    AND worker_id = ANY($2::uuid[])
+`
+
+type ListWorkerWorkflowVersionsParams struct {
+	Tenantid  uuid.UUID   `json:"tenantid"`
+	Workerids []uuid.UUID `json:"workerids"`
+}
+
+type ListWorkerWorkflowVersionsRow struct {
+	WorkerID   uuid.UUID `json:"worker_id"`
+	WorkflowID uuid.UUID `json:"workflow_id"`
+	Version    string    `json:"version"`
+}
+
+func (q *Queries) ListWorkerWorkflowVersions(ctx context.Context, db DBTX, arg ListWorkerWorkflowVersionsParams) ([]*ListWorkerWorkflowVersionsRow, error) {
+	rows, err := db.Query(ctx, listWorkerWorkflowVersions, arg.Tenantid, arg.Workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkerWorkflowVersionsRow
+	for rows.Next() {
+		var i ListWorkerWorkflowVersionsRow
+		if err := rows.Scan(&i.WorkerID, &i.WorkflowID, &i.Version); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkers = `-- name: ListWorkers :many
 SELECT
     workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion", workers."durableTaskDispatcherId", workers."actionHash"
@@ -0,0 +1,279 @@
+-- name: UpsertWorkflowRetentionPolicy :one
+INSERT INTO v1_workflow_retention_policy (
+    tenant_id,
+    workflow_id,
+    retention_period,
+    completed_retention_period,
+    failed_retention_period,
+    cancelled_retention_period
+)
+VALUES (
+    @tenantId::uuid,
+    @workflowId::uuid,
+    @retentionPeriod::interval,
+    sqlc.narg('completedRetentionPeriod')::interval,
+    sqlc.narg('failedRetentionPeriod')::interval,
+    sqlc.narg('cancelledRetentionPeriod')::interval
+)
+ON CONFLICT (tenant_id, workflow_id) DO UPDATE
+SET
+    retention_period = EXCLUDED.retention_period,
+    completed_retention_period = EXCLUDED.completed_retention_period,
+    failed_retention_period = EXCLUDED.failed_retention_period,
+    cancelled_retention_period = EXCLUDED.cancelled_retention_period,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetWorkflowRetentionPolicy :one
+SELECT *
+FROM v1_workflow_retention_policy
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_id = @workflowId::uuid;
+
+-- name: ListWorkflowRetentionPolicies :many
+SELECT *
+FROM v1_workflow_retention_policy
+WHERE tenant_id = @tenantId::uuid
+ORDER BY created_at;
+
+-- name: DeleteWorkflowRetentionPolicy :exec
+DELETE FROM v1_workflow_retention_policy
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_id = @workflowId::uuid;
+
+-- name: GetShortestWorkflowRetentionPeriod :one
+-- LEAST ignores nulls, so unset status overrides don't affect the result. Returns null if no workflow has a retention
+-- policy.
+SELECT
+    MIN(LEAST(retention_period, completed_retention_period, failed_retention_period, cancelled_retention_period))::interval AS shortest_retention_period
+FROM v1_workflow_retention_policy;
+
+-- name: DeleteExpiredTasksByRetention :execresult
+-- Deletes tasks which have been kept for longer than the retention period of their workflow. The final status of a
+-- task is the type of its latest terminal event. Tasks of workflows without a retention policy use the default retention
+-- period.
+WITH expired_tasks AS (
+    SELECT
+        t.id,
+        t.inserted_at,
+        t.tenant_id,
+        t.external_id
+    FROM v1_task t
+    LEFT JOIN v1_workflow_retention_policy p ON p.tenant_id = t.tenant_id AND p.workflow_id = t.workflow_id
+    LEFT JOIN LATERAL (
+        SELECT e.event_type
+        FROM v1_task_event e
+        WHERE
+            e.task_id = t.id
+            AND e.task_inserted_at = t.inserted_at
+            AND e.retry_count = t.retry_count
+            AND e.event_type IN ('COMPLETED', 'FAILED', 'CANCELLED')
+        ORDER BY e.id DESC
+        LIMIT 1
+    ) e ON p.id IS NOT NULL
+    WHERE
+        -- no task expires before the shortest retention period, which lets recent partitions be pruned
+        t.inserted_at < NOW() - @shortestRetentionPeriod::interval
+        AND t.inserted_at < NOW() - COALESCE(
+            CASE e.event_type
+                WHEN 'COMPLETED' THEN p.completed_retention_period
+                WHEN 'FAILED' THEN p.failed_retention_period
+                WHEN 'CANCELLED' THEN p.cancelled_retention_period
+            END,
+            p.retention_period,
+            @defaultRetentionPeriod::interval
+        )
+        -- runs of workflows with a retention policy are only deleted once they've finished
+        AND (p.id IS NULL OR e.event_type IS NOT NULL)
+    LIMIT @batchSize::int
+), deleted_events AS (
+    DELETE FROM v1_task_event e
+    USING expired_tasks t
+    WHERE
+        e.task_id = t.id
+        AND e.task_inserted_at = t.inserted_at
+    RETURNING e.id, e.inserted_at, e.tenant_id
+), deleted_event_payloads AS (
+    DELETE FROM v1_payload p
+    USING deleted_events e
+    WHERE
+        p.tenant_id = e.tenant_id
+        AND p.id = e.id
+        AND p.inserted_at = e.inserted_at
+        AND p.type = 'TASK_EVENT_DATA'
+), deleted_task_payloads AS (
+    DELETE FROM v1_payload p
+    USING expired_tasks t
+    WHERE
+        p.tenant_id = t.tenant_id
+        AND p.id = t.id
+        AND p.inserted_at = t.inserted_at
+        AND p.type = 'TASK_INPUT'
+), deleted_log_lines AS (
+    DELETE FROM v1_log_line l
+    USING expired_tasks t
+    WHERE
+        l.task_id = t.id
+        AND l.task_inserted_at = t.inserted_at
+), deleted_lookups AS (
+    DELETE FROM v1_lookup_table l
+    USING expired_tasks t
+    WHERE l.external_id = t.external_id
+), deleted_dag_to_tasks AS (
+    DELETE FROM v1_dag_to_task dt
+    USING expired_tasks t
+    WHERE
+        dt.task_id = t.id
+        AND dt.task_inserted_at = t.inserted_at
+)
+DELETE FROM v1_task t
+USING expired_tasks e
+WHERE
+    t.id = e.id
+    AND t.inserted_at = e.inserted_at;
+
+-- name: DeleteExpiredDagsByRetention :execresult
+-- Deletes DAGs whose tasks have all been deleted, once the shortest retention period of their workflow, or the default
+-- retention period, has passed.
+WITH expired_dags AS (
+    SELECT
+        d.id,
+        d.inserted_at,
+        d.tenant_id,
+        d.external_id
+    FROM v1_dag d
+    LEFT JOIN v1_workflow_retention_policy p ON p.tenant_id = d.tenant_id AND p.workflow_id = d.workflow_id
+    WHERE
+        d.inserted_at < NOW() - @shortestRetentionPeriod::interval
+        AND d.inserted_at < NOW() - COALESCE(
+            LEAST(p.retention_period, p.completed_retention_period, p.failed_retention_period, p.cancelled_retention_period),
+            @defaultRetentionPeriod::interval
+        )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM v1_dag_to_task dt
+            WHERE
+                dt.dag_id = d.id
+                AND dt.dag_inserted_at = d.inserted_at
+        )
+    LIMIT @batchSize::int
+), deleted_dag_data AS (
+    DELETE FROM v1_dag_data dd
+    USING expired_dags d
+    WHERE
+        dd.dag_id = d.id
+        AND dd.dag_inserted_at = d.inserted_at
+), deleted_dag_payloads AS (
+    DELETE FROM v1_payload p
+    USING expired_dags d
+    WHERE
+        p.tenant_id = d.tenant_id
+        AND p.id = d.id
+        AND p.inserted_at = d.inserted_at
+        AND p.type = 'DAG_INPUT'
+), deleted_lookups AS (
+    DELETE FROM v1_lookup_table l
+    USING expired_dags d
+    WHERE l.external_id = d.external_id
+)
+DELETE FROM v1_dag d
+USING expired_dags e
+WHERE
+    d.id = e.id
+    AND d.inserted_at = e.inserted_at;
+
+-- name: DeleteExpiredOLAPRunsByRetention :execresult
+-- Deletes runs which have been kept for longer than the retention period of their workflow and final status, along with
+-- their tasks. Runs of workflows without a retention policy use the default retention period.
+WITH expired_runs AS (
+    SELECT
+        r.tenant_id,
+        r.id,
+        r.inserted_at,
+        r.external_id,
+        r.readable_status,
+        r.kind
+    FROM v1_runs_olap r
+    LEFT JOIN v1_workflow_retention_policy p ON p.tenant_id = r.tenant_id AND p.workflow_id = r.workflow_id
+    WHERE
+        -- no run expires before the shortest retention period, which lets recent partitions be pruned
+        r.inserted_at < NOW() - @shortestRetentionPeriod::interval
+        AND r.inserted_at < NOW() - COALESCE(
+            CASE r.readable_status
+                WHEN 'COMPLETED' THEN p.completed_retention_period
+                WHEN 'FAILED' THEN p.failed_retention_period
+                WHEN 'CANCELLED' THEN p.cancelled_retention_period
+            END,
+            p.retention_period,
+            @defaultRetentionPeriod::interval
+        )
+        -- runs of workflows with a retention policy are only deleted once they've finished
+        AND (p.id IS NULL OR r.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED'))
+    LIMIT @batchSize::int
+), expired_tasks AS (
+    SELECT r.id AS task_id, r.inserted_at AS task_inserted_at
+    FROM expired_runs r
+    WHERE r.kind = 'TASK'
+
+    UNION ALL
+
+    SELECT dt.task_id, dt.task_inserted_at
+    FROM v1_dag_to_task_olap dt
+    JOIN expired_runs r ON r.kind = 'DAG' AND dt.dag_id = r.id AND dt.dag_inserted_at = r.inserted_at
+), deleted_tasks AS (
+    DELETE FROM v1_tasks_olap t
+    USING expired_tasks e
+    WHERE
+        t.id = e.task_id
+        AND t.inserted_at = e.task_inserted_at
+    RETURNING t.tenant_id, t.external_id
+), deleted_task_events AS (
+    DELETE FROM v1_task_events_olap ev
+    USING expired_tasks e
+    WHERE
+        ev.task_id = e.task_id
+        AND ev.task_inserted_at = e.task_inserted_at
+), deleted_dags AS (
+    DELETE FROM v1_dags_olap d
+    USING expired_runs r
+    WHERE
+        r.kind = 'DAG'
+        AND d.id = r.id
+        AND d.inserted_at = r.inserted_at
+), deleted_dag_to_tasks AS (
+    DELETE FROM v1_dag_to_task_olap dt
+    USING expired_runs r
+    WHERE
+        r.kind = 'DAG'
+        AND dt.dag_id = r.id
+        AND dt.dag_inserted_at = r.inserted_at
+), expired_external_ids AS (
+    SELECT tenant_id, external_id FROM expired_runs
+
+    UNION ALL
+
+    SELECT tenant_id, external_id FROM deleted_tasks
+), deleted_lookups AS (
+    DELETE FROM v1_lookup_table_olap l
+    USING expired_external_ids x
+    WHERE l.external_id = x.external_id
+), deleted_statuses AS (
+    DELETE FROM v1_statuses_olap s
+    USING expired_external_ids x
+    WHERE s.external_id = x.external_id
+), deleted_payloads AS (
+    DELETE FROM v1_payloads_olap p
+    USING expired_external_ids x
+    WHERE
+        p.tenant_id = x.tenant_id
+        AND p.external_id = x.external_id
+)
+DELETE FROM v1_runs_olap r
+USING expired_runs e
+WHERE
+    r.inserted_at = e.inserted_at
+    AND r.id = e.id
+    AND r.readable_status = e.readable_status
+    AND r.kind = e.kind;
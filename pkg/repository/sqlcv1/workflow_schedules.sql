@@ -249,6 +249,67 @@ WHERE
 ;
 
 -- name: DeleteWorkflowTriggerCronRef :exec
-DELETE FROM "WorkflowTriggerCronRef"
+WITH deleted_cron AS (
+    DELETE FROM "WorkflowTriggerCronRef"
+    WHERE
+        "id" = @id::uuid
+    RETURNING "id"
+)
+DELETE FROM v1_cron_fire
+WHERE cron_id IN (SELECT "id" FROM deleted_cron);
+
+-- name: CreateCronFire :exec
+INSERT INTO v1_cron_fire (
+    tenant_id,
+    cron_id,
+    scheduled_at,
+    outcome,
+    workflow_run_external_id
+) VALUES (
+    @tenantId::uuid,
+    @cronId::uuid,
+    @scheduledAt::timestamptz,
+    @outcome::v1_cron_fire_outcome,
+    sqlc.narg('workflowRunExternalId')::uuid
+);
+
+-- name: DeleteOldCronFires :exec
+-- Keeps the most recent fires of a cron
+DELETE FROM v1_cron_fire
+WHERE
+    cron_id = @cronId::uuid
+    AND id <= (
+        SELECT id
+        FROM v1_cron_fire
+        WHERE cron_id = @cronId::uuid
+        ORDER BY id DESC
+        OFFSET @keep::integer
+        LIMIT 1
+    );
+
+-- name: GetLatestTriggeredCronFire :one
+SELECT *
+FROM v1_cron_fire
+WHERE
+    tenant_id = @tenantId::uuid
+    AND cron_id = @cronId::uuid
+    AND outcome = 'TRIGGERED'
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: ListCronFires :many
+SELECT *
+FROM v1_cron_fire
+WHERE
+    tenant_id = @tenantId::uuid
+    AND cron_id = @cronId::uuid
+ORDER BY id DESC
+OFFSET @fireOffset::integer
+LIMIT @fireLimit::integer;
+
+-- name: CountCronFires :one
+SELECT count(*)
+FROM v1_cron_fire
 WHERE
-    "id" = @id::uuid;
+    tenant_id = @tenantId::uuid
+    AND cron_id = @cronId::uuid;
@@ -101,6 +101,26 @@ func (q *Queries) BulkUpdateScheduledWorkflows(ctx context.Context, db DBTX, arg
 	return items, nil
 }
 
+const countCronFires = `-- name: CountCronFires :one
+SELECT count(*)
+FROM v1_cron_fire
+WHERE
+    tenant_id = $1::uuid
+    AND cron_id = $2::uuid
+`
+
+type CountCronFiresParams struct {
+	Tenantid uuid.UUID `json:"tenantid"`
+	Cronid   uuid.UUID `json:"cronid"`
+}
+
+func (q *Queries) CountCronFires(ctx context.Context, db DBTX, arg CountCronFiresParams) (int64, error) {
+	row := db.QueryRow(ctx, countCronFires, arg.Tenantid, arg.Cronid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countCronWorkflows = `-- name: CountCronWorkflows :one
 WITH latest_versions AS (
     SELECT DISTINCT ON("workflowId")
@@ -212,6 +232,41 @@ func (q *Queries) CountScheduledWorkflows(ctx context.Context, db DBTX, arg Coun
 	return count, err
 }
 
+const createCronFire = `-- name: CreateCronFire :exec
+INSERT INTO v1_cron_fire (
+    tenant_id,
+    cron_id,
+    scheduled_at,
+    outcome,
+    workflow_run_external_id
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::timestamptz,
+    $4::v1_cron_fire_outcome,
+    $5::uuid
+)
+`
+
+type CreateCronFireParams struct {
+	Tenantid              uuid.UUID          `json:"tenantid"`
+	Cronid                uuid.UUID          `json:"cronid"`
+	Scheduledat           pgtype.Timestamptz `json:"scheduledat"`
+	Outcome               V1CronFireOutcome  `json:"outcome"`
+	WorkflowRunExternalId *uuid.UUID         `json:"workflowRunExternalId"`
+}
+
+func (q *Queries) CreateCronFire(ctx context.Context, db DBTX, arg CreateCronFireParams) error {
+	_, err := db.Exec(ctx, createCronFire,
+		arg.Tenantid,
+		arg.Cronid,
+		arg.Scheduledat,
+		arg.Outcome,
+		arg.WorkflowRunExternalId,
+	)
+	return err
+}
+
 const createWorkflowTriggerScheduledRefForWorkflow = `-- name: CreateWorkflowTriggerScheduledRefForWorkflow :one
 WITH latest_version AS (
     SELECT "id" FROM "WorkflowVersion"
@@ -284,6 +339,31 @@ func (q *Queries) CreateWorkflowTriggerScheduledRefForWorkflow(ctx context.Conte
 	return &i, err
 }
 
+const deleteOldCronFires = `-- name: DeleteOldCronFires :exec
+DELETE FROM v1_cron_fire
+WHERE
+    cron_id = $1::uuid
+    AND id <= (
+        SELECT id
+        FROM v1_cron_fire
+        WHERE cron_id = $1::uuid
+        ORDER BY id DESC
+        OFFSET $2::integer
+        LIMIT 1
+    )
+`
+
+type DeleteOldCronFiresParams struct {
+	Cronid uuid.UUID `json:"cronid"`
+	Keep   int32     `json:"keep"`
+}
+
+// Keeps the most recent fires of a cron
+func (q *Queries) DeleteOldCronFires(ctx context.Context, db DBTX, arg DeleteOldCronFiresParams) error {
+	_, err := db.Exec(ctx, deleteOldCronFires, arg.Cronid, arg.Keep)
+	return err
+}
+
 const deleteScheduledWorkflow = `-- name: DeleteScheduledWorkflow :exec
 DELETE FROM "WorkflowTriggerScheduledRef"
 WHERE
@@ -296,9 +376,14 @@ func (q *Queries) DeleteScheduledWorkflow(ctx context.Context, db DBTX, schedule
 }
 
 const deleteWorkflowTriggerCronRef = `-- name: DeleteWorkflowTriggerCronRef :exec
-DELETE FROM "WorkflowTriggerCronRef"
-WHERE
-    "id" = $1::uuid
+WITH deleted_cron AS (
+    DELETE FROM "WorkflowTriggerCronRef"
+    WHERE
+        "id" = $1::uuid
+    RETURNING "id"
+)
+DELETE FROM v1_cron_fire
+WHERE cron_id IN (SELECT "id" FROM deleted_cron)
 `
 
 func (q *Queries) DeleteWorkflowTriggerCronRef(ctx context.Context, db DBTX, id uuid.UUID) error {
@@ -306,6 +391,37 @@ func (q *Queries) DeleteWorkflowTriggerCronRef(ctx context.Context, db DBTX, id
 	return err
 }
 
+const getLatestTriggeredCronFire = `-- name: GetLatestTriggeredCronFire :one
+SELECT id, tenant_id, cron_id, scheduled_at, fired_at, outcome, workflow_run_external_id
+FROM v1_cron_fire
+WHERE
+    tenant_id = $1::uuid
+    AND cron_id = $2::uuid
+    AND outcome = 'TRIGGERED'
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetLatestTriggeredCronFireParams struct {
+	Tenantid uuid.UUID `json:"tenantid"`
+	Cronid   uuid.UUID `json:"cronid"`
+}
+
+func (q *Queries) GetLatestTriggeredCronFire(ctx context.Context, db DBTX, arg GetLatestTriggeredCronFireParams) (*V1CronFire, error) {
+	row := db.QueryRow(ctx, getLatestTriggeredCronFire, arg.Tenantid, arg.Cronid)
+	var i V1CronFire
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.CronID,
+		&i.ScheduledAt,
+		&i.FiredAt,
+		&i.Outcome,
+		&i.WorkflowRunExternalID,
+	)
+	return &i, err
+}
+
 const getScheduledWorkflowMetaByIds = `-- name: GetScheduledWorkflowMetaByIds :many
 SELECT
     t."id",
@@ -354,6 +470,57 @@ func (q *Queries) GetScheduledWorkflowMetaByIds(ctx context.Context, db DBTX, ar
 	return items, nil
 }
 
+const listCronFires = `-- name: ListCronFires :many
+SELECT id, tenant_id, cron_id, scheduled_at, fired_at, outcome, workflow_run_external_id
+FROM v1_cron_fire
+WHERE
+    tenant_id = $1::uuid
+    AND cron_id = $2::uuid
+ORDER BY id DESC
+OFFSET $3::integer
+LIMIT $4::integer
+`
+
+type ListCronFiresParams struct {
+	Tenantid   uuid.UUID `json:"tenantid"`
+	Cronid     uuid.UUID `json:"cronid"`
+	Fireoffset int32     `json:"fireoffset"`
+	Firelimit  int32     `json:"firelimit"`
+}
+
+func (q *Queries) ListCronFires(ctx context.Context, db DBTX, arg ListCronFiresParams) ([]*V1CronFire, error) {
+	rows, err := db.Query(ctx, listCronFires,
+		arg.Tenantid,
+		arg.Cronid,
+		arg.Fireoffset,
+		arg.Firelimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1CronFire
+	for rows.Next() {
+		var i V1CronFire
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.CronID,
+			&i.ScheduledAt,
+			&i.FiredAt,
+			&i.Outcome,
+			&i.WorkflowRunExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCronWorkflows = `-- name: ListCronWorkflows :many
 WITH latest_versions AS (
     SELECT DISTINCT ON("workflowId")
@@ -376,7 +543,7 @@ SELECT
     t."id" as "triggerId",
     c."id" as "cronId",
     t.id, t."createdAt", t."updatedAt", t."deletedAt", t."workflowVersionId", t."tenantId",
-    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method, c.priority
+    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method, c.priority, c.timezone, c."misfirePolicy", c."misfireLimit", c."lastFiredAt", c."overlapPolicy"
 FROM
     latest_versions
 JOIN
@@ -419,31 +586,36 @@ type ListCronWorkflowsParams struct {
 }
 
 type ListCronWorkflowsRow struct {
-	WorkflowVersionId   uuid.UUID                     `json:"workflowVersionId"`
-	WorkflowName        string                        `json:"workflowName"`
-	WorkflowId          uuid.UUID                     `json:"workflowId"`
-	TenantId            uuid.UUID                     `json:"tenantId"`
-	TriggerId           uuid.UUID                     `json:"triggerId"`
-	CronId              uuid.UUID                     `json:"cronId"`
-	ID                  uuid.UUID                     `json:"id"`
-	CreatedAt           pgtype.Timestamp              `json:"createdAt"`
-	UpdatedAt           pgtype.Timestamp              `json:"updatedAt"`
-	DeletedAt           pgtype.Timestamp              `json:"deletedAt"`
-	WorkflowVersionId_2 uuid.UUID                     `json:"workflowVersionId_2"`
-	TenantId_2          uuid.UUID                     `json:"tenantId_2"`
-	ParentId            uuid.UUID                     `json:"parentId"`
-	Cron                string                        `json:"cron"`
-	TickerId            *uuid.UUID                    `json:"tickerId"`
-	Input               []byte                        `json:"input"`
-	Enabled             bool                          `json:"enabled"`
-	AdditionalMetadata  []byte                        `json:"additionalMetadata"`
-	CreatedAt_2         pgtype.Timestamp              `json:"createdAt_2"`
-	DeletedAt_2         pgtype.Timestamp              `json:"deletedAt_2"`
-	UpdatedAt_2         pgtype.Timestamp              `json:"updatedAt_2"`
-	Name                pgtype.Text                   `json:"name"`
-	ID_2                uuid.UUID                     `json:"id_2"`
-	Method              WorkflowTriggerCronRefMethods `json:"method"`
-	Priority            int32                         `json:"priority"`
+	WorkflowVersionId   uuid.UUID                           `json:"workflowVersionId"`
+	WorkflowName        string                              `json:"workflowName"`
+	WorkflowId          uuid.UUID                           `json:"workflowId"`
+	TenantId            uuid.UUID                           `json:"tenantId"`
+	TriggerId           uuid.UUID                           `json:"triggerId"`
+	CronId              uuid.UUID                           `json:"cronId"`
+	ID                  uuid.UUID                           `json:"id"`
+	CreatedAt           pgtype.Timestamp                    `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp                    `json:"updatedAt"`
+	DeletedAt           pgtype.Timestamp                    `json:"deletedAt"`
+	WorkflowVersionId_2 uuid.UUID                           `json:"workflowVersionId_2"`
+	TenantId_2          uuid.UUID                           `json:"tenantId_2"`
+	ParentId            uuid.UUID                           `json:"parentId"`
+	Cron                string                              `json:"cron"`
+	TickerId            *uuid.UUID                          `json:"tickerId"`
+	Input               []byte                              `json:"input"`
+	Enabled             bool                                `json:"enabled"`
+	AdditionalMetadata  []byte                              `json:"additionalMetadata"`
+	CreatedAt_2         pgtype.Timestamp                    `json:"createdAt_2"`
+	DeletedAt_2         pgtype.Timestamp                    `json:"deletedAt_2"`
+	UpdatedAt_2         pgtype.Timestamp                    `json:"updatedAt_2"`
+	Name                pgtype.Text                         `json:"name"`
+	ID_2                uuid.UUID                           `json:"id_2"`
+	Method              WorkflowTriggerCronRefMethods       `json:"method"`
+	Priority            int32                               `json:"priority"`
+	Timezone            pgtype.Text                         `json:"timezone"`
+	MisfirePolicy       WorkflowTriggerCronRefMisfirePolicy `json:"misfirePolicy"`
+	MisfireLimit        pgtype.Int4                         `json:"misfireLimit"`
+	LastFiredAt         pgtype.Timestamp                    `json:"lastFiredAt"`
+	OverlapPolicy       WorkflowTriggerCronRefOverlapPolicy `json:"overlapPolicy"`
 }
 
 // Get all of the latest workflow versions for the tenant
@@ -492,6 +664,11 @@ func (q *Queries) ListCronWorkflows(ctx context.Context, db DBTX, arg ListCronWo
 			&i.ID_2,
 			&i.Method,
 			&i.Priority,
+			&i.Timezone,
+			&i.MisfirePolicy,
+			&i.MisfireLimit,
+			&i.LastFiredAt,
+			&i.OverlapPolicy,
 		); err != nil {
 			return nil, err
 		}
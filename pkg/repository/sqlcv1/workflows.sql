@@ -121,7 +121,8 @@ INSERT INTO "WorkflowVersion" (
     "kind",
     "defaultPriority",
     "createWorkflowVersionOpts",
-    "inputJsonSchema"
+    "inputJsonSchema",
+    "canaryPercentage"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -136,7 +137,8 @@ INSERT INTO "WorkflowVersion" (
     coalesce(sqlc.narg('kind')::"WorkflowKind", 'DAG'),
     sqlc.narg('defaultPriority') :: integer,
     sqlc.narg('createWorkflowVersionOpts')::jsonb,
-    sqlc.narg('inputJsonSchema')::jsonb
+    sqlc.narg('inputJsonSchema')::jsonb,
+    sqlc.narg('canaryPercentage')::integer
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -211,7 +213,7 @@ INSERT INTO "WorkflowTriggerEventRef" (
 
 -- name: CreateWorkflowTriggerCronRef :one
 WITH previous_trigger AS (
-    SELECT "enabled"
+    SELECT "enabled", "lastFiredAt"
     FROM "WorkflowTriggerCronRef" c
     JOIN "WorkflowTriggers" t ON t."id" = c."parentId"
     JOIN "WorkflowVersion" wv ON wv."id" = t."workflowVersionId"
@@ -230,7 +232,8 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "id",
     "method",
     "priority",
-    "enabled"
+    "enabled",
+    "lastFiredAt"
 )
 SELECT
     @workflowTriggersId::uuid AS "parentId",
@@ -241,7 +244,9 @@ SELECT
     gen_random_uuid() AS "id",
     COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT') AS "method",
     COALESCE(sqlc.narg('priority')::integer, 1) AS "priority",
-    COALESCE((SELECT "enabled" FROM previous_trigger), true) AS "enabled"
+    COALESCE((SELECT "enabled" FROM previous_trigger), true) AS "enabled",
+    -- carry over when the cron last fired, so missed runs aren't lost when a new workflow version recreates it
+    (SELECT "lastFiredAt" FROM previous_trigger) AS "lastFiredAt"
 RETURNING *;
 
 -- name: CreateWorkflowConcurrency :one
@@ -325,6 +330,52 @@ SELECT
 -- NOTE: ON CONFLICT can be removed after the 0_76_d migration is run to remove insert triggers added in 0_76
 ON CONFLICT (tenant_id, step_id, slot_type) DO NOTHING;
 
+-- name: CreateStepRetryPolicies :exec
+WITH input AS (
+    SELECT
+        unnest(@errorTypes::text[]) AS error_type,
+        unnest(@maxRetries::integer[]) AS max_retries,
+        unnest(@backoffStrategies::text[]) AS backoff_strategy,
+        unnest(@backoffBaseSeconds::float8[]) AS backoff_base_seconds
+)
+INSERT INTO v1_step_retry_policy (
+    tenant_id,
+    step_id,
+    error_type,
+    max_retries,
+    backoff_strategy,
+    backoff_base_seconds
+)
+SELECT
+    @tenantId::uuid,
+    @stepId::uuid,
+    i.error_type,
+    -- negative values are used to indicate that the step-level value applies
+    NULLIF(i.max_retries, -1),
+    i.backoff_strategy::v1_retry_backoff_strategy,
+    NULLIF(i.backoff_base_seconds, -1)
+FROM
+    input i;
+
+-- name: CreateStepOutputSchema :exec
+INSERT INTO v1_step_output_schema (
+    tenant_id,
+    step_id,
+    output_schema
+) VALUES (
+    @tenantId::uuid,
+    @stepId::uuid,
+    @outputSchema::jsonb
+);
+
+-- name: GetStepOutputSchema :one
+SELECT
+    output_schema
+FROM
+    v1_step_output_schema
+WHERE
+    step_id = @stepId::uuid;
+
 -- name: AddStepParents :exec
 INSERT INTO "_StepOrder" ("A", "B")
 SELECT
@@ -416,6 +467,30 @@ WHERE
     w."deletedAt" IS NULL AND
     workflowVersions."deletedAt" IS NULL;
 
+-- name: GetWorkflowVersionIdByChecksum :one
+SELECT
+    "id"
+FROM
+    "WorkflowVersion"
+WHERE
+    "workflowId" = @workflowId::uuid AND
+    "checksum" = @checksum::text AND
+    "deletedAt" IS NULL
+ORDER BY "order" DESC
+LIMIT 1;
+
+-- name: GetWorkflowVersionIdByVersion :one
+SELECT
+    "id"
+FROM
+    "WorkflowVersion"
+WHERE
+    "workflowId" = @workflowId::uuid AND
+    "version" = @version::text AND
+    "deletedAt" IS NULL
+ORDER BY "order" DESC
+LIMIT 1;
+
 -- name: GetWorkflowByName :one
 SELECT
     *
@@ -445,11 +520,34 @@ WHERE "WorkflowTriggerCronRef"."id" = triggersToUpdate."id"
 ;
 
 -- name: DeleteOldDefaultCronTriggersForWorkflowVersion :exec
-DELETE FROM "WorkflowTriggerCronRef"
-USING "WorkflowTriggers"
-WHERE "WorkflowTriggerCronRef"."parentId" = "WorkflowTriggers"."id"
-    AND "WorkflowTriggers"."workflowVersionId" = @oldWorkflowVersionId::uuid
-    AND "WorkflowTriggerCronRef"."method" = 'DEFAULT';
+-- Deletes the DEFAULT crons of the old workflow version. The fire history of each deleted cron is moved to the cron
+-- which replaced it on the new workflow version, and is only deleted for crons which were removed from the workflow.
+WITH deleted_crons AS (
+    DELETE FROM "WorkflowTriggerCronRef"
+    USING "WorkflowTriggers"
+    WHERE "WorkflowTriggerCronRef"."parentId" = "WorkflowTriggers"."id"
+        AND "WorkflowTriggers"."workflowVersionId" = @oldWorkflowVersionId::uuid
+        AND "WorkflowTriggerCronRef"."method" = 'DEFAULT'
+    RETURNING "WorkflowTriggerCronRef"."id", "WorkflowTriggerCronRef"."cron", "WorkflowTriggerCronRef"."name"
+), replaced_crons AS (
+    SELECT
+        deleted_crons."id" AS old_id,
+        new_crons."id" AS new_id
+    FROM deleted_crons
+    JOIN "WorkflowTriggerCronRef" new_crons ON
+        new_crons."parentId" = @newWorkflowTriggerId::uuid
+        AND new_crons."method" = 'DEFAULT'
+        AND new_crons."cron" = deleted_crons."cron"
+        AND new_crons."name" IS NOT DISTINCT FROM deleted_crons."name"
+), moved_fires AS (
+    UPDATE v1_cron_fire
+    SET cron_id = replaced_crons.new_id
+    FROM replaced_crons
+    WHERE v1_cron_fire.cron_id = replaced_crons.old_id
+)
+DELETE FROM v1_cron_fire
+WHERE cron_id IN (SELECT "id" FROM deleted_crons)
+    AND cron_id NOT IN (SELECT old_id FROM replaced_crons);
 
 -- name: MoveScheduledTriggerToNewWorkflowTriggers :exec
 WITH triggersToUpdate AS (
@@ -557,7 +655,8 @@ INSERT INTO v1_step_concurrency (
     strategy,
     expression,
     tenant_id,
-    max_concurrency
+    max_concurrency,
+    weight_expression
 )
 VALUES (
     @workflowId::uuid,
@@ -566,7 +665,8 @@ VALUES (
     @strategy::v1_concurrency_strategy,
     @expression::text,
     @tenantId::uuid,
-    @maxConcurrency::integer
+    @maxConcurrency::integer,
+    sqlc.narg('weightExpression')::text
 ) RETURNING *;
 
 -- name: CreateStepMatchCondition :one
@@ -649,7 +749,12 @@ WHERE
 -- name: UpdateCronTrigger :exec
 UPDATE "WorkflowTriggerCronRef"
 SET
-    "enabled" = COALESCE(sqlc.narg('enabled')::BOOLEAN, "enabled")
+    "enabled" = COALESCE(sqlc.narg('enabled')::BOOLEAN, "enabled"),
+    -- a cron which is enabled again resumes from now, so the runs it missed while it was disabled aren't fired
+    "lastFiredAt" = CASE
+        WHEN sqlc.narg('enabled')::BOOLEAN AND NOT "enabled" THEN NOW()
+        ELSE "lastFiredAt"
+    END
 WHERE "id" = @cronTriggerId::uuid
 ;
 
@@ -675,7 +780,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "additionalMetadata",
     "id",
     "method",
-    "priority"
+    "priority",
+    "timezone",
+    "misfirePolicy",
+    "misfireLimit",
+    "overlapPolicy"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     @cronTrigger::text,
@@ -684,7 +793,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     sqlc.narg('additionalMetadata')::jsonb,
     gen_random_uuid(),
     COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
-    COALESCE(sqlc.narg('priority')::integer, 1)
+    COALESCE(sqlc.narg('priority')::integer, 1),
+    sqlc.narg('timezone')::text,
+    COALESCE(sqlc.narg('misfirePolicy')::"WorkflowTriggerCronRefMisfirePolicy", 'SKIP'),
+    sqlc.narg('misfireLimit')::integer,
+    COALESCE(sqlc.narg('overlapPolicy')::"WorkflowTriggerCronRefOverlapPolicy", 'ALLOW')
 ) RETURNING *;
 
 -- name: GetWorkflowById :one
@@ -788,7 +901,8 @@ WHERE
 UPDATE "Workflow"
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
-    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused")
+    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused"),
+    "inputValidationMode" = coalesce(sqlc.narg('inputValidationMode')::"WorkflowInputValidationMode", "inputValidationMode")
 WHERE "id" = @id::uuid
 RETURNING *;
 
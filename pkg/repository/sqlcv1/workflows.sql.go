@@ -253,7 +253,8 @@ INSERT INTO v1_step_concurrency (
     strategy,
     expression,
     tenant_id,
-    max_concurrency
+    max_concurrency,
+    weight_expression
 )
 VALUES (
     $1::uuid,
@@ -262,8 +263,9 @@ VALUES (
     $4::v1_concurrency_strategy,
     $5::text,
     $6::uuid,
-    $7::integer
-) RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    $7::integer,
+    $8::text
+) RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, weight_expression
 `
 
 type CreateStepConcurrencyParams struct {
@@ -274,6 +276,7 @@ type CreateStepConcurrencyParams struct {
 	Expression        string                `json:"expression"`
 	Tenantid          uuid.UUID             `json:"tenantid"`
 	Maxconcurrency    int32                 `json:"maxconcurrency"`
+	WeightExpression  pgtype.Text           `json:"weightExpression"`
 }
 
 func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg CreateStepConcurrencyParams) (*V1StepConcurrency, error) {
@@ -285,6 +288,7 @@ func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg Create
 		arg.Expression,
 		arg.Tenantid,
 		arg.Maxconcurrency,
+		arg.WeightExpression,
 	)
 	var i V1StepConcurrency
 	err := row.Scan(
@@ -298,6 +302,7 @@ func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg Create
 		&i.Expression,
 		&i.TenantID,
 		&i.MaxConcurrency,
+		&i.WeightExpression,
 	)
 	return &i, err
 }
@@ -405,6 +410,29 @@ func (q *Queries) CreateStepMatchCondition(ctx context.Context, db DBTX, arg Cre
 	return &i, err
 }
 
+const createStepOutputSchema = `-- name: CreateStepOutputSchema :exec
+INSERT INTO v1_step_output_schema (
+    tenant_id,
+    step_id,
+    output_schema
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::jsonb
+)
+`
+
+type CreateStepOutputSchemaParams struct {
+	Tenantid     uuid.UUID `json:"tenantid"`
+	Stepid       uuid.UUID `json:"stepid"`
+	Outputschema []byte    `json:"outputschema"`
+}
+
+func (q *Queries) CreateStepOutputSchema(ctx context.Context, db DBTX, arg CreateStepOutputSchemaParams) error {
+	_, err := db.Exec(ctx, createStepOutputSchema, arg.Tenantid, arg.Stepid, arg.Outputschema)
+	return err
+}
+
 const createStepRateLimit = `-- name: CreateStepRateLimit :one
 INSERT INTO "StepRateLimit" (
     "units",
@@ -448,6 +476,55 @@ func (q *Queries) CreateStepRateLimit(ctx context.Context, db DBTX, arg CreateSt
 	return &i, err
 }
 
+const createStepRetryPolicies = `-- name: CreateStepRetryPolicies :exec
+WITH input AS (
+    SELECT
+        unnest($1::text[]) AS error_type,
+        unnest($2::integer[]) AS max_retries,
+        unnest($3::text[]) AS backoff_strategy,
+        unnest($4::float8[]) AS backoff_base_seconds
+)
+INSERT INTO v1_step_retry_policy (
+    tenant_id,
+    step_id,
+    error_type,
+    max_retries,
+    backoff_strategy,
+    backoff_base_seconds
+)
+SELECT
+    $5::uuid,
+    $6::uuid,
+    i.error_type,
+    -- negative values are used to indicate that the step-level value applies
+    NULLIF(i.max_retries, -1),
+    i.backoff_strategy::v1_retry_backoff_strategy,
+    NULLIF(i.backoff_base_seconds, -1)
+FROM
+    input i
+`
+
+type CreateStepRetryPoliciesParams struct {
+	Errortypes         []string  `json:"errortypes"`
+	Maxretries         []int32   `json:"maxretries"`
+	Backoffstrategies  []string  `json:"backoffstrategies"`
+	Backoffbaseseconds []float64 `json:"backoffbaseseconds"`
+	Tenantid           uuid.UUID `json:"tenantid"`
+	Stepid             uuid.UUID `json:"stepid"`
+}
+
+func (q *Queries) CreateStepRetryPolicies(ctx context.Context, db DBTX, arg CreateStepRetryPoliciesParams) error {
+	_, err := db.Exec(ctx, createStepRetryPolicies,
+		arg.Errortypes,
+		arg.Maxretries,
+		arg.Backoffstrategies,
+		arg.Backoffbaseseconds,
+		arg.Tenantid,
+		arg.Stepid,
+	)
+	return err
+}
+
 const createStepSlotRequests = `-- name: CreateStepSlotRequests :exec
 INSERT INTO v1_step_slot_request (
     tenant_id,
@@ -502,7 +579,7 @@ INSERT INTO "Workflow" (
     $5::uuid,
     $6::text,
     $7::text
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused", "inputValidationMode"
 `
 
 type CreateWorkflowParams struct {
@@ -535,6 +612,7 @@ func (q *Queries) CreateWorkflow(ctx context.Context, db DBTX, arg CreateWorkflo
 		&i.Name,
 		&i.Description,
 		&i.IsPaused,
+		&i.InputValidationMode,
 	)
 	return &i, err
 }
@@ -648,7 +726,7 @@ WITH inserted_wcs AS (
           wv."id" = $2::uuid
           AND j."kind" = 'DEFAULT'
     ) s, inserted_wcs wcs
-    RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, weight_expression
 )
 SELECT
     wcs.id,
@@ -691,7 +769,7 @@ func (q *Queries) CreateWorkflowConcurrencyV1(ctx context.Context, db DBTX, arg
 
 const createWorkflowTriggerCronRef = `-- name: CreateWorkflowTriggerCronRef :one
 WITH previous_trigger AS (
-    SELECT "enabled"
+    SELECT "enabled", "lastFiredAt"
     FROM "WorkflowTriggerCronRef" c
     JOIN "WorkflowTriggers" t ON t."id" = c."parentId"
     JOIN "WorkflowVersion" wv ON wv."id" = t."workflowVersionId"
@@ -710,7 +788,8 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "id",
     "method",
     "priority",
-    "enabled"
+    "enabled",
+    "lastFiredAt"
 )
 SELECT
     $1::uuid AS "parentId",
@@ -721,8 +800,10 @@ SELECT
     gen_random_uuid() AS "id",
     COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT') AS "method",
     COALESCE($7::integer, 1) AS "priority",
-    COALESCE((SELECT "enabled" FROM previous_trigger), true) AS "enabled"
-RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, priority
+    COALESCE((SELECT "enabled" FROM previous_trigger), true) AS "enabled",
+    -- carry over when the cron last fired, so missed runs aren't lost when a new workflow version recreates it
+    (SELECT "lastFiredAt" FROM previous_trigger) AS "lastFiredAt"
+RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, priority, timezone, "misfirePolicy", "misfireLimit", "lastFiredAt", "overlapPolicy"
 `
 
 type CreateWorkflowTriggerCronRefParams struct {
@@ -762,6 +843,11 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		&i.ID,
 		&i.Method,
 		&i.Priority,
+		&i.Timezone,
+		&i.MisfirePolicy,
+		&i.MisfireLimit,
+		&i.LastFiredAt,
+		&i.OverlapPolicy,
 	)
 	return &i, err
 }
@@ -769,7 +855,7 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 const createWorkflowTriggerCronRefForWorkflow = `-- name: CreateWorkflowTriggerCronRefForWorkflow :one
 WITH latest_version AS (
     SELECT "id" FROM "WorkflowVersion"
-    WHERE "workflowId" = $7::uuid
+    WHERE "workflowId" = $11::uuid
         AND "deletedAt" IS NULL
     ORDER BY "order" DESC
     LIMIT 1
@@ -788,7 +874,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "additionalMetadata",
     "id",
     "method",
-    "priority"
+    "priority",
+    "timezone",
+    "misfirePolicy",
+    "misfireLimit",
+    "overlapPolicy"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     $1::text,
@@ -797,18 +887,26 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $4::jsonb,
     gen_random_uuid(),
     COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
-    COALESCE($6::integer, 1)
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, priority
+    COALESCE($6::integer, 1),
+    $7::text,
+    COALESCE($8::"WorkflowTriggerCronRefMisfirePolicy", 'SKIP'),
+    $9::integer,
+    COALESCE($10::"WorkflowTriggerCronRefOverlapPolicy", 'ALLOW')
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, priority, timezone, "misfirePolicy", "misfireLimit", "lastFiredAt", "overlapPolicy"
 `
 
 type CreateWorkflowTriggerCronRefForWorkflowParams struct {
-	Crontrigger        string                            `json:"crontrigger"`
-	Name               pgtype.Text                       `json:"name"`
-	Input              []byte                            `json:"input"`
-	AdditionalMetadata []byte                            `json:"additionalMetadata"`
-	Method             NullWorkflowTriggerCronRefMethods `json:"method"`
-	Priority           pgtype.Int4                       `json:"priority"`
-	Workflowid         uuid.UUID                         `json:"workflowid"`
+	Crontrigger        string                                  `json:"crontrigger"`
+	Name               pgtype.Text                             `json:"name"`
+	Input              []byte                                  `json:"input"`
+	AdditionalMetadata []byte                                  `json:"additionalMetadata"`
+	Method             NullWorkflowTriggerCronRefMethods       `json:"method"`
+	Priority           pgtype.Int4                             `json:"priority"`
+	Timezone           pgtype.Text                             `json:"timezone"`
+	MisfirePolicy      NullWorkflowTriggerCronRefMisfirePolicy `json:"misfirePolicy"`
+	MisfireLimit       pgtype.Int4                             `json:"misfireLimit"`
+	OverlapPolicy      NullWorkflowTriggerCronRefOverlapPolicy `json:"overlapPolicy"`
+	Workflowid         uuid.UUID                               `json:"workflowid"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefForWorkflowParams) (*WorkflowTriggerCronRef, error) {
@@ -819,6 +917,10 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		arg.AdditionalMetadata,
 		arg.Method,
 		arg.Priority,
+		arg.Timezone,
+		arg.MisfirePolicy,
+		arg.MisfireLimit,
+		arg.OverlapPolicy,
 		arg.Workflowid,
 	)
 	var i WorkflowTriggerCronRef
@@ -836,6 +938,11 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		&i.ID,
 		&i.Method,
 		&i.Priority,
+		&i.Timezone,
+		&i.MisfirePolicy,
+		&i.MisfireLimit,
+		&i.LastFiredAt,
+		&i.OverlapPolicy,
 	)
 	return &i, err
 }
@@ -914,7 +1021,8 @@ INSERT INTO "WorkflowVersion" (
     "kind",
     "defaultPriority",
     "createWorkflowVersionOpts",
-    "inputJsonSchema"
+    "inputJsonSchema",
+    "canaryPercentage"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -929,8 +1037,9 @@ INSERT INTO "WorkflowVersion" (
     coalesce($9::"WorkflowKind", 'DAG'),
     $10 :: integer,
     $11::jsonb,
-    $12::jsonb
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "inputJsonSchema"
+    $12::jsonb,
+    $13::integer
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "inputJsonSchema", "canaryPercentage"
 `
 
 type CreateWorkflowVersionParams struct {
@@ -946,6 +1055,7 @@ type CreateWorkflowVersionParams struct {
 	DefaultPriority           pgtype.Int4        `json:"defaultPriority"`
 	CreateWorkflowVersionOpts []byte             `json:"createWorkflowVersionOpts"`
 	InputJsonSchema           []byte             `json:"inputJsonSchema"`
+	CanaryPercentage          pgtype.Int4        `json:"canaryPercentage"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -962,6 +1072,7 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.DefaultPriority,
 		arg.CreateWorkflowVersionOpts,
 		arg.InputJsonSchema,
+		arg.CanaryPercentage,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -980,20 +1091,49 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
 		&i.InputJsonSchema,
+		&i.CanaryPercentage,
 	)
 	return &i, err
 }
 
 const deleteOldDefaultCronTriggersForWorkflowVersion = `-- name: DeleteOldDefaultCronTriggersForWorkflowVersion :exec
-DELETE FROM "WorkflowTriggerCronRef"
-USING "WorkflowTriggers"
-WHERE "WorkflowTriggerCronRef"."parentId" = "WorkflowTriggers"."id"
-    AND "WorkflowTriggers"."workflowVersionId" = $1::uuid
-    AND "WorkflowTriggerCronRef"."method" = 'DEFAULT'
+WITH deleted_crons AS (
+    DELETE FROM "WorkflowTriggerCronRef"
+    USING "WorkflowTriggers"
+    WHERE "WorkflowTriggerCronRef"."parentId" = "WorkflowTriggers"."id"
+        AND "WorkflowTriggers"."workflowVersionId" = $1::uuid
+        AND "WorkflowTriggerCronRef"."method" = 'DEFAULT'
+    RETURNING "WorkflowTriggerCronRef"."id", "WorkflowTriggerCronRef"."cron", "WorkflowTriggerCronRef"."name"
+), replaced_crons AS (
+    SELECT
+        deleted_crons."id" AS old_id,
+        new_crons."id" AS new_id
+    FROM deleted_crons
+    JOIN "WorkflowTriggerCronRef" new_crons ON
+        new_crons."parentId" = $2::uuid
+        AND new_crons."method" = 'DEFAULT'
+        AND new_crons."cron" = deleted_crons."cron"
+        AND new_crons."name" IS NOT DISTINCT FROM deleted_crons."name"
+), moved_fires AS (
+    UPDATE v1_cron_fire
+    SET cron_id = replaced_crons.new_id
+    FROM replaced_crons
+    WHERE v1_cron_fire.cron_id = replaced_crons.old_id
+)
+DELETE FROM v1_cron_fire
+WHERE cron_id IN (SELECT "id" FROM deleted_crons)
+    AND cron_id NOT IN (SELECT old_id FROM replaced_crons)
 `
 
-func (q *Queries) DeleteOldDefaultCronTriggersForWorkflowVersion(ctx context.Context, db DBTX, oldworkflowversionid uuid.UUID) error {
-	_, err := db.Exec(ctx, deleteOldDefaultCronTriggersForWorkflowVersion, oldworkflowversionid)
+type DeleteOldDefaultCronTriggersForWorkflowVersionParams struct {
+	Oldworkflowversionid uuid.UUID `json:"oldworkflowversionid"`
+	Newworkflowtriggerid uuid.UUID `json:"newworkflowtriggerid"`
+}
+
+// Deletes the DEFAULT crons of the old workflow version. The fire history of each deleted cron is moved to the cron
+// which replaced it on the new workflow version, and is only deleted for crons which were removed from the workflow.
+func (q *Queries) DeleteOldDefaultCronTriggersForWorkflowVersion(ctx context.Context, db DBTX, arg DeleteOldDefaultCronTriggersForWorkflowVersionParams) error {
+	_, err := db.Exec(ctx, deleteOldDefaultCronTriggersForWorkflowVersion, arg.Oldworkflowversionid, arg.Newworkflowtriggerid)
 	return err
 }
 
@@ -1037,6 +1177,22 @@ func (q *Queries) GetLatestWorkflowVersionForWorkflows(ctx context.Context, db D
 	return items, nil
 }
 
+const getStepOutputSchema = `-- name: GetStepOutputSchema :one
+SELECT
+    output_schema
+FROM
+    v1_step_output_schema
+WHERE
+    step_id = $1::uuid
+`
+
+func (q *Queries) GetStepOutputSchema(ctx context.Context, db DBTX, stepid uuid.UUID) ([]byte, error) {
+	row := db.QueryRow(ctx, getStepOutputSchema, stepid)
+	var output_schema []byte
+	err := row.Scan(&output_schema)
+	return output_schema, err
+}
+
 const getStepsForJobs = `-- name: GetStepsForJobs :many
 SELECT
 	j."id" as "jobId",
@@ -1105,7 +1261,7 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 
 const getWorkflowById = `-- name: GetWorkflowById :one
 SELECT
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused", w."inputValidationMode",
     wv."id" as "workflowVersionId"
 FROM
     "Workflow" as w
@@ -1135,6 +1291,7 @@ func (q *Queries) GetWorkflowById(ctx context.Context, db DBTX, id uuid.UUID) (*
 		&i.Workflow.Name,
 		&i.Workflow.Description,
 		&i.Workflow.IsPaused,
+		&i.Workflow.InputValidationMode,
 		&i.WorkflowVersionId,
 	)
 	return &i, err
@@ -1142,7 +1299,7 @@ func (q *Queries) GetWorkflowById(ctx context.Context, db DBTX, id uuid.UUID) (*
 
 const getWorkflowByName = `-- name: GetWorkflowByName :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused"
+    id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused", "inputValidationMode"
 FROM
     "Workflow" as workflows
 WHERE
@@ -1168,6 +1325,7 @@ func (q *Queries) GetWorkflowByName(ctx context.Context, db DBTX, arg GetWorkflo
 		&i.Name,
 		&i.Description,
 		&i.IsPaused,
+		&i.InputValidationMode,
 	)
 	return &i, err
 }
@@ -1233,8 +1391,8 @@ func (q *Queries) GetWorkflowShape(ctx context.Context, db DBTX, workflowversion
 
 const getWorkflowVersionById = `-- name: GetWorkflowVersionById :one
 SELECT
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."createWorkflowVersionOpts", wv."inputJsonSchema",
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused"
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."createWorkflowVersionOpts", wv."inputJsonSchema", wv."canaryPercentage",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused", w."inputValidationMode"
 FROM
     "WorkflowVersion" as wv
 JOIN "Workflow" as w on w."id" = wv."workflowId"
@@ -1268,6 +1426,7 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id uuid.U
 		&i.WorkflowVersion.DefaultPriority,
 		&i.WorkflowVersion.CreateWorkflowVersionOpts,
 		&i.WorkflowVersion.InputJsonSchema,
+		&i.WorkflowVersion.CanaryPercentage,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1276,13 +1435,14 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id uuid.U
 		&i.Workflow.Name,
 		&i.Workflow.Description,
 		&i.Workflow.IsPaused,
+		&i.Workflow.InputValidationMode,
 	)
 	return &i, err
 }
 
 const getWorkflowVersionCronTriggerRefs = `-- name: GetWorkflowVersionCronTriggerRefs :many
 SELECT
-    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method, wtc.priority
+    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method, wtc.priority, wtc.timezone, wtc."misfirePolicy", wtc."misfireLimit", wtc."lastFiredAt", wtc."overlapPolicy"
 FROM
     "WorkflowTriggerCronRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1313,6 +1473,11 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 			&i.ID,
 			&i.Method,
 			&i.Priority,
+			&i.Timezone,
+			&i.MisfirePolicy,
+			&i.MisfireLimit,
+			&i.LastFiredAt,
+			&i.OverlapPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -1356,7 +1521,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts", workflowversions."inputJsonSchema",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts", workflowversions."inputJsonSchema", workflowversions."canaryPercentage",
     w."name" as "workflowName",
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1414,6 +1579,7 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.DefaultPriority,
 			&i.WorkflowVersion.CreateWorkflowVersionOpts,
 			&i.WorkflowVersion.InputJsonSchema,
+			&i.WorkflowVersion.CanaryPercentage,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1430,6 +1596,56 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 	return items, nil
 }
 
+const getWorkflowVersionIdByChecksum = `-- name: GetWorkflowVersionIdByChecksum :one
+SELECT
+    "id"
+FROM
+    "WorkflowVersion"
+WHERE
+    "workflowId" = $1::uuid AND
+    "checksum" = $2::text AND
+    "deletedAt" IS NULL
+ORDER BY "order" DESC
+LIMIT 1
+`
+
+type GetWorkflowVersionIdByChecksumParams struct {
+	Workflowid uuid.UUID `json:"workflowid"`
+	Checksum   string    `json:"checksum"`
+}
+
+func (q *Queries) GetWorkflowVersionIdByChecksum(ctx context.Context, db DBTX, arg GetWorkflowVersionIdByChecksumParams) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, getWorkflowVersionIdByChecksum, arg.Workflowid, arg.Checksum)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getWorkflowVersionIdByVersion = `-- name: GetWorkflowVersionIdByVersion :one
+SELECT
+    "id"
+FROM
+    "WorkflowVersion"
+WHERE
+    "workflowId" = $1::uuid AND
+    "version" = $2::text AND
+    "deletedAt" IS NULL
+ORDER BY "order" DESC
+LIMIT 1
+`
+
+type GetWorkflowVersionIdByVersionParams struct {
+	Workflowid uuid.UUID `json:"workflowid"`
+	Version    string    `json:"version"`
+}
+
+func (q *Queries) GetWorkflowVersionIdByVersion(ctx context.Context, db DBTX, arg GetWorkflowVersionIdByVersionParams) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, getWorkflowVersionIdByVersion, arg.Workflowid, arg.Version)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getWorkflowVersionScheduleTriggerRefs = `-- name: GetWorkflowVersionScheduleTriggerRefs :many
 SELECT
     wtc.id, wtc."parentId", wtc."triggerAt", wtc."tickerId", wtc.input, wtc."childIndex", wtc."childKey", wtc."parentStepRunId", wtc."parentWorkflowRunId", wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.method, wtc.priority
@@ -1480,7 +1696,7 @@ const linkOnFailureJob = `-- name: LinkOnFailureJob :one
 UPDATE "WorkflowVersion"
 SET "onFailureJobId" = $1::uuid
 WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "inputJsonSchema"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "inputJsonSchema", "canaryPercentage"
 `
 
 type LinkOnFailureJobParams struct {
@@ -1507,6 +1723,7 @@ func (q *Queries) LinkOnFailureJob(ctx context.Context, db DBTX, arg LinkOnFailu
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
 		&i.InputJsonSchema,
+		&i.CanaryPercentage,
 	)
 	return &i, err
 }
@@ -1897,7 +2114,7 @@ func (q *Queries) ListWorkflowNamesByIds(ctx context.Context, db DBTX, ids []uui
 
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT
-    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused"
+    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused", workflows."inputValidationMode"
 FROM
     "Workflow" as workflows
 WHERE
@@ -1952,6 +2169,7 @@ func (q *Queries) ListWorkflows(ctx context.Context, db DBTX, arg ListWorkflowsP
 			&i.Workflow.Name,
 			&i.Workflow.Description,
 			&i.Workflow.IsPaused,
+			&i.Workflow.InputValidationMode,
 		); err != nil {
 			return nil, err
 		}
@@ -2046,7 +2264,7 @@ SET
     "name" = "name" || '-' || gen_random_uuid(),
     "deletedAt" = CURRENT_TIMESTAMP
 WHERE "id" = $1::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused", "inputValidationMode"
 `
 
 func (q *Queries) SoftDeleteWorkflow(ctx context.Context, db DBTX, id uuid.UUID) (*Workflow, error) {
@@ -2061,6 +2279,7 @@ func (q *Queries) SoftDeleteWorkflow(ctx context.Context, db DBTX, id uuid.UUID)
 		&i.Name,
 		&i.Description,
 		&i.IsPaused,
+		&i.InputValidationMode,
 	)
 	return &i, err
 }
@@ -2068,7 +2287,12 @@ func (q *Queries) SoftDeleteWorkflow(ctx context.Context, db DBTX, id uuid.UUID)
 const updateCronTrigger = `-- name: UpdateCronTrigger :exec
 UPDATE "WorkflowTriggerCronRef"
 SET
-    "enabled" = COALESCE($1::BOOLEAN, "enabled")
+    "enabled" = COALESCE($1::BOOLEAN, "enabled"),
+    -- a cron which is enabled again resumes from now, so the runs it missed while it was disabled aren't fired
+    "lastFiredAt" = CASE
+        WHEN $1::BOOLEAN AND NOT "enabled" THEN NOW()
+        ELSE "lastFiredAt"
+    END
 WHERE "id" = $2::uuid
 `
 
@@ -2086,18 +2310,20 @@ const updateWorkflow = `-- name: UpdateWorkflow :one
 UPDATE "Workflow"
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
-    "isPaused" = coalesce($1::boolean, "isPaused")
-WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused"
+    "isPaused" = coalesce($1::boolean, "isPaused"),
+    "inputValidationMode" = coalesce($2::"WorkflowInputValidationMode", "inputValidationMode")
+WHERE "id" = $3::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused", "inputValidationMode"
 `
 
 type UpdateWorkflowParams struct {
-	IsPaused pgtype.Bool `json:"isPaused"`
-	ID       uuid.UUID   `json:"id"`
+	IsPaused            pgtype.Bool                     `json:"isPaused"`
+	InputValidationMode NullWorkflowInputValidationMode `json:"inputValidationMode"`
+	ID                  uuid.UUID                       `json:"id"`
 }
 
 func (q *Queries) UpdateWorkflow(ctx context.Context, db DBTX, arg UpdateWorkflowParams) (*Workflow, error) {
-	row := db.QueryRow(ctx, updateWorkflow, arg.IsPaused, arg.ID)
+	row := db.QueryRow(ctx, updateWorkflow, arg.IsPaused, arg.InputValidationMode, arg.ID)
 	var i Workflow
 	err := row.Scan(
 		&i.ID,
@@ -2108,6 +2334,7 @@ func (q *Queries) UpdateWorkflow(ctx context.Context, db DBTX, arg UpdateWorkflo
 		&i.Name,
 		&i.Description,
 		&i.IsPaused,
+		&i.InputValidationMode,
 	)
 	return &i, err
 }
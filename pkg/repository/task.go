@@ -182,6 +182,9 @@ type FailTaskOpts struct {
 
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool
+
+	// (optional) the type of the error reported by the worker, used to match the retry rules of the step
+	ErrorType string
 }
 
 type TaskIdEventKeyTuple struct {
@@ -218,6 +221,9 @@ type RetriedTask struct {
 	RetryBackoffFactor pgtype.Float8
 
 	RetryMaxBackoff pgtype.Int4
+
+	// the delay computed by the retry strategy of the step, if the step doesn't use exponential backoff
+	RetryDelaySeconds pgtype.Float8
 }
 
 type FailTasksResponse struct {
@@ -257,6 +263,10 @@ type TaskRepository interface {
 	// This is non-cacheable because tasks can be added to a workflow run as it executes.
 	FlattenExternalIds(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]*sqlcv1.FlattenExternalIdsRow, error)
 
+	// ValidateTaskOutput validates the output of a task against the output schema of its step, and returns an
+	// *ErrInvalidOutput if the output doesn't match the schema.
+	ValidateTaskOutput(ctx context.Context, stepId uuid.UUID, stepReadableId string, output []byte) error
+
 	CompleteTasks(ctx context.Context, tenantId uuid.UUID, tasks []CompleteTaskOpts) (*FinalizedTaskResponse, error)
 
 	FailTasks(ctx context.Context, tenantId uuid.UUID, tasks []FailTaskOpts) (*FailTasksResponse, error)
@@ -364,9 +374,13 @@ func (r *TaskRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 		}
 	}()
 
+	// partitions are kept until the longest retention period which can be set for a workflow has passed, runs with a
+	// shorter retention period are deleted by Cleanup
+	retentionPeriod := r.partitionRetentionPeriod(r.taskRetentionPeriod)
+
 	today := time.Now().UTC()
 	tomorrow := today.AddDate(0, 0, 1)
-	removeBefore := today.Add(-1 * r.taskRetentionPeriod)
+	removeBefore := today.Add(-1 * retentionPeriod)
 
 	// important: uses the ddlPool because these operations are critical (shouldn't be blocked by the main app pool)
 	// and not all can run inside of a transaction (e.g. DETACH PARTITION CONCURRENTLY),
@@ -427,10 +441,26 @@ func (r *TaskRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 	}
 
 	if len(partitions) > 0 {
-		r.l.Warn().Ctx(ctx).Msgf("removing partitions before %s using retention period of %s", removeBefore.Format(time.RFC3339), r.taskRetentionPeriod)
+		r.l.Warn().Ctx(ctx).Msgf("removing partitions before %s using retention period of %s", removeBefore.Format(time.RFC3339), retentionPeriod)
+	}
+
+	expiredPartitions := make([]ExpiredPartition, 0, len(partitions))
+
+	for _, partition := range partitions {
+		expiredPartitions = append(expiredPartitions, ExpiredPartition{
+			ParentTable:   partition.ParentTable,
+			PartitionName: partition.PartitionName,
+		})
 	}
 
+	// partitions are archived before any of them are detached, and partitions which fail to archive are kept
+	unarchivedPartitions := r.archiveExpiredPartitions(ctx, expiredPartitions)
+
 	for _, partition := range partitions {
+		if _, ok := unarchivedPartitions[partition.PartitionName]; ok {
+			continue
+		}
+
 		r.l.Debug().Ctx(ctx).Msgf("detaching partition %s", partition.PartitionName)
 
 		conn, release, err := sqlchelpers.AcquireConnectionWithStatementTimeout(ctx, r.ddlPool, r.l, 30*60*1000) // nolint:govet
@@ -851,6 +881,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 	appFailureTaskInsertedAts := make([]pgtype.Timestamptz, 0)
 	appFailureTaskRetryCounts := make([]int32, 0)
 	appFailureIsNonRetryableStatuses := make([]bool, 0)
+	appFailureErrorTypes := make([]string, 0)
 
 	internalFailureTaskIds := make([]int64, 0)
 	internalFailureInsertedAts := make([]pgtype.Timestamptz, 0)
@@ -865,6 +896,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 			appFailureTaskInsertedAts = append(appFailureTaskInsertedAts, failureOpt.InsertedAt)
 			appFailureTaskRetryCounts = append(appFailureTaskRetryCounts, failureOpt.RetryCount)
 			appFailureIsNonRetryableStatuses = append(appFailureIsNonRetryableStatuses, failureOpt.IsNonRetryable)
+			appFailureErrorTypes = append(appFailureErrorTypes, failureOpt.ErrorType)
 		} else {
 			internalFailureTaskIds = append(internalFailureTaskIds, failureOpt.Id)
 			internalFailureInsertedAts = append(internalFailureInsertedAts, failureOpt.InsertedAt)
@@ -890,6 +922,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 			Taskinsertedats: appFailureTaskInsertedAts,
 			Taskretrycounts: appFailureTaskRetryCounts,
 			Isnonretryables: appFailureIsNonRetryableStatuses,
+			Errortypes:      appFailureErrorTypes,
 		})
 
 		if err != nil {
@@ -907,6 +940,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 				AppRetryCount:      task.AppRetryCount,
 				RetryBackoffFactor: task.RetryBackoffFactor,
 				RetryMaxBackoff:    task.RetryMaxBackoff,
+				RetryDelaySeconds:  task.RetryDelaySeconds,
 			},
 			)
 		}
@@ -4124,6 +4158,47 @@ func (r *TaskRepositoryImpl) Cleanup(ctx context.Context) (bool, error) {
 		return nil
 	}))
 
+	// DeleteExpiredTasksByRetention and DeleteExpiredDagsByRetention, which are only needed when partitions are kept
+	// for longer than some runs should be, because of workflow retention policies
+	eg.Go(runCleanup("cleanup-v1-workflow-retention", func(ctx context.Context, tx sqlcv1.DBTX) error {
+		shortestRetentionPeriod, ok, err := r.shortestRetentionPeriod(ctx, r.taskRetentionPeriod)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+
+		result, err := r.queries.DeleteExpiredTasksByRetention(ctx, tx, sqlcv1.DeleteExpiredTasksByRetentionParams{
+			Shortestretentionperiod: sqlchelpers.DurationToPgInterval(shortestRetentionPeriod),
+			Defaultretentionperiod:  sqlchelpers.DurationToPgInterval(r.taskRetentionPeriod),
+			Batchsize:               batchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting expired tasks: %v", err)
+		}
+		if result.RowsAffected() == batchSize {
+			mu.Lock()
+			shouldContinue = true
+			mu.Unlock()
+		}
+
+		result, err = r.queries.DeleteExpiredDagsByRetention(ctx, tx, sqlcv1.DeleteExpiredDagsByRetentionParams{
+			Shortestretentionperiod: sqlchelpers.DurationToPgInterval(shortestRetentionPeriod),
+			Defaultretentionperiod:  sqlchelpers.DurationToPgInterval(r.taskRetentionPeriod),
+			Batchsize:               batchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting expired dags: %v", err)
+		}
+		if result.RowsAffected() == batchSize {
+			mu.Lock()
+			shouldContinue = true
+			mu.Unlock()
+		}
+		return nil
+	}))
+
 	// ReactivateInactiveQueuesWithItems
 	eg.Go(runCleanup("cleanup-reactivate-queues", func(ctx context.Context, tx sqlcv1.DBTX) error {
 		result, err := r.queries.ReactivateInactiveQueuesWithItems(ctx, tx)
@@ -57,6 +57,10 @@ type CreateTenantMemberOpts struct {
 
 type UpdateTenantMemberOpts struct {
 	Role *string `validate:"omitempty,oneof=OWNER ADMIN MEMBER"`
+
+	// (optional) the custom role of the member. This is only applied when the role is set, and the custom role is
+	// removed if it's nil.
+	CustomRoleId *uuid.UUID `validate:"omitempty"`
 }
 
 type GetQueueMetricsOpts struct {
@@ -507,6 +511,7 @@ func (r *tenantRepository) UpdateTenantMember(ctx context.Context, memberId uuid
 			TenantMemberRole: sqlcv1.TenantMemberRole(*opts.Role),
 			Valid:            true,
 		}
+		params.CustomRoleId = opts.CustomRoleId
 	}
 
 	updatedMember, err := r.queries.UpdateTenantMember(
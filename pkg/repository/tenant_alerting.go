@@ -33,6 +33,22 @@ type UpdateTenantAlertGroupOpts struct {
 	Emails []string `validate:"required,dive,email,max=255"`
 }
 
+type CreateTenantAlertSinkOpts struct {
+	Name string `validate:"required,max=255"`
+	Kind string `validate:"required,oneof=WEBHOOK TEAMS PAGERDUTY"`
+
+	// Config is the encrypted config of the sink
+	Config []byte `validate:"required"`
+}
+
+type CreateTenantAlertRuleOpts struct {
+	Name       string    `validate:"required,max=255"`
+	Kind       string    `validate:"required,oneof=QUEUE_DEPTH P95_DURATION FAILURE_RATE CRON_MISSED"`
+	WorkflowId uuid.UUID `validate:"required"`
+	Threshold  float64   `validate:"gte=0"`
+	Window     string    `validate:"required,duration"`
+}
+
 type TenantAlertEmailGroupForSend struct {
 	TenantId uuid.UUID `json:"tenantId"`
 	Emails   []string  `validate:"required,dive,email,max=255"`
@@ -45,6 +61,8 @@ type GetTenantAlertingSettingsResponse struct {
 
 	EmailGroups []*TenantAlertEmailGroupForSend
 
+	AlertSinks []*sqlcv1.TenantAlertSink
+
 	Tenant *sqlcv1.Tenant
 }
 
@@ -66,6 +84,26 @@ type TenantAlertingRepository interface {
 	GetTenantAlertGroupById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantAlertEmailGroup, error)
 
 	DeleteTenantAlertGroup(ctx context.Context, tenantId uuid.UUID, id uuid.UUID) error
+
+	CreateTenantAlertSink(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantAlertSinkOpts) (*sqlcv1.TenantAlertSink, error)
+
+	ListTenantAlertSinks(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantAlertSink, error)
+
+	GetTenantAlertSinkById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantAlertSink, error)
+
+	DeleteTenantAlertSink(ctx context.Context, tenantId uuid.UUID, id uuid.UUID) error
+
+	CreateTenantAlertRule(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantAlertRuleOpts) (*sqlcv1.TenantAlertRule, error)
+
+	ListTenantAlertRules(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantAlertRule, error)
+
+	GetTenantAlertRuleById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantAlertRule, error)
+
+	DeleteTenantAlertRule(ctx context.Context, tenantId uuid.UUID, id uuid.UUID) error
+
+	// UpdateTenantAlertRuleState records the result of evaluating a rule. The rule's lastFiredAt is set when it
+	// starts firing.
+	UpdateTenantAlertRuleState(ctx context.Context, id uuid.UUID, isFiring bool) error
 }
 
 type tenantAlertingRepository struct {
@@ -174,6 +212,107 @@ func (r *tenantAlertingRepository) DeleteTenantAlertGroup(ctx context.Context, t
 	)
 }
 
+func (r *tenantAlertingRepository) CreateTenantAlertSink(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantAlertSinkOpts) (*sqlcv1.TenantAlertSink, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.CreateTenantAlertSink(
+		ctx,
+		r.pool,
+		sqlcv1.CreateTenantAlertSinkParams{
+			Tenantid: tenantId,
+			Kind:     sqlcv1.TenantAlertSinkKind(opts.Kind),
+			Name:     opts.Name,
+			Config:   opts.Config,
+		},
+	)
+}
+
+func (r *tenantAlertingRepository) ListTenantAlertSinks(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantAlertSink, error) {
+	return r.queries.ListTenantAlertSinks(
+		ctx,
+		r.pool,
+		tenantId,
+	)
+}
+
+func (r *tenantAlertingRepository) GetTenantAlertSinkById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantAlertSink, error) {
+	return r.queries.GetTenantAlertSinkById(
+		ctx,
+		r.pool,
+		id,
+	)
+}
+
+func (r *tenantAlertingRepository) DeleteTenantAlertSink(ctx context.Context, tenantId uuid.UUID, id uuid.UUID) error {
+	return r.queries.DeleteTenantAlertSink(
+		ctx,
+		r.pool,
+		sqlcv1.DeleteTenantAlertSinkParams{
+			Tenantid: tenantId,
+			ID:       id,
+		},
+	)
+}
+
+func (r *tenantAlertingRepository) CreateTenantAlertRule(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantAlertRuleOpts) (*sqlcv1.TenantAlertRule, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.CreateTenantAlertRule(
+		ctx,
+		r.pool,
+		sqlcv1.CreateTenantAlertRuleParams{
+			Tenantid:   tenantId,
+			Workflowid: opts.WorkflowId,
+			Name:       opts.Name,
+			Kind:       sqlcv1.TenantAlertRuleKind(opts.Kind),
+			Threshold:  opts.Threshold,
+			Window:     opts.Window,
+		},
+	)
+}
+
+func (r *tenantAlertingRepository) ListTenantAlertRules(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantAlertRule, error) {
+	return r.queries.ListTenantAlertRules(
+		ctx,
+		r.pool,
+		tenantId,
+	)
+}
+
+func (r *tenantAlertingRepository) GetTenantAlertRuleById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantAlertRule, error) {
+	return r.queries.GetTenantAlertRuleById(
+		ctx,
+		r.pool,
+		id,
+	)
+}
+
+func (r *tenantAlertingRepository) DeleteTenantAlertRule(ctx context.Context, tenantId uuid.UUID, id uuid.UUID) error {
+	return r.queries.DeleteTenantAlertRule(
+		ctx,
+		r.pool,
+		sqlcv1.DeleteTenantAlertRuleParams{
+			Tenantid: tenantId,
+			ID:       id,
+		},
+	)
+}
+
+func (r *tenantAlertingRepository) UpdateTenantAlertRuleState(ctx context.Context, id uuid.UUID, isFiring bool) error {
+	return r.queries.UpdateTenantAlertRuleState(
+		ctx,
+		r.pool,
+		sqlcv1.UpdateTenantAlertRuleStateParams{
+			Isfiring: isFiring,
+			ID:       id,
+		},
+	)
+}
+
 func (r *tenantAlertingRepository) GetTenantAlertingSettings(ctx context.Context, tenantId uuid.UUID) (*GetTenantAlertingSettingsResponse, error) {
 	tx, err := r.pool.Begin(ctx)
 
@@ -212,6 +351,12 @@ func (r *tenantAlertingRepository) GetTenantAlertingSettings(ctx context.Context
 		})
 	}
 
+	sinks, err := r.queries.ListTenantAlertSinks(ctx, tx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
 	tenant, err := r.queries.GetTenantByID(ctx, tx, tenantId)
 
 	if err != nil {
@@ -245,6 +390,7 @@ func (r *tenantAlertingRepository) GetTenantAlertingSettings(ctx context.Context
 		Settings:      settings,
 		SlackWebhooks: webhooks,
 		EmailGroups:   groupsForSend,
+		AlertSinks:    sinks,
 		Tenant:        tenant,
 	}, nil
 }
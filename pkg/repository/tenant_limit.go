@@ -2,15 +2,18 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/hatchet-dev/hatchet/pkg/config/limits"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
 
@@ -25,6 +28,68 @@ type Limit struct {
 	Window   *time.Duration
 }
 
+// MinScopedResourceLimitWindow is the shortest window which usage of a scoped resource limit can be counted over
+const MinScopedResourceLimitWindow = time.Minute
+
+type UpsertScopedResourceLimitOpts struct {
+	// (required) the resource to limit. Only task runs can be limited per scope.
+	Resource sqlcv1.LimitResource `validate:"required,oneof=TASK_RUN"`
+
+	// (required) whether the limit applies to the runs of a workflow, or to the runs with an additional metadata key
+	// and value
+	Scope sqlcv1.V1ResourceLimitScope `validate:"required,oneof=WORKFLOW METADATA"`
+
+	// (optional) the workflow of a WORKFLOW limit
+	WorkflowId *uuid.UUID
+
+	// (optional) the additional metadata key of a METADATA limit
+	MetadataKey *string `validate:"omitnil,min=1,max=255"`
+
+	// (optional) the additional metadata value of a METADATA limit
+	MetadataValue *string `validate:"omitnil,max=255"`
+
+	// (required) the hard limit. Resources which would exceed it aren't created.
+	Limit int32 `validate:"gt=0"`
+
+	// (optional) the soft limit, which alerts the tenant once it's crossed
+	SoftLimit *int32 `validate:"omitnil,gt=0"`
+
+	// (required) the window which usage is counted over
+	Window time.Duration
+}
+
+// ScopedResourceUsage is the usage of a resource by a run, which is counted against the scoped limits matching the
+// workflow or the additional metadata of the run
+type ScopedResourceUsage struct {
+	WorkflowId         uuid.UUID
+	AdditionalMetadata []byte
+	Count              int32
+}
+
+// ScopedResourceExhaustedError is returned when creating resources would exceed the hard limit of a scoped resource
+// limit. It wraps ErrResourceExhausted.
+type ScopedResourceExhaustedError struct {
+	Limit *sqlcv1.V1ScopedResourceLimit
+
+	// Value is the usage of the limit in the current window
+	Value int32
+}
+
+func (e *ScopedResourceExhaustedError) Error() string {
+	return fmt.Sprintf(
+		"%s: %s limit for %s reached (%d/%d)",
+		ErrResourceExhausted,
+		e.Limit.Resource,
+		describeScopedResourceLimit(e.Limit),
+		e.Value,
+		e.Limit.LimitValue,
+	)
+}
+
+func (e *ScopedResourceExhaustedError) Unwrap() error {
+	return ErrResourceExhausted
+}
+
 type TenantLimitRepository interface {
 	GetLimits(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantResourceLimit, error)
 
@@ -41,6 +106,26 @@ type TenantLimitRepository interface {
 
 	Meter(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, numberOfResources int32) (precommit func() error, postcommit func())
 
+	// ListScopedLimits returns the scoped resource limits of the tenant with their usage, oldest first
+	ListScopedLimits(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1ScopedResourceLimit, error)
+
+	GetScopedLimitById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1ScopedResourceLimit, error)
+
+	// UpsertScopedLimit creates a scoped resource limit, or updates the limit of the tenant with the same resource and
+	// scope. Usage in the current window is kept.
+	UpsertScopedLimit(ctx context.Context, tenantId uuid.UUID, opts *UpsertScopedResourceLimitOpts) (*sqlcv1.V1ScopedResourceLimit, error)
+
+	DeleteScopedLimit(ctx context.Context, tenantId, id uuid.UUID) error
+
+	// CanCreateScoped returns a *ScopedResourceExhaustedError if the usage would exceed the hard limit of a scoped
+	// resource limit of the tenant
+	CanCreateScoped(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, usage []ScopedResourceUsage) error
+
+	// MeterScoped checks the usage against the scoped resource limits of the tenant before commit, and counts it
+	// against them after commit. Precommit returns the usage which would exceed a hard limit by index, which isn't
+	// counted after commit.
+	MeterScoped(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, usage []ScopedResourceUsage) (precommit func() (map[int]*ScopedResourceExhaustedError, error), postcommit func())
+
 	Stop()
 }
 
@@ -62,7 +147,12 @@ func newTenantLimitRepository(shared *sharedRepository, s limits.LimitConfigFile
 
 func (t *tenantLimitRepository) ResolveAllTenantResourceLimits(ctx context.Context) error {
 	_, err := t.queries.ResolveAllLimitsIfWindowPassed(ctx, t.pool)
-	return err
+
+	if err != nil {
+		return err
+	}
+
+	return t.queries.ResolveAllScopedResourceLimitsIfWindowPassed(ctx, t.pool)
 }
 
 // FIXME(mnafees): WE NEED TO GET RID OF CUSTOM VALUE METERS
@@ -324,6 +414,397 @@ func (t *tenantLimitRepository) UpdateLimits(ctx context.Context, tenantId uuid.
 
 var ErrResourceExhausted = fmt.Errorf("resource exhausted")
 
+func (t *tenantLimitRepository) ListScopedLimits(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1ScopedResourceLimit, error) {
+	return t.queries.ListScopedResourceLimits(ctx, t.pool, tenantId)
+}
+
+func (t *tenantLimitRepository) GetScopedLimitById(ctx context.Context, id uuid.UUID) (*sqlcv1.V1ScopedResourceLimit, error) {
+	return t.queries.GetScopedResourceLimitById(ctx, t.pool, id)
+}
+
+func (t *tenantLimitRepository) UpsertScopedLimit(ctx context.Context, tenantId uuid.UUID, opts *UpsertScopedResourceLimitOpts) (*sqlcv1.V1ScopedResourceLimit, error) {
+	if err := t.v.Validate(opts); err != nil {
+		return nil, fmt.Errorf("invalid opts: %w", err)
+	}
+
+	if err := ValidateScopedResourceLimitOpts(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.UpsertScopedResourceLimitParams{
+		Tenantid:    tenantId,
+		Resource:    opts.Resource,
+		Scope:       opts.Scope,
+		Limitvalue:  opts.Limit,
+		Limitwindow: sqlchelpers.DurationToPgInterval(opts.Window),
+	}
+
+	if opts.Scope == sqlcv1.V1ResourceLimitScopeWORKFLOW {
+		params.Workflowid = opts.WorkflowId
+	} else {
+		params.Metadatakey = sqlchelpers.TextFromStr(*opts.MetadataKey)
+		params.Metadatavalue = sqlchelpers.TextFromStr(*opts.MetadataValue)
+	}
+
+	if opts.SoftLimit != nil {
+		params.Softlimitvalue = pgtype.Int4{Int32: *opts.SoftLimit, Valid: true}
+	}
+
+	limit, err := t.queries.UpsertScopedResourceLimit(ctx, t.pool, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// clear the cached limits of the tenant, which are refreshed on other instances once they expire
+	t.c.Set(scopedLimitsCacheKey(tenantId), nil)
+
+	return limit, nil
+}
+
+func (t *tenantLimitRepository) DeleteScopedLimit(ctx context.Context, tenantId, id uuid.UUID) error {
+	err := t.queries.DeleteScopedResourceLimit(ctx, t.pool, sqlcv1.DeleteScopedResourceLimitParams{
+		Tenantid: tenantId,
+		ID:       id,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	t.c.Set(scopedLimitsCacheKey(tenantId), nil)
+
+	return nil
+}
+
+// ValidateScopedResourceLimitOpts validates the scope, thresholds and window of a scoped resource limit
+func ValidateScopedResourceLimitOpts(opts *UpsertScopedResourceLimitOpts) error {
+	if opts.Resource != sqlcv1.LimitResourceTASKRUN {
+		return fmt.Errorf("only TASK_RUN limits can be scoped")
+	}
+
+	switch opts.Scope {
+	case sqlcv1.V1ResourceLimitScopeWORKFLOW:
+		if opts.WorkflowId == nil {
+			return fmt.Errorf("workflow id is required for WORKFLOW limits")
+		}
+
+		if opts.MetadataKey != nil || opts.MetadataValue != nil {
+			return fmt.Errorf("metadata key and value can't be set for WORKFLOW limits")
+		}
+	case sqlcv1.V1ResourceLimitScopeMETADATA:
+		if opts.MetadataKey == nil || opts.MetadataValue == nil {
+			return fmt.Errorf("metadata key and value are required for METADATA limits")
+		}
+
+		if opts.WorkflowId != nil {
+			return fmt.Errorf("workflow id can't be set for METADATA limits")
+		}
+	default:
+		return fmt.Errorf("scope must be WORKFLOW or METADATA")
+	}
+
+	if opts.SoftLimit != nil && *opts.SoftLimit >= opts.Limit {
+		return fmt.Errorf("soft limit must be lower than the limit")
+	}
+
+	if opts.Window < MinScopedResourceLimitWindow {
+		return fmt.Errorf("window must be at least %s", MinScopedResourceLimitWindow)
+	}
+
+	return nil
+}
+
+func scopedLimitsCacheKey(tenantId uuid.UUID) string {
+	return fmt.Sprintf("scoped-limits:%s", tenantId)
+}
+
+// cachedListScopedLimits lists the scoped resource limits of the tenant from the cache. The usage of cached limits is
+// stale, so it's only used to match the limits against usage.
+func (t *tenantLimitRepository) cachedListScopedLimits(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1ScopedResourceLimit, error) {
+	key := scopedLimitsCacheKey(tenantId)
+
+	if hit, ok := t.c.Get(key); ok {
+		if limits, ok := hit.([]*sqlcv1.V1ScopedResourceLimit); ok {
+			return limits, nil
+		}
+	}
+
+	limits, err := t.queries.ListScopedResourceLimits(ctx, t.pool, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list scoped resource limits: %w", err)
+	}
+
+	t.c.Set(key, limits)
+
+	return limits, nil
+}
+
+// matchScopedLimits returns the scoped resource limits of the resource which match the usage, along with the usage
+// counted against each of them
+func (t *tenantLimitRepository) matchScopedLimits(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, usage []ScopedResourceUsage) ([]*sqlcv1.V1ScopedResourceLimit, []int32, error) {
+	if len(usage) == 0 {
+		return nil, nil, nil
+	}
+
+	limits, err := t.cachedListScopedLimits(ctx, tenantId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matched := make([]*sqlcv1.V1ScopedResourceLimit, 0)
+	amounts := make([]int32, 0)
+
+	for _, limit := range limits {
+		if limit.Resource != resource {
+			continue
+		}
+
+		var amount int32
+
+		for _, u := range usage {
+			if matchesScopedResourceLimit(limit, u) {
+				amount += u.Count
+			}
+		}
+
+		if amount > 0 {
+			matched = append(matched, limit)
+			amounts = append(amounts, amount)
+		}
+	}
+
+	return matched, amounts, nil
+}
+
+// matchesScopedResourceLimit returns whether the usage counts against the limit. Additional metadata values which
+// aren't strings are matched against their JSON encoding, so a value of 42 matches "42".
+func matchesScopedResourceLimit(limit *sqlcv1.V1ScopedResourceLimit, usage ScopedResourceUsage) bool {
+	switch limit.Scope {
+	case sqlcv1.V1ResourceLimitScopeWORKFLOW:
+		return limit.WorkflowID != nil && *limit.WorkflowID == usage.WorkflowId
+	case sqlcv1.V1ResourceLimitScopeMETADATA:
+		if len(usage.AdditionalMetadata) == 0 {
+			return false
+		}
+
+		metadata := make(map[string]json.RawMessage)
+
+		if err := json.Unmarshal(usage.AdditionalMetadata, &metadata); err != nil {
+			return false
+		}
+
+		raw, ok := metadata[limit.MetadataKey.String]
+
+		if !ok {
+			return false
+		}
+
+		var value string
+
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+
+		return value == limit.MetadataValue.String
+	}
+
+	return false
+}
+
+func describeScopedResourceLimit(limit *sqlcv1.V1ScopedResourceLimit) string {
+	if limit.Scope == sqlcv1.V1ResourceLimitScopeWORKFLOW && limit.WorkflowID != nil {
+		return fmt.Sprintf("workflow %s", limit.WorkflowID)
+	}
+
+	return fmt.Sprintf("metadata %s=%s", limit.MetadataKey.String, limit.MetadataValue.String)
+}
+
+// listScopedLimitUsage returns the current usage of the limits by id. Limits which were deleted since they were cached
+// are omitted.
+func (t *tenantLimitRepository) listScopedLimitUsage(ctx context.Context, tenantId uuid.UUID, limits []*sqlcv1.V1ScopedResourceLimit) (map[uuid.UUID]*sqlcv1.ListScopedResourceLimitUsageRow, error) {
+	ids := make([]uuid.UUID, len(limits))
+
+	for i, limit := range limits {
+		ids[i] = limit.ID
+	}
+
+	rows, err := t.queries.ListScopedResourceLimitUsage(ctx, t.pool, sqlcv1.ListScopedResourceLimitUsageParams{
+		Tenantid: tenantId,
+		Ids:      ids,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list scoped resource limit usage: %w", err)
+	}
+
+	idsToUsage := make(map[uuid.UUID]*sqlcv1.ListScopedResourceLimitUsageRow, len(rows))
+
+	for _, row := range rows {
+		idsToUsage[row.ID] = row
+	}
+
+	return idsToUsage, nil
+}
+
+func newScopedResourceExhaustedError(limit *sqlcv1.V1ScopedResourceLimit, row *sqlcv1.ListScopedResourceLimitUsageRow, value int32) *ScopedResourceExhaustedError {
+	exhausted := *limit
+	exhausted.LimitValue = row.LimitValue
+
+	return &ScopedResourceExhaustedError{
+		Limit: &exhausted,
+		Value: value,
+	}
+}
+
+func (t *tenantLimitRepository) CanCreateScoped(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, usage []ScopedResourceUsage) error {
+	limits, amounts, err := t.matchScopedLimits(ctx, resource, tenantId, usage)
+
+	if err != nil {
+		return err
+	}
+
+	if len(limits) == 0 {
+		return nil
+	}
+
+	idsToUsage, err := t.listScopedLimitUsage(ctx, tenantId, limits)
+
+	if err != nil {
+		return err
+	}
+
+	for i, limit := range limits {
+		// the limit was deleted since it was cached
+		row, ok := idsToUsage[limit.ID]
+
+		if !ok {
+			continue
+		}
+
+		if row.Value+amounts[i] > row.LimitValue {
+			return newScopedResourceExhaustedError(limit, row, row.Value)
+		}
+	}
+
+	return nil
+}
+
+// exhaustedScopedUsage returns the usage which would exceed the hard limit of one of the limits, by index. Usage is
+// admitted in order, so earlier usage takes precedence over later usage counted against the same limit.
+func exhaustedScopedUsage(
+	limits []*sqlcv1.V1ScopedResourceLimit,
+	idsToUsage map[uuid.UUID]*sqlcv1.ListScopedResourceLimitUsageRow,
+	usage []ScopedResourceUsage,
+) map[int]*ScopedResourceExhaustedError {
+	values := make(map[uuid.UUID]int32, len(idsToUsage))
+
+	for id, row := range idsToUsage {
+		values[id] = row.Value
+	}
+
+	exhausted := make(map[int]*ScopedResourceExhaustedError)
+
+	for i, u := range usage {
+		matched := make([]uuid.UUID, 0)
+
+		for _, limit := range limits {
+			if !matchesScopedResourceLimit(limit, u) {
+				continue
+			}
+
+			// the limit was deleted since it was cached
+			row, ok := idsToUsage[limit.ID]
+
+			if !ok {
+				continue
+			}
+
+			if values[limit.ID]+u.Count > row.LimitValue {
+				exhausted[i] = newScopedResourceExhaustedError(limit, row, values[limit.ID])
+				break
+			}
+
+			matched = append(matched, limit.ID)
+		}
+
+		if _, ok := exhausted[i]; ok {
+			continue
+		}
+
+		for _, id := range matched {
+			values[id] += u.Count
+		}
+	}
+
+	return exhausted
+}
+
+func (t *tenantLimitRepository) MeterScoped(ctx context.Context, resource sqlcv1.LimitResource, tenantId uuid.UUID, usage []ScopedResourceUsage) (precommit func() (map[int]*ScopedResourceExhaustedError, error), postcommit func()) {
+	admitted := usage
+
+	return func() (map[int]*ScopedResourceExhaustedError, error) {
+			limits, _, err := t.matchScopedLimits(ctx, resource, tenantId, usage)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(limits) == 0 {
+				return nil, nil
+			}
+
+			idsToUsage, err := t.listScopedLimitUsage(ctx, tenantId, limits)
+
+			if err != nil {
+				return nil, err
+			}
+
+			exhausted := exhaustedScopedUsage(limits, idsToUsage, usage)
+
+			if len(exhausted) > 0 {
+				admitted = make([]ScopedResourceUsage, 0, len(usage)-len(exhausted))
+
+				for i, u := range usage {
+					if _, ok := exhausted[i]; !ok {
+						admitted = append(admitted, u)
+					}
+				}
+			}
+
+			return exhausted, nil
+		}, func() {
+			limits, amounts, err := t.matchScopedLimits(ctx, resource, tenantId, admitted)
+
+			if err != nil {
+				t.l.Error().Ctx(ctx).Err(err).Msg("could not match scoped resource limits")
+				return
+			}
+
+			if len(limits) == 0 {
+				return
+			}
+
+			ids := make([]uuid.UUID, len(limits))
+
+			for i, limit := range limits {
+				ids[i] = limit.ID
+			}
+
+			err = t.queries.MeterScopedResourceLimits(ctx, t.pool, sqlcv1.MeterScopedResourceLimitsParams{
+				Ids:      ids,
+				Amounts:  amounts,
+				Tenantid: tenantId,
+			})
+
+			if err != nil {
+				t.l.Error().Ctx(ctx).Err(err).Msg("could not meter scoped resource limits")
+			}
+		}
+}
+
 func (t *tenantLimitRepository) Stop() {
 	t.c.Stop()
 }
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ErrTenantRoleInUse is returned when deleting a custom role which is still assigned to tenant members.
+var ErrTenantRoleInUse = errors.New("tenant role is assigned to one or more members")
+
+type CreateTenantRoleOpts struct {
+	// (required) the name of the role, unique within the tenant
+	Name string `validate:"required,hatchetName,max=255"`
+
+	// (optional) a description of the role
+	Description *string `validate:"omitempty,max=1024"`
+
+	// (optional) the built-in roles which this role inherits permissions from
+	Inherits []string `validate:"omitempty,dive,required"`
+
+	// (optional) the operations which this role is permitted to perform
+	Permissions []string `validate:"omitempty,dive,required"`
+}
+
+type UpdateTenantRoleOpts struct {
+	// (optional) a description of the role
+	Description *string `validate:"omitempty,max=1024"`
+
+	// (optional) the built-in roles which this role inherits permissions from. If nil, the inherited roles
+	// are not changed.
+	Inherits []string `validate:"omitempty,dive,required"`
+
+	// (optional) the operations which this role is permitted to perform. If nil, the permissions are not
+	// changed.
+	Permissions []string `validate:"omitempty,dive,required"`
+}
+
+type TenantRoleRepository interface {
+	// CreateTenantRole creates a new custom role in the tenant
+	CreateTenantRole(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantRoleOpts) (*sqlcv1.TenantRole, error)
+
+	// GetTenantRoleById returns the custom role with the given id
+	GetTenantRoleById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantRole, error)
+
+	// ListTenantRoles returns the custom roles of the tenant, ordered by name
+	ListTenantRoles(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantRole, error)
+
+	// UpdateTenantRole updates the custom role with the given id
+	UpdateTenantRole(ctx context.Context, id uuid.UUID, opts *UpdateTenantRoleOpts) (*sqlcv1.TenantRole, error)
+
+	// DeleteTenantRole deletes the custom role with the given id. It returns ErrTenantRoleInUse if the role
+	// is still assigned to tenant members.
+	DeleteTenantRole(ctx context.Context, id uuid.UUID) error
+}
+
+type tenantRoleRepository struct {
+	*sharedRepository
+}
+
+func newTenantRoleRepository(shared *sharedRepository) TenantRoleRepository {
+	return &tenantRoleRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *tenantRoleRepository) CreateTenantRole(ctx context.Context, tenantId uuid.UUID, opts *CreateTenantRoleOpts) (*sqlcv1.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.CreateTenantRoleParams{
+		Tenantid:    tenantId,
+		Name:        opts.Name,
+		Inherits:    opts.Inherits,
+		Permissions: opts.Permissions,
+	}
+
+	if params.Inherits == nil {
+		params.Inherits = []string{}
+	}
+
+	if params.Permissions == nil {
+		params.Permissions = []string{}
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	return r.queries.CreateTenantRole(
+		ctx,
+		r.pool,
+		params,
+	)
+}
+
+func (r *tenantRoleRepository) GetTenantRoleById(ctx context.Context, id uuid.UUID) (*sqlcv1.TenantRole, error) {
+	return r.queries.GetTenantRoleById(
+		ctx,
+		r.pool,
+		id,
+	)
+}
+
+func (r *tenantRoleRepository) ListTenantRoles(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.TenantRole, error) {
+	return r.queries.ListTenantRoles(
+		ctx,
+		r.pool,
+		tenantId,
+	)
+}
+
+func (r *tenantRoleRepository) UpdateTenantRole(ctx context.Context, id uuid.UUID, opts *UpdateTenantRoleOpts) (*sqlcv1.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.UpdateTenantRoleParams{
+		ID:          id,
+		Inherits:    opts.Inherits,
+		Permissions: opts.Permissions,
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	return r.queries.UpdateTenantRole(
+		ctx,
+		r.pool,
+		params,
+	)
+}
+
+func (r *tenantRoleRepository) DeleteTenantRole(ctx context.Context, id uuid.UUID) error {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	members, err := r.queries.CountTenantRoleMembers(ctx, tx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if members > 0 {
+		return ErrTenantRoleInUse
+	}
+
+	if err := r.queries.DeleteTenantRole(ctx, tx, id); err != nil {
+		return err
+	}
+
+	return commit(ctx)
+}
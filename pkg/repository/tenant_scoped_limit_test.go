@@ -0,0 +1,171 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestMatchesScopedResourceLimit_Workflow(t *testing.T) {
+	workflowId := uuid.New()
+
+	limit := &sqlcv1.V1ScopedResourceLimit{
+		Scope:      sqlcv1.V1ResourceLimitScopeWORKFLOW,
+		WorkflowID: &workflowId,
+	}
+
+	assert.True(t, matchesScopedResourceLimit(limit, ScopedResourceUsage{WorkflowId: workflowId, Count: 1}))
+	assert.False(t, matchesScopedResourceLimit(limit, ScopedResourceUsage{WorkflowId: uuid.New(), Count: 1}))
+}
+
+func TestMatchesScopedResourceLimit_Metadata(t *testing.T) {
+	limit := &sqlcv1.V1ScopedResourceLimit{
+		Scope:         sqlcv1.V1ResourceLimitScopeMETADATA,
+		MetadataKey:   sqlchelpers.TextFromStr("team"),
+		MetadataValue: sqlchelpers.TextFromStr("payments"),
+	}
+
+	matches := func(metadata string) bool {
+		return matchesScopedResourceLimit(limit, ScopedResourceUsage{WorkflowId: uuid.New(), AdditionalMetadata: []byte(metadata), Count: 1})
+	}
+
+	assert.True(t, matches(`{"team": "payments", "env": "prod"}`))
+	assert.False(t, matches(`{"team": "search"}`))
+	assert.False(t, matches(`{"env": "payments"}`))
+	assert.False(t, matches(""))
+	assert.False(t, matches("not json"))
+
+	// values which aren't strings are matched against their JSON encoding
+	limit.MetadataKey = sqlchelpers.TextFromStr("customer")
+	limit.MetadataValue = sqlchelpers.TextFromStr("42")
+
+	assert.True(t, matches(`{"customer": 42}`))
+	assert.True(t, matches(`{"customer": "42"}`))
+}
+
+func TestValidateScopedResourceLimitOpts(t *testing.T) {
+	workflowId := uuid.New()
+	key, value := "team", "payments"
+	soft, high := int32(80), int32(100)
+
+	valid := func() *UpsertScopedResourceLimitOpts {
+		return &UpsertScopedResourceLimitOpts{
+			Resource:   sqlcv1.LimitResourceTASKRUN,
+			Scope:      sqlcv1.V1ResourceLimitScopeWORKFLOW,
+			WorkflowId: &workflowId,
+			Limit:      100,
+			SoftLimit:  &soft,
+			Window:     24 * time.Hour,
+		}
+	}
+
+	assert.NoError(t, ValidateScopedResourceLimitOpts(valid()))
+
+	metadata := valid()
+	metadata.Scope = sqlcv1.V1ResourceLimitScopeMETADATA
+	metadata.WorkflowId = nil
+	metadata.MetadataKey = &key
+	metadata.MetadataValue = &value
+
+	assert.NoError(t, ValidateScopedResourceLimitOpts(metadata))
+
+	for name, mutate := range map[string]func(*UpsertScopedResourceLimitOpts){
+		"unscoped resource":         func(o *UpsertScopedResourceLimitOpts) { o.Resource = sqlcv1.LimitResourceEVENT },
+		"missing workflow":          func(o *UpsertScopedResourceLimitOpts) { o.WorkflowId = nil },
+		"metadata on workflow":      func(o *UpsertScopedResourceLimitOpts) { o.MetadataKey = &key },
+		"missing metadata":          func(o *UpsertScopedResourceLimitOpts) { o.Scope = sqlcv1.V1ResourceLimitScopeMETADATA },
+		"soft limit equal to limit": func(o *UpsertScopedResourceLimitOpts) { o.SoftLimit = &high },
+		"short window":              func(o *UpsertScopedResourceLimitOpts) { o.Window = 30 * time.Second },
+	} {
+		opts := valid()
+		mutate(opts)
+
+		assert.Error(t, ValidateScopedResourceLimitOpts(opts), name)
+	}
+}
+
+func TestScopedResourceExhaustedError(t *testing.T) {
+	limit := &sqlcv1.V1ScopedResourceLimit{
+		Resource:      sqlcv1.LimitResourceTASKRUN,
+		Scope:         sqlcv1.V1ResourceLimitScopeMETADATA,
+		MetadataKey:   sqlchelpers.TextFromStr("team"),
+		MetadataValue: sqlchelpers.TextFromStr("payments"),
+		LimitValue:    100,
+	}
+
+	var err error = &ScopedResourceExhaustedError{Limit: limit, Value: 100}
+
+	assert.ErrorIs(t, err, ErrResourceExhausted)
+	assert.Equal(t, "resource exhausted: TASK_RUN limit for metadata team=payments reached (100/100)", err.Error())
+
+	var scopedErr *ScopedResourceExhaustedError
+	assert.True(t, errors.As(err, &scopedErr))
+}
+
+func TestExhaustedScopedUsage(t *testing.T) {
+	workflowId, otherWorkflowId := uuid.New(), uuid.New()
+
+	workflowLimit := &sqlcv1.V1ScopedResourceLimit{
+		ID:         uuid.New(),
+		Resource:   sqlcv1.LimitResourceTASKRUN,
+		Scope:      sqlcv1.V1ResourceLimitScopeWORKFLOW,
+		WorkflowID: &workflowId,
+	}
+
+	metadataLimit := &sqlcv1.V1ScopedResourceLimit{
+		ID:            uuid.New(),
+		Resource:      sqlcv1.LimitResourceTASKRUN,
+		Scope:         sqlcv1.V1ResourceLimitScopeMETADATA,
+		MetadataKey:   sqlchelpers.TextFromStr("team"),
+		MetadataValue: sqlchelpers.TextFromStr("payments"),
+	}
+
+	idsToUsage := map[uuid.UUID]*sqlcv1.ListScopedResourceLimitUsageRow{
+		workflowLimit.ID: {ID: workflowLimit.ID, LimitValue: 10, Value: 7},
+		metadataLimit.ID: {ID: metadataLimit.ID, LimitValue: 5, Value: 0},
+	}
+
+	payments := []byte(`{"team": "payments"}`)
+
+	exhausted := exhaustedScopedUsage(
+		[]*sqlcv1.V1ScopedResourceLimit{workflowLimit, metadataLimit},
+		idsToUsage,
+		[]ScopedResourceUsage{
+			{WorkflowId: workflowId, Count: 2},
+			// exceeds the workflow limit, which has 1 run left
+			{WorkflowId: workflowId, Count: 2},
+			{WorkflowId: workflowId, Count: 1},
+			// doesn't match any limit
+			{WorkflowId: otherWorkflowId, Count: 100},
+			// the workflow limit is used up, so this isn't counted against the metadata limit either
+			{WorkflowId: workflowId, AdditionalMetadata: payments, Count: 1},
+			{WorkflowId: otherWorkflowId, AdditionalMetadata: payments, Count: 5},
+			{WorkflowId: otherWorkflowId, AdditionalMetadata: payments, Count: 1},
+		},
+	)
+
+	assert.Len(t, exhausted, 3)
+
+	for _, i := range []int{1, 4} {
+		if assert.Contains(t, exhausted, i) {
+			assert.Equal(t, workflowLimit.ID, exhausted[i].Limit.ID)
+			assert.Equal(t, int32(10), exhausted[i].Limit.LimitValue)
+		}
+	}
+
+	assert.Equal(t, int32(9), exhausted[1].Value)
+	assert.Equal(t, int32(10), exhausted[4].Value)
+
+	if assert.Contains(t, exhausted, 6) {
+		assert.Equal(t, metadataLimit.ID, exhausted[6].Limit.ID)
+		assert.Equal(t, int32(5), exhausted[6].Value)
+	}
+}
@@ -54,6 +54,9 @@ type TickerRepository interface {
 	// PollCronSchedules returns all cron schedules which should be managed by the ticker
 	PollCronSchedules(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollCronSchedulesRow, error)
 
+	// UpdateCronLastFiredAt records that a cron schedule fired at the given time, used to compute missed runs
+	UpdateCronLastFiredAt(ctx context.Context, cronId uuid.UUID, firedAt time.Time) error
+
 	PollScheduledWorkflows(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollScheduledWorkflowsRow, error)
 
 	PollTenantAlerts(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollTenantAlertsRow, error)
@@ -61,6 +64,8 @@ type TickerRepository interface {
 	PollExpiringTokens(ctx context.Context) ([]*sqlcv1.PollExpiringTokensRow, error)
 
 	PollTenantResourceLimitAlerts(ctx context.Context) ([]*sqlcv1.TenantResourceLimitAlert, error)
+
+	PollScopedResourceLimitAlerts(ctx context.Context) ([]*sqlcv1.PollScopedResourceLimitAlertsRow, error)
 }
 
 type tickerRepository struct {
@@ -146,6 +151,13 @@ func (t *tickerRepository) PollCronSchedules(ctx context.Context, tickerId uuid.
 	return t.queries.PollCronSchedules(ctx, t.pool, tickerId)
 }
 
+func (t *tickerRepository) UpdateCronLastFiredAt(ctx context.Context, cronId uuid.UUID, firedAt time.Time) error {
+	return t.queries.UpdateCronLastFiredAt(ctx, t.pool, sqlcv1.UpdateCronLastFiredAtParams{
+		ID:      cronId,
+		Firedat: sqlchelpers.TimestampFromTime(firedAt.UTC()),
+	})
+}
+
 func (t *tickerRepository) PollScheduledWorkflows(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollScheduledWorkflowsRow, error) {
 	return t.queries.PollScheduledWorkflows(ctx, t.pool, tickerId)
 }
@@ -161,3 +173,7 @@ func (t *tickerRepository) PollExpiringTokens(ctx context.Context) ([]*sqlcv1.Po
 func (t *tickerRepository) PollTenantResourceLimitAlerts(ctx context.Context) ([]*sqlcv1.TenantResourceLimitAlert, error) {
 	return t.queries.PollTenantResourceLimitAlerts(ctx, t.pool)
 }
+
+func (t *tickerRepository) PollScopedResourceLimitAlerts(ctx context.Context) ([]*sqlcv1.PollScopedResourceLimitAlertsRow, error) {
+	return t.queries.PollScopedResourceLimitAlerts(ctx, t.pool)
+}
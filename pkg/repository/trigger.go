@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
 	"slices"
 	"strings"
 	"time"
@@ -73,6 +74,10 @@ type TriggerTaskData struct {
 
 	// (optional) overrides for desired worker labels for the task, used for routing a task to a specific worker (or worker pool)
 	DesiredWorkerLabels []*sqlcv1.GetDesiredLabelsRow `json:"desired_worker_labels"`
+
+	// (optional) the version of the workflow to run. if not set, the run uses the latest version of the workflow,
+	// or is split between versions if the latest version is a canary.
+	Version *string `json:"version,omitempty"`
 }
 
 func ProtoToDesiredWorkerLabel(key string, strValue *string, intValue *int32, required *bool, weight *int32, comparator *string) *sqlcv1.GetDesiredLabelsRow {
@@ -145,6 +150,16 @@ type TriggerRepository interface {
 
 	PreflightVerifyWorkflowNameOpts(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) error
 
+	// PreflightCheckScopedLimits returns a *ScopedResourceExhaustedError if a scoped resource limit of the tenant has
+	// no task runs left for the workflow runs. Each workflow run is counted as a single task run, as the number of
+	// tasks is only known once the runs are triggered.
+	PreflightCheckScopedLimits(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) error
+
+	// ValidateWorkflowNameOptsInput validates the input of each workflow run against the input schema of its workflow.
+	// It returns an *ErrInvalidInput for the first invalid input of a workflow which rejects invalid input, and
+	// otherwise returns a CEL evaluation failure for each invalid input which should be recorded.
+	ValidateWorkflowNameOptsInput(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) ([]CELEvaluationFailure, error)
+
 	NewTriggerTaskData(ctx context.Context, tenantId uuid.UUID, req *v1contracts.TriggerWorkflowRequest, parentTask *sqlcv1.FlattenExternalIdsRow) (*TriggerTaskData, error)
 }
 
@@ -312,12 +327,14 @@ func (r *sharedRepository) doTriggerFromEvents(
 		return nil, fmt.Errorf("failed to prepare trigger from events: %w", err)
 	}
 
-	tasks, dags, err := r.triggerWorkflows(ctx, tx, tenantId, triggerOpts, createCoreEventOpts)
+	tasks, dags, scopedLimitFailures, err := r.triggerWorkflows(ctx, tx, tenantId, triggerOpts, createCoreEventOpts)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to trigger workflows: %w", err)
 	}
 
+	celEvaluationFailures = append(celEvaluationFailures, scopedLimitFailures...)
+
 	eventExternalIdToRuns := getEventExternalIdToRuns(opts, externalIdToEventIdAndFilterId, tasks, dags)
 
 	return &TriggerFromEventsResult{
@@ -379,7 +396,24 @@ func (s *sharedRepository) triggerFromWorkflowNames(ctx context.Context, tx *Opt
 		return nil, nil, fmt.Errorf("failed to prepare trigger from workflow names: %w", err)
 	}
 
-	return s.triggerWorkflows(ctx, tx, tenantId, triggerOpts, nil)
+	tasks, dags, scopedLimitFailures, err := s.triggerWorkflows(ctx, tx, tenantId, triggerOpts, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.logScopedLimitFailures(ctx, tenantId, scopedLimitFailures)
+
+	return tasks, dags, nil
+}
+
+// logScopedLimitFailures logs the runs which weren't triggered because they would exceed a scoped resource limit, for
+// callers which don't record CEL evaluation failures. Direct triggers are checked against scoped limits before they're
+// accepted, so these are only skipped when the limit is reached in the meantime.
+func (s *sharedRepository) logScopedLimitFailures(ctx context.Context, tenantId uuid.UUID, failures []CELEvaluationFailure) {
+	for _, failure := range failures {
+		s.l.Warn().Ctx(ctx).Str("tenantId", tenantId.String()).Msg(failure.ErrorMessage)
+	}
 }
 
 func (r *TriggerRepositoryImpl) TriggerFromWorkflowNames(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) ([]*V1TaskWithPayload, []*DAGWithData, error) {
@@ -444,6 +478,28 @@ func (r *TriggerRepositoryImpl) PreflightVerifyWorkflowNameOpts(ctx context.Cont
 		}
 	}
 
+	// pinned versions must exist as well, reported as name@version
+	pinnedVersions, err := r.listWorkflowVersionsByVersions(ctx, r.pool, tenantId, opts)
+
+	if err != nil {
+		return fmt.Errorf("failed to list workflow versions: %w", err)
+	}
+
+	for _, opt := range opts {
+		if !isPinnedToVersion(opt) || !workflowNamesFound[opt.WorkflowName] {
+			continue
+		}
+
+		key := workflowNameAndVersion{name: opt.WorkflowName, version: *opt.Version}
+
+		if _, ok := pinnedVersions[key]; !ok {
+			workflowNamesNotFound = append(workflowNamesNotFound, key.String())
+
+			// only report each missing version once
+			pinnedVersions[key] = nil
+		}
+	}
+
 	if len(workflowNamesNotFound) > 0 {
 		return &ErrNamesNotFound{
 			Names: workflowNamesNotFound,
@@ -453,6 +509,44 @@ func (r *TriggerRepositoryImpl) PreflightVerifyWorkflowNameOpts(ctx context.Cont
 	return nil
 }
 
+func (r *TriggerRepositoryImpl) PreflightCheckScopedLimits(ctx context.Context, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) error {
+	uniqueWorkflowNames := make(map[string]struct{})
+
+	for _, opt := range opts {
+		uniqueWorkflowNames[opt.WorkflowName] = struct{}{}
+	}
+
+	workflowNames := make([]string, 0, len(uniqueWorkflowNames))
+
+	for name := range uniqueWorkflowNames {
+		workflowNames = append(workflowNames, name)
+	}
+
+	rows, err := r.listWorkflowsByNames(ctx, r.pool, tenantId, workflowNames)
+
+	if err != nil {
+		return fmt.Errorf("failed to list workflows by names: %w", err)
+	}
+
+	workflowNamesToIds := make(map[string]uuid.UUID, len(rows))
+
+	for _, row := range rows {
+		workflowNamesToIds[row.WorkflowName] = row.WorkflowId
+	}
+
+	usage := make([]ScopedResourceUsage, 0, len(opts))
+
+	for _, opt := range opts {
+		usage = append(usage, ScopedResourceUsage{
+			WorkflowId:         workflowNamesToIds[opt.WorkflowName],
+			AdditionalMetadata: opt.AdditionalMetadata,
+			Count:              1,
+		})
+	}
+
+	return r.m.CanCreateScoped(ctx, sqlcv1.LimitResourceTASKRUN, tenantId, usage)
+}
+
 type TriggeredBy interface {
 	ToMetadata([]byte) []byte
 }
@@ -559,6 +653,9 @@ func (t *TriggeredByEvent) ToMetadata(additionalMetadata []byte) []byte {
 	if v, ok := origMeta["hatchet__source_step_run_id"]; ok {
 		res["hatchet__source_step_run_id"] = v
 	}
+	if v, ok := origMeta[constants.ReplayedEventIdKey.String()]; ok {
+		res[constants.ReplayedEventIdKey.String()] = v
+	}
 
 	resBytes, err := json.Marshal(res)
 
@@ -596,13 +693,15 @@ type createCoreUserEventOpts struct {
 	params                         sqlcv1.BulkCreateEventsParams
 }
 
+// triggerWorkflows creates the runs of the tuples. Runs which would exceed a scoped resource limit are skipped, and
+// returned as failures.
 func (r *sharedRepository) triggerWorkflows(
 	ctx context.Context,
 	existingTx *OptimisticTx,
 	tenantId uuid.UUID,
 	tuples []triggerTuple,
 	coreEvents *createCoreUserEventOpts,
-) ([]*V1TaskWithPayload, []*DAGWithData, error) {
+) ([]*V1TaskWithPayload, []*DAGWithData, []CELEvaluationFailure, error) {
 	for i := range tuples {
 		tuples[i].additionalMetadata = ensureTraceparent(tuples[i].additionalMetadata, tuples[i].externalId)
 	}
@@ -630,7 +729,7 @@ func (r *sharedRepository) triggerWorkflows(
 	workflowVersionToSteps, err := r.listStepsByWorkflowVersionIds(ctx, preflightTx, tenantId, workflowVersionIds)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get workflow versions for engine: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to get workflow versions for engine: %w", err)
 	}
 
 	// group steps by workflow version ids
@@ -647,6 +746,64 @@ func (r *sharedRepository) triggerWorkflows(
 		}
 	}
 
+	scopedUsage := make([]ScopedResourceUsage, 0, len(tuples))
+
+	// the index of the tuple of each scoped usage, since tuples without steps aren't counted
+	scopedUsageTuples := make([]int, 0, len(tuples))
+
+	for i, tuple := range tuples {
+		steps, ok := workflowVersionToSteps[tuple.workflowVersionId]
+
+		if !ok {
+			continue
+		}
+
+		scopedUsage = append(scopedUsage, ScopedResourceUsage{
+			WorkflowId:         tuple.workflowId,
+			AdditionalMetadata: tuple.additionalMetadata,
+			Count:              int32(len(steps)), // nolint: gosec
+		})
+
+		scopedUsageTuples = append(scopedUsageTuples, i)
+	}
+
+	preScopedTask, postScopedTask := r.m.MeterScoped(ctx, sqlcv1.LimitResourceTASKRUN, tenantId, scopedUsage)
+
+	exhaustedScopedUsage, err := preScopedTask()
+
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to check scoped resource limits: %w", err)
+	}
+
+	scopedLimitFailures := make([]CELEvaluationFailure, 0, len(exhaustedScopedUsage))
+
+	// runs which would exceed a scoped limit are skipped, so that they don't fail the rest of the batch
+	if len(exhaustedScopedUsage) > 0 {
+		exhaustedTuples := make(map[int]*ScopedResourceExhaustedError, len(exhaustedScopedUsage))
+
+		for i, exhaustedErr := range exhaustedScopedUsage {
+			exhaustedTuples[scopedUsageTuples[i]] = exhaustedErr
+		}
+
+		admittedTuples := make([]triggerTuple, 0, len(tuples)-len(exhaustedTuples))
+
+		for i, tuple := range tuples {
+			exhaustedErr, ok := exhaustedTuples[i]
+
+			if !ok {
+				admittedTuples = append(admittedTuples, tuple)
+				continue
+			}
+
+			scopedLimitFailures = append(scopedLimitFailures, CELEvaluationFailure{
+				Source:       sqlcv1.V1CelEvaluationFailureSourceSCOPEDLIMIT,
+				ErrorMessage: fmt.Sprintf("workflow %s was not triggered: %s", tuple.workflowName, exhaustedErr.Error()),
+			})
+		}
+
+		tuples = admittedTuples
+	}
+
 	countWorkflowRuns := 0
 	countTasks := 0
 
@@ -665,7 +822,7 @@ func (r *sharedRepository) triggerWorkflows(
 	preTask, postTask := r.m.Meter(ctx, sqlcv1.LimitResourceTASKRUN, tenantId, int32(countTasks)) // nolint: gosec
 
 	if err := preTask(); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	stepsToAdditionalMatches := make(map[uuid.UUID][]*sqlcv1.V1StepMatchCondition)
@@ -677,7 +834,7 @@ func (r *sharedRepository) triggerWorkflows(
 		})
 
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to list step match conditions: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to list step match conditions: %w", err)
 		}
 
 		for _, match := range additionalMatches {
@@ -758,7 +915,7 @@ func (r *sharedRepository) triggerWorkflows(
 		tx, commit, rollback, err = sqlchelpers.PrepareTx(ctx, r.pool, r.l)
 
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		defer rollback()
@@ -771,7 +928,7 @@ func (r *sharedRepository) triggerWorkflows(
 	tuplesToSkip, err := r.registerChildWorkflows(ctx, tx, tenantId, tuples, stepsToExternalIds, workflowVersionToSteps)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to register child workflows: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to register child workflows: %w", err)
 	}
 
 	for i, tuple := range tuples {
@@ -917,7 +1074,7 @@ func (r *sharedRepository) triggerWorkflows(
 							)
 
 							if err != nil {
-								return nil, nil, fmt.Errorf("failed to create sleep condition: %w", err)
+								return nil, nil, nil, fmt.Errorf("failed to create sleep condition: %w", err)
 							}
 
 							groupConditions = append(groupConditions, *c)
@@ -1174,7 +1331,7 @@ func (r *sharedRepository) triggerWorkflows(
 	dags, err := r.createDAGs(ctx, tx, tenantId, dagOpts)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create DAGs: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create DAGs: %w", err)
 	}
 
 	// populate taskOpts with inserted DAG data
@@ -1199,7 +1356,7 @@ func (r *sharedRepository) triggerWorkflows(
 	tasks, err := r.createTasks(ctx, tx, tenantId, createTaskOpts)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create tasks: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create tasks: %w", err)
 	}
 
 	for _, dag := range dags {
@@ -1216,7 +1373,7 @@ func (r *sharedRepository) triggerWorkflows(
 	err = r.createEventMatches(ctx, tx, tenantId, createMatchOpts)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create event matches: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create event matches: %w", err)
 	}
 
 	storePayloadOpts := make([]StorePayloadOpts, 0, len(tasks))
@@ -1249,7 +1406,7 @@ func (r *sharedRepository) triggerWorkflows(
 		createdEvents, err := r.queries.BulkCreateEvents(ctx, tx, coreEvents.params)
 
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create core events: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to create core events: %w", err)
 		}
 
 		for _, createdEvent := range createdEvents {
@@ -1325,7 +1482,7 @@ func (r *sharedRepository) triggerWorkflows(
 		})
 
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create event to runs: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to create event to runs: %w", err)
 		}
 
 		for _, e := range createdEvents {
@@ -1349,22 +1506,24 @@ func (r *sharedRepository) triggerWorkflows(
 	err = r.payloadStore.Store(ctx, tx, storePayloadOpts...)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to store payloads: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to store payloads: %w", err)
 	}
 
 	// commit if we started the transaction
 	if existingTx == nil {
 		if err := commit(ctx); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		postTask()
+		postScopedTask()
 
 	} else {
 		existingTx.AddPostCommit(postTask)
+		existingTx.AddPostCommit(postScopedTask)
 	}
 
-	return tasks, dags, nil
+	return tasks, dags, scopedLimitFailures, nil
 }
 
 type DAGWithData struct {
@@ -2237,9 +2396,33 @@ func (r *sharedRepository) prepareTriggerFromEvents(ctx context.Context, tx sqlc
 
 				additionalMetadata := triggerConverter.ToMetadata(opt.AdditionalMetadata)
 				externalId := uuid.New()
+				workflowVersionId := selectWorkflowVersion(externalId, workflow.WorkflowVersionId, workflow.CanaryPercentage, workflow.StableWorkflowVersionId)
+
+				inputSchema := workflow.InputJsonSchema
+
+				if workflowVersionId != workflow.WorkflowVersionId {
+					inputSchema = workflow.StableInputJsonSchema
+				}
+
+				// the input is validated against the version the run uses, which may be the stable version of a canary
+				inputFailure, inputErr := r.validateInput(workflowVersionId, workflow.WorkflowName, inputSchema, workflow.InputValidationMode, opt.Data)
+
+				if inputErr != nil {
+					// the workflow rejects invalid input, so we record the failure and don't trigger the workflow
+					celEvaluationFailures = append(celEvaluationFailures, CELEvaluationFailure{
+						Source:       sqlcv1.V1CelEvaluationFailureSourceINPUTSCHEMA,
+						ErrorMessage: inputErr.Error(),
+					})
+
+					continue
+				}
+
+				if inputFailure != nil {
+					celEvaluationFailures = append(celEvaluationFailures, *inputFailure)
+				}
 
 				triggerOpts = append(triggerOpts, triggerTuple{
-					workflowVersionId:         workflow.WorkflowVersionId,
+					workflowVersionId:         workflowVersionId,
 					workflowId:                workflow.WorkflowId,
 					workflowName:              workflow.WorkflowName,
 					externalId:                externalId,
@@ -2321,6 +2504,12 @@ func (r *sharedRepository) prepareTriggerFromWorkflowNames(ctx context.Context,
 		return nil, fmt.Errorf("failed to list workflows for names: %w", err)
 	}
 
+	pinnedVersions, err := r.listWorkflowVersionsByVersions(ctx, tx, tenantId, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow versions: %w", err)
+	}
+
 	// each (workflowVersionId, opt) is a separate workflow that we need to create
 	triggerOpts := make([]triggerTuple, 0, len(opts))
 
@@ -2346,8 +2535,32 @@ func (r *sharedRepository) prepareTriggerFromWorkflowNames(ctx context.Context,
 				}
 			}
 
+			var workflowVersionId uuid.UUID
+
+			if isPinnedToVersion(opt) {
+				key := workflowNameAndVersion{name: opt.WorkflowName, version: *opt.Version}
+				pinned, ok := pinnedVersions[key]
+
+				// callers without a preflight check, like child spawns, would otherwise get back the external id of a
+				// run which is never created
+				if !ok {
+					return nil, &ErrNamesNotFound{
+						Names: []string{key.String()},
+					}
+				}
+
+				workflowVersionId = pinned.WorkflowVersionId
+			} else {
+				workflowVersionId = selectWorkflowVersion(
+					opt.ExternalId,
+					workflowVersion.WorkflowVersionId,
+					workflowVersion.CanaryPercentage,
+					workflowVersion.StableWorkflowVersionId,
+				)
+			}
+
 			triggerOpts = append(triggerOpts, triggerTuple{
-				workflowVersionId:    workflowVersion.WorkflowVersionId,
+				workflowVersionId:    workflowVersionId,
 				workflowId:           workflowVersion.WorkflowId,
 				workflowName:         workflowVersion.WorkflowName,
 				externalId:           opt.ExternalId,
@@ -2368,6 +2581,82 @@ func (r *sharedRepository) prepareTriggerFromWorkflowNames(ctx context.Context,
 	return triggerOpts, nil
 }
 
+type workflowNameAndVersion struct {
+	name    string
+	version string
+}
+
+func (w workflowNameAndVersion) String() string {
+	return fmt.Sprintf("%s@%s", w.name, w.version)
+}
+
+func isPinnedToVersion(opt *WorkflowNameTriggerOpts) bool {
+	return opt.TriggerTaskData != nil && opt.Version != nil && *opt.Version != ""
+}
+
+// listWorkflowVersionsByVersions looks up the workflow versions which triggers are pinned to, keyed by
+// workflow name and version.
+func (r *sharedRepository) listWorkflowVersionsByVersions(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, opts []*WorkflowNameTriggerOpts) (map[workflowNameAndVersion]*sqlcv1.ListWorkflowVersionsByVersionsRow, error) {
+	res := make(map[workflowNameAndVersion]*sqlcv1.ListWorkflowVersionsByVersionsRow)
+
+	seen := make(map[workflowNameAndVersion]struct{})
+	workflowNames := make([]string, 0)
+	versions := make([]string, 0)
+
+	for _, opt := range opts {
+		if !isPinnedToVersion(opt) {
+			continue
+		}
+
+		key := workflowNameAndVersion{name: opt.WorkflowName, version: *opt.Version}
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		workflowNames = append(workflowNames, opt.WorkflowName)
+		versions = append(versions, *opt.Version)
+	}
+
+	if len(workflowNames) == 0 {
+		return res, nil
+	}
+
+	rows, err := r.queries.ListWorkflowVersionsByVersions(ctx, tx, sqlcv1.ListWorkflowVersionsByVersionsParams{
+		Tenantid:      tenantId,
+		Workflownames: workflowNames,
+		Versions:      versions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		res[workflowNameAndVersion{name: row.WorkflowName, version: row.Version}] = row
+	}
+
+	return res, nil
+}
+
+// selectWorkflowVersion picks the workflow version for an unpinned run. If the latest version is a canary, the
+// run is bucketed by its external id so that the canary percentage of runs use the latest version and the rest
+// use the stable version. The same external id always lands in the same bucket.
+func selectWorkflowVersion(externalId, latestVersionId uuid.UUID, canaryPercentage pgtype.Int4, stableVersionId *uuid.UUID) uuid.UUID {
+	if !canaryPercentage.Valid || stableVersionId == nil {
+		return latestVersionId
+	}
+
+	bucket := crc32.ChecksumIEEE(externalId[:]) % 100
+
+	if bucket < uint32(max(canaryPercentage.Int32, 0)) { // nolint: gosec
+		return latestVersionId
+	}
+
+	return *stableVersionId
+}
+
 type TriggerOptInvalidArgumentError struct {
 	Err error
 }
@@ -2416,6 +2705,7 @@ func (r *sharedRepository) NewTriggerTaskData(
 		AdditionalMetadata: []byte(additionalMeta),
 		DesiredWorkerId:    desiredWorkerId,
 		Priority:           req.Priority,
+		Version:            req.Version,
 	}
 
 	if len(req.DesiredWorkerLabels) > 0 {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -93,3 +94,46 @@ func Test_ensureTraceparent_inherits_when_present(t *testing.T) {
 	assert.Nil(t, m["hatchet__traceparent_parent_span_id"],
 		"no separate parent span_id key stored")
 }
+
+func TestSelectWorkflowVersion(t *testing.T) {
+	latest := uuid.New()
+	stable := uuid.New()
+
+	t.Run("no canary uses the latest version", func(t *testing.T) {
+		assert.Equal(t, latest, selectWorkflowVersion(uuid.New(), latest, pgtype.Int4{}, &stable))
+	})
+
+	t.Run("canary without a stable version uses the latest version", func(t *testing.T) {
+		assert.Equal(t, latest, selectWorkflowVersion(uuid.New(), latest, pgtype.Int4{Int32: 0, Valid: true}, nil))
+	})
+
+	t.Run("zero percent canary uses the stable version", func(t *testing.T) {
+		for range 100 {
+			assert.Equal(t, stable, selectWorkflowVersion(uuid.New(), latest, pgtype.Int4{Int32: 0, Valid: true}, &stable))
+		}
+	})
+
+	t.Run("full canary uses the latest version", func(t *testing.T) {
+		for range 100 {
+			assert.Equal(t, latest, selectWorkflowVersion(uuid.New(), latest, pgtype.Int4{Int32: 100, Valid: true}, &stable))
+		}
+	})
+
+	t.Run("canary splits runs and is stable per run", func(t *testing.T) {
+		canary := pgtype.Int4{Int32: 25, Valid: true}
+		numLatest := 0
+
+		for range 4000 {
+			externalId := uuid.New()
+			selected := selectWorkflowVersion(externalId, latest, canary, &stable)
+
+			require.Equal(t, selected, selectWorkflowVersion(externalId, latest, canary, &stable))
+
+			if selected == latest {
+				numLatest++
+			}
+		}
+
+		assert.InDelta(t, 1000, numLatest, 200)
+	})
+}
@@ -24,7 +24,7 @@ type CreateUserOpts struct {
 }
 
 type OAuthOpts struct {
-	Provider       string     `validate:"required,oneof=google github sso"`
+	Provider       string     `validate:"required,oneof=google github sso oidc"`
 	ProviderUserId string     `validate:"required,min=1"`
 	AccessToken    []byte     `validate:"required,min=1"`
 	RefreshToken   []byte     // optional
@@ -57,6 +57,9 @@ type UserRepository interface {
 	// GetUserByEmail returns the user with the given email
 	GetUserByEmail(ctx context.Context, email string) (*sqlcv1.User, error)
 
+	// GetUserByOAuthProviderUserId returns the user who is linked to the given account of an oauth provider
+	GetUserByOAuthProviderUserId(ctx context.Context, provider, providerUserId string) (*sqlcv1.User, error)
+
 	// GetUserPassword returns the user password with the given id
 	GetUserPassword(ctx context.Context, id uuid.UUID) (*sqlcv1.UserPassword, error)
 
@@ -129,6 +132,13 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*sql
 	return r.queries.GetUserByEmail(ctx, r.pool, emailLower)
 }
 
+func (r *userRepository) GetUserByOAuthProviderUserId(ctx context.Context, provider, providerUserId string) (*sqlcv1.User, error) {
+	return r.queries.GetUserByOAuthProviderUserId(ctx, r.pool, sqlcv1.GetUserByOAuthProviderUserIdParams{
+		Provider:       provider,
+		Provideruserid: providerUserId,
+	})
+}
+
 func (r *userRepository) GetUserPassword(ctx context.Context, id uuid.UUID) (*sqlcv1.UserPassword, error) {
 	return r.queries.GetUserPassword(ctx, r.pool, id)
 }
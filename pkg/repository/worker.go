@@ -45,6 +45,10 @@ type CreateWorkerOpts struct {
 
 	// (optional) Runtime info for the worker
 	RuntimeInfo *RuntimeInfo `validate:"omitempty"`
+
+	// (optional) the version of each workflow the worker registered (workflow name -> version), used to route
+	// runs which are pinned to a version
+	WorkflowVersions map[string]string `validate:"omitempty"`
 }
 
 type UpdateWorkerOpts struct {
@@ -86,6 +90,7 @@ type UpsertWorkerLabelOpts struct {
 
 type DurableTaskDispatcherLookup struct {
 	DispatcherId *uuid.UUID
+	WorkerId     *uuid.UUID
 	IsEvicted    bool
 }
 
@@ -619,6 +624,27 @@ func (w *workerRepository) CreateNewWorker(ctx context.Context, tenantId uuid.UU
 		}
 	}
 
+	if len(opts.WorkflowVersions) > 0 {
+		workflowNames := make([]string, 0, len(opts.WorkflowVersions))
+		versions := make([]string, 0, len(opts.WorkflowVersions))
+
+		for workflowName, version := range opts.WorkflowVersions {
+			workflowNames = append(workflowNames, workflowName)
+			versions = append(versions, version)
+		}
+
+		err = w.queries.CreateWorkerWorkflowVersions(ctx, tx, sqlcv1.CreateWorkerWorkflowVersionsParams{
+			Tenantid:      tenantId,
+			Workerid:      worker.ID,
+			Workflownames: workflowNames,
+			Versions:      versions,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create worker workflow versions: %w", err)
+		}
+	}
+
 	svcUUIDs := make([]uuid.UUID, len(opts.Services))
 
 	for i, svc := range opts.Services {
@@ -937,6 +963,7 @@ func (w *workerRepository) GetDurableDispatcherIdsForTasks(ctx context.Context,
 			InsertedAt: row.TaskInsertedAt,
 		}] = DurableTaskDispatcherLookup{
 			DispatcherId: row.DurableTaskDispatcherId,
+			WorkerId:     row.WorkerID,
 			IsEvicted:    row.EvictedAt.Valid,
 		}
 	}
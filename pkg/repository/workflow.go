@@ -56,6 +56,13 @@ type CreateWorkflowVersionOpts struct {
 	DefaultFilters []types.DefaultFilter `json:"defaultFilters,omitempty" validate:"omitempty,dive"`
 
 	InputJsonSchema []byte `json:"inputJsonSchema,omitempty"`
+
+	// (optional) the version of the workflow, which triggers can pin runs to and workers register with
+	Version string `json:"version,omitempty" validate:"required_with=CanaryPercentage"`
+
+	// (optional) the percentage of unpinned runs which use this version while it's rolled out, the rest use the
+	// most recent version without a canary percentage
+	CanaryPercentage *int32 `json:"canaryPercentage,omitempty" validate:"omitnil,min=0,max=100"`
 }
 
 type CreateConcurrencyOpts struct {
@@ -63,10 +70,13 @@ type CreateConcurrencyOpts struct {
 	MaxRuns *int32
 
 	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
-	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST"`
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST WEIGHTED_FAIR"`
 
 	// (required) a concurrency expression for evaluating the concurrency key
 	Expression string `validate:"celworkflowrunstr"`
+
+	// (optional) a CEL expression which evaluates the weight of a concurrency key, used by WEIGHTED_FAIR
+	WeightExpression *string `validate:"omitnil,celconcurrencyweight"`
 }
 
 type CreateStepOpts struct {
@@ -111,6 +121,32 @@ type CreateStepOpts struct {
 
 	// (optional) the step concurrency options
 	Concurrency []CreateConcurrencyOpts `json:"concurrency,omitempty" validate:"omitempty,dive"`
+
+	// (optional) the JSON schema which the output of the step is validated against when it completes
+	OutputJsonSchema []byte `json:"outputJsonSchema,omitempty"`
+
+	// (optional) the strategy used to compute the delay between retries, default EXPONENTIAL
+	RetryBackoffStrategy *string `json:"retryBackoffStrategy,omitempty" validate:"omitnil,oneof=EXPONENTIAL FULL_JITTER DECORRELATED_JITTER FIXED"`
+
+	// (optional) the base delay in seconds for the FIXED and DECORRELATED_JITTER strategies, default 1
+	RetryBackoffBaseSeconds *float64 `json:"retryBackoffBaseSeconds,omitempty" validate:"omitnil,min=0,max=86400"`
+
+	// (optional) retry rules which override the retry policy of the step for specific error types
+	RetryRules []CreateStepRetryRuleOpts `json:"retryRules,omitempty" validate:"omitempty,dive"`
+}
+
+type CreateStepRetryRuleOpts struct {
+	// (required) the error type reported by the worker when the task fails
+	ErrorType string `json:"errorType" validate:"required"`
+
+	// (optional) the number of retries for this error type, defaults to the step retries
+	MaxRetries *int32 `json:"maxRetries,omitempty" validate:"omitnil,min=0"`
+
+	// (optional) the backoff strategy for this error type, defaults to the step strategy
+	BackoffStrategy *string `json:"backoffStrategy,omitempty" validate:"omitnil,oneof=EXPONENTIAL FULL_JITTER DECORRELATED_JITTER FIXED"`
+
+	// (optional) the base delay in seconds for this error type, defaults to the step base delay
+	BackoffBaseSeconds *float64 `json:"backoffBaseSeconds,omitempty" validate:"omitnil,min=0,max=86400"`
 }
 
 type CreateStepMatchConditionOpt struct {
@@ -191,6 +227,11 @@ type ListWorkflowsResult struct {
 	Count int
 }
 
+type UpdateWorkflowOpts struct {
+	// (optional) what happens when the input of a workflow run doesn't match the workflow's input schema
+	InputValidationMode *sqlcv1.WorkflowInputValidationMode `validate:"omitempty,oneof=REJECT WARN"`
+}
+
 type WorkflowMetrics struct {
 	// the number of runs for a specific group key
 	GroupKeyRunsCount int `json:"groupKeyRunsCount,omitempty"`
@@ -225,9 +266,17 @@ type WorkflowRepository interface {
 	// DeleteWorkflow deletes a workflow for a given tenant.
 	DeleteWorkflow(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID) (*sqlcv1.Workflow, error)
 
+	// UpdateWorkflow updates the settings of a workflow which apply to every version of the workflow.
+	UpdateWorkflow(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID, opts *UpdateWorkflowOpts) (*sqlcv1.Workflow, error)
+
 	GetWorkflowByName(ctx context.Context, tenantId uuid.UUID, workflowName string) (*sqlcv1.Workflow, error)
 
 	GetLatestWorkflowVersion(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID) (*sqlcv1.GetWorkflowVersionForEngineRow, error)
+
+	// GetWorkflowDefinition returns the canonical definition of a workflow version. The version can be a workflow
+	// version id, a version string, or empty for the latest version. It returns ErrWorkflowDefinitionNotStored if the
+	// version was registered before definitions were stored.
+	GetWorkflowDefinition(ctx context.Context, tenantId, workflowId uuid.UUID, version string) (*WorkflowDefinition, error)
 }
 
 type workflowRepository struct {
@@ -278,6 +327,14 @@ func (r *workflowRepository) PutWorkflowVersion(ctx context.Context, tenantId uu
 		}
 	}
 
+	// weighted fair concurrency is only supported by the in-memory task concurrency index, which does
+	// not back workflow-level strategies
+	for _, wfConcurrency := range opts.Concurrency {
+		if wfConcurrency.LimitStrategy != nil && *wfConcurrency.LimitStrategy == string(sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR) {
+			return nil, fmt.Errorf("workflow %s: WEIGHTED_FAIR concurrency is only supported on tasks", opts.Name)
+		}
+	}
+
 	var err error
 	opts.Tasks, err = orderWorkflowStepsV1(opts.Tasks)
 
@@ -406,6 +463,23 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 		return &oldWorkflowVersion.WorkflowVersion.ID, nil
 	}
 
+	// if an explicitly versioned workflow was already registered, we reuse that version rather than making it the
+	// latest again, so workers running older versions during a deploy don't fight over which version is the latest
+	if oldWorkflowVersion != nil && opts.Version != "" {
+		existingId, err := r.queries.GetWorkflowVersionIdByChecksum(ctx, tx, sqlcv1.GetWorkflowVersionIdByChecksumParams{
+			Workflowid: workflowId,
+			Checksum:   cs,
+		})
+
+		if err == nil {
+			return &existingId, nil
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not get existing workflow version: %w", err)
+		}
+	}
+
 	optsJson, err := json.Marshal(modifiedOpts)
 
 	if err != nil {
@@ -433,6 +507,18 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 			Valid: true,
 		}
 	}
+
+	if opts.Version != "" {
+		createParams.Version = sqlchelpers.TextFromStr(opts.Version)
+	}
+
+	if opts.CanaryPercentage != nil {
+		createParams.CanaryPercentage = pgtype.Int4{
+			Int32: *opts.CanaryPercentage,
+			Valid: true,
+		}
+	}
+
 	sqlcWorkflowVersion, err := r.queries.CreateWorkflowVersion(
 		ctx,
 		tx,
@@ -600,8 +686,12 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 		}
 
 		// delete DEFAULT cron refs from the old version — they were re-created fresh above for the new version,
-		// and leaving them accumulates dead rows that the PollCronSchedules query locks every 15 seconds
-		err = r.queries.DeleteOldDefaultCronTriggersForWorkflowVersion(ctx, tx, oldWorkflowVersion.WorkflowVersion.ID)
+		// and leaving them accumulates dead rows that the PollCronSchedules query locks every 15 seconds. Their
+		// fire history is moved to the re-created crons.
+		err = r.queries.DeleteOldDefaultCronTriggersForWorkflowVersion(ctx, tx, sqlcv1.DeleteOldDefaultCronTriggersForWorkflowVersionParams{
+			Oldworkflowversionid: oldWorkflowVersion.WorkflowVersion.ID,
+			Newworkflowtriggerid: sqlcWorkflowTriggers.ID,
+		})
 
 		if err != nil {
 			return nil, fmt.Errorf("could not delete old DEFAULT cron triggers: %w", err)
@@ -812,6 +902,30 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			return nil, err
 		}
 
+		if len(stepOpts.OutputJsonSchema) > 0 {
+			err = r.queries.CreateStepOutputSchema(
+				ctx,
+				tx,
+				sqlcv1.CreateStepOutputSchemaParams{
+					Tenantid:     tenantId,
+					Stepid:       stepId,
+					Outputschema: stepOpts.OutputJsonSchema,
+				},
+			)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if retryPolicyParams := toStepRetryPolicyParams(tenantId, stepId, stepOpts); retryPolicyParams != nil {
+			err = r.queries.CreateStepRetryPolicies(ctx, tx, *retryPolicyParams)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// upsert the queue based on the action
 		// note: we don't use the postCommit func, it just sets the queue in the cache which is not necessary for writing a
 		// workflow version, only when we're inserting a bunch of tasks for that queue
@@ -994,6 +1108,12 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 					strategy = sqlcv1.ConcurrencyLimitStrategy(*concurrency.LimitStrategy)
 				}
 
+				var weightExpression pgtype.Text
+
+				if concurrency.WeightExpression != nil {
+					weightExpression = sqlchelpers.TextFromStr(*concurrency.WeightExpression)
+				}
+
 				_, err := r.queries.CreateStepConcurrency(
 					ctx,
 					tx,
@@ -1005,6 +1125,7 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 						Expression:        concurrency.Expression,
 						Maxconcurrency:    maxRuns,
 						Strategy:          sqlcv1.V1ConcurrencyStrategy(strategy),
+						WeightExpression:  weightExpression,
 					},
 				)
 
@@ -1235,6 +1356,25 @@ func (r *workflowRepository) DeleteWorkflow(ctx context.Context, tenantId uuid.U
 	return r.queries.SoftDeleteWorkflow(ctx, r.pool, workflowId)
 }
 
+func (r *workflowRepository) UpdateWorkflow(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID, opts *UpdateWorkflowOpts) (*sqlcv1.Workflow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.UpdateWorkflowParams{
+		ID: workflowId,
+	}
+
+	if opts.InputValidationMode != nil {
+		params.InputValidationMode = sqlcv1.NullWorkflowInputValidationMode{
+			WorkflowInputValidationMode: *opts.InputValidationMode,
+			Valid:                       true,
+		}
+	}
+
+	return r.queries.UpdateWorkflow(ctx, r.pool, params)
+}
+
 func (r *workflowRepository) GetWorkflowByName(ctx context.Context, tenantId uuid.UUID, workflowName string) (*sqlcv1.Workflow, error) {
 	return r.queries.GetWorkflowByName(ctx, r.pool, sqlcv1.GetWorkflowByNameParams{
 		Tenantid: tenantId,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -162,3 +163,183 @@ func TestOnlyDefaultCronsAreDeleted(t *testing.T) {
 	assert.Equal(t, 1, defaultCount, "should have exactly 1 DEFAULT cron on the new version")
 	assert.Equal(t, 1, apiCount, "API cron should be migrated to the new version, not deleted")
 }
+
+func TestDefaultCronLastFiredAtCarriedOverOnReregistration(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := newWorkflowTestRepository(pool)
+
+	const workflowName = "cron-last-fired-test"
+
+	_, err := repo.PutWorkflowVersion(ctx, internalTenantId, minimalWorkflowOpts(workflowName, "v1", []string{"0 * * * *", "30 * * * *"}))
+	require.NoError(t, err)
+
+	firedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err = pool.Exec(ctx, `
+		UPDATE "WorkflowTriggerCronRef" c
+		SET "lastFiredAt" = $2
+		FROM "WorkflowTriggers" tr, "WorkflowVersion" wv, "Workflow" w
+		WHERE tr."id" = c."parentId"
+		  AND wv."id" = tr."workflowVersionId"
+		  AND w."id" = wv."workflowId"
+		  AND w."name" = $1
+		  AND c."cron" = '0 * * * *'
+	`, workflowName, firedAt)
+	require.NoError(t, err)
+
+	// Register v2 — the "0 * * * *" cron is recreated for the new version, "30 * * * *" is replaced by a new expression
+	_, err = repo.PutWorkflowVersion(ctx, internalTenantId, minimalWorkflowOpts(workflowName, "v2", []string{"0 * * * *", "45 * * * *"}))
+	require.NoError(t, err)
+
+	rows, err := pool.Query(ctx, `
+		SELECT c."cron", c."lastFiredAt"
+		FROM "WorkflowTriggerCronRef" c
+		JOIN "WorkflowTriggers" tr ON tr."id" = c."parentId"
+		JOIN "WorkflowVersion" wv ON wv."id" = tr."workflowVersionId"
+		JOIN "Workflow" w ON w."id" = wv."workflowId"
+		WHERE w."name" = $1
+		  AND w."deletedAt" IS NULL
+	`, workflowName)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	lastFiredAt := make(map[string]*time.Time)
+
+	for rows.Next() {
+		var cron string
+		var firedAt *time.Time
+		require.NoError(t, rows.Scan(&cron, &firedAt))
+		lastFiredAt[cron] = firedAt
+	}
+	require.NoError(t, rows.Err())
+
+	require.Len(t, lastFiredAt, 2)
+	require.NotNil(t, lastFiredAt["0 * * * *"], "recreated cron should keep when it last fired")
+	assert.True(t, firedAt.Equal(*lastFiredAt["0 * * * *"]))
+	assert.Nil(t, lastFiredAt["45 * * * *"], "new cron expression should not have fired yet")
+}
+
+func TestDefaultCronFireHistoryMovedOnReregistration(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := newWorkflowTestRepository(pool)
+
+	const workflowName = "cron-fire-history-test"
+
+	_, err := repo.PutWorkflowVersion(ctx, internalTenantId, minimalWorkflowOpts(workflowName, "v1", []string{"0 * * * *", "30 * * * *"}))
+	require.NoError(t, err)
+
+	cronIds := func() map[string]uuid.UUID {
+		rows, err := pool.Query(ctx, `
+			SELECT c."cron", c."id"
+			FROM "WorkflowTriggerCronRef" c
+			JOIN "WorkflowTriggers" tr ON tr."id" = c."parentId"
+			JOIN "WorkflowVersion" wv ON wv."id" = tr."workflowVersionId"
+			JOIN "Workflow" w ON w."id" = wv."workflowId"
+			WHERE w."name" = $1
+			  AND w."deletedAt" IS NULL
+		`, workflowName)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		ids := make(map[string]uuid.UUID)
+
+		for rows.Next() {
+			var cron string
+			var id uuid.UUID
+			require.NoError(t, rows.Scan(&cron, &id))
+			ids[cron] = id
+		}
+		require.NoError(t, rows.Err())
+
+		return ids
+	}
+
+	countFires := func(cronId uuid.UUID) int {
+		var count int
+		require.NoError(t, pool.QueryRow(ctx, `SELECT count(*) FROM v1_cron_fire WHERE cron_id = $1`, cronId).Scan(&count))
+		return count
+	}
+
+	v1Crons := cronIds()
+	require.Len(t, v1Crons, 2)
+
+	for _, cronId := range v1Crons {
+		require.NoError(t, repo.queries.CreateCronFire(ctx, pool, sqlcv1.CreateCronFireParams{
+			Tenantid:    internalTenantId,
+			Cronid:      cronId,
+			Scheduledat: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			Outcome:     sqlcv1.V1CronFireOutcomeTRIGGERED,
+		}))
+	}
+
+	// Register v2 — the "0 * * * *" cron is recreated for the new version, "30 * * * *" is removed
+	_, err = repo.PutWorkflowVersion(ctx, internalTenantId, minimalWorkflowOpts(workflowName, "v2", []string{"0 * * * *"}))
+	require.NoError(t, err)
+
+	v2Crons := cronIds()
+	require.Len(t, v2Crons, 1)
+	require.NotEqual(t, v1Crons["0 * * * *"], v2Crons["0 * * * *"])
+
+	assert.Equal(t, 1, countFires(v2Crons["0 * * * *"]), "recreated cron should keep its fire history")
+	assert.Equal(t, 0, countFires(v1Crons["0 * * * *"]))
+	assert.Equal(t, 0, countFires(v1Crons["30 * * * *"]), "fire history of a removed cron should be deleted")
+}
+
+func TestReenabledCronLastFiredAtIsReset(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := newWorkflowTestRepository(pool)
+
+	const workflowName = "cron-reenabled-test"
+
+	_, err := repo.PutWorkflowVersion(ctx, internalTenantId, minimalWorkflowOpts(workflowName, "v1", []string{"0 * * * *"}))
+	require.NoError(t, err)
+
+	firedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var cronId uuid.UUID
+
+	err = pool.QueryRow(ctx, `
+		UPDATE "WorkflowTriggerCronRef" c
+		SET "lastFiredAt" = $2
+		FROM "WorkflowTriggers" tr, "WorkflowVersion" wv, "Workflow" w
+		WHERE tr."id" = c."parentId"
+		  AND wv."id" = tr."workflowVersionId"
+		  AND w."id" = wv."workflowId"
+		  AND w."name" = $1
+		RETURNING c."id"
+	`, workflowName, firedAt).Scan(&cronId)
+	require.NoError(t, err)
+
+	getLastFiredAt := func() time.Time {
+		var lastFiredAt time.Time
+		require.NoError(t, pool.QueryRow(ctx, `SELECT "lastFiredAt" FROM "WorkflowTriggerCronRef" WHERE "id" = $1`, cronId).Scan(&lastFiredAt))
+		return lastFiredAt
+	}
+
+	setEnabled := func(enabled bool) {
+		require.NoError(t, repo.queries.UpdateCronTrigger(ctx, pool, sqlcv1.UpdateCronTriggerParams{
+			Crontriggerid: cronId,
+			Enabled:       pgtype.Bool{Bool: enabled, Valid: true},
+		}))
+	}
+
+	// disabling the cron, or enabling a cron which is already enabled, keeps when it last fired
+	setEnabled(true)
+	assert.True(t, firedAt.Equal(getLastFiredAt()))
+
+	setEnabled(false)
+	assert.True(t, firedAt.Equal(getLastFiredAt()))
+
+	// enabling the cron again resumes it from now, so the runs it missed while disabled aren't fired
+	setEnabled(true)
+	assert.WithinDuration(t, time.Now(), getLastFiredAt(), time.Minute)
+}
@@ -0,0 +1,801 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrWorkflowDefinitionNotRepresentable is returned when a workflow version has options which can't be represented in
+// a workflow definition, so exporting it would lose them.
+var ErrWorkflowDefinitionNotRepresentable = errors.New("the workflow version can't be represented as a definition")
+
+// ErrWorkflowDefinitionNotStored is returned when a workflow version was registered before the engine started
+// storing the options it was created with, so its definition can't be exported.
+var ErrWorkflowDefinitionNotStored = errors.New("the definition of this workflow version was not stored, register the workflow again to export it")
+
+// WorkflowDefinition is a canonical representation of a workflow version. Tasks, parents, triggers, rate limits, filters
+// and retry rules are sorted, so two definitions of the same workflow serialize identically and can be compared or
+// moved between environments.
+type WorkflowDefinition struct {
+	Name             string                            `json:"name"`
+	Version          string                            `json:"version,omitempty"`
+	Description      string                            `json:"description,omitempty"`
+	Sticky           *string                           `json:"sticky,omitempty"`
+	DefaultPriority  *int32                            `json:"defaultPriority,omitempty"`
+	CanaryPercentage *int32                            `json:"canaryPercentage,omitempty"`
+	EventTriggers    []string                          `json:"eventTriggers,omitempty"`
+	CronTriggers     []string                          `json:"cronTriggers,omitempty"`
+	CronInput        map[string]interface{}            `json:"cronInput,omitempty"`
+	DefaultFilters   []WorkflowDefinitionDefaultFilter `json:"defaultFilters,omitempty"`
+	InputJsonSchema  map[string]interface{}            `json:"inputJsonSchema,omitempty"`
+	Concurrency      []WorkflowDefinitionConcurrency   `json:"concurrency,omitempty"`
+	Tasks            []WorkflowDefinitionTask          `json:"tasks"`
+	OnFailureTask    *WorkflowDefinitionTask           `json:"onFailureTask,omitempty"`
+}
+
+type WorkflowDefinitionTask struct {
+	Name                    string                                   `json:"name"`
+	Action                  string                                   `json:"action"`
+	Parents                 []string                                 `json:"parents,omitempty"`
+	Timeout                 *string                                  `json:"timeout,omitempty"`
+	ScheduleTimeout         *string                                  `json:"scheduleTimeout,omitempty"`
+	Retries                 *int                                     `json:"retries,omitempty"`
+	RetryBackoffFactor      *float64                                 `json:"retryBackoffFactor,omitempty"`
+	RetryBackoffMaxSeconds  *int                                     `json:"retryBackoffMaxSeconds,omitempty"`
+	RetryBackoffStrategy    *string                                  `json:"retryBackoffStrategy,omitempty"`
+	RetryBackoffBaseSeconds *float64                                 `json:"retryBackoffBaseSeconds,omitempty"`
+	RetryRules              []WorkflowDefinitionRetryRule            `json:"retryRules,omitempty"`
+	IsDurable               bool                                     `json:"isDurable,omitempty"`
+	RateLimits              []WorkflowDefinitionRateLimit            `json:"rateLimits,omitempty"`
+	Concurrency             []WorkflowDefinitionConcurrency          `json:"concurrency,omitempty"`
+	DesiredWorkerLabels     map[string]WorkflowDefinitionWorkerLabel `json:"desiredWorkerLabels,omitempty"`
+	SlotRequests            map[string]int32                         `json:"slotRequests,omitempty"`
+	TriggerConditions       []WorkflowDefinitionTriggerCondition     `json:"triggerConditions,omitempty"`
+	OutputJsonSchema        map[string]interface{}                   `json:"outputJsonSchema,omitempty"`
+}
+
+type WorkflowDefinitionDefaultFilter struct {
+	Expression string                 `json:"expression"`
+	Scope      string                 `json:"scope"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+}
+
+type WorkflowDefinitionRetryRule struct {
+	ErrorType          string   `json:"errorType"`
+	MaxRetries         *int32   `json:"maxRetries,omitempty"`
+	BackoffStrategy    *string  `json:"backoffStrategy,omitempty"`
+	BackoffBaseSeconds *float64 `json:"backoffBaseSeconds,omitempty"`
+}
+
+// WorkflowDefinitionWorkerLabel is a desired worker label of a task, keyed by the label key
+type WorkflowDefinitionWorkerLabel struct {
+	IntValue   *int32  `json:"intValue,omitempty"`
+	StrValue   *string `json:"strValue,omitempty"`
+	Required   *bool   `json:"required,omitempty"`
+	Weight     *int32  `json:"weight,omitempty"`
+	Comparator *string `json:"comparator,omitempty"`
+}
+
+// WorkflowDefinitionTriggerCondition is an additional condition which a task waits for. Conditions with the same
+// OrGroup are satisfied when any of them is. Or groups are numbered in the order they first appear in the sorted tasks,
+// and separately for the on failure task.
+type WorkflowDefinitionTriggerCondition struct {
+	Kind            string  `json:"kind"`
+	Action          string  `json:"action"`
+	ReadableDataKey string  `json:"readableDataKey"`
+	OrGroup         int32   `json:"orGroup"`
+	Expression      string  `json:"expression,omitempty"`
+	SleepDuration   *string `json:"sleepDuration,omitempty"`
+	EventKey        *string `json:"eventKey,omitempty"`
+	ParentName      *string `json:"parentName,omitempty"`
+}
+
+type WorkflowDefinitionConcurrency struct {
+	Expression       string  `json:"expression"`
+	MaxRuns          *int32  `json:"maxRuns,omitempty"`
+	LimitStrategy    *string `json:"limitStrategy,omitempty"`
+	WeightExpression *string `json:"weightExpression,omitempty"`
+}
+
+type WorkflowDefinitionRateLimit struct {
+	Key       string  `json:"key,omitempty"`
+	KeyExpr   *string `json:"keyExpr,omitempty"`
+	Units     *int    `json:"units,omitempty"`
+	UnitsExpr *string `json:"unitsExpr,omitempty"`
+	LimitExpr *string `json:"limitExpr,omitempty"`
+	Duration  *string `json:"duration,omitempty"`
+}
+
+type WorkflowDefinitionChangeKind string
+
+const (
+	WorkflowDefinitionChangeAdded   WorkflowDefinitionChangeKind = "ADDED"
+	WorkflowDefinitionChangeRemoved WorkflowDefinitionChangeKind = "REMOVED"
+	WorkflowDefinitionChangeChanged WorkflowDefinitionChangeKind = "CHANGED"
+)
+
+// WorkflowDefinitionChange is a single difference between two workflow definitions. From and To hold the JSON
+// encoding of the value before and after the change, and are unset when the value was added or removed.
+type WorkflowDefinitionChange struct {
+	Path string                       `json:"path"`
+	Kind WorkflowDefinitionChangeKind `json:"kind"`
+	From *string                      `json:"from,omitempty"`
+	To   *string                      `json:"to,omitempty"`
+}
+
+// NewWorkflowDefinition builds the canonical definition of the options a workflow version was created with.
+func NewWorkflowDefinition(opts *CreateWorkflowVersionOpts) (*WorkflowDefinition, error) {
+	def := &WorkflowDefinition{
+		Name:             opts.Name,
+		Version:          opts.Version,
+		Sticky:           opts.Sticky,
+		DefaultPriority:  opts.DefaultPriority,
+		CanaryPercentage: opts.CanaryPercentage,
+		EventTriggers:    sortedStrings(opts.EventTriggers),
+		CronTriggers:     sortedStrings(opts.CronTriggers),
+		Concurrency:      toWorkflowDefinitionConcurrency(opts.Concurrency),
+		Tasks:            make([]WorkflowDefinitionTask, 0, len(opts.Tasks)),
+	}
+
+	if opts.Description != nil {
+		def.Description = *opts.Description
+	}
+
+	if len(opts.CronInput) > 0 {
+		if err := json.Unmarshal(opts.CronInput, &def.CronInput); err != nil {
+			return nil, fmt.Errorf("could not decode cron input: %w", err)
+		}
+	}
+
+	if len(opts.InputJsonSchema) > 0 {
+		if err := json.Unmarshal(opts.InputJsonSchema, &def.InputJsonSchema); err != nil {
+			return nil, fmt.Errorf("%w: the input JSON schema must be an object: %w", ErrWorkflowDefinitionNotRepresentable, err)
+		}
+	}
+
+	for _, filter := range opts.DefaultFilters {
+		def.DefaultFilters = append(def.DefaultFilters, WorkflowDefinitionDefaultFilter{
+			Expression: filter.Expression,
+			Scope:      filter.Scope,
+			Payload:    filter.Payload,
+		})
+	}
+
+	sort.SliceStable(def.DefaultFilters, func(i, j int) bool {
+		if def.DefaultFilters[i].Scope != def.DefaultFilters[j].Scope {
+			return def.DefaultFilters[i].Scope < def.DefaultFilters[j].Scope
+		}
+
+		return def.DefaultFilters[i].Expression < def.DefaultFilters[j].Expression
+	})
+
+	// or groups are identified by their index, because the ids aren't stored with the options
+	orGroups := make(map[int32]int32)
+
+	tasks := make([]CreateStepOpts, len(opts.Tasks))
+	copy(tasks, opts.Tasks)
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].ReadableId < tasks[j].ReadableId
+	})
+
+	for _, task := range tasks {
+		defTask, err := toWorkflowDefinitionTask(task, orGroups)
+
+		if err != nil {
+			return nil, err
+		}
+
+		def.Tasks = append(def.Tasks, defTask)
+	}
+
+	if opts.OnFailure != nil {
+		onFailure, err := toWorkflowDefinitionTask(*opts.OnFailure, make(map[int32]int32))
+
+		if err != nil {
+			return nil, err
+		}
+
+		def.OnFailureTask = &onFailure
+	}
+
+	return def, nil
+}
+
+// ToCreateWorkflowVersionOpts converts the definition back into the options used to register a workflow version.
+func (d *WorkflowDefinition) ToCreateWorkflowVersionOpts() (*CreateWorkflowVersionOpts, error) {
+	opts := &CreateWorkflowVersionOpts{
+		Name:             d.Name,
+		Version:          d.Version,
+		Sticky:           d.Sticky,
+		DefaultPriority:  d.DefaultPriority,
+		CanaryPercentage: d.CanaryPercentage,
+		EventTriggers:    d.EventTriggers,
+		CronTriggers:     d.CronTriggers,
+		Concurrency:      fromWorkflowDefinitionConcurrency(d.Concurrency),
+		Tasks:            make([]CreateStepOpts, 0, len(d.Tasks)),
+	}
+
+	if d.Description != "" {
+		description := d.Description
+		opts.Description = &description
+	}
+
+	if d.CronInput != nil {
+		cronInput, err := json.Marshal(d.CronInput)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not encode cron input: %w", err)
+		}
+
+		opts.CronInput = cronInput
+	}
+
+	if d.InputJsonSchema != nil {
+		inputJsonSchema, err := json.Marshal(d.InputJsonSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not encode input JSON schema: %w", err)
+		}
+
+		opts.InputJsonSchema = inputJsonSchema
+	}
+
+	for _, filter := range d.DefaultFilters {
+		opts.DefaultFilters = append(opts.DefaultFilters, types.DefaultFilter{
+			Expression: filter.Expression,
+			Scope:      filter.Scope,
+			Payload:    filter.Payload,
+		})
+	}
+
+	// the ids of or groups only need to be unique within the workflow, so new ones are generated for each import
+	orGroupIds := make(map[int32]uuid.UUID)
+
+	for _, task := range d.Tasks {
+		stepOpts, err := task.toCreateStepOpts(orGroupIds)
+
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Tasks = append(opts.Tasks, stepOpts)
+	}
+
+	if d.OnFailureTask != nil {
+		onFailure, err := d.OnFailureTask.toCreateStepOpts(make(map[int32]uuid.UUID))
+
+		if err != nil {
+			return nil, err
+		}
+
+		opts.OnFailure = &onFailure
+	}
+
+	return opts, nil
+}
+
+func toWorkflowDefinitionTask(task CreateStepOpts, orGroups map[int32]int32) (WorkflowDefinitionTask, error) {
+	res := WorkflowDefinitionTask{
+		Name:                    task.ReadableId,
+		Action:                  task.Action,
+		Parents:                 sortedStrings(task.Parents),
+		Timeout:                 task.Timeout,
+		ScheduleTimeout:         task.ScheduleTimeout,
+		Retries:                 task.Retries,
+		RetryBackoffFactor:      task.RetryBackoffFactor,
+		RetryBackoffMaxSeconds:  task.RetryBackoffMaxSeconds,
+		RetryBackoffStrategy:    task.RetryBackoffStrategy,
+		RetryBackoffBaseSeconds: task.RetryBackoffBaseSeconds,
+		IsDurable:               task.IsDurable,
+		Concurrency:             toWorkflowDefinitionConcurrency(task.Concurrency),
+	}
+
+	if len(task.SlotRequests) > 0 {
+		res.SlotRequests = task.SlotRequests
+	}
+
+	for _, rl := range task.RateLimits {
+		res.RateLimits = append(res.RateLimits, WorkflowDefinitionRateLimit{
+			Key:       rl.Key,
+			KeyExpr:   rl.KeyExpr,
+			Units:     rl.Units,
+			UnitsExpr: rl.UnitsExpr,
+			LimitExpr: rl.LimitExpr,
+			Duration:  rl.Duration,
+		})
+	}
+
+	sort.SliceStable(res.RateLimits, func(i, j int) bool {
+		return res.RateLimits[i].Key < res.RateLimits[j].Key
+	})
+
+	for _, rule := range task.RetryRules {
+		res.RetryRules = append(res.RetryRules, WorkflowDefinitionRetryRule{
+			ErrorType:          rule.ErrorType,
+			MaxRetries:         rule.MaxRetries,
+			BackoffStrategy:    rule.BackoffStrategy,
+			BackoffBaseSeconds: rule.BackoffBaseSeconds,
+		})
+	}
+
+	sort.SliceStable(res.RetryRules, func(i, j int) bool {
+		return res.RetryRules[i].ErrorType < res.RetryRules[j].ErrorType
+	})
+
+	for key, label := range task.DesiredWorkerLabels {
+		if label.Key != "" && label.Key != key {
+			return res, fmt.Errorf("%w: desired worker label '%s' of task '%s' has key '%s'", ErrWorkflowDefinitionNotRepresentable, key, task.ReadableId, label.Key)
+		}
+
+		if res.DesiredWorkerLabels == nil {
+			res.DesiredWorkerLabels = make(map[string]WorkflowDefinitionWorkerLabel, len(task.DesiredWorkerLabels))
+		}
+
+		res.DesiredWorkerLabels[key] = WorkflowDefinitionWorkerLabel{
+			IntValue:   label.IntValue,
+			StrValue:   label.StrValue,
+			Required:   label.Required,
+			Weight:     label.Weight,
+			Comparator: label.Comparator,
+		}
+	}
+
+	for _, condition := range task.TriggerConditions {
+		orGroup, ok := orGroups[condition.OrGroupIdIndex]
+
+		if !ok {
+			orGroup = int32(len(orGroups)) // nolint: gosec
+			orGroups[condition.OrGroupIdIndex] = orGroup
+		}
+
+		res.TriggerConditions = append(res.TriggerConditions, WorkflowDefinitionTriggerCondition{
+			Kind:            condition.MatchConditionKind,
+			Action:          condition.Action,
+			ReadableDataKey: condition.ReadableDataKey,
+			OrGroup:         orGroup,
+			Expression:      condition.Expression,
+			SleepDuration:   condition.SleepDuration,
+			EventKey:        condition.EventKey,
+			ParentName:      condition.ParentReadableId,
+		})
+	}
+
+	if len(task.OutputJsonSchema) > 0 {
+		if err := json.Unmarshal(task.OutputJsonSchema, &res.OutputJsonSchema); err != nil {
+			return res, fmt.Errorf("%w: the output JSON schema of task '%s' must be an object: %w", ErrWorkflowDefinitionNotRepresentable, task.ReadableId, err)
+		}
+	}
+
+	return res, nil
+}
+
+func (t *WorkflowDefinitionTask) toCreateStepOpts(orGroupIds map[int32]uuid.UUID) (CreateStepOpts, error) {
+	res := CreateStepOpts{
+		ReadableId:              t.Name,
+		Action:                  t.Action,
+		Parents:                 t.Parents,
+		Timeout:                 t.Timeout,
+		ScheduleTimeout:         t.ScheduleTimeout,
+		Retries:                 t.Retries,
+		RetryBackoffFactor:      t.RetryBackoffFactor,
+		RetryBackoffMaxSeconds:  t.RetryBackoffMaxSeconds,
+		RetryBackoffStrategy:    t.RetryBackoffStrategy,
+		RetryBackoffBaseSeconds: t.RetryBackoffBaseSeconds,
+		IsDurable:               t.IsDurable,
+		SlotRequests:            t.SlotRequests,
+		Concurrency:             fromWorkflowDefinitionConcurrency(t.Concurrency),
+	}
+
+	for _, rl := range t.RateLimits {
+		res.RateLimits = append(res.RateLimits, CreateWorkflowStepRateLimitOpts{
+			Key:       rl.Key,
+			KeyExpr:   rl.KeyExpr,
+			Units:     rl.Units,
+			UnitsExpr: rl.UnitsExpr,
+			LimitExpr: rl.LimitExpr,
+			Duration:  rl.Duration,
+		})
+	}
+
+	for _, rule := range t.RetryRules {
+		res.RetryRules = append(res.RetryRules, CreateStepRetryRuleOpts{
+			ErrorType:          rule.ErrorType,
+			MaxRetries:         rule.MaxRetries,
+			BackoffStrategy:    rule.BackoffStrategy,
+			BackoffBaseSeconds: rule.BackoffBaseSeconds,
+		})
+	}
+
+	if len(t.DesiredWorkerLabels) > 0 {
+		res.DesiredWorkerLabels = make(map[string]DesiredWorkerLabelOpts, len(t.DesiredWorkerLabels))
+
+		for key, label := range t.DesiredWorkerLabels {
+			res.DesiredWorkerLabels[key] = DesiredWorkerLabelOpts{
+				Key:        key,
+				IntValue:   label.IntValue,
+				StrValue:   label.StrValue,
+				Required:   label.Required,
+				Weight:     label.Weight,
+				Comparator: label.Comparator,
+			}
+		}
+	}
+
+	for _, condition := range t.TriggerConditions {
+		orGroupId, ok := orGroupIds[condition.OrGroup]
+
+		if !ok {
+			orGroupId = uuid.New()
+			orGroupIds[condition.OrGroup] = orGroupId
+		}
+
+		res.TriggerConditions = append(res.TriggerConditions, CreateStepMatchConditionOpt{
+			MatchConditionKind: condition.Kind,
+			Action:             condition.Action,
+			ReadableDataKey:    condition.ReadableDataKey,
+			OrGroupId:          orGroupId,
+			OrGroupIdIndex:     condition.OrGroup,
+			Expression:         condition.Expression,
+			SleepDuration:      condition.SleepDuration,
+			EventKey:           condition.EventKey,
+			ParentReadableId:   condition.ParentName,
+		})
+	}
+
+	if t.OutputJsonSchema != nil {
+		outputJsonSchema, err := json.Marshal(t.OutputJsonSchema)
+
+		if err != nil {
+			return res, fmt.Errorf("could not encode output JSON schema of task '%s': %w", t.Name, err)
+		}
+
+		res.OutputJsonSchema = outputJsonSchema
+	}
+
+	return res, nil
+}
+
+func toWorkflowDefinitionConcurrency(concurrency []CreateConcurrencyOpts) []WorkflowDefinitionConcurrency {
+	if len(concurrency) == 0 {
+		return nil
+	}
+
+	res := make([]WorkflowDefinitionConcurrency, 0, len(concurrency))
+
+	for _, c := range concurrency {
+		res = append(res, WorkflowDefinitionConcurrency{
+			Expression:       c.Expression,
+			MaxRuns:          c.MaxRuns,
+			LimitStrategy:    c.LimitStrategy,
+			WeightExpression: c.WeightExpression,
+		})
+	}
+
+	return res
+}
+
+func fromWorkflowDefinitionConcurrency(concurrency []WorkflowDefinitionConcurrency) []CreateConcurrencyOpts {
+	if len(concurrency) == 0 {
+		return nil
+	}
+
+	res := make([]CreateConcurrencyOpts, 0, len(concurrency))
+
+	for _, c := range concurrency {
+		res = append(res, CreateConcurrencyOpts{
+			Expression:       c.Expression,
+			MaxRuns:          c.MaxRuns,
+			LimitStrategy:    c.LimitStrategy,
+			WeightExpression: c.WeightExpression,
+		})
+	}
+
+	return res
+}
+
+func sortedStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(s))
+	copy(res, s)
+	sort.Strings(res)
+
+	return res
+}
+
+// DiffWorkflowDefinitions returns the changes between two workflow definitions, sorted by path. Tasks are matched by
+// name, rate limits by key and concurrency strategies by expression, so reordering them isn't reported as a change.
+func DiffWorkflowDefinitions(from, to *WorkflowDefinition) ([]WorkflowDefinitionChange, error) {
+	fromVal, err := toGenericJSON(from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	toVal, err := toGenericJSON(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]WorkflowDefinitionChange, 0)
+	diffValues("", fromVal, toVal, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+
+		return changeSortKey(changes[i]) < changeSortKey(changes[j])
+	})
+
+	return changes, nil
+}
+
+func changeSortKey(c WorkflowDefinitionChange) string {
+	var from, to string
+
+	if c.From != nil {
+		from = *c.From
+	}
+
+	if c.To != nil {
+		to = *c.To
+	}
+
+	return string(c.Kind) + from + to
+}
+
+func toGenericJSON(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encode workflow definition: %w", err)
+	}
+
+	var res interface{}
+
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, fmt.Errorf("could not decode workflow definition: %w", err)
+	}
+
+	return res, nil
+}
+
+func diffValues(path string, from, to interface{}, changes *[]WorkflowDefinitionChange) {
+	switch {
+	case from == nil && to == nil:
+		return
+	case from == nil:
+		*changes = append(*changes, WorkflowDefinitionChange{Path: path, Kind: WorkflowDefinitionChangeAdded, To: encodeValue(to)})
+		return
+	case to == nil:
+		*changes = append(*changes, WorkflowDefinitionChange{Path: path, Kind: WorkflowDefinitionChangeRemoved, From: encodeValue(from)})
+		return
+	}
+
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+
+	if fromIsMap && toIsMap {
+		keys := make(map[string]struct{}, len(fromMap)+len(toMap))
+
+		for k := range fromMap {
+			keys[k] = struct{}{}
+		}
+
+		for k := range toMap {
+			keys[k] = struct{}{}
+		}
+
+		for k := range keys {
+			diffValues(joinPath(path, k), fromMap[k], toMap[k], changes)
+		}
+
+		return
+	}
+
+	fromArr, fromIsArr := from.([]interface{})
+	toArr, toIsArr := to.([]interface{})
+
+	if fromIsArr && toIsArr {
+		diffArrays(path, fromArr, toArr, changes)
+		return
+	}
+
+	if *encodeValue(from) != *encodeValue(to) {
+		*changes = append(*changes, WorkflowDefinitionChange{Path: path, Kind: WorkflowDefinitionChangeChanged, From: encodeValue(from), To: encodeValue(to)})
+	}
+}
+
+// diffArrays compares arrays of objects element by element, matched by their identifying key, and arrays of scalars
+// as sets.
+func diffArrays(path string, from, to []interface{}, changes *[]WorkflowDefinitionChange) {
+	fromKeyed, fromOk := keyElements(from)
+	toKeyed, toOk := keyElements(to)
+
+	if !fromOk || !toOk {
+		for i := 0; i < max(len(from), len(to)); i++ {
+			var fromElem, toElem interface{}
+
+			if i < len(from) {
+				fromElem = from[i]
+			}
+
+			if i < len(to) {
+				toElem = to[i]
+			}
+
+			diffValues(fmt.Sprintf("%s[%d]", path, i), fromElem, toElem, changes)
+		}
+
+		return
+	}
+
+	for k, fromElem := range fromKeyed {
+		toElem, ok := toKeyed[k]
+
+		if !ok {
+			if isScalar(fromElem) {
+				*changes = append(*changes, WorkflowDefinitionChange{Path: path, Kind: WorkflowDefinitionChangeRemoved, From: encodeValue(fromElem)})
+			} else {
+				diffValues(fmt.Sprintf("%s[%s]", path, k), fromElem, nil, changes)
+			}
+
+			continue
+		}
+
+		if !isScalar(fromElem) {
+			diffValues(fmt.Sprintf("%s[%s]", path, k), fromElem, toElem, changes)
+		}
+	}
+
+	for k, toElem := range toKeyed {
+		if _, ok := fromKeyed[k]; ok {
+			continue
+		}
+
+		if isScalar(toElem) {
+			*changes = append(*changes, WorkflowDefinitionChange{Path: path, Kind: WorkflowDefinitionChangeAdded, To: encodeValue(toElem)})
+		} else {
+			diffValues(fmt.Sprintf("%s[%s]", path, k), nil, toElem, changes)
+		}
+	}
+}
+
+var workflowDefinitionElementKeys = []string{"name", "key", "expression", "errorType"}
+
+// keyElements indexes the elements of an array by their identity. It returns false if the elements can't be
+// identified, in which case the array is compared by position.
+func keyElements(arr []interface{}) (map[string]interface{}, bool) {
+	res := make(map[string]interface{}, len(arr))
+
+	for _, elem := range arr {
+		var key string
+
+		if m, ok := elem.(map[string]interface{}); ok {
+			for _, k := range workflowDefinitionElementKeys {
+				if v, ok := m[k].(string); ok && v != "" {
+					key = v
+					break
+				}
+			}
+		} else {
+			key = *encodeValue(elem)
+		}
+
+		if key == "" {
+			return nil, false
+		}
+
+		if _, exists := res[key]; exists {
+			return nil, false
+		}
+
+		res[key] = elem
+	}
+
+	return res, true
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	default:
+		return true
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func encodeValue(v interface{}) *string {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		s := fmt.Sprintf("%v", v)
+		return &s
+	}
+
+	s := strings.TrimSuffix(buf.String(), "\n")
+
+	return &s
+}
+
+func (r *workflowRepository) GetWorkflowDefinition(ctx context.Context, tenantId, workflowId uuid.UUID, version string) (*WorkflowDefinition, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-workflow-definition")
+	defer span.End()
+
+	var workflowVersionId uuid.UUID
+	var err error
+
+	switch {
+	case version == "" || version == "latest":
+		workflowVersionId, err = r.queries.GetWorkflowLatestVersion(ctx, r.pool, workflowId)
+	case uuid.Validate(version) == nil:
+		workflowVersionId = uuid.MustParse(version)
+	default:
+		workflowVersionId, err = r.queries.GetWorkflowVersionIdByVersion(ctx, r.pool, sqlcv1.GetWorkflowVersionIdByVersionParams{
+			Workflowid: workflowId,
+			Version:    version,
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := r.queries.GetWorkflowVersionForEngine(ctx, r.pool, sqlcv1.GetWorkflowVersionForEngineParams{
+		Tenantid: tenantId,
+		Ids:      []uuid.UUID{workflowVersionId},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow version: %w", err)
+	}
+
+	if len(versions) != 1 || versions[0].WorkflowVersion.WorkflowId != workflowId {
+		return nil, pgx.ErrNoRows
+	}
+
+	if len(versions[0].WorkflowVersion.CreateWorkflowVersionOpts) == 0 {
+		return nil, ErrWorkflowDefinitionNotStored
+	}
+
+	var opts CreateWorkflowVersionOpts
+
+	if err := json.Unmarshal(versions[0].WorkflowVersion.CreateWorkflowVersionOpts, &opts); err != nil {
+		return nil, fmt.Errorf("could not decode workflow version options: %w", err)
+	}
+
+	// versions registered before the version was stored in the options still have it on the workflow version
+	if opts.Version == "" && versions[0].WorkflowVersion.Version.Valid {
+		opts.Version = versions[0].WorkflowVersion.Version.String
+	}
+
+	return NewWorkflowDefinition(&opts)
+}
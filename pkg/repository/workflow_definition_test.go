@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+func definitionTestOpts() *CreateWorkflowVersionOpts {
+	retries := 3
+	units := 1
+	maxRuns := int32(5)
+	strategy := "GROUP_ROUND_ROBIN"
+
+	return &CreateWorkflowVersionOpts{
+		Name:          "process-order",
+		Version:       "v1",
+		EventTriggers: []string{"order:updated", "order:created"},
+		CronTriggers:  []string{"0 * * * *"},
+		CronInput:     []byte(`{"source":"cron"}`),
+		Concurrency: []CreateConcurrencyOpts{
+			{
+				Expression:    "input.customer_id",
+				MaxRuns:       &maxRuns,
+				LimitStrategy: &strategy,
+			},
+		},
+		Tasks: []CreateStepOpts{
+			{
+				ReadableId: "ship",
+				Action:     "process-order:ship",
+				Parents:    []string{"reserve", "charge"},
+				Retries:    &retries,
+				RateLimits: []CreateWorkflowStepRateLimitOpts{
+					{Key: "shipping", Units: &units},
+					{Key: "carrier", Units: &units},
+				},
+			},
+			{
+				ReadableId: "charge",
+				Action:     "process-order:charge",
+			},
+			{
+				ReadableId: "reserve",
+				Action:     "process-order:reserve",
+			},
+		},
+	}
+}
+
+func TestNewWorkflowDefinition_IsCanonical(t *testing.T) {
+	def, err := NewWorkflowDefinition(definitionTestOpts())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"order:created", "order:updated"}, def.EventTriggers)
+	assert.Equal(t, map[string]interface{}{"source": "cron"}, def.CronInput)
+
+	require.Len(t, def.Tasks, 3)
+	assert.Equal(t, "charge", def.Tasks[0].Name)
+	assert.Equal(t, "reserve", def.Tasks[1].Name)
+	assert.Equal(t, "ship", def.Tasks[2].Name)
+	assert.Equal(t, []string{"charge", "reserve"}, def.Tasks[2].Parents)
+	assert.Equal(t, "carrier", def.Tasks[2].RateLimits[0].Key)
+
+	// reordering the options doesn't change the serialized definition
+	reordered := definitionTestOpts()
+	reordered.Tasks[0], reordered.Tasks[2] = reordered.Tasks[2], reordered.Tasks[0]
+	reordered.EventTriggers = []string{"order:created", "order:updated"}
+
+	reorderedDef, err := NewWorkflowDefinition(reordered)
+	require.NoError(t, err)
+
+	a, err := json.Marshal(def)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(reorderedDef)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(a), string(b))
+}
+
+func TestWorkflowDefinition_RoundTrip(t *testing.T) {
+	def, err := NewWorkflowDefinition(definitionTestOpts())
+	require.NoError(t, err)
+
+	opts, err := def.ToCreateWorkflowVersionOpts()
+	require.NoError(t, err)
+
+	roundTripped, err := NewWorkflowDefinition(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, def, roundTripped)
+}
+
+// fullDefinitionTestOpts sets every option a workflow version can be created with
+func fullDefinitionTestOpts() *CreateWorkflowVersionOpts {
+	i32 := func(i int32) *int32 { return &i }
+	f64 := func(f float64) *float64 { return &f }
+	str := func(s string) *string { return &s }
+	boolean := func(b bool) *bool { return &b }
+
+	retries := 3
+	maxSeconds := 60
+	units := 1
+
+	task := func(name string) CreateStepOpts {
+		orGroupA, orGroupB := uuid.New(), uuid.New()
+
+		return CreateStepOpts{
+			ReadableId:              name,
+			Action:                  "process-order:" + name,
+			Timeout:                 str("30s"),
+			ScheduleTimeout:         str("5m"),
+			Parents:                 []string{"reserve"},
+			Retries:                 &retries,
+			RateLimits:              []CreateWorkflowStepRateLimitOpts{{Key: "carrier", Units: &units}},
+			RetryBackoffFactor:      f64(2),
+			RetryBackoffMaxSeconds:  &maxSeconds,
+			RetryBackoffStrategy:    str("FULL_JITTER"),
+			RetryBackoffBaseSeconds: f64(0.5),
+			RetryRules: []CreateStepRetryRuleOpts{
+				{ErrorType: "TimeoutError", MaxRetries: i32(5), BackoffStrategy: str("FIXED"), BackoffBaseSeconds: f64(1)},
+				{ErrorType: "RateLimitError", MaxRetries: i32(10)},
+			},
+			IsDurable: true,
+			DesiredWorkerLabels: map[string]DesiredWorkerLabelOpts{
+				"region": {Key: "region", StrValue: str("eu"), Required: boolean(true), Comparator: str("EQUAL")},
+				"memory": {Key: "memory", IntValue: i32(4096), Weight: i32(10), Comparator: str("GREATER_THAN_OR_EQUAL")},
+			},
+			SlotRequests: map[string]int32{"default": 1, "gpu": 2},
+			TriggerConditions: []CreateStepMatchConditionOpt{
+				{MatchConditionKind: "SLEEP", ReadableDataKey: "sleep", Action: "QUEUE", OrGroupId: orGroupA, OrGroupIdIndex: 4, SleepDuration: str("10s")},
+				{MatchConditionKind: "USER_EVENT", ReadableDataKey: "approved", Action: "QUEUE", OrGroupId: orGroupA, OrGroupIdIndex: 4, EventKey: str("order:approved"), Expression: "input.approved"},
+				{MatchConditionKind: "PARENT_OVERRIDE", ReadableDataKey: "reserve", Action: "SKIP", OrGroupId: orGroupB, OrGroupIdIndex: 7, ParentReadableId: str("reserve"), Expression: "output.skip"},
+			},
+			Concurrency: []CreateConcurrencyOpts{
+				{Expression: "input.customer_id", MaxRuns: i32(1), LimitStrategy: str("GROUP_ROUND_ROBIN")},
+			},
+			OutputJsonSchema: []byte(`{"type":"object"}`),
+		}
+	}
+
+	onFailure := task("on-failure")
+
+	return &CreateWorkflowVersionOpts{
+		Name:             "process-order",
+		Description:      str("Processes an order"),
+		Version:          "v1",
+		EventTriggers:    []string{"order:created"},
+		CronTriggers:     []string{"0 * * * *"},
+		CronInput:        []byte(`{"source":"cron"}`),
+		Concurrency:      []CreateConcurrencyOpts{{Expression: "input.tier", MaxRuns: i32(5), LimitStrategy: str("WEIGHTED_FAIR"), WeightExpression: str("key == 'gold' ? 10 : 1")}},
+		Sticky:           str("SOFT"),
+		DefaultPriority:  i32(2),
+		DefaultFilters:   []types.DefaultFilter{{Expression: "input.region == 'eu'", Scope: "eu", Payload: map[string]interface{}{"region": "eu"}}},
+		InputJsonSchema:  []byte(`{"properties":{"order_id":{"type":"string"}},"type":"object"}`),
+		CanaryPercentage: i32(10),
+		Tasks: []CreateStepOpts{
+			{ReadableId: "reserve", Action: "process-order:reserve"},
+			task("ship"),
+		},
+		OnFailure: &onFailure,
+	}
+}
+
+func TestWorkflowDefinition_RoundTripsEveryOption(t *testing.T) {
+	opts := fullDefinitionTestOpts()
+
+	// a new option has to be added to the definition, or export would silently drop it
+	assertAllFieldsSet(t, *opts)
+	assertAllFieldsSet(t, opts.Tasks[1])
+
+	exported, err := NewWorkflowDefinition(opts)
+	require.NoError(t, err)
+
+	// definitions are moved between environments as JSON
+	exportedJSON, err := json.Marshal(exported)
+	require.NoError(t, err)
+
+	var received WorkflowDefinition
+	require.NoError(t, json.Unmarshal(exportedJSON, &received))
+
+	imported, err := received.ToCreateWorkflowVersionOpts()
+	require.NoError(t, err)
+
+	reexported, err := NewWorkflowDefinition(imported)
+	require.NoError(t, err)
+
+	reexportedJSON, err := json.Marshal(reexported)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(exportedJSON), string(reexportedJSON))
+
+	// the imported options are the options the workflow was created with, up to the generated or group ids
+	ship := imported.Tasks[1]
+	require.Equal(t, "ship", ship.ReadableId)
+	require.Len(t, ship.TriggerConditions, 3)
+	assert.Equal(t, ship.TriggerConditions[0].OrGroupId, ship.TriggerConditions[1].OrGroupId)
+	assert.NotEqual(t, ship.TriggerConditions[0].OrGroupId, ship.TriggerConditions[2].OrGroupId)
+	assert.NotEqual(t, ship.TriggerConditions[2].OrGroupId, imported.OnFailure.TriggerConditions[2].OrGroupId)
+
+	expected := fullDefinitionTestOpts()
+	normalizeDefinitionTestOpts(expected)
+	normalizeDefinitionTestOpts(imported)
+
+	assert.Equal(t, expected, imported)
+}
+
+// normalizeDefinitionTestOpts makes options which only differ in ordering and or group ids comparable
+func normalizeDefinitionTestOpts(opts *CreateWorkflowVersionOpts) {
+	orGroups := make(map[uuid.UUID]int32)
+
+	normalizeTask := func(task *CreateStepOpts) {
+		for i := range task.TriggerConditions {
+			c := &task.TriggerConditions[i]
+
+			if _, ok := orGroups[c.OrGroupId]; !ok {
+				orGroups[c.OrGroupId] = int32(len(orGroups)) // nolint: gosec
+			}
+
+			c.OrGroupIdIndex = orGroups[c.OrGroupId]
+			c.OrGroupId = uuid.Nil
+		}
+
+		if len(task.RetryRules) > 1 && task.RetryRules[0].ErrorType > task.RetryRules[1].ErrorType {
+			task.RetryRules[0], task.RetryRules[1] = task.RetryRules[1], task.RetryRules[0]
+		}
+	}
+
+	for i := range opts.Tasks {
+		normalizeTask(&opts.Tasks[i])
+	}
+
+	if opts.OnFailure != nil {
+		orGroups = make(map[uuid.UUID]int32)
+		normalizeTask(opts.OnFailure)
+	}
+}
+
+func assertAllFieldsSet(t *testing.T, v interface{}) {
+	t.Helper()
+
+	rv := reflect.ValueOf(v)
+
+	for i := 0; i < rv.NumField(); i++ {
+		assert.False(t, rv.Field(i).IsZero(), "%s.%s is not set", rv.Type().Name(), rv.Type().Field(i).Name)
+	}
+}
+
+func TestNewWorkflowDefinition_RejectsUnrepresentableOptions(t *testing.T) {
+	opts := definitionTestOpts()
+	opts.InputJsonSchema = []byte(`true`)
+
+	_, err := NewWorkflowDefinition(opts)
+	assert.ErrorIs(t, err, ErrWorkflowDefinitionNotRepresentable)
+
+	opts = definitionTestOpts()
+	opts.Tasks[0].DesiredWorkerLabels = map[string]DesiredWorkerLabelOpts{
+		"region": {Key: "zone"},
+	}
+
+	_, err = NewWorkflowDefinition(opts)
+	assert.ErrorIs(t, err, ErrWorkflowDefinitionNotRepresentable)
+}
+
+func TestDiffWorkflowDefinitions(t *testing.T) {
+	from, err := NewWorkflowDefinition(definitionTestOpts())
+	require.NoError(t, err)
+
+	t.Run("identical definitions have no changes", func(t *testing.T) {
+		changes, err := DiffWorkflowDefinitions(from, from)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("reports added, removed and changed values", func(t *testing.T) {
+		toOpts := definitionTestOpts()
+		toOpts.Version = "v2"
+		toOpts.EventTriggers = []string{"order:created", "order:paid"}
+
+		retries := 5
+		toOpts.Tasks[0].Retries = &retries
+		toOpts.Tasks[0].RateLimits = toOpts.Tasks[0].RateLimits[:1]
+		toOpts.Tasks = append(toOpts.Tasks, CreateStepOpts{
+			ReadableId: "notify",
+			Action:     "process-order:notify",
+			Parents:    []string{"ship"},
+		})
+
+		to, err := NewWorkflowDefinition(toOpts)
+		require.NoError(t, err)
+
+		changes, err := DiffWorkflowDefinitions(from, to)
+		require.NoError(t, err)
+
+		str := func(s string) *string { return &s }
+
+		assert.Equal(t, []WorkflowDefinitionChange{
+			{Path: "eventTriggers", Kind: WorkflowDefinitionChangeAdded, To: str(`"order:paid"`)},
+			{Path: "eventTriggers", Kind: WorkflowDefinitionChangeRemoved, From: str(`"order:updated"`)},
+			{Path: "tasks[notify]", Kind: WorkflowDefinitionChangeAdded, To: str(`{"action":"process-order:notify","name":"notify","parents":["ship"]}`)},
+			{Path: "tasks[ship].rateLimits[carrier]", Kind: WorkflowDefinitionChangeRemoved, From: str(`{"key":"carrier","units":1}`)},
+			{Path: "tasks[ship].retries", Kind: WorkflowDefinitionChangeChanged, From: str("3"), To: str("5")},
+			{Path: "version", Kind: WorkflowDefinitionChangeChanged, From: str(`"v1"`), To: str(`"v2"`)},
+		}, changes)
+	})
+}
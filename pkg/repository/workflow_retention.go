@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// MinWorkflowRetentionPeriod is the shortest retention period which can be set for a workflow
+const MinWorkflowRetentionPeriod = time.Hour
+
+type UpsertWorkflowRetentionPolicyOpts struct {
+	// (required) the retention period of runs of the workflow
+	RetentionPeriod time.Duration
+
+	// (optional) the retention period of completed runs, which overrides RetentionPeriod
+	CompletedRetentionPeriod *time.Duration
+
+	// (optional) the retention period of failed runs, which overrides RetentionPeriod
+	FailedRetentionPeriod *time.Duration
+
+	// (optional) the retention period of cancelled runs, which overrides RetentionPeriod
+	CancelledRetentionPeriod *time.Duration
+}
+
+type WorkflowRetentionRepository interface {
+	// UpsertWorkflowRetentionPolicy sets the retention policy of the workflow, replacing the existing policy
+	UpsertWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID, opts *UpsertWorkflowRetentionPolicyOpts) (*sqlcv1.V1WorkflowRetentionPolicy, error)
+
+	// GetWorkflowRetentionPolicy returns the retention policy of the workflow, or pgx.ErrNoRows if it doesn't have one
+	GetWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID) (*sqlcv1.V1WorkflowRetentionPolicy, error)
+
+	// ListWorkflowRetentionPolicies returns the retention policies of the tenant, oldest first
+	ListWorkflowRetentionPolicies(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1WorkflowRetentionPolicy, error)
+
+	// DeleteWorkflowRetentionPolicy deletes the retention policy of the workflow, so its runs are kept for the
+	// default retention period again
+	DeleteWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID) error
+}
+
+type workflowRetentionRepository struct {
+	*sharedRepository
+}
+
+func newWorkflowRetentionRepository(shared *sharedRepository) WorkflowRetentionRepository {
+	return &workflowRetentionRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *workflowRetentionRepository) UpsertWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID, opts *UpsertWorkflowRetentionPolicyOpts) (*sqlcv1.V1WorkflowRetentionPolicy, error) {
+	if err := r.validateRetentionPeriod("retention period", &opts.RetentionPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateRetentionPeriod("completed retention period", opts.CompletedRetentionPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateRetentionPeriod("failed retention period", opts.FailedRetentionPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateRetentionPeriod("cancelled retention period", opts.CancelledRetentionPeriod); err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertWorkflowRetentionPolicy(ctx, r.pool, sqlcv1.UpsertWorkflowRetentionPolicyParams{
+		Tenantid:                 tenantId,
+		Workflowid:               workflowId,
+		Retentionperiod:          sqlchelpers.DurationToPgInterval(opts.RetentionPeriod),
+		Completedretentionperiod: optionalInterval(opts.CompletedRetentionPeriod),
+		Failedretentionperiod:    optionalInterval(opts.FailedRetentionPeriod),
+		Cancelledretentionperiod: optionalInterval(opts.CancelledRetentionPeriod),
+	})
+}
+
+func (r *workflowRetentionRepository) GetWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID) (*sqlcv1.V1WorkflowRetentionPolicy, error) {
+	return r.queries.GetWorkflowRetentionPolicy(ctx, r.pool, sqlcv1.GetWorkflowRetentionPolicyParams{
+		Tenantid:   tenantId,
+		Workflowid: workflowId,
+	})
+}
+
+func (r *workflowRetentionRepository) ListWorkflowRetentionPolicies(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1WorkflowRetentionPolicy, error) {
+	return r.queries.ListWorkflowRetentionPolicies(ctx, r.pool, tenantId)
+}
+
+func (r *workflowRetentionRepository) DeleteWorkflowRetentionPolicy(ctx context.Context, tenantId, workflowId uuid.UUID) error {
+	return r.queries.DeleteWorkflowRetentionPolicy(ctx, r.pool, sqlcv1.DeleteWorkflowRetentionPolicyParams{
+		Tenantid:   tenantId,
+		Workflowid: workflowId,
+	})
+}
+
+func (r *workflowRetentionRepository) validateRetentionPeriod(name string, d *time.Duration) error {
+	if d == nil {
+		return nil
+	}
+
+	if *d < MinWorkflowRetentionPeriod {
+		return fmt.Errorf("%s must be at least %s", name, MinWorkflowRetentionPeriod)
+	}
+
+	if *d > r.maxWorkflowRetentionPeriod {
+		return fmt.Errorf("%s must be at most %s", name, r.maxWorkflowRetentionPeriod)
+	}
+
+	return nil
+}
+
+func optionalInterval(d *time.Duration) pgtype.Interval {
+	if d == nil {
+		return pgtype.Interval{}
+	}
+
+	return sqlchelpers.DurationToPgInterval(*d)
+}
+
+// partitionRetentionPeriod returns how long partitions are kept for, which is the longer of the default retention
+// period and the longest retention period which can be set for a workflow. Runs which expire before their partition
+// is dropped are deleted row by row.
+func (s *sharedRepository) partitionRetentionPeriod(defaultRetentionPeriod time.Duration) time.Duration {
+	return max(defaultRetentionPeriod, s.maxWorkflowRetentionPeriod)
+}
+
+// shortestRetentionPeriod returns the shortest retention period of any workflow, or false if every run is kept until
+// its partition is dropped, in which case runs don't need to be deleted row by row
+func (s *sharedRepository) shortestRetentionPeriod(ctx context.Context, defaultRetentionPeriod time.Duration) (time.Duration, bool, error) {
+	shortestWorkflowRetentionPeriod, err := s.queries.GetShortestWorkflowRetentionPeriod(ctx, s.pool)
+
+	if err != nil {
+		return 0, false, fmt.Errorf("could not get shortest workflow retention period: %w", err)
+	}
+
+	shortest := defaultRetentionPeriod
+
+	if shortestWorkflowRetentionPeriod.Valid {
+		shortest = min(shortest, sqlchelpers.PgIntervalToDuration(shortestWorkflowRetentionPeriod))
+	}
+
+	if shortest >= s.partitionRetentionPeriod(defaultRetentionPeriod) {
+		return 0, false, nil
+	}
+
+	return shortest, true, nil
+}
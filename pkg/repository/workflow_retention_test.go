@@ -0,0 +1,67 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+)
+
+func TestUpsertWorkflowRetentionPolicy_RejectsShortPeriods(t *testing.T) {
+	r := &workflowRetentionRepository{sharedRepository: &sharedRepository{maxWorkflowRetentionPeriod: 90 * 24 * time.Hour}}
+	short := 30 * time.Minute
+
+	// validation happens before the database is used, so a repository without a pool is enough
+	for _, opts := range []*UpsertWorkflowRetentionPolicyOpts{
+		{RetentionPeriod: short},
+		{RetentionPeriod: time.Hour, CompletedRetentionPeriod: &short},
+		{RetentionPeriod: time.Hour, FailedRetentionPeriod: &short},
+		{RetentionPeriod: time.Hour, CancelledRetentionPeriod: &short},
+	} {
+		_, err := r.UpsertWorkflowRetentionPolicy(context.Background(), uuid.New(), uuid.New(), opts)
+		assert.ErrorContains(t, err, "must be at least 1h0m0s")
+	}
+}
+
+func TestUpsertWorkflowRetentionPolicy_RejectsLongPeriods(t *testing.T) {
+	r := &workflowRetentionRepository{sharedRepository: &sharedRepository{maxWorkflowRetentionPeriod: 90 * 24 * time.Hour}}
+	long := 876000 * time.Hour
+
+	for _, opts := range []*UpsertWorkflowRetentionPolicyOpts{
+		{RetentionPeriod: long},
+		{RetentionPeriod: time.Hour, FailedRetentionPeriod: &long},
+	} {
+		_, err := r.UpsertWorkflowRetentionPolicy(context.Background(), uuid.New(), uuid.New(), opts)
+		assert.ErrorContains(t, err, "must be at most 2160h0m0s")
+	}
+}
+
+func TestPartitionRetentionPeriod(t *testing.T) {
+	s := &sharedRepository{maxWorkflowRetentionPeriod: 90 * 24 * time.Hour}
+
+	assert.Equal(t, 90*24*time.Hour, s.partitionRetentionPeriod(30*24*time.Hour))
+	assert.Equal(t, 120*24*time.Hour, s.partitionRetentionPeriod(120*24*time.Hour))
+}
+
+func TestOptionalInterval(t *testing.T) {
+	assert.False(t, optionalInterval(nil).Valid, "unset overrides are stored as null")
+
+	d := 90 * 24 * time.Hour
+	i := optionalInterval(&d)
+
+	require.True(t, i.Valid)
+	assert.Equal(t, d, sqlchelpers.PgIntervalToDuration(i))
+}
+
+func TestPgIntervalToDuration(t *testing.T) {
+	assert.Equal(t, 36*time.Hour, sqlchelpers.PgIntervalToDuration(pgtype.Interval{Days: 1, Microseconds: 12 * 60 * 60 * 1_000_000, Valid: true}))
+	assert.Equal(t, 30*24*time.Hour, sqlchelpers.PgIntervalToDuration(pgtype.Interval{Months: 1, Valid: true}))
+}
@@ -102,6 +102,18 @@ type CreateCronWorkflowTriggerOpts struct {
 	WorkflowId         uuid.UUID `validate:"required"`
 	Name               string    `validate:"required"`
 	Cron               string    `validate:"required,cron"`
+
+	// (optional) the IANA timezone which the cron expression is evaluated in, defaults to UTC
+	Timezone *string `validate:"omitempty,timezone"`
+
+	// (optional) what to do with runs which were missed while no ticker was running, defaults to SKIP
+	MisfirePolicy *sqlcv1.WorkflowTriggerCronRefMisfirePolicy `validate:"omitempty,oneof=SKIP FIRE_ONCE FIRE_ALL"`
+
+	// (optional) the maximum number of missed runs to fire with the FIRE_ALL misfire policy
+	MisfireLimit *int32 `validate:"omitempty,min=1,max=1000"`
+
+	// (optional) what to do when the cron fires while its previous run is still running, defaults to ALLOW
+	OverlapPolicy *sqlcv1.WorkflowTriggerCronRefOverlapPolicy `validate:"omitempty,oneof=ALLOW SKIP_IF_RUNNING CANCEL_PREVIOUS"`
 }
 
 type WorkflowScheduleRepository interface {
@@ -145,6 +157,15 @@ type WorkflowScheduleRepository interface {
 	UpdateCronWorkflow(ctx context.Context, tenantId, id uuid.UUID, opts *UpdateCronOpts) error
 
 	DeleteInvalidCron(ctx context.Context, id uuid.UUID) error
+
+	// RecordCronFire records the outcome of a cron firing and prunes the oldest fires of the cron
+	RecordCronFire(ctx context.Context, tenantId uuid.UUID, opts *RecordCronFireOpts) error
+
+	// GetLatestTriggeredCronFire gets the most recent fire of a cron which triggered a workflow run, or nil if there is none
+	GetLatestTriggeredCronFire(ctx context.Context, tenantId, cronId uuid.UUID) (*sqlcv1.V1CronFire, error)
+
+	// ListCronFires lists the most recent fires of a cron
+	ListCronFires(ctx context.Context, tenantId, cronId uuid.UUID, opts *ListCronFiresOpts) ([]*sqlcv1.V1CronFire, int64, error)
 }
 
 type workflowScheduleRepository struct {
@@ -172,6 +193,10 @@ func (w *workflowScheduleRepository) CreateScheduledWorkflow(ctx context.Context
 		return nil, err
 	}
 
+	if err := w.validateWorkflowIdInput(ctx, tenantId, opts.WorkflowId, opts.Input); err != nil {
+		return nil, err
+	}
+
 	var priority int32 = 1
 
 	if opts.Priority != nil {
@@ -511,6 +536,9 @@ func (w *workflowScheduleRepository) UpdateCronWorkflow(ctx context.Context, ten
 }
 
 func (w *workflowScheduleRepository) CreateCronWorkflow(ctx context.Context, tenantId uuid.UUID, opts *CreateCronWorkflowTriggerOpts) (*sqlcv1.ListCronWorkflowsRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
 
 	var input, additionalMetadata []byte
 	var err error
@@ -531,6 +559,10 @@ func (w *workflowScheduleRepository) CreateCronWorkflow(ctx context.Context, ten
 		}
 	}
 
+	if err := w.validateWorkflowIdInput(ctx, tenantId, opts.WorkflowId, input); err != nil {
+		return nil, err
+	}
+
 	var priority int32 = 1
 
 	if opts.Priority != nil {
@@ -550,6 +582,28 @@ func (w *workflowScheduleRepository) CreateCronWorkflow(ctx context.Context, ten
 		Priority: sqlchelpers.ToInt(&priority),
 	}
 
+	if opts.Timezone != nil {
+		createParams.Timezone = sqlchelpers.TextFromStr(*opts.Timezone)
+	}
+
+	if opts.MisfirePolicy != nil {
+		createParams.MisfirePolicy = sqlcv1.NullWorkflowTriggerCronRefMisfirePolicy{
+			Valid:                               true,
+			WorkflowTriggerCronRefMisfirePolicy: *opts.MisfirePolicy,
+		}
+	}
+
+	if opts.MisfireLimit != nil {
+		createParams.MisfireLimit = sqlchelpers.ToInt(opts.MisfireLimit)
+	}
+
+	if opts.OverlapPolicy != nil {
+		createParams.OverlapPolicy = sqlcv1.NullWorkflowTriggerCronRefOverlapPolicy{
+			Valid:                               true,
+			WorkflowTriggerCronRefOverlapPolicy: *opts.OverlapPolicy,
+		}
+	}
+
 	cronTrigger, err := w.queries.CreateWorkflowTriggerCronRefForWorkflow(ctx, w.pool, createParams)
 
 	if err != nil {
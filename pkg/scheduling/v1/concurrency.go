@@ -69,8 +69,11 @@ func newConcurrencyManager(conf *sharedConfig, tenantId uuid.UUID, strategy *sql
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// WEIGHTED_FAIR weights each key individually, which is only implemented by the in-memory index
+	useInMemoryIndex := conf.concurrencyInMemoryIndexEnabled || strategy.Strategy == sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR
+
 	var concurrencyStrategy *concurrency.ConcurrencyStrategy
-	if conf.concurrencyInMemoryIndexEnabled && !strategy.ParentStrategyID.Valid {
+	if useInMemoryIndex && !strategy.ParentStrategyID.Valid {
 		concurrencyStrategy = concurrency.NewConcurrencyStrategy(ctx, repo, strategy, conf.outbox, &l)
 	} else {
 		concurrency.NewNoOpFlusher(ctx, conf.outbox, strategy, &l)
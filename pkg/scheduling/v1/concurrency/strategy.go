@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	outboxsqlc "github.com/hatchet-dev/pgoutbox/sqlc"
 	"github.com/jackc/pgx/v5"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
@@ -23,6 +25,10 @@ import (
 const (
 	minBackoffDuration = 100 * time.Millisecond
 	maxBackoffDuration = 10 * time.Second
+
+	// maxConcurrencyWeight caps the weight of a single WEIGHTED_FAIR key, so that a misconfigured
+	// expression can't hand one key an effectively unbounded number of slots.
+	maxConcurrencyWeight = 1000
 )
 
 type ConcurrencyStrategy struct {
@@ -36,6 +42,7 @@ type ConcurrencyStrategy struct {
 	strategy       *sqlcv1.V1StepConcurrency
 	l              *zerolog.Logger
 	compare        func(a, b slot) int
+	weight         func(key string, task *repository.ConcurrencyWeightInput) int32
 	built          chan struct{}
 	topic          string
 	pending        []*repository.RunConcurrencyResult
@@ -111,6 +118,8 @@ func NewConcurrencyStrategy(
 		built:     make(chan struct{}),
 	}
 
+	c.weight = c.newWeightFn()
+
 	outbox.AddFlusher(c.topic, c)
 
 	go c.buildIndexLoop(ctx)
@@ -118,6 +127,154 @@ func NewConcurrencyStrategy(
 	return c
 }
 
+// newWeightFn returns the function used to weight each concurrency key, given the input and additional
+// metadata of a task queued for the key (nil if unknown). Every strategy other than WEIGHTED_FAIR gives
+// each key a weight of 1, as does a WEIGHTED_FAIR strategy whose weight expression fails to compile or
+// evaluate, so that a bad expression degrades to GROUP_ROUND_ROBIN rather than stalling the queue.
+func (c *ConcurrencyStrategy) newWeightFn() func(key string, task *repository.ConcurrencyWeightInput) int32 {
+	uniform := func(string, *repository.ConcurrencyWeightInput) int32 { return 1 }
+
+	if c.strategy.Strategy != sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR || !c.strategy.WeightExpression.Valid {
+		return uniform
+	}
+
+	parser := cel.NewCELParser()
+
+	prg, err := parser.ParseConcurrencyWeight(c.strategy.WeightExpression.String)
+
+	if err != nil {
+		c.l.Error().Err(err).Msgf("invalid weight expression for concurrency strategy %d, using a weight of 1", c.strategy.ID)
+		return uniform
+	}
+
+	return func(key string, task *repository.ConcurrencyWeightInput) int32 {
+		var input, additionalMetadata map[string]interface{}
+
+		if task != nil {
+			input = task.Input
+			additionalMetadata = task.AdditionalMetadata
+		}
+
+		w, err := parser.EvaluateConcurrencyWeight(prg, key, input, additionalMetadata)
+
+		if err != nil {
+			c.l.Warn().Err(err).Msgf("could not evaluate weight for concurrency key %s, using a weight of 1", key)
+			return 1
+		}
+
+		return int32(min(max(w, 1), maxConcurrencyWeight)) //nolint:gosec // clamped to maxConcurrencyWeight
+	}
+}
+
+// weighsTasks returns whether the weight of a key depends on the tasks queued for it, i.e. whether the
+// strategy is WEIGHTED_FAIR with a weight expression which can read a task's input and additional metadata.
+func (c *ConcurrencyStrategy) weighsTasks() bool {
+	return c.strategy.Strategy == sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR && c.strategy.WeightExpression.Valid
+}
+
+// listWeightInputs returns the input and additional metadata of the task of each key, keyed by task id.
+func (c *ConcurrencyStrategy) listWeightInputs(ctx context.Context, tasks map[string]repository.TaskIdInsertedAtRetryCount) (map[int64]*repository.ConcurrencyWeightInput, error) {
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	toList := make([]repository.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	for _, task := range tasks {
+		toList = append(toList, task)
+	}
+
+	inputs, err := c.repo.ListConcurrencyWeightInputs(ctx, c.strategy.TenantID, toList)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list concurrency weight inputs: %w", err)
+	}
+
+	return inputs, nil
+}
+
+// createWeightedSubQueues creates the sub-queue of every new key in grouped for a WEIGHTED_FAIR strategy. The
+// weight expression can read a task's input and additional metadata, so each new key is weighed against the
+// first task inserted for it. Keys without an insert are left to getOrCreateSubQueue.
+func (c *ConcurrencyStrategy) createWeightedSubQueues(ctx context.Context, grouped map[string][]walMessage) error {
+	if !c.weighsTasks() {
+		return nil
+	}
+
+	firstTasks := make(map[string]repository.TaskIdInsertedAtRetryCount)
+
+	c.mu.RLock()
+	for key, msgs := range grouped {
+		if _, ok := c.subQueues[key]; ok {
+			continue
+		}
+
+		for _, msg := range msgs {
+			if msg.Operation == "INSERT" {
+				firstTasks[key] = slotTask(walMessageToSlot(msg))
+				break
+			}
+		}
+	}
+	c.mu.RUnlock()
+
+	inputs, err := c.listWeightInputs(ctx, firstTasks)
+
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, task := range firstTasks {
+		c.subQueues[key] = newSubQueue(key, c.maxRunsForKey(key, inputs[task.Id]), c.compare)
+	}
+
+	return nil
+}
+
+// weighSubQueues sets the max runs of every sub-queue hydrated by buildIndex for a WEIGHTED_FAIR strategy,
+// weighing each key against one of its slots' tasks.
+func (c *ConcurrencyStrategy) weighSubQueues(ctx context.Context) error {
+	if !c.weighsTasks() {
+		return nil
+	}
+
+	tasks := make(map[string]repository.TaskIdInsertedAtRetryCount)
+
+	c.mu.RLock()
+	for key, sq := range c.subQueues {
+		s, ok := sq.queued.peek()
+
+		if !ok {
+			s, ok = sq.running.peek()
+		}
+
+		if ok {
+			tasks[key] = slotTask(s)
+		}
+	}
+	c.mu.RUnlock()
+
+	inputs, err := c.listWeightInputs(ctx, tasks)
+
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, task := range tasks {
+		if sq, ok := c.subQueues[key]; ok {
+			sq.maxRuns = c.maxRunsForKey(key, inputs[task.Id])
+		}
+	}
+
+	return nil
+}
+
 func NewNoOpFlusher(
 	ctx context.Context,
 	outbox pgoutbox.Outbox,
@@ -473,7 +630,7 @@ func (c *ConcurrencyStrategy) buildIndex(ctx context.Context) error {
 		return ctx.Err()
 	}
 
-	return nil
+	return c.weighSubQueues(ctx)
 }
 
 func (c *ConcurrencyStrategy) processWALMessages(ctx context.Context, tx pgx.Tx, messages []walMessage) (*repository.RunConcurrencyResult, error) {
@@ -491,14 +648,15 @@ func (c *ConcurrencyStrategy) processWALMessages(ctx context.Context, tx pgx.Tx,
 // Timed-out queued slots are handled by the shared pipeline and are not passed here.
 type decideFn func(sq *subQueue) (toFill, toCancel []slot)
 
-// decide selects the per-sub-queue decision function for this strategy's kind. All three fill free
+// decide selects the per-sub-queue decision function for this strategy's kind. All of them fill free
 // capacity from the queued backlog in priorityCompare order; they differ in what happens to the
 // slots that don't fit: GROUP_ROUND_ROBIN leaves them queued, CANCEL_NEWEST cancels them (reject the
 // newest arrivals, never touch running work), and CANCEL_IN_PROGRESS cancels them too but may also
-// preempt a running slot when a higher-priority slot is waiting.
+// preempt a running slot when a higher-priority slot is waiting. WEIGHTED_FAIR decides like
+// GROUP_ROUND_ROBIN; the weighting is applied through each sub-queue's maxRuns (see createWeightedSubQueues).
 func (c *ConcurrencyStrategy) decide() decideFn {
 	switch c.strategy.Strategy {
-	case sqlcv1.V1ConcurrencyStrategyGROUPROUNDROBIN:
+	case sqlcv1.V1ConcurrencyStrategyGROUPROUNDROBIN, sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR:
 		return decideGroupRoundRobin
 	case sqlcv1.V1ConcurrencyStrategyCANCELINPROGRESS:
 		return decideCancelInProgress
@@ -633,6 +791,10 @@ func applyWAL(sq *subQueue, msgs []walMessage) []slot {
 func (c *ConcurrencyStrategy) processStrategy(ctx context.Context, tx pgx.Tx, msgs []walMessage, decide decideFn) (*repository.RunConcurrencyResult, error) {
 	grouped := groupMessagesBySubQueue(msgs)
 
+	if err := c.createWeightedSubQueues(ctx, grouped); err != nil {
+		return nil, err
+	}
+
 	// single "now" so every sub-queue evaluates scheduling timeouts against the same instant
 	now := time.Now().UTC()
 
@@ -798,11 +960,24 @@ func (c *ConcurrencyStrategy) getOrCreateSubQueue(key string) *subQueue {
 	if ok {
 		return sq
 	}
-	sq = newSubQueue(key, c.strategy.MaxConcurrency, c.compare)
+	sq = newSubQueue(key, c.maxRunsForKey(key, nil), c.compare)
 	c.subQueues[key] = sq
 	return sq
 }
 
+// maxRunsForKey returns the number of slots a key may fill: the strategy's max concurrency scaled by
+// the key's weight, so a key with weight 3 gets three times the slots of a key with weight 1. The
+// product is clamped to math.MaxInt32 so that a large max concurrency can't overflow.
+func (c *ConcurrencyStrategy) maxRunsForKey(key string, task *repository.ConcurrencyWeightInput) int32 {
+	if c.weight == nil {
+		return c.strategy.MaxConcurrency
+	}
+
+	maxRuns := int64(c.strategy.MaxConcurrency) * int64(c.weight(key, task))
+
+	return int32(min(maxRuns, math.MaxInt32)) //nolint:gosec // clamped to math.MaxInt32
+}
+
 func groupMessagesBySubQueue(msgs []walMessage) map[string][]walMessage {
 	grouped := make(map[string][]walMessage)
 	for _, msg := range msgs {
@@ -811,6 +986,14 @@ func groupMessagesBySubQueue(msgs []walMessage) map[string][]walMessage {
 	return grouped
 }
 
+func slotTask(s slot) repository.TaskIdInsertedAtRetryCount {
+	return repository.TaskIdInsertedAtRetryCount{
+		Id:         s.taskId,
+		InsertedAt: sqlchelpers.TimestamptzFromTime(time.Unix(0, s.taskInsertedAtNs).UTC()),
+		RetryCount: s.taskRetryCount,
+	}
+}
+
 func walMessageToSlot(msg walMessage) slot {
 	return slot{
 		priority:            msg.Priority,
@@ -21,6 +21,9 @@ import (
 type mockConcurrencyRepo struct {
 	indexRows []*sqlcv1.ListConcurrencySlotsForIndexingRow
 
+	// weightInputs is returned by ListConcurrencyWeightInputs for the requested task ids
+	weightInputs map[int64]*repository.ConcurrencyWeightInput
+
 	updateResult *repository.RunConcurrencyResult
 	updateErr    error
 
@@ -41,6 +44,16 @@ func (m *mockConcurrencyRepo) ReadConcurrencySlotsForIndexing(ctx context.Contex
 	return nil
 }
 
+func (m *mockConcurrencyRepo) ListConcurrencyWeightInputs(ctx context.Context, tenantId uuid.UUID, tasks []repository.TaskIdInsertedAtRetryCount) (map[int64]*repository.ConcurrencyWeightInput, error) {
+	res := make(map[int64]*repository.ConcurrencyWeightInput)
+	for _, task := range tasks {
+		if in, ok := m.weightInputs[task.Id]; ok {
+			res[task.Id] = in
+		}
+	}
+	return res, nil
+}
+
 func (m *mockConcurrencyRepo) UpdateConcurrencySlotsTx(ctx context.Context, tx pgx.Tx, tenantId uuid.UUID, strategyId int64, filledSlots []repository.TaskIdInsertedAtRetryCount, cancelledSlots []repository.CancelledSlotInput) (*repository.RunConcurrencyResult, error) {
 	if m.flushLatency > 0 {
 		time.Sleep(m.flushLatency)
@@ -0,0 +1,157 @@
+package concurrency
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func newWeightedFairStrategy(repo repository.ConcurrencyRepository, maxConcurrency int32, weightExpr string) *ConcurrencyStrategy {
+	c := newTestStrategyKind(repo, maxConcurrency, sqlcv1.V1ConcurrencyStrategyWEIGHTEDFAIR)
+	c.strategy.WeightExpression = pgtype.Text{String: weightExpr, Valid: true}
+	c.weight = c.newWeightFn()
+	return c
+}
+
+// Each key fills maxConcurrency * weight slots, so a key with weight 3 runs three times as many tasks
+// as a key with weight 1. Within a key, slots are still filled in priority order and the backlog that
+// doesn't fit stays queued, exactly like GROUP_ROUND_ROBIN.
+func TestWeightedFair_FillsCapacityProportionalToWeight(t *testing.T) {
+	now := time.Now().UTC()
+	future := now.Add(time.Hour)
+
+	repo := &mockConcurrencyRepo{}
+	c := newWeightedFairStrategy(repo, 1, `key.startsWith("gold:") ? 3 : 1`)
+
+	msgs := []walMessage{
+		walInsert("gold:acme", 101, 1, now, future),
+		walInsert("gold:acme", 102, 2, now, future),
+		walInsert("gold:acme", 103, 3, now, future),
+		walInsert("gold:acme", 104, 4, now, future),
+		walInsert("free:bob", 201, 1, now, future),
+		walInsert("free:bob", 202, 2, now, future),
+	}
+
+	if _, err := c.processWALMessages(context.Background(), nil, msgs); err != nil {
+		t.Fatalf("processWALMessages: %v", err)
+	}
+
+	filled := filledIDs(repo.lastFilled)
+
+	for _, id := range []int64{104, 103, 102, 202} {
+		if !containsID(filled, id) {
+			t.Fatalf("task %d not filled: %v", id, filled)
+		}
+	}
+
+	if len(filled) != 4 {
+		t.Fatalf("filled = %v, want 3 gold slots and 1 free slot", filled)
+	}
+
+	gold := c.getOrCreateSubQueue("gold:acme")
+	if gold.maxRuns != 3 || gold.running.len() != 3 || gold.queued.len() != 1 {
+		t.Fatalf("gold maxRuns/running/queued = %d/%d/%d, want 3/3/1", gold.maxRuns, gold.running.len(), gold.queued.len())
+	}
+
+	free := c.getOrCreateSubQueue("free:bob")
+	if free.maxRuns != 1 || free.running.len() != 1 || free.queued.len() != 1 {
+		t.Fatalf("free maxRuns/running/queued = %d/%d/%d, want 1/1/1", free.maxRuns, free.running.len(), free.queued.len())
+	}
+
+	if got := cancelledByReason(repo.lastCancelled, repository.CancelledReasonConcurrencyLimit); len(got) != 0 {
+		t.Fatalf("unexpected CONCURRENCY_LIMIT cancellations: %v", got)
+	}
+}
+
+// The weight expression can read the additional metadata and input of the first task queued for a key,
+// both for keys created by WAL messages and for keys hydrated by buildIndex.
+func TestWeightedFair_WeighsByTaskData(t *testing.T) {
+	now := time.Now().UTC()
+	future := now.Add(time.Hour)
+
+	repo := &mockConcurrencyRepo{
+		indexRows: []*sqlcv1.ListConcurrencySlotsForIndexingRow{
+			indexRow("acme", 1, 1, 0, now, future, false),
+		},
+		weightInputs: map[int64]*repository.ConcurrencyWeightInput{
+			1: {AdditionalMetadata: map[string]interface{}{"tier": "gold"}},
+			2: {Input: map[string]interface{}{"seats": 20}},
+		},
+	}
+	c := newWeightedFairStrategy(repo, 2, `has(additional_metadata.tier) && additional_metadata.tier == "gold" ? 3 : (has(input.seats) ? input.seats / 10 : 1)`)
+
+	if err := c.buildIndex(context.Background()); err != nil {
+		t.Fatalf("buildIndex: %v", err)
+	}
+
+	if got := c.getOrCreateSubQueue("acme").maxRuns; got != 6 {
+		t.Fatalf("acme maxRuns = %d, want 6", got)
+	}
+
+	msgs := []walMessage{
+		walInsert("bob", 2, 1, now, future),
+		walInsert("carol", 3, 1, now, future), // no task data: weighed with empty maps
+	}
+
+	if _, err := c.processWALMessages(context.Background(), nil, msgs); err != nil {
+		t.Fatalf("processWALMessages: %v", err)
+	}
+
+	if got := c.getOrCreateSubQueue("bob").maxRuns; got != 4 {
+		t.Fatalf("bob maxRuns = %d, want 4", got)
+	}
+
+	if got := c.getOrCreateSubQueue("carol").maxRuns; got != 2 {
+		t.Fatalf("carol maxRuns = %d, want 2", got)
+	}
+}
+
+// Weights are clamped to [1, maxConcurrencyWeight], and an expression that fails to evaluate for a key
+// falls back to a weight of 1 instead of blocking the key.
+func TestWeightedFair_WeightBounds(t *testing.T) {
+	repo := &mockConcurrencyRepo{}
+	c := newWeightedFairStrategy(repo, 2, `{"zero": 0, "huge": 1000000, "two": 2}[key]`)
+
+	cases := map[string]int32{
+		"zero":    2,
+		"huge":    2 * maxConcurrencyWeight,
+		"two":     4,
+		"missing": 2, // evaluation error: missing map key
+	}
+
+	for key, want := range cases {
+		if got := c.maxRunsForKey(key, nil); got != want {
+			t.Fatalf("maxRunsForKey(%q) = %d, want %d", key, got, want)
+		}
+	}
+}
+
+// A weighted max concurrency which doesn't fit in an int32 is clamped rather than overflowing.
+func TestWeightedFair_MaxRunsDoesNotOverflow(t *testing.T) {
+	repo := &mockConcurrencyRepo{}
+	c := newWeightedFairStrategy(repo, math.MaxInt32/2, `key == "heavy" ? 3 : 1`)
+
+	if got := c.maxRunsForKey("heavy", nil); got != math.MaxInt32 {
+		t.Fatalf("maxRunsForKey(heavy) = %d, want %d", got, int32(math.MaxInt32))
+	}
+
+	if got := c.maxRunsForKey("light", nil); got != math.MaxInt32/2 {
+		t.Fatalf("maxRunsForKey(light) = %d, want %d", got, int32(math.MaxInt32/2))
+	}
+}
+
+// An expression that doesn't compile degrades to a uniform weight, i.e. GROUP_ROUND_ROBIN behavior.
+func TestWeightedFair_InvalidExpressionIsUniform(t *testing.T) {
+	repo := &mockConcurrencyRepo{}
+	c := newWeightedFairStrategy(repo, 2, `key + "not a number"`)
+
+	if got := c.maxRunsForKey("gold:acme", nil); got != 2 {
+		t.Fatalf("maxRunsForKey = %d, want 2", got)
+	}
+}
@@ -251,10 +251,21 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 			continue
 		}
 
+		stepWorkflowVersions, err := q.repo.GetStepWorkflowVersions(ctx, nil, stepIds)
+
+		if err != nil {
+			span.RecordError(err)
+			span.End()
+			q.l.Error().Ctx(ctx).Err(err).Msg("error getting step workflow versions")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
 		getSlotRequestsTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
-		assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger)
+		assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, stepWorkflowVersions, rls, taskIdToDesiredLabelsFromTrigger)
 		count := 0
 
 		countMu := sync.Mutex{}
@@ -709,7 +720,12 @@ func (q *Queuer) runOptimisticQueue(
 		return nil, nil, err
 	}
 
-	assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger)
+	stepWorkflowVersions, err := q.repo.GetStepWorkflowVersions(ctx, tx, stepIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, stepWorkflowVersions, rls, taskIdToDesiredLabelsFromTrigger)
 
 	var allLocalAssigned []*v1.AssignedItem
 	var allQueueResults []*QueueResults
@@ -608,6 +608,7 @@ func (s *Scheduler) tryAssignBatch(
 	ringOffset int,
 	stepIdsToLabels map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow,
 	stepIdsToRequests map[uuid.UUID]map[string]int32,
+	stepIdsToWorkflowVersions map[uuid.UUID]string,
 	taskIdsToRateLimits map[int64]map[string]int32,
 	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
 ) (
@@ -726,12 +727,30 @@ func (s *Scheduler) tryAssignBatch(
 
 	candidateSlots := action.slots
 
+	// runs of a versioned workflow can only be assigned to workers which registered the same version, so we
+	// filter the candidate slots once per workflow version in the batch
+	versionedCandidateSlots := make(map[string][]*slot)
+
 	for i := range res {
 		if res[i].rateLimitResult != nil {
 			continue
 		}
 
-		denom := len(candidateSlots)
+		qi := qis[i]
+
+		qiCandidateSlots := candidateSlots
+
+		if version := stepIdsToWorkflowVersions[qi.StepID]; version != "" {
+			key := qi.WorkflowID.String() + ":" + version
+
+			if _, ok := versionedCandidateSlots[key]; !ok {
+				versionedCandidateSlots[key] = filterSlotsForWorkflowVersion(candidateSlots, qi.WorkflowID, version)
+			}
+
+			qiCandidateSlots = versionedCandidateSlots[key]
+		}
+
+		denom := len(qiCandidateSlots)
 
 		if denom == 0 {
 			res[i].noSlots = true
@@ -742,8 +761,6 @@ func (s *Scheduler) tryAssignBatch(
 
 		childRingOffset := newRingOffset % denom
 
-		qi := qis[i]
-
 		labels := []*sqlcv1.GetDesiredLabelsRow(nil)
 
 		if stepIdsToLabels != nil {
@@ -769,7 +786,7 @@ func (s *Scheduler) tryAssignBatch(
 			ctx,
 			qi,
 			action,
-			candidateSlots,
+			qiCandidateSlots,
 			childRingOffset,
 			labels,
 			requests,
@@ -794,6 +811,18 @@ func (s *Scheduler) tryAssignBatch(
 	return res, newRingOffset, nil
 }
 
+func filterSlotsForWorkflowVersion(candidateSlots []*slot, workflowId uuid.UUID, version string) []*slot {
+	res := make([]*slot, 0, len(candidateSlots))
+
+	for _, candidateSlot := range candidateSlots {
+		if candidateSlot.worker.runsWorkflowVersion(workflowId, version) {
+			res = append(res, candidateSlot)
+		}
+	}
+
+	return res
+}
+
 func findAssignableSlots(
 	candidateSlots []*slot,
 	action *action,
@@ -995,6 +1024,7 @@ func (s *Scheduler) tryAssign(
 	qis []*sqlcv1.V1QueueItem,
 	stepIdsToLabels map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow,
 	stepIdsToRequests map[uuid.UUID]map[string]int32,
+	stepIdsToWorkflowVersions map[uuid.UUID]string,
 	taskIdsToRateLimits map[int64]map[string]int32,
 	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
 ) <-chan *assignResults {
@@ -1070,7 +1100,7 @@ func (s *Scheduler) tryAssign(
 
 					batchStart := time.Now()
 
-					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepIdsToRequests, taskIdsToRateLimits, taskIdsToLabelOverrides)
+					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepIdsToRequests, stepIdsToWorkflowVersions, taskIdsToRateLimits, taskIdsToLabelOverrides)
 
 					if err != nil {
 						return err
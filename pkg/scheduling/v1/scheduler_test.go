@@ -407,7 +407,7 @@ func TestScheduler_TryAssignBatch_NoActionSlots(t *testing.T) {
 		testQI(tenantId, "missing", 2),
 	}
 
-	res, _, err := s.tryAssignBatch(context.Background(), "missing", qis, 0, nil, nil, nil, nil)
+	res, _, err := s.tryAssignBatch(context.Background(), "missing", qis, 0, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 	require.Len(t, res, 2)
 	for _, r := range res {
@@ -578,7 +578,7 @@ func TestScheduler_TryAssignBatch_AssignsUntilExhausted(t *testing.T) {
 		testQI(tenantId, "A", 3),
 	}
 
-	res, newOffset, err := s.tryAssignBatch(context.Background(), "A", qis, 0, map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, map[uuid.UUID]map[string]int32{}, nil, nil)
+	res, newOffset, err := s.tryAssignBatch(context.Background(), "A", qis, 0, map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, map[uuid.UUID]map[string]int32{}, nil, nil, nil)
 	require.NoError(t, err)
 	require.Equal(t, 3, newOffset)
 
@@ -596,6 +596,58 @@ func TestScheduler_TryAssignBatch_AssignsUntilExhausted(t *testing.T) {
 	require.Equal(t, 1, noSlots)
 }
 
+func TestScheduler_TryAssignBatch_RoutesToMatchingWorkflowVersion(t *testing.T) {
+	tenantId := uuid.New()
+	workflowId := uuid.New()
+
+	s := newTestScheduler(t, tenantId, &mockAssignmentRepo{})
+
+	v1Worker := &worker{ListActiveWorkersResult: testWorker(uuid.New())}
+	v1Worker.WorkflowVersions = map[uuid.UUID]string{workflowId: "v1"}
+
+	v2Worker := &worker{ListActiveWorkersResult: testWorker(uuid.New())}
+	v2Worker.WorkflowVersions = map[uuid.UUID]string{workflowId: "v2"}
+
+	actA, err := actionWithSlots(
+		"A",
+		newSlot(v1Worker, newSlotMeta([]string{"A"}, repo.SlotTypeDefault)),
+		newSlot(v1Worker, newSlotMeta([]string{"A"}, repo.SlotTypeDefault)),
+		newSlot(v2Worker, newSlotMeta([]string{"A"}, repo.SlotTypeDefault)),
+	)
+	require.NoError(t, err)
+	s.actions["A"] = actA
+
+	pinnedV2 := testQI(tenantId, "A", 1)
+	pinnedV2.WorkflowID = workflowId
+
+	pinnedV3 := testQI(tenantId, "A", 2)
+	pinnedV3.WorkflowID = workflowId
+
+	unversioned := testQI(tenantId, "A", 3)
+	unversioned.WorkflowID = workflowId
+
+	stepIdsToWorkflowVersions := map[uuid.UUID]string{
+		pinnedV2.StepID: "v2",
+		pinnedV3.StepID: "v3",
+	}
+
+	res, _, err := s.tryAssignBatch(context.Background(), "A", []*sqlcv1.V1QueueItem{pinnedV2, pinnedV3, unversioned}, 0, nil, nil, stepIdsToWorkflowVersions, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	// the v2 run can only be assigned to the v2 worker
+	require.True(t, res[0].succeeded)
+	require.Equal(t, v2Worker.ID, res[0].workerId)
+
+	// no worker registered v3
+	require.False(t, res[1].succeeded)
+	require.True(t, res[1].noSlots)
+
+	// runs of unversioned workflows can be assigned to any worker
+	require.True(t, res[2].succeeded)
+	require.Equal(t, v1Worker.ID, res[2].workerId)
+}
+
 func TestScheduler_TryAssignBatch_RateLimitedSkipsAssignment(t *testing.T) {
 	tenantId := uuid.New()
 	workerId := uuid.New()
@@ -623,7 +675,7 @@ func TestScheduler_TryAssignBatch_RateLimitedSkipsAssignment(t *testing.T) {
 		qi.TaskID: {"k": 1},
 	}
 
-	res, _, err := s.tryAssignBatch(context.Background(), "A", qis, 0, nil, map[uuid.UUID]map[string]int32{}, rls, nil)
+	res, _, err := s.tryAssignBatch(context.Background(), "A", qis, 0, nil, map[uuid.UUID]map[string]int32{}, nil, rls, nil)
 	require.NoError(t, err)
 	require.Len(t, res, 1)
 	require.False(t, res[0].succeeded)
@@ -660,6 +712,7 @@ func TestScheduler_TryAssign_GroupsAndFiltersTimedOut(t *testing.T) {
 		map[uuid.UUID]map[string]int32{},
 		nil,
 		nil,
+		nil,
 	)
 
 	var (
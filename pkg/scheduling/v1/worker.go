@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"github.com/google/uuid"
+
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
@@ -9,6 +11,16 @@ type worker struct {
 	*v1.ListActiveWorkersResult
 }
 
+// runsWorkflowVersion returns whether the worker can run tasks of the given version of a workflow. Workers which
+// didn't register any workflow versions, for example workers on older SDKs, aren't version-aware and can run any version.
+func (w *worker) runsWorkflowVersion(workflowId uuid.UUID, version string) bool {
+	if version == "" || len(w.WorkflowVersions) == 0 {
+		return true
+	}
+
+	return w.WorkflowVersions[workflowId] == version
+}
+
 // computeWeight computes the weight of a worker based on the desired labels. If the worker does not
 // meet the required labels, the weight is -1.
 func (w *worker) computeWeight(s []*sqlcv1.GetDesiredLabelsRow) int {
@@ -139,6 +139,8 @@ func getErrorStr(errObj *ValidationErrObject) string {
 		return errObj.SafeExternalError(CELExprErr)
 	case "celsteprunstr":
 		return errObj.SafeExternalError(CELExprErr)
+	case "celconcurrencyweight":
+		return errObj.SafeExternalError(CELExprErr)
 	default:
 		return errObj.SafeExternalError("")
 	}
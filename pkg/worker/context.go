@@ -597,6 +597,7 @@ type SpawnWorkflowOpts struct {
 	AdditionalMetadata  *map[string]string
 	Priority            *int32
 	DesiredWorkerLabels map[string]*types.DesiredWorkerLabel
+	Version             *string
 }
 
 func (h *hatchetContext) saveOrLoadListener() (*client.WorkflowRunsListener, error) {
@@ -675,6 +676,7 @@ func (h *hatchetContext) SpawnWorkflow(workflowName string, input any, opts *Spa
 			AdditionalMetadata:  opts.AdditionalMetadata,
 			Priority:            opts.Priority,
 			DesiredWorkerLabels: opts.DesiredWorkerLabels,
+			Version:             opts.Version,
 		},
 	)
 
@@ -1041,6 +1043,26 @@ type DurableHatchetContext interface {
 
 	// SleepUntil sleeps until the specified absolute time. Combines Now() with SleepFor.
 	SleepUntil(t time.Time) (*SingleWaitResult, error)
+
+	// WaitForSignal pauses execution until a signal with the given name is sent to the task, and returns the
+	// signal payload. Signals sent before the task waits for them are buffered, and the payload is recorded in
+	// the durable event log so replays receive the same payload.
+	WaitForSignal(name string) (json.RawMessage, error)
+
+	// SetQueryHandler registers a handler which answers queries with the given name while the task is running.
+	// Queries aren't recorded in the durable event log, so handlers must only read the state of the task, and
+	// must be safe to call concurrently with it. The result must encode to a JSON object.
+	SetQueryHandler(name string, handler func(input json.RawMessage) (any, error)) error
+
+	// Patched reports whether the task should take the code path guarded by the patch with the given id. Runs which
+	// reach the patch for the first time record a marker in the durable event log and take the patched code path,
+	// while runs which reached it before the patch was deployed replay the old code path.
+	Patched(patchID string) (bool, error)
+
+	// DeprecatePatch marks the patch with the given id as deprecated, once the old code path it guarded has been
+	// removed. Runs which recorded the marker still replay, but new runs don't record it, so the call can be removed
+	// once no in-flight runs are on the marker.
+	DeprecatePatch(patchID string) error
 }
 
 // durableHatchetContext implements the DurableHatchetContext interface.
@@ -1053,9 +1075,10 @@ type durableHatchetContext struct {
 	durableEventListener *client.DurableEventsListener
 	durableListenerMu    sync.Mutex
 
-	invocationCount int32
-	memoMu          sync.Mutex
-	memoCache       map[string]json.RawMessage
+	invocationCount  int32
+	memoMu           sync.Mutex
+	memoCache        map[string]json.RawMessage
+	queryCleanupOnce sync.Once
 }
 
 // SleepFor implements the DurableHatchetContext.SleepFor method.
@@ -1297,6 +1320,110 @@ func (d *durableHatchetContext) SleepUntil(t time.Time) (*SingleWaitResult, erro
 	return d.SleepFor(duration)
 }
 
+// WaitForSignal implements the DurableHatchetContext.WaitForSignal method.
+func (d *durableHatchetContext) WaitForSignal(name string) (json.RawMessage, error) {
+	listener := d.DurableTaskListener()
+
+	if listener == nil || !d.DurableEvictionSupported() {
+		return nil, fmt.Errorf("signals are not supported by this engine version")
+	}
+
+	tracer := otel.Tracer("github.com/hatchet-dev/hatchet/pkg/worker")
+	_, span := tracer.Start(d.GetContext(), "hatchet.durable.wait_for_signal",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("instrumentor", "hatchet"),
+			attribute.String("hatchet.step_run_id", d.StepRunId()),
+			attribute.String("hatchet.signal_name", name),
+		),
+	)
+	defer span.End()
+
+	if hook := d.DurableEvictionHook(); hook != nil {
+		hook.MarkWaiting(d.StepRunId(), "signal", name)
+		defer hook.MarkActive(d.StepRunId())
+	}
+
+	data, err := listener.SendWaitForSignalRequest(d.GetContext(), d.StepRunId(), d.invocationCount, name)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to wait for signal %s: %w", name, err)
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return data, nil
+}
+
+// Patched implements the DurableHatchetContext.Patched method.
+func (d *durableHatchetContext) Patched(patchID string) (bool, error) {
+	return d.sendPatch(patchID, false)
+}
+
+// DeprecatePatch implements the DurableHatchetContext.DeprecatePatch method.
+func (d *durableHatchetContext) DeprecatePatch(patchID string) error {
+	_, err := d.sendPatch(patchID, true)
+	return err
+}
+
+func (d *durableHatchetContext) sendPatch(patchID string, deprecated bool) (bool, error) {
+	listener := d.DurableTaskListener()
+
+	if listener == nil || !d.DurableEvictionSupported() {
+		return false, fmt.Errorf("patches are not supported by this engine version")
+	}
+
+	tracer := otel.Tracer("github.com/hatchet-dev/hatchet/pkg/worker")
+	_, span := tracer.Start(d.GetContext(), "hatchet.durable.patch",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("instrumentor", "hatchet"),
+			attribute.String("hatchet.step_run_id", d.StepRunId()),
+			attribute.String("hatchet.patch_id", patchID),
+			attribute.Bool("hatchet.patch_deprecated", deprecated),
+		),
+	)
+	defer span.End()
+
+	patched, err := listener.SendPatchRequest(d.GetContext(), d.StepRunId(), d.invocationCount, patchID, deprecated)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return false, fmt.Errorf("failed to check patch %s: %w", patchID, err)
+	}
+
+	span.SetAttributes(attribute.Bool("hatchet.patched", patched))
+	span.SetStatus(codes.Ok, "")
+	return patched, nil
+}
+
+// SetQueryHandler implements the DurableHatchetContext.SetQueryHandler method.
+func (d *durableHatchetContext) SetQueryHandler(name string, handler func(input json.RawMessage) (any, error)) error {
+	listener := d.DurableTaskListener()
+
+	if listener == nil || !d.DurableEvictionSupported() {
+		return fmt.Errorf("queries are not supported by this engine version")
+	}
+
+	taskExternalID := d.StepRunId()
+	invocationCount := d.invocationCount
+
+	listener.SetQueryHandler(taskExternalID, invocationCount, name, func(input []byte) ([]byte, error) {
+		result, err := handler(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(result)
+	})
+
+	d.queryCleanupOnce.Do(func() {
+		context.AfterFunc(d.GetContext(), func() {
+			listener.RemoveQueryHandlers(taskExternalID, invocationCount)
+		})
+	})
+
+	return nil
+}
+
 func (d *durableHatchetContext) saveOrLoadDurableEventListener() (*client.DurableEventsListener, error) {
 	return d.client().Subscribe().ListenForDurableEvents(context.Background())
 }
@@ -26,3 +26,40 @@ func IsNonRetryableError(err error) bool {
 	e := &NonRetryableError{}
 	return errors.As(err, &e)
 }
+
+// Deprecated: TypedError is an internal type used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of using this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+type TypedError struct {
+	errorType string
+	e         error
+}
+
+// Deprecated: Error is an internal method used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of using this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func (e *TypedError) Error() string {
+	return e.e.Error()
+}
+
+// Deprecated: Unwrap is an internal method used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of using this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func (e *TypedError) Unwrap() error {
+	return e.e
+}
+
+// Deprecated: NewTypedError is an internal function used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of calling this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func NewTypedError(errorType string, err error) error {
+	return &TypedError{errorType: errorType, e: err}
+}
+
+// Deprecated: GetErrorType is an internal function used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of calling this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func GetErrorType(err error) string {
+	e := &TypedError{}
+
+	if errors.As(err, &e) {
+		return e.errorType
+	}
+
+	return ""
+}
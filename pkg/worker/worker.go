@@ -113,6 +113,10 @@ type Worker struct {
 
 	registered_workflows map[string]bool
 
+	// workflowVersions maps workflow names to the versions this worker registered, so that
+	// the scheduler only routes runs pinned to those versions to this worker
+	workflowVersions map[string]string
+
 	l *zerolog.Logger
 
 	cancelMap sync.Map
@@ -362,6 +366,7 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		initActionNames:      opts.actions,
 		labels:               opts.labels,
 		registered_workflows: map[string]bool{},
+		workflowVersions:     map[string]string{},
 	}
 
 	mws.add(w.panicMiddleware)
@@ -436,6 +441,10 @@ func (w *Worker) RegisterWorkflowV1(workflow *contracts.CreateWorkflowVersionReq
 
 	w.registered_workflows[namespaced] = true
 
+	if workflow.Version != "" {
+		w.workflowVersions[workflow.Name] = workflow.Version
+	}
+
 	return w.client.Admin().PutWorkflowV1(workflow)
 }
 
@@ -589,11 +598,12 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 	_ = NewManagedCompute(&w.actions, w.client, 1)
 
 	listener, id, err := w.client.Dispatcher().GetActionListener(ctx, &client.GetActionListenerRequest{
-		WorkerName:  w.name,
-		Actions:     actionNames,
-		Labels:      w.labels,
-		SlotConfig:  w.slotConfig,
-		LegacySlots: w.legacySlots,
+		WorkerName:       w.name,
+		Actions:          actionNames,
+		Labels:           w.labels,
+		SlotConfig:       w.slotConfig,
+		LegacySlots:      w.legacySlots,
+		WorkflowVersions: w.workflowVersions,
 	})
 
 	w.id = id
@@ -937,6 +947,10 @@ func (w *Worker) sendFailureEvent(ctx HatchetContext, taskErr error) error {
 		failureEvent.ShouldNotRetry = &shouldNotRetry
 	}
 
+	if errorType := GetErrorType(taskErr); errorType != "" {
+		failureEvent.ErrorType = &errorType
+	}
+
 	innerCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
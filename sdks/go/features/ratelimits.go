@@ -20,6 +20,10 @@ type CreateRatelimitOpts struct {
 	Limit int
 	// duration specifies the time period for the rate limit
 	Duration types.RateLimitDuration
+	// mode specifies how the rate limit refills, defaults to types.FixedWindow
+	Mode types.RateLimitMode
+	// burst is the maximum number of requests which can be made at once, only valid for types.TokenBucket
+	Burst *int
 }
 
 // RateLimitsClient provides methods for interacting with rate limits
@@ -49,6 +53,8 @@ func (c *RateLimitsClient) Upsert(opts CreateRatelimitOpts) error {
 	if err := c.admin.PutRateLimit(opts.Key, &types.RateLimitOpts{
 		Max:      opts.Limit,
 		Duration: opts.Duration,
+		Mode:     opts.Mode,
+		Burst:    opts.Burst,
 	}); err != nil {
 		return errors.Wrap(err, "failed to upsert rate limit")
 	}
@@ -196,6 +196,79 @@ func (r *RunsClient) Restore(ctx context.Context, taskExternalId string) (*rest.
 	return resp.JSON200, nil
 }
 
+// Signal sends a named signal to a running durable task, which receives the payload when it waits for the signal.
+// Signals sent before the task waits for them are buffered until it does.
+func (r *RunsClient) Signal(ctx context.Context, taskExternalId string, name string, payload map[string]interface{}) (*rest.V1SignalTaskResponse, error) {
+	body := rest.V1SignalTaskRequest{
+		Name: name,
+	}
+
+	if payload != nil {
+		body.Payload = &payload
+	}
+
+	resp, err := r.api.V1TaskSignalWithResponse(
+		ctx,
+		uuid.MustParse(taskExternalId),
+		body,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to signal task")
+	}
+
+	if err := validateJSON200Response(resp.StatusCode(), resp.Body, resp.JSON200); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// Query asks a running durable task for its current state, using a query handler registered by the task.
+// Queries don't affect the durable event log of the task.
+func (r *RunsClient) Query(ctx context.Context, taskExternalId string, name string, input map[string]interface{}) (map[string]interface{}, error) {
+	body := rest.V1QueryTaskRequest{
+		Name: name,
+	}
+
+	if input != nil {
+		body.Input = &input
+	}
+
+	resp, err := r.api.V1TaskQueryWithResponse(
+		ctx,
+		uuid.MustParse(taskExternalId),
+		body,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query task")
+	}
+
+	if err := validateJSON200Response(resp.StatusCode(), resp.Body, resp.JSON200); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200.Result, nil
+}
+
+// ListPatchMarkers lists the in-flight durable tasks which recorded the given patch marker. Once none are left, the
+// code path guarded by a deprecated patch can be removed.
+func (r *RunsClient) ListPatchMarkers(ctx context.Context, opts rest.V1DurableTaskPatchListParams) (*rest.V1DurableTaskPatchMarkerList, error) {
+	resp, err := r.api.V1DurableTaskPatchListWithResponse(
+		ctx,
+		r.tenantId,
+		&opts,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list patch markers")
+	}
+
+	if err := validateJSON200Response(resp.StatusCode(), resp.Body, resp.JSON200); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
 // SubscribeToStream subscribes to streaming events for a specific workflow run.
 func (r *RunsClient) SubscribeToStream(ctx context.Context, workflowRunId string) <-chan string {
 	ch := make(chan string)
@@ -118,15 +118,16 @@ type workflowDeclarationImpl[I any, O any] struct {
 
 	outputKey *string
 
-	name           string
-	Version        *string
-	Description    *string
-	OnEvents       []string
-	OnCron         []string
-	CronInput      *string
-	Concurrency    []types.Concurrency
-	OnFailureTask  *task.OnFailureTaskDeclaration[I]
-	StickyStrategy *types.StickyStrategy
+	name             string
+	Version          *string
+	CanaryPercentage *int32
+	Description      *string
+	OnEvents         []string
+	OnCron           []string
+	CronInput        *string
+	Concurrency      []types.Concurrency
+	OnFailureTask    *task.OnFailureTaskDeclaration[I]
+	StickyStrategy   *types.StickyStrategy
 
 	TaskDefaults *create.TaskDefaults
 
@@ -197,6 +198,7 @@ func NewWorkflowDeclaration[I any, O any](opts create.WorkflowCreateOpts[I], v0
 		outputSetters:    make(map[string]func(*O, interface{})),
 		DefaultPriority:  opts.DefaultPriority,
 		DefaultFilters:   opts.DefaultFilters,
+		CanaryPercentage: opts.CanaryPercentage,
 	}
 
 	if opts.Version != "" {
@@ -294,6 +296,16 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 		retries = &opts.Retries
 	}
 
+	var retryBackoffStrategy *types.RetryBackoffStrategy
+	var retryBackoffBaseSeconds *float32
+
+	if opts.RetryBackoffStrategy != "" {
+		retryBackoffStrategy = &opts.RetryBackoffStrategy
+	}
+	if opts.RetryBackoffBaseSeconds != 0 {
+		retryBackoffBaseSeconds = &opts.RetryBackoffBaseSeconds
+	}
+
 	// Convert parent task declarations to parent task names
 	parentNames := make([]string, len(opts.Parents))
 	for i, parent := range opts.Parents {
@@ -308,14 +320,17 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 		SkipIf:   opts.SkipIf,
 		CancelIf: opts.CancelIf,
 		TaskShared: task.TaskShared{
-			ExecutionTimeout:       executionTimeout,
-			ScheduleTimeout:        scheduleTimeout,
-			Retries:                retries,
-			RetryBackoffFactor:     retryBackoffFactor,
-			RetryMaxBackoffSeconds: retryMaxBackoffSeconds,
-			RateLimits:             opts.RateLimits,
-			WorkerLabels:           opts.WorkerLabels,
-			Concurrency:            opts.Concurrency,
+			ExecutionTimeout:        executionTimeout,
+			ScheduleTimeout:         scheduleTimeout,
+			Retries:                 retries,
+			RetryBackoffFactor:      retryBackoffFactor,
+			RetryMaxBackoffSeconds:  retryMaxBackoffSeconds,
+			RetryBackoffStrategy:    retryBackoffStrategy,
+			RetryBackoffBaseSeconds: retryBackoffBaseSeconds,
+			RetryRules:              opts.RetryRules,
+			RateLimits:              opts.RateLimits,
+			WorkerLabels:            opts.WorkerLabels,
+			Concurrency:             opts.Concurrency,
 		},
 	}
 
@@ -389,6 +404,16 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 		retries = &opts.Retries
 	}
 
+	var retryBackoffStrategy *types.RetryBackoffStrategy
+	var retryBackoffBaseSeconds *float32
+
+	if opts.RetryBackoffStrategy != "" {
+		retryBackoffStrategy = &opts.RetryBackoffStrategy
+	}
+	if opts.RetryBackoffBaseSeconds != 0 {
+		retryBackoffBaseSeconds = &opts.RetryBackoffBaseSeconds
+	}
+
 	// Convert parent task declarations to parent task names
 	parentNames := make([]string, len(opts.Parents))
 	for i, parent := range opts.Parents {
@@ -414,14 +439,17 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 		SkipIf:   opts.SkipIf,
 		CancelIf: opts.CancelIf,
 		TaskShared: task.TaskShared{
-			ExecutionTimeout:       executionTimeout,
-			ScheduleTimeout:        scheduleTimeout,
-			Retries:                retries,
-			RetryBackoffFactor:     retryBackoffFactor,
-			RetryMaxBackoffSeconds: retryMaxBackoffSeconds,
-			RateLimits:             opts.RateLimits,
-			WorkerLabels:           labels,
-			Concurrency:            opts.Concurrency,
+			ExecutionTimeout:        executionTimeout,
+			ScheduleTimeout:         scheduleTimeout,
+			Retries:                 retries,
+			RetryBackoffFactor:      retryBackoffFactor,
+			RetryMaxBackoffSeconds:  retryMaxBackoffSeconds,
+			RetryBackoffStrategy:    retryBackoffStrategy,
+			RetryBackoffBaseSeconds: retryBackoffBaseSeconds,
+			RetryRules:              opts.RetryRules,
+			RateLimits:              opts.RateLimits,
+			WorkerLabels:            labels,
+			Concurrency:             opts.Concurrency,
 		},
 	}
 
@@ -616,13 +644,14 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 	}
 
 	req := &contracts.CreateWorkflowVersionRequest{
-		Tasks:           tasksToRegister,
-		Name:            w.name,
-		EventTriggers:   w.OnEvents,
-		CronTriggers:    w.OnCron,
-		CronInput:       w.CronInput,
-		DefaultPriority: w.DefaultPriority,
-		DefaultFilters:  filters,
+		Tasks:            tasksToRegister,
+		Name:             w.name,
+		EventTriggers:    w.OnEvents,
+		CronTriggers:     w.OnCron,
+		CronInput:        w.CronInput,
+		DefaultPriority:  w.DefaultPriority,
+		DefaultFilters:   filters,
+		CanaryPercentage: w.CanaryPercentage,
 	}
 
 	if w.Version != nil {
@@ -47,6 +47,15 @@ type TaskShared struct {
 	// RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds *int32
 
+	// RetryBackoffStrategy is the strategy used to compute the delay between retries
+	RetryBackoffStrategy *types.RetryBackoffStrategy
+
+	// RetryBackoffBaseSeconds is the base delay for the FIXED and DECORRELATED_JITTER strategies
+	RetryBackoffBaseSeconds *float32
+
+	// RetryRules override the retry policy of the task for specific error types
+	RetryRules []*types.RetryRule
+
 	// RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
@@ -179,8 +188,9 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 
 	for j, concurrency := range t.Concurrency {
 		concurrencyOpts := &contracts.Concurrency{
-			Expression: concurrency.Expression,
-			MaxRuns:    concurrency.MaxRuns,
+			Expression:       concurrency.Expression,
+			MaxRuns:          concurrency.MaxRuns,
+			WeightExpression: concurrency.WeightExpression,
 		}
 
 		if concurrency.LimitStrategy != nil {
@@ -215,6 +225,32 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		taskOpts.BackoffMaxSeconds = t.RetryMaxBackoffSeconds
 	}
 
+	if t.RetryBackoffStrategy != nil {
+		taskOpts.BackoffStrategy = toRetryBackoffStrategy(*t.RetryBackoffStrategy)
+	}
+
+	if t.RetryBackoffBaseSeconds != nil {
+		taskOpts.BackoffBaseSeconds = t.RetryBackoffBaseSeconds
+	}
+
+	for _, rule := range t.RetryRules {
+		if rule == nil {
+			continue
+		}
+
+		ruleOpts := &contracts.RetryRule{
+			ErrorType:          rule.ErrorType,
+			MaxRetries:         rule.MaxRetries,
+			BackoffBaseSeconds: rule.BackoffBaseSeconds,
+		}
+
+		if rule.BackoffStrategy != nil {
+			ruleOpts.BackoffStrategy = toRetryBackoffStrategy(*rule.BackoffStrategy)
+		}
+
+		taskOpts.RetryRules = append(taskOpts.RetryRules, ruleOpts)
+	}
+
 	// Apply workflow task defaults if they are not set
 	if taskDefaults != nil {
 		if t.Retries == nil && taskDefaults.Retries != 0 {
@@ -344,3 +380,8 @@ func (t *NamedTaskImpl) GetName() string {
 func getActionID(workflowName, taskName string) string {
 	return strings.ToLower(fmt.Sprintf("%s:%s", workflowName, taskName))
 }
+
+func toRetryBackoffStrategy(strategy types.RetryBackoffStrategy) *contracts.RetryBackoffStrategy {
+	strategyEnum := contracts.RetryBackoffStrategy(contracts.RetryBackoffStrategy_value[string(strategy)])
+	return &strategyEnum
+}
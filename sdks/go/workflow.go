@@ -53,12 +53,17 @@ type DesiredWorkerLabel = types.DesiredWorkerLabel
 
 type WorkerLabelComparator = types.WorkerLabelComparator
 
+type RetryBackoffStrategy = types.RetryBackoffStrategy
+
+type RetryRule = types.RetryRule
+
 type runOpts struct {
 	AdditionalMetadata  *map[string]string
 	Priority            *RunPriority
 	Sticky              *bool
 	Key                 *string
 	DesiredWorkerLabels map[string]*DesiredWorkerLabel
+	Version             *string
 }
 
 type RunOptFunc func(*runOpts)
@@ -98,6 +103,14 @@ func WithDesiredWorkerLabels(labels map[string]*DesiredWorkerLabel) RunOptFunc {
 	}
 }
 
+// WithRunVersion pins the workflow run to a specific registered workflow version.
+// The run will only be assigned to workers that registered this version.
+func WithRunVersion(version string) RunOptFunc {
+	return func(opts *runOpts) {
+		opts.Version = &version
+	}
+}
+
 // convertInputToType converts input (typically map[string]interface{}) to the expected struct type
 func convertInputToType(input any, expectedType reflect.Type) reflect.Value {
 	if input == nil {
@@ -149,16 +162,17 @@ func (w *Workflow) GetName() string {
 type WorkflowOption func(*workflowConfig)
 
 type workflowConfig struct {
-	onCron          []string
-	onEvents        []string
-	concurrency     []types.Concurrency
-	version         string
-	description     string
-	taskDefaults    *create.TaskDefaults
-	defaultPriority *RunPriority
-	stickyStrategy  *types.StickyStrategy
-	cronInput       *string
-	defaultFilters  []types.DefaultFilter
+	onCron           []string
+	onEvents         []string
+	concurrency      []types.Concurrency
+	version          string
+	canaryPercentage *int32
+	description      string
+	taskDefaults     *create.TaskDefaults
+	defaultPriority  *RunPriority
+	stickyStrategy   *types.StickyStrategy
+	cronInput        *string
+	defaultFilters   []types.DefaultFilter
 }
 
 // WithWorkflowCron configures the workflow to run on a cron schedule.
@@ -201,6 +215,15 @@ func WithWorkflowVersion(version string) WorkflowOption {
 	}
 }
 
+// WithWorkflowCanaryPercentage rolls out the workflow version gradually: the given percentage
+// (0-100) of new triggers run on this version, and the rest stay on the previous version.
+// Must be combined with WithWorkflowVersion.
+func WithWorkflowCanaryPercentage(percentage int32) WorkflowOption {
+	return func(config *workflowConfig) {
+		config.canaryPercentage = &percentage
+	}
+}
+
 // WithWorkflowDescription sets a human-readable description for the workflow.
 func WithWorkflowDescription(description string) WorkflowOption {
 	return func(config *workflowConfig) {
@@ -250,16 +273,17 @@ func newWorkflow(name string, v0Client v0Client.Client, options ...WorkflowOptio
 	}
 
 	createOpts := create.WorkflowCreateOpts[any]{
-		Name:           name,
-		Version:        config.version,
-		Description:    config.description,
-		OnEvents:       config.onEvents,
-		OnCron:         config.onCron,
-		CronInput:      config.cronInput,
-		Concurrency:    config.concurrency,
-		TaskDefaults:   config.taskDefaults,
-		StickyStrategy: config.stickyStrategy,
-		DefaultFilters: config.defaultFilters,
+		Name:             name,
+		Version:          config.version,
+		CanaryPercentage: config.canaryPercentage,
+		Description:      config.description,
+		OnEvents:         config.onEvents,
+		OnCron:           config.onCron,
+		CronInput:        config.cronInput,
+		Concurrency:      config.concurrency,
+		TaskDefaults:     config.taskDefaults,
+		StickyStrategy:   config.stickyStrategy,
+		DefaultFilters:   config.defaultFilters,
 	}
 
 	if config.defaultPriority != nil {
@@ -282,6 +306,9 @@ type taskConfig struct {
 	retries                int32
 	retryBackoffFactor     float32
 	retryMaxBackoffSeconds int32
+	retryBackoffStrategy   types.RetryBackoffStrategy
+	retryBackoffBaseSecs   float32
+	retryRules             []*types.RetryRule
 	executionTimeout       time.Duration
 	scheduleTimeout        time.Duration
 	onCron                 []string
@@ -311,6 +338,23 @@ func WithRetryBackoff(factor float32, maxBackoffSeconds int) TaskOption {
 	}
 }
 
+// WithRetryBackoffStrategy sets the strategy used to compute the delay between retries. The base delay is used by
+// the FIXED and DECORRELATED_JITTER strategies, the jitter strategies are capped by the max backoff of WithRetryBackoff.
+func WithRetryBackoffStrategy(strategy RetryBackoffStrategy, baseSeconds float32) TaskOption {
+	return func(config *taskConfig) {
+		config.retryBackoffStrategy = strategy
+		config.retryBackoffBaseSecs = baseSeconds
+	}
+}
+
+// WithRetryRules overrides the retry policy of a task for specific error types. Return worker.NewTypedError from
+// the task to report the error type of a failure.
+func WithRetryRules(rules ...*RetryRule) TaskOption {
+	return func(config *taskConfig) {
+		config.retryRules = rules
+	}
+}
+
 // WithScheduleTimeout sets the maximum time a task can wait to be scheduled.
 func WithScheduleTimeout(timeout time.Duration) TaskOption {
 	return func(config *taskConfig) {
@@ -500,17 +544,20 @@ func (w *Workflow) NewTask(name string, fn any, options ...TaskOption) *Task {
 	}
 
 	taskOpts := create.WorkflowTask[any, any]{
-		Name:                   name,
-		Retries:                config.retries,
-		RetryBackoffFactor:     config.retryBackoffFactor,
-		RetryMaxBackoffSeconds: config.retryMaxBackoffSeconds,
-		ExecutionTimeout:       config.executionTimeout,
-		ScheduleTimeout:        config.scheduleTimeout,
-		Concurrency:            config.concurrency,
-		RateLimits:             config.rateLimits,
-		Parents:                config.parents,
-		WaitFor:                config.waitFor,
-		SkipIf:                 config.skipIf,
+		Name:                    name,
+		Retries:                 config.retries,
+		RetryBackoffFactor:      config.retryBackoffFactor,
+		RetryMaxBackoffSeconds:  config.retryMaxBackoffSeconds,
+		RetryBackoffStrategy:    config.retryBackoffStrategy,
+		RetryBackoffBaseSeconds: config.retryBackoffBaseSecs,
+		RetryRules:              config.retryRules,
+		ExecutionTimeout:        config.executionTimeout,
+		ScheduleTimeout:         config.scheduleTimeout,
+		Concurrency:             config.concurrency,
+		RateLimits:              config.rateLimits,
+		Parents:                 config.parents,
+		WaitFor:                 config.waitFor,
+		SkipIf:                  config.skipIf,
 	}
 
 	if config.isDurable {
@@ -698,6 +745,10 @@ func (w *Workflow) runWorkflowInternal(ctx context.Context, otelCtx context.Cont
 		v0Opts = append(v0Opts, v0Client.WithDesiredWorkerLabels(runOpts.DesiredWorkerLabels))
 	}
 
+	if runOpts.Version != nil {
+		v0Opts = append(v0Opts, v0Client.WithVersion(*runOpts.Version))
+	}
+
 	var v0Workflow *v0Client.Workflow
 	var err error
 
@@ -720,6 +771,7 @@ func (w *Workflow) runWorkflowInternal(ctx context.Context, otelCtx context.Cont
 			Priority:            priority,
 			AdditionalMetadata:  runOpts.AdditionalMetadata,
 			DesiredWorkerLabels: runOpts.DesiredWorkerLabels,
+			Version:             runOpts.Version,
 		})
 	} else {
 		v0Workflow, err = w.v0Client.Admin().RunWorkflow(w.declaration.Name(), input, v0Opts...)
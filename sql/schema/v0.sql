@@ -50,6 +50,9 @@ CREATE TYPE "LimitResource" AS ENUM (
 -- CreateEnum
 CREATE TYPE "LogLineLevel" AS ENUM ('DEBUG', 'INFO', 'WARN', 'ERROR');
 
+-- CreateEnum
+CREATE TYPE "RateLimitMode" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET');
+
 -- CreateEnum
 CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
@@ -103,6 +106,12 @@ CREATE TYPE "StepRunStatus" AS ENUM (
 -- CreateEnum
 CREATE TYPE "StickyStrategy" AS ENUM ('SOFT', 'HARD');
 
+-- CreateEnum
+CREATE TYPE "TenantAlertRuleKind" AS ENUM ('QUEUE_DEPTH', 'P95_DURATION', 'FAILURE_RATE', 'CRON_MISSED');
+
+-- CreateEnum
+CREATE TYPE "TenantAlertSinkKind" AS ENUM ('WEBHOOK', 'TEAMS', 'PAGERDUTY');
+
 -- CreateEnum
 CREATE TYPE "TenantMemberRole" AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
 
@@ -135,6 +144,9 @@ CREATE TYPE "WorkerSDKS" AS ENUM ('UNKNOWN', 'GO', 'PYTHON', 'TYPESCRIPT', 'RUBY
 -- CreateEnum
 CREATE TYPE "WorkerType" AS ENUM ('WEBHOOK', 'MANAGED', 'SELFHOSTED');
 
+-- CreateEnum
+CREATE TYPE "WorkflowInputValidationMode" AS ENUM ('REJECT', 'WARN');
+
 -- CreateEnum
 CREATE TYPE "WorkflowKind" AS ENUM ('FUNCTION', 'DURABLE', 'DAG');
 
@@ -152,6 +164,8 @@ CREATE TABLE "APIToken" (
     "tenantId" UUID,
     "nextAlertAt" TIMESTAMP(3),
     "internal" BOOLEAN NOT NULL DEFAULT false,
+    "scopes" TEXT[],
+    "workflows" TEXT[],
 
     CONSTRAINT "APIToken_pkey" PRIMARY KEY ("id")
 );
@@ -366,7 +380,10 @@ CREATE TABLE "RateLimit" (
     "limitValue" INTEGER NOT NULL,
     "value" INTEGER NOT NULL,
     "window" TEXT NOT NULL,
-    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "mode" "RateLimitMode" NOT NULL DEFAULT 'FIXED_WINDOW',
+    "burst" INTEGER,
+    "previousWindowUsed" INTEGER NOT NULL DEFAULT 0
 );
 
 -- CreateTable
@@ -636,6 +653,40 @@ CREATE TABLE "TenantAlertEmailGroup" (
     CONSTRAINT "TenantAlertEmailGroup_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "TenantAlertRule" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "TenantAlertRuleKind" NOT NULL,
+    -- the threshold is a number of runs, a duration in seconds or a percentage, depending on the kind
+    "threshold" DOUBLE PRECISION NOT NULL,
+    -- the window which the rule is evaluated over, as a duration string
+    "window" TEXT NOT NULL DEFAULT '1h',
+    "isFiring" BOOLEAN NOT NULL DEFAULT false,
+    "lastEvaluatedAt" TIMESTAMP(3),
+    "lastFiredAt" TIMESTAMP(3),
+
+    CONSTRAINT "TenantAlertRule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "TenantAlertSink" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "kind" "TenantAlertSinkKind" NOT NULL,
+    "name" TEXT NOT NULL,
+    -- the encrypted sink config, which contains urls, secrets and routing keys
+    "config" BYTEA NOT NULL,
+
+    CONSTRAINT "TenantAlertSink_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "TenantAlertingSettings" (
     "id" UUID NOT NULL,
@@ -676,6 +727,7 @@ CREATE TABLE "TenantMember" (
     "tenantId" UUID NOT NULL,
     "userId" UUID NOT NULL,
     "role" "TenantMemberRole" NOT NULL,
+    "customRoleId" UUID,
 
     CONSTRAINT "TenantMember_pkey" PRIMARY KEY ("id")
 );
@@ -712,6 +764,20 @@ CREATE TABLE "TenantResourceLimitAlert" (
     CONSTRAINT "TenantResourceLimitAlert_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "TenantRole" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT,
+    "inherits" TEXT[] NOT NULL DEFAULT '{}',
+    "permissions" TEXT[] NOT NULL DEFAULT '{}',
+
+    CONSTRAINT "TenantRole_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "TenantVcsProvider" (
     "id" UUID NOT NULL,
@@ -901,6 +967,7 @@ CREATE TABLE "Workflow" (
     "name" TEXT NOT NULL,
     "description" TEXT,
     "isPaused" BOOLEAN DEFAULT false,
+    "inputValidationMode" "WorkflowInputValidationMode" NOT NULL DEFAULT 'REJECT',
 
     CONSTRAINT "Workflow_pkey" PRIMARY KEY ("id")
 );
@@ -1005,6 +1072,12 @@ CREATE TYPE "WorkflowTriggerCronRefMethods" AS ENUM (
     'API'
 );
 
+-- CreateEnum
+CREATE TYPE "WorkflowTriggerCronRefMisfirePolicy" AS ENUM ('SKIP', 'FIRE_ONCE', 'FIRE_ALL');
+
+-- CreateEnum
+CREATE TYPE "WorkflowTriggerCronRefOverlapPolicy" AS ENUM ('ALLOW', 'SKIP_IF_RUNNING', 'CANCEL_PREVIOUS');
+
 
 -- CreateTable
 CREATE TABLE "WorkflowTriggerCronRef" (
@@ -1021,6 +1094,11 @@ CREATE TABLE "WorkflowTriggerCronRef" (
     "id" UUID NOT NULL,
     "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT',
     "priority" INTEGER NOT NULL DEFAULT 1,
+    "timezone" TEXT,
+    "misfirePolicy" "WorkflowTriggerCronRefMisfirePolicy" NOT NULL DEFAULT 'SKIP',
+    "misfireLimit" INTEGER,
+    "lastFiredAt" TIMESTAMP(3),
+    "overlapPolicy" "WorkflowTriggerCronRefOverlapPolicy" NOT NULL DEFAULT 'ALLOW',
     CONSTRAINT "WorkflowTriggerCronRef_pkey" PRIMARY KEY ("id")
 );
 
@@ -1086,6 +1164,7 @@ CREATE TABLE
         "defaultPriority" INTEGER,
         "createWorkflowVersionOpts" JSONB,
         "inputJsonSchema" JSONB,
+        "canaryPercentage" INTEGER,
         CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
     );
 
@@ -1354,6 +1433,12 @@ CREATE INDEX "Tenant_workerPartitionId_idx" ON "Tenant" ("workerPartitionId" ASC
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantAlertEmailGroup_id_key" ON "TenantAlertEmailGroup" ("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "TenantAlertRule_tenantId_idx" ON "TenantAlertRule" ("tenantId" ASC);
+
+-- CreateIndex
+CREATE INDEX "TenantAlertSink_tenantId_idx" ON "TenantAlertSink" ("tenantId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantAlertingSettings_id_key" ON "TenantAlertingSettings" ("id" ASC);
 
@@ -1378,6 +1463,9 @@ CREATE UNIQUE INDEX "TenantResourceLimit_tenantId_resource_key" ON "TenantResour
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantResourceLimitAlert_id_key" ON "TenantResourceLimitAlert" ("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantRole_tenantId_name_key" ON "TenantRole" ("tenantId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantVcsProvider_id_key" ON "TenantVcsProvider" ("id" ASC);
 
@@ -1657,6 +1745,15 @@ ALTER TABLE "Tenant" ADD CONSTRAINT "Tenant_workerPartitionId_fkey" FOREIGN KEY
 -- AddForeignKey
 ALTER TABLE "TenantAlertEmailGroup" ADD CONSTRAINT "TenantAlertEmailGroup_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantAlertRule" ADD CONSTRAINT "TenantAlertRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantAlertRule" ADD CONSTRAINT "TenantAlertRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "TenantAlertSink" ADD CONSTRAINT "TenantAlertSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantAlertingSettings" ADD CONSTRAINT "TenantAlertingSettings_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1672,6 +1769,9 @@ ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_tenantId_fkey" FOREIGN K
 -- AddForeignKey
 ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_customRoleId_fkey" FOREIGN KEY ("customRoleId") REFERENCES "TenantRole" ("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantResourceLimit" ADD CONSTRAINT "TenantResourceLimit_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1681,6 +1781,9 @@ ALTER TABLE "TenantResourceLimitAlert" ADD CONSTRAINT "TenantResourceLimitAlert_
 -- AddForeignKey
 ALTER TABLE "TenantResourceLimitAlert" ADD CONSTRAINT "TenantResourceLimitAlert_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantRole" ADD CONSTRAINT "TenantRole_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantVcsProvider" ADD CONSTRAINT "TenantVcsProvider_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -208,7 +208,7 @@ CREATE TYPE v1_task_initial_state AS ENUM ('QUEUED', 'CANCELLED', 'SKIPPED', 'FA
 
 -- We need a NONE strategy to allow for tasks which were previously using a concurrency strategy to
 -- enqueue if the strategy is removed.
-CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST');
+CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST', 'WEIGHTED_FAIR');
 
 CREATE TABLE v1_workflow_concurrency (
     -- We need an id used for stable ordering to prevent deadlocks. We must process all concurrency
@@ -241,6 +241,9 @@ CREATE TABLE v1_step_concurrency (
     expression TEXT NOT NULL,
     tenant_id UUID NOT NULL,
     max_concurrency INTEGER NOT NULL,
+    -- A CEL expression evaluated against each concurrency key to determine its weight, only used by
+    -- the WEIGHTED_FAIR strategy
+    weight_expression TEXT,
     CONSTRAINT v1_step_concurrency_pkey PRIMARY KEY (workflow_id, workflow_version_id, step_id, id)
 );
 
@@ -369,6 +372,9 @@ CREATE TABLE v1_task (
     concurrency_keys TEXT[],
     retry_backoff_factor DOUBLE PRECISION,
     retry_max_backoff INTEGER,
+    -- retry_delay_seconds is the delay before the next retry as computed by the step's retry strategy. When null,
+    -- the delay is derived from retry_backoff_factor and retry_max_backoff.
+    retry_delay_seconds DOUBLE PRECISION,
     is_durable BOOLEAN,
     desired_worker_label JSONB,
     triggering_event_external_id UUID,
@@ -519,6 +525,19 @@ CREATE TABLE v1_worker_slot_config (
 
 CREATE INDEX v1_worker_slot_config_worker_id_idx ON v1_worker_slot_config (worker_id);
 
+-- v1_worker_workflow_version stores the version of each workflow which a worker registered, used to route runs
+-- which are pinned to a workflow version to matching workers
+CREATE TABLE v1_worker_workflow_version (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    version TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, worker_id, workflow_id)
+);
+
+CREATE INDEX v1_worker_workflow_version_worker_id_idx ON v1_worker_workflow_version (worker_id);
+
 -- v1_step_slot_request stores per-step slot requests.
 CREATE TABLE v1_step_slot_request (
     tenant_id UUID NOT NULL,
@@ -533,6 +552,30 @@ CREATE TABLE v1_step_slot_request (
 CREATE INDEX v1_step_slot_request_step_idx
     ON v1_step_slot_request (step_id ASC);
 
+-- v1_step_output_schema stores the JSON schema which the output of a step is validated against when it completes.
+CREATE TABLE v1_step_output_schema (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    output_schema JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_retry_backoff_strategy AS ENUM ('EXPONENTIAL', 'FULL_JITTER', 'DECORRELATED_JITTER', 'FIXED');
+
+-- v1_step_retry_policy stores the retry strategy of a step. The row with an empty error_type is the default policy
+-- for the step, other rows override the policy when a task fails with a matching error type.
+CREATE TABLE v1_step_retry_policy (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    error_type TEXT NOT NULL DEFAULT '',
+    max_retries INTEGER,
+    backoff_strategy v1_retry_backoff_strategy NOT NULL DEFAULT 'EXPONENTIAL',
+    backoff_base_seconds DOUBLE PRECISION,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (step_id, error_type)
+);
+
 -- v1_task_runtime_slot stores runtime slot consumption per task.
 CREATE TABLE v1_task_runtime_slot (
     tenant_id UUID NOT NULL,
@@ -685,11 +728,11 @@ CREATE UNIQUE INDEX v1_filter_unique_tenant_workflow_id_scope_expression_payload
 );
 
 CREATE TYPE v1_incoming_webhook_auth_type AS ENUM ('BASIC', 'API_KEY', 'HMAC');
-CREATE TYPE v1_incoming_webhook_hmac_algorithm AS ENUM ('SHA1', 'SHA256', 'SHA512', 'MD5');
+CREATE TYPE v1_incoming_webhook_hmac_algorithm AS ENUM ('SHA1', 'SHA256', 'SHA512', 'MD5', 'ED25519');
 CREATE TYPE v1_incoming_webhook_hmac_encoding AS ENUM ('HEX', 'BASE64', 'BASE64URL');
 
 -- Can add more sources in the future
-CREATE TYPE v1_incoming_webhook_source_name AS ENUM ('GENERIC', 'GITHUB', 'STRIPE', 'SLACK', 'LINEAR', 'SVIX');
+CREATE TYPE v1_incoming_webhook_source_name AS ENUM ('GENERIC', 'GITHUB', 'STRIPE', 'SLACK', 'LINEAR', 'SVIX', 'GITLAB', 'BITBUCKET', 'SHOPIFY', 'TWILIO', 'DISCORD');
 
 CREATE TABLE v1_incoming_webhook (
     tenant_id UUID NOT NULL,
@@ -1260,12 +1303,13 @@ BEGIN
             nt.inserted_at,
             nt.retry_count,
             nt.tenant_id,
-            -- Convert the retry_after based on min(retry_backoff_factor ^ retry_count, retry_max_backoff)
-            NOW() + (LEAST(nt.retry_max_backoff, POWER(nt.retry_backoff_factor, nt.app_retry_count)) * interval '1 second') AS retry_after
+            -- Use the delay computed by the retry strategy if set, otherwise convert the retry_after based on
+            -- min(retry_backoff_factor ^ retry_count, retry_max_backoff)
+            NOW() + (COALESCE(nt.retry_delay_seconds, LEAST(nt.retry_max_backoff, POWER(nt.retry_backoff_factor, nt.app_retry_count))) * interval '1 second') AS retry_after
         FROM new_table nt
         JOIN old_table ot ON ot.id = nt.id
         WHERE nt.initial_state = 'QUEUED'
-            AND nt.retry_backoff_factor IS NOT NULL
+            AND (nt.retry_backoff_factor IS NOT NULL OR nt.retry_delay_seconds IS NOT NULL)
             AND ot.app_retry_count IS DISTINCT FROM nt.app_retry_count
             AND nt.app_retry_count != 0
     )
@@ -1316,7 +1360,7 @@ BEGIN
         WHERE nt.initial_state = 'QUEUED'
             -- Concurrency strategy id should never be null
             AND nt.concurrency_strategy_ids[1] IS NOT NULL
-            AND (nt.retry_backoff_factor IS NULL OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
+            AND ((nt.retry_backoff_factor IS NULL AND nt.retry_delay_seconds IS NULL) OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
             AND ot.retry_count IS DISTINCT FROM nt.retry_count
     ), updated_slot AS (
         UPDATE
@@ -1422,7 +1466,7 @@ BEGIN
     JOIN old_table ot ON ot.id = nt.id
     WHERE nt.initial_state = 'QUEUED'
         AND nt.concurrency_strategy_ids[1] IS NULL
-        AND (nt.retry_backoff_factor IS NULL OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
+        AND ((nt.retry_backoff_factor IS NULL AND nt.retry_delay_seconds IS NULL) OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
         AND ot.retry_count IS DISTINCT FROM nt.retry_count
     ON CONFLICT (task_id, task_inserted_at, retry_count) DO NOTHING
     ;
@@ -2388,7 +2432,9 @@ CREATE TABLE v1_durable_event_log_file (
 CREATE TYPE v1_durable_event_log_kind AS ENUM (
     'RUN',
     'WAIT_FOR',
-    'MEMO'
+    'MEMO',
+    'SIGNAL',
+    'PATCH'
 );
 
 CREATE TABLE v1_durable_event_log_entry (
@@ -2435,6 +2481,9 @@ CREATE TABLE v1_durable_event_log_entry (
     CONSTRAINT v1_durable_event_log_entry_pkey PRIMARY KEY (durable_task_id, durable_task_inserted_at, branch_id, node_id)
 ) PARTITION BY RANGE(durable_task_inserted_at);
 
+-- Supports listing the in-flight durable tasks which recorded a patch marker, without scanning the whole event log
+CREATE INDEX v1_durable_event_log_entry_patch_idx ON v1_durable_event_log_entry (tenant_id, idempotency_key) WHERE kind = 'PATCH';
+
 
 CREATE TABLE v1_durable_event_log_branch_point (
     tenant_id UUID NOT NULL,
@@ -2456,6 +2505,21 @@ CREATE TABLE v1_durable_event_log_branch_point (
     CONSTRAINT v1_durable_event_log_branch_point_pkey PRIMARY KEY (durable_task_id, durable_task_inserted_at, parent_branch_id, first_node_id_in_new_branch, next_branch_id)
 ) PARTITION BY RANGE(durable_task_inserted_at);
 
+-- v1_durable_signal stores signals which were sent to a durable task before it waited for them. A signal is consumed
+-- by the first SIGNAL entry with the same name in the durable event log of the task, which records the signal payload
+-- as its result.
+CREATE TABLE v1_durable_signal (
+    tenant_id UUID NOT NULL,
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+    durable_task_id BIGINT NOT NULL,
+    durable_task_inserted_at TIMESTAMPTZ NOT NULL,
+    name TEXT NOT NULL,
+    payload BYTEA,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT v1_durable_signal_pkey PRIMARY KEY (durable_task_id, durable_task_inserted_at, id)
+) PARTITION BY RANGE(durable_task_inserted_at);
+
 CREATE TABLE tenant_entitlement (
     tenant_id UUID NOT NULL,
 
@@ -2469,6 +2533,144 @@ CREATE TABLE tenant_entitlement (
     CONSTRAINT tenant_entitlement_pkey PRIMARY KEY (tenant_id)
 );
 
+CREATE TYPE v1_cron_fire_outcome AS ENUM ('TRIGGERED', 'SKIPPED_OVERLAP', 'SKIPPED_INVALID_INPUT');
+
+CREATE TABLE v1_cron_fire (
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    cron_id UUID NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    fired_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    outcome v1_cron_fire_outcome NOT NULL,
+    -- the external id of the workflow run which was triggered, NULL if the fire was skipped
+    workflow_run_external_id UUID,
+
+    CONSTRAINT v1_cron_fire_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_cron_fire_cron_id_idx ON v1_cron_fire (cron_id, id DESC);
+
+-- v1_dead_letter_policy configures the workflow which is triggered when a workflow run fails permanently. The policy
+-- without a source workflow is the tenant default, and applies to every workflow which doesn't have its own policy.
+CREATE TABLE v1_dead_letter_policy (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    source_workflow_id UUID,
+    dead_letter_workflow_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_dead_letter_policy_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX v1_dead_letter_policy_source_idx ON v1_dead_letter_policy (
+    tenant_id,
+    COALESCE(source_workflow_id, '00000000-0000-0000-0000-000000000000'::UUID)
+);
+
+-- v1_dead_letter_run stores the failed workflow runs which haven't been sent to their dead-letter workflow yet
+CREATE TABLE v1_dead_letter_run (
+    tenant_id UUID NOT NULL,
+    workflow_run_external_id UUID NOT NULL,
+    dead_letter_workflow_name TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_dead_letter_run_pkey PRIMARY KEY (tenant_id, workflow_run_external_id)
+);
+
+CREATE TYPE v1_bulk_operation_kind AS ENUM ('CANCEL', 'REPLAY', 'REPLAY_EVENTS');
+
+CREATE TYPE v1_bulk_operation_status AS ENUM ('PENDING', 'RUNNING', 'COMPLETED', 'CANCELLED');
+
+-- v1_bulk_operation stores bulk cancellations and replays of the runs matching a filter, which are processed in
+-- batches by the tasks controller
+CREATE TABLE v1_bulk_operation (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_operation_kind NOT NULL,
+    status v1_bulk_operation_status NOT NULL DEFAULT 'PENDING',
+    filter JSONB NOT NULL,
+    -- the number of runs which matched the filter, NULL until the operation has started
+    total_count INTEGER,
+    processed_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+
+    CONSTRAINT v1_bulk_operation_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_bulk_operation_tenant_id_idx ON v1_bulk_operation (tenant_id, created_at DESC);
+
+CREATE INDEX v1_bulk_operation_active_idx ON v1_bulk_operation (tenant_id, created_at) WHERE status IN ('PENDING', 'RUNNING');
+
+-- v1_bulk_operation_run stores the runs matched by a bulk operation which haven't been processed yet
+CREATE TABLE v1_bulk_operation_run (
+    operation_id UUID NOT NULL,
+    workflow_run_external_id UUID NOT NULL,
+
+    CONSTRAINT v1_bulk_operation_run_pkey PRIMARY KEY (operation_id, workflow_run_external_id)
+);
+
+-- v1_workflow_retention_policy overrides how long the runs of a workflow are kept. The retention period can be set
+-- per final status of a run, for example to keep failed runs for longer than completed runs. Partitions are kept
+-- until the longest retention period has passed, and runs which expire before their partition is dropped are
+-- deleted row by row.
+CREATE TABLE v1_workflow_retention_policy (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    retention_period INTERVAL NOT NULL,
+    completed_retention_period INTERVAL,
+    failed_retention_period INTERVAL,
+    cancelled_retention_period INTERVAL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_workflow_retention_policy_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX v1_workflow_retention_policy_workflow_idx ON v1_workflow_retention_policy (tenant_id, workflow_id);
+
+CREATE TYPE v1_resource_limit_scope AS ENUM ('WORKFLOW', 'METADATA');
+
+-- v1_scoped_resource_limit limits the usage of a resource by the runs of a workflow, or by the runs with an additional
+-- metadata key and value, on top of the limits of the tenant. Usage is counted over the window of the limit. Crossing
+-- the soft limit alerts the tenant, and triggers which would cross the hard limit are rejected.
+CREATE TABLE v1_scoped_resource_limit (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    resource "LimitResource" NOT NULL,
+    scope v1_resource_limit_scope NOT NULL,
+    workflow_id UUID,
+    metadata_key TEXT,
+    metadata_value TEXT,
+    limit_value INTEGER NOT NULL,
+    soft_limit_value INTEGER,
+    limit_window INTERVAL NOT NULL,
+    value INTEGER NOT NULL DEFAULT 0,
+    last_refill TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    soft_limit_alerted_at TIMESTAMPTZ,
+    hard_limit_alerted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT v1_scoped_resource_limit_pkey PRIMARY KEY (id),
+    CONSTRAINT v1_scoped_resource_limit_scope_check CHECK (
+        (scope = 'WORKFLOW' AND workflow_id IS NOT NULL AND metadata_key IS NULL AND metadata_value IS NULL)
+        OR (scope = 'METADATA' AND workflow_id IS NULL AND metadata_key IS NOT NULL AND metadata_value IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX v1_scoped_resource_limit_scope_idx ON v1_scoped_resource_limit (
+    tenant_id,
+    resource,
+    scope,
+    COALESCE(workflow_id, '00000000-0000-0000-0000-000000000000'::uuid),
+    COALESCE(metadata_key, ''),
+    COALESCE(metadata_value, '')
+);
+
 CREATE OR REPLACE FUNCTION after_v1_concurrency_slot_insert_outbox_function()
 RETURNS trigger AS $$
 BEGIN
@@ -576,7 +576,7 @@ CREATE TABLE v1_event_to_run_olap (
     PRIMARY KEY (event_id, event_seen_at, run_id, run_inserted_at)
 ) PARTITION BY RANGE(event_seen_at);
 
-CREATE TYPE v1_cel_evaluation_failure_source AS ENUM ('FILTER', 'WEBHOOK');
+CREATE TYPE v1_cel_evaluation_failure_source AS ENUM ('FILTER', 'WEBHOOK', 'INPUT_SCHEMA', 'SCOPED_LIMIT');
 
 CREATE TABLE v1_cel_evaluation_failures_olap (
     id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,